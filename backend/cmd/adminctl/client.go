@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// apiResponse 管理 API 的统一响应包装
+type apiResponse struct {
+	Code     int               `json:"code"`
+	Message  string            `json:"message"`
+	Reason   string            `json:"reason"`
+	Metadata map[string]string `json:"metadata"`
+	Data     json.RawMessage   `json:"data"`
+}
+
+// page 分页响应
+type page struct {
+	Items    []any `json:"items"`
+	Total    int64 `json:"total"`
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+	Pages    int   `json:"pages"`
+}
+
+type client struct {
+	server string
+	apiKey string
+	http   *http.Client
+}
+
+func newClient(server, apiKey string) *client {
+	return &client{
+		server: strings.TrimRight(server, "/"),
+		apiKey: apiKey,
+		http:   &http.Client{Timeout: 2 * time.Minute},
+	}
+}
+
+func (c *client) newRequest(ctx context.Context, method, path string, query url.Values, body any) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(raw)
+	}
+	target := c.server + "/api/v1/admin" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-api-key", c.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// call 发起请求并将 data 解码到 out（out 为 nil 时忽略 data）
+func (c *client) call(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	req, err := c.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var envelope apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("%s %s: HTTP %d: invalid response: %w", method, path, resp.StatusCode, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 || envelope.Code != 0 {
+		msg := envelope.Message
+		if envelope.Reason != "" {
+			msg += " (" + envelope.Reason + ")"
+		}
+		if detail := envelope.Metadata["detail"]; detail != "" {
+			msg += ": " + detail
+		}
+		return fmt.Errorf("%s %s: HTTP %d: %s", method, path, resp.StatusCode, msg)
+	}
+	if out == nil || len(envelope.Data) == 0 {
+		return nil
+	}
+	// 保留数字原样，避免大整数 ID 与金额在 JSON 输出中丢失精度
+	dec := json.NewDecoder(bytes.NewReader(envelope.Data))
+	dec.UseNumber()
+	return dec.Decode(out)
+}
+
+// list 读取一页；all 为 true 时逐页读取全部记录
+func (c *client) list(ctx context.Context, path string, query url.Values, all bool) (*page, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	var result page
+	if err := c.call(ctx, http.MethodGet, path, query, nil, &result); err != nil {
+		return nil, err
+	}
+	for all && result.Page < result.Pages {
+		query.Set("page", fmt.Sprint(result.Page+1))
+		var next page
+		if err := c.call(ctx, http.MethodGet, path, query, nil, &next); err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, next.Items...)
+		result.Page = next.Page
+		if len(next.Items) == 0 {
+			break
+		}
+	}
+	return &result, nil
+}
+
+// stream 读取 SSE 响应，逐个回调 data 事件
+func (c *client) stream(ctx context.Context, method, path string, body any, fn func(data []byte) error) error {
+	req, err := c.newRequest(ctx, method, path, nil, body)
+	if err != nil {
+		return err
+	}
+	// 流式响应的时长由服务端控制
+	httpClient := *c.http
+	httpClient.Timeout = 0
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		// 参数错误等情况返回普通 JSON
+		var envelope apiResponse
+		if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+			return fmt.Errorf("%s %s: HTTP %d: invalid response: %w", method, path, resp.StatusCode, err)
+		}
+		return fmt.Errorf("%s %s: HTTP %d: %s", method, path, resp.StatusCode, envelope.Message)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		if err := fn([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:")))); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,82 @@
+//go:build unit
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCall_InjectsAuthAndDecodesNumbers(t *testing.T) {
+	api := newFakeAdminAPI(t, map[string]func(r *http.Request) any{
+		"POST /api/v1/admin/users": func(r *http.Request) any {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			return map[string]any{"id": 9007199254740993, "email": body["email"]}
+		},
+	})
+	c := newClient(api.URL+"/", "admin-key")
+
+	var out any
+	require.NoError(t, c.call(context.Background(), http.MethodPost, "/users", nil, map[string]any{"email": "bob@example.com"}, &out))
+	req := api.lastRequest(t)
+	require.Equal(t, "admin-key", req.Header.Get("x-api-key"))
+	require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	require.Equal(t, "/api/v1/admin/users", req.URL.Path, "trailing slash on the server URL is trimmed")
+	require.Equal(t, json.Number("9007199254740993"), lookup(out, "id"), "large IDs keep full precision")
+	require.Equal(t, "bob@example.com", lookup(out, "email"))
+
+	// GET 请求不带请求体
+	_ = c.call(context.Background(), http.MethodGet, "/users", url.Values{"search": {"bob"}}, nil, nil)
+	req = api.lastRequest(t)
+	require.Empty(t, req.Header.Get("Content-Type"))
+	require.Equal(t, "bob", req.URL.Query().Get("search"))
+}
+
+func TestClientCall_ErrorEnvelope(t *testing.T) {
+	api := newFakeAdminAPI(t, nil)
+	api.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"code": 400, "message": "invalid request", "reason": "EMAIL_EXISTS", "metadata": map[string]string{"detail": "bob@example.com"},
+		})
+	})
+
+	err := newClient(api.URL, "k").call(context.Background(), http.MethodPost, "/users", nil, map[string]any{}, nil)
+	require.EqualError(t, err, "POST /users: HTTP 400: invalid request (EMAIL_EXISTS): bob@example.com")
+}
+
+func TestClientList_AllPages(t *testing.T) {
+	api := newFakeAdminAPI(t, map[string]func(r *http.Request) any{
+		"GET /api/v1/admin/accounts": func(r *http.Request) any {
+			pageNum, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			items := []any{}
+			for i := 0; i < 2; i++ {
+				items = append(items, map[string]any{"id": pageNum*10 + i, "name": fmt.Sprintf("acc-%d-%d", pageNum, i)})
+			}
+			return map[string]any{"items": items, "total": 6, "page": pageNum, "page_size": 2, "pages": 3}
+		},
+	})
+	c := newClient(api.URL, "k")
+
+	single, err := c.list(context.Background(), "/accounts", url.Values{"page": {"2"}, "platform": {"anthropic"}}, false)
+	require.NoError(t, err)
+	require.Len(t, single.Items, 2)
+	require.Equal(t, 2, single.Page)
+	require.Len(t, api.requests, 1)
+
+	all, err := c.list(context.Background(), "/accounts", url.Values{"page": {"1"}, "platform": {"anthropic"}}, true)
+	require.NoError(t, err)
+	require.Len(t, all.Items, 6)
+	require.Equal(t, 3, all.Page)
+	require.Equal(t, json.Number("31"), lookup(all.Items[5], "id"))
+	require.Len(t, api.requests, 4)
+	require.Equal(t, "anthropic", api.lastRequest(t).URL.Query().Get("platform"), "filters are kept across pages")
+}
@@ -0,0 +1,707 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	userColumns    = columns("id", "email", "username", "role", "status", "balance", "concurrency", "created_at")
+	accountColumns = columns("id", "name", "platform", "type", "status", "schedulable", "priority", "concurrency", "error_message")
+	codeColumns    = columns("id", "code", "type", "value", "status", "used_by", "created_at")
+)
+
+// parseArgs 解析参数，允许标志与位置参数交错（如 "users get 42 -o json"），
+// 全局的 -o 也可写在操作之后
+func (a *app) parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&a.output, "o", a.output, "output format: table or json")
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: %v", errUsage, err)
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// parseID 解析唯一的位置参数 ID
+func parseID(positional []string) (string, error) {
+	if len(positional) != 1 {
+		return "", errUsage
+	}
+	if _, err := strconv.ParseInt(positional[0], 10, 64); err != nil {
+		return "", fmt.Errorf("invalid id %q", positional[0])
+	}
+	return positional[0], nil
+}
+
+// listFlags 列表命令的公共参数
+type listFlags struct {
+	page     int
+	pageSize int
+	all      bool
+}
+
+func (l *listFlags) register(fs *flag.FlagSet) {
+	fs.IntVar(&l.page, "page", 1, "page number")
+	fs.IntVar(&l.pageSize, "page-size", 20, "page size (max 1000)")
+	fs.BoolVar(&l.all, "all", false, "fetch all pages")
+}
+
+func (l *listFlags) query() url.Values {
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(l.page))
+	q.Set("page_size", strconv.Itoa(l.pageSize))
+	if l.all {
+		q.Set("page", "1")
+		q.Set("page_size", "1000")
+	}
+	return q
+}
+
+// setIf 仅在值非空时设置查询参数
+func setIf(q url.Values, key, value string) {
+	if value != "" {
+		q.Set(key, value)
+	}
+}
+
+// runList 执行列表命令并输出，表格模式下在末尾打印分页信息
+func runList(ctx context.Context, a *app, path string, q url.Values, all bool, cols []column) error {
+	p, err := a.printer()
+	if err != nil {
+		return err
+	}
+	result, err := a.client.list(ctx, path, q, all)
+	if err != nil {
+		return err
+	}
+	if result.Items == nil {
+		result.Items = []any{}
+	}
+	if err := p.print(result.Items, cols); err != nil {
+		return err
+	}
+	if p.format == outputTable && !all {
+		fmt.Fprintf(a.stderr, "page %d/%d, %d total\n", result.Page, result.Pages, result.Total)
+	}
+	return nil
+}
+
+// runObject 执行返回单个对象的请求并输出
+func runObject(ctx context.Context, a *app, method, path string, body any, cols []column) error {
+	p, err := a.printer()
+	if err != nil {
+		return err
+	}
+	var out any
+	if err := a.client.call(ctx, method, path, nil, body, &out); err != nil {
+		return err
+	}
+	if items, ok := out.([]any); ok && p.format == outputTable {
+		return p.print(items, cols)
+	}
+	return p.print(out, cols)
+}
+
+var userCommands = map[string]command{
+	"list": {
+		usage: "[-search TEXT] [-status active|disabled] [-role admin|user] [-page N] [-page-size N] [-all]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("users list", flag.ContinueOnError)
+			var lf listFlags
+			lf.register(fs)
+			search := fs.String("search", "", "search email or username")
+			status := fs.String("status", "", "filter by status")
+			role := fs.String("role", "", "filter by role")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			q := lf.query()
+			setIf(q, "search", *search)
+			setIf(q, "status", *status)
+			setIf(q, "role", *role)
+			return runList(ctx, a, "/users", q, lf.all, userColumns)
+		},
+	},
+	"get": {
+		usage: "ID",
+		run: func(ctx context.Context, a *app, args []string) error {
+			positional, err := a.parseArgs(flag.NewFlagSet("users get", flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+			id, err := parseID(positional)
+			if err != nil {
+				return err
+			}
+			return runObject(ctx, a, http.MethodGet, "/users/"+id, nil, append(userColumns, columns("notes", "allowed_groups")...))
+		},
+	},
+	"create": {
+		usage: "-email EMAIL -password PASSWORD [-username NAME] [-notes TEXT] [-balance USD] [-concurrency N]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("users create", flag.ContinueOnError)
+			email := fs.String("email", "", "email (required)")
+			password := fs.String("password", "", "password, at least 6 characters (required)")
+			username := fs.String("username", "", "display name")
+			notes := fs.String("notes", "", "admin notes")
+			balance := fs.Float64("balance", 0, "initial balance in USD")
+			concurrency := fs.Int("concurrency", 0, "concurrency limit (0 uses the default)")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			if *email == "" || *password == "" {
+				return errUsage
+			}
+			body := map[string]any{
+				"email":       *email,
+				"password":    *password,
+				"username":    *username,
+				"notes":       *notes,
+				"balance":     *balance,
+				"concurrency": *concurrency,
+			}
+			return runObject(ctx, a, http.MethodPost, "/users", body, userColumns)
+		},
+	},
+	"update": {
+		usage: "ID [-email EMAIL] [-password PASSWORD] [-username NAME] [-notes TEXT] [-concurrency N] [-status active|disabled]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("users update", flag.ContinueOnError)
+			fs.String("email", "", "new email")
+			fs.String("password", "", "new password")
+			fs.String("username", "", "new display name")
+			fs.String("notes", "", "new admin notes")
+			fs.Int("concurrency", 0, "new concurrency limit")
+			fs.String("status", "", "active or disabled")
+			positional, err := a.parseArgs(fs, args)
+			if err != nil {
+				return err
+			}
+			id, err := parseID(positional)
+			if err != nil {
+				return err
+			}
+			// 仅提交显式指定的字段
+			body := map[string]any{}
+			fs.Visit(func(f *flag.Flag) {
+				switch f.Name {
+				case "o":
+				case "concurrency":
+					body[f.Name] = f.Value.(flag.Getter).Get()
+				default:
+					body[f.Name] = f.Value.String()
+				}
+			})
+			if len(body) == 0 {
+				return errors.New("nothing to update")
+			}
+			return runObject(ctx, a, http.MethodPut, "/users/"+id, body, userColumns)
+		},
+	},
+	"balance": {
+		usage: "ID (-set USD | -add USD | -subtract USD) [-notes TEXT]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("users balance", flag.ContinueOnError)
+			fs.Float64("set", 0, "set the balance")
+			fs.Float64("add", 0, "add to the balance")
+			fs.Float64("subtract", 0, "subtract from the balance")
+			notes := fs.String("notes", "", "reason, recorded in the balance history")
+			positional, err := a.parseArgs(fs, args)
+			if err != nil {
+				return err
+			}
+			id, err := parseID(positional)
+			if err != nil {
+				return err
+			}
+			var operations []*flag.Flag
+			fs.Visit(func(f *flag.Flag) {
+				if f.Name != "notes" && f.Name != "o" {
+					operations = append(operations, f)
+				}
+			})
+			if len(operations) != 1 {
+				return errUsage
+			}
+			body := map[string]any{
+				"balance":   operations[0].Value.(flag.Getter).Get(),
+				"operation": operations[0].Name,
+				"notes":     *notes,
+			}
+			return runObject(ctx, a, http.MethodPost, "/users/"+id+"/balance", body, userColumns)
+		},
+	},
+}
+
+var codeCommands = map[string]command{
+	"generate": {
+		usage: "-type balance|concurrency|subscription|invitation [-count N] [-value N] [-group ID] [-validity-days N]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("codes generate", flag.ContinueOnError)
+			count := fs.Int("count", 1, "number of codes (max 100)")
+			codeType := fs.String("type", "balance", "code type")
+			value := fs.Float64("value", 0, "USD for balance codes, slots for concurrency codes")
+			group := fs.Int64("group", 0, "group ID (subscription codes)")
+			validity := fs.Int("validity-days", 0, "subscription validity in days")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			body := map[string]any{
+				"count":         *count,
+				"type":          *codeType,
+				"value":         *value,
+				"validity_days": *validity,
+			}
+			if *group > 0 {
+				body["group_id"] = *group
+			}
+			return runObject(ctx, a, http.MethodPost, "/redeem-codes/generate", body, codeColumns)
+		},
+	},
+	"list": {
+		usage: "[-type TYPE] [-status unused|used|expired] [-search TEXT] [-page N] [-page-size N] [-all]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("codes list", flag.ContinueOnError)
+			var lf listFlags
+			lf.register(fs)
+			codeType := fs.String("type", "", "filter by type")
+			status := fs.String("status", "", "filter by status")
+			search := fs.String("search", "", "search code")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			q := lf.query()
+			setIf(q, "type", *codeType)
+			setIf(q, "status", *status)
+			setIf(q, "search", *search)
+			return runList(ctx, a, "/redeem-codes", q, lf.all, codeColumns)
+		},
+	},
+}
+
+// accountAction 对单个账号执行无参数的 POST 操作
+func accountAction(name, path string, cols []column) command {
+	return command{
+		usage: "ID",
+		run: func(ctx context.Context, a *app, args []string) error {
+			positional, err := a.parseArgs(flag.NewFlagSet("accounts "+name, flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+			id, err := parseID(positional)
+			if err != nil {
+				return err
+			}
+			return runObject(ctx, a, http.MethodPost, "/accounts/"+id+path, nil, cols)
+		},
+	}
+}
+
+var accountCommands = map[string]command{
+	"list": {
+		usage: "[-platform P] [-type T] [-status S] [-search TEXT] [-page N] [-page-size N] [-all]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("accounts list", flag.ContinueOnError)
+			var lf listFlags
+			lf.register(fs)
+			platform := fs.String("platform", "", "filter by platform")
+			accountType := fs.String("type", "", "filter by account type")
+			status := fs.String("status", "", "filter by status")
+			search := fs.String("search", "", "search name")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			q := lf.query()
+			setIf(q, "platform", *platform)
+			setIf(q, "type", *accountType)
+			setIf(q, "status", *status)
+			setIf(q, "search", *search)
+			return runList(ctx, a, "/accounts", q, lf.all, accountColumns)
+		},
+	},
+	"get": {
+		usage: "ID",
+		run: func(ctx context.Context, a *app, args []string) error {
+			positional, err := a.parseArgs(flag.NewFlagSet("accounts get", flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+			id, err := parseID(positional)
+			if err != nil {
+				return err
+			}
+			cols := append(accountColumns, columns("rate_limit_reset_at", "temp_unschedulable_until", "last_used_at", "expires_at")...)
+			return runObject(ctx, a, http.MethodGet, "/accounts/"+id, nil, cols)
+		},
+	},
+	"test": {
+		usage: "ID [-model MODEL]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("accounts test", flag.ContinueOnError)
+			model := fs.String("model", "", "model to test (default: platform default)")
+			positional, err := a.parseArgs(fs, args)
+			if err != nil {
+				return err
+			}
+			id, err := parseID(positional)
+			if err != nil {
+				return err
+			}
+			return testAccount(ctx, a, id, *model)
+		},
+	},
+	"refresh":          accountAction("refresh", "/refresh", accountColumns),
+	"clear-rate-limit": accountAction("clear-rate-limit", "/clear-rate-limit", columns("message")),
+	"clear-error":      accountAction("clear-error", "/clear-error", accountColumns),
+	"schedulable": {
+		usage: "ID on|off",
+		run: func(ctx context.Context, a *app, args []string) error {
+			positional, err := a.parseArgs(flag.NewFlagSet("accounts schedulable", flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+			if len(positional) != 2 {
+				return errUsage
+			}
+			id, err := parseID(positional[:1])
+			if err != nil {
+				return err
+			}
+			var schedulable bool
+			switch strings.ToLower(positional[1]) {
+			case "on", "true", "yes":
+				schedulable = true
+			case "off", "false", "no":
+			default:
+				return errUsage
+			}
+			return runObject(ctx, a, http.MethodPost, "/accounts/"+id+"/schedulable", map[string]any{"schedulable": schedulable}, accountColumns)
+		},
+	},
+}
+
+// testAccount 流式输出账号测试过程，测试失败时返回错误
+func testAccount(ctx context.Context, a *app, id, model string) error {
+	p, err := a.printer()
+	if err != nil {
+		return err
+	}
+	var body map[string]any
+	if model != "" {
+		body = map[string]any{"model_id": model}
+	}
+
+	var (
+		success bool
+		failure string
+	)
+	err = a.client.stream(ctx, http.MethodPost, "/accounts/"+id+"/test", body, func(data []byte) error {
+		var event struct {
+			Type    string `json:"type"`
+			Text    string `json:"text"`
+			Model   string `json:"model"`
+			Success bool   `json:"success"`
+			Error   string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil
+		}
+		if p.format == outputJSON {
+			fmt.Fprintln(p.w, string(data))
+		} else {
+			switch event.Type {
+			case "test_start":
+				fmt.Fprintf(p.w, "Testing account %s with %s...\n", id, event.Model)
+			case "content":
+				fmt.Fprint(p.w, event.Text)
+			}
+		}
+		switch event.Type {
+		case "test_complete":
+			success = event.Success
+		case "error":
+			failure = event.Error
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if p.format == outputTable {
+		fmt.Fprintln(p.w)
+	}
+	if failure != "" {
+		return fmt.Errorf("test failed: %s", failure)
+	}
+	if !success {
+		return errors.New("test did not complete")
+	}
+	if p.format == outputTable {
+		fmt.Fprintln(p.w, "Test passed.")
+	}
+	return nil
+}
+
+var opsCommands = map[string]command{
+	"tail": {
+		usage: "[-since DURATION] [-platform P] [-account ID] [-phase PHASE] [-interval DURATION] [-once]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("ops tail", flag.ContinueOnError)
+			since := fs.Duration("since", 10*time.Minute, "show errors from this long ago before following")
+			platform := fs.String("platform", "", "filter by platform")
+			account := fs.String("account", "", "filter by account ID")
+			phase := fs.String("phase", "", "filter by phase (request, auth, routing, upstream, network, internal)")
+			interval := fs.Duration("interval", 5*time.Second, "poll interval")
+			once := fs.Bool("once", false, "print recent errors and exit")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			q := url.Values{}
+			setIf(q, "platform", *platform)
+			setIf(q, "account_id", *account)
+			setIf(q, "phase", *phase)
+			return tailOpsErrors(ctx, a, q, time.Now().Add(-*since), *interval, *once)
+		},
+	},
+}
+
+// tailOpsErrors 轮询错误日志，按时间顺序输出新出现的记录
+func tailOpsErrors(ctx context.Context, a *app, filter url.Values, start time.Time, interval time.Duration, once bool) error {
+	p, err := a.printer()
+	if err != nil {
+		return err
+	}
+	var lastID int64
+	for {
+		q := url.Values{}
+		for k, v := range filter {
+			q[k] = v
+		}
+		q.Set("start_time", start.UTC().Format(time.RFC3339Nano))
+		q.Set("page_size", "500")
+		result, err := a.client.list(ctx, "/ops/errors", q, false)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		// 接口按时间倒序返回
+		items := result.Items
+		sort.SliceStable(items, func(i, j int) bool { return itemID(items[i]) < itemID(items[j]) })
+		for _, item := range items {
+			id := itemID(item)
+			if id <= lastID {
+				continue
+			}
+			lastID = id
+			if created, ok := lookup(item, "created_at").(string); ok {
+				if t, err := time.Parse(time.RFC3339Nano, created); err == nil && t.After(start) {
+					// 保留 1 秒重叠，避免遗漏同一时刻写入的记录；重复记录按 ID 过滤
+					start = t.Add(-time.Second)
+				}
+			}
+			if p.format == outputJSON {
+				raw, _ := json.Marshal(item)
+				fmt.Fprintln(p.w, string(raw))
+				continue
+			}
+			fmt.Fprintf(p.w, "%s  #%d  %s  %s  %s  %s  %s\n",
+				formatCell(lookup(item, "created_at")), id,
+				formatCell(lookup(item, "status_code")),
+				formatCell(lookup(item, "platform")),
+				formatCell(lookup(item, "account_name")),
+				formatCell(lookup(item, "model")),
+				formatCell(lookup(item, "message")))
+		}
+
+		if once {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+func itemID(item any) int64 {
+	if n, ok := lookup(item, "id").(json.Number); ok {
+		id, _ := n.Int64()
+		return id
+	}
+	return 0
+}
+
+// usageExportColumns CSV 导出列
+var usageExportColumns = []string{
+	"id", "created_at", "user_id", "api_key_id", "account_id", "group_id", "model", "stream",
+	"input_tokens", "output_tokens", "cache_creation_tokens", "cache_read_tokens",
+	"total_cost", "actual_cost", "rate_multiplier", "duration_ms", "first_token_ms", "request_id",
+}
+
+var usageCommands = map[string]command{
+	"export": {
+		usage: "[-start-date YYYY-MM-DD] [-end-date YYYY-MM-DD] [-user ID] [-api-key ID] [-account ID] [-group ID] [-model M] [-format csv|jsonl] [-out FILE]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("usage export", flag.ContinueOnError)
+			startDate := fs.String("start-date", "", "first day (inclusive)")
+			endDate := fs.String("end-date", "", "last day (inclusive)")
+			user := fs.String("user", "", "filter by user ID")
+			apiKey := fs.String("api-key", "", "filter by API key ID")
+			account := fs.String("account", "", "filter by account ID")
+			group := fs.String("group", "", "filter by group ID")
+			model := fs.String("model", "", "filter by model")
+			timezone := fs.String("timezone", "", "timezone for the date range (IANA name, default server timezone)")
+			format := fs.String("format", "csv", "csv or jsonl")
+			out := fs.String("out", "", "output file (default stdout)")
+			if _, err := a.parseArgs(fs, args); err != nil {
+				return err
+			}
+			if *format != "csv" && *format != "jsonl" {
+				return errUsage
+			}
+			q := url.Values{}
+			setIf(q, "start_date", *startDate)
+			setIf(q, "end_date", *endDate)
+			setIf(q, "user_id", *user)
+			setIf(q, "api_key_id", *apiKey)
+			setIf(q, "account_id", *account)
+			setIf(q, "group_id", *group)
+			setIf(q, "model", *model)
+			setIf(q, "timezone", *timezone)
+
+			w := a.stdout
+			if *out != "" {
+				f, err := os.Create(*out)
+				if err != nil {
+					return err
+				}
+				defer func() { _ = f.Close() }()
+				w = f
+			}
+			n, err := exportUsage(ctx, a.client, q, *format, w)
+			if *out != "" {
+				fmt.Fprintf(a.stderr, "exported %d records to %s\n", n, *out)
+			}
+			return err
+		},
+	},
+}
+
+var configCommands = map[string]command{
+	"list": {
+		usage: "",
+		run: func(_ context.Context, a *app, _ []string) error {
+			names := make([]string, 0, len(a.config.Profiles))
+			for name := range a.config.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			items := make([]any, 0, len(names))
+			for _, name := range names {
+				p := a.config.Profiles[name]
+				items = append(items, map[string]any{
+					"current": name == a.config.Current,
+					"name":    name,
+					"server":  p.Server,
+					"output":  p.Output,
+				})
+			}
+			pr, err := a.printer()
+			if err != nil {
+				return err
+			}
+			return pr.print(items, columns("current", "name", "server", "output"))
+		},
+	},
+	"set": {
+		usage: "NAME [-server URL] [-api-key KEY] [-output table|json]",
+		run: func(_ context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("config set", flag.ContinueOnError)
+			server := fs.String("server", "", "sub2api base URL")
+			apiKey := fs.String("api-key", "", "admin API key")
+			output := fs.String("output", "", "default output format")
+			positional, err := a.parseArgs(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(positional) != 1 {
+				return errUsage
+			}
+			name := positional[0]
+			p := a.config.Profiles[name]
+			if p == nil {
+				p = &profile{}
+				a.config.Profiles[name] = p
+			}
+			fs.Visit(func(f *flag.Flag) {
+				switch f.Name {
+				case "server":
+					p.Server = strings.TrimRight(*server, "/")
+				case "api-key":
+					p.APIKey = *apiKey
+				case "output":
+					p.Output = *output
+				}
+			})
+			if a.config.Current == "" {
+				a.config.Current = name
+			}
+			return a.config.save(a.configPath)
+		},
+	},
+	"use": {
+		usage: "NAME",
+		run: func(_ context.Context, a *app, args []string) error {
+			if len(args) != 1 {
+				return errUsage
+			}
+			if _, ok := a.config.Profiles[args[0]]; !ok {
+				return fmt.Errorf("profile %q not found", args[0])
+			}
+			a.config.Current = args[0]
+			return a.config.save(a.configPath)
+		},
+	},
+	"delete": {
+		usage: "NAME",
+		run: func(_ context.Context, a *app, args []string) error {
+			if len(args) != 1 {
+				return errUsage
+			}
+			if _, ok := a.config.Profiles[args[0]]; !ok {
+				return fmt.Errorf("profile %q not found", args[0])
+			}
+			delete(a.config.Profiles, args[0])
+			if a.config.Current == args[0] {
+				a.config.Current = ""
+			}
+			return a.config.save(a.configPath)
+		},
+	},
+}
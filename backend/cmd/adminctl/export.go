@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// exportUsage 逐页读取使用记录并写出，返回写出的记录数。
+// 导出期间新写入的记录会使分页后移，按 ID 去重避免重复输出。
+func exportUsage(ctx context.Context, c *client, filter url.Values, format string, w io.Writer) (int, error) {
+	var csvWriter *csv.Writer
+	if format == "csv" {
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(usageExportColumns); err != nil {
+			return 0, err
+		}
+	}
+	enc := json.NewEncoder(w)
+
+	seen := map[int64]struct{}{}
+	q := url.Values{}
+	for k, v := range filter {
+		q[k] = v
+	}
+	q.Set("page_size", "1000")
+	for pageNum := 1; ; pageNum++ {
+		q.Set("page", strconv.Itoa(pageNum))
+		var result page
+		if err := c.call(ctx, http.MethodGet, "/usage", q, nil, &result); err != nil {
+			return len(seen), err
+		}
+		for _, item := range result.Items {
+			id := itemID(item)
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			if csvWriter == nil {
+				if err := enc.Encode(item); err != nil {
+					return len(seen), err
+				}
+				continue
+			}
+			record := make([]string, len(usageExportColumns))
+			for i, col := range usageExportColumns {
+				record[i] = csvCell(lookup(item, col))
+			}
+			if err := csvWriter.Write(record); err != nil {
+				return len(seen), err
+			}
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return len(seen), err
+			}
+		}
+		if len(result.Items) == 0 || pageNum >= result.Pages {
+			return len(seen), nil
+		}
+	}
+}
+
+func csvCell(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
@@ -0,0 +1,95 @@
+//go:build unit
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// usagePagesAPI 模拟导出期间有新记录写入：第 2 页开头重复第 1 页的最后一条
+func usagePagesAPI(t *testing.T) *fakeAdminAPI {
+	pages := map[int][]any{
+		1: {
+			map[string]any{"id": 3, "model": "claude-sonnet-4-5", "stream": true, "total_cost": 0.12, "request_id": "r3"},
+			map[string]any{"id": 2, "model": "claude-haiku-4-5", "stream": false, "total_cost": 0.01, "request_id": "r2"},
+		},
+		2: {
+			map[string]any{"id": 2, "model": "claude-haiku-4-5", "stream": false, "total_cost": 0.01, "request_id": "r2"},
+			map[string]any{"id": 1, "model": "gpt-5", "stream": false, "total_cost": 0.5, "request_id": "r1"},
+		},
+	}
+	return newFakeAdminAPI(t, map[string]func(r *http.Request) any{
+		"GET /api/v1/admin/usage": func(r *http.Request) any {
+			pageNum, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			items, ok := pages[pageNum]
+			if !ok {
+				items = []any{}
+			}
+			return map[string]any{"items": items, "total": 4, "page": pageNum, "page_size": 2, "pages": 2}
+		},
+	})
+}
+
+func TestExportUsage_CSVPaginatesAndDedupes(t *testing.T) {
+	api := usagePagesAPI(t)
+	var buf bytes.Buffer
+	n, err := exportUsage(context.Background(), newClient(api.URL, "k"), url.Values{"model": {"claude"}}, "csv", &buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	require.Len(t, api.requests, 2)
+	for i, req := range api.requests {
+		q := req.URL.Query()
+		require.Equal(t, strconv.Itoa(i+1), q.Get("page"))
+		require.Equal(t, "1000", q.Get("page_size"))
+		require.Equal(t, "claude", q.Get("model"))
+		require.Equal(t, "k", req.Header.Get("x-api-key"))
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, usageExportColumns, records[0])
+	col := func(name string) int {
+		for i, c := range usageExportColumns {
+			if c == name {
+				return i
+			}
+		}
+		t.Fatalf("unknown column %s", name)
+		return -1
+	}
+	require.Equal(t, []string{"3", "2", "1"}, []string{records[1][col("id")], records[2][col("id")], records[3][col("id")]})
+	require.Equal(t, "true", records[1][col("stream")])
+	require.Equal(t, "0.12", records[1][col("total_cost")])
+	require.Equal(t, "", records[1][col("account_id")], "missing fields export as empty cells")
+}
+
+func TestExportUsage_JSONL(t *testing.T) {
+	api := usagePagesAPI(t)
+	var buf bytes.Buffer
+	n, err := exportUsage(context.Background(), newClient(api.URL, "k"), nil, "jsonl", &buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.JSONEq(t, `{"id":1,"model":"gpt-5","stream":false,"total_cost":0.5,"request_id":"r1"}`, lines[2])
+}
+
+func TestExportUsage_StopsOnError(t *testing.T) {
+	api := newFakeAdminAPI(t, nil)
+	var buf bytes.Buffer
+	n, err := exportUsage(context.Background(), newClient(api.URL, "k"), nil, "csv", &buf)
+	require.Error(t, err)
+	require.Zero(t, n)
+	require.Contains(t, err.Error(), "HTTP 404")
+}
@@ -0,0 +1,194 @@
+// Command adminctl 是 sub2api 管理 API 的命令行客户端。
+//
+// 用法：
+//
+//	adminctl [全局参数] <资源> <操作> [参数]
+//
+//	adminctl users list -search alice
+//	adminctl users create -email bob@example.com -password secret123 -balance 10
+//	adminctl users update 42 -status disabled
+//	adminctl users balance 42 -add 5 -notes "refund"
+//	adminctl codes generate -count 10 -type balance -value 20
+//	adminctl accounts test 7 -model claude-sonnet-4-5
+//	adminctl accounts schedulable 7 off
+//	adminctl ops tail -since 30m
+//	adminctl usage export -start-date 2026-01-01 -end-date 2026-01-31 -out usage.csv
+//	adminctl config set prod -server https://api.example.com -api-key admin-xxx
+//	adminctl -profile prod accounts list -o json
+//
+// 连接参数优先级：命令行参数 > 环境变量（SUB2API_URL / SUB2API_ADMIN_API_KEY）> 配置档案。
+// 配置档案保存在 $XDG_CONFIG_HOME/sub2api/adminctl.yaml，可通过 -config 或 SUB2API_ADMINCTL_CONFIG 指定。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// command 一个 资源/操作 组合
+type command struct {
+	usage string
+	run   func(ctx context.Context, app *app, args []string) error
+}
+
+// commands 资源 -> 操作 -> 命令
+var commands = map[string]map[string]command{
+	"users":    userCommands,
+	"codes":    codeCommands,
+	"accounts": accountCommands,
+	"ops":      opsCommands,
+	"usage":    usageCommands,
+	"config":   configCommands,
+}
+
+var errUsage = errors.New("usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run 解析全局参数并执行命令，返回进程退出码（参数错误为 2，执行失败为 1）
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("adminctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var (
+		configPath = fs.String("config", envOr("SUB2API_ADMINCTL_CONFIG", defaultConfigPath()), "profile config file (env SUB2API_ADMINCTL_CONFIG)")
+		profile    = fs.String("profile", os.Getenv("SUB2API_PROFILE"), "profile name, defaults to the current profile (env SUB2API_PROFILE)")
+		server     = fs.String("server", os.Getenv("SUB2API_URL"), "sub2api base URL (env SUB2API_URL)")
+		apiKey     = fs.String("api-key", os.Getenv("SUB2API_ADMIN_API_KEY"), "admin API key (env SUB2API_ADMIN_API_KEY)")
+		output     = fs.String("o", "", "output format: table or json (default from profile, else table)")
+	)
+	fs.Usage = func() { printUsage(fs) }
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if fs.NArg() < 2 {
+		printUsage(fs)
+		return 2
+	}
+	resource, action := fs.Arg(0), fs.Arg(1)
+	cmd, ok := commands[resource][action]
+	if !ok {
+		printUsage(fs)
+		return 2
+	}
+
+	fail := func(err error) int {
+		fmt.Fprintf(stderr, "adminctl: %v\n", err)
+		return 1
+	}
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		return fail(err)
+	}
+	a := &app{config: cfg, configPath: *configPath, profileName: *profile, output: *output, stdout: stdout, stderr: stderr}
+	// config 子命令只操作本地文件，不需要连接信息
+	if resource != "config" {
+		if err := a.connect(*server, *apiKey); err != nil {
+			return fail(err)
+		}
+	}
+
+	if err := cmd.run(ctx, a, fs.Args()[2:]); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			if err != errUsage && !errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintln(stderr, err)
+			}
+			fmt.Fprintf(stderr, "Usage: adminctl %s %s %s\n", resource, action, cmd.usage)
+			return 2
+		}
+		return fail(err)
+	}
+	return 0
+}
+
+func printUsage(fs *flag.FlagSet) {
+	out := fs.Output()
+	fmt.Fprintf(out, "Usage: adminctl [flags] <resource> <action> [args]\n\nCommands:\n")
+	resources := make([]string, 0, len(commands))
+	for resource := range commands {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		actions := make([]string, 0, len(commands[resource]))
+		for action := range commands[resource] {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			fmt.Fprintf(out, "  %s %s %s\n", resource, action, commands[resource][action].usage)
+		}
+	}
+	fmt.Fprintf(out, "\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// app 一次命令执行的上下文
+type app struct {
+	config      *config
+	configPath  string
+	profileName string
+	output      string
+	client      *client
+	stdout      io.Writer
+	stderr      io.Writer
+}
+
+// connect 解析连接参数并创建 API 客户端
+func (a *app) connect(server, apiKey string) error {
+	p, err := a.config.profile(a.profileName)
+	if err != nil {
+		return err
+	}
+	if server == "" {
+		server = p.Server
+	}
+	if apiKey == "" {
+		apiKey = p.APIKey
+	}
+	if a.output == "" {
+		a.output = p.Output
+	}
+	if server == "" {
+		server = "http://localhost:8080"
+	}
+	if apiKey == "" {
+		return errors.New("admin API key is required (-api-key, SUB2API_ADMIN_API_KEY or a profile)")
+	}
+	a.client = newClient(server, apiKey)
+	return nil
+}
+
+// printer 按全局 -o 参数创建输出器
+func (a *app) printer() (*printer, error) {
+	switch strings.ToLower(a.output) {
+	case "", outputTable:
+		return &printer{w: a.stdout, format: outputTable}, nil
+	case outputJSON:
+		return &printer{w: a.stdout, format: outputJSON}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q (table or json)", a.output)
+}
@@ -0,0 +1,210 @@
+//go:build unit
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdminAPI 模拟管理 API：记录收到的请求，按路径返回统一响应包装
+type fakeAdminAPI struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*http.Request
+	handlers map[string]func(r *http.Request) any
+}
+
+func newFakeAdminAPI(t *testing.T, handlers map[string]func(r *http.Request) any) *fakeAdminAPI {
+	t.Helper()
+	f := &fakeAdminAPI{handlers: handlers}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.requests = append(f.requests, r)
+		f.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		handler, ok := f.handlers[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"code": 404, "message": "not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": 0, "message": "success", "data": handler(r)})
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeAdminAPI) lastRequest(t *testing.T) *http.Request {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	require.NotEmpty(t, f.requests)
+	return f.requests[len(f.requests)-1]
+}
+
+// runCLI 以隔离的环境变量执行命令，返回退出码与输出
+func runCLI(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	for _, name := range []string{"SUB2API_URL", "SUB2API_ADMIN_API_KEY", "SUB2API_PROFILE"} {
+		t.Setenv(name, "")
+	}
+	if os.Getenv("SUB2API_ADMINCTL_CONFIG") == "" {
+		t.Setenv("SUB2API_ADMINCTL_CONFIG", filepath.Join(t.TempDir(), "adminctl.yaml"))
+	}
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+var testUser = map[string]any{"id": 42, "email": "alice@example.com", "username": "alice", "role": "user", "status": "active", "balance": 12.5, "concurrency": 3}
+
+func TestRun_UsageErrors(t *testing.T) {
+	code, _, stderr := runCLI(t)
+	require.Equal(t, 2, code)
+	require.Contains(t, stderr, "Usage: adminctl [flags] <resource> <action>")
+
+	code, _, _ = runCLI(t, "users", "nope")
+	require.Equal(t, 2, code)
+
+	code, _, _ = runCLI(t, "-unknown-flag", "users", "list")
+	require.Equal(t, 2, code)
+
+	api := newFakeAdminAPI(t, nil)
+	code, _, stderr = runCLI(t, "-server", api.URL, "-api-key", "k", "users", "get")
+	require.Equal(t, 2, code)
+	require.Contains(t, stderr, "Usage: adminctl users get ID")
+
+	code, _, stderr = runCLI(t, "-server", api.URL, "-api-key", "k", "users", "get", "abc")
+	require.Equal(t, 1, code)
+	require.Contains(t, stderr, `invalid id "abc"`)
+
+	// 缺少 API Key 时不发请求
+	code, _, stderr = runCLI(t, "-server", api.URL, "users", "list")
+	require.Equal(t, 1, code)
+	require.Contains(t, stderr, "admin API key is required")
+	require.Empty(t, api.requests)
+}
+
+// TestRun_InterleavedFlags 标志可写在位置参数之后，全局 -o 也可写在操作之后
+func TestRun_InterleavedFlags(t *testing.T) {
+	api := newFakeAdminAPI(t, map[string]func(r *http.Request) any{
+		"GET /api/v1/admin/users/42": func(*http.Request) any { return testUser },
+	})
+
+	code, stdout, stderr := runCLI(t, "-server", api.URL, "-api-key", "admin-key", "users", "get", "42", "-o", "json")
+	require.Equal(t, 0, code, stderr)
+	var out map[string]any
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	require.Equal(t, "alice@example.com", out["email"])
+	require.Equal(t, "admin-key", api.lastRequest(t).Header.Get("x-api-key"))
+}
+
+// TestRun_ConnectionPrecedence 连接参数优先级：命令行参数 > 环境变量 > 配置档案
+func TestRun_ConnectionPrecedence(t *testing.T) {
+	users := func(*http.Request) any {
+		return map[string]any{"items": []any{testUser}, "total": 1, "page": 1, "page_size": 20, "pages": 1}
+	}
+	profileAPI := newFakeAdminAPI(t, map[string]func(r *http.Request) any{"GET /api/v1/admin/users": users})
+	envAPI := newFakeAdminAPI(t, map[string]func(r *http.Request) any{"GET /api/v1/admin/users": users})
+
+	configPath := filepath.Join(t.TempDir(), "adminctl.yaml")
+	cfg := &config{Current: "prod", Profiles: map[string]*profile{
+		"prod": {Server: profileAPI.URL, APIKey: "profile-key", Output: outputJSON},
+	}}
+	require.NoError(t, cfg.save(configPath))
+	t.Setenv("SUB2API_ADMINCTL_CONFIG", configPath)
+
+	// 档案：服务地址、API Key 与默认输出格式
+	code, stdout, stderr := runCLI(t, "users", "list")
+	require.Equal(t, 0, code, stderr)
+	require.Equal(t, "profile-key", profileAPI.lastRequest(t).Header.Get("x-api-key"))
+	require.True(t, strings.HasPrefix(strings.TrimSpace(stdout), "["), "profile output format should be json")
+
+	// 环境变量覆盖档案
+	var out, errOut bytes.Buffer
+	t.Setenv("SUB2API_URL", envAPI.URL)
+	t.Setenv("SUB2API_ADMIN_API_KEY", "env-key")
+	require.Equal(t, 0, run(context.Background(), []string{"users", "list"}, &out, &errOut), errOut.String())
+	require.Equal(t, "env-key", envAPI.lastRequest(t).Header.Get("x-api-key"))
+
+	// 命令行参数覆盖环境变量
+	out.Reset()
+	require.Equal(t, 0, run(context.Background(), []string{"-server", profileAPI.URL, "-api-key", "flag-key", "-o", "table", "users", "list"}, &out, &errOut), errOut.String())
+	require.Equal(t, "flag-key", profileAPI.lastRequest(t).Header.Get("x-api-key"))
+	require.Contains(t, out.String(), "alice@example.com")
+	require.Contains(t, errOut.String(), "page 1/1, 1 total")
+
+	// 指定不存在的档案
+	code, _, stderr = runCLI(t, "-profile", "staging", "users", "list")
+	require.Equal(t, 1, code)
+	require.Contains(t, stderr, `profile "staging" not found`)
+}
+
+// TestRun_ConfigCommands config 子命令只读写本地档案
+func TestRun_ConfigCommands(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "sub2api", "adminctl.yaml")
+	t.Setenv("SUB2API_ADMINCTL_CONFIG", configPath)
+
+	code, _, stderr := runCLI(t, "config", "set", "prod", "-server", "https://api.example.com/", "-api-key", "admin-xxx")
+	require.Equal(t, 0, code, stderr)
+	code, _, stderr = runCLI(t, "config", "set", "staging", "-server", "https://staging.example.com", "-output", "json")
+	require.Equal(t, 0, code, stderr)
+
+	cfg, err := loadConfig(configPath)
+	require.NoError(t, err)
+	require.Equal(t, "prod", cfg.Current, "first profile becomes current")
+	require.Equal(t, "https://api.example.com", cfg.Profiles["prod"].Server)
+	require.Equal(t, "admin-xxx", cfg.Profiles["prod"].APIKey)
+	require.Equal(t, outputJSON, cfg.Profiles["staging"].Output)
+
+	code, _, stderr = runCLI(t, "config", "use", "staging")
+	require.Equal(t, 0, code, stderr)
+	code, stdout, stderr := runCLI(t, "config", "list")
+	require.Equal(t, 0, code, stderr)
+	require.NotContains(t, stdout, "admin-xxx", "API keys must not be printed")
+	require.Contains(t, stdout, "https://staging.example.com")
+
+	code, _, stderr = runCLI(t, "config", "use", "missing")
+	require.Equal(t, 1, code)
+	require.Contains(t, stderr, `profile "missing" not found`)
+
+	code, _, stderr = runCLI(t, "config", "delete", "staging")
+	require.Equal(t, 0, code, stderr)
+	cfg, err = loadConfig(configPath)
+	require.NoError(t, err)
+	require.Empty(t, cfg.Current)
+	require.NotContains(t, cfg.Profiles, "staging")
+}
+
+func TestRun_UsageExportToFile(t *testing.T) {
+	api := newFakeAdminAPI(t, map[string]func(r *http.Request) any{
+		"GET /api/v1/admin/usage": func(*http.Request) any {
+			return map[string]any{"items": []any{map[string]any{"id": 1, "model": "claude-sonnet-4-5"}}, "total": 1, "page": 1, "page_size": 1000, "pages": 1}
+		},
+	})
+	out := filepath.Join(t.TempDir(), "usage.jsonl")
+
+	code, stdout, stderr := runCLI(t, "-server", api.URL, "-api-key", "k", "usage", "export", "-format", "jsonl", "-model", "claude-sonnet-4-5", "-out", out)
+	require.Equal(t, 0, code, stderr)
+	require.Empty(t, stdout)
+	require.Contains(t, stderr, "exported 1 records to "+out)
+	require.Equal(t, "claude-sonnet-4-5", api.lastRequest(t).URL.Query().Get("model"))
+	raw, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":1,"model":"claude-sonnet-4-5"}`, strings.TrimSpace(string(raw)))
+
+	code, _, _ = runCLI(t, "-server", api.URL, "-api-key", "k", "usage", "export", "-format", "xml")
+	require.Equal(t, 2, code)
+}
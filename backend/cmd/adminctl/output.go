@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// column 表格列：path 为以 . 分隔的字段路径，如 "group.name"
+type column struct {
+	header string
+	path   string
+}
+
+func columns(spec ...string) []column {
+	out := make([]column, 0, len(spec))
+	for _, path := range spec {
+		header := path
+		if i := strings.LastIndex(path, "."); i >= 0 {
+			header = path[i+1:]
+		}
+		out = append(out, column{header: strings.ToUpper(header), path: path})
+	}
+	return out
+}
+
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+// print 输出单个对象或列表；表格模式下对象按 字段/值 两列输出
+func (p *printer) print(v any, cols []column) error {
+	if p.format == outputJSON {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	if items, ok := v.([]any); ok {
+		headers := make([]string, len(cols))
+		for i, col := range cols {
+			headers[i] = col.header
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		for _, item := range items {
+			cells := make([]string, len(cols))
+			for i, col := range cols {
+				cells[i] = formatCell(lookup(item, col.path))
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	} else {
+		for _, col := range cols {
+			fmt.Fprintf(tw, "%s\t%s\n", col.header, formatCell(lookup(v, col.path)))
+		}
+	}
+	return tw.Flush()
+}
+
+// lookup 按字段路径取值，缺失时返回 nil
+func lookup(v any, path string) any {
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+func formatCell(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		if value == "" {
+			return "-"
+		}
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return t.Local().Format("2006-01-02 15:04:05")
+		}
+		return strings.ReplaceAll(value, "\n", " ")
+	case json.Number:
+		return value.String()
+	case bool:
+		if value {
+			return "yes"
+		}
+		return "no"
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
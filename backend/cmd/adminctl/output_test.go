@@ -0,0 +1,63 @@
+//go:build unit
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTestItems(t *testing.T, raw string) any {
+	t.Helper()
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	require.NoError(t, dec.Decode(&v))
+	return v
+}
+
+func TestPrinter_TableList(t *testing.T) {
+	items := decodeTestItems(t, `[
+		{"id": 1, "name": "main", "group": {"name": "pro"}, "schedulable": true, "error_message": "line1\nline2"},
+		{"id": 12, "name": "", "schedulable": false, "tags": ["a", "b"]}
+	]`)
+	var buf bytes.Buffer
+	p := &printer{w: &buf, format: outputTable}
+	require.NoError(t, p.print(items, columns("id", "name", "group.name", "schedulable", "error_message", "tags")))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []string{"ID", "NAME", "NAME", "SCHEDULABLE", "ERROR_MESSAGE", "TAGS"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"1", "main", "pro", "yes", "line1", "line2", "-"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"12", "-", "-", "no", "-", `["a","b"]`}, strings.Fields(lines[2]))
+	// 列按最宽单元格对齐
+	require.Equal(t, strings.Index(lines[0], "NAME"), strings.Index(lines[1], "main"))
+}
+
+func TestPrinter_TableObject(t *testing.T) {
+	obj := decodeTestItems(t, `{"id": 42, "email": "alice@example.com", "balance": 12.50}`)
+	var buf bytes.Buffer
+	require.NoError(t, (&printer{w: &buf, format: outputTable}).print(obj, columns("id", "email", "balance", "notes")))
+	require.Equal(t, "ID       42\nEMAIL    alice@example.com\nBALANCE  12.50\nNOTES    -\n", buf.String())
+}
+
+func TestPrinter_JSON(t *testing.T) {
+	obj := decodeTestItems(t, `{"id": 9007199254740993, "balance": 12.50}`)
+	var buf bytes.Buffer
+	require.NoError(t, (&printer{w: &buf, format: outputJSON}).print(obj, nil))
+	require.Equal(t, "{\n  \"balance\": 12.50,\n  \"id\": 9007199254740993\n}\n", buf.String())
+}
+
+func TestApp_PrinterFormat(t *testing.T) {
+	for output, want := range map[string]string{"": outputTable, "table": outputTable, "JSON": outputJSON} {
+		p, err := (&app{output: output}).printer()
+		require.NoError(t, err)
+		require.Equal(t, want, p.format)
+	}
+	_, err := (&app{output: "yaml"}).printer()
+	require.EqualError(t, err, `unknown output format "yaml" (table or json)`)
+}
@@ -0,0 +1,59 @@
+//go:build unit
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_LoadSaveAndSelect(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "adminctl.yaml")
+
+	// 文件不存在时返回空配置
+	cfg, err := loadConfig(path)
+	require.NoError(t, err)
+	require.Empty(t, cfg.Profiles)
+	p, err := cfg.profile("")
+	require.NoError(t, err)
+	require.Equal(t, &profile{}, p)
+
+	cfg.Profiles["prod"] = &profile{Server: "https://api.example.com", APIKey: "admin-xxx", Output: outputJSON}
+	cfg.Profiles["dev"] = &profile{Server: "http://localhost:8080"}
+	cfg.Current = "prod"
+	require.NoError(t, cfg.save(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "profile file holds API keys")
+
+	loaded, err := loadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, cfg, loaded)
+
+	p, err = loaded.profile("")
+	require.NoError(t, err)
+	require.Equal(t, "admin-xxx", p.APIKey)
+	p, err = loaded.profile("dev")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080", p.Server)
+	_, err = loaded.profile("missing")
+	require.EqualError(t, err, `profile "missing" not found`)
+}
+
+func TestConfig_LoadInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "adminctl.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("profiles: [not a map"), 0o600))
+	_, err := loadConfig(path)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), path)
+
+	// 空文件得到可写入的空配置
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	cfg, err := loadConfig(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Profiles)
+}
@@ -0,0 +1,290 @@
+// Command configsync 将声明式配置文件（YAML / JSON）同步到 sub2api 实例。
+//
+// 用法：
+//
+//	configsync -f sub2api.yaml plan            # 显示差异
+//	configsync -f sub2api.yaml apply           # 在单个事务中执行变更
+//	configsync -f sub2api.yaml -watch apply    # 持续同步（GitOps），文件变化或定时漂移检查时执行
+//	configsync export > sub2api.yaml           # 导出当前配置（不含凭证）
+//
+// 文件中的 ${ENV_NAME} 引用在本地解析后再提交，服务端不会读取本机环境变量。
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"gopkg.in/yaml.v3"
+)
+
+type options struct {
+	server   string
+	apiKey   string
+	file     string
+	prune    bool
+	watch    bool
+	interval time.Duration
+}
+
+type apiResponse struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Reason  string          `json:"reason"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func main() {
+	opts := options{}
+	flag.StringVar(&opts.server, "server", envOr("SUB2API_URL", "http://localhost:8080"), "sub2api base URL (env SUB2API_URL)")
+	flag.StringVar(&opts.apiKey, "api-key", os.Getenv("SUB2API_ADMIN_API_KEY"), "admin API key (env SUB2API_ADMIN_API_KEY)")
+	flag.StringVar(&opts.file, "f", "sub2api.yaml", "desired-state file (YAML or JSON)")
+	flag.BoolVar(&opts.prune, "prune", false, "delete routing rules, error passthrough rules and price overrides not declared in the file")
+	flag.BoolVar(&opts.watch, "watch", false, "keep running and apply whenever the file changes or the server drifts")
+	flag.DurationVar(&opts.interval, "interval", time.Minute, "drift check interval in watch mode")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] plan|apply|export\n\nFlags:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if opts.apiKey == "" {
+		log.Fatal("admin API key is required (-api-key or SUB2API_ADMIN_API_KEY)")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client := &syncClient{opts: opts, http: &http.Client{Timeout: 2 * time.Minute}}
+	var err error
+	switch cmd := flag.Arg(0); cmd {
+	case "plan":
+		var plan *service.ConfigSyncPlan
+		if plan, err = client.run(ctx, "plan"); err == nil && len(plan.Errors) > 0 {
+			err = errors.New("plan has validation errors")
+		}
+	case "apply":
+		if opts.watch {
+			err = client.watch(ctx)
+		} else {
+			_, err = client.apply(ctx)
+		}
+	case "export":
+		err = client.export(ctx, os.Stdout)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
+
+type syncClient struct {
+	opts options
+	http *http.Client
+}
+
+// loadDocument 读取并校验配置文件，解析环境变量引用后编码为 JSON
+func (c *syncClient) loadDocument() ([]byte, []byte, error) {
+	raw, err := os.ReadFile(c.opts.file)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := service.ParseConfigSyncDocument(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", c.opts.file, err)
+	}
+	if err := doc.ExpandEnv(os.LookupEnv); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", c.opts.file, err)
+	}
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(body)
+	return body, sum[:], nil
+}
+
+// run 提交配置文件到 plan 或 apply 接口并打印结果
+func (c *syncClient) run(ctx context.Context, action string) (*service.ConfigSyncPlan, error) {
+	body, _, err := c.loadDocument()
+	if err != nil {
+		return nil, err
+	}
+	return c.submit(ctx, action, body)
+}
+
+func (c *syncClient) submit(ctx context.Context, action string, body []byte) (*service.ConfigSyncPlan, error) {
+	query := url.Values{}
+	if c.opts.prune {
+		query.Set("prune", "true")
+	}
+	var plan service.ConfigSyncPlan
+	if err := c.call(ctx, http.MethodPost, "/api/v1/admin/config-sync/"+action+"?"+query.Encode(), body, &plan); err != nil {
+		return nil, err
+	}
+	printPlan(os.Stdout, &plan)
+	return &plan, nil
+}
+
+// apply 先计划再执行，没有变更时不发起写请求
+func (c *syncClient) apply(ctx context.Context) (*service.ConfigSyncPlan, error) {
+	body, _, err := c.loadDocument()
+	if err != nil {
+		return nil, err
+	}
+	return c.applyBody(ctx, body)
+}
+
+func (c *syncClient) applyBody(ctx context.Context, body []byte) (*service.ConfigSyncPlan, error) {
+	plan, err := c.submit(ctx, "plan", body)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.Errors) > 0 {
+		return plan, errors.New("plan has validation errors, nothing applied")
+	}
+	if !plan.HasChanges() {
+		return plan, nil
+	}
+	plan, err = c.submit(ctx, "apply", body)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Applied {
+		return plan, errors.New("apply was rejected, nothing applied")
+	}
+	return plan, nil
+}
+
+// watch 持续同步：文件内容变化时立即执行，否则按 interval 检查服务端漂移
+func (c *syncClient) watch(ctx context.Context) error {
+	const pollInterval = 2 * time.Second
+	var lastSum []byte
+	var lastSync time.Time
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		body, sum, err := c.loadDocument()
+		switch {
+		case err != nil:
+			log.Printf("watch: %v", err)
+		case !bytes.Equal(sum, lastSum) || time.Since(lastSync) >= c.opts.interval:
+			if _, err := c.applyBody(ctx, body); err != nil {
+				log.Printf("watch: %v", err)
+			}
+			// 失败时同样记录，避免每个轮询周期重复提交同一份错误配置
+			lastSum, lastSync = sum, time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// export 导出当前配置为 YAML
+func (c *syncClient) export(ctx context.Context, w io.Writer) error {
+	var doc service.ConfigSyncDocument
+	if err := c.call(ctx, http.MethodGet, "/api/v1/admin/config-sync/export", nil, &doc); err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func (c *syncClient) call(ctx context.Context, method, path string, body []byte, out any) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(c.opts.server, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-api-key", c.opts.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var envelope apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("%s %s: HTTP %d: invalid response: %w", method, path, resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || envelope.Code != 0 {
+		if envelope.Reason != "" {
+			return fmt.Errorf("%s %s: HTTP %d: %s (%s)", method, path, resp.StatusCode, envelope.Message, envelope.Reason)
+		}
+		return fmt.Errorf("%s %s: HTTP %d: %s", method, path, resp.StatusCode, envelope.Message)
+	}
+	return json.Unmarshal(envelope.Data, out)
+}
+
+// printPlan 打印计划：+ 新建，~ 更新，- 删除
+func printPlan(w io.Writer, plan *service.ConfigSyncPlan) {
+	for _, msg := range plan.Errors {
+		fmt.Fprintf(w, "! %s\n", msg)
+	}
+	for _, change := range plan.Changes {
+		symbol := "~"
+		switch change.Action {
+		case service.ConfigSyncActionCreate:
+			symbol = "+"
+		case service.ConfigSyncActionDelete:
+			symbol = "-"
+		}
+		line := fmt.Sprintf("%s %s %s", symbol, change.Kind, change.Name)
+		if len(change.Fields) > 0 {
+			line += " (" + strings.Join(change.Fields, ", ") + ")"
+		}
+		fmt.Fprintln(w, line)
+	}
+	status := "planned"
+	if plan.Applied {
+		status = "applied"
+	}
+	if !plan.HasChanges() && len(plan.Errors) == 0 {
+		fmt.Fprintln(w, "No changes. The server matches the file.")
+		return
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d to delete (%s)\n", plan.Created, plan.Updated, plan.Deleted, status)
+}
@@ -0,0 +1,104 @@
+// Command openapigen 生成 OpenAPI 文档与 Go 客户端。
+//
+// 用法（在 backend 目录下）：
+//
+//	go generate ./internal/server/routes
+//	go run ./cmd/openapigen [-dir .] [-check]
+//
+// 分析路由注册与 handler 源码，写入 internal/server/routes/openapi.json（由服务在
+// /api/v1/openapi.json 提供）与 pkg/apiclient/client_gen.go。-check 只比较不写入，
+// 生成结果与仓库不一致时以非零状态退出，适用于 CI。
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/openapi"
+)
+
+func main() {
+	dir := flag.String("dir", "", "backend module root (default: nearest directory containing go.mod)")
+	check := flag.Bool("check", false, "report stale files instead of writing them")
+	flag.Parse()
+	log.SetFlags(0)
+
+	root := *dir
+	if root == "" {
+		var err error
+		if root, err = moduleRoot(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	doc, err := openapi.Generate(root)
+	if err != nil {
+		log.Fatalf("generate openapi: %v", err)
+	}
+	spec, err := doc.Marshal()
+	if err != nil {
+		log.Fatalf("marshal openapi: %v", err)
+	}
+	client, err := openapi.GenerateClient(doc, openapi.ClientPackage)
+	if err != nil {
+		log.Fatalf("generate client: %v", err)
+	}
+
+	stale := false
+	for _, out := range []struct {
+		path string
+		data []byte
+	}{
+		{openapi.SpecFile, spec},
+		{openapi.ClientFile, client},
+	} {
+		target := filepath.Join(root, filepath.FromSlash(out.path))
+		current, err := os.ReadFile(target)
+		if err == nil && bytes.Equal(current, out.data) {
+			continue
+		}
+		if *check {
+			fmt.Fprintf(os.Stderr, "%s is out of date\n", out.path)
+			stale = true
+			continue
+		}
+		if err := os.WriteFile(target, out.data, 0o644); err != nil {
+			log.Fatalf("write %s: %v", out.path, err)
+		}
+		fmt.Printf("wrote %s\n", out.path)
+	}
+	if stale {
+		fmt.Fprintln(os.Stderr, "run `go generate ./internal/server/routes` to update")
+		os.Exit(1)
+	}
+	fmt.Printf("%d operations\n", countOperations(doc))
+}
+
+// moduleRoot 从当前目录向上查找 go.mod（go generate 在 routes 包目录下运行）
+func moduleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+func countOperations(doc *openapi.Document) int {
+	n := 0
+	doc.Operations(func(string, string, *openapi.Operation) { n++ })
+	return n
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/repository"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// backupPassphraseEnv 归档口令环境变量（避免口令出现在命令行与进程列表中）
+const backupPassphraseEnv = "BACKUP_PASSPHRASE"
+
+type backupCommandOptions struct {
+	backupFile    string
+	restoreFile   string
+	skipUsageLogs bool
+	usageFrom     string
+	usageTo       string
+	force         bool
+}
+
+// runBackupCommand 执行 -backup / -restore 命令：直接连接数据库，不启动 HTTP 服务。
+// 恢复前会按 config.yaml 执行迁移，因此可以指向一个全新的空数据库。
+func runBackupCommand(opts backupCommandOptions) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	client, sqlDB, err := repository.InitEnt(cfg)
+	if err != nil {
+		return fmt.Errorf("init database: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	svc := service.NewBackupService(repository.NewBackupRepository(sqlDB), client, service.BuildInfo{Version: Version, BuildType: BuildType})
+	passphrase := os.Getenv(backupPassphraseEnv)
+	ctx := context.Background()
+
+	if opts.restoreFile != "" {
+		f, err := os.Open(opts.restoreFile)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+
+		result, err := svc.Restore(ctx, f, service.RestoreOptions{Passphrase: passphrase, Force: opts.force})
+		if err != nil {
+			return err
+		}
+		for _, table := range result.Tables {
+			log.Printf("restored %-28s %8d rows (%d merged)", table.Table, table.Restored+table.Merged, table.Merged)
+		}
+		log.Printf("Restore complete (backup created %s, app %s). Flush Redis and restart the service before serving traffic.",
+			result.Manifest.CreatedAt.Format(time.RFC3339), result.Manifest.AppVersion)
+		return nil
+	}
+
+	backupOpts := service.BackupOptions{Passphrase: passphrase, SkipUsageLogs: opts.skipUsageLogs}
+	if backupOpts.UsageFrom, err = parseBackupDate(opts.usageFrom); err != nil {
+		return fmt.Errorf("invalid -usage-from: %w", err)
+	}
+	if backupOpts.UsageTo, err = parseBackupDate(opts.usageTo); err != nil {
+		return fmt.Errorf("invalid -usage-to: %w", err)
+	}
+
+	// 先写临时文件，成功后再重命名，失败时不会留下不完整的归档
+	tmp, err := os.CreateTemp(filepath.Dir(opts.backupFile), ".sub2api-backup-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	manifest, err := svc.Backup(ctx, tmp, backupOpts)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), opts.backupFile); err != nil {
+		return err
+	}
+	log.Printf("Backup written to %s (schema %s, %d tables, encrypted=%t)", opts.backupFile, manifest.SchemaVersion, len(manifest.Tables), manifest.Encrypted)
+	return nil
+}
+
+func parseBackupDate(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse("2006-01-02", raw); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
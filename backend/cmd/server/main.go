@@ -19,6 +19,7 @@ import (
 	_ "github.com/Wei-Shaw/sub2api/ent/runtime"
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/setup"
 	"github.com/Wei-Shaw/sub2api/internal/web"
@@ -67,6 +68,13 @@ func main() {
 	// Parse command line flags
 	setupMode := flag.Bool("setup", false, "Run setup wizard in CLI mode")
 	showVersion := flag.Bool("version", false, "Show version information")
+	backupOpts := backupCommandOptions{}
+	flag.StringVar(&backupOpts.backupFile, "backup", "", "Write a full backup archive to the given file and exit (passphrase from "+backupPassphraseEnv+")")
+	flag.StringVar(&backupOpts.restoreFile, "restore", "", "Restore a backup archive from the given file and exit (passphrase from "+backupPassphraseEnv+")")
+	flag.BoolVar(&backupOpts.skipUsageLogs, "skip-usage-logs", false, "Backup: exclude usage logs")
+	flag.StringVar(&backupOpts.usageFrom, "usage-from", "", "Backup: include usage logs created at or after this date (YYYY-MM-DD or RFC3339)")
+	flag.StringVar(&backupOpts.usageTo, "usage-to", "", "Backup: include usage logs created before this date (YYYY-MM-DD or RFC3339)")
+	flag.BoolVar(&backupOpts.force, "force", false, "Restore: proceed even if the target database already contains data")
 	flag.Parse()
 
 	if *showVersion {
@@ -74,6 +82,17 @@ func main() {
 		return
 	}
 
+	// 备份 / 恢复模式
+	if backupOpts.backupFile != "" || backupOpts.restoreFile != "" {
+		if backupOpts.backupFile != "" && backupOpts.restoreFile != "" {
+			log.Fatal("-backup and -restore cannot be used together")
+		}
+		if err := runBackupCommand(backupOpts); err != nil {
+			log.Fatalf("Backup command failed: %v", err)
+		}
+		return
+	}
+
 	// CLI setup mode
 	if *setupMode {
 		if err := setup.RunCLI(); err != nil {
@@ -136,6 +155,19 @@ func runMainServer() {
 		log.Println("⚠️  WARNING: Running in SIMPLE mode - billing and quota checks are DISABLED")
 	}
 
+	// 链路追踪需在初始化数据库/Redis 客户端之前启用，以便挂载埋点
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing, Version)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Printf("Failed to flush traces: %v", err)
+		}
+	}()
+
 	buildInfo := handler.BuildInfo{
 		Version:   Version,
 		BuildType: BuildType,
@@ -161,14 +193,36 @@ func runMainServer() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	gracefulShutdown(app, cfg)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	log.Println("Server exited")
+}
+
+// gracefulShutdown 先进入 draining 状态让负载均衡摘除实例，再等待进行中的请求（含流式响应）完成，
+// 超过 shutdown_drain_timeout 后强制关闭连接。后台任务由 app.Cleanup 在之后按顺序停止。
+func gracefulShutdown(app *Application, cfg *config.Config) {
+	app.Health.StartDraining()
+	log.Printf("Draining: readiness now failing, %d gateway requests in flight", app.Health.InFlight())
 
-	if err := app.Server.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+	if delay := time.Duration(cfg.Server.ShutdownDrainDelay) * time.Second; delay > 0 {
+		time.Sleep(delay)
 	}
 
-	log.Println("Server exited")
+	timeout := time.Duration(cfg.Server.ShutdownDrainTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	log.Printf("Shutting down server (waiting up to %s for in-flight requests)...", timeout)
+	// Shutdown 停止监听并等待普通连接空闲；h2c 连接被 Hijack 不受其跟踪，需额外等待网关请求计数归零
+	err := app.Server.Shutdown(ctx)
+	if err == nil {
+		err = app.Health.WaitDrained(ctx)
+	}
+	if err != nil {
+		log.Printf("Drain deadline exceeded, %d gateway requests still in flight, forcing close: %v", app.Health.InFlight(), err)
+		_ = app.Server.Close()
+	}
 }
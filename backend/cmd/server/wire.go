@@ -23,6 +23,7 @@ import (
 
 type Application struct {
 	Server  *http.Server
+	Health  *service.HealthService
 	Cleanup func()
 }
 
@@ -47,7 +48,7 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 		provideCleanup,
 
 		// Application struct
-		wire.Struct(new(Application), "Server", "Cleanup"),
+		wire.Struct(new(Application), "Server", "Health", "Cleanup"),
 	)
 	return nil, nil
 }
@@ -62,6 +63,8 @@ func provideServiceBuildInfo(buildInfo handler.BuildInfo) service.BuildInfo {
 func provideCleanup(
 	entClient *ent.Client,
 	rdb *redis.Client,
+	cluster *service.ClusterService,
+	configReload *service.ConfigReloadService,
 	opsMetricsCollector *service.OpsMetricsCollector,
 	opsAggregation *service.OpsAggregationService,
 	opsAlertEvaluator *service.OpsAlertEvaluatorService,
@@ -72,6 +75,8 @@ func provideCleanup(
 	accountExpiry *service.AccountExpiryService,
 	subscriptionExpiry *service.SubscriptionExpiryService,
 	usageCleanup *service.UsageCleanupService,
+	usageBilling *service.UsageBillingService,
+	accountQuota *service.AccountQuotaService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
 	billingCache *service.BillingCacheService,
@@ -89,6 +94,14 @@ func provideCleanup(
 			name string
 			fn   func() error
 		}{
+			{"ClusterService", func() error {
+				cluster.Stop()
+				return nil
+			}},
+			{"ConfigReloadService", func() error {
+				configReload.Stop()
+				return nil
+			}},
 			{"OpsScheduledReportService", func() error {
 				if opsScheduledReport != nil {
 					opsScheduledReport.Stop()
@@ -131,6 +144,14 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"UsageBillingService", func() error {
+				usageBilling.Stop()
+				return nil
+			}},
+			{"AccountQuotaService", func() error {
+				accountQuota.Stop()
+				return nil
+			}},
 			{"TokenRefreshService", func() error {
 				tokenRefresh.Stop()
 				return nil
@@ -56,7 +56,16 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	promoCodeRepository := repository.NewPromoCodeRepository(client)
 	billingCache := repository.NewBillingCache(redisClient)
 	userSubscriptionRepository := repository.NewUserSubscriptionRepository(client)
-	billingCacheService := service.NewBillingCacheService(billingCache, userRepository, userSubscriptionRepository, configConfig)
+	pricingRemoteClient := repository.ProvidePricingRemoteClient(configConfig)
+	pricingService, err := service.ProvidePricingService(configConfig, pricingRemoteClient)
+	if err != nil {
+		return nil, err
+	}
+	modelPriceRepository := repository.NewModelPriceRepository(client)
+	modelPriceCache := repository.NewModelPriceCache(redisClient)
+	modelPriceService := service.NewModelPriceService(modelPriceRepository, modelPriceCache)
+	billingService := service.NewBillingService(configConfig, pricingService, modelPriceService)
+	billingCacheService := service.NewBillingCacheService(billingCache, userRepository, userSubscriptionRepository, billingService, configConfig)
 	apiKeyRepository := repository.NewAPIKeyRepository(client)
 	groupRepository := repository.NewGroupRepository(client, db)
 	userGroupRateRepository := repository.NewUserGroupRateRepository(db)
@@ -101,9 +110,17 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	proxyRepository := repository.NewProxyRepository(client, db)
 	proxyExitInfoProber := repository.NewProxyExitInfoProber(configConfig)
 	proxyLatencyCache := repository.NewProxyLatencyCache(redisClient)
-	adminService := service.NewAdminService(userRepository, groupRepository, accountRepository, proxyRepository, apiKeyRepository, redeemCodeRepository, userGroupRateRepository, billingCacheService, proxyExitInfoProber, proxyLatencyCache, apiKeyAuthCacheInvalidator)
+	routingRuleRepository := repository.NewRoutingRuleRepository(client)
+	routingRuleCache := repository.NewRoutingRuleCache(redisClient)
+	routingRuleService := service.NewRoutingRuleService(routingRuleRepository, routingRuleCache, groupRepository)
+	clientRuleRepository := repository.NewClientRuleRepository(client)
+	clientRuleCache := repository.NewClientRuleCache(redisClient)
+	clientRuleService := service.NewClientRuleService(clientRuleRepository, clientRuleCache, groupRepository)
+	adminService := service.NewAdminService(userRepository, groupRepository, accountRepository, proxyRepository, apiKeyRepository, redeemCodeRepository, userGroupRateRepository, billingCacheService, proxyExitInfoProber, proxyLatencyCache, apiKeyAuthCacheInvalidator, routingRuleService, clientRuleService)
 	concurrencyCache := repository.ProvideConcurrencyCache(redisClient, configConfig)
-	concurrencyService := service.ProvideConcurrencyService(concurrencyCache, accountRepository, configConfig)
+	fairQueueCache := repository.NewFairQueueCache(redisClient)
+	fairQueueService := service.NewFairQueueService(fairQueueCache, configConfig)
+	concurrencyService := service.ProvideConcurrencyService(concurrencyCache, accountRepository, fairQueueService, configConfig)
 	adminUserHandler := admin.NewUserHandler(adminService, concurrencyService)
 	groupHandler := admin.NewGroupHandler(adminService)
 	claudeOAuthClient := repository.NewClaudeOAuthClient()
@@ -146,20 +163,19 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	adminRedeemHandler := admin.NewRedeemHandler(adminService)
 	promoHandler := admin.NewPromoHandler(promoService)
 	opsRepository := repository.NewOpsRepository(db)
-	pricingRemoteClient := repository.ProvidePricingRemoteClient(configConfig)
-	pricingService, err := service.ProvidePricingService(configConfig, pricingRemoteClient)
-	if err != nil {
-		return nil, err
-	}
-	billingService := service.NewBillingService(configConfig, pricingService)
 	identityService := service.NewIdentityService(identityCache)
 	deferredService := service.ProvideDeferredService(accountRepository, timingWheelService)
 	claudeTokenProvider := service.NewClaudeTokenProvider(accountRepository, geminiTokenCache, oAuthService)
 	digestSessionStore := service.NewDigestSessionStore()
-	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, userGroupRateRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache, digestSessionStore)
-	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider)
-	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig)
-	opsService := service.NewOpsService(opsRepository, settingRepository, configConfig, accountRepository, userRepository, concurrencyService, gatewayService, openAIGatewayService, geminiMessagesCompatService, antigravityGatewayService)
+	usageBillingRepository := repository.NewUsageBillingRepository(client, db)
+	usageOutbox := repository.NewUsageOutbox(redisClient)
+	usageBillingService := service.ProvideUsageBillingService(client, usageBillingRepository, userRepository, userSubscriptionRepository, apiKeyService, billingCacheService, usageOutbox, configConfig)
+	accountQuotaService := service.ProvideAccountQuotaService(accountRepository, accountUsageService, schedulerCache, configConfig)
+	accountScheduler := service.NewAccountScheduler(configConfig, accountQuotaService)
+	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, userGroupRateRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache, digestSessionStore, usageBillingService, accountQuotaService, accountScheduler)
+	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider, usageBillingService, accountQuotaService, accountScheduler)
+	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig, accountScheduler)
+	opsService := service.NewOpsService(opsRepository, settingRepository, configConfig, accountRepository, userRepository, concurrencyService, gatewayService, openAIGatewayService, geminiMessagesCompatService, antigravityGatewayService, usageBillingService, fairQueueService)
 	settingHandler := admin.NewSettingHandler(settingService, emailService, turnstileService, opsService)
 	opsHandler := admin.NewOpsHandler(opsService)
 	updateCache := repository.NewUpdateCache(redisClient)
@@ -179,16 +195,43 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	errorPassthroughCache := repository.NewErrorPassthroughCache(redisClient)
 	errorPassthroughService := service.NewErrorPassthroughService(errorPassthroughRepository, errorPassthroughCache)
 	errorPassthroughHandler := admin.NewErrorPassthroughHandler(errorPassthroughService)
-	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, adminAnnouncementHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler, errorPassthroughHandler)
-	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, userService, concurrencyService, billingCacheService, usageService, apiKeyService, errorPassthroughService, configConfig)
-	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, apiKeyService, errorPassthroughService, configConfig)
+	modelPriceHandler := admin.NewModelPriceHandler(modelPriceService)
+	requestPolicyRepository := repository.NewRequestPolicyRepository(client)
+	requestPolicyCache := repository.NewRequestPolicyCache(redisClient)
+	requestPolicyService := service.NewRequestPolicyService(requestPolicyRepository, requestPolicyCache)
+	requestPolicyHandler := admin.NewRequestPolicyHandler(requestPolicyService)
+	guardrailRuleRepository := repository.NewGuardrailRuleRepository(client)
+	guardrailRuleCache := repository.NewGuardrailRuleCache(redisClient)
+	guardrailService := service.NewGuardrailService(guardrailRuleRepository, guardrailRuleCache)
+	guardrailHandler := admin.NewGuardrailHandler(guardrailService)
+	clientRuleHandler := admin.NewClientRuleHandler(clientRuleService)
+	routingRuleHandler := admin.NewRoutingRuleHandler(routingRuleService)
+	clusterNodeCache := repository.NewClusterNodeCache(redisClient)
+	healthService := service.NewHealthService(db, redisClient, schedulerSnapshotService, pricingService, configConfig)
+	clusterService := service.ProvideClusterService(clusterNodeCache, healthService, configConfig, serviceBuildInfo)
+	clusterHandler := admin.NewClusterHandler(clusterService)
+	configSyncService := service.NewConfigSyncService(client, groupRepository, accountRepository, proxyRepository, settingRepository, errorPassthroughRepository, routingRuleRepository, modelPriceRepository, settingService, errorPassthroughService, routingRuleService, modelPriceService, apiKeyAuthCacheInvalidator)
+	configSyncHandler := admin.NewConfigSyncHandler(configSyncService)
+	backupRepository := repository.NewBackupRepository(db)
+	backupService := service.NewBackupService(backupRepository, client, serviceBuildInfo)
+	backupHandler := admin.NewBackupHandler(backupService)
+	oneAPIImportService := service.NewOneAPIImportService(accountRepository, groupRepository, userRepository, apiKeyRepository, redeemCodeRepository, settingService)
+	oneAPIImportHandler := admin.NewOneAPIImportHandler(oneAPIImportService)
+	compatibleGatewayService := service.NewCompatibleGatewayService(rateLimitService, httpUpstream, configConfig)
+	configReloadService := service.ProvideConfigReloadService(configConfig, fairQueueService, accountQuotaService, accountScheduler, gatewayService, openAIGatewayService, antigravityGatewayService, geminiMessagesCompatService, compatibleGatewayService, schedulerSnapshotService, rateLimitService, pricingService)
+	configReloadHandler := admin.NewConfigReloadHandler(configReloadService)
+	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, adminAnnouncementHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler, errorPassthroughHandler, modelPriceHandler, requestPolicyHandler, guardrailHandler, clientRuleHandler, routingRuleHandler, clusterHandler, configSyncHandler, backupHandler, oneAPIImportHandler, configReloadHandler)
+	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, compatibleGatewayService, userService, concurrencyService, fairQueueService, billingCacheService, usageService, apiKeyService, errorPassthroughService, requestPolicyService, guardrailService, clientRuleService, routingRuleService, configConfig)
+	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, fairQueueService, billingCacheService, apiKeyService, errorPassthroughService, requestPolicyService, guardrailService, clientRuleService, routingRuleService, configConfig)
+	compatibleGatewayHandler := handler.NewCompatibleGatewayHandler(gatewayService, compatibleGatewayService, concurrencyService, fairQueueService, billingCacheService, apiKeyService, errorPassthroughService, requestPolicyService, guardrailService, clientRuleService, routingRuleService, configConfig)
 	handlerSettingHandler := handler.ProvideSettingHandler(settingService, buildInfo)
 	totpHandler := handler.NewTotpHandler(totpService)
-	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, announcementHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, handlerSettingHandler, totpHandler)
+	healthHandler := handler.NewHealthHandler(healthService)
+	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, announcementHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, compatibleGatewayHandler, handlerSettingHandler, totpHandler, healthHandler)
 	jwtAuthMiddleware := middleware.NewJWTAuthMiddleware(authService, userService)
 	adminAuthMiddleware := middleware.NewAdminAuthMiddleware(authService, userService, settingService)
 	apiKeyAuthMiddleware := middleware.NewAPIKeyAuthMiddleware(apiKeyService, subscriptionService, configConfig)
-	engine := server.ProvideRouter(configConfig, handlers, jwtAuthMiddleware, adminAuthMiddleware, apiKeyAuthMiddleware, apiKeyService, subscriptionService, opsService, settingService, redisClient)
+	engine := server.ProvideRouter(configConfig, handlers, jwtAuthMiddleware, adminAuthMiddleware, apiKeyAuthMiddleware, apiKeyService, subscriptionService, opsService, settingService, healthService, redisClient)
 	httpServer := server.ProvideHTTPServer(configConfig, engine)
 	opsMetricsCollector := service.ProvideOpsMetricsCollector(opsRepository, settingRepository, accountRepository, concurrencyService, db, redisClient, configConfig)
 	opsAggregationService := service.ProvideOpsAggregationService(opsRepository, settingRepository, db, redisClient, configConfig)
@@ -198,9 +241,10 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	tokenRefreshService := service.ProvideTokenRefreshService(accountRepository, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, compositeTokenCacheInvalidator, schedulerCache, configConfig)
 	accountExpiryService := service.ProvideAccountExpiryService(accountRepository)
 	subscriptionExpiryService := service.ProvideSubscriptionExpiryService(userSubscriptionRepository)
-	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, subscriptionExpiryService, usageCleanupService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService)
+	v := provideCleanup(client, redisClient, clusterService, configReloadService, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, subscriptionExpiryService, usageCleanupService, usageBillingService, accountQuotaService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService)
 	application := &Application{
 		Server:  httpServer,
+		Health:  healthService,
 		Cleanup: v,
 	}
 	return application, nil
@@ -210,6 +254,7 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 
 type Application struct {
 	Server  *http.Server
+	Health  *service.HealthService
 	Cleanup func()
 }
 
@@ -223,6 +268,8 @@ func provideServiceBuildInfo(buildInfo handler.BuildInfo) service.BuildInfo {
 func provideCleanup(
 	entClient *ent.Client,
 	rdb *redis.Client,
+	cluster *service.ClusterService,
+	configReload *service.ConfigReloadService,
 	opsMetricsCollector *service.OpsMetricsCollector,
 	opsAggregation *service.OpsAggregationService,
 	opsAlertEvaluator *service.OpsAlertEvaluatorService,
@@ -233,6 +280,8 @@ func provideCleanup(
 	accountExpiry *service.AccountExpiryService,
 	subscriptionExpiry *service.SubscriptionExpiryService,
 	usageCleanup *service.UsageCleanupService,
+	usageBilling *service.UsageBillingService,
+	accountQuota *service.AccountQuotaService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
 	billingCache *service.BillingCacheService,
@@ -249,6 +298,14 @@ func provideCleanup(
 			name string
 			fn   func() error
 		}{
+			{"ClusterService", func() error {
+				cluster.Stop()
+				return nil
+			}},
+			{"ConfigReloadService", func() error {
+				configReload.Stop()
+				return nil
+			}},
 			{"OpsScheduledReportService", func() error {
 				if opsScheduledReport != nil {
 					opsScheduledReport.Stop()
@@ -291,6 +348,14 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"UsageBillingService", func() error {
+				usageBilling.Stop()
+				return nil
+			}},
+			{"AccountQuotaService", func() error {
+				accountQuota.Stop()
+				return nil
+			}},
 			{"TokenRefreshService", func() error {
 				tokenRefresh.Stop()
 				return nil
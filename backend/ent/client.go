@@ -20,12 +20,17 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/announcement"
 	"github.com/Wei-Shaw/sub2api/ent/announcementread"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/clientrule"
 	"github.com/Wei-Shaw/sub2api/ent/errorpassthroughrule"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
+	"github.com/Wei-Shaw/sub2api/ent/requestpolicy"
+	"github.com/Wei-Shaw/sub2api/ent/routingrule"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
@@ -53,10 +58,16 @@ type Client struct {
 	Announcement *AnnouncementClient
 	// AnnouncementRead is the client for interacting with the AnnouncementRead builders.
 	AnnouncementRead *AnnouncementReadClient
+	// ClientRule is the client for interacting with the ClientRule builders.
+	ClientRule *ClientRuleClient
 	// ErrorPassthroughRule is the client for interacting with the ErrorPassthroughRule builders.
 	ErrorPassthroughRule *ErrorPassthroughRuleClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// GuardrailRule is the client for interacting with the GuardrailRule builders.
+	GuardrailRule *GuardrailRuleClient
+	// ModelPrice is the client for interacting with the ModelPrice builders.
+	ModelPrice *ModelPriceClient
 	// PromoCode is the client for interacting with the PromoCode builders.
 	PromoCode *PromoCodeClient
 	// PromoCodeUsage is the client for interacting with the PromoCodeUsage builders.
@@ -65,6 +76,10 @@ type Client struct {
 	Proxy *ProxyClient
 	// RedeemCode is the client for interacting with the RedeemCode builders.
 	RedeemCode *RedeemCodeClient
+	// RequestPolicy is the client for interacting with the RequestPolicy builders.
+	RequestPolicy *RequestPolicyClient
+	// RoutingRule is the client for interacting with the RoutingRule builders.
+	RoutingRule *RoutingRuleClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// UsageCleanupTask is the client for interacting with the UsageCleanupTask builders.
@@ -97,12 +112,17 @@ func (c *Client) init() {
 	c.AccountGroup = NewAccountGroupClient(c.config)
 	c.Announcement = NewAnnouncementClient(c.config)
 	c.AnnouncementRead = NewAnnouncementReadClient(c.config)
+	c.ClientRule = NewClientRuleClient(c.config)
 	c.ErrorPassthroughRule = NewErrorPassthroughRuleClient(c.config)
 	c.Group = NewGroupClient(c.config)
+	c.GuardrailRule = NewGuardrailRuleClient(c.config)
+	c.ModelPrice = NewModelPriceClient(c.config)
 	c.PromoCode = NewPromoCodeClient(c.config)
 	c.PromoCodeUsage = NewPromoCodeUsageClient(c.config)
 	c.Proxy = NewProxyClient(c.config)
 	c.RedeemCode = NewRedeemCodeClient(c.config)
+	c.RequestPolicy = NewRequestPolicyClient(c.config)
+	c.RoutingRule = NewRoutingRuleClient(c.config)
 	c.Setting = NewSettingClient(c.config)
 	c.UsageCleanupTask = NewUsageCleanupTaskClient(c.config)
 	c.UsageLog = NewUsageLogClient(c.config)
@@ -208,12 +228,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		AccountGroup:            NewAccountGroupClient(cfg),
 		Announcement:            NewAnnouncementClient(cfg),
 		AnnouncementRead:        NewAnnouncementReadClient(cfg),
+		ClientRule:              NewClientRuleClient(cfg),
 		ErrorPassthroughRule:    NewErrorPassthroughRuleClient(cfg),
 		Group:                   NewGroupClient(cfg),
+		GuardrailRule:           NewGuardrailRuleClient(cfg),
+		ModelPrice:              NewModelPriceClient(cfg),
 		PromoCode:               NewPromoCodeClient(cfg),
 		PromoCodeUsage:          NewPromoCodeUsageClient(cfg),
 		Proxy:                   NewProxyClient(cfg),
 		RedeemCode:              NewRedeemCodeClient(cfg),
+		RequestPolicy:           NewRequestPolicyClient(cfg),
+		RoutingRule:             NewRoutingRuleClient(cfg),
 		Setting:                 NewSettingClient(cfg),
 		UsageCleanupTask:        NewUsageCleanupTaskClient(cfg),
 		UsageLog:                NewUsageLogClient(cfg),
@@ -246,12 +271,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		AccountGroup:            NewAccountGroupClient(cfg),
 		Announcement:            NewAnnouncementClient(cfg),
 		AnnouncementRead:        NewAnnouncementReadClient(cfg),
+		ClientRule:              NewClientRuleClient(cfg),
 		ErrorPassthroughRule:    NewErrorPassthroughRuleClient(cfg),
 		Group:                   NewGroupClient(cfg),
+		GuardrailRule:           NewGuardrailRuleClient(cfg),
+		ModelPrice:              NewModelPriceClient(cfg),
 		PromoCode:               NewPromoCodeClient(cfg),
 		PromoCodeUsage:          NewPromoCodeUsageClient(cfg),
 		Proxy:                   NewProxyClient(cfg),
 		RedeemCode:              NewRedeemCodeClient(cfg),
+		RequestPolicy:           NewRequestPolicyClient(cfg),
+		RoutingRule:             NewRoutingRuleClient(cfg),
 		Setting:                 NewSettingClient(cfg),
 		UsageCleanupTask:        NewUsageCleanupTaskClient(cfg),
 		UsageLog:                NewUsageLogClient(cfg),
@@ -290,8 +320,9 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.APIKey, c.Account, c.AccountGroup, c.Announcement, c.AnnouncementRead,
-		c.ErrorPassthroughRule, c.Group, c.PromoCode, c.PromoCodeUsage, c.Proxy,
-		c.RedeemCode, c.Setting, c.UsageCleanupTask, c.UsageLog, c.User,
+		c.ClientRule, c.ErrorPassthroughRule, c.Group, c.GuardrailRule, c.ModelPrice,
+		c.PromoCode, c.PromoCodeUsage, c.Proxy, c.RedeemCode, c.RequestPolicy,
+		c.RoutingRule, c.Setting, c.UsageCleanupTask, c.UsageLog, c.User,
 		c.UserAllowedGroup, c.UserAttributeDefinition, c.UserAttributeValue,
 		c.UserSubscription,
 	} {
@@ -304,8 +335,9 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.APIKey, c.Account, c.AccountGroup, c.Announcement, c.AnnouncementRead,
-		c.ErrorPassthroughRule, c.Group, c.PromoCode, c.PromoCodeUsage, c.Proxy,
-		c.RedeemCode, c.Setting, c.UsageCleanupTask, c.UsageLog, c.User,
+		c.ClientRule, c.ErrorPassthroughRule, c.Group, c.GuardrailRule, c.ModelPrice,
+		c.PromoCode, c.PromoCodeUsage, c.Proxy, c.RedeemCode, c.RequestPolicy,
+		c.RoutingRule, c.Setting, c.UsageCleanupTask, c.UsageLog, c.User,
 		c.UserAllowedGroup, c.UserAttributeDefinition, c.UserAttributeValue,
 		c.UserSubscription,
 	} {
@@ -326,10 +358,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Announcement.mutate(ctx, m)
 	case *AnnouncementReadMutation:
 		return c.AnnouncementRead.mutate(ctx, m)
+	case *ClientRuleMutation:
+		return c.ClientRule.mutate(ctx, m)
 	case *ErrorPassthroughRuleMutation:
 		return c.ErrorPassthroughRule.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
+	case *GuardrailRuleMutation:
+		return c.GuardrailRule.mutate(ctx, m)
+	case *ModelPriceMutation:
+		return c.ModelPrice.mutate(ctx, m)
 	case *PromoCodeMutation:
 		return c.PromoCode.mutate(ctx, m)
 	case *PromoCodeUsageMutation:
@@ -338,6 +376,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Proxy.mutate(ctx, m)
 	case *RedeemCodeMutation:
 		return c.RedeemCode.mutate(ctx, m)
+	case *RequestPolicyMutation:
+		return c.RequestPolicy.mutate(ctx, m)
+	case *RoutingRuleMutation:
+		return c.RoutingRule.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
 	case *UsageCleanupTaskMutation:
@@ -1171,6 +1213,139 @@ func (c *AnnouncementReadClient) mutate(ctx context.Context, m *AnnouncementRead
 	}
 }
 
+// ClientRuleClient is a client for the ClientRule schema.
+type ClientRuleClient struct {
+	config
+}
+
+// NewClientRuleClient returns a client for the ClientRule from the given config.
+func NewClientRuleClient(c config) *ClientRuleClient {
+	return &ClientRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clientrule.Hooks(f(g(h())))`.
+func (c *ClientRuleClient) Use(hooks ...Hook) {
+	c.hooks.ClientRule = append(c.hooks.ClientRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clientrule.Intercept(f(g(h())))`.
+func (c *ClientRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientRule = append(c.inters.ClientRule, interceptors...)
+}
+
+// Create returns a builder for creating a ClientRule entity.
+func (c *ClientRuleClient) Create() *ClientRuleCreate {
+	mutation := newClientRuleMutation(c.config, OpCreate)
+	return &ClientRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClientRule entities.
+func (c *ClientRuleClient) CreateBulk(builders ...*ClientRuleCreate) *ClientRuleCreateBulk {
+	return &ClientRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClientRuleClient) MapCreateBulk(slice any, setFunc func(*ClientRuleCreate, int)) *ClientRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClientRuleCreateBulk{err: fmt.Errorf("calling to ClientRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClientRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClientRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClientRule.
+func (c *ClientRuleClient) Update() *ClientRuleUpdate {
+	mutation := newClientRuleMutation(c.config, OpUpdate)
+	return &ClientRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClientRuleClient) UpdateOne(_m *ClientRule) *ClientRuleUpdateOne {
+	mutation := newClientRuleMutation(c.config, OpUpdateOne, withClientRule(_m))
+	return &ClientRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClientRuleClient) UpdateOneID(id int64) *ClientRuleUpdateOne {
+	mutation := newClientRuleMutation(c.config, OpUpdateOne, withClientRuleID(id))
+	return &ClientRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClientRule.
+func (c *ClientRuleClient) Delete() *ClientRuleDelete {
+	mutation := newClientRuleMutation(c.config, OpDelete)
+	return &ClientRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClientRuleClient) DeleteOne(_m *ClientRule) *ClientRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClientRuleClient) DeleteOneID(id int64) *ClientRuleDeleteOne {
+	builder := c.Delete().Where(clientrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClientRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ClientRule.
+func (c *ClientRuleClient) Query() *ClientRuleQuery {
+	return &ClientRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClientRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClientRule entity by its id.
+func (c *ClientRuleClient) Get(ctx context.Context, id int64) (*ClientRule, error) {
+	return c.Query().Where(clientrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClientRuleClient) GetX(ctx context.Context, id int64) *ClientRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClientRuleClient) Hooks() []Hook {
+	return c.hooks.ClientRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClientRuleClient) Interceptors() []Interceptor {
+	return c.inters.ClientRule
+}
+
+func (c *ClientRuleClient) mutate(ctx context.Context, m *ClientRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClientRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClientRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClientRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClientRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ClientRule mutation op: %q", m.Op())
+	}
+}
+
 // ErrorPassthroughRuleClient is a client for the ErrorPassthroughRule schema.
 type ErrorPassthroughRuleClient struct {
 	config
@@ -1567,6 +1742,272 @@ func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, erro
 	}
 }
 
+// GuardrailRuleClient is a client for the GuardrailRule schema.
+type GuardrailRuleClient struct {
+	config
+}
+
+// NewGuardrailRuleClient returns a client for the GuardrailRule from the given config.
+func NewGuardrailRuleClient(c config) *GuardrailRuleClient {
+	return &GuardrailRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `guardrailrule.Hooks(f(g(h())))`.
+func (c *GuardrailRuleClient) Use(hooks ...Hook) {
+	c.hooks.GuardrailRule = append(c.hooks.GuardrailRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `guardrailrule.Intercept(f(g(h())))`.
+func (c *GuardrailRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GuardrailRule = append(c.inters.GuardrailRule, interceptors...)
+}
+
+// Create returns a builder for creating a GuardrailRule entity.
+func (c *GuardrailRuleClient) Create() *GuardrailRuleCreate {
+	mutation := newGuardrailRuleMutation(c.config, OpCreate)
+	return &GuardrailRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GuardrailRule entities.
+func (c *GuardrailRuleClient) CreateBulk(builders ...*GuardrailRuleCreate) *GuardrailRuleCreateBulk {
+	return &GuardrailRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GuardrailRuleClient) MapCreateBulk(slice any, setFunc func(*GuardrailRuleCreate, int)) *GuardrailRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GuardrailRuleCreateBulk{err: fmt.Errorf("calling to GuardrailRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GuardrailRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GuardrailRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GuardrailRule.
+func (c *GuardrailRuleClient) Update() *GuardrailRuleUpdate {
+	mutation := newGuardrailRuleMutation(c.config, OpUpdate)
+	return &GuardrailRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GuardrailRuleClient) UpdateOne(_m *GuardrailRule) *GuardrailRuleUpdateOne {
+	mutation := newGuardrailRuleMutation(c.config, OpUpdateOne, withGuardrailRule(_m))
+	return &GuardrailRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GuardrailRuleClient) UpdateOneID(id int64) *GuardrailRuleUpdateOne {
+	mutation := newGuardrailRuleMutation(c.config, OpUpdateOne, withGuardrailRuleID(id))
+	return &GuardrailRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GuardrailRule.
+func (c *GuardrailRuleClient) Delete() *GuardrailRuleDelete {
+	mutation := newGuardrailRuleMutation(c.config, OpDelete)
+	return &GuardrailRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GuardrailRuleClient) DeleteOne(_m *GuardrailRule) *GuardrailRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GuardrailRuleClient) DeleteOneID(id int64) *GuardrailRuleDeleteOne {
+	builder := c.Delete().Where(guardrailrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GuardrailRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for GuardrailRule.
+func (c *GuardrailRuleClient) Query() *GuardrailRuleQuery {
+	return &GuardrailRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGuardrailRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GuardrailRule entity by its id.
+func (c *GuardrailRuleClient) Get(ctx context.Context, id int64) (*GuardrailRule, error) {
+	return c.Query().Where(guardrailrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GuardrailRuleClient) GetX(ctx context.Context, id int64) *GuardrailRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GuardrailRuleClient) Hooks() []Hook {
+	return c.hooks.GuardrailRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *GuardrailRuleClient) Interceptors() []Interceptor {
+	return c.inters.GuardrailRule
+}
+
+func (c *GuardrailRuleClient) mutate(ctx context.Context, m *GuardrailRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GuardrailRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GuardrailRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GuardrailRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GuardrailRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GuardrailRule mutation op: %q", m.Op())
+	}
+}
+
+// ModelPriceClient is a client for the ModelPrice schema.
+type ModelPriceClient struct {
+	config
+}
+
+// NewModelPriceClient returns a client for the ModelPrice from the given config.
+func NewModelPriceClient(c config) *ModelPriceClient {
+	return &ModelPriceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `modelprice.Hooks(f(g(h())))`.
+func (c *ModelPriceClient) Use(hooks ...Hook) {
+	c.hooks.ModelPrice = append(c.hooks.ModelPrice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `modelprice.Intercept(f(g(h())))`.
+func (c *ModelPriceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModelPrice = append(c.inters.ModelPrice, interceptors...)
+}
+
+// Create returns a builder for creating a ModelPrice entity.
+func (c *ModelPriceClient) Create() *ModelPriceCreate {
+	mutation := newModelPriceMutation(c.config, OpCreate)
+	return &ModelPriceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModelPrice entities.
+func (c *ModelPriceClient) CreateBulk(builders ...*ModelPriceCreate) *ModelPriceCreateBulk {
+	return &ModelPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModelPriceClient) MapCreateBulk(slice any, setFunc func(*ModelPriceCreate, int)) *ModelPriceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModelPriceCreateBulk{err: fmt.Errorf("calling to ModelPriceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModelPriceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModelPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModelPrice.
+func (c *ModelPriceClient) Update() *ModelPriceUpdate {
+	mutation := newModelPriceMutation(c.config, OpUpdate)
+	return &ModelPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModelPriceClient) UpdateOne(_m *ModelPrice) *ModelPriceUpdateOne {
+	mutation := newModelPriceMutation(c.config, OpUpdateOne, withModelPrice(_m))
+	return &ModelPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModelPriceClient) UpdateOneID(id int64) *ModelPriceUpdateOne {
+	mutation := newModelPriceMutation(c.config, OpUpdateOne, withModelPriceID(id))
+	return &ModelPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModelPrice.
+func (c *ModelPriceClient) Delete() *ModelPriceDelete {
+	mutation := newModelPriceMutation(c.config, OpDelete)
+	return &ModelPriceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModelPriceClient) DeleteOne(_m *ModelPrice) *ModelPriceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModelPriceClient) DeleteOneID(id int64) *ModelPriceDeleteOne {
+	builder := c.Delete().Where(modelprice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModelPriceDeleteOne{builder}
+}
+
+// Query returns a query builder for ModelPrice.
+func (c *ModelPriceClient) Query() *ModelPriceQuery {
+	return &ModelPriceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModelPrice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModelPrice entity by its id.
+func (c *ModelPriceClient) Get(ctx context.Context, id int64) (*ModelPrice, error) {
+	return c.Query().Where(modelprice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModelPriceClient) GetX(ctx context.Context, id int64) *ModelPrice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModelPriceClient) Hooks() []Hook {
+	return c.hooks.ModelPrice
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModelPriceClient) Interceptors() []Interceptor {
+	return c.inters.ModelPrice
+}
+
+func (c *ModelPriceClient) mutate(ctx context.Context, m *ModelPriceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModelPriceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModelPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModelPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModelPriceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModelPrice mutation op: %q", m.Op())
+	}
+}
+
 // PromoCodeClient is a client for the PromoCode schema.
 type PromoCodeClient struct {
 	config
@@ -2197,6 +2638,272 @@ func (c *RedeemCodeClient) mutate(ctx context.Context, m *RedeemCodeMutation) (V
 	}
 }
 
+// RequestPolicyClient is a client for the RequestPolicy schema.
+type RequestPolicyClient struct {
+	config
+}
+
+// NewRequestPolicyClient returns a client for the RequestPolicy from the given config.
+func NewRequestPolicyClient(c config) *RequestPolicyClient {
+	return &RequestPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `requestpolicy.Hooks(f(g(h())))`.
+func (c *RequestPolicyClient) Use(hooks ...Hook) {
+	c.hooks.RequestPolicy = append(c.hooks.RequestPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `requestpolicy.Intercept(f(g(h())))`.
+func (c *RequestPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RequestPolicy = append(c.inters.RequestPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a RequestPolicy entity.
+func (c *RequestPolicyClient) Create() *RequestPolicyCreate {
+	mutation := newRequestPolicyMutation(c.config, OpCreate)
+	return &RequestPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RequestPolicy entities.
+func (c *RequestPolicyClient) CreateBulk(builders ...*RequestPolicyCreate) *RequestPolicyCreateBulk {
+	return &RequestPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RequestPolicyClient) MapCreateBulk(slice any, setFunc func(*RequestPolicyCreate, int)) *RequestPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RequestPolicyCreateBulk{err: fmt.Errorf("calling to RequestPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RequestPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RequestPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RequestPolicy.
+func (c *RequestPolicyClient) Update() *RequestPolicyUpdate {
+	mutation := newRequestPolicyMutation(c.config, OpUpdate)
+	return &RequestPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RequestPolicyClient) UpdateOne(_m *RequestPolicy) *RequestPolicyUpdateOne {
+	mutation := newRequestPolicyMutation(c.config, OpUpdateOne, withRequestPolicy(_m))
+	return &RequestPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RequestPolicyClient) UpdateOneID(id int64) *RequestPolicyUpdateOne {
+	mutation := newRequestPolicyMutation(c.config, OpUpdateOne, withRequestPolicyID(id))
+	return &RequestPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RequestPolicy.
+func (c *RequestPolicyClient) Delete() *RequestPolicyDelete {
+	mutation := newRequestPolicyMutation(c.config, OpDelete)
+	return &RequestPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RequestPolicyClient) DeleteOne(_m *RequestPolicy) *RequestPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RequestPolicyClient) DeleteOneID(id int64) *RequestPolicyDeleteOne {
+	builder := c.Delete().Where(requestpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RequestPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for RequestPolicy.
+func (c *RequestPolicyClient) Query() *RequestPolicyQuery {
+	return &RequestPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRequestPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RequestPolicy entity by its id.
+func (c *RequestPolicyClient) Get(ctx context.Context, id int64) (*RequestPolicy, error) {
+	return c.Query().Where(requestpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RequestPolicyClient) GetX(ctx context.Context, id int64) *RequestPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RequestPolicyClient) Hooks() []Hook {
+	return c.hooks.RequestPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *RequestPolicyClient) Interceptors() []Interceptor {
+	return c.inters.RequestPolicy
+}
+
+func (c *RequestPolicyClient) mutate(ctx context.Context, m *RequestPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RequestPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RequestPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RequestPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RequestPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RequestPolicy mutation op: %q", m.Op())
+	}
+}
+
+// RoutingRuleClient is a client for the RoutingRule schema.
+type RoutingRuleClient struct {
+	config
+}
+
+// NewRoutingRuleClient returns a client for the RoutingRule from the given config.
+func NewRoutingRuleClient(c config) *RoutingRuleClient {
+	return &RoutingRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `routingrule.Hooks(f(g(h())))`.
+func (c *RoutingRuleClient) Use(hooks ...Hook) {
+	c.hooks.RoutingRule = append(c.hooks.RoutingRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `routingrule.Intercept(f(g(h())))`.
+func (c *RoutingRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoutingRule = append(c.inters.RoutingRule, interceptors...)
+}
+
+// Create returns a builder for creating a RoutingRule entity.
+func (c *RoutingRuleClient) Create() *RoutingRuleCreate {
+	mutation := newRoutingRuleMutation(c.config, OpCreate)
+	return &RoutingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoutingRule entities.
+func (c *RoutingRuleClient) CreateBulk(builders ...*RoutingRuleCreate) *RoutingRuleCreateBulk {
+	return &RoutingRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoutingRuleClient) MapCreateBulk(slice any, setFunc func(*RoutingRuleCreate, int)) *RoutingRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoutingRuleCreateBulk{err: fmt.Errorf("calling to RoutingRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoutingRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoutingRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoutingRule.
+func (c *RoutingRuleClient) Update() *RoutingRuleUpdate {
+	mutation := newRoutingRuleMutation(c.config, OpUpdate)
+	return &RoutingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoutingRuleClient) UpdateOne(_m *RoutingRule) *RoutingRuleUpdateOne {
+	mutation := newRoutingRuleMutation(c.config, OpUpdateOne, withRoutingRule(_m))
+	return &RoutingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoutingRuleClient) UpdateOneID(id int64) *RoutingRuleUpdateOne {
+	mutation := newRoutingRuleMutation(c.config, OpUpdateOne, withRoutingRuleID(id))
+	return &RoutingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoutingRule.
+func (c *RoutingRuleClient) Delete() *RoutingRuleDelete {
+	mutation := newRoutingRuleMutation(c.config, OpDelete)
+	return &RoutingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoutingRuleClient) DeleteOne(_m *RoutingRule) *RoutingRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoutingRuleClient) DeleteOneID(id int64) *RoutingRuleDeleteOne {
+	builder := c.Delete().Where(routingrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoutingRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RoutingRule.
+func (c *RoutingRuleClient) Query() *RoutingRuleQuery {
+	return &RoutingRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoutingRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoutingRule entity by its id.
+func (c *RoutingRuleClient) Get(ctx context.Context, id int64) (*RoutingRule, error) {
+	return c.Query().Where(routingrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoutingRuleClient) GetX(ctx context.Context, id int64) *RoutingRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoutingRuleClient) Hooks() []Hook {
+	return c.hooks.RoutingRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoutingRuleClient) Interceptors() []Interceptor {
+	return c.inters.RoutingRule
+}
+
+func (c *RoutingRuleClient) mutate(ctx context.Context, m *RoutingRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoutingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoutingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoutingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoutingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoutingRule mutation op: %q", m.Op())
+	}
+}
+
 // SettingClient is a client for the Setting schema.
 type SettingClient struct {
 	config
@@ -3605,16 +4312,18 @@ func (c *UserSubscriptionClient) mutate(ctx context.Context, m *UserSubscription
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, Account, AccountGroup, Announcement, AnnouncementRead,
-		ErrorPassthroughRule, Group, PromoCode, PromoCodeUsage, Proxy, RedeemCode,
-		Setting, UsageCleanupTask, UsageLog, User, UserAllowedGroup,
-		UserAttributeDefinition, UserAttributeValue, UserSubscription []ent.Hook
+		APIKey, Account, AccountGroup, Announcement, AnnouncementRead, ClientRule,
+		ErrorPassthroughRule, Group, GuardrailRule, ModelPrice, PromoCode,
+		PromoCodeUsage, Proxy, RedeemCode, RequestPolicy, RoutingRule, Setting,
+		UsageCleanupTask, UsageLog, User, UserAllowedGroup, UserAttributeDefinition,
+		UserAttributeValue, UserSubscription []ent.Hook
 	}
 	inters struct {
-		APIKey, Account, AccountGroup, Announcement, AnnouncementRead,
-		ErrorPassthroughRule, Group, PromoCode, PromoCodeUsage, Proxy, RedeemCode,
-		Setting, UsageCleanupTask, UsageLog, User, UserAllowedGroup,
-		UserAttributeDefinition, UserAttributeValue, UserSubscription []ent.Interceptor
+		APIKey, Account, AccountGroup, Announcement, AnnouncementRead, ClientRule,
+		ErrorPassthroughRule, Group, GuardrailRule, ModelPrice, PromoCode,
+		PromoCodeUsage, Proxy, RedeemCode, RequestPolicy, RoutingRule, Setting,
+		UsageCleanupTask, UsageLog, User, UserAllowedGroup, UserAttributeDefinition,
+		UserAttributeValue, UserSubscription []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/clientrule"
+)
+
+// ClientRule is the model entity for the ClientRule schema.
+type ClientRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// GroupIds holds the value of the "group_ids" field.
+	GroupIds []int64 `json:"group_ids,omitempty"`
+	// Preset holds the value of the "preset" field.
+	Preset string `json:"preset,omitempty"`
+	// UserAgentPattern holds the value of the "user_agent_pattern" field.
+	UserAgentPattern *string `json:"user_agent_pattern,omitempty"`
+	// RequiredHeaders holds the value of the "required_headers" field.
+	RequiredHeaders []string `json:"required_headers,omitempty"`
+	// MetadataUserIDPattern holds the value of the "metadata_user_id_pattern" field.
+	MetadataUserIDPattern *string `json:"metadata_user_id_pattern,omitempty"`
+	// BodyFields holds the value of the "body_fields" field.
+	BodyFields []string `json:"body_fields,omitempty"`
+	// FallbackGroupID holds the value of the "fallback_group_id" field.
+	FallbackGroupID *int64 `json:"fallback_group_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  *string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientrule.FieldGroupIds, clientrule.FieldRequiredHeaders, clientrule.FieldBodyFields:
+			values[i] = new([]byte)
+		case clientrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case clientrule.FieldID, clientrule.FieldPriority, clientrule.FieldFallbackGroupID:
+			values[i] = new(sql.NullInt64)
+		case clientrule.FieldName, clientrule.FieldPreset, clientrule.FieldUserAgentPattern, clientrule.FieldMetadataUserIDPattern, clientrule.FieldDescription:
+			values[i] = new(sql.NullString)
+		case clientrule.FieldCreatedAt, clientrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientRule fields.
+func (_m *ClientRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case clientrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case clientrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case clientrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case clientrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case clientrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case clientrule.FieldGroupIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupIds); err != nil {
+					return fmt.Errorf("unmarshal field group_ids: %w", err)
+				}
+			}
+		case clientrule.FieldPreset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preset", values[i])
+			} else if value.Valid {
+				_m.Preset = value.String
+			}
+		case clientrule.FieldUserAgentPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent_pattern", values[i])
+			} else if value.Valid {
+				_m.UserAgentPattern = new(string)
+				*_m.UserAgentPattern = value.String
+			}
+		case clientrule.FieldRequiredHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field required_headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RequiredHeaders); err != nil {
+					return fmt.Errorf("unmarshal field required_headers: %w", err)
+				}
+			}
+		case clientrule.FieldMetadataUserIDPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata_user_id_pattern", values[i])
+			} else if value.Valid {
+				_m.MetadataUserIDPattern = new(string)
+				*_m.MetadataUserIDPattern = value.String
+			}
+		case clientrule.FieldBodyFields:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field body_fields", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BodyFields); err != nil {
+					return fmt.Errorf("unmarshal field body_fields: %w", err)
+				}
+			}
+		case clientrule.FieldFallbackGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_group_id", values[i])
+			} else if value.Valid {
+				_m.FallbackGroupID = new(int64)
+				*_m.FallbackGroupID = value.Int64
+			}
+		case clientrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClientRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientRule.
+// Note that you need to call ClientRule.Unwrap() before calling this method if this ClientRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClientRule) Update() *ClientRuleUpdateOne {
+	return NewClientRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClientRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClientRule) Unwrap() *ClientRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClientRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupIds))
+	builder.WriteString(", ")
+	builder.WriteString("preset=")
+	builder.WriteString(_m.Preset)
+	builder.WriteString(", ")
+	if v := _m.UserAgentPattern; v != nil {
+		builder.WriteString("user_agent_pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("required_headers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredHeaders))
+	builder.WriteString(", ")
+	if v := _m.MetadataUserIDPattern; v != nil {
+		builder.WriteString("metadata_user_id_pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("body_fields=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BodyFields))
+	builder.WriteString(", ")
+	if v := _m.FallbackGroupID; v != nil {
+		builder.WriteString("fallback_group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientRules is a parsable slice of ClientRule.
+type ClientRules []*ClientRule
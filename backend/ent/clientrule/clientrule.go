@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the clientrule type in the database.
+	Label = "client_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldGroupIds holds the string denoting the group_ids field in the database.
+	FieldGroupIds = "group_ids"
+	// FieldPreset holds the string denoting the preset field in the database.
+	FieldPreset = "preset"
+	// FieldUserAgentPattern holds the string denoting the user_agent_pattern field in the database.
+	FieldUserAgentPattern = "user_agent_pattern"
+	// FieldRequiredHeaders holds the string denoting the required_headers field in the database.
+	FieldRequiredHeaders = "required_headers"
+	// FieldMetadataUserIDPattern holds the string denoting the metadata_user_id_pattern field in the database.
+	FieldMetadataUserIDPattern = "metadata_user_id_pattern"
+	// FieldBodyFields holds the string denoting the body_fields field in the database.
+	FieldBodyFields = "body_fields"
+	// FieldFallbackGroupID holds the string denoting the fallback_group_id field in the database.
+	FieldFallbackGroupID = "fallback_group_id"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// Table holds the table name of the clientrule in the database.
+	Table = "client_rules"
+)
+
+// Columns holds all SQL columns for clientrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldEnabled,
+	FieldPriority,
+	FieldGroupIds,
+	FieldPreset,
+	FieldUserAgentPattern,
+	FieldRequiredHeaders,
+	FieldMetadataUserIDPattern,
+	FieldBodyFields,
+	FieldFallbackGroupID,
+	FieldDescription,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultPreset holds the default value on creation for the "preset" field.
+	DefaultPreset string
+	// PresetValidator is a validator for the "preset" field. It is called by the builders before save.
+	PresetValidator func(string) error
+	// UserAgentPatternValidator is a validator for the "user_agent_pattern" field. It is called by the builders before save.
+	UserAgentPatternValidator func(string) error
+	// MetadataUserIDPatternValidator is a validator for the "metadata_user_id_pattern" field. It is called by the builders before save.
+	MetadataUserIDPatternValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ClientRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByPreset orders the results by the preset field.
+func ByPreset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreset, opts...).ToFunc()
+}
+
+// ByUserAgentPattern orders the results by the user_agent_pattern field.
+func ByUserAgentPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgentPattern, opts...).ToFunc()
+}
+
+// ByMetadataUserIDPattern orders the results by the metadata_user_id_pattern field.
+func ByMetadataUserIDPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetadataUserIDPattern, opts...).ToFunc()
+}
+
+// ByFallbackGroupID orders the results by the fallback_group_id field.
+func ByFallbackGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackGroupID, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// Preset applies equality check predicate on the "preset" field. It's identical to PresetEQ.
+func Preset(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldPreset, v))
+}
+
+// UserAgentPattern applies equality check predicate on the "user_agent_pattern" field. It's identical to UserAgentPatternEQ.
+func UserAgentPattern(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldUserAgentPattern, v))
+}
+
+// MetadataUserIDPattern applies equality check predicate on the "metadata_user_id_pattern" field. It's identical to MetadataUserIDPatternEQ.
+func MetadataUserIDPattern(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldMetadataUserIDPattern, v))
+}
+
+// FallbackGroupID applies equality check predicate on the "fallback_group_id" field. It's identical to FallbackGroupIDEQ.
+func FallbackGroupID(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldFallbackGroupID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// GroupIdsIsNil applies the IsNil predicate on the "group_ids" field.
+func GroupIdsIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldGroupIds))
+}
+
+// GroupIdsNotNil applies the NotNil predicate on the "group_ids" field.
+func GroupIdsNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldGroupIds))
+}
+
+// PresetEQ applies the EQ predicate on the "preset" field.
+func PresetEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldPreset, v))
+}
+
+// PresetNEQ applies the NEQ predicate on the "preset" field.
+func PresetNEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldPreset, v))
+}
+
+// PresetIn applies the In predicate on the "preset" field.
+func PresetIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldPreset, vs...))
+}
+
+// PresetNotIn applies the NotIn predicate on the "preset" field.
+func PresetNotIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldPreset, vs...))
+}
+
+// PresetGT applies the GT predicate on the "preset" field.
+func PresetGT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldPreset, v))
+}
+
+// PresetGTE applies the GTE predicate on the "preset" field.
+func PresetGTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldPreset, v))
+}
+
+// PresetLT applies the LT predicate on the "preset" field.
+func PresetLT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldPreset, v))
+}
+
+// PresetLTE applies the LTE predicate on the "preset" field.
+func PresetLTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldPreset, v))
+}
+
+// PresetContains applies the Contains predicate on the "preset" field.
+func PresetContains(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContains(FieldPreset, v))
+}
+
+// PresetHasPrefix applies the HasPrefix predicate on the "preset" field.
+func PresetHasPrefix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasPrefix(FieldPreset, v))
+}
+
+// PresetHasSuffix applies the HasSuffix predicate on the "preset" field.
+func PresetHasSuffix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasSuffix(FieldPreset, v))
+}
+
+// PresetEqualFold applies the EqualFold predicate on the "preset" field.
+func PresetEqualFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEqualFold(FieldPreset, v))
+}
+
+// PresetContainsFold applies the ContainsFold predicate on the "preset" field.
+func PresetContainsFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContainsFold(FieldPreset, v))
+}
+
+// UserAgentPatternEQ applies the EQ predicate on the "user_agent_pattern" field.
+func UserAgentPatternEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternNEQ applies the NEQ predicate on the "user_agent_pattern" field.
+func UserAgentPatternNEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternIn applies the In predicate on the "user_agent_pattern" field.
+func UserAgentPatternIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldUserAgentPattern, vs...))
+}
+
+// UserAgentPatternNotIn applies the NotIn predicate on the "user_agent_pattern" field.
+func UserAgentPatternNotIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldUserAgentPattern, vs...))
+}
+
+// UserAgentPatternGT applies the GT predicate on the "user_agent_pattern" field.
+func UserAgentPatternGT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternGTE applies the GTE predicate on the "user_agent_pattern" field.
+func UserAgentPatternGTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternLT applies the LT predicate on the "user_agent_pattern" field.
+func UserAgentPatternLT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternLTE applies the LTE predicate on the "user_agent_pattern" field.
+func UserAgentPatternLTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternContains applies the Contains predicate on the "user_agent_pattern" field.
+func UserAgentPatternContains(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContains(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternHasPrefix applies the HasPrefix predicate on the "user_agent_pattern" field.
+func UserAgentPatternHasPrefix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasPrefix(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternHasSuffix applies the HasSuffix predicate on the "user_agent_pattern" field.
+func UserAgentPatternHasSuffix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasSuffix(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternIsNil applies the IsNil predicate on the "user_agent_pattern" field.
+func UserAgentPatternIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldUserAgentPattern))
+}
+
+// UserAgentPatternNotNil applies the NotNil predicate on the "user_agent_pattern" field.
+func UserAgentPatternNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldUserAgentPattern))
+}
+
+// UserAgentPatternEqualFold applies the EqualFold predicate on the "user_agent_pattern" field.
+func UserAgentPatternEqualFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEqualFold(FieldUserAgentPattern, v))
+}
+
+// UserAgentPatternContainsFold applies the ContainsFold predicate on the "user_agent_pattern" field.
+func UserAgentPatternContainsFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContainsFold(FieldUserAgentPattern, v))
+}
+
+// RequiredHeadersIsNil applies the IsNil predicate on the "required_headers" field.
+func RequiredHeadersIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldRequiredHeaders))
+}
+
+// RequiredHeadersNotNil applies the NotNil predicate on the "required_headers" field.
+func RequiredHeadersNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldRequiredHeaders))
+}
+
+// MetadataUserIDPatternEQ applies the EQ predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternNEQ applies the NEQ predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternNEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternIn applies the In predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldMetadataUserIDPattern, vs...))
+}
+
+// MetadataUserIDPatternNotIn applies the NotIn predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternNotIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldMetadataUserIDPattern, vs...))
+}
+
+// MetadataUserIDPatternGT applies the GT predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternGT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternGTE applies the GTE predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternGTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternLT applies the LT predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternLT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternLTE applies the LTE predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternLTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternContains applies the Contains predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternContains(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContains(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternHasPrefix applies the HasPrefix predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternHasPrefix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasPrefix(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternHasSuffix applies the HasSuffix predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternHasSuffix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasSuffix(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternIsNil applies the IsNil predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldMetadataUserIDPattern))
+}
+
+// MetadataUserIDPatternNotNil applies the NotNil predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldMetadataUserIDPattern))
+}
+
+// MetadataUserIDPatternEqualFold applies the EqualFold predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternEqualFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEqualFold(FieldMetadataUserIDPattern, v))
+}
+
+// MetadataUserIDPatternContainsFold applies the ContainsFold predicate on the "metadata_user_id_pattern" field.
+func MetadataUserIDPatternContainsFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContainsFold(FieldMetadataUserIDPattern, v))
+}
+
+// BodyFieldsIsNil applies the IsNil predicate on the "body_fields" field.
+func BodyFieldsIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldBodyFields))
+}
+
+// BodyFieldsNotNil applies the NotNil predicate on the "body_fields" field.
+func BodyFieldsNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldBodyFields))
+}
+
+// FallbackGroupIDEQ applies the EQ predicate on the "fallback_group_id" field.
+func FallbackGroupIDEQ(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldFallbackGroupID, v))
+}
+
+// FallbackGroupIDNEQ applies the NEQ predicate on the "fallback_group_id" field.
+func FallbackGroupIDNEQ(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldFallbackGroupID, v))
+}
+
+// FallbackGroupIDIn applies the In predicate on the "fallback_group_id" field.
+func FallbackGroupIDIn(vs ...int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldFallbackGroupID, vs...))
+}
+
+// FallbackGroupIDNotIn applies the NotIn predicate on the "fallback_group_id" field.
+func FallbackGroupIDNotIn(vs ...int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldFallbackGroupID, vs...))
+}
+
+// FallbackGroupIDGT applies the GT predicate on the "fallback_group_id" field.
+func FallbackGroupIDGT(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldFallbackGroupID, v))
+}
+
+// FallbackGroupIDGTE applies the GTE predicate on the "fallback_group_id" field.
+func FallbackGroupIDGTE(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldFallbackGroupID, v))
+}
+
+// FallbackGroupIDLT applies the LT predicate on the "fallback_group_id" field.
+func FallbackGroupIDLT(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldFallbackGroupID, v))
+}
+
+// FallbackGroupIDLTE applies the LTE predicate on the "fallback_group_id" field.
+func FallbackGroupIDLTE(v int64) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldFallbackGroupID, v))
+}
+
+// FallbackGroupIDIsNil applies the IsNil predicate on the "fallback_group_id" field.
+func FallbackGroupIDIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldFallbackGroupID))
+}
+
+// FallbackGroupIDNotNil applies the NotNil predicate on the "fallback_group_id" field.
+func FallbackGroupIDNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldFallbackGroupID))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ClientRule {
+	return predicate.ClientRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClientRule) predicate.ClientRule {
+	return predicate.ClientRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClientRule) predicate.ClientRule {
+	return predicate.ClientRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClientRule) predicate.ClientRule {
+	return predicate.ClientRule(sql.NotPredicates(p))
+}
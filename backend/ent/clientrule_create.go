@@ -0,0 +1,1340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/clientrule"
+)
+
+// ClientRuleCreate is the builder for creating a ClientRule entity.
+type ClientRuleCreate struct {
+	config
+	mutation *ClientRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ClientRuleCreate) SetCreatedAt(v time.Time) *ClientRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableCreatedAt(v *time.Time) *ClientRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ClientRuleCreate) SetUpdatedAt(v time.Time) *ClientRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableUpdatedAt(v *time.Time) *ClientRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ClientRuleCreate) SetName(v string) *ClientRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ClientRuleCreate) SetEnabled(v bool) *ClientRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableEnabled(v *bool) *ClientRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *ClientRuleCreate) SetPriority(v int) *ClientRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillablePriority(v *int) *ClientRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_c *ClientRuleCreate) SetGroupIds(v []int64) *ClientRuleCreate {
+	_c.mutation.SetGroupIds(v)
+	return _c
+}
+
+// SetPreset sets the "preset" field.
+func (_c *ClientRuleCreate) SetPreset(v string) *ClientRuleCreate {
+	_c.mutation.SetPreset(v)
+	return _c
+}
+
+// SetNillablePreset sets the "preset" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillablePreset(v *string) *ClientRuleCreate {
+	if v != nil {
+		_c.SetPreset(*v)
+	}
+	return _c
+}
+
+// SetUserAgentPattern sets the "user_agent_pattern" field.
+func (_c *ClientRuleCreate) SetUserAgentPattern(v string) *ClientRuleCreate {
+	_c.mutation.SetUserAgentPattern(v)
+	return _c
+}
+
+// SetNillableUserAgentPattern sets the "user_agent_pattern" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableUserAgentPattern(v *string) *ClientRuleCreate {
+	if v != nil {
+		_c.SetUserAgentPattern(*v)
+	}
+	return _c
+}
+
+// SetRequiredHeaders sets the "required_headers" field.
+func (_c *ClientRuleCreate) SetRequiredHeaders(v []string) *ClientRuleCreate {
+	_c.mutation.SetRequiredHeaders(v)
+	return _c
+}
+
+// SetMetadataUserIDPattern sets the "metadata_user_id_pattern" field.
+func (_c *ClientRuleCreate) SetMetadataUserIDPattern(v string) *ClientRuleCreate {
+	_c.mutation.SetMetadataUserIDPattern(v)
+	return _c
+}
+
+// SetNillableMetadataUserIDPattern sets the "metadata_user_id_pattern" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableMetadataUserIDPattern(v *string) *ClientRuleCreate {
+	if v != nil {
+		_c.SetMetadataUserIDPattern(*v)
+	}
+	return _c
+}
+
+// SetBodyFields sets the "body_fields" field.
+func (_c *ClientRuleCreate) SetBodyFields(v []string) *ClientRuleCreate {
+	_c.mutation.SetBodyFields(v)
+	return _c
+}
+
+// SetFallbackGroupID sets the "fallback_group_id" field.
+func (_c *ClientRuleCreate) SetFallbackGroupID(v int64) *ClientRuleCreate {
+	_c.mutation.SetFallbackGroupID(v)
+	return _c
+}
+
+// SetNillableFallbackGroupID sets the "fallback_group_id" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableFallbackGroupID(v *int64) *ClientRuleCreate {
+	if v != nil {
+		_c.SetFallbackGroupID(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ClientRuleCreate) SetDescription(v string) *ClientRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ClientRuleCreate) SetNillableDescription(v *string) *ClientRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ClientRuleMutation object of the builder.
+func (_c *ClientRuleCreate) Mutation() *ClientRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ClientRule in the database.
+func (_c *ClientRuleCreate) Save(ctx context.Context) (*ClientRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClientRuleCreate) SaveX(ctx context.Context) *ClientRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClientRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := clientrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := clientrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := clientrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := clientrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Preset(); !ok {
+		v := clientrule.DefaultPreset
+		_c.mutation.SetPreset(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClientRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ClientRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ClientRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ClientRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := clientrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ClientRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "ClientRule.priority"`)}
+	}
+	if _, ok := _c.mutation.Preset(); !ok {
+		return &ValidationError{Name: "preset", err: errors.New(`ent: missing required field "ClientRule.preset"`)}
+	}
+	if v, ok := _c.mutation.Preset(); ok {
+		if err := clientrule.PresetValidator(v); err != nil {
+			return &ValidationError{Name: "preset", err: fmt.Errorf(`ent: validator failed for field "ClientRule.preset": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserAgentPattern(); ok {
+		if err := clientrule.UserAgentPatternValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent_pattern", err: fmt.Errorf(`ent: validator failed for field "ClientRule.user_agent_pattern": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MetadataUserIDPattern(); ok {
+		if err := clientrule.MetadataUserIDPatternValidator(v); err != nil {
+			return &ValidationError{Name: "metadata_user_id_pattern", err: fmt.Errorf(`ent: validator failed for field "ClientRule.metadata_user_id_pattern": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ClientRuleCreate) sqlSave(ctx context.Context) (*ClientRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClientRuleCreate) createSpec() (*ClientRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(clientrule.Table, sqlgraph.NewFieldSpec(clientrule.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(clientrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(clientrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(clientrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(clientrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(clientrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.GroupIds(); ok {
+		_spec.SetField(clientrule.FieldGroupIds, field.TypeJSON, value)
+		_node.GroupIds = value
+	}
+	if value, ok := _c.mutation.Preset(); ok {
+		_spec.SetField(clientrule.FieldPreset, field.TypeString, value)
+		_node.Preset = value
+	}
+	if value, ok := _c.mutation.UserAgentPattern(); ok {
+		_spec.SetField(clientrule.FieldUserAgentPattern, field.TypeString, value)
+		_node.UserAgentPattern = &value
+	}
+	if value, ok := _c.mutation.RequiredHeaders(); ok {
+		_spec.SetField(clientrule.FieldRequiredHeaders, field.TypeJSON, value)
+		_node.RequiredHeaders = value
+	}
+	if value, ok := _c.mutation.MetadataUserIDPattern(); ok {
+		_spec.SetField(clientrule.FieldMetadataUserIDPattern, field.TypeString, value)
+		_node.MetadataUserIDPattern = &value
+	}
+	if value, ok := _c.mutation.BodyFields(); ok {
+		_spec.SetField(clientrule.FieldBodyFields, field.TypeJSON, value)
+		_node.BodyFields = value
+	}
+	if value, ok := _c.mutation.FallbackGroupID(); ok {
+		_spec.SetField(clientrule.FieldFallbackGroupID, field.TypeInt64, value)
+		_node.FallbackGroupID = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(clientrule.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClientRuleCreate) OnConflict(opts ...sql.ConflictOption) *ClientRuleUpsertOne {
+	_c.conflict = opts
+	return &ClientRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClientRuleCreate) OnConflictColumns(columns ...string) *ClientRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClientRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ClientRuleUpsertOne is the builder for "upsert"-ing
+	//  one ClientRule node.
+	ClientRuleUpsertOne struct {
+		create *ClientRuleCreate
+	}
+
+	// ClientRuleUpsert is the "OnConflict" setter.
+	ClientRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ClientRuleUpsert) SetUpdatedAt(v time.Time) *ClientRuleUpsert {
+	u.Set(clientrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateUpdatedAt() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ClientRuleUpsert) SetName(v string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateName() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ClientRuleUpsert) SetEnabled(v bool) *ClientRuleUpsert {
+	u.Set(clientrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateEnabled() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldEnabled)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *ClientRuleUpsert) SetPriority(v int) *ClientRuleUpsert {
+	u.Set(clientrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdatePriority() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ClientRuleUpsert) AddPriority(v int) *ClientRuleUpsert {
+	u.Add(clientrule.FieldPriority, v)
+	return u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *ClientRuleUpsert) SetGroupIds(v []int64) *ClientRuleUpsert {
+	u.Set(clientrule.FieldGroupIds, v)
+	return u
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateGroupIds() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldGroupIds)
+	return u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *ClientRuleUpsert) ClearGroupIds() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldGroupIds)
+	return u
+}
+
+// SetPreset sets the "preset" field.
+func (u *ClientRuleUpsert) SetPreset(v string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldPreset, v)
+	return u
+}
+
+// UpdatePreset sets the "preset" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdatePreset() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldPreset)
+	return u
+}
+
+// SetUserAgentPattern sets the "user_agent_pattern" field.
+func (u *ClientRuleUpsert) SetUserAgentPattern(v string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldUserAgentPattern, v)
+	return u
+}
+
+// UpdateUserAgentPattern sets the "user_agent_pattern" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateUserAgentPattern() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldUserAgentPattern)
+	return u
+}
+
+// ClearUserAgentPattern clears the value of the "user_agent_pattern" field.
+func (u *ClientRuleUpsert) ClearUserAgentPattern() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldUserAgentPattern)
+	return u
+}
+
+// SetRequiredHeaders sets the "required_headers" field.
+func (u *ClientRuleUpsert) SetRequiredHeaders(v []string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldRequiredHeaders, v)
+	return u
+}
+
+// UpdateRequiredHeaders sets the "required_headers" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateRequiredHeaders() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldRequiredHeaders)
+	return u
+}
+
+// ClearRequiredHeaders clears the value of the "required_headers" field.
+func (u *ClientRuleUpsert) ClearRequiredHeaders() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldRequiredHeaders)
+	return u
+}
+
+// SetMetadataUserIDPattern sets the "metadata_user_id_pattern" field.
+func (u *ClientRuleUpsert) SetMetadataUserIDPattern(v string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldMetadataUserIDPattern, v)
+	return u
+}
+
+// UpdateMetadataUserIDPattern sets the "metadata_user_id_pattern" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateMetadataUserIDPattern() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldMetadataUserIDPattern)
+	return u
+}
+
+// ClearMetadataUserIDPattern clears the value of the "metadata_user_id_pattern" field.
+func (u *ClientRuleUpsert) ClearMetadataUserIDPattern() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldMetadataUserIDPattern)
+	return u
+}
+
+// SetBodyFields sets the "body_fields" field.
+func (u *ClientRuleUpsert) SetBodyFields(v []string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldBodyFields, v)
+	return u
+}
+
+// UpdateBodyFields sets the "body_fields" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateBodyFields() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldBodyFields)
+	return u
+}
+
+// ClearBodyFields clears the value of the "body_fields" field.
+func (u *ClientRuleUpsert) ClearBodyFields() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldBodyFields)
+	return u
+}
+
+// SetFallbackGroupID sets the "fallback_group_id" field.
+func (u *ClientRuleUpsert) SetFallbackGroupID(v int64) *ClientRuleUpsert {
+	u.Set(clientrule.FieldFallbackGroupID, v)
+	return u
+}
+
+// UpdateFallbackGroupID sets the "fallback_group_id" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateFallbackGroupID() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldFallbackGroupID)
+	return u
+}
+
+// AddFallbackGroupID adds v to the "fallback_group_id" field.
+func (u *ClientRuleUpsert) AddFallbackGroupID(v int64) *ClientRuleUpsert {
+	u.Add(clientrule.FieldFallbackGroupID, v)
+	return u
+}
+
+// ClearFallbackGroupID clears the value of the "fallback_group_id" field.
+func (u *ClientRuleUpsert) ClearFallbackGroupID() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldFallbackGroupID)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ClientRuleUpsert) SetDescription(v string) *ClientRuleUpsert {
+	u.Set(clientrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ClientRuleUpsert) UpdateDescription() *ClientRuleUpsert {
+	u.SetExcluded(clientrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ClientRuleUpsert) ClearDescription() *ClientRuleUpsert {
+	u.SetNull(clientrule.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ClientRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ClientRuleUpsertOne) UpdateNewValues() *ClientRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(clientrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ClientRuleUpsertOne) Ignore() *ClientRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientRuleUpsertOne) DoNothing() *ClientRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientRuleCreate.OnConflict
+// documentation for more info.
+func (u *ClientRuleUpsertOne) Update(set func(*ClientRuleUpsert)) *ClientRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ClientRuleUpsertOne) SetUpdatedAt(v time.Time) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateUpdatedAt() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ClientRuleUpsertOne) SetName(v string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateName() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ClientRuleUpsertOne) SetEnabled(v bool) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateEnabled() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *ClientRuleUpsertOne) SetPriority(v int) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ClientRuleUpsertOne) AddPriority(v int) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdatePriority() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *ClientRuleUpsertOne) SetGroupIds(v []int64) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateGroupIds() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *ClientRuleUpsertOne) ClearGroupIds() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetPreset sets the "preset" field.
+func (u *ClientRuleUpsertOne) SetPreset(v string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetPreset(v)
+	})
+}
+
+// UpdatePreset sets the "preset" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdatePreset() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdatePreset()
+	})
+}
+
+// SetUserAgentPattern sets the "user_agent_pattern" field.
+func (u *ClientRuleUpsertOne) SetUserAgentPattern(v string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetUserAgentPattern(v)
+	})
+}
+
+// UpdateUserAgentPattern sets the "user_agent_pattern" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateUserAgentPattern() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateUserAgentPattern()
+	})
+}
+
+// ClearUserAgentPattern clears the value of the "user_agent_pattern" field.
+func (u *ClientRuleUpsertOne) ClearUserAgentPattern() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearUserAgentPattern()
+	})
+}
+
+// SetRequiredHeaders sets the "required_headers" field.
+func (u *ClientRuleUpsertOne) SetRequiredHeaders(v []string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetRequiredHeaders(v)
+	})
+}
+
+// UpdateRequiredHeaders sets the "required_headers" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateRequiredHeaders() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateRequiredHeaders()
+	})
+}
+
+// ClearRequiredHeaders clears the value of the "required_headers" field.
+func (u *ClientRuleUpsertOne) ClearRequiredHeaders() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearRequiredHeaders()
+	})
+}
+
+// SetMetadataUserIDPattern sets the "metadata_user_id_pattern" field.
+func (u *ClientRuleUpsertOne) SetMetadataUserIDPattern(v string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetMetadataUserIDPattern(v)
+	})
+}
+
+// UpdateMetadataUserIDPattern sets the "metadata_user_id_pattern" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateMetadataUserIDPattern() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateMetadataUserIDPattern()
+	})
+}
+
+// ClearMetadataUserIDPattern clears the value of the "metadata_user_id_pattern" field.
+func (u *ClientRuleUpsertOne) ClearMetadataUserIDPattern() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearMetadataUserIDPattern()
+	})
+}
+
+// SetBodyFields sets the "body_fields" field.
+func (u *ClientRuleUpsertOne) SetBodyFields(v []string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetBodyFields(v)
+	})
+}
+
+// UpdateBodyFields sets the "body_fields" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateBodyFields() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateBodyFields()
+	})
+}
+
+// ClearBodyFields clears the value of the "body_fields" field.
+func (u *ClientRuleUpsertOne) ClearBodyFields() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearBodyFields()
+	})
+}
+
+// SetFallbackGroupID sets the "fallback_group_id" field.
+func (u *ClientRuleUpsertOne) SetFallbackGroupID(v int64) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetFallbackGroupID(v)
+	})
+}
+
+// AddFallbackGroupID adds v to the "fallback_group_id" field.
+func (u *ClientRuleUpsertOne) AddFallbackGroupID(v int64) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.AddFallbackGroupID(v)
+	})
+}
+
+// UpdateFallbackGroupID sets the "fallback_group_id" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateFallbackGroupID() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateFallbackGroupID()
+	})
+}
+
+// ClearFallbackGroupID clears the value of the "fallback_group_id" field.
+func (u *ClientRuleUpsertOne) ClearFallbackGroupID() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearFallbackGroupID()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ClientRuleUpsertOne) SetDescription(v string) *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ClientRuleUpsertOne) UpdateDescription() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ClientRuleUpsertOne) ClearDescription() *ClientRuleUpsertOne {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ClientRuleUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ClientRuleUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ClientRuleCreateBulk is the builder for creating many ClientRule entities in bulk.
+type ClientRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ClientRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ClientRule entities in the database.
+func (_c *ClientRuleCreateBulk) Save(ctx context.Context) ([]*ClientRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClientRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClientRuleCreateBulk) SaveX(ctx context.Context) []*ClientRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClientRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClientRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ClientRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ClientRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ClientRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ClientRuleUpsertBulk {
+	_c.conflict = opts
+	return &ClientRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ClientRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ClientRuleCreateBulk) OnConflictColumns(columns ...string) *ClientRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ClientRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// ClientRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ClientRule nodes.
+type ClientRuleUpsertBulk struct {
+	create *ClientRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ClientRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ClientRuleUpsertBulk) UpdateNewValues() *ClientRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(clientrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ClientRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ClientRuleUpsertBulk) Ignore() *ClientRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ClientRuleUpsertBulk) DoNothing() *ClientRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ClientRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ClientRuleUpsertBulk) Update(set func(*ClientRuleUpsert)) *ClientRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ClientRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ClientRuleUpsertBulk) SetUpdatedAt(v time.Time) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateUpdatedAt() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ClientRuleUpsertBulk) SetName(v string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateName() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ClientRuleUpsertBulk) SetEnabled(v bool) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateEnabled() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *ClientRuleUpsertBulk) SetPriority(v int) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ClientRuleUpsertBulk) AddPriority(v int) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdatePriority() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *ClientRuleUpsertBulk) SetGroupIds(v []int64) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateGroupIds() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *ClientRuleUpsertBulk) ClearGroupIds() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetPreset sets the "preset" field.
+func (u *ClientRuleUpsertBulk) SetPreset(v string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetPreset(v)
+	})
+}
+
+// UpdatePreset sets the "preset" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdatePreset() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdatePreset()
+	})
+}
+
+// SetUserAgentPattern sets the "user_agent_pattern" field.
+func (u *ClientRuleUpsertBulk) SetUserAgentPattern(v string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetUserAgentPattern(v)
+	})
+}
+
+// UpdateUserAgentPattern sets the "user_agent_pattern" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateUserAgentPattern() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateUserAgentPattern()
+	})
+}
+
+// ClearUserAgentPattern clears the value of the "user_agent_pattern" field.
+func (u *ClientRuleUpsertBulk) ClearUserAgentPattern() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearUserAgentPattern()
+	})
+}
+
+// SetRequiredHeaders sets the "required_headers" field.
+func (u *ClientRuleUpsertBulk) SetRequiredHeaders(v []string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetRequiredHeaders(v)
+	})
+}
+
+// UpdateRequiredHeaders sets the "required_headers" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateRequiredHeaders() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateRequiredHeaders()
+	})
+}
+
+// ClearRequiredHeaders clears the value of the "required_headers" field.
+func (u *ClientRuleUpsertBulk) ClearRequiredHeaders() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearRequiredHeaders()
+	})
+}
+
+// SetMetadataUserIDPattern sets the "metadata_user_id_pattern" field.
+func (u *ClientRuleUpsertBulk) SetMetadataUserIDPattern(v string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetMetadataUserIDPattern(v)
+	})
+}
+
+// UpdateMetadataUserIDPattern sets the "metadata_user_id_pattern" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateMetadataUserIDPattern() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateMetadataUserIDPattern()
+	})
+}
+
+// ClearMetadataUserIDPattern clears the value of the "metadata_user_id_pattern" field.
+func (u *ClientRuleUpsertBulk) ClearMetadataUserIDPattern() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearMetadataUserIDPattern()
+	})
+}
+
+// SetBodyFields sets the "body_fields" field.
+func (u *ClientRuleUpsertBulk) SetBodyFields(v []string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetBodyFields(v)
+	})
+}
+
+// UpdateBodyFields sets the "body_fields" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateBodyFields() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateBodyFields()
+	})
+}
+
+// ClearBodyFields clears the value of the "body_fields" field.
+func (u *ClientRuleUpsertBulk) ClearBodyFields() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearBodyFields()
+	})
+}
+
+// SetFallbackGroupID sets the "fallback_group_id" field.
+func (u *ClientRuleUpsertBulk) SetFallbackGroupID(v int64) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetFallbackGroupID(v)
+	})
+}
+
+// AddFallbackGroupID adds v to the "fallback_group_id" field.
+func (u *ClientRuleUpsertBulk) AddFallbackGroupID(v int64) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.AddFallbackGroupID(v)
+	})
+}
+
+// UpdateFallbackGroupID sets the "fallback_group_id" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateFallbackGroupID() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateFallbackGroupID()
+	})
+}
+
+// ClearFallbackGroupID clears the value of the "fallback_group_id" field.
+func (u *ClientRuleUpsertBulk) ClearFallbackGroupID() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearFallbackGroupID()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ClientRuleUpsertBulk) SetDescription(v string) *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ClientRuleUpsertBulk) UpdateDescription() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ClientRuleUpsertBulk) ClearDescription() *ClientRuleUpsertBulk {
+	return u.Update(func(s *ClientRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *ClientRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ClientRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ClientRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ClientRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
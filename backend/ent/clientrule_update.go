@@ -0,0 +1,827 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/clientrule"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ClientRuleUpdate is the builder for updating ClientRule entities.
+type ClientRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClientRuleMutation
+}
+
+// Where appends a list predicates to the ClientRuleUpdate builder.
+func (_u *ClientRuleUpdate) Where(ps ...predicate.ClientRule) *ClientRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClientRuleUpdate) SetUpdatedAt(v time.Time) *ClientRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ClientRuleUpdate) SetName(v string) *ClientRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillableName(v *string) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ClientRuleUpdate) SetEnabled(v bool) *ClientRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillableEnabled(v *bool) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *ClientRuleUpdate) SetPriority(v int) *ClientRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillablePriority(v *int) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *ClientRuleUpdate) AddPriority(v int) *ClientRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *ClientRuleUpdate) SetGroupIds(v []int64) *ClientRuleUpdate {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *ClientRuleUpdate) AppendGroupIds(v []int64) *ClientRuleUpdate {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *ClientRuleUpdate) ClearGroupIds() *ClientRuleUpdate {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetPreset sets the "preset" field.
+func (_u *ClientRuleUpdate) SetPreset(v string) *ClientRuleUpdate {
+	_u.mutation.SetPreset(v)
+	return _u
+}
+
+// SetNillablePreset sets the "preset" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillablePreset(v *string) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetPreset(*v)
+	}
+	return _u
+}
+
+// SetUserAgentPattern sets the "user_agent_pattern" field.
+func (_u *ClientRuleUpdate) SetUserAgentPattern(v string) *ClientRuleUpdate {
+	_u.mutation.SetUserAgentPattern(v)
+	return _u
+}
+
+// SetNillableUserAgentPattern sets the "user_agent_pattern" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillableUserAgentPattern(v *string) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetUserAgentPattern(*v)
+	}
+	return _u
+}
+
+// ClearUserAgentPattern clears the value of the "user_agent_pattern" field.
+func (_u *ClientRuleUpdate) ClearUserAgentPattern() *ClientRuleUpdate {
+	_u.mutation.ClearUserAgentPattern()
+	return _u
+}
+
+// SetRequiredHeaders sets the "required_headers" field.
+func (_u *ClientRuleUpdate) SetRequiredHeaders(v []string) *ClientRuleUpdate {
+	_u.mutation.SetRequiredHeaders(v)
+	return _u
+}
+
+// AppendRequiredHeaders appends value to the "required_headers" field.
+func (_u *ClientRuleUpdate) AppendRequiredHeaders(v []string) *ClientRuleUpdate {
+	_u.mutation.AppendRequiredHeaders(v)
+	return _u
+}
+
+// ClearRequiredHeaders clears the value of the "required_headers" field.
+func (_u *ClientRuleUpdate) ClearRequiredHeaders() *ClientRuleUpdate {
+	_u.mutation.ClearRequiredHeaders()
+	return _u
+}
+
+// SetMetadataUserIDPattern sets the "metadata_user_id_pattern" field.
+func (_u *ClientRuleUpdate) SetMetadataUserIDPattern(v string) *ClientRuleUpdate {
+	_u.mutation.SetMetadataUserIDPattern(v)
+	return _u
+}
+
+// SetNillableMetadataUserIDPattern sets the "metadata_user_id_pattern" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillableMetadataUserIDPattern(v *string) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetMetadataUserIDPattern(*v)
+	}
+	return _u
+}
+
+// ClearMetadataUserIDPattern clears the value of the "metadata_user_id_pattern" field.
+func (_u *ClientRuleUpdate) ClearMetadataUserIDPattern() *ClientRuleUpdate {
+	_u.mutation.ClearMetadataUserIDPattern()
+	return _u
+}
+
+// SetBodyFields sets the "body_fields" field.
+func (_u *ClientRuleUpdate) SetBodyFields(v []string) *ClientRuleUpdate {
+	_u.mutation.SetBodyFields(v)
+	return _u
+}
+
+// AppendBodyFields appends value to the "body_fields" field.
+func (_u *ClientRuleUpdate) AppendBodyFields(v []string) *ClientRuleUpdate {
+	_u.mutation.AppendBodyFields(v)
+	return _u
+}
+
+// ClearBodyFields clears the value of the "body_fields" field.
+func (_u *ClientRuleUpdate) ClearBodyFields() *ClientRuleUpdate {
+	_u.mutation.ClearBodyFields()
+	return _u
+}
+
+// SetFallbackGroupID sets the "fallback_group_id" field.
+func (_u *ClientRuleUpdate) SetFallbackGroupID(v int64) *ClientRuleUpdate {
+	_u.mutation.ResetFallbackGroupID()
+	_u.mutation.SetFallbackGroupID(v)
+	return _u
+}
+
+// SetNillableFallbackGroupID sets the "fallback_group_id" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillableFallbackGroupID(v *int64) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetFallbackGroupID(*v)
+	}
+	return _u
+}
+
+// AddFallbackGroupID adds value to the "fallback_group_id" field.
+func (_u *ClientRuleUpdate) AddFallbackGroupID(v int64) *ClientRuleUpdate {
+	_u.mutation.AddFallbackGroupID(v)
+	return _u
+}
+
+// ClearFallbackGroupID clears the value of the "fallback_group_id" field.
+func (_u *ClientRuleUpdate) ClearFallbackGroupID() *ClientRuleUpdate {
+	_u.mutation.ClearFallbackGroupID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ClientRuleUpdate) SetDescription(v string) *ClientRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ClientRuleUpdate) SetNillableDescription(v *string) *ClientRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ClientRuleUpdate) ClearDescription() *ClientRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the ClientRuleMutation object of the builder.
+func (_u *ClientRuleUpdate) Mutation() *ClientRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClientRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClientRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ClientRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := clientrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := clientrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Preset(); ok {
+		if err := clientrule.PresetValidator(v); err != nil {
+			return &ValidationError{Name: "preset", err: fmt.Errorf(`ent: validator failed for field "ClientRule.preset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgentPattern(); ok {
+		if err := clientrule.UserAgentPatternValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent_pattern", err: fmt.Errorf(`ent: validator failed for field "ClientRule.user_agent_pattern": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MetadataUserIDPattern(); ok {
+		if err := clientrule.MetadataUserIDPatternValidator(v); err != nil {
+			return &ValidationError{Name: "metadata_user_id_pattern", err: fmt.Errorf(`ent: validator failed for field "ClientRule.metadata_user_id_pattern": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClientRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientrule.Table, clientrule.Columns, sqlgraph.NewFieldSpec(clientrule.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clientrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(clientrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(clientrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(clientrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(clientrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(clientrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(clientrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Preset(); ok {
+		_spec.SetField(clientrule.FieldPreset, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgentPattern(); ok {
+		_spec.SetField(clientrule.FieldUserAgentPattern, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentPatternCleared() {
+		_spec.ClearField(clientrule.FieldUserAgentPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequiredHeaders(); ok {
+		_spec.SetField(clientrule.FieldRequiredHeaders, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequiredHeaders(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientrule.FieldRequiredHeaders, value)
+		})
+	}
+	if _u.mutation.RequiredHeadersCleared() {
+		_spec.ClearField(clientrule.FieldRequiredHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MetadataUserIDPattern(); ok {
+		_spec.SetField(clientrule.FieldMetadataUserIDPattern, field.TypeString, value)
+	}
+	if _u.mutation.MetadataUserIDPatternCleared() {
+		_spec.ClearField(clientrule.FieldMetadataUserIDPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodyFields(); ok {
+		_spec.SetField(clientrule.FieldBodyFields, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBodyFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientrule.FieldBodyFields, value)
+		})
+	}
+	if _u.mutation.BodyFieldsCleared() {
+		_spec.ClearField(clientrule.FieldBodyFields, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FallbackGroupID(); ok {
+		_spec.SetField(clientrule.FieldFallbackGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFallbackGroupID(); ok {
+		_spec.AddField(clientrule.FieldFallbackGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.FallbackGroupIDCleared() {
+		_spec.ClearField(clientrule.FieldFallbackGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(clientrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(clientrule.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClientRuleUpdateOne is the builder for updating a single ClientRule entity.
+type ClientRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClientRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClientRuleUpdateOne) SetUpdatedAt(v time.Time) *ClientRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ClientRuleUpdateOne) SetName(v string) *ClientRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillableName(v *string) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ClientRuleUpdateOne) SetEnabled(v bool) *ClientRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillableEnabled(v *bool) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *ClientRuleUpdateOne) SetPriority(v int) *ClientRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillablePriority(v *int) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *ClientRuleUpdateOne) AddPriority(v int) *ClientRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *ClientRuleUpdateOne) SetGroupIds(v []int64) *ClientRuleUpdateOne {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *ClientRuleUpdateOne) AppendGroupIds(v []int64) *ClientRuleUpdateOne {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *ClientRuleUpdateOne) ClearGroupIds() *ClientRuleUpdateOne {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetPreset sets the "preset" field.
+func (_u *ClientRuleUpdateOne) SetPreset(v string) *ClientRuleUpdateOne {
+	_u.mutation.SetPreset(v)
+	return _u
+}
+
+// SetNillablePreset sets the "preset" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillablePreset(v *string) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetPreset(*v)
+	}
+	return _u
+}
+
+// SetUserAgentPattern sets the "user_agent_pattern" field.
+func (_u *ClientRuleUpdateOne) SetUserAgentPattern(v string) *ClientRuleUpdateOne {
+	_u.mutation.SetUserAgentPattern(v)
+	return _u
+}
+
+// SetNillableUserAgentPattern sets the "user_agent_pattern" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillableUserAgentPattern(v *string) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetUserAgentPattern(*v)
+	}
+	return _u
+}
+
+// ClearUserAgentPattern clears the value of the "user_agent_pattern" field.
+func (_u *ClientRuleUpdateOne) ClearUserAgentPattern() *ClientRuleUpdateOne {
+	_u.mutation.ClearUserAgentPattern()
+	return _u
+}
+
+// SetRequiredHeaders sets the "required_headers" field.
+func (_u *ClientRuleUpdateOne) SetRequiredHeaders(v []string) *ClientRuleUpdateOne {
+	_u.mutation.SetRequiredHeaders(v)
+	return _u
+}
+
+// AppendRequiredHeaders appends value to the "required_headers" field.
+func (_u *ClientRuleUpdateOne) AppendRequiredHeaders(v []string) *ClientRuleUpdateOne {
+	_u.mutation.AppendRequiredHeaders(v)
+	return _u
+}
+
+// ClearRequiredHeaders clears the value of the "required_headers" field.
+func (_u *ClientRuleUpdateOne) ClearRequiredHeaders() *ClientRuleUpdateOne {
+	_u.mutation.ClearRequiredHeaders()
+	return _u
+}
+
+// SetMetadataUserIDPattern sets the "metadata_user_id_pattern" field.
+func (_u *ClientRuleUpdateOne) SetMetadataUserIDPattern(v string) *ClientRuleUpdateOne {
+	_u.mutation.SetMetadataUserIDPattern(v)
+	return _u
+}
+
+// SetNillableMetadataUserIDPattern sets the "metadata_user_id_pattern" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillableMetadataUserIDPattern(v *string) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetMetadataUserIDPattern(*v)
+	}
+	return _u
+}
+
+// ClearMetadataUserIDPattern clears the value of the "metadata_user_id_pattern" field.
+func (_u *ClientRuleUpdateOne) ClearMetadataUserIDPattern() *ClientRuleUpdateOne {
+	_u.mutation.ClearMetadataUserIDPattern()
+	return _u
+}
+
+// SetBodyFields sets the "body_fields" field.
+func (_u *ClientRuleUpdateOne) SetBodyFields(v []string) *ClientRuleUpdateOne {
+	_u.mutation.SetBodyFields(v)
+	return _u
+}
+
+// AppendBodyFields appends value to the "body_fields" field.
+func (_u *ClientRuleUpdateOne) AppendBodyFields(v []string) *ClientRuleUpdateOne {
+	_u.mutation.AppendBodyFields(v)
+	return _u
+}
+
+// ClearBodyFields clears the value of the "body_fields" field.
+func (_u *ClientRuleUpdateOne) ClearBodyFields() *ClientRuleUpdateOne {
+	_u.mutation.ClearBodyFields()
+	return _u
+}
+
+// SetFallbackGroupID sets the "fallback_group_id" field.
+func (_u *ClientRuleUpdateOne) SetFallbackGroupID(v int64) *ClientRuleUpdateOne {
+	_u.mutation.ResetFallbackGroupID()
+	_u.mutation.SetFallbackGroupID(v)
+	return _u
+}
+
+// SetNillableFallbackGroupID sets the "fallback_group_id" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillableFallbackGroupID(v *int64) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetFallbackGroupID(*v)
+	}
+	return _u
+}
+
+// AddFallbackGroupID adds value to the "fallback_group_id" field.
+func (_u *ClientRuleUpdateOne) AddFallbackGroupID(v int64) *ClientRuleUpdateOne {
+	_u.mutation.AddFallbackGroupID(v)
+	return _u
+}
+
+// ClearFallbackGroupID clears the value of the "fallback_group_id" field.
+func (_u *ClientRuleUpdateOne) ClearFallbackGroupID() *ClientRuleUpdateOne {
+	_u.mutation.ClearFallbackGroupID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ClientRuleUpdateOne) SetDescription(v string) *ClientRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ClientRuleUpdateOne) SetNillableDescription(v *string) *ClientRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ClientRuleUpdateOne) ClearDescription() *ClientRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the ClientRuleMutation object of the builder.
+func (_u *ClientRuleUpdateOne) Mutation() *ClientRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClientRuleUpdate builder.
+func (_u *ClientRuleUpdateOne) Where(ps ...predicate.ClientRule) *ClientRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClientRuleUpdateOne) Select(field string, fields ...string) *ClientRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClientRule entity.
+func (_u *ClientRuleUpdateOne) Save(ctx context.Context) (*ClientRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClientRuleUpdateOne) SaveX(ctx context.Context) *ClientRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClientRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClientRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ClientRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := clientrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClientRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := clientrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Preset(); ok {
+		if err := clientrule.PresetValidator(v); err != nil {
+			return &ValidationError{Name: "preset", err: fmt.Errorf(`ent: validator failed for field "ClientRule.preset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgentPattern(); ok {
+		if err := clientrule.UserAgentPatternValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent_pattern", err: fmt.Errorf(`ent: validator failed for field "ClientRule.user_agent_pattern": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MetadataUserIDPattern(); ok {
+		if err := clientrule.MetadataUserIDPatternValidator(v); err != nil {
+			return &ValidationError{Name: "metadata_user_id_pattern", err: fmt.Errorf(`ent: validator failed for field "ClientRule.metadata_user_id_pattern": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClientRuleUpdateOne) sqlSave(ctx context.Context) (_node *ClientRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientrule.Table, clientrule.Columns, sqlgraph.NewFieldSpec(clientrule.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientrule.FieldID)
+		for _, f := range fields {
+			if !clientrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clientrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clientrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(clientrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(clientrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(clientrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(clientrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(clientrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(clientrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Preset(); ok {
+		_spec.SetField(clientrule.FieldPreset, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgentPattern(); ok {
+		_spec.SetField(clientrule.FieldUserAgentPattern, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentPatternCleared() {
+		_spec.ClearField(clientrule.FieldUserAgentPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequiredHeaders(); ok {
+		_spec.SetField(clientrule.FieldRequiredHeaders, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequiredHeaders(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientrule.FieldRequiredHeaders, value)
+		})
+	}
+	if _u.mutation.RequiredHeadersCleared() {
+		_spec.ClearField(clientrule.FieldRequiredHeaders, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MetadataUserIDPattern(); ok {
+		_spec.SetField(clientrule.FieldMetadataUserIDPattern, field.TypeString, value)
+	}
+	if _u.mutation.MetadataUserIDPatternCleared() {
+		_spec.ClearField(clientrule.FieldMetadataUserIDPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodyFields(); ok {
+		_spec.SetField(clientrule.FieldBodyFields, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBodyFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, clientrule.FieldBodyFields, value)
+		})
+	}
+	if _u.mutation.BodyFieldsCleared() {
+		_spec.ClearField(clientrule.FieldBodyFields, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FallbackGroupID(); ok {
+		_spec.SetField(clientrule.FieldFallbackGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFallbackGroupID(); ok {
+		_spec.AddField(clientrule.FieldFallbackGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.FallbackGroupIDCleared() {
+		_spec.ClearField(clientrule.FieldFallbackGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(clientrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(clientrule.FieldDescription, field.TypeString)
+	}
+	_node = &ClientRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
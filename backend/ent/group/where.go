@@ -170,6 +170,16 @@ func SortOrder(v int) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldSortOrder, v))
 }
 
+// SchedulingStrategy applies equality check predicate on the "scheduling_strategy" field. It's identical to SchedulingStrategyEQ.
+func SchedulingStrategy(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldSchedulingStrategy, v))
+}
+
+// FirstByteTimeoutSeconds applies equality check predicate on the "first_byte_timeout_seconds" field. It's identical to FirstByteTimeoutSecondsEQ.
+func FirstByteTimeoutSeconds(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldFirstByteTimeoutSeconds, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -1205,6 +1215,111 @@ func SortOrderLTE(v int) predicate.Group {
 	return predicate.Group(sql.FieldLTE(FieldSortOrder, v))
 }
 
+// SchedulingStrategyEQ applies the EQ predicate on the "scheduling_strategy" field.
+func SchedulingStrategyEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyNEQ applies the NEQ predicate on the "scheduling_strategy" field.
+func SchedulingStrategyNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyIn applies the In predicate on the "scheduling_strategy" field.
+func SchedulingStrategyIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldSchedulingStrategy, vs...))
+}
+
+// SchedulingStrategyNotIn applies the NotIn predicate on the "scheduling_strategy" field.
+func SchedulingStrategyNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldSchedulingStrategy, vs...))
+}
+
+// SchedulingStrategyGT applies the GT predicate on the "scheduling_strategy" field.
+func SchedulingStrategyGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyGTE applies the GTE predicate on the "scheduling_strategy" field.
+func SchedulingStrategyGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyLT applies the LT predicate on the "scheduling_strategy" field.
+func SchedulingStrategyLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyLTE applies the LTE predicate on the "scheduling_strategy" field.
+func SchedulingStrategyLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyContains applies the Contains predicate on the "scheduling_strategy" field.
+func SchedulingStrategyContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyHasPrefix applies the HasPrefix predicate on the "scheduling_strategy" field.
+func SchedulingStrategyHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyHasSuffix applies the HasSuffix predicate on the "scheduling_strategy" field.
+func SchedulingStrategyHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyEqualFold applies the EqualFold predicate on the "scheduling_strategy" field.
+func SchedulingStrategyEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyContainsFold applies the ContainsFold predicate on the "scheduling_strategy" field.
+func SchedulingStrategyContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldSchedulingStrategy, v))
+}
+
+// FirstByteTimeoutSecondsEQ applies the EQ predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldFirstByteTimeoutSeconds, v))
+}
+
+// FirstByteTimeoutSecondsNEQ applies the NEQ predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldFirstByteTimeoutSeconds, v))
+}
+
+// FirstByteTimeoutSecondsIn applies the In predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldFirstByteTimeoutSeconds, vs...))
+}
+
+// FirstByteTimeoutSecondsNotIn applies the NotIn predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldFirstByteTimeoutSeconds, vs...))
+}
+
+// FirstByteTimeoutSecondsGT applies the GT predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldFirstByteTimeoutSeconds, v))
+}
+
+// FirstByteTimeoutSecondsGTE applies the GTE predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldFirstByteTimeoutSeconds, v))
+}
+
+// FirstByteTimeoutSecondsLT applies the LT predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldFirstByteTimeoutSeconds, v))
+}
+
+// FirstByteTimeoutSecondsLTE applies the LTE predicate on the "first_byte_timeout_seconds" field.
+func FirstByteTimeoutSecondsLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldFirstByteTimeoutSeconds, v))
+}
+
 // HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
 func HasAPIKeys() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
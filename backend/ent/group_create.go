@@ -18,6 +18,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
 )
 
 // GroupCreate is the builder for creating a Group entity.
@@ -354,6 +355,46 @@ func (_c *GroupCreate) SetNillableSortOrder(v *int) *GroupCreate {
 	return _c
 }
 
+// SetModelFallbacks sets the "model_fallbacks" field.
+func (_c *GroupCreate) SetModelFallbacks(v []domain.ModelFallbackRule) *GroupCreate {
+	_c.mutation.SetModelFallbacks(v)
+	return _c
+}
+
+// SetModelRateMultipliers sets the "model_rate_multipliers" field.
+func (_c *GroupCreate) SetModelRateMultipliers(v map[string]float64) *GroupCreate {
+	_c.mutation.SetModelRateMultipliers(v)
+	return _c
+}
+
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (_c *GroupCreate) SetSchedulingStrategy(v string) *GroupCreate {
+	_c.mutation.SetSchedulingStrategy(v)
+	return _c
+}
+
+// SetNillableSchedulingStrategy sets the "scheduling_strategy" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableSchedulingStrategy(v *string) *GroupCreate {
+	if v != nil {
+		_c.SetSchedulingStrategy(*v)
+	}
+	return _c
+}
+
+// SetFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field.
+func (_c *GroupCreate) SetFirstByteTimeoutSeconds(v int) *GroupCreate {
+	_c.mutation.SetFirstByteTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableFirstByteTimeoutSeconds(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetFirstByteTimeoutSeconds(*v)
+	}
+	return _c
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_c *GroupCreate) AddAPIKeyIDs(ids ...int64) *GroupCreate {
 	_c.mutation.AddAPIKeyIDs(ids...)
@@ -539,6 +580,22 @@ func (_c *GroupCreate) defaults() error {
 		v := group.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
 	}
+	if _, ok := _c.mutation.ModelFallbacks(); !ok {
+		v := group.DefaultModelFallbacks
+		_c.mutation.SetModelFallbacks(v)
+	}
+	if _, ok := _c.mutation.ModelRateMultipliers(); !ok {
+		v := group.DefaultModelRateMultipliers
+		_c.mutation.SetModelRateMultipliers(v)
+	}
+	if _, ok := _c.mutation.SchedulingStrategy(); !ok {
+		v := group.DefaultSchedulingStrategy
+		_c.mutation.SetSchedulingStrategy(v)
+	}
+	if _, ok := _c.mutation.FirstByteTimeoutSeconds(); !ok {
+		v := group.DefaultFirstByteTimeoutSeconds
+		_c.mutation.SetFirstByteTimeoutSeconds(v)
+	}
 	return nil
 }
 
@@ -606,6 +663,23 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.SortOrder(); !ok {
 		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Group.sort_order"`)}
 	}
+	if _, ok := _c.mutation.ModelFallbacks(); !ok {
+		return &ValidationError{Name: "model_fallbacks", err: errors.New(`ent: missing required field "Group.model_fallbacks"`)}
+	}
+	if _, ok := _c.mutation.ModelRateMultipliers(); !ok {
+		return &ValidationError{Name: "model_rate_multipliers", err: errors.New(`ent: missing required field "Group.model_rate_multipliers"`)}
+	}
+	if _, ok := _c.mutation.SchedulingStrategy(); !ok {
+		return &ValidationError{Name: "scheduling_strategy", err: errors.New(`ent: missing required field "Group.scheduling_strategy"`)}
+	}
+	if v, ok := _c.mutation.SchedulingStrategy(); ok {
+		if err := group.SchedulingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "scheduling_strategy", err: fmt.Errorf(`ent: validator failed for field "Group.scheduling_strategy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstByteTimeoutSeconds(); !ok {
+		return &ValidationError{Name: "first_byte_timeout_seconds", err: errors.New(`ent: missing required field "Group.first_byte_timeout_seconds"`)}
+	}
 	return nil
 }
 
@@ -733,6 +807,22 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldSortOrder, field.TypeInt, value)
 		_node.SortOrder = value
 	}
+	if value, ok := _c.mutation.ModelFallbacks(); ok {
+		_spec.SetField(group.FieldModelFallbacks, field.TypeJSON, value)
+		_node.ModelFallbacks = value
+	}
+	if value, ok := _c.mutation.ModelRateMultipliers(); ok {
+		_spec.SetField(group.FieldModelRateMultipliers, field.TypeJSON, value)
+		_node.ModelRateMultipliers = value
+	}
+	if value, ok := _c.mutation.SchedulingStrategy(); ok {
+		_spec.SetField(group.FieldSchedulingStrategy, field.TypeString, value)
+		_node.SchedulingStrategy = value
+	}
+	if value, ok := _c.mutation.FirstByteTimeoutSeconds(); ok {
+		_spec.SetField(group.FieldFirstByteTimeoutSeconds, field.TypeInt, value)
+		_node.FirstByteTimeoutSeconds = value
+	}
 	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1309,6 +1399,60 @@ func (u *GroupUpsert) AddSortOrder(v int) *GroupUpsert {
 	return u
 }
 
+// SetModelFallbacks sets the "model_fallbacks" field.
+func (u *GroupUpsert) SetModelFallbacks(v []domain.ModelFallbackRule) *GroupUpsert {
+	u.Set(group.FieldModelFallbacks, v)
+	return u
+}
+
+// UpdateModelFallbacks sets the "model_fallbacks" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateModelFallbacks() *GroupUpsert {
+	u.SetExcluded(group.FieldModelFallbacks)
+	return u
+}
+
+// SetModelRateMultipliers sets the "model_rate_multipliers" field.
+func (u *GroupUpsert) SetModelRateMultipliers(v map[string]float64) *GroupUpsert {
+	u.Set(group.FieldModelRateMultipliers, v)
+	return u
+}
+
+// UpdateModelRateMultipliers sets the "model_rate_multipliers" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateModelRateMultipliers() *GroupUpsert {
+	u.SetExcluded(group.FieldModelRateMultipliers)
+	return u
+}
+
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (u *GroupUpsert) SetSchedulingStrategy(v string) *GroupUpsert {
+	u.Set(group.FieldSchedulingStrategy, v)
+	return u
+}
+
+// UpdateSchedulingStrategy sets the "scheduling_strategy" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateSchedulingStrategy() *GroupUpsert {
+	u.SetExcluded(group.FieldSchedulingStrategy)
+	return u
+}
+
+// SetFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field.
+func (u *GroupUpsert) SetFirstByteTimeoutSeconds(v int) *GroupUpsert {
+	u.Set(group.FieldFirstByteTimeoutSeconds, v)
+	return u
+}
+
+// UpdateFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateFirstByteTimeoutSeconds() *GroupUpsert {
+	u.SetExcluded(group.FieldFirstByteTimeoutSeconds)
+	return u
+}
+
+// AddFirstByteTimeoutSeconds adds v to the "first_byte_timeout_seconds" field.
+func (u *GroupUpsert) AddFirstByteTimeoutSeconds(v int) *GroupUpsert {
+	u.Add(group.FieldFirstByteTimeoutSeconds, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1844,6 +1988,69 @@ func (u *GroupUpsertOne) UpdateSortOrder() *GroupUpsertOne {
 	})
 }
 
+// SetModelFallbacks sets the "model_fallbacks" field.
+func (u *GroupUpsertOne) SetModelFallbacks(v []domain.ModelFallbackRule) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetModelFallbacks(v)
+	})
+}
+
+// UpdateModelFallbacks sets the "model_fallbacks" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateModelFallbacks() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateModelFallbacks()
+	})
+}
+
+// SetModelRateMultipliers sets the "model_rate_multipliers" field.
+func (u *GroupUpsertOne) SetModelRateMultipliers(v map[string]float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetModelRateMultipliers(v)
+	})
+}
+
+// UpdateModelRateMultipliers sets the "model_rate_multipliers" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateModelRateMultipliers() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateModelRateMultipliers()
+	})
+}
+
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (u *GroupUpsertOne) SetSchedulingStrategy(v string) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetSchedulingStrategy(v)
+	})
+}
+
+// UpdateSchedulingStrategy sets the "scheduling_strategy" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateSchedulingStrategy() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateSchedulingStrategy()
+	})
+}
+
+// SetFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field.
+func (u *GroupUpsertOne) SetFirstByteTimeoutSeconds(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetFirstByteTimeoutSeconds(v)
+	})
+}
+
+// AddFirstByteTimeoutSeconds adds v to the "first_byte_timeout_seconds" field.
+func (u *GroupUpsertOne) AddFirstByteTimeoutSeconds(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddFirstByteTimeoutSeconds(v)
+	})
+}
+
+// UpdateFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateFirstByteTimeoutSeconds() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateFirstByteTimeoutSeconds()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2545,6 +2752,69 @@ func (u *GroupUpsertBulk) UpdateSortOrder() *GroupUpsertBulk {
 	})
 }
 
+// SetModelFallbacks sets the "model_fallbacks" field.
+func (u *GroupUpsertBulk) SetModelFallbacks(v []domain.ModelFallbackRule) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetModelFallbacks(v)
+	})
+}
+
+// UpdateModelFallbacks sets the "model_fallbacks" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateModelFallbacks() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateModelFallbacks()
+	})
+}
+
+// SetModelRateMultipliers sets the "model_rate_multipliers" field.
+func (u *GroupUpsertBulk) SetModelRateMultipliers(v map[string]float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetModelRateMultipliers(v)
+	})
+}
+
+// UpdateModelRateMultipliers sets the "model_rate_multipliers" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateModelRateMultipliers() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateModelRateMultipliers()
+	})
+}
+
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (u *GroupUpsertBulk) SetSchedulingStrategy(v string) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetSchedulingStrategy(v)
+	})
+}
+
+// UpdateSchedulingStrategy sets the "scheduling_strategy" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateSchedulingStrategy() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateSchedulingStrategy()
+	})
+}
+
+// SetFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field.
+func (u *GroupUpsertBulk) SetFirstByteTimeoutSeconds(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetFirstByteTimeoutSeconds(v)
+	})
+}
+
+// AddFirstByteTimeoutSeconds adds v to the "first_byte_timeout_seconds" field.
+func (u *GroupUpsertBulk) AddFirstByteTimeoutSeconds(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddFirstByteTimeoutSeconds(v)
+	})
+}
+
+// UpdateFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateFirstByteTimeoutSeconds() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateFirstByteTimeoutSeconds()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
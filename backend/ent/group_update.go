@@ -20,6 +20,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
 )
 
 // GroupUpdate is the builder for updating Group entities.
@@ -496,6 +497,59 @@ func (_u *GroupUpdate) AddSortOrder(v int) *GroupUpdate {
 	return _u
 }
 
+// SetModelFallbacks sets the "model_fallbacks" field.
+func (_u *GroupUpdate) SetModelFallbacks(v []domain.ModelFallbackRule) *GroupUpdate {
+	_u.mutation.SetModelFallbacks(v)
+	return _u
+}
+
+// AppendModelFallbacks appends value to the "model_fallbacks" field.
+func (_u *GroupUpdate) AppendModelFallbacks(v []domain.ModelFallbackRule) *GroupUpdate {
+	_u.mutation.AppendModelFallbacks(v)
+	return _u
+}
+
+// SetModelRateMultipliers sets the "model_rate_multipliers" field.
+func (_u *GroupUpdate) SetModelRateMultipliers(v map[string]float64) *GroupUpdate {
+	_u.mutation.SetModelRateMultipliers(v)
+	return _u
+}
+
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (_u *GroupUpdate) SetSchedulingStrategy(v string) *GroupUpdate {
+	_u.mutation.SetSchedulingStrategy(v)
+	return _u
+}
+
+// SetNillableSchedulingStrategy sets the "scheduling_strategy" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableSchedulingStrategy(v *string) *GroupUpdate {
+	if v != nil {
+		_u.SetSchedulingStrategy(*v)
+	}
+	return _u
+}
+
+// SetFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field.
+func (_u *GroupUpdate) SetFirstByteTimeoutSeconds(v int) *GroupUpdate {
+	_u.mutation.ResetFirstByteTimeoutSeconds()
+	_u.mutation.SetFirstByteTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableFirstByteTimeoutSeconds(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetFirstByteTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddFirstByteTimeoutSeconds adds value to the "first_byte_timeout_seconds" field.
+func (_u *GroupUpdate) AddFirstByteTimeoutSeconds(v int) *GroupUpdate {
+	_u.mutation.AddFirstByteTimeoutSeconds(v)
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdate) AddAPIKeyIDs(ids ...int64) *GroupUpdate {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -781,6 +835,11 @@ func (_u *GroupUpdate) check() error {
 			return &ValidationError{Name: "subscription_type", err: fmt.Errorf(`ent: validator failed for field "Group.subscription_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SchedulingStrategy(); ok {
+		if err := group.SchedulingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "scheduling_strategy", err: fmt.Errorf(`ent: validator failed for field "Group.scheduling_strategy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -939,6 +998,26 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSortOrder(); ok {
 		_spec.AddField(group.FieldSortOrder, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ModelFallbacks(); ok {
+		_spec.SetField(group.FieldModelFallbacks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelFallbacks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldModelFallbacks, value)
+		})
+	}
+	if value, ok := _u.mutation.ModelRateMultipliers(); ok {
+		_spec.SetField(group.FieldModelRateMultipliers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.SchedulingStrategy(); ok {
+		_spec.SetField(group.FieldSchedulingStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FirstByteTimeoutSeconds(); ok {
+		_spec.SetField(group.FieldFirstByteTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFirstByteTimeoutSeconds(); ok {
+		_spec.AddField(group.FieldFirstByteTimeoutSeconds, field.TypeInt, value)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1714,6 +1793,59 @@ func (_u *GroupUpdateOne) AddSortOrder(v int) *GroupUpdateOne {
 	return _u
 }
 
+// SetModelFallbacks sets the "model_fallbacks" field.
+func (_u *GroupUpdateOne) SetModelFallbacks(v []domain.ModelFallbackRule) *GroupUpdateOne {
+	_u.mutation.SetModelFallbacks(v)
+	return _u
+}
+
+// AppendModelFallbacks appends value to the "model_fallbacks" field.
+func (_u *GroupUpdateOne) AppendModelFallbacks(v []domain.ModelFallbackRule) *GroupUpdateOne {
+	_u.mutation.AppendModelFallbacks(v)
+	return _u
+}
+
+// SetModelRateMultipliers sets the "model_rate_multipliers" field.
+func (_u *GroupUpdateOne) SetModelRateMultipliers(v map[string]float64) *GroupUpdateOne {
+	_u.mutation.SetModelRateMultipliers(v)
+	return _u
+}
+
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (_u *GroupUpdateOne) SetSchedulingStrategy(v string) *GroupUpdateOne {
+	_u.mutation.SetSchedulingStrategy(v)
+	return _u
+}
+
+// SetNillableSchedulingStrategy sets the "scheduling_strategy" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableSchedulingStrategy(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetSchedulingStrategy(*v)
+	}
+	return _u
+}
+
+// SetFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field.
+func (_u *GroupUpdateOne) SetFirstByteTimeoutSeconds(v int) *GroupUpdateOne {
+	_u.mutation.ResetFirstByteTimeoutSeconds()
+	_u.mutation.SetFirstByteTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableFirstByteTimeoutSeconds sets the "first_byte_timeout_seconds" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableFirstByteTimeoutSeconds(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetFirstByteTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddFirstByteTimeoutSeconds adds value to the "first_byte_timeout_seconds" field.
+func (_u *GroupUpdateOne) AddFirstByteTimeoutSeconds(v int) *GroupUpdateOne {
+	_u.mutation.AddFirstByteTimeoutSeconds(v)
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdateOne) AddAPIKeyIDs(ids ...int64) *GroupUpdateOne {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -2012,6 +2144,11 @@ func (_u *GroupUpdateOne) check() error {
 			return &ValidationError{Name: "subscription_type", err: fmt.Errorf(`ent: validator failed for field "Group.subscription_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SchedulingStrategy(); ok {
+		if err := group.SchedulingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "scheduling_strategy", err: fmt.Errorf(`ent: validator failed for field "Group.scheduling_strategy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -2187,6 +2324,26 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 	if value, ok := _u.mutation.AddedSortOrder(); ok {
 		_spec.AddField(group.FieldSortOrder, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ModelFallbacks(); ok {
+		_spec.SetField(group.FieldModelFallbacks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelFallbacks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldModelFallbacks, value)
+		})
+	}
+	if value, ok := _u.mutation.ModelRateMultipliers(); ok {
+		_spec.SetField(group.FieldModelRateMultipliers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.SchedulingStrategy(); ok {
+		_spec.SetField(group.FieldSchedulingStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FirstByteTimeoutSeconds(); ok {
+		_spec.SetField(group.FieldFirstByteTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFirstByteTimeoutSeconds(); ok {
+		_spec.AddField(group.FieldFirstByteTimeoutSeconds, field.TypeInt, value)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
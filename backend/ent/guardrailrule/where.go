@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guardrailrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// MatchType applies equality check predicate on the "match_type" field. It's identical to MatchTypeEQ.
+func MatchType(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldMatchType, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldAction, v))
+}
+
+// BlockMessage applies equality check predicate on the "block_message" field. It's identical to BlockMessageEQ.
+func BlockMessage(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldBlockMessage, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// GroupIdsIsNil applies the IsNil predicate on the "group_ids" field.
+func GroupIdsIsNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIsNull(FieldGroupIds))
+}
+
+// GroupIdsNotNil applies the NotNil predicate on the "group_ids" field.
+func GroupIdsNotNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotNull(FieldGroupIds))
+}
+
+// MatchTypeEQ applies the EQ predicate on the "match_type" field.
+func MatchTypeEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldMatchType, v))
+}
+
+// MatchTypeNEQ applies the NEQ predicate on the "match_type" field.
+func MatchTypeNEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldMatchType, v))
+}
+
+// MatchTypeIn applies the In predicate on the "match_type" field.
+func MatchTypeIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldMatchType, vs...))
+}
+
+// MatchTypeNotIn applies the NotIn predicate on the "match_type" field.
+func MatchTypeNotIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldMatchType, vs...))
+}
+
+// MatchTypeGT applies the GT predicate on the "match_type" field.
+func MatchTypeGT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldMatchType, v))
+}
+
+// MatchTypeGTE applies the GTE predicate on the "match_type" field.
+func MatchTypeGTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldMatchType, v))
+}
+
+// MatchTypeLT applies the LT predicate on the "match_type" field.
+func MatchTypeLT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldMatchType, v))
+}
+
+// MatchTypeLTE applies the LTE predicate on the "match_type" field.
+func MatchTypeLTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldMatchType, v))
+}
+
+// MatchTypeContains applies the Contains predicate on the "match_type" field.
+func MatchTypeContains(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContains(FieldMatchType, v))
+}
+
+// MatchTypeHasPrefix applies the HasPrefix predicate on the "match_type" field.
+func MatchTypeHasPrefix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasPrefix(FieldMatchType, v))
+}
+
+// MatchTypeHasSuffix applies the HasSuffix predicate on the "match_type" field.
+func MatchTypeHasSuffix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasSuffix(FieldMatchType, v))
+}
+
+// MatchTypeEqualFold applies the EqualFold predicate on the "match_type" field.
+func MatchTypeEqualFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEqualFold(FieldMatchType, v))
+}
+
+// MatchTypeContainsFold applies the ContainsFold predicate on the "match_type" field.
+func MatchTypeContainsFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContainsFold(FieldMatchType, v))
+}
+
+// PatternsIsNil applies the IsNil predicate on the "patterns" field.
+func PatternsIsNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIsNull(FieldPatterns))
+}
+
+// PatternsNotNil applies the NotNil predicate on the "patterns" field.
+func PatternsNotNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotNull(FieldPatterns))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContainsFold(FieldAction, v))
+}
+
+// BlockMessageEQ applies the EQ predicate on the "block_message" field.
+func BlockMessageEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldBlockMessage, v))
+}
+
+// BlockMessageNEQ applies the NEQ predicate on the "block_message" field.
+func BlockMessageNEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldBlockMessage, v))
+}
+
+// BlockMessageIn applies the In predicate on the "block_message" field.
+func BlockMessageIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldBlockMessage, vs...))
+}
+
+// BlockMessageNotIn applies the NotIn predicate on the "block_message" field.
+func BlockMessageNotIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldBlockMessage, vs...))
+}
+
+// BlockMessageGT applies the GT predicate on the "block_message" field.
+func BlockMessageGT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldBlockMessage, v))
+}
+
+// BlockMessageGTE applies the GTE predicate on the "block_message" field.
+func BlockMessageGTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldBlockMessage, v))
+}
+
+// BlockMessageLT applies the LT predicate on the "block_message" field.
+func BlockMessageLT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldBlockMessage, v))
+}
+
+// BlockMessageLTE applies the LTE predicate on the "block_message" field.
+func BlockMessageLTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldBlockMessage, v))
+}
+
+// BlockMessageContains applies the Contains predicate on the "block_message" field.
+func BlockMessageContains(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContains(FieldBlockMessage, v))
+}
+
+// BlockMessageHasPrefix applies the HasPrefix predicate on the "block_message" field.
+func BlockMessageHasPrefix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasPrefix(FieldBlockMessage, v))
+}
+
+// BlockMessageHasSuffix applies the HasSuffix predicate on the "block_message" field.
+func BlockMessageHasSuffix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasSuffix(FieldBlockMessage, v))
+}
+
+// BlockMessageIsNil applies the IsNil predicate on the "block_message" field.
+func BlockMessageIsNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIsNull(FieldBlockMessage))
+}
+
+// BlockMessageNotNil applies the NotNil predicate on the "block_message" field.
+func BlockMessageNotNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotNull(FieldBlockMessage))
+}
+
+// BlockMessageEqualFold applies the EqualFold predicate on the "block_message" field.
+func BlockMessageEqualFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEqualFold(FieldBlockMessage, v))
+}
+
+// BlockMessageContainsFold applies the ContainsFold predicate on the "block_message" field.
+func BlockMessageContainsFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContainsFold(FieldBlockMessage, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GuardrailRule) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GuardrailRule) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GuardrailRule) predicate.GuardrailRule {
+	return predicate.GuardrailRule(sql.NotPredicates(p))
+}
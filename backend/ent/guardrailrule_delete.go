@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// GuardrailRuleDelete is the builder for deleting a GuardrailRule entity.
+type GuardrailRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *GuardrailRuleMutation
+}
+
+// Where appends a list predicates to the GuardrailRuleDelete builder.
+func (_d *GuardrailRuleDelete) Where(ps ...predicate.GuardrailRule) *GuardrailRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GuardrailRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GuardrailRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GuardrailRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(guardrailrule.Table, sqlgraph.NewFieldSpec(guardrailrule.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GuardrailRuleDeleteOne is the builder for deleting a single GuardrailRule entity.
+type GuardrailRuleDeleteOne struct {
+	_d *GuardrailRuleDelete
+}
+
+// Where appends a list predicates to the GuardrailRuleDelete builder.
+func (_d *GuardrailRuleDeleteOne) Where(ps ...predicate.GuardrailRule) *GuardrailRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GuardrailRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{guardrailrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GuardrailRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
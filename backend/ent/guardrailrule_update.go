@@ -0,0 +1,669 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// GuardrailRuleUpdate is the builder for updating GuardrailRule entities.
+type GuardrailRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuardrailRuleMutation
+}
+
+// Where appends a list predicates to the GuardrailRuleUpdate builder.
+func (_u *GuardrailRuleUpdate) Where(ps ...predicate.GuardrailRule) *GuardrailRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GuardrailRuleUpdate) SetUpdatedAt(v time.Time) *GuardrailRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GuardrailRuleUpdate) SetName(v string) *GuardrailRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillableName(v *string) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *GuardrailRuleUpdate) SetEnabled(v bool) *GuardrailRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillableEnabled(v *bool) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *GuardrailRuleUpdate) SetPriority(v int) *GuardrailRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillablePriority(v *int) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *GuardrailRuleUpdate) AddPriority(v int) *GuardrailRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *GuardrailRuleUpdate) SetGroupIds(v []int64) *GuardrailRuleUpdate {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *GuardrailRuleUpdate) AppendGroupIds(v []int64) *GuardrailRuleUpdate {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *GuardrailRuleUpdate) ClearGroupIds() *GuardrailRuleUpdate {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetMatchType sets the "match_type" field.
+func (_u *GuardrailRuleUpdate) SetMatchType(v string) *GuardrailRuleUpdate {
+	_u.mutation.SetMatchType(v)
+	return _u
+}
+
+// SetNillableMatchType sets the "match_type" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillableMatchType(v *string) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetMatchType(*v)
+	}
+	return _u
+}
+
+// SetPatterns sets the "patterns" field.
+func (_u *GuardrailRuleUpdate) SetPatterns(v []string) *GuardrailRuleUpdate {
+	_u.mutation.SetPatterns(v)
+	return _u
+}
+
+// AppendPatterns appends value to the "patterns" field.
+func (_u *GuardrailRuleUpdate) AppendPatterns(v []string) *GuardrailRuleUpdate {
+	_u.mutation.AppendPatterns(v)
+	return _u
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (_u *GuardrailRuleUpdate) ClearPatterns() *GuardrailRuleUpdate {
+	_u.mutation.ClearPatterns()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *GuardrailRuleUpdate) SetAction(v string) *GuardrailRuleUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillableAction(v *string) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetBlockMessage sets the "block_message" field.
+func (_u *GuardrailRuleUpdate) SetBlockMessage(v string) *GuardrailRuleUpdate {
+	_u.mutation.SetBlockMessage(v)
+	return _u
+}
+
+// SetNillableBlockMessage sets the "block_message" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillableBlockMessage(v *string) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetBlockMessage(*v)
+	}
+	return _u
+}
+
+// ClearBlockMessage clears the value of the "block_message" field.
+func (_u *GuardrailRuleUpdate) ClearBlockMessage() *GuardrailRuleUpdate {
+	_u.mutation.ClearBlockMessage()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GuardrailRuleUpdate) SetDescription(v string) *GuardrailRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GuardrailRuleUpdate) SetNillableDescription(v *string) *GuardrailRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GuardrailRuleUpdate) ClearDescription() *GuardrailRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the GuardrailRuleMutation object of the builder.
+func (_u *GuardrailRuleUpdate) Mutation() *GuardrailRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GuardrailRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuardrailRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GuardrailRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuardrailRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GuardrailRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := guardrailrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GuardrailRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := guardrailrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MatchType(); ok {
+		if err := guardrailrule.MatchTypeValidator(v); err != nil {
+			return &ValidationError{Name: "match_type", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.match_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := guardrailrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GuardrailRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guardrailrule.Table, guardrailrule.Columns, sqlgraph.NewFieldSpec(guardrailrule.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(guardrailrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(guardrailrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(guardrailrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(guardrailrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(guardrailrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(guardrailrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, guardrailrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(guardrailrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MatchType(); ok {
+		_spec.SetField(guardrailrule.FieldMatchType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Patterns(); ok {
+		_spec.SetField(guardrailrule.FieldPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, guardrailrule.FieldPatterns, value)
+		})
+	}
+	if _u.mutation.PatternsCleared() {
+		_spec.ClearField(guardrailrule.FieldPatterns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(guardrailrule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockMessage(); ok {
+		_spec.SetField(guardrailrule.FieldBlockMessage, field.TypeString, value)
+	}
+	if _u.mutation.BlockMessageCleared() {
+		_spec.ClearField(guardrailrule.FieldBlockMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(guardrailrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(guardrailrule.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guardrailrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GuardrailRuleUpdateOne is the builder for updating a single GuardrailRule entity.
+type GuardrailRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuardrailRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GuardrailRuleUpdateOne) SetUpdatedAt(v time.Time) *GuardrailRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GuardrailRuleUpdateOne) SetName(v string) *GuardrailRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillableName(v *string) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *GuardrailRuleUpdateOne) SetEnabled(v bool) *GuardrailRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillableEnabled(v *bool) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *GuardrailRuleUpdateOne) SetPriority(v int) *GuardrailRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillablePriority(v *int) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *GuardrailRuleUpdateOne) AddPriority(v int) *GuardrailRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *GuardrailRuleUpdateOne) SetGroupIds(v []int64) *GuardrailRuleUpdateOne {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *GuardrailRuleUpdateOne) AppendGroupIds(v []int64) *GuardrailRuleUpdateOne {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *GuardrailRuleUpdateOne) ClearGroupIds() *GuardrailRuleUpdateOne {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetMatchType sets the "match_type" field.
+func (_u *GuardrailRuleUpdateOne) SetMatchType(v string) *GuardrailRuleUpdateOne {
+	_u.mutation.SetMatchType(v)
+	return _u
+}
+
+// SetNillableMatchType sets the "match_type" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillableMatchType(v *string) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetMatchType(*v)
+	}
+	return _u
+}
+
+// SetPatterns sets the "patterns" field.
+func (_u *GuardrailRuleUpdateOne) SetPatterns(v []string) *GuardrailRuleUpdateOne {
+	_u.mutation.SetPatterns(v)
+	return _u
+}
+
+// AppendPatterns appends value to the "patterns" field.
+func (_u *GuardrailRuleUpdateOne) AppendPatterns(v []string) *GuardrailRuleUpdateOne {
+	_u.mutation.AppendPatterns(v)
+	return _u
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (_u *GuardrailRuleUpdateOne) ClearPatterns() *GuardrailRuleUpdateOne {
+	_u.mutation.ClearPatterns()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *GuardrailRuleUpdateOne) SetAction(v string) *GuardrailRuleUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillableAction(v *string) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetBlockMessage sets the "block_message" field.
+func (_u *GuardrailRuleUpdateOne) SetBlockMessage(v string) *GuardrailRuleUpdateOne {
+	_u.mutation.SetBlockMessage(v)
+	return _u
+}
+
+// SetNillableBlockMessage sets the "block_message" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillableBlockMessage(v *string) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetBlockMessage(*v)
+	}
+	return _u
+}
+
+// ClearBlockMessage clears the value of the "block_message" field.
+func (_u *GuardrailRuleUpdateOne) ClearBlockMessage() *GuardrailRuleUpdateOne {
+	_u.mutation.ClearBlockMessage()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GuardrailRuleUpdateOne) SetDescription(v string) *GuardrailRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GuardrailRuleUpdateOne) SetNillableDescription(v *string) *GuardrailRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GuardrailRuleUpdateOne) ClearDescription() *GuardrailRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the GuardrailRuleMutation object of the builder.
+func (_u *GuardrailRuleUpdateOne) Mutation() *GuardrailRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GuardrailRuleUpdate builder.
+func (_u *GuardrailRuleUpdateOne) Where(ps ...predicate.GuardrailRule) *GuardrailRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GuardrailRuleUpdateOne) Select(field string, fields ...string) *GuardrailRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GuardrailRule entity.
+func (_u *GuardrailRuleUpdateOne) Save(ctx context.Context) (*GuardrailRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuardrailRuleUpdateOne) SaveX(ctx context.Context) *GuardrailRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GuardrailRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuardrailRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GuardrailRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := guardrailrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GuardrailRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := guardrailrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MatchType(); ok {
+		if err := guardrailrule.MatchTypeValidator(v); err != nil {
+			return &ValidationError{Name: "match_type", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.match_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := guardrailrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GuardrailRuleUpdateOne) sqlSave(ctx context.Context) (_node *GuardrailRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guardrailrule.Table, guardrailrule.Columns, sqlgraph.NewFieldSpec(guardrailrule.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuardrailRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guardrailrule.FieldID)
+		for _, f := range fields {
+			if !guardrailrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guardrailrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(guardrailrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(guardrailrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(guardrailrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(guardrailrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(guardrailrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(guardrailrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, guardrailrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(guardrailrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MatchType(); ok {
+		_spec.SetField(guardrailrule.FieldMatchType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Patterns(); ok {
+		_spec.SetField(guardrailrule.FieldPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, guardrailrule.FieldPatterns, value)
+		})
+	}
+	if _u.mutation.PatternsCleared() {
+		_spec.ClearField(guardrailrule.FieldPatterns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(guardrailrule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockMessage(); ok {
+		_spec.SetField(guardrailrule.FieldBlockMessage, field.TypeString, value)
+	}
+	if _u.mutation.BlockMessageCleared() {
+		_spec.ClearField(guardrailrule.FieldBlockMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(guardrailrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(guardrailrule.FieldDescription, field.TypeString)
+	}
+	_node = &GuardrailRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guardrailrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
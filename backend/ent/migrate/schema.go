@@ -309,6 +309,41 @@ var (
 			},
 		},
 	}
+	// ClientRulesColumns holds the columns for the "client_rules" table.
+	ClientRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "preset", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "user_agent_pattern", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "required_headers", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "metadata_user_id_pattern", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "body_fields", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "fallback_group_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ClientRulesTable holds the schema information for the "client_rules" table.
+	ClientRulesTable = &schema.Table{
+		Name:       "client_rules",
+		Columns:    ClientRulesColumns,
+		PrimaryKey: []*schema.Column{ClientRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clientrule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{ClientRulesColumns[4]},
+			},
+			{
+				Name:    "clientrule_priority",
+				Unique:  false,
+				Columns: []*schema.Column{ClientRulesColumns[5]},
+			},
+		},
+	}
 	// ErrorPassthroughRulesColumns holds the columns for the "error_passthrough_rules" table.
 	ErrorPassthroughRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -374,6 +409,10 @@ var (
 		{Name: "mcp_xml_inject", Type: field.TypeBool, Default: true},
 		{Name: "supported_model_scopes", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "model_fallbacks", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "model_rate_multipliers", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "scheduling_strategy", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "first_byte_timeout_seconds", Type: field.TypeInt, Default: 0},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -413,6 +452,79 @@ var (
 			},
 		},
 	}
+	// GuardrailRulesColumns holds the columns for the "guardrail_rules" table.
+	GuardrailRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "match_type", Type: field.TypeString, Size: 16},
+		{Name: "patterns", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "action", Type: field.TypeString, Size: 10, Default: "flag"},
+		{Name: "block_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// GuardrailRulesTable holds the schema information for the "guardrail_rules" table.
+	GuardrailRulesTable = &schema.Table{
+		Name:       "guardrail_rules",
+		Columns:    GuardrailRulesColumns,
+		PrimaryKey: []*schema.Column{GuardrailRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "guardrailrule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{GuardrailRulesColumns[4]},
+			},
+			{
+				Name:    "guardrailrule_priority",
+				Unique:  false,
+				Columns: []*schema.Column{GuardrailRulesColumns[5]},
+			},
+		},
+	}
+	// ModelPricesColumns holds the columns for the "model_prices" table.
+	ModelPricesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "model", Type: field.TypeString, Size: 100},
+		{Name: "group_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "platform", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "input_price", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "output_price", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "cache_write_price", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "cache_read_price", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "image_price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "effective_from", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ModelPricesTable holds the schema information for the "model_prices" table.
+	ModelPricesTable = &schema.Table{
+		Name:       "model_prices",
+		Columns:    ModelPricesColumns,
+		PrimaryKey: []*schema.Column{ModelPricesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "modelprice_model",
+				Unique:  false,
+				Columns: []*schema.Column{ModelPricesColumns[3]},
+			},
+			{
+				Name:    "modelprice_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModelPricesColumns[4]},
+			},
+			{
+				Name:    "modelprice_model_group_id_platform_effective_from",
+				Unique:  true,
+				Columns: []*schema.Column{ModelPricesColumns[3], ModelPricesColumns[4], ModelPricesColumns[5], ModelPricesColumns[11]},
+			},
+		},
+	}
 	// PromoCodesColumns holds the columns for the "promo_codes" table.
 	PromoCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -572,6 +684,77 @@ var (
 			},
 		},
 	}
+	// RequestPoliciesColumns holds the columns for the "request_policies" table.
+	RequestPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "protocols", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "kind", Type: field.TypeString, Size: 32},
+		{Name: "action", Type: field.TypeString, Size: 10, Default: "modify"},
+		{Name: "dry_run", Type: field.TypeBool, Default: false},
+		{Name: "limit_value", Type: field.TypeInt, Nullable: true},
+		{Name: "params", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "reject_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// RequestPoliciesTable holds the schema information for the "request_policies" table.
+	RequestPoliciesTable = &schema.Table{
+		Name:       "request_policies",
+		Columns:    RequestPoliciesColumns,
+		PrimaryKey: []*schema.Column{RequestPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "requestpolicy_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{RequestPoliciesColumns[4]},
+			},
+			{
+				Name:    "requestpolicy_priority",
+				Unique:  false,
+				Columns: []*schema.Column{RequestPoliciesColumns[5]},
+			},
+		},
+	}
+	// RoutingRulesColumns holds the columns for the "routing_rules" table.
+	RoutingRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "model_patterns", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "max_tokens_below", Type: field.TypeInt, Nullable: true},
+		{Name: "require_no_tools", Type: field.TypeBool, Default: false},
+		{Name: "input_tokens_below", Type: field.TypeInt, Nullable: true},
+		{Name: "target_group_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "target_account_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// RoutingRulesTable holds the schema information for the "routing_rules" table.
+	RoutingRulesTable = &schema.Table{
+		Name:       "routing_rules",
+		Columns:    RoutingRulesColumns,
+		PrimaryKey: []*schema.Column{RoutingRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "routingrule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingRulesColumns[4]},
+			},
+			{
+				Name:    "routingrule_priority",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingRulesColumns[5]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -758,6 +941,7 @@ var (
 		{Name: "role", Type: field.TypeString, Size: 20, Default: "user"},
 		{Name: "balance", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
 		{Name: "concurrency", Type: field.TypeInt, Default: 5},
+		{Name: "queue_weight", Type: field.TypeInt, Default: 1},
 		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
 		{Name: "username", Type: field.TypeString, Size: 100, Default: ""},
 		{Name: "notes", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "text"}},
@@ -774,7 +958,7 @@ var (
 			{
 				Name:    "user_status",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[9]},
+				Columns: []*schema.Column{UsersColumns[10]},
 			},
 			{
 				Name:    "user_deleted_at",
@@ -993,12 +1177,17 @@ var (
 		AccountGroupsTable,
 		AnnouncementsTable,
 		AnnouncementReadsTable,
+		ClientRulesTable,
 		ErrorPassthroughRulesTable,
 		GroupsTable,
+		GuardrailRulesTable,
+		ModelPricesTable,
 		PromoCodesTable,
 		PromoCodeUsagesTable,
 		ProxiesTable,
 		RedeemCodesTable,
+		RequestPoliciesTable,
+		RoutingRulesTable,
 		SettingsTable,
 		UsageCleanupTasksTable,
 		UsageLogsTable,
@@ -1033,12 +1222,21 @@ func init() {
 	AnnouncementReadsTable.Annotation = &entsql.Annotation{
 		Table: "announcement_reads",
 	}
+	ClientRulesTable.Annotation = &entsql.Annotation{
+		Table: "client_rules",
+	}
 	ErrorPassthroughRulesTable.Annotation = &entsql.Annotation{
 		Table: "error_passthrough_rules",
 	}
 	GroupsTable.Annotation = &entsql.Annotation{
 		Table: "groups",
 	}
+	GuardrailRulesTable.Annotation = &entsql.Annotation{
+		Table: "guardrail_rules",
+	}
+	ModelPricesTable.Annotation = &entsql.Annotation{
+		Table: "model_prices",
+	}
 	PromoCodesTable.Annotation = &entsql.Annotation{
 		Table: "promo_codes",
 	}
@@ -1055,6 +1253,12 @@ func init() {
 	RedeemCodesTable.Annotation = &entsql.Annotation{
 		Table: "redeem_codes",
 	}
+	RequestPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "request_policies",
+	}
+	RoutingRulesTable.Annotation = &entsql.Annotation{
+		Table: "routing_rules",
+	}
 	SettingsTable.Annotation = &entsql.Annotation{
 		Table: "settings",
 	}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package modelprice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the modelprice type in the database.
+	Label = "model_price"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldInputPrice holds the string denoting the input_price field in the database.
+	FieldInputPrice = "input_price"
+	// FieldOutputPrice holds the string denoting the output_price field in the database.
+	FieldOutputPrice = "output_price"
+	// FieldCacheWritePrice holds the string denoting the cache_write_price field in the database.
+	FieldCacheWritePrice = "cache_write_price"
+	// FieldCacheReadPrice holds the string denoting the cache_read_price field in the database.
+	FieldCacheReadPrice = "cache_read_price"
+	// FieldImagePrice holds the string denoting the image_price field in the database.
+	FieldImagePrice = "image_price"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// Table holds the table name of the modelprice in the database.
+	Table = "model_prices"
+)
+
+// Columns holds all SQL columns for modelprice fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldModel,
+	FieldGroupID,
+	FieldPlatform,
+	FieldInputPrice,
+	FieldOutputPrice,
+	FieldCacheWritePrice,
+	FieldCacheReadPrice,
+	FieldImagePrice,
+	FieldEffectiveFrom,
+	FieldEnabled,
+	FieldDescription,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	ModelValidator func(string) error
+	// DefaultPlatform holds the default value on creation for the "platform" field.
+	DefaultPlatform string
+	// PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	PlatformValidator func(string) error
+	// DefaultInputPrice holds the default value on creation for the "input_price" field.
+	DefaultInputPrice float64
+	// DefaultOutputPrice holds the default value on creation for the "output_price" field.
+	DefaultOutputPrice float64
+	// DefaultCacheWritePrice holds the default value on creation for the "cache_write_price" field.
+	DefaultCacheWritePrice float64
+	// DefaultCacheReadPrice holds the default value on creation for the "cache_read_price" field.
+	DefaultCacheReadPrice float64
+	// DefaultEffectiveFrom holds the default value on creation for the "effective_from" field.
+	DefaultEffectiveFrom func() time.Time
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+)
+
+// OrderOption defines the ordering options for the ModelPrice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByInputPrice orders the results by the input_price field.
+func ByInputPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputPrice, opts...).ToFunc()
+}
+
+// ByOutputPrice orders the results by the output_price field.
+func ByOutputPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputPrice, opts...).ToFunc()
+}
+
+// ByCacheWritePrice orders the results by the cache_write_price field.
+func ByCacheWritePrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheWritePrice, opts...).ToFunc()
+}
+
+// ByCacheReadPrice orders the results by the cache_read_price field.
+func ByCacheReadPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheReadPrice, opts...).ToFunc()
+}
+
+// ByImagePrice orders the results by the image_price field.
+func ByImagePrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImagePrice, opts...).ToFunc()
+}
+
+// ByEffectiveFrom orders the results by the effective_from field.
+func ByEffectiveFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveFrom, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package modelprice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldModel, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
+func Platform(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldPlatform, v))
+}
+
+// InputPrice applies equality check predicate on the "input_price" field. It's identical to InputPriceEQ.
+func InputPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldInputPrice, v))
+}
+
+// OutputPrice applies equality check predicate on the "output_price" field. It's identical to OutputPriceEQ.
+func OutputPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldOutputPrice, v))
+}
+
+// CacheWritePrice applies equality check predicate on the "cache_write_price" field. It's identical to CacheWritePriceEQ.
+func CacheWritePrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheWritePrice, v))
+}
+
+// CacheReadPrice applies equality check predicate on the "cache_read_price" field. It's identical to CacheReadPriceEQ.
+func CacheReadPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheReadPrice, v))
+}
+
+// ImagePrice applies equality check predicate on the "image_price" field. It's identical to ImagePriceEQ.
+func ImagePrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldImagePrice, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContainsFold(FieldModel, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotNull(FieldGroupID))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// PlatformGT applies the GT predicate on the "platform" field.
+func PlatformGT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldPlatform, v))
+}
+
+// PlatformGTE applies the GTE predicate on the "platform" field.
+func PlatformGTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldPlatform, v))
+}
+
+// PlatformLT applies the LT predicate on the "platform" field.
+func PlatformLT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldPlatform, v))
+}
+
+// PlatformLTE applies the LTE predicate on the "platform" field.
+func PlatformLTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldPlatform, v))
+}
+
+// PlatformContains applies the Contains predicate on the "platform" field.
+func PlatformContains(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContains(FieldPlatform, v))
+}
+
+// PlatformHasPrefix applies the HasPrefix predicate on the "platform" field.
+func PlatformHasPrefix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasPrefix(FieldPlatform, v))
+}
+
+// PlatformHasSuffix applies the HasSuffix predicate on the "platform" field.
+func PlatformHasSuffix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasSuffix(FieldPlatform, v))
+}
+
+// PlatformEqualFold applies the EqualFold predicate on the "platform" field.
+func PlatformEqualFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEqualFold(FieldPlatform, v))
+}
+
+// PlatformContainsFold applies the ContainsFold predicate on the "platform" field.
+func PlatformContainsFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContainsFold(FieldPlatform, v))
+}
+
+// InputPriceEQ applies the EQ predicate on the "input_price" field.
+func InputPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldInputPrice, v))
+}
+
+// InputPriceNEQ applies the NEQ predicate on the "input_price" field.
+func InputPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldInputPrice, v))
+}
+
+// InputPriceIn applies the In predicate on the "input_price" field.
+func InputPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldInputPrice, vs...))
+}
+
+// InputPriceNotIn applies the NotIn predicate on the "input_price" field.
+func InputPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldInputPrice, vs...))
+}
+
+// InputPriceGT applies the GT predicate on the "input_price" field.
+func InputPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldInputPrice, v))
+}
+
+// InputPriceGTE applies the GTE predicate on the "input_price" field.
+func InputPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldInputPrice, v))
+}
+
+// InputPriceLT applies the LT predicate on the "input_price" field.
+func InputPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldInputPrice, v))
+}
+
+// InputPriceLTE applies the LTE predicate on the "input_price" field.
+func InputPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldInputPrice, v))
+}
+
+// OutputPriceEQ applies the EQ predicate on the "output_price" field.
+func OutputPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldOutputPrice, v))
+}
+
+// OutputPriceNEQ applies the NEQ predicate on the "output_price" field.
+func OutputPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldOutputPrice, v))
+}
+
+// OutputPriceIn applies the In predicate on the "output_price" field.
+func OutputPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldOutputPrice, vs...))
+}
+
+// OutputPriceNotIn applies the NotIn predicate on the "output_price" field.
+func OutputPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldOutputPrice, vs...))
+}
+
+// OutputPriceGT applies the GT predicate on the "output_price" field.
+func OutputPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldOutputPrice, v))
+}
+
+// OutputPriceGTE applies the GTE predicate on the "output_price" field.
+func OutputPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldOutputPrice, v))
+}
+
+// OutputPriceLT applies the LT predicate on the "output_price" field.
+func OutputPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldOutputPrice, v))
+}
+
+// OutputPriceLTE applies the LTE predicate on the "output_price" field.
+func OutputPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldOutputPrice, v))
+}
+
+// CacheWritePriceEQ applies the EQ predicate on the "cache_write_price" field.
+func CacheWritePriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceNEQ applies the NEQ predicate on the "cache_write_price" field.
+func CacheWritePriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceIn applies the In predicate on the "cache_write_price" field.
+func CacheWritePriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldCacheWritePrice, vs...))
+}
+
+// CacheWritePriceNotIn applies the NotIn predicate on the "cache_write_price" field.
+func CacheWritePriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldCacheWritePrice, vs...))
+}
+
+// CacheWritePriceGT applies the GT predicate on the "cache_write_price" field.
+func CacheWritePriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceGTE applies the GTE predicate on the "cache_write_price" field.
+func CacheWritePriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceLT applies the LT predicate on the "cache_write_price" field.
+func CacheWritePriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceLTE applies the LTE predicate on the "cache_write_price" field.
+func CacheWritePriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldCacheWritePrice, v))
+}
+
+// CacheReadPriceEQ applies the EQ predicate on the "cache_read_price" field.
+func CacheReadPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceNEQ applies the NEQ predicate on the "cache_read_price" field.
+func CacheReadPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceIn applies the In predicate on the "cache_read_price" field.
+func CacheReadPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldCacheReadPrice, vs...))
+}
+
+// CacheReadPriceNotIn applies the NotIn predicate on the "cache_read_price" field.
+func CacheReadPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldCacheReadPrice, vs...))
+}
+
+// CacheReadPriceGT applies the GT predicate on the "cache_read_price" field.
+func CacheReadPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceGTE applies the GTE predicate on the "cache_read_price" field.
+func CacheReadPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceLT applies the LT predicate on the "cache_read_price" field.
+func CacheReadPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceLTE applies the LTE predicate on the "cache_read_price" field.
+func CacheReadPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldCacheReadPrice, v))
+}
+
+// ImagePriceEQ applies the EQ predicate on the "image_price" field.
+func ImagePriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldImagePrice, v))
+}
+
+// ImagePriceNEQ applies the NEQ predicate on the "image_price" field.
+func ImagePriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldImagePrice, v))
+}
+
+// ImagePriceIn applies the In predicate on the "image_price" field.
+func ImagePriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldImagePrice, vs...))
+}
+
+// ImagePriceNotIn applies the NotIn predicate on the "image_price" field.
+func ImagePriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldImagePrice, vs...))
+}
+
+// ImagePriceGT applies the GT predicate on the "image_price" field.
+func ImagePriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldImagePrice, v))
+}
+
+// ImagePriceGTE applies the GTE predicate on the "image_price" field.
+func ImagePriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldImagePrice, v))
+}
+
+// ImagePriceLT applies the LT predicate on the "image_price" field.
+func ImagePriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldImagePrice, v))
+}
+
+// ImagePriceLTE applies the LTE predicate on the "image_price" field.
+func ImagePriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldImagePrice, v))
+}
+
+// ImagePriceIsNil applies the IsNil predicate on the "image_price" field.
+func ImagePriceIsNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIsNull(FieldImagePrice))
+}
+
+// ImagePriceNotNil applies the NotNil predicate on the "image_price" field.
+func ImagePriceNotNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotNull(FieldImagePrice))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModelPrice) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModelPrice) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModelPrice) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.NotPredicates(p))
+}
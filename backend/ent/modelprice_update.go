@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ModelPriceUpdate is the builder for updating ModelPrice entities.
+type ModelPriceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModelPriceMutation
+}
+
+// Where appends a list predicates to the ModelPriceUpdate builder.
+func (_u *ModelPriceUpdate) Where(ps ...predicate.ModelPrice) *ModelPriceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModelPriceUpdate) SetUpdatedAt(v time.Time) *ModelPriceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ModelPriceUpdate) SetModel(v string) *ModelPriceUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableModel(v *string) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ModelPriceUpdate) SetGroupID(v int64) *ModelPriceUpdate {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableGroupID(v *int64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *ModelPriceUpdate) AddGroupID(v int64) *ModelPriceUpdate {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *ModelPriceUpdate) ClearGroupID() *ModelPriceUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *ModelPriceUpdate) SetPlatform(v string) *ModelPriceUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillablePlatform(v *string) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_u *ModelPriceUpdate) SetInputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetInputPrice()
+	_u.mutation.SetInputPrice(v)
+	return _u
+}
+
+// SetNillableInputPrice sets the "input_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableInputPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetInputPrice(*v)
+	}
+	return _u
+}
+
+// AddInputPrice adds value to the "input_price" field.
+func (_u *ModelPriceUpdate) AddInputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddInputPrice(v)
+	return _u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_u *ModelPriceUpdate) SetOutputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetOutputPrice()
+	_u.mutation.SetOutputPrice(v)
+	return _u
+}
+
+// SetNillableOutputPrice sets the "output_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableOutputPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputPrice adds value to the "output_price" field.
+func (_u *ModelPriceUpdate) AddOutputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddOutputPrice(v)
+	return _u
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (_u *ModelPriceUpdate) SetCacheWritePrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetCacheWritePrice()
+	_u.mutation.SetCacheWritePrice(v)
+	return _u
+}
+
+// SetNillableCacheWritePrice sets the "cache_write_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableCacheWritePrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetCacheWritePrice(*v)
+	}
+	return _u
+}
+
+// AddCacheWritePrice adds value to the "cache_write_price" field.
+func (_u *ModelPriceUpdate) AddCacheWritePrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddCacheWritePrice(v)
+	return _u
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (_u *ModelPriceUpdate) SetCacheReadPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetCacheReadPrice()
+	_u.mutation.SetCacheReadPrice(v)
+	return _u
+}
+
+// SetNillableCacheReadPrice sets the "cache_read_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableCacheReadPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetCacheReadPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheReadPrice adds value to the "cache_read_price" field.
+func (_u *ModelPriceUpdate) AddCacheReadPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddCacheReadPrice(v)
+	return _u
+}
+
+// SetImagePrice sets the "image_price" field.
+func (_u *ModelPriceUpdate) SetImagePrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetImagePrice()
+	_u.mutation.SetImagePrice(v)
+	return _u
+}
+
+// SetNillableImagePrice sets the "image_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableImagePrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetImagePrice(*v)
+	}
+	return _u
+}
+
+// AddImagePrice adds value to the "image_price" field.
+func (_u *ModelPriceUpdate) AddImagePrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddImagePrice(v)
+	return _u
+}
+
+// ClearImagePrice clears the value of the "image_price" field.
+func (_u *ModelPriceUpdate) ClearImagePrice() *ModelPriceUpdate {
+	_u.mutation.ClearImagePrice()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *ModelPriceUpdate) SetEffectiveFrom(v time.Time) *ModelPriceUpdate {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableEffectiveFrom(v *time.Time) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ModelPriceUpdate) SetEnabled(v bool) *ModelPriceUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableEnabled(v *bool) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ModelPriceUpdate) SetDescription(v string) *ModelPriceUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableDescription(v *string) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ModelPriceUpdate) ClearDescription() *ModelPriceUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the ModelPriceMutation object of the builder.
+func (_u *ModelPriceUpdate) Mutation() *ModelPriceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModelPriceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModelPriceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModelPriceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModelPriceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModelPriceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := modelprice.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModelPriceUpdate) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := modelprice.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := modelprice.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.platform": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModelPriceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelprice.Table, modelprice.Columns, sqlgraph.NewFieldSpec(modelprice.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelprice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(modelprice.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(modelprice.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(modelprice.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputPrice(); ok {
+		_spec.SetField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputPrice(); ok {
+		_spec.AddField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutputPrice(); ok {
+		_spec.SetField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputPrice(); ok {
+		_spec.AddField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheWritePrice(); ok {
+		_spec.SetField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheWritePrice(); ok {
+		_spec.AddField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheReadPrice(); ok {
+		_spec.SetField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheReadPrice(); ok {
+		_spec.AddField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ImagePrice(); ok {
+		_spec.SetField(modelprice.FieldImagePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImagePrice(); ok {
+		_spec.AddField(modelprice.FieldImagePrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ImagePriceCleared() {
+		_spec.ClearField(modelprice.FieldImagePrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelprice.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(modelprice.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(modelprice.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(modelprice.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModelPriceUpdateOne is the builder for updating a single ModelPrice entity.
+type ModelPriceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModelPriceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModelPriceUpdateOne) SetUpdatedAt(v time.Time) *ModelPriceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ModelPriceUpdateOne) SetModel(v string) *ModelPriceUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableModel(v *string) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ModelPriceUpdateOne) SetGroupID(v int64) *ModelPriceUpdateOne {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableGroupID(v *int64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *ModelPriceUpdateOne) AddGroupID(v int64) *ModelPriceUpdateOne {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *ModelPriceUpdateOne) ClearGroupID() *ModelPriceUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *ModelPriceUpdateOne) SetPlatform(v string) *ModelPriceUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillablePlatform(v *string) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_u *ModelPriceUpdateOne) SetInputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetInputPrice()
+	_u.mutation.SetInputPrice(v)
+	return _u
+}
+
+// SetNillableInputPrice sets the "input_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableInputPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetInputPrice(*v)
+	}
+	return _u
+}
+
+// AddInputPrice adds value to the "input_price" field.
+func (_u *ModelPriceUpdateOne) AddInputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddInputPrice(v)
+	return _u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_u *ModelPriceUpdateOne) SetOutputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetOutputPrice()
+	_u.mutation.SetOutputPrice(v)
+	return _u
+}
+
+// SetNillableOutputPrice sets the "output_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableOutputPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputPrice adds value to the "output_price" field.
+func (_u *ModelPriceUpdateOne) AddOutputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddOutputPrice(v)
+	return _u
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (_u *ModelPriceUpdateOne) SetCacheWritePrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetCacheWritePrice()
+	_u.mutation.SetCacheWritePrice(v)
+	return _u
+}
+
+// SetNillableCacheWritePrice sets the "cache_write_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableCacheWritePrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetCacheWritePrice(*v)
+	}
+	return _u
+}
+
+// AddCacheWritePrice adds value to the "cache_write_price" field.
+func (_u *ModelPriceUpdateOne) AddCacheWritePrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddCacheWritePrice(v)
+	return _u
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (_u *ModelPriceUpdateOne) SetCacheReadPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetCacheReadPrice()
+	_u.mutation.SetCacheReadPrice(v)
+	return _u
+}
+
+// SetNillableCacheReadPrice sets the "cache_read_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableCacheReadPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetCacheReadPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheReadPrice adds value to the "cache_read_price" field.
+func (_u *ModelPriceUpdateOne) AddCacheReadPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddCacheReadPrice(v)
+	return _u
+}
+
+// SetImagePrice sets the "image_price" field.
+func (_u *ModelPriceUpdateOne) SetImagePrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetImagePrice()
+	_u.mutation.SetImagePrice(v)
+	return _u
+}
+
+// SetNillableImagePrice sets the "image_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableImagePrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetImagePrice(*v)
+	}
+	return _u
+}
+
+// AddImagePrice adds value to the "image_price" field.
+func (_u *ModelPriceUpdateOne) AddImagePrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddImagePrice(v)
+	return _u
+}
+
+// ClearImagePrice clears the value of the "image_price" field.
+func (_u *ModelPriceUpdateOne) ClearImagePrice() *ModelPriceUpdateOne {
+	_u.mutation.ClearImagePrice()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *ModelPriceUpdateOne) SetEffectiveFrom(v time.Time) *ModelPriceUpdateOne {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableEffectiveFrom(v *time.Time) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ModelPriceUpdateOne) SetEnabled(v bool) *ModelPriceUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableEnabled(v *bool) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ModelPriceUpdateOne) SetDescription(v string) *ModelPriceUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableDescription(v *string) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ModelPriceUpdateOne) ClearDescription() *ModelPriceUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the ModelPriceMutation object of the builder.
+func (_u *ModelPriceUpdateOne) Mutation() *ModelPriceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModelPriceUpdate builder.
+func (_u *ModelPriceUpdateOne) Where(ps ...predicate.ModelPrice) *ModelPriceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModelPriceUpdateOne) Select(field string, fields ...string) *ModelPriceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModelPrice entity.
+func (_u *ModelPriceUpdateOne) Save(ctx context.Context) (*ModelPrice, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModelPriceUpdateOne) SaveX(ctx context.Context) *ModelPrice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModelPriceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModelPriceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModelPriceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := modelprice.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModelPriceUpdateOne) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := modelprice.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := modelprice.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.platform": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModelPriceUpdateOne) sqlSave(ctx context.Context) (_node *ModelPrice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelprice.Table, modelprice.Columns, sqlgraph.NewFieldSpec(modelprice.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModelPrice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelprice.FieldID)
+		for _, f := range fields {
+			if !modelprice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != modelprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelprice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(modelprice.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(modelprice.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(modelprice.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputPrice(); ok {
+		_spec.SetField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputPrice(); ok {
+		_spec.AddField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutputPrice(); ok {
+		_spec.SetField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputPrice(); ok {
+		_spec.AddField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheWritePrice(); ok {
+		_spec.SetField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheWritePrice(); ok {
+		_spec.AddField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheReadPrice(); ok {
+		_spec.SetField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheReadPrice(); ok {
+		_spec.AddField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ImagePrice(); ok {
+		_spec.SetField(modelprice.FieldImagePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImagePrice(); ok {
+		_spec.AddField(modelprice.FieldImagePrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ImagePriceCleared() {
+		_spec.ClearField(modelprice.FieldImagePrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelprice.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(modelprice.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(modelprice.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(modelprice.FieldDescription, field.TypeString)
+	}
+	_node = &ModelPrice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
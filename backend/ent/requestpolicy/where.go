@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package requestpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldPriority, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldKind, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldAction, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldDryRun, v))
+}
+
+// LimitValue applies equality check predicate on the "limit_value" field. It's identical to LimitValueEQ.
+func LimitValue(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldLimitValue, v))
+}
+
+// RejectMessage applies equality check predicate on the "reject_message" field. It's identical to RejectMessageEQ.
+func RejectMessage(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldRejectMessage, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldPriority, v))
+}
+
+// GroupIdsIsNil applies the IsNil predicate on the "group_ids" field.
+func GroupIdsIsNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIsNull(FieldGroupIds))
+}
+
+// GroupIdsNotNil applies the NotNil predicate on the "group_ids" field.
+func GroupIdsNotNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotNull(FieldGroupIds))
+}
+
+// ProtocolsIsNil applies the IsNil predicate on the "protocols" field.
+func ProtocolsIsNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIsNull(FieldProtocols))
+}
+
+// ProtocolsNotNil applies the NotNil predicate on the "protocols" field.
+func ProtocolsNotNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotNull(FieldProtocols))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContainsFold(FieldAction, v))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// LimitValueEQ applies the EQ predicate on the "limit_value" field.
+func LimitValueEQ(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldLimitValue, v))
+}
+
+// LimitValueNEQ applies the NEQ predicate on the "limit_value" field.
+func LimitValueNEQ(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldLimitValue, v))
+}
+
+// LimitValueIn applies the In predicate on the "limit_value" field.
+func LimitValueIn(vs ...int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldLimitValue, vs...))
+}
+
+// LimitValueNotIn applies the NotIn predicate on the "limit_value" field.
+func LimitValueNotIn(vs ...int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldLimitValue, vs...))
+}
+
+// LimitValueGT applies the GT predicate on the "limit_value" field.
+func LimitValueGT(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldLimitValue, v))
+}
+
+// LimitValueGTE applies the GTE predicate on the "limit_value" field.
+func LimitValueGTE(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldLimitValue, v))
+}
+
+// LimitValueLT applies the LT predicate on the "limit_value" field.
+func LimitValueLT(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldLimitValue, v))
+}
+
+// LimitValueLTE applies the LTE predicate on the "limit_value" field.
+func LimitValueLTE(v int) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldLimitValue, v))
+}
+
+// LimitValueIsNil applies the IsNil predicate on the "limit_value" field.
+func LimitValueIsNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIsNull(FieldLimitValue))
+}
+
+// LimitValueNotNil applies the NotNil predicate on the "limit_value" field.
+func LimitValueNotNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotNull(FieldLimitValue))
+}
+
+// ParamsIsNil applies the IsNil predicate on the "params" field.
+func ParamsIsNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIsNull(FieldParams))
+}
+
+// ParamsNotNil applies the NotNil predicate on the "params" field.
+func ParamsNotNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotNull(FieldParams))
+}
+
+// RejectMessageEQ applies the EQ predicate on the "reject_message" field.
+func RejectMessageEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldRejectMessage, v))
+}
+
+// RejectMessageNEQ applies the NEQ predicate on the "reject_message" field.
+func RejectMessageNEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldRejectMessage, v))
+}
+
+// RejectMessageIn applies the In predicate on the "reject_message" field.
+func RejectMessageIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldRejectMessage, vs...))
+}
+
+// RejectMessageNotIn applies the NotIn predicate on the "reject_message" field.
+func RejectMessageNotIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldRejectMessage, vs...))
+}
+
+// RejectMessageGT applies the GT predicate on the "reject_message" field.
+func RejectMessageGT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldRejectMessage, v))
+}
+
+// RejectMessageGTE applies the GTE predicate on the "reject_message" field.
+func RejectMessageGTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldRejectMessage, v))
+}
+
+// RejectMessageLT applies the LT predicate on the "reject_message" field.
+func RejectMessageLT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldRejectMessage, v))
+}
+
+// RejectMessageLTE applies the LTE predicate on the "reject_message" field.
+func RejectMessageLTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldRejectMessage, v))
+}
+
+// RejectMessageContains applies the Contains predicate on the "reject_message" field.
+func RejectMessageContains(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContains(FieldRejectMessage, v))
+}
+
+// RejectMessageHasPrefix applies the HasPrefix predicate on the "reject_message" field.
+func RejectMessageHasPrefix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasPrefix(FieldRejectMessage, v))
+}
+
+// RejectMessageHasSuffix applies the HasSuffix predicate on the "reject_message" field.
+func RejectMessageHasSuffix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasSuffix(FieldRejectMessage, v))
+}
+
+// RejectMessageIsNil applies the IsNil predicate on the "reject_message" field.
+func RejectMessageIsNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIsNull(FieldRejectMessage))
+}
+
+// RejectMessageNotNil applies the NotNil predicate on the "reject_message" field.
+func RejectMessageNotNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotNull(FieldRejectMessage))
+}
+
+// RejectMessageEqualFold applies the EqualFold predicate on the "reject_message" field.
+func RejectMessageEqualFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEqualFold(FieldRejectMessage, v))
+}
+
+// RejectMessageContainsFold applies the ContainsFold predicate on the "reject_message" field.
+func RejectMessageContainsFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContainsFold(FieldRejectMessage, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RequestPolicy) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RequestPolicy) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RequestPolicy) predicate.RequestPolicy {
+	return predicate.RequestPolicy(sql.NotPredicates(p))
+}
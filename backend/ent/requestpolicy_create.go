@@ -0,0 +1,1375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/requestpolicy"
+)
+
+// RequestPolicyCreate is the builder for creating a RequestPolicy entity.
+type RequestPolicyCreate struct {
+	config
+	mutation *RequestPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RequestPolicyCreate) SetCreatedAt(v time.Time) *RequestPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableCreatedAt(v *time.Time) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RequestPolicyCreate) SetUpdatedAt(v time.Time) *RequestPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableUpdatedAt(v *time.Time) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *RequestPolicyCreate) SetName(v string) *RequestPolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RequestPolicyCreate) SetEnabled(v bool) *RequestPolicyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableEnabled(v *bool) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *RequestPolicyCreate) SetPriority(v int) *RequestPolicyCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillablePriority(v *int) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_c *RequestPolicyCreate) SetGroupIds(v []int64) *RequestPolicyCreate {
+	_c.mutation.SetGroupIds(v)
+	return _c
+}
+
+// SetProtocols sets the "protocols" field.
+func (_c *RequestPolicyCreate) SetProtocols(v []string) *RequestPolicyCreate {
+	_c.mutation.SetProtocols(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *RequestPolicyCreate) SetKind(v string) *RequestPolicyCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *RequestPolicyCreate) SetAction(v string) *RequestPolicyCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableAction(v *string) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_c *RequestPolicyCreate) SetDryRun(v bool) *RequestPolicyCreate {
+	_c.mutation.SetDryRun(v)
+	return _c
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableDryRun(v *bool) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetDryRun(*v)
+	}
+	return _c
+}
+
+// SetLimitValue sets the "limit_value" field.
+func (_c *RequestPolicyCreate) SetLimitValue(v int) *RequestPolicyCreate {
+	_c.mutation.SetLimitValue(v)
+	return _c
+}
+
+// SetNillableLimitValue sets the "limit_value" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableLimitValue(v *int) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetLimitValue(*v)
+	}
+	return _c
+}
+
+// SetParams sets the "params" field.
+func (_c *RequestPolicyCreate) SetParams(v []string) *RequestPolicyCreate {
+	_c.mutation.SetParams(v)
+	return _c
+}
+
+// SetRejectMessage sets the "reject_message" field.
+func (_c *RequestPolicyCreate) SetRejectMessage(v string) *RequestPolicyCreate {
+	_c.mutation.SetRejectMessage(v)
+	return _c
+}
+
+// SetNillableRejectMessage sets the "reject_message" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableRejectMessage(v *string) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetRejectMessage(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RequestPolicyCreate) SetDescription(v string) *RequestPolicyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *RequestPolicyCreate) SetNillableDescription(v *string) *RequestPolicyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RequestPolicyMutation object of the builder.
+func (_c *RequestPolicyCreate) Mutation() *RequestPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the RequestPolicy in the database.
+func (_c *RequestPolicyCreate) Save(ctx context.Context) (*RequestPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RequestPolicyCreate) SaveX(ctx context.Context) *RequestPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RequestPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RequestPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RequestPolicyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := requestpolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := requestpolicy.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := requestpolicy.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := requestpolicy.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := requestpolicy.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		v := requestpolicy.DefaultDryRun
+		_c.mutation.SetDryRun(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RequestPolicyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RequestPolicy.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RequestPolicy.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RequestPolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := requestpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RequestPolicy.enabled"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RequestPolicy.priority"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "RequestPolicy.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := requestpolicy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "RequestPolicy.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := requestpolicy.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "RequestPolicy.dry_run"`)}
+	}
+	return nil
+}
+
+func (_c *RequestPolicyCreate) sqlSave(ctx context.Context) (*RequestPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RequestPolicyCreate) createSpec() (*RequestPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RequestPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(requestpolicy.Table, sqlgraph.NewFieldSpec(requestpolicy.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(requestpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(requestpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(requestpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(requestpolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(requestpolicy.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.GroupIds(); ok {
+		_spec.SetField(requestpolicy.FieldGroupIds, field.TypeJSON, value)
+		_node.GroupIds = value
+	}
+	if value, ok := _c.mutation.Protocols(); ok {
+		_spec.SetField(requestpolicy.FieldProtocols, field.TypeJSON, value)
+		_node.Protocols = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(requestpolicy.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(requestpolicy.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.DryRun(); ok {
+		_spec.SetField(requestpolicy.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := _c.mutation.LimitValue(); ok {
+		_spec.SetField(requestpolicy.FieldLimitValue, field.TypeInt, value)
+		_node.LimitValue = &value
+	}
+	if value, ok := _c.mutation.Params(); ok {
+		_spec.SetField(requestpolicy.FieldParams, field.TypeJSON, value)
+		_node.Params = value
+	}
+	if value, ok := _c.mutation.RejectMessage(); ok {
+		_spec.SetField(requestpolicy.FieldRejectMessage, field.TypeString, value)
+		_node.RejectMessage = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(requestpolicy.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequestPolicy.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequestPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RequestPolicyCreate) OnConflict(opts ...sql.ConflictOption) *RequestPolicyUpsertOne {
+	_c.conflict = opts
+	return &RequestPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequestPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RequestPolicyCreate) OnConflictColumns(columns ...string) *RequestPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RequestPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RequestPolicyUpsertOne is the builder for "upsert"-ing
+	//  one RequestPolicy node.
+	RequestPolicyUpsertOne struct {
+		create *RequestPolicyCreate
+	}
+
+	// RequestPolicyUpsert is the "OnConflict" setter.
+	RequestPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequestPolicyUpsert) SetUpdatedAt(v time.Time) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateUpdatedAt() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RequestPolicyUpsert) SetName(v string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateName() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RequestPolicyUpsert) SetEnabled(v bool) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateEnabled() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldEnabled)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *RequestPolicyUpsert) SetPriority(v int) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdatePriority() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RequestPolicyUpsert) AddPriority(v int) *RequestPolicyUpsert {
+	u.Add(requestpolicy.FieldPriority, v)
+	return u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RequestPolicyUpsert) SetGroupIds(v []int64) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldGroupIds, v)
+	return u
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateGroupIds() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldGroupIds)
+	return u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RequestPolicyUpsert) ClearGroupIds() *RequestPolicyUpsert {
+	u.SetNull(requestpolicy.FieldGroupIds)
+	return u
+}
+
+// SetProtocols sets the "protocols" field.
+func (u *RequestPolicyUpsert) SetProtocols(v []string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldProtocols, v)
+	return u
+}
+
+// UpdateProtocols sets the "protocols" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateProtocols() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldProtocols)
+	return u
+}
+
+// ClearProtocols clears the value of the "protocols" field.
+func (u *RequestPolicyUpsert) ClearProtocols() *RequestPolicyUpsert {
+	u.SetNull(requestpolicy.FieldProtocols)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *RequestPolicyUpsert) SetKind(v string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateKind() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldKind)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *RequestPolicyUpsert) SetAction(v string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateAction() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldAction)
+	return u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (u *RequestPolicyUpsert) SetDryRun(v bool) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldDryRun, v)
+	return u
+}
+
+// UpdateDryRun sets the "dry_run" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateDryRun() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldDryRun)
+	return u
+}
+
+// SetLimitValue sets the "limit_value" field.
+func (u *RequestPolicyUpsert) SetLimitValue(v int) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldLimitValue, v)
+	return u
+}
+
+// UpdateLimitValue sets the "limit_value" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateLimitValue() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldLimitValue)
+	return u
+}
+
+// AddLimitValue adds v to the "limit_value" field.
+func (u *RequestPolicyUpsert) AddLimitValue(v int) *RequestPolicyUpsert {
+	u.Add(requestpolicy.FieldLimitValue, v)
+	return u
+}
+
+// ClearLimitValue clears the value of the "limit_value" field.
+func (u *RequestPolicyUpsert) ClearLimitValue() *RequestPolicyUpsert {
+	u.SetNull(requestpolicy.FieldLimitValue)
+	return u
+}
+
+// SetParams sets the "params" field.
+func (u *RequestPolicyUpsert) SetParams(v []string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldParams, v)
+	return u
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateParams() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldParams)
+	return u
+}
+
+// ClearParams clears the value of the "params" field.
+func (u *RequestPolicyUpsert) ClearParams() *RequestPolicyUpsert {
+	u.SetNull(requestpolicy.FieldParams)
+	return u
+}
+
+// SetRejectMessage sets the "reject_message" field.
+func (u *RequestPolicyUpsert) SetRejectMessage(v string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldRejectMessage, v)
+	return u
+}
+
+// UpdateRejectMessage sets the "reject_message" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateRejectMessage() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldRejectMessage)
+	return u
+}
+
+// ClearRejectMessage clears the value of the "reject_message" field.
+func (u *RequestPolicyUpsert) ClearRejectMessage() *RequestPolicyUpsert {
+	u.SetNull(requestpolicy.FieldRejectMessage)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RequestPolicyUpsert) SetDescription(v string) *RequestPolicyUpsert {
+	u.Set(requestpolicy.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequestPolicyUpsert) UpdateDescription() *RequestPolicyUpsert {
+	u.SetExcluded(requestpolicy.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequestPolicyUpsert) ClearDescription() *RequestPolicyUpsert {
+	u.SetNull(requestpolicy.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RequestPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequestPolicyUpsertOne) UpdateNewValues() *RequestPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(requestpolicy.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequestPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RequestPolicyUpsertOne) Ignore() *RequestPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequestPolicyUpsertOne) DoNothing() *RequestPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequestPolicyCreate.OnConflict
+// documentation for more info.
+func (u *RequestPolicyUpsertOne) Update(set func(*RequestPolicyUpsert)) *RequestPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequestPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequestPolicyUpsertOne) SetUpdatedAt(v time.Time) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateUpdatedAt() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RequestPolicyUpsertOne) SetName(v string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateName() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RequestPolicyUpsertOne) SetEnabled(v bool) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateEnabled() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RequestPolicyUpsertOne) SetPriority(v int) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RequestPolicyUpsertOne) AddPriority(v int) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdatePriority() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RequestPolicyUpsertOne) SetGroupIds(v []int64) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateGroupIds() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RequestPolicyUpsertOne) ClearGroupIds() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetProtocols sets the "protocols" field.
+func (u *RequestPolicyUpsertOne) SetProtocols(v []string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetProtocols(v)
+	})
+}
+
+// UpdateProtocols sets the "protocols" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateProtocols() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateProtocols()
+	})
+}
+
+// ClearProtocols clears the value of the "protocols" field.
+func (u *RequestPolicyUpsertOne) ClearProtocols() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearProtocols()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *RequestPolicyUpsertOne) SetKind(v string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateKind() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *RequestPolicyUpsertOne) SetAction(v string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateAction() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetDryRun sets the "dry_run" field.
+func (u *RequestPolicyUpsertOne) SetDryRun(v bool) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetDryRun(v)
+	})
+}
+
+// UpdateDryRun sets the "dry_run" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateDryRun() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateDryRun()
+	})
+}
+
+// SetLimitValue sets the "limit_value" field.
+func (u *RequestPolicyUpsertOne) SetLimitValue(v int) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetLimitValue(v)
+	})
+}
+
+// AddLimitValue adds v to the "limit_value" field.
+func (u *RequestPolicyUpsertOne) AddLimitValue(v int) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.AddLimitValue(v)
+	})
+}
+
+// UpdateLimitValue sets the "limit_value" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateLimitValue() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateLimitValue()
+	})
+}
+
+// ClearLimitValue clears the value of the "limit_value" field.
+func (u *RequestPolicyUpsertOne) ClearLimitValue() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearLimitValue()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *RequestPolicyUpsertOne) SetParams(v []string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateParams() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// ClearParams clears the value of the "params" field.
+func (u *RequestPolicyUpsertOne) ClearParams() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearParams()
+	})
+}
+
+// SetRejectMessage sets the "reject_message" field.
+func (u *RequestPolicyUpsertOne) SetRejectMessage(v string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetRejectMessage(v)
+	})
+}
+
+// UpdateRejectMessage sets the "reject_message" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateRejectMessage() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateRejectMessage()
+	})
+}
+
+// ClearRejectMessage clears the value of the "reject_message" field.
+func (u *RequestPolicyUpsertOne) ClearRejectMessage() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearRejectMessage()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RequestPolicyUpsertOne) SetDescription(v string) *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequestPolicyUpsertOne) UpdateDescription() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequestPolicyUpsertOne) ClearDescription() *RequestPolicyUpsertOne {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RequestPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequestPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequestPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RequestPolicyUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RequestPolicyUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RequestPolicyCreateBulk is the builder for creating many RequestPolicy entities in bulk.
+type RequestPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*RequestPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RequestPolicy entities in the database.
+func (_c *RequestPolicyCreateBulk) Save(ctx context.Context) ([]*RequestPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RequestPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RequestPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RequestPolicyCreateBulk) SaveX(ctx context.Context) []*RequestPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RequestPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RequestPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequestPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequestPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RequestPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *RequestPolicyUpsertBulk {
+	_c.conflict = opts
+	return &RequestPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequestPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RequestPolicyCreateBulk) OnConflictColumns(columns ...string) *RequestPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RequestPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// RequestPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of RequestPolicy nodes.
+type RequestPolicyUpsertBulk struct {
+	create *RequestPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RequestPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequestPolicyUpsertBulk) UpdateNewValues() *RequestPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(requestpolicy.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequestPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RequestPolicyUpsertBulk) Ignore() *RequestPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequestPolicyUpsertBulk) DoNothing() *RequestPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequestPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *RequestPolicyUpsertBulk) Update(set func(*RequestPolicyUpsert)) *RequestPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequestPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequestPolicyUpsertBulk) SetUpdatedAt(v time.Time) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateUpdatedAt() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RequestPolicyUpsertBulk) SetName(v string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateName() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RequestPolicyUpsertBulk) SetEnabled(v bool) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateEnabled() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RequestPolicyUpsertBulk) SetPriority(v int) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RequestPolicyUpsertBulk) AddPriority(v int) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdatePriority() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RequestPolicyUpsertBulk) SetGroupIds(v []int64) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateGroupIds() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RequestPolicyUpsertBulk) ClearGroupIds() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetProtocols sets the "protocols" field.
+func (u *RequestPolicyUpsertBulk) SetProtocols(v []string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetProtocols(v)
+	})
+}
+
+// UpdateProtocols sets the "protocols" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateProtocols() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateProtocols()
+	})
+}
+
+// ClearProtocols clears the value of the "protocols" field.
+func (u *RequestPolicyUpsertBulk) ClearProtocols() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearProtocols()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *RequestPolicyUpsertBulk) SetKind(v string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateKind() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *RequestPolicyUpsertBulk) SetAction(v string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateAction() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetDryRun sets the "dry_run" field.
+func (u *RequestPolicyUpsertBulk) SetDryRun(v bool) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetDryRun(v)
+	})
+}
+
+// UpdateDryRun sets the "dry_run" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateDryRun() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateDryRun()
+	})
+}
+
+// SetLimitValue sets the "limit_value" field.
+func (u *RequestPolicyUpsertBulk) SetLimitValue(v int) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetLimitValue(v)
+	})
+}
+
+// AddLimitValue adds v to the "limit_value" field.
+func (u *RequestPolicyUpsertBulk) AddLimitValue(v int) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.AddLimitValue(v)
+	})
+}
+
+// UpdateLimitValue sets the "limit_value" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateLimitValue() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateLimitValue()
+	})
+}
+
+// ClearLimitValue clears the value of the "limit_value" field.
+func (u *RequestPolicyUpsertBulk) ClearLimitValue() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearLimitValue()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *RequestPolicyUpsertBulk) SetParams(v []string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateParams() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// ClearParams clears the value of the "params" field.
+func (u *RequestPolicyUpsertBulk) ClearParams() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearParams()
+	})
+}
+
+// SetRejectMessage sets the "reject_message" field.
+func (u *RequestPolicyUpsertBulk) SetRejectMessage(v string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetRejectMessage(v)
+	})
+}
+
+// UpdateRejectMessage sets the "reject_message" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateRejectMessage() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateRejectMessage()
+	})
+}
+
+// ClearRejectMessage clears the value of the "reject_message" field.
+func (u *RequestPolicyUpsertBulk) ClearRejectMessage() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearRejectMessage()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RequestPolicyUpsertBulk) SetDescription(v string) *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequestPolicyUpsertBulk) UpdateDescription() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequestPolicyUpsertBulk) ClearDescription() *RequestPolicyUpsertBulk {
+	return u.Update(func(s *RequestPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RequestPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RequestPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequestPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequestPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/requestpolicy"
+)
+
+// RequestPolicyUpdate is the builder for updating RequestPolicy entities.
+type RequestPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RequestPolicyMutation
+}
+
+// Where appends a list predicates to the RequestPolicyUpdate builder.
+func (_u *RequestPolicyUpdate) Where(ps ...predicate.RequestPolicy) *RequestPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RequestPolicyUpdate) SetUpdatedAt(v time.Time) *RequestPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RequestPolicyUpdate) SetName(v string) *RequestPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableName(v *string) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RequestPolicyUpdate) SetEnabled(v bool) *RequestPolicyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableEnabled(v *bool) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RequestPolicyUpdate) SetPriority(v int) *RequestPolicyUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillablePriority(v *int) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RequestPolicyUpdate) AddPriority(v int) *RequestPolicyUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *RequestPolicyUpdate) SetGroupIds(v []int64) *RequestPolicyUpdate {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *RequestPolicyUpdate) AppendGroupIds(v []int64) *RequestPolicyUpdate {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *RequestPolicyUpdate) ClearGroupIds() *RequestPolicyUpdate {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetProtocols sets the "protocols" field.
+func (_u *RequestPolicyUpdate) SetProtocols(v []string) *RequestPolicyUpdate {
+	_u.mutation.SetProtocols(v)
+	return _u
+}
+
+// AppendProtocols appends value to the "protocols" field.
+func (_u *RequestPolicyUpdate) AppendProtocols(v []string) *RequestPolicyUpdate {
+	_u.mutation.AppendProtocols(v)
+	return _u
+}
+
+// ClearProtocols clears the value of the "protocols" field.
+func (_u *RequestPolicyUpdate) ClearProtocols() *RequestPolicyUpdate {
+	_u.mutation.ClearProtocols()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RequestPolicyUpdate) SetKind(v string) *RequestPolicyUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableKind(v *string) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *RequestPolicyUpdate) SetAction(v string) *RequestPolicyUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableAction(v *string) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *RequestPolicyUpdate) SetDryRun(v bool) *RequestPolicyUpdate {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableDryRun(v *bool) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetLimitValue sets the "limit_value" field.
+func (_u *RequestPolicyUpdate) SetLimitValue(v int) *RequestPolicyUpdate {
+	_u.mutation.ResetLimitValue()
+	_u.mutation.SetLimitValue(v)
+	return _u
+}
+
+// SetNillableLimitValue sets the "limit_value" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableLimitValue(v *int) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetLimitValue(*v)
+	}
+	return _u
+}
+
+// AddLimitValue adds value to the "limit_value" field.
+func (_u *RequestPolicyUpdate) AddLimitValue(v int) *RequestPolicyUpdate {
+	_u.mutation.AddLimitValue(v)
+	return _u
+}
+
+// ClearLimitValue clears the value of the "limit_value" field.
+func (_u *RequestPolicyUpdate) ClearLimitValue() *RequestPolicyUpdate {
+	_u.mutation.ClearLimitValue()
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *RequestPolicyUpdate) SetParams(v []string) *RequestPolicyUpdate {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// AppendParams appends value to the "params" field.
+func (_u *RequestPolicyUpdate) AppendParams(v []string) *RequestPolicyUpdate {
+	_u.mutation.AppendParams(v)
+	return _u
+}
+
+// ClearParams clears the value of the "params" field.
+func (_u *RequestPolicyUpdate) ClearParams() *RequestPolicyUpdate {
+	_u.mutation.ClearParams()
+	return _u
+}
+
+// SetRejectMessage sets the "reject_message" field.
+func (_u *RequestPolicyUpdate) SetRejectMessage(v string) *RequestPolicyUpdate {
+	_u.mutation.SetRejectMessage(v)
+	return _u
+}
+
+// SetNillableRejectMessage sets the "reject_message" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableRejectMessage(v *string) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetRejectMessage(*v)
+	}
+	return _u
+}
+
+// ClearRejectMessage clears the value of the "reject_message" field.
+func (_u *RequestPolicyUpdate) ClearRejectMessage() *RequestPolicyUpdate {
+	_u.mutation.ClearRejectMessage()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RequestPolicyUpdate) SetDescription(v string) *RequestPolicyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RequestPolicyUpdate) SetNillableDescription(v *string) *RequestPolicyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RequestPolicyUpdate) ClearDescription() *RequestPolicyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the RequestPolicyMutation object of the builder.
+func (_u *RequestPolicyUpdate) Mutation() *RequestPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RequestPolicyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RequestPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RequestPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RequestPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RequestPolicyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := requestpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RequestPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := requestpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := requestpolicy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := requestpolicy.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RequestPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requestpolicy.Table, requestpolicy.Columns, sqlgraph.NewFieldSpec(requestpolicy.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(requestpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(requestpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(requestpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(requestpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(requestpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(requestpolicy.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestpolicy.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(requestpolicy.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Protocols(); ok {
+		_spec.SetField(requestpolicy.FieldProtocols, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedProtocols(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestpolicy.FieldProtocols, value)
+		})
+	}
+	if _u.mutation.ProtocolsCleared() {
+		_spec.ClearField(requestpolicy.FieldProtocols, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(requestpolicy.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(requestpolicy.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(requestpolicy.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LimitValue(); ok {
+		_spec.SetField(requestpolicy.FieldLimitValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLimitValue(); ok {
+		_spec.AddField(requestpolicy.FieldLimitValue, field.TypeInt, value)
+	}
+	if _u.mutation.LimitValueCleared() {
+		_spec.ClearField(requestpolicy.FieldLimitValue, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(requestpolicy.FieldParams, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParams(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestpolicy.FieldParams, value)
+		})
+	}
+	if _u.mutation.ParamsCleared() {
+		_spec.ClearField(requestpolicy.FieldParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RejectMessage(); ok {
+		_spec.SetField(requestpolicy.FieldRejectMessage, field.TypeString, value)
+	}
+	if _u.mutation.RejectMessageCleared() {
+		_spec.ClearField(requestpolicy.FieldRejectMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(requestpolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(requestpolicy.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requestpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RequestPolicyUpdateOne is the builder for updating a single RequestPolicy entity.
+type RequestPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RequestPolicyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RequestPolicyUpdateOne) SetUpdatedAt(v time.Time) *RequestPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RequestPolicyUpdateOne) SetName(v string) *RequestPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableName(v *string) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RequestPolicyUpdateOne) SetEnabled(v bool) *RequestPolicyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableEnabled(v *bool) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RequestPolicyUpdateOne) SetPriority(v int) *RequestPolicyUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillablePriority(v *int) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RequestPolicyUpdateOne) AddPriority(v int) *RequestPolicyUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *RequestPolicyUpdateOne) SetGroupIds(v []int64) *RequestPolicyUpdateOne {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *RequestPolicyUpdateOne) AppendGroupIds(v []int64) *RequestPolicyUpdateOne {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *RequestPolicyUpdateOne) ClearGroupIds() *RequestPolicyUpdateOne {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetProtocols sets the "protocols" field.
+func (_u *RequestPolicyUpdateOne) SetProtocols(v []string) *RequestPolicyUpdateOne {
+	_u.mutation.SetProtocols(v)
+	return _u
+}
+
+// AppendProtocols appends value to the "protocols" field.
+func (_u *RequestPolicyUpdateOne) AppendProtocols(v []string) *RequestPolicyUpdateOne {
+	_u.mutation.AppendProtocols(v)
+	return _u
+}
+
+// ClearProtocols clears the value of the "protocols" field.
+func (_u *RequestPolicyUpdateOne) ClearProtocols() *RequestPolicyUpdateOne {
+	_u.mutation.ClearProtocols()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RequestPolicyUpdateOne) SetKind(v string) *RequestPolicyUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableKind(v *string) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *RequestPolicyUpdateOne) SetAction(v string) *RequestPolicyUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableAction(v *string) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *RequestPolicyUpdateOne) SetDryRun(v bool) *RequestPolicyUpdateOne {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableDryRun(v *bool) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetLimitValue sets the "limit_value" field.
+func (_u *RequestPolicyUpdateOne) SetLimitValue(v int) *RequestPolicyUpdateOne {
+	_u.mutation.ResetLimitValue()
+	_u.mutation.SetLimitValue(v)
+	return _u
+}
+
+// SetNillableLimitValue sets the "limit_value" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableLimitValue(v *int) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetLimitValue(*v)
+	}
+	return _u
+}
+
+// AddLimitValue adds value to the "limit_value" field.
+func (_u *RequestPolicyUpdateOne) AddLimitValue(v int) *RequestPolicyUpdateOne {
+	_u.mutation.AddLimitValue(v)
+	return _u
+}
+
+// ClearLimitValue clears the value of the "limit_value" field.
+func (_u *RequestPolicyUpdateOne) ClearLimitValue() *RequestPolicyUpdateOne {
+	_u.mutation.ClearLimitValue()
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *RequestPolicyUpdateOne) SetParams(v []string) *RequestPolicyUpdateOne {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// AppendParams appends value to the "params" field.
+func (_u *RequestPolicyUpdateOne) AppendParams(v []string) *RequestPolicyUpdateOne {
+	_u.mutation.AppendParams(v)
+	return _u
+}
+
+// ClearParams clears the value of the "params" field.
+func (_u *RequestPolicyUpdateOne) ClearParams() *RequestPolicyUpdateOne {
+	_u.mutation.ClearParams()
+	return _u
+}
+
+// SetRejectMessage sets the "reject_message" field.
+func (_u *RequestPolicyUpdateOne) SetRejectMessage(v string) *RequestPolicyUpdateOne {
+	_u.mutation.SetRejectMessage(v)
+	return _u
+}
+
+// SetNillableRejectMessage sets the "reject_message" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableRejectMessage(v *string) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetRejectMessage(*v)
+	}
+	return _u
+}
+
+// ClearRejectMessage clears the value of the "reject_message" field.
+func (_u *RequestPolicyUpdateOne) ClearRejectMessage() *RequestPolicyUpdateOne {
+	_u.mutation.ClearRejectMessage()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RequestPolicyUpdateOne) SetDescription(v string) *RequestPolicyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RequestPolicyUpdateOne) SetNillableDescription(v *string) *RequestPolicyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RequestPolicyUpdateOne) ClearDescription() *RequestPolicyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the RequestPolicyMutation object of the builder.
+func (_u *RequestPolicyUpdateOne) Mutation() *RequestPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RequestPolicyUpdate builder.
+func (_u *RequestPolicyUpdateOne) Where(ps ...predicate.RequestPolicy) *RequestPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RequestPolicyUpdateOne) Select(field string, fields ...string) *RequestPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RequestPolicy entity.
+func (_u *RequestPolicyUpdateOne) Save(ctx context.Context) (*RequestPolicy, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RequestPolicyUpdateOne) SaveX(ctx context.Context) *RequestPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RequestPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RequestPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RequestPolicyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := requestpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RequestPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := requestpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := requestpolicy.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := requestpolicy.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RequestPolicy.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RequestPolicyUpdateOne) sqlSave(ctx context.Context) (_node *RequestPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requestpolicy.Table, requestpolicy.Columns, sqlgraph.NewFieldSpec(requestpolicy.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RequestPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requestpolicy.FieldID)
+		for _, f := range fields {
+			if !requestpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != requestpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(requestpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(requestpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(requestpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(requestpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(requestpolicy.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(requestpolicy.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestpolicy.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(requestpolicy.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Protocols(); ok {
+		_spec.SetField(requestpolicy.FieldProtocols, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedProtocols(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestpolicy.FieldProtocols, value)
+		})
+	}
+	if _u.mutation.ProtocolsCleared() {
+		_spec.ClearField(requestpolicy.FieldProtocols, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(requestpolicy.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(requestpolicy.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(requestpolicy.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LimitValue(); ok {
+		_spec.SetField(requestpolicy.FieldLimitValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLimitValue(); ok {
+		_spec.AddField(requestpolicy.FieldLimitValue, field.TypeInt, value)
+	}
+	if _u.mutation.LimitValueCleared() {
+		_spec.ClearField(requestpolicy.FieldLimitValue, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(requestpolicy.FieldParams, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParams(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestpolicy.FieldParams, value)
+		})
+	}
+	if _u.mutation.ParamsCleared() {
+		_spec.ClearField(requestpolicy.FieldParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RejectMessage(); ok {
+		_spec.SetField(requestpolicy.FieldRejectMessage, field.TypeString, value)
+	}
+	if _u.mutation.RejectMessageCleared() {
+		_spec.ClearField(requestpolicy.FieldRejectMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(requestpolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(requestpolicy.FieldDescription, field.TypeString)
+	}
+	_node = &RequestPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requestpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
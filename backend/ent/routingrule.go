@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/routingrule"
+)
+
+// RoutingRule is the model entity for the RoutingRule schema.
+type RoutingRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// GroupIds holds the value of the "group_ids" field.
+	GroupIds []int64 `json:"group_ids,omitempty"`
+	// ModelPatterns holds the value of the "model_patterns" field.
+	ModelPatterns []string `json:"model_patterns,omitempty"`
+	// MaxTokensBelow holds the value of the "max_tokens_below" field.
+	MaxTokensBelow *int `json:"max_tokens_below,omitempty"`
+	// RequireNoTools holds the value of the "require_no_tools" field.
+	RequireNoTools bool `json:"require_no_tools,omitempty"`
+	// InputTokensBelow holds the value of the "input_tokens_below" field.
+	InputTokensBelow *int `json:"input_tokens_below,omitempty"`
+	// TargetGroupID holds the value of the "target_group_id" field.
+	TargetGroupID *int64 `json:"target_group_id,omitempty"`
+	// TargetAccountIds holds the value of the "target_account_ids" field.
+	TargetAccountIds []int64 `json:"target_account_ids,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  *string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoutingRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case routingrule.FieldGroupIds, routingrule.FieldModelPatterns, routingrule.FieldTargetAccountIds:
+			values[i] = new([]byte)
+		case routingrule.FieldEnabled, routingrule.FieldRequireNoTools:
+			values[i] = new(sql.NullBool)
+		case routingrule.FieldID, routingrule.FieldPriority, routingrule.FieldMaxTokensBelow, routingrule.FieldInputTokensBelow, routingrule.FieldTargetGroupID:
+			values[i] = new(sql.NullInt64)
+		case routingrule.FieldName, routingrule.FieldDescription:
+			values[i] = new(sql.NullString)
+		case routingrule.FieldCreatedAt, routingrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoutingRule fields.
+func (_m *RoutingRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case routingrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case routingrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case routingrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case routingrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case routingrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case routingrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case routingrule.FieldGroupIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupIds); err != nil {
+					return fmt.Errorf("unmarshal field group_ids: %w", err)
+				}
+			}
+		case routingrule.FieldModelPatterns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model_patterns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModelPatterns); err != nil {
+					return fmt.Errorf("unmarshal field model_patterns: %w", err)
+				}
+			}
+		case routingrule.FieldMaxTokensBelow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_tokens_below", values[i])
+			} else if value.Valid {
+				_m.MaxTokensBelow = new(int)
+				*_m.MaxTokensBelow = int(value.Int64)
+			}
+		case routingrule.FieldRequireNoTools:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_no_tools", values[i])
+			} else if value.Valid {
+				_m.RequireNoTools = value.Bool
+			}
+		case routingrule.FieldInputTokensBelow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_tokens_below", values[i])
+			} else if value.Valid {
+				_m.InputTokensBelow = new(int)
+				*_m.InputTokensBelow = int(value.Int64)
+			}
+		case routingrule.FieldTargetGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_group_id", values[i])
+			} else if value.Valid {
+				_m.TargetGroupID = new(int64)
+				*_m.TargetGroupID = value.Int64
+			}
+		case routingrule.FieldTargetAccountIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target_account_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TargetAccountIds); err != nil {
+					return fmt.Errorf("unmarshal field target_account_ids: %w", err)
+				}
+			}
+		case routingrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoutingRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoutingRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoutingRule.
+// Note that you need to call RoutingRule.Unwrap() before calling this method if this RoutingRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoutingRule) Update() *RoutingRuleUpdateOne {
+	return NewRoutingRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoutingRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoutingRule) Unwrap() *RoutingRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoutingRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoutingRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoutingRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupIds))
+	builder.WriteString(", ")
+	builder.WriteString("model_patterns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModelPatterns))
+	builder.WriteString(", ")
+	if v := _m.MaxTokensBelow; v != nil {
+		builder.WriteString("max_tokens_below=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("require_no_tools=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireNoTools))
+	builder.WriteString(", ")
+	if v := _m.InputTokensBelow; v != nil {
+		builder.WriteString("input_tokens_below=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TargetGroupID; v != nil {
+		builder.WriteString("target_group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_account_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetAccountIds))
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoutingRules is a parsable slice of RoutingRule.
+type RoutingRules []*RoutingRule
@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package routingrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the routingrule type in the database.
+	Label = "routing_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldGroupIds holds the string denoting the group_ids field in the database.
+	FieldGroupIds = "group_ids"
+	// FieldModelPatterns holds the string denoting the model_patterns field in the database.
+	FieldModelPatterns = "model_patterns"
+	// FieldMaxTokensBelow holds the string denoting the max_tokens_below field in the database.
+	FieldMaxTokensBelow = "max_tokens_below"
+	// FieldRequireNoTools holds the string denoting the require_no_tools field in the database.
+	FieldRequireNoTools = "require_no_tools"
+	// FieldInputTokensBelow holds the string denoting the input_tokens_below field in the database.
+	FieldInputTokensBelow = "input_tokens_below"
+	// FieldTargetGroupID holds the string denoting the target_group_id field in the database.
+	FieldTargetGroupID = "target_group_id"
+	// FieldTargetAccountIds holds the string denoting the target_account_ids field in the database.
+	FieldTargetAccountIds = "target_account_ids"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// Table holds the table name of the routingrule in the database.
+	Table = "routing_rules"
+)
+
+// Columns holds all SQL columns for routingrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldEnabled,
+	FieldPriority,
+	FieldGroupIds,
+	FieldModelPatterns,
+	FieldMaxTokensBelow,
+	FieldRequireNoTools,
+	FieldInputTokensBelow,
+	FieldTargetGroupID,
+	FieldTargetAccountIds,
+	FieldDescription,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultRequireNoTools holds the default value on creation for the "require_no_tools" field.
+	DefaultRequireNoTools bool
+)
+
+// OrderOption defines the ordering options for the RoutingRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByMaxTokensBelow orders the results by the max_tokens_below field.
+func ByMaxTokensBelow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTokensBelow, opts...).ToFunc()
+}
+
+// ByRequireNoTools orders the results by the require_no_tools field.
+func ByRequireNoTools(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireNoTools, opts...).ToFunc()
+}
+
+// ByInputTokensBelow orders the results by the input_tokens_below field.
+func ByInputTokensBelow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputTokensBelow, opts...).ToFunc()
+}
+
+// ByTargetGroupID orders the results by the target_group_id field.
+func ByTargetGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetGroupID, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
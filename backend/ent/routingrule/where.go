@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package routingrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// MaxTokensBelow applies equality check predicate on the "max_tokens_below" field. It's identical to MaxTokensBelowEQ.
+func MaxTokensBelow(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldMaxTokensBelow, v))
+}
+
+// RequireNoTools applies equality check predicate on the "require_no_tools" field. It's identical to RequireNoToolsEQ.
+func RequireNoTools(v bool) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldRequireNoTools, v))
+}
+
+// InputTokensBelow applies equality check predicate on the "input_tokens_below" field. It's identical to InputTokensBelowEQ.
+func InputTokensBelow(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldInputTokensBelow, v))
+}
+
+// TargetGroupID applies equality check predicate on the "target_group_id" field. It's identical to TargetGroupIDEQ.
+func TargetGroupID(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldTargetGroupID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// GroupIdsIsNil applies the IsNil predicate on the "group_ids" field.
+func GroupIdsIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldGroupIds))
+}
+
+// GroupIdsNotNil applies the NotNil predicate on the "group_ids" field.
+func GroupIdsNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldGroupIds))
+}
+
+// ModelPatternsIsNil applies the IsNil predicate on the "model_patterns" field.
+func ModelPatternsIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldModelPatterns))
+}
+
+// ModelPatternsNotNil applies the NotNil predicate on the "model_patterns" field.
+func ModelPatternsNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldModelPatterns))
+}
+
+// MaxTokensBelowEQ applies the EQ predicate on the "max_tokens_below" field.
+func MaxTokensBelowEQ(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldMaxTokensBelow, v))
+}
+
+// MaxTokensBelowNEQ applies the NEQ predicate on the "max_tokens_below" field.
+func MaxTokensBelowNEQ(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldMaxTokensBelow, v))
+}
+
+// MaxTokensBelowIn applies the In predicate on the "max_tokens_below" field.
+func MaxTokensBelowIn(vs ...int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldMaxTokensBelow, vs...))
+}
+
+// MaxTokensBelowNotIn applies the NotIn predicate on the "max_tokens_below" field.
+func MaxTokensBelowNotIn(vs ...int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldMaxTokensBelow, vs...))
+}
+
+// MaxTokensBelowGT applies the GT predicate on the "max_tokens_below" field.
+func MaxTokensBelowGT(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldMaxTokensBelow, v))
+}
+
+// MaxTokensBelowGTE applies the GTE predicate on the "max_tokens_below" field.
+func MaxTokensBelowGTE(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldMaxTokensBelow, v))
+}
+
+// MaxTokensBelowLT applies the LT predicate on the "max_tokens_below" field.
+func MaxTokensBelowLT(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldMaxTokensBelow, v))
+}
+
+// MaxTokensBelowLTE applies the LTE predicate on the "max_tokens_below" field.
+func MaxTokensBelowLTE(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldMaxTokensBelow, v))
+}
+
+// MaxTokensBelowIsNil applies the IsNil predicate on the "max_tokens_below" field.
+func MaxTokensBelowIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldMaxTokensBelow))
+}
+
+// MaxTokensBelowNotNil applies the NotNil predicate on the "max_tokens_below" field.
+func MaxTokensBelowNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldMaxTokensBelow))
+}
+
+// RequireNoToolsEQ applies the EQ predicate on the "require_no_tools" field.
+func RequireNoToolsEQ(v bool) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldRequireNoTools, v))
+}
+
+// RequireNoToolsNEQ applies the NEQ predicate on the "require_no_tools" field.
+func RequireNoToolsNEQ(v bool) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldRequireNoTools, v))
+}
+
+// InputTokensBelowEQ applies the EQ predicate on the "input_tokens_below" field.
+func InputTokensBelowEQ(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldInputTokensBelow, v))
+}
+
+// InputTokensBelowNEQ applies the NEQ predicate on the "input_tokens_below" field.
+func InputTokensBelowNEQ(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldInputTokensBelow, v))
+}
+
+// InputTokensBelowIn applies the In predicate on the "input_tokens_below" field.
+func InputTokensBelowIn(vs ...int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldInputTokensBelow, vs...))
+}
+
+// InputTokensBelowNotIn applies the NotIn predicate on the "input_tokens_below" field.
+func InputTokensBelowNotIn(vs ...int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldInputTokensBelow, vs...))
+}
+
+// InputTokensBelowGT applies the GT predicate on the "input_tokens_below" field.
+func InputTokensBelowGT(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldInputTokensBelow, v))
+}
+
+// InputTokensBelowGTE applies the GTE predicate on the "input_tokens_below" field.
+func InputTokensBelowGTE(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldInputTokensBelow, v))
+}
+
+// InputTokensBelowLT applies the LT predicate on the "input_tokens_below" field.
+func InputTokensBelowLT(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldInputTokensBelow, v))
+}
+
+// InputTokensBelowLTE applies the LTE predicate on the "input_tokens_below" field.
+func InputTokensBelowLTE(v int) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldInputTokensBelow, v))
+}
+
+// InputTokensBelowIsNil applies the IsNil predicate on the "input_tokens_below" field.
+func InputTokensBelowIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldInputTokensBelow))
+}
+
+// InputTokensBelowNotNil applies the NotNil predicate on the "input_tokens_below" field.
+func InputTokensBelowNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldInputTokensBelow))
+}
+
+// TargetGroupIDEQ applies the EQ predicate on the "target_group_id" field.
+func TargetGroupIDEQ(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldTargetGroupID, v))
+}
+
+// TargetGroupIDNEQ applies the NEQ predicate on the "target_group_id" field.
+func TargetGroupIDNEQ(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldTargetGroupID, v))
+}
+
+// TargetGroupIDIn applies the In predicate on the "target_group_id" field.
+func TargetGroupIDIn(vs ...int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldTargetGroupID, vs...))
+}
+
+// TargetGroupIDNotIn applies the NotIn predicate on the "target_group_id" field.
+func TargetGroupIDNotIn(vs ...int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldTargetGroupID, vs...))
+}
+
+// TargetGroupIDGT applies the GT predicate on the "target_group_id" field.
+func TargetGroupIDGT(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldTargetGroupID, v))
+}
+
+// TargetGroupIDGTE applies the GTE predicate on the "target_group_id" field.
+func TargetGroupIDGTE(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldTargetGroupID, v))
+}
+
+// TargetGroupIDLT applies the LT predicate on the "target_group_id" field.
+func TargetGroupIDLT(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldTargetGroupID, v))
+}
+
+// TargetGroupIDLTE applies the LTE predicate on the "target_group_id" field.
+func TargetGroupIDLTE(v int64) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldTargetGroupID, v))
+}
+
+// TargetGroupIDIsNil applies the IsNil predicate on the "target_group_id" field.
+func TargetGroupIDIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldTargetGroupID))
+}
+
+// TargetGroupIDNotNil applies the NotNil predicate on the "target_group_id" field.
+func TargetGroupIDNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldTargetGroupID))
+}
+
+// TargetAccountIdsIsNil applies the IsNil predicate on the "target_account_ids" field.
+func TargetAccountIdsIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldTargetAccountIds))
+}
+
+// TargetAccountIdsNotNil applies the NotNil predicate on the "target_account_ids" field.
+func TargetAccountIdsNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldTargetAccountIds))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoutingRule) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoutingRule) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoutingRule) predicate.RoutingRule {
+	return predicate.RoutingRule(sql.NotPredicates(p))
+}
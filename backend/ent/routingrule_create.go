@@ -0,0 +1,1365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/routingrule"
+)
+
+// RoutingRuleCreate is the builder for creating a RoutingRule entity.
+type RoutingRuleCreate struct {
+	config
+	mutation *RoutingRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RoutingRuleCreate) SetCreatedAt(v time.Time) *RoutingRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableCreatedAt(v *time.Time) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RoutingRuleCreate) SetUpdatedAt(v time.Time) *RoutingRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableUpdatedAt(v *time.Time) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *RoutingRuleCreate) SetName(v string) *RoutingRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RoutingRuleCreate) SetEnabled(v bool) *RoutingRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableEnabled(v *bool) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *RoutingRuleCreate) SetPriority(v int) *RoutingRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillablePriority(v *int) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_c *RoutingRuleCreate) SetGroupIds(v []int64) *RoutingRuleCreate {
+	_c.mutation.SetGroupIds(v)
+	return _c
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (_c *RoutingRuleCreate) SetModelPatterns(v []string) *RoutingRuleCreate {
+	_c.mutation.SetModelPatterns(v)
+	return _c
+}
+
+// SetMaxTokensBelow sets the "max_tokens_below" field.
+func (_c *RoutingRuleCreate) SetMaxTokensBelow(v int) *RoutingRuleCreate {
+	_c.mutation.SetMaxTokensBelow(v)
+	return _c
+}
+
+// SetNillableMaxTokensBelow sets the "max_tokens_below" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableMaxTokensBelow(v *int) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetMaxTokensBelow(*v)
+	}
+	return _c
+}
+
+// SetRequireNoTools sets the "require_no_tools" field.
+func (_c *RoutingRuleCreate) SetRequireNoTools(v bool) *RoutingRuleCreate {
+	_c.mutation.SetRequireNoTools(v)
+	return _c
+}
+
+// SetNillableRequireNoTools sets the "require_no_tools" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableRequireNoTools(v *bool) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetRequireNoTools(*v)
+	}
+	return _c
+}
+
+// SetInputTokensBelow sets the "input_tokens_below" field.
+func (_c *RoutingRuleCreate) SetInputTokensBelow(v int) *RoutingRuleCreate {
+	_c.mutation.SetInputTokensBelow(v)
+	return _c
+}
+
+// SetNillableInputTokensBelow sets the "input_tokens_below" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableInputTokensBelow(v *int) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetInputTokensBelow(*v)
+	}
+	return _c
+}
+
+// SetTargetGroupID sets the "target_group_id" field.
+func (_c *RoutingRuleCreate) SetTargetGroupID(v int64) *RoutingRuleCreate {
+	_c.mutation.SetTargetGroupID(v)
+	return _c
+}
+
+// SetNillableTargetGroupID sets the "target_group_id" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableTargetGroupID(v *int64) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetTargetGroupID(*v)
+	}
+	return _c
+}
+
+// SetTargetAccountIds sets the "target_account_ids" field.
+func (_c *RoutingRuleCreate) SetTargetAccountIds(v []int64) *RoutingRuleCreate {
+	_c.mutation.SetTargetAccountIds(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RoutingRuleCreate) SetDescription(v string) *RoutingRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *RoutingRuleCreate) SetNillableDescription(v *string) *RoutingRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RoutingRuleMutation object of the builder.
+func (_c *RoutingRuleCreate) Mutation() *RoutingRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RoutingRule in the database.
+func (_c *RoutingRuleCreate) Save(ctx context.Context) (*RoutingRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoutingRuleCreate) SaveX(ctx context.Context) *RoutingRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoutingRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoutingRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoutingRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := routingrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := routingrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := routingrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := routingrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.RequireNoTools(); !ok {
+		v := routingrule.DefaultRequireNoTools
+		_c.mutation.SetRequireNoTools(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoutingRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoutingRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RoutingRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RoutingRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := routingrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RoutingRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RoutingRule.priority"`)}
+	}
+	if _, ok := _c.mutation.RequireNoTools(); !ok {
+		return &ValidationError{Name: "require_no_tools", err: errors.New(`ent: missing required field "RoutingRule.require_no_tools"`)}
+	}
+	return nil
+}
+
+func (_c *RoutingRuleCreate) sqlSave(ctx context.Context) (*RoutingRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoutingRuleCreate) createSpec() (*RoutingRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoutingRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(routingrule.Table, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(routingrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(routingrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(routingrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(routingrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(routingrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.GroupIds(); ok {
+		_spec.SetField(routingrule.FieldGroupIds, field.TypeJSON, value)
+		_node.GroupIds = value
+	}
+	if value, ok := _c.mutation.ModelPatterns(); ok {
+		_spec.SetField(routingrule.FieldModelPatterns, field.TypeJSON, value)
+		_node.ModelPatterns = value
+	}
+	if value, ok := _c.mutation.MaxTokensBelow(); ok {
+		_spec.SetField(routingrule.FieldMaxTokensBelow, field.TypeInt, value)
+		_node.MaxTokensBelow = &value
+	}
+	if value, ok := _c.mutation.RequireNoTools(); ok {
+		_spec.SetField(routingrule.FieldRequireNoTools, field.TypeBool, value)
+		_node.RequireNoTools = value
+	}
+	if value, ok := _c.mutation.InputTokensBelow(); ok {
+		_spec.SetField(routingrule.FieldInputTokensBelow, field.TypeInt, value)
+		_node.InputTokensBelow = &value
+	}
+	if value, ok := _c.mutation.TargetGroupID(); ok {
+		_spec.SetField(routingrule.FieldTargetGroupID, field.TypeInt64, value)
+		_node.TargetGroupID = &value
+	}
+	if value, ok := _c.mutation.TargetAccountIds(); ok {
+		_spec.SetField(routingrule.FieldTargetAccountIds, field.TypeJSON, value)
+		_node.TargetAccountIds = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(routingrule.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoutingRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoutingRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RoutingRuleCreate) OnConflict(opts ...sql.ConflictOption) *RoutingRuleUpsertOne {
+	_c.conflict = opts
+	return &RoutingRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RoutingRuleCreate) OnConflictColumns(columns ...string) *RoutingRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RoutingRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RoutingRuleUpsertOne is the builder for "upsert"-ing
+	//  one RoutingRule node.
+	RoutingRuleUpsertOne struct {
+		create *RoutingRuleCreate
+	}
+
+	// RoutingRuleUpsert is the "OnConflict" setter.
+	RoutingRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoutingRuleUpsert) SetUpdatedAt(v time.Time) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateUpdatedAt() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RoutingRuleUpsert) SetName(v string) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateName() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RoutingRuleUpsert) SetEnabled(v bool) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateEnabled() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldEnabled)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *RoutingRuleUpsert) SetPriority(v int) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdatePriority() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RoutingRuleUpsert) AddPriority(v int) *RoutingRuleUpsert {
+	u.Add(routingrule.FieldPriority, v)
+	return u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RoutingRuleUpsert) SetGroupIds(v []int64) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldGroupIds, v)
+	return u
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateGroupIds() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldGroupIds)
+	return u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RoutingRuleUpsert) ClearGroupIds() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldGroupIds)
+	return u
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (u *RoutingRuleUpsert) SetModelPatterns(v []string) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldModelPatterns, v)
+	return u
+}
+
+// UpdateModelPatterns sets the "model_patterns" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateModelPatterns() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldModelPatterns)
+	return u
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (u *RoutingRuleUpsert) ClearModelPatterns() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldModelPatterns)
+	return u
+}
+
+// SetMaxTokensBelow sets the "max_tokens_below" field.
+func (u *RoutingRuleUpsert) SetMaxTokensBelow(v int) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldMaxTokensBelow, v)
+	return u
+}
+
+// UpdateMaxTokensBelow sets the "max_tokens_below" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateMaxTokensBelow() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldMaxTokensBelow)
+	return u
+}
+
+// AddMaxTokensBelow adds v to the "max_tokens_below" field.
+func (u *RoutingRuleUpsert) AddMaxTokensBelow(v int) *RoutingRuleUpsert {
+	u.Add(routingrule.FieldMaxTokensBelow, v)
+	return u
+}
+
+// ClearMaxTokensBelow clears the value of the "max_tokens_below" field.
+func (u *RoutingRuleUpsert) ClearMaxTokensBelow() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldMaxTokensBelow)
+	return u
+}
+
+// SetRequireNoTools sets the "require_no_tools" field.
+func (u *RoutingRuleUpsert) SetRequireNoTools(v bool) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldRequireNoTools, v)
+	return u
+}
+
+// UpdateRequireNoTools sets the "require_no_tools" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateRequireNoTools() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldRequireNoTools)
+	return u
+}
+
+// SetInputTokensBelow sets the "input_tokens_below" field.
+func (u *RoutingRuleUpsert) SetInputTokensBelow(v int) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldInputTokensBelow, v)
+	return u
+}
+
+// UpdateInputTokensBelow sets the "input_tokens_below" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateInputTokensBelow() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldInputTokensBelow)
+	return u
+}
+
+// AddInputTokensBelow adds v to the "input_tokens_below" field.
+func (u *RoutingRuleUpsert) AddInputTokensBelow(v int) *RoutingRuleUpsert {
+	u.Add(routingrule.FieldInputTokensBelow, v)
+	return u
+}
+
+// ClearInputTokensBelow clears the value of the "input_tokens_below" field.
+func (u *RoutingRuleUpsert) ClearInputTokensBelow() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldInputTokensBelow)
+	return u
+}
+
+// SetTargetGroupID sets the "target_group_id" field.
+func (u *RoutingRuleUpsert) SetTargetGroupID(v int64) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldTargetGroupID, v)
+	return u
+}
+
+// UpdateTargetGroupID sets the "target_group_id" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateTargetGroupID() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldTargetGroupID)
+	return u
+}
+
+// AddTargetGroupID adds v to the "target_group_id" field.
+func (u *RoutingRuleUpsert) AddTargetGroupID(v int64) *RoutingRuleUpsert {
+	u.Add(routingrule.FieldTargetGroupID, v)
+	return u
+}
+
+// ClearTargetGroupID clears the value of the "target_group_id" field.
+func (u *RoutingRuleUpsert) ClearTargetGroupID() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldTargetGroupID)
+	return u
+}
+
+// SetTargetAccountIds sets the "target_account_ids" field.
+func (u *RoutingRuleUpsert) SetTargetAccountIds(v []int64) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldTargetAccountIds, v)
+	return u
+}
+
+// UpdateTargetAccountIds sets the "target_account_ids" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateTargetAccountIds() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldTargetAccountIds)
+	return u
+}
+
+// ClearTargetAccountIds clears the value of the "target_account_ids" field.
+func (u *RoutingRuleUpsert) ClearTargetAccountIds() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldTargetAccountIds)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RoutingRuleUpsert) SetDescription(v string) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateDescription() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RoutingRuleUpsert) ClearDescription() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RoutingRuleUpsertOne) UpdateNewValues() *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(routingrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoutingRuleUpsertOne) Ignore() *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoutingRuleUpsertOne) DoNothing() *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoutingRuleCreate.OnConflict
+// documentation for more info.
+func (u *RoutingRuleUpsertOne) Update(set func(*RoutingRuleUpsert)) *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoutingRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoutingRuleUpsertOne) SetUpdatedAt(v time.Time) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateUpdatedAt() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RoutingRuleUpsertOne) SetName(v string) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateName() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RoutingRuleUpsertOne) SetEnabled(v bool) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateEnabled() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RoutingRuleUpsertOne) SetPriority(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RoutingRuleUpsertOne) AddPriority(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdatePriority() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RoutingRuleUpsertOne) SetGroupIds(v []int64) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateGroupIds() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RoutingRuleUpsertOne) ClearGroupIds() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (u *RoutingRuleUpsertOne) SetModelPatterns(v []string) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetModelPatterns(v)
+	})
+}
+
+// UpdateModelPatterns sets the "model_patterns" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateModelPatterns() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateModelPatterns()
+	})
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (u *RoutingRuleUpsertOne) ClearModelPatterns() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearModelPatterns()
+	})
+}
+
+// SetMaxTokensBelow sets the "max_tokens_below" field.
+func (u *RoutingRuleUpsertOne) SetMaxTokensBelow(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetMaxTokensBelow(v)
+	})
+}
+
+// AddMaxTokensBelow adds v to the "max_tokens_below" field.
+func (u *RoutingRuleUpsertOne) AddMaxTokensBelow(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddMaxTokensBelow(v)
+	})
+}
+
+// UpdateMaxTokensBelow sets the "max_tokens_below" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateMaxTokensBelow() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateMaxTokensBelow()
+	})
+}
+
+// ClearMaxTokensBelow clears the value of the "max_tokens_below" field.
+func (u *RoutingRuleUpsertOne) ClearMaxTokensBelow() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearMaxTokensBelow()
+	})
+}
+
+// SetRequireNoTools sets the "require_no_tools" field.
+func (u *RoutingRuleUpsertOne) SetRequireNoTools(v bool) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetRequireNoTools(v)
+	})
+}
+
+// UpdateRequireNoTools sets the "require_no_tools" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateRequireNoTools() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateRequireNoTools()
+	})
+}
+
+// SetInputTokensBelow sets the "input_tokens_below" field.
+func (u *RoutingRuleUpsertOne) SetInputTokensBelow(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetInputTokensBelow(v)
+	})
+}
+
+// AddInputTokensBelow adds v to the "input_tokens_below" field.
+func (u *RoutingRuleUpsertOne) AddInputTokensBelow(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddInputTokensBelow(v)
+	})
+}
+
+// UpdateInputTokensBelow sets the "input_tokens_below" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateInputTokensBelow() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateInputTokensBelow()
+	})
+}
+
+// ClearInputTokensBelow clears the value of the "input_tokens_below" field.
+func (u *RoutingRuleUpsertOne) ClearInputTokensBelow() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearInputTokensBelow()
+	})
+}
+
+// SetTargetGroupID sets the "target_group_id" field.
+func (u *RoutingRuleUpsertOne) SetTargetGroupID(v int64) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetTargetGroupID(v)
+	})
+}
+
+// AddTargetGroupID adds v to the "target_group_id" field.
+func (u *RoutingRuleUpsertOne) AddTargetGroupID(v int64) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddTargetGroupID(v)
+	})
+}
+
+// UpdateTargetGroupID sets the "target_group_id" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateTargetGroupID() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateTargetGroupID()
+	})
+}
+
+// ClearTargetGroupID clears the value of the "target_group_id" field.
+func (u *RoutingRuleUpsertOne) ClearTargetGroupID() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearTargetGroupID()
+	})
+}
+
+// SetTargetAccountIds sets the "target_account_ids" field.
+func (u *RoutingRuleUpsertOne) SetTargetAccountIds(v []int64) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetTargetAccountIds(v)
+	})
+}
+
+// UpdateTargetAccountIds sets the "target_account_ids" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateTargetAccountIds() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateTargetAccountIds()
+	})
+}
+
+// ClearTargetAccountIds clears the value of the "target_account_ids" field.
+func (u *RoutingRuleUpsertOne) ClearTargetAccountIds() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearTargetAccountIds()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RoutingRuleUpsertOne) SetDescription(v string) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateDescription() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RoutingRuleUpsertOne) ClearDescription() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RoutingRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoutingRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoutingRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoutingRuleUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoutingRuleUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoutingRuleCreateBulk is the builder for creating many RoutingRule entities in bulk.
+type RoutingRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RoutingRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoutingRule entities in the database.
+func (_c *RoutingRuleCreateBulk) Save(ctx context.Context) ([]*RoutingRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoutingRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoutingRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoutingRuleCreateBulk) SaveX(ctx context.Context) []*RoutingRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoutingRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoutingRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoutingRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoutingRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RoutingRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoutingRuleUpsertBulk {
+	_c.conflict = opts
+	return &RoutingRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RoutingRuleCreateBulk) OnConflictColumns(columns ...string) *RoutingRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RoutingRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RoutingRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoutingRule nodes.
+type RoutingRuleUpsertBulk struct {
+	create *RoutingRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RoutingRuleUpsertBulk) UpdateNewValues() *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(routingrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoutingRuleUpsertBulk) Ignore() *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoutingRuleUpsertBulk) DoNothing() *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoutingRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoutingRuleUpsertBulk) Update(set func(*RoutingRuleUpsert)) *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoutingRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoutingRuleUpsertBulk) SetUpdatedAt(v time.Time) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateUpdatedAt() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RoutingRuleUpsertBulk) SetName(v string) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateName() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RoutingRuleUpsertBulk) SetEnabled(v bool) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateEnabled() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RoutingRuleUpsertBulk) SetPriority(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RoutingRuleUpsertBulk) AddPriority(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdatePriority() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RoutingRuleUpsertBulk) SetGroupIds(v []int64) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateGroupIds() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RoutingRuleUpsertBulk) ClearGroupIds() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (u *RoutingRuleUpsertBulk) SetModelPatterns(v []string) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetModelPatterns(v)
+	})
+}
+
+// UpdateModelPatterns sets the "model_patterns" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateModelPatterns() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateModelPatterns()
+	})
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (u *RoutingRuleUpsertBulk) ClearModelPatterns() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearModelPatterns()
+	})
+}
+
+// SetMaxTokensBelow sets the "max_tokens_below" field.
+func (u *RoutingRuleUpsertBulk) SetMaxTokensBelow(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetMaxTokensBelow(v)
+	})
+}
+
+// AddMaxTokensBelow adds v to the "max_tokens_below" field.
+func (u *RoutingRuleUpsertBulk) AddMaxTokensBelow(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddMaxTokensBelow(v)
+	})
+}
+
+// UpdateMaxTokensBelow sets the "max_tokens_below" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateMaxTokensBelow() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateMaxTokensBelow()
+	})
+}
+
+// ClearMaxTokensBelow clears the value of the "max_tokens_below" field.
+func (u *RoutingRuleUpsertBulk) ClearMaxTokensBelow() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearMaxTokensBelow()
+	})
+}
+
+// SetRequireNoTools sets the "require_no_tools" field.
+func (u *RoutingRuleUpsertBulk) SetRequireNoTools(v bool) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetRequireNoTools(v)
+	})
+}
+
+// UpdateRequireNoTools sets the "require_no_tools" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateRequireNoTools() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateRequireNoTools()
+	})
+}
+
+// SetInputTokensBelow sets the "input_tokens_below" field.
+func (u *RoutingRuleUpsertBulk) SetInputTokensBelow(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetInputTokensBelow(v)
+	})
+}
+
+// AddInputTokensBelow adds v to the "input_tokens_below" field.
+func (u *RoutingRuleUpsertBulk) AddInputTokensBelow(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddInputTokensBelow(v)
+	})
+}
+
+// UpdateInputTokensBelow sets the "input_tokens_below" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateInputTokensBelow() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateInputTokensBelow()
+	})
+}
+
+// ClearInputTokensBelow clears the value of the "input_tokens_below" field.
+func (u *RoutingRuleUpsertBulk) ClearInputTokensBelow() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearInputTokensBelow()
+	})
+}
+
+// SetTargetGroupID sets the "target_group_id" field.
+func (u *RoutingRuleUpsertBulk) SetTargetGroupID(v int64) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetTargetGroupID(v)
+	})
+}
+
+// AddTargetGroupID adds v to the "target_group_id" field.
+func (u *RoutingRuleUpsertBulk) AddTargetGroupID(v int64) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddTargetGroupID(v)
+	})
+}
+
+// UpdateTargetGroupID sets the "target_group_id" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateTargetGroupID() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateTargetGroupID()
+	})
+}
+
+// ClearTargetGroupID clears the value of the "target_group_id" field.
+func (u *RoutingRuleUpsertBulk) ClearTargetGroupID() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearTargetGroupID()
+	})
+}
+
+// SetTargetAccountIds sets the "target_account_ids" field.
+func (u *RoutingRuleUpsertBulk) SetTargetAccountIds(v []int64) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetTargetAccountIds(v)
+	})
+}
+
+// UpdateTargetAccountIds sets the "target_account_ids" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateTargetAccountIds() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateTargetAccountIds()
+	})
+}
+
+// ClearTargetAccountIds clears the value of the "target_account_ids" field.
+func (u *RoutingRuleUpsertBulk) ClearTargetAccountIds() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearTargetAccountIds()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RoutingRuleUpsertBulk) SetDescription(v string) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateDescription() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RoutingRuleUpsertBulk) ClearDescription() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RoutingRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoutingRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoutingRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoutingRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/routingrule"
+)
+
+// RoutingRuleUpdate is the builder for updating RoutingRule entities.
+type RoutingRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoutingRuleMutation
+}
+
+// Where appends a list predicates to the RoutingRuleUpdate builder.
+func (_u *RoutingRuleUpdate) Where(ps ...predicate.RoutingRule) *RoutingRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RoutingRuleUpdate) SetUpdatedAt(v time.Time) *RoutingRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoutingRuleUpdate) SetName(v string) *RoutingRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableName(v *string) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RoutingRuleUpdate) SetEnabled(v bool) *RoutingRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableEnabled(v *bool) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RoutingRuleUpdate) SetPriority(v int) *RoutingRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillablePriority(v *int) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RoutingRuleUpdate) AddPriority(v int) *RoutingRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *RoutingRuleUpdate) SetGroupIds(v []int64) *RoutingRuleUpdate {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *RoutingRuleUpdate) AppendGroupIds(v []int64) *RoutingRuleUpdate {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *RoutingRuleUpdate) ClearGroupIds() *RoutingRuleUpdate {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (_u *RoutingRuleUpdate) SetModelPatterns(v []string) *RoutingRuleUpdate {
+	_u.mutation.SetModelPatterns(v)
+	return _u
+}
+
+// AppendModelPatterns appends value to the "model_patterns" field.
+func (_u *RoutingRuleUpdate) AppendModelPatterns(v []string) *RoutingRuleUpdate {
+	_u.mutation.AppendModelPatterns(v)
+	return _u
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (_u *RoutingRuleUpdate) ClearModelPatterns() *RoutingRuleUpdate {
+	_u.mutation.ClearModelPatterns()
+	return _u
+}
+
+// SetMaxTokensBelow sets the "max_tokens_below" field.
+func (_u *RoutingRuleUpdate) SetMaxTokensBelow(v int) *RoutingRuleUpdate {
+	_u.mutation.ResetMaxTokensBelow()
+	_u.mutation.SetMaxTokensBelow(v)
+	return _u
+}
+
+// SetNillableMaxTokensBelow sets the "max_tokens_below" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableMaxTokensBelow(v *int) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetMaxTokensBelow(*v)
+	}
+	return _u
+}
+
+// AddMaxTokensBelow adds value to the "max_tokens_below" field.
+func (_u *RoutingRuleUpdate) AddMaxTokensBelow(v int) *RoutingRuleUpdate {
+	_u.mutation.AddMaxTokensBelow(v)
+	return _u
+}
+
+// ClearMaxTokensBelow clears the value of the "max_tokens_below" field.
+func (_u *RoutingRuleUpdate) ClearMaxTokensBelow() *RoutingRuleUpdate {
+	_u.mutation.ClearMaxTokensBelow()
+	return _u
+}
+
+// SetRequireNoTools sets the "require_no_tools" field.
+func (_u *RoutingRuleUpdate) SetRequireNoTools(v bool) *RoutingRuleUpdate {
+	_u.mutation.SetRequireNoTools(v)
+	return _u
+}
+
+// SetNillableRequireNoTools sets the "require_no_tools" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableRequireNoTools(v *bool) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetRequireNoTools(*v)
+	}
+	return _u
+}
+
+// SetInputTokensBelow sets the "input_tokens_below" field.
+func (_u *RoutingRuleUpdate) SetInputTokensBelow(v int) *RoutingRuleUpdate {
+	_u.mutation.ResetInputTokensBelow()
+	_u.mutation.SetInputTokensBelow(v)
+	return _u
+}
+
+// SetNillableInputTokensBelow sets the "input_tokens_below" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableInputTokensBelow(v *int) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetInputTokensBelow(*v)
+	}
+	return _u
+}
+
+// AddInputTokensBelow adds value to the "input_tokens_below" field.
+func (_u *RoutingRuleUpdate) AddInputTokensBelow(v int) *RoutingRuleUpdate {
+	_u.mutation.AddInputTokensBelow(v)
+	return _u
+}
+
+// ClearInputTokensBelow clears the value of the "input_tokens_below" field.
+func (_u *RoutingRuleUpdate) ClearInputTokensBelow() *RoutingRuleUpdate {
+	_u.mutation.ClearInputTokensBelow()
+	return _u
+}
+
+// SetTargetGroupID sets the "target_group_id" field.
+func (_u *RoutingRuleUpdate) SetTargetGroupID(v int64) *RoutingRuleUpdate {
+	_u.mutation.ResetTargetGroupID()
+	_u.mutation.SetTargetGroupID(v)
+	return _u
+}
+
+// SetNillableTargetGroupID sets the "target_group_id" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableTargetGroupID(v *int64) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetTargetGroupID(*v)
+	}
+	return _u
+}
+
+// AddTargetGroupID adds value to the "target_group_id" field.
+func (_u *RoutingRuleUpdate) AddTargetGroupID(v int64) *RoutingRuleUpdate {
+	_u.mutation.AddTargetGroupID(v)
+	return _u
+}
+
+// ClearTargetGroupID clears the value of the "target_group_id" field.
+func (_u *RoutingRuleUpdate) ClearTargetGroupID() *RoutingRuleUpdate {
+	_u.mutation.ClearTargetGroupID()
+	return _u
+}
+
+// SetTargetAccountIds sets the "target_account_ids" field.
+func (_u *RoutingRuleUpdate) SetTargetAccountIds(v []int64) *RoutingRuleUpdate {
+	_u.mutation.SetTargetAccountIds(v)
+	return _u
+}
+
+// AppendTargetAccountIds appends value to the "target_account_ids" field.
+func (_u *RoutingRuleUpdate) AppendTargetAccountIds(v []int64) *RoutingRuleUpdate {
+	_u.mutation.AppendTargetAccountIds(v)
+	return _u
+}
+
+// ClearTargetAccountIds clears the value of the "target_account_ids" field.
+func (_u *RoutingRuleUpdate) ClearTargetAccountIds() *RoutingRuleUpdate {
+	_u.mutation.ClearTargetAccountIds()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoutingRuleUpdate) SetDescription(v string) *RoutingRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoutingRuleUpdate) SetNillableDescription(v *string) *RoutingRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RoutingRuleUpdate) ClearDescription() *RoutingRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the RoutingRuleMutation object of the builder.
+func (_u *RoutingRuleUpdate) Mutation() *RoutingRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoutingRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoutingRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoutingRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoutingRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RoutingRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := routingrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoutingRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := routingrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoutingRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(routingrule.Table, routingrule.Columns, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(routingrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(routingrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(routingrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(routingrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, routingrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(routingrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelPatterns(); ok {
+		_spec.SetField(routingrule.FieldModelPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, routingrule.FieldModelPatterns, value)
+		})
+	}
+	if _u.mutation.ModelPatternsCleared() {
+		_spec.ClearField(routingrule.FieldModelPatterns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxTokensBelow(); ok {
+		_spec.SetField(routingrule.FieldMaxTokensBelow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokensBelow(); ok {
+		_spec.AddField(routingrule.FieldMaxTokensBelow, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensBelowCleared() {
+		_spec.ClearField(routingrule.FieldMaxTokensBelow, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RequireNoTools(); ok {
+		_spec.SetField(routingrule.FieldRequireNoTools, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InputTokensBelow(); ok {
+		_spec.SetField(routingrule.FieldInputTokensBelow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTokensBelow(); ok {
+		_spec.AddField(routingrule.FieldInputTokensBelow, field.TypeInt, value)
+	}
+	if _u.mutation.InputTokensBelowCleared() {
+		_spec.ClearField(routingrule.FieldInputTokensBelow, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TargetGroupID(); ok {
+		_spec.SetField(routingrule.FieldTargetGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetGroupID(); ok {
+		_spec.AddField(routingrule.FieldTargetGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.TargetGroupIDCleared() {
+		_spec.ClearField(routingrule.FieldTargetGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TargetAccountIds(); ok {
+		_spec.SetField(routingrule.FieldTargetAccountIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetAccountIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, routingrule.FieldTargetAccountIds, value)
+		})
+	}
+	if _u.mutation.TargetAccountIdsCleared() {
+		_spec.ClearField(routingrule.FieldTargetAccountIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(routingrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(routingrule.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{routingrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoutingRuleUpdateOne is the builder for updating a single RoutingRule entity.
+type RoutingRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoutingRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RoutingRuleUpdateOne) SetUpdatedAt(v time.Time) *RoutingRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoutingRuleUpdateOne) SetName(v string) *RoutingRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableName(v *string) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RoutingRuleUpdateOne) SetEnabled(v bool) *RoutingRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableEnabled(v *bool) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RoutingRuleUpdateOne) SetPriority(v int) *RoutingRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillablePriority(v *int) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RoutingRuleUpdateOne) AddPriority(v int) *RoutingRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *RoutingRuleUpdateOne) SetGroupIds(v []int64) *RoutingRuleUpdateOne {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *RoutingRuleUpdateOne) AppendGroupIds(v []int64) *RoutingRuleUpdateOne {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *RoutingRuleUpdateOne) ClearGroupIds() *RoutingRuleUpdateOne {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (_u *RoutingRuleUpdateOne) SetModelPatterns(v []string) *RoutingRuleUpdateOne {
+	_u.mutation.SetModelPatterns(v)
+	return _u
+}
+
+// AppendModelPatterns appends value to the "model_patterns" field.
+func (_u *RoutingRuleUpdateOne) AppendModelPatterns(v []string) *RoutingRuleUpdateOne {
+	_u.mutation.AppendModelPatterns(v)
+	return _u
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (_u *RoutingRuleUpdateOne) ClearModelPatterns() *RoutingRuleUpdateOne {
+	_u.mutation.ClearModelPatterns()
+	return _u
+}
+
+// SetMaxTokensBelow sets the "max_tokens_below" field.
+func (_u *RoutingRuleUpdateOne) SetMaxTokensBelow(v int) *RoutingRuleUpdateOne {
+	_u.mutation.ResetMaxTokensBelow()
+	_u.mutation.SetMaxTokensBelow(v)
+	return _u
+}
+
+// SetNillableMaxTokensBelow sets the "max_tokens_below" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableMaxTokensBelow(v *int) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxTokensBelow(*v)
+	}
+	return _u
+}
+
+// AddMaxTokensBelow adds value to the "max_tokens_below" field.
+func (_u *RoutingRuleUpdateOne) AddMaxTokensBelow(v int) *RoutingRuleUpdateOne {
+	_u.mutation.AddMaxTokensBelow(v)
+	return _u
+}
+
+// ClearMaxTokensBelow clears the value of the "max_tokens_below" field.
+func (_u *RoutingRuleUpdateOne) ClearMaxTokensBelow() *RoutingRuleUpdateOne {
+	_u.mutation.ClearMaxTokensBelow()
+	return _u
+}
+
+// SetRequireNoTools sets the "require_no_tools" field.
+func (_u *RoutingRuleUpdateOne) SetRequireNoTools(v bool) *RoutingRuleUpdateOne {
+	_u.mutation.SetRequireNoTools(v)
+	return _u
+}
+
+// SetNillableRequireNoTools sets the "require_no_tools" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableRequireNoTools(v *bool) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetRequireNoTools(*v)
+	}
+	return _u
+}
+
+// SetInputTokensBelow sets the "input_tokens_below" field.
+func (_u *RoutingRuleUpdateOne) SetInputTokensBelow(v int) *RoutingRuleUpdateOne {
+	_u.mutation.ResetInputTokensBelow()
+	_u.mutation.SetInputTokensBelow(v)
+	return _u
+}
+
+// SetNillableInputTokensBelow sets the "input_tokens_below" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableInputTokensBelow(v *int) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetInputTokensBelow(*v)
+	}
+	return _u
+}
+
+// AddInputTokensBelow adds value to the "input_tokens_below" field.
+func (_u *RoutingRuleUpdateOne) AddInputTokensBelow(v int) *RoutingRuleUpdateOne {
+	_u.mutation.AddInputTokensBelow(v)
+	return _u
+}
+
+// ClearInputTokensBelow clears the value of the "input_tokens_below" field.
+func (_u *RoutingRuleUpdateOne) ClearInputTokensBelow() *RoutingRuleUpdateOne {
+	_u.mutation.ClearInputTokensBelow()
+	return _u
+}
+
+// SetTargetGroupID sets the "target_group_id" field.
+func (_u *RoutingRuleUpdateOne) SetTargetGroupID(v int64) *RoutingRuleUpdateOne {
+	_u.mutation.ResetTargetGroupID()
+	_u.mutation.SetTargetGroupID(v)
+	return _u
+}
+
+// SetNillableTargetGroupID sets the "target_group_id" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableTargetGroupID(v *int64) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetTargetGroupID(*v)
+	}
+	return _u
+}
+
+// AddTargetGroupID adds value to the "target_group_id" field.
+func (_u *RoutingRuleUpdateOne) AddTargetGroupID(v int64) *RoutingRuleUpdateOne {
+	_u.mutation.AddTargetGroupID(v)
+	return _u
+}
+
+// ClearTargetGroupID clears the value of the "target_group_id" field.
+func (_u *RoutingRuleUpdateOne) ClearTargetGroupID() *RoutingRuleUpdateOne {
+	_u.mutation.ClearTargetGroupID()
+	return _u
+}
+
+// SetTargetAccountIds sets the "target_account_ids" field.
+func (_u *RoutingRuleUpdateOne) SetTargetAccountIds(v []int64) *RoutingRuleUpdateOne {
+	_u.mutation.SetTargetAccountIds(v)
+	return _u
+}
+
+// AppendTargetAccountIds appends value to the "target_account_ids" field.
+func (_u *RoutingRuleUpdateOne) AppendTargetAccountIds(v []int64) *RoutingRuleUpdateOne {
+	_u.mutation.AppendTargetAccountIds(v)
+	return _u
+}
+
+// ClearTargetAccountIds clears the value of the "target_account_ids" field.
+func (_u *RoutingRuleUpdateOne) ClearTargetAccountIds() *RoutingRuleUpdateOne {
+	_u.mutation.ClearTargetAccountIds()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoutingRuleUpdateOne) SetDescription(v string) *RoutingRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoutingRuleUpdateOne) SetNillableDescription(v *string) *RoutingRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RoutingRuleUpdateOne) ClearDescription() *RoutingRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the RoutingRuleMutation object of the builder.
+func (_u *RoutingRuleUpdateOne) Mutation() *RoutingRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RoutingRuleUpdate builder.
+func (_u *RoutingRuleUpdateOne) Where(ps ...predicate.RoutingRule) *RoutingRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoutingRuleUpdateOne) Select(field string, fields ...string) *RoutingRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoutingRule entity.
+func (_u *RoutingRuleUpdateOne) Save(ctx context.Context) (*RoutingRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoutingRuleUpdateOne) SaveX(ctx context.Context) *RoutingRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoutingRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoutingRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RoutingRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := routingrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoutingRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := routingrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RoutingRuleUpdateOne) sqlSave(ctx context.Context) (_node *RoutingRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(routingrule.Table, routingrule.Columns, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoutingRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, routingrule.FieldID)
+		for _, f := range fields {
+			if !routingrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != routingrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(routingrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(routingrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(routingrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(routingrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, routingrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(routingrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelPatterns(); ok {
+		_spec.SetField(routingrule.FieldModelPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, routingrule.FieldModelPatterns, value)
+		})
+	}
+	if _u.mutation.ModelPatternsCleared() {
+		_spec.ClearField(routingrule.FieldModelPatterns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxTokensBelow(); ok {
+		_spec.SetField(routingrule.FieldMaxTokensBelow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTokensBelow(); ok {
+		_spec.AddField(routingrule.FieldMaxTokensBelow, field.TypeInt, value)
+	}
+	if _u.mutation.MaxTokensBelowCleared() {
+		_spec.ClearField(routingrule.FieldMaxTokensBelow, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RequireNoTools(); ok {
+		_spec.SetField(routingrule.FieldRequireNoTools, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InputTokensBelow(); ok {
+		_spec.SetField(routingrule.FieldInputTokensBelow, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTokensBelow(); ok {
+		_spec.AddField(routingrule.FieldInputTokensBelow, field.TypeInt, value)
+	}
+	if _u.mutation.InputTokensBelowCleared() {
+		_spec.ClearField(routingrule.FieldInputTokensBelow, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TargetGroupID(); ok {
+		_spec.SetField(routingrule.FieldTargetGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetGroupID(); ok {
+		_spec.AddField(routingrule.FieldTargetGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.TargetGroupIDCleared() {
+		_spec.ClearField(routingrule.FieldTargetGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TargetAccountIds(); ok {
+		_spec.SetField(routingrule.FieldTargetAccountIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetAccountIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, routingrule.FieldTargetAccountIds, value)
+		})
+	}
+	if _u.mutation.TargetAccountIdsCleared() {
+		_spec.ClearField(routingrule.FieldTargetAccountIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(routingrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(routingrule.FieldDescription, field.TypeString)
+	}
+	_node = &RoutingRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{routingrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -10,12 +10,17 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/announcement"
 	"github.com/Wei-Shaw/sub2api/ent/announcementread"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/clientrule"
 	"github.com/Wei-Shaw/sub2api/ent/errorpassthroughrule"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
+	"github.com/Wei-Shaw/sub2api/ent/requestpolicy"
+	"github.com/Wei-Shaw/sub2api/ent/routingrule"
 	"github.com/Wei-Shaw/sub2api/ent/schema"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
@@ -25,6 +30,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/userattributedefinition"
 	"github.com/Wei-Shaw/sub2api/ent/userattributevalue"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -271,6 +277,61 @@ func init() {
 	announcementreadDescCreatedAt := announcementreadFields[3].Descriptor()
 	// announcementread.DefaultCreatedAt holds the default value on creation for the created_at field.
 	announcementread.DefaultCreatedAt = announcementreadDescCreatedAt.Default.(func() time.Time)
+	clientruleMixin := schema.ClientRule{}.Mixin()
+	clientruleMixinFields0 := clientruleMixin[0].Fields()
+	_ = clientruleMixinFields0
+	clientruleFields := schema.ClientRule{}.Fields()
+	_ = clientruleFields
+	// clientruleDescCreatedAt is the schema descriptor for created_at field.
+	clientruleDescCreatedAt := clientruleMixinFields0[0].Descriptor()
+	// clientrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clientrule.DefaultCreatedAt = clientruleDescCreatedAt.Default.(func() time.Time)
+	// clientruleDescUpdatedAt is the schema descriptor for updated_at field.
+	clientruleDescUpdatedAt := clientruleMixinFields0[1].Descriptor()
+	// clientrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	clientrule.DefaultUpdatedAt = clientruleDescUpdatedAt.Default.(func() time.Time)
+	// clientrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	clientrule.UpdateDefaultUpdatedAt = clientruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// clientruleDescName is the schema descriptor for name field.
+	clientruleDescName := clientruleFields[0].Descriptor()
+	// clientrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	clientrule.NameValidator = func() func(string) error {
+		validators := clientruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// clientruleDescEnabled is the schema descriptor for enabled field.
+	clientruleDescEnabled := clientruleFields[1].Descriptor()
+	// clientrule.DefaultEnabled holds the default value on creation for the enabled field.
+	clientrule.DefaultEnabled = clientruleDescEnabled.Default.(bool)
+	// clientruleDescPriority is the schema descriptor for priority field.
+	clientruleDescPriority := clientruleFields[2].Descriptor()
+	// clientrule.DefaultPriority holds the default value on creation for the priority field.
+	clientrule.DefaultPriority = clientruleDescPriority.Default.(int)
+	// clientruleDescPreset is the schema descriptor for preset field.
+	clientruleDescPreset := clientruleFields[4].Descriptor()
+	// clientrule.DefaultPreset holds the default value on creation for the preset field.
+	clientrule.DefaultPreset = clientruleDescPreset.Default.(string)
+	// clientrule.PresetValidator is a validator for the "preset" field. It is called by the builders before save.
+	clientrule.PresetValidator = clientruleDescPreset.Validators[0].(func(string) error)
+	// clientruleDescUserAgentPattern is the schema descriptor for user_agent_pattern field.
+	clientruleDescUserAgentPattern := clientruleFields[5].Descriptor()
+	// clientrule.UserAgentPatternValidator is a validator for the "user_agent_pattern" field. It is called by the builders before save.
+	clientrule.UserAgentPatternValidator = clientruleDescUserAgentPattern.Validators[0].(func(string) error)
+	// clientruleDescMetadataUserIDPattern is the schema descriptor for metadata_user_id_pattern field.
+	clientruleDescMetadataUserIDPattern := clientruleFields[7].Descriptor()
+	// clientrule.MetadataUserIDPatternValidator is a validator for the "metadata_user_id_pattern" field. It is called by the builders before save.
+	clientrule.MetadataUserIDPatternValidator = clientruleDescMetadataUserIDPattern.Validators[0].(func(string) error)
 	errorpassthroughruleMixin := schema.ErrorPassthroughRule{}.Mixin()
 	errorpassthroughruleMixinFields0 := errorpassthroughruleMixin[0].Fields()
 	_ = errorpassthroughruleMixinFields0
@@ -417,6 +478,138 @@ func init() {
 	groupDescSortOrder := groupFields[21].Descriptor()
 	// group.DefaultSortOrder holds the default value on creation for the sort_order field.
 	group.DefaultSortOrder = groupDescSortOrder.Default.(int)
+	// groupDescModelFallbacks is the schema descriptor for model_fallbacks field.
+	groupDescModelFallbacks := groupFields[22].Descriptor()
+	// group.DefaultModelFallbacks holds the default value on creation for the model_fallbacks field.
+	group.DefaultModelFallbacks = groupDescModelFallbacks.Default.([]domain.ModelFallbackRule)
+	// groupDescModelRateMultipliers is the schema descriptor for model_rate_multipliers field.
+	groupDescModelRateMultipliers := groupFields[23].Descriptor()
+	// group.DefaultModelRateMultipliers holds the default value on creation for the model_rate_multipliers field.
+	group.DefaultModelRateMultipliers = groupDescModelRateMultipliers.Default.(map[string]float64)
+	// groupDescSchedulingStrategy is the schema descriptor for scheduling_strategy field.
+	groupDescSchedulingStrategy := groupFields[24].Descriptor()
+	// group.DefaultSchedulingStrategy holds the default value on creation for the scheduling_strategy field.
+	group.DefaultSchedulingStrategy = groupDescSchedulingStrategy.Default.(string)
+	// group.SchedulingStrategyValidator is a validator for the "scheduling_strategy" field. It is called by the builders before save.
+	group.SchedulingStrategyValidator = groupDescSchedulingStrategy.Validators[0].(func(string) error)
+	// groupDescFirstByteTimeoutSeconds is the schema descriptor for first_byte_timeout_seconds field.
+	groupDescFirstByteTimeoutSeconds := groupFields[25].Descriptor()
+	// group.DefaultFirstByteTimeoutSeconds holds the default value on creation for the first_byte_timeout_seconds field.
+	group.DefaultFirstByteTimeoutSeconds = groupDescFirstByteTimeoutSeconds.Default.(int)
+	guardrailruleMixin := schema.GuardrailRule{}.Mixin()
+	guardrailruleMixinFields0 := guardrailruleMixin[0].Fields()
+	_ = guardrailruleMixinFields0
+	guardrailruleFields := schema.GuardrailRule{}.Fields()
+	_ = guardrailruleFields
+	// guardrailruleDescCreatedAt is the schema descriptor for created_at field.
+	guardrailruleDescCreatedAt := guardrailruleMixinFields0[0].Descriptor()
+	// guardrailrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	guardrailrule.DefaultCreatedAt = guardrailruleDescCreatedAt.Default.(func() time.Time)
+	// guardrailruleDescUpdatedAt is the schema descriptor for updated_at field.
+	guardrailruleDescUpdatedAt := guardrailruleMixinFields0[1].Descriptor()
+	// guardrailrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	guardrailrule.DefaultUpdatedAt = guardrailruleDescUpdatedAt.Default.(func() time.Time)
+	// guardrailrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	guardrailrule.UpdateDefaultUpdatedAt = guardrailruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// guardrailruleDescName is the schema descriptor for name field.
+	guardrailruleDescName := guardrailruleFields[0].Descriptor()
+	// guardrailrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	guardrailrule.NameValidator = func() func(string) error {
+		validators := guardrailruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// guardrailruleDescEnabled is the schema descriptor for enabled field.
+	guardrailruleDescEnabled := guardrailruleFields[1].Descriptor()
+	// guardrailrule.DefaultEnabled holds the default value on creation for the enabled field.
+	guardrailrule.DefaultEnabled = guardrailruleDescEnabled.Default.(bool)
+	// guardrailruleDescPriority is the schema descriptor for priority field.
+	guardrailruleDescPriority := guardrailruleFields[2].Descriptor()
+	// guardrailrule.DefaultPriority holds the default value on creation for the priority field.
+	guardrailrule.DefaultPriority = guardrailruleDescPriority.Default.(int)
+	// guardrailruleDescMatchType is the schema descriptor for match_type field.
+	guardrailruleDescMatchType := guardrailruleFields[4].Descriptor()
+	// guardrailrule.MatchTypeValidator is a validator for the "match_type" field. It is called by the builders before save.
+	guardrailrule.MatchTypeValidator = guardrailruleDescMatchType.Validators[0].(func(string) error)
+	// guardrailruleDescAction is the schema descriptor for action field.
+	guardrailruleDescAction := guardrailruleFields[6].Descriptor()
+	// guardrailrule.DefaultAction holds the default value on creation for the action field.
+	guardrailrule.DefaultAction = guardrailruleDescAction.Default.(string)
+	// guardrailrule.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	guardrailrule.ActionValidator = guardrailruleDescAction.Validators[0].(func(string) error)
+	modelpriceMixin := schema.ModelPrice{}.Mixin()
+	modelpriceMixinFields0 := modelpriceMixin[0].Fields()
+	_ = modelpriceMixinFields0
+	modelpriceFields := schema.ModelPrice{}.Fields()
+	_ = modelpriceFields
+	// modelpriceDescCreatedAt is the schema descriptor for created_at field.
+	modelpriceDescCreatedAt := modelpriceMixinFields0[0].Descriptor()
+	// modelprice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	modelprice.DefaultCreatedAt = modelpriceDescCreatedAt.Default.(func() time.Time)
+	// modelpriceDescUpdatedAt is the schema descriptor for updated_at field.
+	modelpriceDescUpdatedAt := modelpriceMixinFields0[1].Descriptor()
+	// modelprice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	modelprice.DefaultUpdatedAt = modelpriceDescUpdatedAt.Default.(func() time.Time)
+	// modelprice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	modelprice.UpdateDefaultUpdatedAt = modelpriceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// modelpriceDescModel is the schema descriptor for model field.
+	modelpriceDescModel := modelpriceFields[0].Descriptor()
+	// modelprice.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	modelprice.ModelValidator = func() func(string) error {
+		validators := modelpriceDescModel.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(model string) error {
+			for _, fn := range fns {
+				if err := fn(model); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// modelpriceDescPlatform is the schema descriptor for platform field.
+	modelpriceDescPlatform := modelpriceFields[2].Descriptor()
+	// modelprice.DefaultPlatform holds the default value on creation for the platform field.
+	modelprice.DefaultPlatform = modelpriceDescPlatform.Default.(string)
+	// modelprice.PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	modelprice.PlatformValidator = modelpriceDescPlatform.Validators[0].(func(string) error)
+	// modelpriceDescInputPrice is the schema descriptor for input_price field.
+	modelpriceDescInputPrice := modelpriceFields[3].Descriptor()
+	// modelprice.DefaultInputPrice holds the default value on creation for the input_price field.
+	modelprice.DefaultInputPrice = modelpriceDescInputPrice.Default.(float64)
+	// modelpriceDescOutputPrice is the schema descriptor for output_price field.
+	modelpriceDescOutputPrice := modelpriceFields[4].Descriptor()
+	// modelprice.DefaultOutputPrice holds the default value on creation for the output_price field.
+	modelprice.DefaultOutputPrice = modelpriceDescOutputPrice.Default.(float64)
+	// modelpriceDescCacheWritePrice is the schema descriptor for cache_write_price field.
+	modelpriceDescCacheWritePrice := modelpriceFields[5].Descriptor()
+	// modelprice.DefaultCacheWritePrice holds the default value on creation for the cache_write_price field.
+	modelprice.DefaultCacheWritePrice = modelpriceDescCacheWritePrice.Default.(float64)
+	// modelpriceDescCacheReadPrice is the schema descriptor for cache_read_price field.
+	modelpriceDescCacheReadPrice := modelpriceFields[6].Descriptor()
+	// modelprice.DefaultCacheReadPrice holds the default value on creation for the cache_read_price field.
+	modelprice.DefaultCacheReadPrice = modelpriceDescCacheReadPrice.Default.(float64)
+	// modelpriceDescEffectiveFrom is the schema descriptor for effective_from field.
+	modelpriceDescEffectiveFrom := modelpriceFields[8].Descriptor()
+	// modelprice.DefaultEffectiveFrom holds the default value on creation for the effective_from field.
+	modelprice.DefaultEffectiveFrom = modelpriceDescEffectiveFrom.Default.(func() time.Time)
+	// modelpriceDescEnabled is the schema descriptor for enabled field.
+	modelpriceDescEnabled := modelpriceFields[9].Descriptor()
+	// modelprice.DefaultEnabled holds the default value on creation for the enabled field.
+	modelprice.DefaultEnabled = modelpriceDescEnabled.Default.(bool)
 	promocodeFields := schema.PromoCode{}.Fields()
 	_ = promocodeFields
 	// promocodeDescCode is the schema descriptor for code field.
@@ -602,6 +795,106 @@ func init() {
 	redeemcodeDescValidityDays := redeemcodeFields[9].Descriptor()
 	// redeemcode.DefaultValidityDays holds the default value on creation for the validity_days field.
 	redeemcode.DefaultValidityDays = redeemcodeDescValidityDays.Default.(int)
+	requestpolicyMixin := schema.RequestPolicy{}.Mixin()
+	requestpolicyMixinFields0 := requestpolicyMixin[0].Fields()
+	_ = requestpolicyMixinFields0
+	requestpolicyFields := schema.RequestPolicy{}.Fields()
+	_ = requestpolicyFields
+	// requestpolicyDescCreatedAt is the schema descriptor for created_at field.
+	requestpolicyDescCreatedAt := requestpolicyMixinFields0[0].Descriptor()
+	// requestpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	requestpolicy.DefaultCreatedAt = requestpolicyDescCreatedAt.Default.(func() time.Time)
+	// requestpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	requestpolicyDescUpdatedAt := requestpolicyMixinFields0[1].Descriptor()
+	// requestpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	requestpolicy.DefaultUpdatedAt = requestpolicyDescUpdatedAt.Default.(func() time.Time)
+	// requestpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	requestpolicy.UpdateDefaultUpdatedAt = requestpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// requestpolicyDescName is the schema descriptor for name field.
+	requestpolicyDescName := requestpolicyFields[0].Descriptor()
+	// requestpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	requestpolicy.NameValidator = func() func(string) error {
+		validators := requestpolicyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// requestpolicyDescEnabled is the schema descriptor for enabled field.
+	requestpolicyDescEnabled := requestpolicyFields[1].Descriptor()
+	// requestpolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	requestpolicy.DefaultEnabled = requestpolicyDescEnabled.Default.(bool)
+	// requestpolicyDescPriority is the schema descriptor for priority field.
+	requestpolicyDescPriority := requestpolicyFields[2].Descriptor()
+	// requestpolicy.DefaultPriority holds the default value on creation for the priority field.
+	requestpolicy.DefaultPriority = requestpolicyDescPriority.Default.(int)
+	// requestpolicyDescKind is the schema descriptor for kind field.
+	requestpolicyDescKind := requestpolicyFields[5].Descriptor()
+	// requestpolicy.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	requestpolicy.KindValidator = requestpolicyDescKind.Validators[0].(func(string) error)
+	// requestpolicyDescAction is the schema descriptor for action field.
+	requestpolicyDescAction := requestpolicyFields[6].Descriptor()
+	// requestpolicy.DefaultAction holds the default value on creation for the action field.
+	requestpolicy.DefaultAction = requestpolicyDescAction.Default.(string)
+	// requestpolicy.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	requestpolicy.ActionValidator = requestpolicyDescAction.Validators[0].(func(string) error)
+	// requestpolicyDescDryRun is the schema descriptor for dry_run field.
+	requestpolicyDescDryRun := requestpolicyFields[7].Descriptor()
+	// requestpolicy.DefaultDryRun holds the default value on creation for the dry_run field.
+	requestpolicy.DefaultDryRun = requestpolicyDescDryRun.Default.(bool)
+	routingruleMixin := schema.RoutingRule{}.Mixin()
+	routingruleMixinFields0 := routingruleMixin[0].Fields()
+	_ = routingruleMixinFields0
+	routingruleFields := schema.RoutingRule{}.Fields()
+	_ = routingruleFields
+	// routingruleDescCreatedAt is the schema descriptor for created_at field.
+	routingruleDescCreatedAt := routingruleMixinFields0[0].Descriptor()
+	// routingrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	routingrule.DefaultCreatedAt = routingruleDescCreatedAt.Default.(func() time.Time)
+	// routingruleDescUpdatedAt is the schema descriptor for updated_at field.
+	routingruleDescUpdatedAt := routingruleMixinFields0[1].Descriptor()
+	// routingrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	routingrule.DefaultUpdatedAt = routingruleDescUpdatedAt.Default.(func() time.Time)
+	// routingrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	routingrule.UpdateDefaultUpdatedAt = routingruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// routingruleDescName is the schema descriptor for name field.
+	routingruleDescName := routingruleFields[0].Descriptor()
+	// routingrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	routingrule.NameValidator = func() func(string) error {
+		validators := routingruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// routingruleDescEnabled is the schema descriptor for enabled field.
+	routingruleDescEnabled := routingruleFields[1].Descriptor()
+	// routingrule.DefaultEnabled holds the default value on creation for the enabled field.
+	routingrule.DefaultEnabled = routingruleDescEnabled.Default.(bool)
+	// routingruleDescPriority is the schema descriptor for priority field.
+	routingruleDescPriority := routingruleFields[2].Descriptor()
+	// routingrule.DefaultPriority holds the default value on creation for the priority field.
+	routingrule.DefaultPriority = routingruleDescPriority.Default.(int)
+	// routingruleDescRequireNoTools is the schema descriptor for require_no_tools field.
+	routingruleDescRequireNoTools := routingruleFields[6].Descriptor()
+	// routingrule.DefaultRequireNoTools holds the default value on creation for the require_no_tools field.
+	routingrule.DefaultRequireNoTools = routingruleDescRequireNoTools.Default.(bool)
 	settingFields := schema.Setting{}.Fields()
 	_ = settingFields
 	// settingDescKey is the schema descriptor for key field.
@@ -852,24 +1145,28 @@ func init() {
 	userDescConcurrency := userFields[4].Descriptor()
 	// user.DefaultConcurrency holds the default value on creation for the concurrency field.
 	user.DefaultConcurrency = userDescConcurrency.Default.(int)
+	// userDescQueueWeight is the schema descriptor for queue_weight field.
+	userDescQueueWeight := userFields[5].Descriptor()
+	// user.DefaultQueueWeight holds the default value on creation for the queue_weight field.
+	user.DefaultQueueWeight = userDescQueueWeight.Default.(int)
 	// userDescStatus is the schema descriptor for status field.
-	userDescStatus := userFields[5].Descriptor()
+	userDescStatus := userFields[6].Descriptor()
 	// user.DefaultStatus holds the default value on creation for the status field.
 	user.DefaultStatus = userDescStatus.Default.(string)
 	// user.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	user.StatusValidator = userDescStatus.Validators[0].(func(string) error)
 	// userDescUsername is the schema descriptor for username field.
-	userDescUsername := userFields[6].Descriptor()
+	userDescUsername := userFields[7].Descriptor()
 	// user.DefaultUsername holds the default value on creation for the username field.
 	user.DefaultUsername = userDescUsername.Default.(string)
 	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
 	// userDescNotes is the schema descriptor for notes field.
-	userDescNotes := userFields[7].Descriptor()
+	userDescNotes := userFields[8].Descriptor()
 	// user.DefaultNotes holds the default value on creation for the notes field.
 	user.DefaultNotes = userDescNotes.Default.(string)
 	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
-	userDescTotpEnabled := userFields[9].Descriptor()
+	userDescTotpEnabled := userFields[10].Descriptor()
 	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
 	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
 	userallowedgroupFields := schema.UserAllowedGroup{}.Fields()
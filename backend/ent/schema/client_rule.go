@@ -0,0 +1,105 @@
+// Package schema 定义 Ent ORM 的数据库 schema。
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ClientRule 定义客户端准入规则的 schema。
+//
+// 客户端准入规则是 claude_code_only 的通用化版本，按分组限制允许访问的客户端：
+//   - 预设：claude_code / codex_cli / gemini_cli
+//   - 自定义匹配：User-Agent 正则、必需请求头、metadata.user_id 格式、请求体必需字段
+//   - 分组命中任一规则即放行；全部不满足时转入降级分组或拒绝
+type ClientRule struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (ClientRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "client_rules"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (ClientRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义客户端准入规则实体的所有字段。
+func (ClientRule) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 规则名称，用于在界面中标识规则
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用该规则
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 规则优先级，数值越小越先匹配；降级分组取第一条配置了降级分组的规则
+		field.Int("priority").
+			Default(0),
+
+		// group_ids: 适用分组 ID 列表，空列表表示适用于所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// preset: 内置客户端预设（claude_code / codex_cli / gemini_cli），为空表示仅使用自定义匹配
+		field.String("preset").
+			MaxLen(32).
+			Default(""),
+
+		// user_agent_pattern: User-Agent 正则
+		field.String("user_agent_pattern").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+
+		// required_headers: 必需请求头，格式为 "Name" 或 "Name: 正则"
+		field.JSON("required_headers", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// metadata_user_id_pattern: metadata.user_id 正则
+		field.String("metadata_user_id_pattern").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+
+		// body_fields: 请求体必需字段（gjson 路径）
+		field.JSON("body_fields", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// fallback_group_id: 不满足规则时转入的降级分组，为空时直接拒绝
+		field.Int64("fallback_group_id").
+			Optional().
+			Nillable(),
+
+		// description: 规则描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (ClientRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
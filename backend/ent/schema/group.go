@@ -126,6 +126,29 @@ func (Group) Fields() []ent.Field {
 		field.Int("sort_order").
 			Default(0).
 			Comment("分组显示排序，数值越小越靠前"),
+
+		// 模型降级链 (added by migration 054)
+		field.JSON("model_fallbacks", []domain.ModelFallbackRule{}).
+			Default([]domain.ModelFallbackRule{}).
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("模型降级链：请求模型 -> 有序备选模型及触发条件"),
+
+		// 分组内模型倍率 (added by migration 056)
+		field.JSON("model_rate_multipliers", map[string]float64{}).
+			Default(map[string]float64{}).
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("模型倍率：模型匹配模式（支持末尾 * 通配符）-> 在分组/用户倍率之上叠加的倍率"),
+
+		// 账号调度策略 (added by migration 057)
+		field.String("scheduling_strategy").
+			MaxLen(32).
+			Default("").
+			Comment("账号调度策略：default/weighted_round_robin/least_cost/least_latency/fill_first，空表示使用全局默认"),
+
+		// 流式首字节预算 (added by migration 059)
+		field.Int("first_byte_timeout_seconds").
+			Default(0).
+			Comment("流式请求首字节预算（秒），超时未收到上游数据时切换账号，0 表示使用全局配置"),
 	}
 }
 
@@ -0,0 +1,98 @@
+// Package schema 定义 Ent ORM 的数据库 schema。
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GuardrailRule 定义内容护栏规则的 schema。
+//
+// 内容护栏在转发前扫描请求文本（system、messages、工具结果）：
+//   - 匹配方式：关键词（keyword）、正则（regex）、PII 检测（pii：email / phone / card / api_key）
+//   - 处理动作：拦截（block）、脱敏（mask）、仅标记（flag）
+//   - 按分组启用：group_ids 为空表示适用于所有分组
+type GuardrailRule struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (GuardrailRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "guardrail_rules"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (GuardrailRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义内容护栏规则实体的所有字段。
+func (GuardrailRule) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 规则名称，用于在界面和运维日志中标识规则
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用该规则
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 规则优先级，数值越小越先执行
+		field.Int("priority").
+			Default(0),
+
+		// group_ids: 适用分组 ID 列表，空列表表示适用于所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// match_type: 匹配方式
+		// - "keyword": 关键词（不区分大小写）
+		// - "regex": 正则表达式
+		// - "pii": 内置 PII 检测器
+		field.String("match_type").
+			MaxLen(16),
+
+		// patterns: 关键词/正则列表；match_type 为 pii 时为检测器名称列表
+		field.JSON("patterns", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// action: 命中时的处理动作
+		// - "block": 拦截请求
+		// - "mask": 将命中内容替换为占位符后继续转发
+		// - "flag": 仅记录到运维日志
+		field.String("action").
+			MaxLen(10).
+			Default("flag"),
+
+		// block_message: 拦截时返回给客户端的错误信息，为空时使用默认信息
+		field.Text("block_message").
+			Optional().
+			Nillable(),
+
+		// description: 规则描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (GuardrailRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
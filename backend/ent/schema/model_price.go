@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ModelPrice 定义管理员维护的模型价格覆盖表。
+//
+// 计费时优先于 LiteLLM 动态价格与硬编码回退价格：
+//   - 作用范围：全局 / 指定平台 / 指定分组，越具体优先级越高
+//   - 模型匹配：精确匹配优先，其次为最长前缀通配（如 "claude-opus-*"）
+//   - 生效时间：同一范围内取 effective_from 不晚于计费时间的最新一条，支持预设调价与历史追溯
+type ModelPrice struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (ModelPrice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "model_prices"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (ModelPrice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义模型价格实体的所有字段。
+func (ModelPrice) Fields() []ent.Field {
+	return []ent.Field{
+		// model: 模型名称，支持末尾 * 通配
+		field.String("model").
+			MaxLen(100).
+			NotEmpty(),
+
+		// group_id: 限定分组，为空表示不限分组
+		field.Int64("group_id").
+			Optional().
+			Nillable(),
+
+		// platform: 限定平台，为空表示全部平台
+		field.String("platform").
+			MaxLen(50).
+			Default(""),
+
+		// 价格单位：USD / 百万 token
+		field.Float("input_price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.Float("output_price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.Float("cache_write_price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.Float("cache_read_price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+
+		// image_price: 单张图片价格（USD），为空表示沿用默认图片价格
+		field.Float("image_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+
+		// effective_from: 生效时间，早于该时间的请求不使用此价格
+		field.Time("effective_from").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+
+		field.Bool("enabled").
+			Default(true),
+
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (ModelPrice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("model"),
+		index.Fields("group_id"),
+		index.Fields("model", "group_id", "platform", "effective_from").Unique(),
+	}
+}
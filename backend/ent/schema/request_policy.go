@@ -0,0 +1,113 @@
+// Package schema 定义 Ent ORM 的数据库 schema。
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RequestPolicy 定义请求参数策略的 schema。
+//
+// 请求参数策略在转发前按分组校验/改写客户端请求参数：
+//   - 策略类型：max_tokens 上限、thinking 预算上限、anthropic-beta 剔除/强制、禁用服务端工具、
+//     强制 reasoning effort、禁止图片输入
+//   - 处理动作：拒绝（reject）、改写（modify）、仅告警（warn）
+//   - 试运行：只记录日志，不改写也不拒绝
+type RequestPolicy struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (RequestPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "request_policies"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (RequestPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义请求参数策略实体的所有字段。
+func (RequestPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 策略名称，用于在界面中标识策略
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用该策略
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 策略优先级，数值越小越先执行
+		field.Int("priority").
+			Default(0),
+
+		// group_ids: 适用分组 ID 列表，空列表表示适用于所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// protocols: 适用的请求协议（anthropic / openai），空列表表示适用于所有协议
+		field.JSON("protocols", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// kind: 策略类型
+		// max_tokens / thinking_budget / anthropic_beta_deny / anthropic_beta_force /
+		// server_tools / reasoning_effort / image_input
+		field.String("kind").
+			MaxLen(32),
+
+		// action: 违反策略时的处理动作
+		// - "reject": 拒绝请求
+		// - "modify": 改写请求参数后继续转发
+		// - "warn": 仅记录日志
+		field.String("action").
+			MaxLen(10).
+			Default("modify"),
+
+		// dry_run: 试运行，仅记录将要执行的动作，不改写也不拒绝
+		field.Bool("dry_run").
+			Default(false),
+
+		// limit_value: 数值上限（max_tokens / thinking_budget 使用）
+		field.Int("limit_value").
+			Optional().
+			Nillable(),
+
+		// params: 字符串参数列表
+		// anthropic-beta 标记、服务端工具类型前缀或 reasoning effort 取值
+		field.JSON("params", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// reject_message: 拒绝时返回给客户端的错误信息，为空时使用默认信息
+		field.Text("reject_message").
+			Optional().
+			Nillable(),
+
+		// description: 策略描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (RequestPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
@@ -0,0 +1,102 @@
+// Package schema 定义 Ent ORM 的数据库 schema。
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoutingRule 定义请求路由规则的 schema。
+//
+// 路由规则将分组内满足条件的请求转入其他分组或账号子集：
+//   - 条件：模型匹配、max_tokens 上限、不含工具、估算输入 token 上限（同时配置时需全部满足）
+//   - 目标：目标分组（用于调度与计费）和/或目标账号子集
+//   - 按优先级匹配，命中第一条可用规则即停止
+type RoutingRule struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (RoutingRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "routing_rules"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (RoutingRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义请求路由规则实体的所有字段。
+func (RoutingRule) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 规则名称，用于在界面中标识规则
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用该规则
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 规则优先级，数值越小越先匹配
+		field.Int("priority").
+			Default(0),
+
+		// group_ids: 适用分组 ID 列表，空列表表示适用于所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// model_patterns: 模型匹配列表（精确或末尾 * 通配），空列表表示不限模型
+		field.JSON("model_patterns", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// max_tokens_below: max_tokens 小于该值时匹配
+		field.Int("max_tokens_below").
+			Optional().
+			Nillable(),
+
+		// require_no_tools: 仅匹配未携带工具定义的请求
+		field.Bool("require_no_tools").
+			Default(false),
+
+		// input_tokens_below: 估算输入 token 小于该值时匹配
+		field.Int("input_tokens_below").
+			Optional().
+			Nillable(),
+
+		// target_group_id: 目标分组，请求在该分组内调度并按该分组计费
+		field.Int64("target_group_id").
+			Optional().
+			Nillable(),
+
+		// target_account_ids: 目标账号子集，在（目标）分组内优先调度这些账号
+		field.JSON("target_account_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// description: 规则描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (RoutingRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
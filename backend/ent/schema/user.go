@@ -49,6 +49,9 @@ func (User) Fields() []ent.Field {
 			Default(0),
 		field.Int("concurrency").
 			Default(5),
+		// 账号饱和排队时的公平队列权重，权重越高出队越快
+		field.Int("queue_weight").
+			Default(1),
 		field.String("status").
 			MaxLen(20).
 			Default(domain.StatusActive),
@@ -31,6 +31,8 @@ const (
 	FieldBalance = "balance"
 	// FieldConcurrency holds the string denoting the concurrency field in the database.
 	FieldConcurrency = "concurrency"
+	// FieldQueueWeight holds the string denoting the queue_weight field in the database.
+	FieldQueueWeight = "queue_weight"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldUsername holds the string denoting the username field in the database.
@@ -146,6 +148,7 @@ var Columns = []string{
 	FieldRole,
 	FieldBalance,
 	FieldConcurrency,
+	FieldQueueWeight,
 	FieldStatus,
 	FieldUsername,
 	FieldNotes,
@@ -196,6 +199,8 @@ var (
 	DefaultBalance float64
 	// DefaultConcurrency holds the default value on creation for the "concurrency" field.
 	DefaultConcurrency int
+	// DefaultQueueWeight holds the default value on creation for the "queue_weight" field.
+	DefaultQueueWeight int
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
@@ -258,6 +263,11 @@ func ByConcurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConcurrency, opts...).ToFunc()
 }
 
+// ByQueueWeight orders the results by the queue_weight field.
+func ByQueueWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueueWeight, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -95,6 +95,11 @@ func Concurrency(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldConcurrency, v))
 }
 
+// QueueWeight applies equality check predicate on the "queue_weight" field. It's identical to QueueWeightEQ.
+func QueueWeight(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldQueueWeight, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStatus, v))
@@ -530,6 +535,46 @@ func ConcurrencyLTE(v int) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldConcurrency, v))
 }
 
+// QueueWeightEQ applies the EQ predicate on the "queue_weight" field.
+func QueueWeightEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldQueueWeight, v))
+}
+
+// QueueWeightNEQ applies the NEQ predicate on the "queue_weight" field.
+func QueueWeightNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldQueueWeight, v))
+}
+
+// QueueWeightIn applies the In predicate on the "queue_weight" field.
+func QueueWeightIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldQueueWeight, vs...))
+}
+
+// QueueWeightNotIn applies the NotIn predicate on the "queue_weight" field.
+func QueueWeightNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldQueueWeight, vs...))
+}
+
+// QueueWeightGT applies the GT predicate on the "queue_weight" field.
+func QueueWeightGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldQueueWeight, v))
+}
+
+// QueueWeightGTE applies the GTE predicate on the "queue_weight" field.
+func QueueWeightGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldQueueWeight, v))
+}
+
+// QueueWeightLT applies the LT predicate on the "queue_weight" field.
+func QueueWeightLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldQueueWeight, v))
+}
+
+// QueueWeightLTE applies the LTE predicate on the "queue_weight" field.
+func QueueWeightLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldQueueWeight, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStatus, v))
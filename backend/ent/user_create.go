@@ -126,6 +126,20 @@ func (_c *UserCreate) SetNillableConcurrency(v *int) *UserCreate {
 	return _c
 }
 
+// SetQueueWeight sets the "queue_weight" field.
+func (_c *UserCreate) SetQueueWeight(v int) *UserCreate {
+	_c.mutation.SetQueueWeight(v)
+	return _c
+}
+
+// SetNillableQueueWeight sets the "queue_weight" field if the given value is not nil.
+func (_c *UserCreate) SetNillableQueueWeight(v *int) *UserCreate {
+	if v != nil {
+		_c.SetQueueWeight(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *UserCreate) SetStatus(v string) *UserCreate {
 	_c.mutation.SetStatus(v)
@@ -408,6 +422,10 @@ func (_c *UserCreate) defaults() error {
 		v := user.DefaultConcurrency
 		_c.mutation.SetConcurrency(v)
 	}
+	if _, ok := _c.mutation.QueueWeight(); !ok {
+		v := user.DefaultQueueWeight
+		_c.mutation.SetQueueWeight(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := user.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -465,6 +483,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Concurrency(); !ok {
 		return &ValidationError{Name: "concurrency", err: errors.New(`ent: missing required field "User.concurrency"`)}
 	}
+	if _, ok := _c.mutation.QueueWeight(); !ok {
+		return &ValidationError{Name: "queue_weight", err: errors.New(`ent: missing required field "User.queue_weight"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
 	}
@@ -546,6 +567,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldConcurrency, field.TypeInt, value)
 		_node.Concurrency = value
 	}
+	if value, ok := _c.mutation.QueueWeight(); ok {
+		_spec.SetField(user.FieldQueueWeight, field.TypeInt, value)
+		_node.QueueWeight = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeString, value)
 		_node.Status = value
@@ -872,6 +897,24 @@ func (u *UserUpsert) AddConcurrency(v int) *UserUpsert {
 	return u
 }
 
+// SetQueueWeight sets the "queue_weight" field.
+func (u *UserUpsert) SetQueueWeight(v int) *UserUpsert {
+	u.Set(user.FieldQueueWeight, v)
+	return u
+}
+
+// UpdateQueueWeight sets the "queue_weight" field to the value that was provided on create.
+func (u *UserUpsert) UpdateQueueWeight() *UserUpsert {
+	u.SetExcluded(user.FieldQueueWeight)
+	return u
+}
+
+// AddQueueWeight adds v to the "queue_weight" field.
+func (u *UserUpsert) AddQueueWeight(v int) *UserUpsert {
+	u.Add(user.FieldQueueWeight, v)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *UserUpsert) SetStatus(v string) *UserUpsert {
 	u.Set(user.FieldStatus, v)
@@ -1120,6 +1163,27 @@ func (u *UserUpsertOne) UpdateConcurrency() *UserUpsertOne {
 	})
 }
 
+// SetQueueWeight sets the "queue_weight" field.
+func (u *UserUpsertOne) SetQueueWeight(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQueueWeight(v)
+	})
+}
+
+// AddQueueWeight adds v to the "queue_weight" field.
+func (u *UserUpsertOne) AddQueueWeight(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddQueueWeight(v)
+	})
+}
+
+// UpdateQueueWeight sets the "queue_weight" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateQueueWeight() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQueueWeight()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *UserUpsertOne) SetStatus(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1548,6 +1612,27 @@ func (u *UserUpsertBulk) UpdateConcurrency() *UserUpsertBulk {
 	})
 }
 
+// SetQueueWeight sets the "queue_weight" field.
+func (u *UserUpsertBulk) SetQueueWeight(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetQueueWeight(v)
+	})
+}
+
+// AddQueueWeight adds v to the "queue_weight" field.
+func (u *UserUpsertBulk) AddQueueWeight(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddQueueWeight(v)
+	})
+}
+
+// UpdateQueueWeight sets the "queue_weight" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateQueueWeight() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateQueueWeight()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *UserUpsertBulk) SetStatus(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
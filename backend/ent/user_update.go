@@ -146,6 +146,27 @@ func (_u *UserUpdate) AddConcurrency(v int) *UserUpdate {
 	return _u
 }
 
+// SetQueueWeight sets the "queue_weight" field.
+func (_u *UserUpdate) SetQueueWeight(v int) *UserUpdate {
+	_u.mutation.ResetQueueWeight()
+	_u.mutation.SetQueueWeight(v)
+	return _u
+}
+
+// SetNillableQueueWeight sets the "queue_weight" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableQueueWeight(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetQueueWeight(*v)
+	}
+	return _u
+}
+
+// AddQueueWeight adds value to the "queue_weight" field.
+func (_u *UserUpdate) AddQueueWeight(v int) *UserUpdate {
+	_u.mutation.AddQueueWeight(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *UserUpdate) SetStatus(v string) *UserUpdate {
 	_u.mutation.SetStatus(v)
@@ -685,6 +706,12 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedConcurrency(); ok {
 		_spec.AddField(user.FieldConcurrency, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.QueueWeight(); ok {
+		_spec.SetField(user.FieldQueueWeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQueueWeight(); ok {
+		_spec.AddField(user.FieldQueueWeight, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeString, value)
 	}
@@ -1256,6 +1283,27 @@ func (_u *UserUpdateOne) AddConcurrency(v int) *UserUpdateOne {
 	return _u
 }
 
+// SetQueueWeight sets the "queue_weight" field.
+func (_u *UserUpdateOne) SetQueueWeight(v int) *UserUpdateOne {
+	_u.mutation.ResetQueueWeight()
+	_u.mutation.SetQueueWeight(v)
+	return _u
+}
+
+// SetNillableQueueWeight sets the "queue_weight" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableQueueWeight(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetQueueWeight(*v)
+	}
+	return _u
+}
+
+// AddQueueWeight adds value to the "queue_weight" field.
+func (_u *UserUpdateOne) AddQueueWeight(v int) *UserUpdateOne {
+	_u.mutation.AddQueueWeight(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *UserUpdateOne) SetStatus(v string) *UserUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -1825,6 +1873,12 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.AddedConcurrency(); ok {
 		_spec.AddField(user.FieldConcurrency, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.QueueWeight(); ok {
+		_spec.SetField(user.FieldQueueWeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQueueWeight(); ok {
+		_spec.AddField(user.FieldQueueWeight, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeString, value)
 	}
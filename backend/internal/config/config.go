@@ -37,6 +37,15 @@ const (
 	ConnectionPoolIsolationAccountProxy = "account_proxy"
 )
 
+// 缓存后端模式
+const (
+	// RedisModeExternal: 连接外部 Redis（默认），支持多实例部署
+	RedisModeExternal = "external"
+	// RedisModeEmbedded: 使用进程内的 Redis 兼容存储，单二进制 + PostgreSQL 即可运行；
+	// 数据不落盘、重启即清空，且不能多实例共享，仅适用于单节点部署
+	RedisModeEmbedded = "embedded"
+)
+
 type Config struct {
 	Server       ServerConfig               `mapstructure:"server"`
 	CORS         CORSConfig                 `mapstructure:"cors"`
@@ -46,6 +55,7 @@ type Config struct {
 	Database     DatabaseConfig             `mapstructure:"database"`
 	Redis        RedisConfig                `mapstructure:"redis"`
 	Ops          OpsConfig                  `mapstructure:"ops"`
+	Tracing      TracingConfig              `mapstructure:"tracing"`
 	JWT          JWTConfig                  `mapstructure:"jwt"`
 	Totp         TotpConfig                 `mapstructure:"totp"`
 	LinuxDo      LinuxDoConnectConfig       `mapstructure:"linuxdo_connect"`
@@ -63,6 +73,17 @@ type Config struct {
 	Timezone     string                     `mapstructure:"timezone"` // e.g. "Asia/Shanghai", "UTC"
 	Gemini       GeminiConfig               `mapstructure:"gemini"`
 	Update       UpdateConfig               `mapstructure:"update"`
+	ConfigReload ConfigReloadConfig         `mapstructure:"config_reload"`
+}
+
+// ConfigReloadConfig 配置文件热更新
+// 监听配置文件变化（按内容摘要轮询，兼容原子替换与 Kubernetes ConfigMap 挂载），
+// 变化后重新加载并校验，可热更新的配置项立即生效，其余配置项报告为需要重启。
+type ConfigReloadConfig struct {
+	// WatchEnabled 是否监听配置文件变化
+	WatchEnabled bool `mapstructure:"watch_enabled"`
+	// WatchIntervalSeconds 检查配置文件变化的间隔（秒）
+	WatchIntervalSeconds int `mapstructure:"watch_interval_seconds"`
 }
 
 type GeminiConfig struct {
@@ -160,6 +181,13 @@ type ServerConfig struct {
 	TrustedProxies     []string  `mapstructure:"trusted_proxies"`       // 可信代理列表（CIDR/IP）
 	MaxRequestBodySize int64     `mapstructure:"max_request_body_size"` // 全局最大请求体限制
 	H2C                H2CConfig `mapstructure:"h2c"`                   // HTTP/2 Cleartext 配置
+
+	// 优雅停机：收到退出信号后先进入 draining 状态（/readyz 失败、新网关请求返回可重试错误），
+	// 等待 ShutdownDrainDelay 让负载均衡摘除实例，再等待进行中的流式请求最多 ShutdownDrainTimeout
+	ShutdownDrainDelay   int `mapstructure:"shutdown_drain_delay"`   // 摘流等待（秒）
+	ShutdownDrainTimeout int `mapstructure:"shutdown_drain_timeout"` // 进行中请求的最长等待（秒）
+	// ReadinessSnapshotMaxAge 调度快照超过该时长未成功同步时 /readyz 失败（秒）
+	ReadinessSnapshotMaxAge int `mapstructure:"readiness_snapshot_max_age"`
 }
 
 // H2CConfig HTTP/2 Cleartext 配置
@@ -210,7 +238,38 @@ type ProxyProbeConfig struct {
 }
 
 type BillingConfig struct {
-	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	CircuitBreaker CircuitBreakerConfig     `mapstructure:"circuit_breaker"`
+	Reservation    BillingReservationConfig `mapstructure:"reservation"`
+	UsageOutbox    UsageOutboxConfig        `mapstructure:"usage_outbox"`
+}
+
+// UsageOutboxConfig 用量计费流水线配置
+// 启用后用量事件先写入 Redis Stream，由工作池批量入库并幂等扣费，进程重启或数据库抖动时不丢失用量。
+type UsageOutboxConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Workers 消费工作协程数量
+	Workers int `mapstructure:"workers"`
+	// BatchSize 单批最多处理的事件数
+	BatchSize int `mapstructure:"batch_size"`
+	// ClaimIdleSeconds 事件未确认超过该时间后可被其他消费者接管（实例崩溃恢复）
+	ClaimIdleSeconds int `mapstructure:"claim_idle_seconds"`
+	// MaxDeliveries 单个事件最多投递次数，超过后转入死信流
+	MaxDeliveries int `mapstructure:"max_deliveries"`
+	// RetryBackoffMaxSeconds 批量入库失败时的最大退避时间（秒）
+	RetryBackoffMaxSeconds int `mapstructure:"retry_backoff_max_seconds"`
+	// SpoolFile 事件流与同步入库均失败时，用量事件追加写入该本地文件，由后台协程按 request_id 幂等重放
+	SpoolFile string `mapstructure:"spool_file"`
+}
+
+// BillingReservationConfig 转发前费用预留配置
+// 请求转发前按 max_tokens 与请求体大小估算最大费用并在 Redis 中预留，
+// 用量记录完成后释放，避免高并发下余额/订阅额度被透支。
+type BillingReservationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// TTLSeconds 预留自动过期时间（秒），实例崩溃时预留在此时间后自动失效
+	TTLSeconds int `mapstructure:"ttl_seconds"`
+	// DefaultMaxOutputTokens 请求未指定 max_tokens 时用于估算的输出 token 数
+	DefaultMaxOutputTokens int `mapstructure:"default_max_output_tokens"`
 }
 
 type CircuitBreakerConfig struct {
@@ -263,6 +322,9 @@ type GatewayConfig struct {
 
 	// StreamDataIntervalTimeout: 流数据间隔超时（秒），0表示禁用
 	StreamDataIntervalTimeout int `mapstructure:"stream_data_interval_timeout"`
+	// FirstByteTimeout: 流式请求首字节预算（秒），0表示禁用
+	// 预算内上游未返回任何数据且尚未向客户端写入时，取消本次上游请求并切换账号；分组可单独覆盖
+	FirstByteTimeout int `mapstructure:"first_byte_timeout"`
 	// StreamKeepaliveInterval: 流式 keepalive 间隔（秒），0表示禁用
 	StreamKeepaliveInterval int `mapstructure:"stream_keepalive_interval"`
 	// MaxLineSize: 上游 SSE 单行最大字节数（0使用默认值）
@@ -359,6 +421,52 @@ type GatewaySchedulingConfig struct {
 	// 全量重建周期配置
 	// 全量重建周期（秒），0 表示禁用
 	FullRebuildIntervalSeconds int `mapstructure:"full_rebuild_interval_seconds"`
+
+	// 上下文容量判断对输入 token 估算值的容差（百分比），吸收估算误差
+	ContextEstimateTolerancePercent int `mapstructure:"context_estimate_tolerance_percent"`
+
+	// 额度感知调度配置
+	QuotaAware QuotaAwareSchedulingConfig `mapstructure:"quota_aware"`
+
+	// 调度策略配置
+	Strategy SchedulingStrategyConfig `mapstructure:"strategy"`
+
+	// 账号饱和时的分组公平队列配置
+	FairQueue FairQueueConfig `mapstructure:"fair_queue"`
+}
+
+// FairQueueConfig 分组公平队列配置
+// 分组内账号槽位全部占满时，等待请求按用户权重进入 Redis 公平队列（起始时间公平排队），
+// 避免单个高并发用户占满等待位、饿死同分组的其他用户。
+type FairQueueConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// AdmitWindow 队首允许尝试获取账号槽位的请求数（等待不同账号的请求互不阻塞）
+	AdmitWindow int `mapstructure:"admit_window"`
+	// TicketTTLSeconds 等待请求心跳超时（秒），超时的排队票据视为已离开（实例崩溃等）
+	TicketTTLSeconds int `mapstructure:"ticket_ttl_seconds"`
+}
+
+// SchedulingStrategyConfig 账号调度策略配置
+// 分组可单独指定策略；未指定时使用 Default。
+type SchedulingStrategyConfig struct {
+	// Default 默认策略：default / weighted_round_robin / least_cost / least_latency / fill_first
+	Default string `mapstructure:"default"`
+	// CostWindowSeconds least_cost 策略统计账号费用的滑动窗口（秒）
+	CostWindowSeconds int `mapstructure:"cost_window_seconds"`
+	// LatencyEWMAAlpha least_latency 策略首字时间 EWMA 平滑系数，取值 (0, 1]，越大越偏向最近样本
+	LatencyEWMAAlpha float64 `mapstructure:"latency_ewma_alpha"`
+}
+
+// QuotaAwareSchedulingConfig 额度感知调度配置
+// 基于 Claude OAuth 5h/7d（含 Opus）使用率与 Codex 5h/7d 用量窗口，在 429 之前主动避开即将耗尽的账号。
+type QuotaAwareSchedulingConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// RefreshIntervalSeconds Claude OAuth 账号使用率刷新周期（秒）
+	RefreshIntervalSeconds int `mapstructure:"refresh_interval_seconds"`
+	// DeprioritizeThreshold 使用率（0-100）达到该值后降低调度优先级
+	DeprioritizeThreshold float64 `mapstructure:"deprioritize_threshold"`
+	// SkipThreshold 使用率（0-100）达到该值后在窗口重置前跳过该账号（无其他可用账号时仍会兜底使用）
+	SkipThreshold float64 `mapstructure:"skip_threshold"`
 }
 
 func (s *ServerConfig) Address() string {
@@ -420,6 +528,8 @@ func (d *DatabaseConfig) DSNWithTimezone(tz string) string {
 // RedisConfig Redis 连接配置
 // 性能优化：新增连接池和超时参数，提升高并发场景下的吞吐量
 type RedisConfig struct {
+	// Mode: 缓存后端，external（外部 Redis）或 embedded（进程内，单节点部署）
+	Mode     string `mapstructure:"mode"`
 	Host     string `mapstructure:"host"`
 	Port     int    `mapstructure:"port"`
 	Password string `mapstructure:"password"`
@@ -439,6 +549,11 @@ type RedisConfig struct {
 	EnableTLS bool `mapstructure:"enable_tls"`
 }
 
+// Embedded 是否使用进程内缓存后端
+func (r *RedisConfig) Embedded() bool {
+	return r.Mode == RedisModeEmbedded
+}
+
 func (r *RedisConfig) Address() string {
 	return fmt.Sprintf("%s:%d", r.Host, r.Port)
 }
@@ -484,6 +599,22 @@ type OpsMetricsCollectorCacheConfig struct {
 	TTL     time.Duration `mapstructure:"ttl"`
 }
 
+// TracingConfig OpenTelemetry 链路追踪配置
+type TracingConfig struct {
+	// Enabled 是否导出链路追踪数据
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint OTLP/HTTP 接收端地址（host:port 或完整 URL）
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure 使用 HTTP 明文连接
+	Insecure bool `mapstructure:"insecure"`
+	// Headers 导出时附带的请求头（如托管 collector 的鉴权 token）
+	Headers map[string]string `mapstructure:"headers"`
+	// ServiceName 上报的服务名
+	ServiceName string `mapstructure:"service_name"`
+	// SampleRatio 新链路采样比例（0-1），已携带 traceparent 的请求沿用上游采样决策
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
 type JWTConfig struct {
 	Secret     string `mapstructure:"secret"`
 	ExpireHour int    `mapstructure:"expire_hour"`
@@ -619,7 +750,7 @@ func Load() (*Config, error) {
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
 	// 默认值
-	setDefaults()
+	setDefaults(viper.GetViper())
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -628,8 +759,33 @@ func Load() (*Config, error) {
 		// 配置文件不存在时使用默认值
 	}
 
+	return loadFrom(viper.GetViper(), nil)
+}
+
+// Reload 重新读取配置文件用于热更新，默认值与环境变量规则与 Load 相同。
+// 文件中未配置的 JWT 密钥与 TOTP 加密密钥沿用 current 的值，避免启动时自动生成的密钥被替换。
+func Reload(path string, current *Config) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	v.AutomaticEnv()
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	setDefaults(v)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("read config error: %w", err)
+	}
+	return loadFrom(v, current)
+}
+
+// ConfigFileUsed 返回 Load 读取的配置文件路径，未找到配置文件时为空
+func ConfigFileUsed() string {
+	return viper.ConfigFileUsed()
+}
+
+// loadFrom 解析、规范化并校验配置；inherit 非空时，未配置的密钥沿用其中的值而不是重新生成
+func loadFrom(v *viper.Viper, inherit *Config) (*Config, error) {
 	var cfg Config
-	if err := viper.Unmarshal(&cfg); err != nil {
+	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("unmarshal config error: %w", err)
 	}
 
@@ -656,7 +812,19 @@ func Load() (*Config, error) {
 	cfg.Security.ResponseHeaders.AdditionalAllowed = normalizeStringSlice(cfg.Security.ResponseHeaders.AdditionalAllowed)
 	cfg.Security.ResponseHeaders.ForceRemove = normalizeStringSlice(cfg.Security.ResponseHeaders.ForceRemove)
 	cfg.Security.CSP.Policy = strings.TrimSpace(cfg.Security.CSP.Policy)
+	cfg.Redis.Mode = strings.ToLower(strings.TrimSpace(cfg.Redis.Mode))
+	if cfg.Redis.Mode == "" {
+		cfg.Redis.Mode = RedisModeExternal
+	}
+	if cfg.Redis.Embedded() && cfg.Billing.UsageOutbox.Enabled {
+		// 内置后端不持久化，Stream 无法在进程崩溃后保留待结算流水，回退为同步写入
+		cfg.Billing.UsageOutbox.Enabled = false
+		log.Println("Warning: billing.usage_outbox is disabled because redis.mode=embedded does not persist streams.")
+	}
 
+	if cfg.JWT.Secret == "" && inherit != nil {
+		cfg.JWT.Secret = inherit.JWT.Secret
+	}
 	if cfg.JWT.Secret == "" {
 		secret, err := generateJWTSecret(64)
 		if err != nil {
@@ -668,7 +836,10 @@ func Load() (*Config, error) {
 
 	// Auto-generate TOTP encryption key if not set (32 bytes = 64 hex chars for AES-256)
 	cfg.Totp.EncryptionKey = strings.TrimSpace(cfg.Totp.EncryptionKey)
-	if cfg.Totp.EncryptionKey == "" {
+	if cfg.Totp.EncryptionKey == "" && inherit != nil {
+		cfg.Totp.EncryptionKey = inherit.Totp.EncryptionKey
+		cfg.Totp.EncryptionKeyConfigured = inherit.Totp.EncryptionKeyConfigured
+	} else if cfg.Totp.EncryptionKey == "" {
 		key, err := generateJWTSecret(32) // Reuse the same random generation function
 		if err != nil {
 			return nil, fmt.Errorf("generate totp encryption key error: %w", err)
@@ -704,32 +875,35 @@ func Load() (*Config, error) {
 	return &cfg, nil
 }
 
-func setDefaults() {
-	viper.SetDefault("run_mode", RunModeStandard)
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("run_mode", RunModeStandard)
 
 	// Server
-	viper.SetDefault("server.host", "0.0.0.0")
-	viper.SetDefault("server.port", 8080)
-	viper.SetDefault("server.mode", "debug")
-	viper.SetDefault("server.read_header_timeout", 30) // 30秒读取请求头
-	viper.SetDefault("server.idle_timeout", 120)       // 120秒空闲超时
-	viper.SetDefault("server.trusted_proxies", []string{})
-	viper.SetDefault("server.max_request_body_size", int64(100*1024*1024))
+	v.SetDefault("server.host", "0.0.0.0")
+	v.SetDefault("server.port", 8080)
+	v.SetDefault("server.mode", "debug")
+	v.SetDefault("server.read_header_timeout", 30) // 30秒读取请求头
+	v.SetDefault("server.idle_timeout", 120)       // 120秒空闲超时
+	v.SetDefault("server.trusted_proxies", []string{})
+	v.SetDefault("server.max_request_body_size", int64(100*1024*1024))
+	v.SetDefault("server.shutdown_drain_delay", 5)
+	v.SetDefault("server.shutdown_drain_timeout", 300)
+	v.SetDefault("server.readiness_snapshot_max_age", 120)
 	// H2C 默认配置
-	viper.SetDefault("server.h2c.enabled", false)
-	viper.SetDefault("server.h2c.max_concurrent_streams", uint32(50))      // 50 个并发流
-	viper.SetDefault("server.h2c.idle_timeout", 75)                        // 75 秒
-	viper.SetDefault("server.h2c.max_read_frame_size", 1<<20)              // 1MB（够用）
-	viper.SetDefault("server.h2c.max_upload_buffer_per_connection", 2<<20) // 2MB
-	viper.SetDefault("server.h2c.max_upload_buffer_per_stream", 512<<10)   // 512KB
+	v.SetDefault("server.h2c.enabled", false)
+	v.SetDefault("server.h2c.max_concurrent_streams", uint32(50))      // 50 个并发流
+	v.SetDefault("server.h2c.idle_timeout", 75)                        // 75 秒
+	v.SetDefault("server.h2c.max_read_frame_size", 1<<20)              // 1MB（够用）
+	v.SetDefault("server.h2c.max_upload_buffer_per_connection", 2<<20) // 2MB
+	v.SetDefault("server.h2c.max_upload_buffer_per_stream", 512<<10)   // 512KB
 
 	// CORS
-	viper.SetDefault("cors.allowed_origins", []string{})
-	viper.SetDefault("cors.allow_credentials", true)
+	v.SetDefault("cors.allowed_origins", []string{})
+	v.SetDefault("cors.allow_credentials", true)
 
 	// Security
-	viper.SetDefault("security.url_allowlist.enabled", false)
-	viper.SetDefault("security.url_allowlist.upstream_hosts", []string{
+	v.SetDefault("security.url_allowlist.enabled", false)
+	v.SetDefault("security.url_allowlist.upstream_hosts", []string{
 		"api.openai.com",
 		"api.anthropic.com",
 		"api.kimi.com",
@@ -739,209 +913,243 @@ func setDefaults() {
 		"cloudcode-pa.googleapis.com",
 		"*.openai.azure.com",
 	})
-	viper.SetDefault("security.url_allowlist.pricing_hosts", []string{
+	v.SetDefault("security.url_allowlist.pricing_hosts", []string{
 		"raw.githubusercontent.com",
 	})
-	viper.SetDefault("security.url_allowlist.crs_hosts", []string{})
-	viper.SetDefault("security.url_allowlist.allow_private_hosts", true)
-	viper.SetDefault("security.url_allowlist.allow_insecure_http", true)
-	viper.SetDefault("security.response_headers.enabled", false)
-	viper.SetDefault("security.response_headers.additional_allowed", []string{})
-	viper.SetDefault("security.response_headers.force_remove", []string{})
-	viper.SetDefault("security.csp.enabled", true)
-	viper.SetDefault("security.csp.policy", DefaultCSPPolicy)
-	viper.SetDefault("security.proxy_probe.insecure_skip_verify", false)
+	v.SetDefault("security.url_allowlist.crs_hosts", []string{})
+	v.SetDefault("security.url_allowlist.allow_private_hosts", true)
+	v.SetDefault("security.url_allowlist.allow_insecure_http", true)
+	v.SetDefault("security.response_headers.enabled", false)
+	v.SetDefault("security.response_headers.additional_allowed", []string{})
+	v.SetDefault("security.response_headers.force_remove", []string{})
+	v.SetDefault("security.csp.enabled", true)
+	v.SetDefault("security.csp.policy", DefaultCSPPolicy)
+	v.SetDefault("security.proxy_probe.insecure_skip_verify", false)
 
 	// Billing
-	viper.SetDefault("billing.circuit_breaker.enabled", true)
-	viper.SetDefault("billing.circuit_breaker.failure_threshold", 5)
-	viper.SetDefault("billing.circuit_breaker.reset_timeout_seconds", 30)
-	viper.SetDefault("billing.circuit_breaker.half_open_requests", 3)
+	v.SetDefault("billing.circuit_breaker.enabled", true)
+	v.SetDefault("billing.circuit_breaker.failure_threshold", 5)
+	v.SetDefault("billing.circuit_breaker.reset_timeout_seconds", 30)
+	v.SetDefault("billing.circuit_breaker.half_open_requests", 3)
+	v.SetDefault("billing.reservation.enabled", true)
+	v.SetDefault("billing.reservation.ttl_seconds", 600)
+	v.SetDefault("billing.reservation.default_max_output_tokens", 4096)
+	v.SetDefault("billing.usage_outbox.enabled", true)
+	v.SetDefault("billing.usage_outbox.workers", 2)
+	v.SetDefault("billing.usage_outbox.batch_size", 100)
+	v.SetDefault("billing.usage_outbox.claim_idle_seconds", 60)
+	v.SetDefault("billing.usage_outbox.max_deliveries", 20)
+	v.SetDefault("billing.usage_outbox.retry_backoff_max_seconds", 30)
+	v.SetDefault("billing.usage_outbox.spool_file", "./data/usage_spool.jsonl")
 
 	// Turnstile
-	viper.SetDefault("turnstile.required", false)
+	v.SetDefault("turnstile.required", false)
 
 	// LinuxDo Connect OAuth 登录
-	viper.SetDefault("linuxdo_connect.enabled", false)
-	viper.SetDefault("linuxdo_connect.client_id", "")
-	viper.SetDefault("linuxdo_connect.client_secret", "")
-	viper.SetDefault("linuxdo_connect.authorize_url", "https://connect.linux.do/oauth2/authorize")
-	viper.SetDefault("linuxdo_connect.token_url", "https://connect.linux.do/oauth2/token")
-	viper.SetDefault("linuxdo_connect.userinfo_url", "https://connect.linux.do/api/user")
-	viper.SetDefault("linuxdo_connect.scopes", "user")
-	viper.SetDefault("linuxdo_connect.redirect_url", "")
-	viper.SetDefault("linuxdo_connect.frontend_redirect_url", "/auth/linuxdo/callback")
-	viper.SetDefault("linuxdo_connect.token_auth_method", "client_secret_post")
-	viper.SetDefault("linuxdo_connect.use_pkce", false)
-	viper.SetDefault("linuxdo_connect.userinfo_email_path", "")
-	viper.SetDefault("linuxdo_connect.userinfo_id_path", "")
-	viper.SetDefault("linuxdo_connect.userinfo_username_path", "")
+	v.SetDefault("linuxdo_connect.enabled", false)
+	v.SetDefault("linuxdo_connect.client_id", "")
+	v.SetDefault("linuxdo_connect.client_secret", "")
+	v.SetDefault("linuxdo_connect.authorize_url", "https://connect.linux.do/oauth2/authorize")
+	v.SetDefault("linuxdo_connect.token_url", "https://connect.linux.do/oauth2/token")
+	v.SetDefault("linuxdo_connect.userinfo_url", "https://connect.linux.do/api/user")
+	v.SetDefault("linuxdo_connect.scopes", "user")
+	v.SetDefault("linuxdo_connect.redirect_url", "")
+	v.SetDefault("linuxdo_connect.frontend_redirect_url", "/auth/linuxdo/callback")
+	v.SetDefault("linuxdo_connect.token_auth_method", "client_secret_post")
+	v.SetDefault("linuxdo_connect.use_pkce", false)
+	v.SetDefault("linuxdo_connect.userinfo_email_path", "")
+	v.SetDefault("linuxdo_connect.userinfo_id_path", "")
+	v.SetDefault("linuxdo_connect.userinfo_username_path", "")
 
 	// Database
-	viper.SetDefault("database.host", "localhost")
-	viper.SetDefault("database.port", 5432)
-	viper.SetDefault("database.user", "postgres")
-	viper.SetDefault("database.password", "postgres")
-	viper.SetDefault("database.dbname", "sub2api")
-	viper.SetDefault("database.sslmode", "disable")
-	viper.SetDefault("database.max_open_conns", 50)
-	viper.SetDefault("database.max_idle_conns", 10)
-	viper.SetDefault("database.conn_max_lifetime_minutes", 30)
-	viper.SetDefault("database.conn_max_idle_time_minutes", 5)
+	v.SetDefault("database.host", "localhost")
+	v.SetDefault("database.port", 5432)
+	v.SetDefault("database.user", "postgres")
+	v.SetDefault("database.password", "postgres")
+	v.SetDefault("database.dbname", "sub2api")
+	v.SetDefault("database.sslmode", "disable")
+	v.SetDefault("database.max_open_conns", 50)
+	v.SetDefault("database.max_idle_conns", 10)
+	v.SetDefault("database.conn_max_lifetime_minutes", 30)
+	v.SetDefault("database.conn_max_idle_time_minutes", 5)
 
 	// Redis
-	viper.SetDefault("redis.host", "localhost")
-	viper.SetDefault("redis.port", 6379)
-	viper.SetDefault("redis.password", "")
-	viper.SetDefault("redis.db", 0)
-	viper.SetDefault("redis.dial_timeout_seconds", 5)
-	viper.SetDefault("redis.read_timeout_seconds", 3)
-	viper.SetDefault("redis.write_timeout_seconds", 3)
-	viper.SetDefault("redis.pool_size", 128)
-	viper.SetDefault("redis.min_idle_conns", 10)
-	viper.SetDefault("redis.enable_tls", false)
+	v.SetDefault("redis.mode", RedisModeExternal)
+	v.SetDefault("redis.host", "localhost")
+	v.SetDefault("redis.port", 6379)
+	v.SetDefault("redis.password", "")
+	v.SetDefault("redis.db", 0)
+	v.SetDefault("redis.dial_timeout_seconds", 5)
+	v.SetDefault("redis.read_timeout_seconds", 3)
+	v.SetDefault("redis.write_timeout_seconds", 3)
+	v.SetDefault("redis.pool_size", 128)
+	v.SetDefault("redis.min_idle_conns", 10)
+	v.SetDefault("redis.enable_tls", false)
 
 	// Ops (vNext)
-	viper.SetDefault("ops.enabled", true)
-	viper.SetDefault("ops.use_preaggregated_tables", false)
-	viper.SetDefault("ops.cleanup.enabled", true)
-	viper.SetDefault("ops.cleanup.schedule", "0 2 * * *")
+	v.SetDefault("ops.enabled", true)
+	v.SetDefault("ops.use_preaggregated_tables", false)
+	v.SetDefault("ops.cleanup.enabled", true)
+	v.SetDefault("ops.cleanup.schedule", "0 2 * * *")
 	// Retention days: vNext defaults to 30 days across ops datasets.
-	viper.SetDefault("ops.cleanup.error_log_retention_days", 30)
-	viper.SetDefault("ops.cleanup.minute_metrics_retention_days", 30)
-	viper.SetDefault("ops.cleanup.hourly_metrics_retention_days", 30)
-	viper.SetDefault("ops.aggregation.enabled", true)
-	viper.SetDefault("ops.metrics_collector_cache.enabled", true)
+	v.SetDefault("ops.cleanup.error_log_retention_days", 30)
+	v.SetDefault("ops.cleanup.minute_metrics_retention_days", 30)
+	v.SetDefault("ops.cleanup.hourly_metrics_retention_days", 30)
+	v.SetDefault("ops.aggregation.enabled", true)
+	v.SetDefault("ops.metrics_collector_cache.enabled", true)
 	// TTL should be slightly larger than collection interval (1m) to maximize cross-replica cache hits.
-	viper.SetDefault("ops.metrics_collector_cache.ttl", 65*time.Second)
+	v.SetDefault("ops.metrics_collector_cache.ttl", 65*time.Second)
+
+	// Tracing
+	v.SetDefault("tracing.enabled", false)
+	v.SetDefault("tracing.endpoint", "localhost:4318")
+	v.SetDefault("tracing.insecure", true)
+	v.SetDefault("tracing.service_name", "sub2api")
+	v.SetDefault("tracing.sample_ratio", 0.1)
 
 	// JWT
-	viper.SetDefault("jwt.secret", "")
-	viper.SetDefault("jwt.expire_hour", 24)
-	viper.SetDefault("jwt.access_token_expire_minutes", 360) // 6小时Access Token有效期
-	viper.SetDefault("jwt.refresh_token_expire_days", 30)    // 30天Refresh Token有效期
-	viper.SetDefault("jwt.refresh_window_minutes", 2)        // 过期前2分钟开始允许刷新
+	v.SetDefault("jwt.secret", "")
+	v.SetDefault("jwt.expire_hour", 24)
+	v.SetDefault("jwt.access_token_expire_minutes", 360) // 6小时Access Token有效期
+	v.SetDefault("jwt.refresh_token_expire_days", 30)    // 30天Refresh Token有效期
+	v.SetDefault("jwt.refresh_window_minutes", 2)        // 过期前2分钟开始允许刷新
 
 	// TOTP
-	viper.SetDefault("totp.encryption_key", "")
+	v.SetDefault("totp.encryption_key", "")
 
 	// Default
 	// Admin credentials are created via the setup flow (web wizard / CLI / AUTO_SETUP).
 	// Do not ship fixed defaults here to avoid insecure "known credentials" in production.
-	viper.SetDefault("default.admin_email", "")
-	viper.SetDefault("default.admin_password", "")
-	viper.SetDefault("default.user_concurrency", 5)
-	viper.SetDefault("default.user_balance", 0)
-	viper.SetDefault("default.api_key_prefix", "sk-")
-	viper.SetDefault("default.rate_multiplier", 1.0)
+	v.SetDefault("default.admin_email", "")
+	v.SetDefault("default.admin_password", "")
+	v.SetDefault("default.user_concurrency", 5)
+	v.SetDefault("default.user_balance", 0)
+	v.SetDefault("default.api_key_prefix", "sk-")
+	v.SetDefault("default.rate_multiplier", 1.0)
 
 	// RateLimit
-	viper.SetDefault("rate_limit.overload_cooldown_minutes", 10)
+	v.SetDefault("rate_limit.overload_cooldown_minutes", 10)
 
 	// Pricing - 从 price-mirror 分支同步，该分支维护了 sha256 哈希文件用于增量更新检查
-	viper.SetDefault("pricing.remote_url", "https://raw.githubusercontent.com/Wei-Shaw/claude-relay-service/price-mirror/model_prices_and_context_window.json")
-	viper.SetDefault("pricing.hash_url", "https://raw.githubusercontent.com/Wei-Shaw/claude-relay-service/price-mirror/model_prices_and_context_window.sha256")
-	viper.SetDefault("pricing.data_dir", "./data")
-	viper.SetDefault("pricing.fallback_file", "./resources/model-pricing/model_prices_and_context_window.json")
-	viper.SetDefault("pricing.update_interval_hours", 24)
-	viper.SetDefault("pricing.hash_check_interval_minutes", 10)
+	v.SetDefault("pricing.remote_url", "https://raw.githubusercontent.com/Wei-Shaw/claude-relay-service/price-mirror/model_prices_and_context_window.json")
+	v.SetDefault("pricing.hash_url", "https://raw.githubusercontent.com/Wei-Shaw/claude-relay-service/price-mirror/model_prices_and_context_window.sha256")
+	v.SetDefault("pricing.data_dir", "./data")
+	v.SetDefault("pricing.fallback_file", "./resources/model-pricing/model_prices_and_context_window.json")
+	v.SetDefault("pricing.update_interval_hours", 24)
+	v.SetDefault("pricing.hash_check_interval_minutes", 10)
 
 	// Timezone (default to Asia/Shanghai for Chinese users)
-	viper.SetDefault("timezone", "Asia/Shanghai")
+	v.SetDefault("timezone", "Asia/Shanghai")
 
 	// API Key auth cache
-	viper.SetDefault("api_key_auth_cache.l1_size", 65535)
-	viper.SetDefault("api_key_auth_cache.l1_ttl_seconds", 15)
-	viper.SetDefault("api_key_auth_cache.l2_ttl_seconds", 300)
-	viper.SetDefault("api_key_auth_cache.negative_ttl_seconds", 30)
-	viper.SetDefault("api_key_auth_cache.jitter_percent", 10)
-	viper.SetDefault("api_key_auth_cache.singleflight", true)
+	v.SetDefault("api_key_auth_cache.l1_size", 65535)
+	v.SetDefault("api_key_auth_cache.l1_ttl_seconds", 15)
+	v.SetDefault("api_key_auth_cache.l2_ttl_seconds", 300)
+	v.SetDefault("api_key_auth_cache.negative_ttl_seconds", 30)
+	v.SetDefault("api_key_auth_cache.jitter_percent", 10)
+	v.SetDefault("api_key_auth_cache.singleflight", true)
 
 	// Dashboard cache
-	viper.SetDefault("dashboard_cache.enabled", true)
-	viper.SetDefault("dashboard_cache.key_prefix", "sub2api:")
-	viper.SetDefault("dashboard_cache.stats_fresh_ttl_seconds", 15)
-	viper.SetDefault("dashboard_cache.stats_ttl_seconds", 30)
-	viper.SetDefault("dashboard_cache.stats_refresh_timeout_seconds", 30)
+	v.SetDefault("dashboard_cache.enabled", true)
+	v.SetDefault("dashboard_cache.key_prefix", "sub2api:")
+	v.SetDefault("dashboard_cache.stats_fresh_ttl_seconds", 15)
+	v.SetDefault("dashboard_cache.stats_ttl_seconds", 30)
+	v.SetDefault("dashboard_cache.stats_refresh_timeout_seconds", 30)
 
 	// Dashboard aggregation
-	viper.SetDefault("dashboard_aggregation.enabled", true)
-	viper.SetDefault("dashboard_aggregation.interval_seconds", 60)
-	viper.SetDefault("dashboard_aggregation.lookback_seconds", 120)
-	viper.SetDefault("dashboard_aggregation.backfill_enabled", false)
-	viper.SetDefault("dashboard_aggregation.backfill_max_days", 31)
-	viper.SetDefault("dashboard_aggregation.retention.usage_logs_days", 90)
-	viper.SetDefault("dashboard_aggregation.retention.hourly_days", 180)
-	viper.SetDefault("dashboard_aggregation.retention.daily_days", 730)
-	viper.SetDefault("dashboard_aggregation.recompute_days", 2)
+	v.SetDefault("dashboard_aggregation.enabled", true)
+	v.SetDefault("dashboard_aggregation.interval_seconds", 60)
+	v.SetDefault("dashboard_aggregation.lookback_seconds", 120)
+	v.SetDefault("dashboard_aggregation.backfill_enabled", false)
+	v.SetDefault("dashboard_aggregation.backfill_max_days", 31)
+	v.SetDefault("dashboard_aggregation.retention.usage_logs_days", 90)
+	v.SetDefault("dashboard_aggregation.retention.hourly_days", 180)
+	v.SetDefault("dashboard_aggregation.retention.daily_days", 730)
+	v.SetDefault("dashboard_aggregation.recompute_days", 2)
 
 	// Usage cleanup task
-	viper.SetDefault("usage_cleanup.enabled", true)
-	viper.SetDefault("usage_cleanup.max_range_days", 31)
-	viper.SetDefault("usage_cleanup.batch_size", 5000)
-	viper.SetDefault("usage_cleanup.worker_interval_seconds", 10)
-	viper.SetDefault("usage_cleanup.task_timeout_seconds", 1800)
+	v.SetDefault("usage_cleanup.enabled", true)
+	v.SetDefault("usage_cleanup.max_range_days", 31)
+	v.SetDefault("usage_cleanup.batch_size", 5000)
+	v.SetDefault("usage_cleanup.worker_interval_seconds", 10)
+	v.SetDefault("usage_cleanup.task_timeout_seconds", 1800)
 
 	// Gateway
-	viper.SetDefault("gateway.response_header_timeout", 600) // 600秒(10分钟)等待上游响应头，LLM高负载时可能排队较久
-	viper.SetDefault("gateway.log_upstream_error_body", true)
-	viper.SetDefault("gateway.log_upstream_error_body_max_bytes", 2048)
-	viper.SetDefault("gateway.inject_beta_for_apikey", false)
-	viper.SetDefault("gateway.failover_on_400", false)
-	viper.SetDefault("gateway.max_account_switches", 10)
-	viper.SetDefault("gateway.max_account_switches_gemini", 3)
-	viper.SetDefault("gateway.antigravity_fallback_cooldown_minutes", 1)
-	viper.SetDefault("gateway.max_body_size", int64(100*1024*1024))
-	viper.SetDefault("gateway.connection_pool_isolation", ConnectionPoolIsolationAccountProxy)
+	v.SetDefault("gateway.response_header_timeout", 600) // 600秒(10分钟)等待上游响应头，LLM高负载时可能排队较久
+	v.SetDefault("gateway.log_upstream_error_body", true)
+	v.SetDefault("gateway.log_upstream_error_body_max_bytes", 2048)
+	v.SetDefault("gateway.inject_beta_for_apikey", false)
+	v.SetDefault("gateway.failover_on_400", false)
+	v.SetDefault("gateway.max_account_switches", 10)
+	v.SetDefault("gateway.max_account_switches_gemini", 3)
+	v.SetDefault("gateway.antigravity_fallback_cooldown_minutes", 1)
+	v.SetDefault("gateway.max_body_size", int64(100*1024*1024))
+	v.SetDefault("gateway.connection_pool_isolation", ConnectionPoolIsolationAccountProxy)
 	// HTTP 上游连接池配置（针对 5000+ 并发用户优化）
-	viper.SetDefault("gateway.max_idle_conns", 240)           // 最大空闲连接总数（HTTP/2 场景默认）
-	viper.SetDefault("gateway.max_idle_conns_per_host", 120)  // 每主机最大空闲连接（HTTP/2 场景默认）
-	viper.SetDefault("gateway.max_conns_per_host", 240)       // 每主机最大连接数（含活跃，HTTP/2 场景默认）
-	viper.SetDefault("gateway.idle_conn_timeout_seconds", 90) // 空闲连接超时（秒）
-	viper.SetDefault("gateway.max_upstream_clients", 5000)
-	viper.SetDefault("gateway.client_idle_ttl_seconds", 900)
-	viper.SetDefault("gateway.concurrency_slot_ttl_minutes", 30) // 并发槽位过期时间（支持超长请求）
-	viper.SetDefault("gateway.stream_data_interval_timeout", 180)
-	viper.SetDefault("gateway.stream_keepalive_interval", 10)
-	viper.SetDefault("gateway.max_line_size", 40*1024*1024)
-	viper.SetDefault("gateway.scheduling.sticky_session_max_waiting", 3)
-	viper.SetDefault("gateway.scheduling.sticky_session_wait_timeout", 120*time.Second)
-	viper.SetDefault("gateway.scheduling.fallback_wait_timeout", 30*time.Second)
-	viper.SetDefault("gateway.scheduling.fallback_max_waiting", 100)
-	viper.SetDefault("gateway.scheduling.fallback_selection_mode", "last_used")
-	viper.SetDefault("gateway.scheduling.load_batch_enabled", true)
-	viper.SetDefault("gateway.scheduling.slot_cleanup_interval", 30*time.Second)
-	viper.SetDefault("gateway.scheduling.db_fallback_enabled", true)
-	viper.SetDefault("gateway.scheduling.db_fallback_timeout_seconds", 0)
-	viper.SetDefault("gateway.scheduling.db_fallback_max_qps", 0)
-	viper.SetDefault("gateway.scheduling.outbox_poll_interval_seconds", 1)
-	viper.SetDefault("gateway.scheduling.outbox_lag_warn_seconds", 5)
-	viper.SetDefault("gateway.scheduling.outbox_lag_rebuild_seconds", 10)
-	viper.SetDefault("gateway.scheduling.outbox_lag_rebuild_failures", 3)
-	viper.SetDefault("gateway.scheduling.outbox_backlog_rebuild_rows", 10000)
-	viper.SetDefault("gateway.scheduling.full_rebuild_interval_seconds", 300)
+	v.SetDefault("gateway.max_idle_conns", 240)           // 最大空闲连接总数（HTTP/2 场景默认）
+	v.SetDefault("gateway.max_idle_conns_per_host", 120)  // 每主机最大空闲连接（HTTP/2 场景默认）
+	v.SetDefault("gateway.max_conns_per_host", 240)       // 每主机最大连接数（含活跃，HTTP/2 场景默认）
+	v.SetDefault("gateway.idle_conn_timeout_seconds", 90) // 空闲连接超时（秒）
+	v.SetDefault("gateway.max_upstream_clients", 5000)
+	v.SetDefault("gateway.client_idle_ttl_seconds", 900)
+	v.SetDefault("gateway.concurrency_slot_ttl_minutes", 30) // 并发槽位过期时间（支持超长请求）
+	v.SetDefault("gateway.stream_data_interval_timeout", 180)
+	v.SetDefault("gateway.first_byte_timeout", 0)
+	v.SetDefault("gateway.stream_keepalive_interval", 10)
+	v.SetDefault("gateway.max_line_size", 40*1024*1024)
+	v.SetDefault("gateway.scheduling.sticky_session_max_waiting", 3)
+	v.SetDefault("gateway.scheduling.sticky_session_wait_timeout", 120*time.Second)
+	v.SetDefault("gateway.scheduling.fallback_wait_timeout", 30*time.Second)
+	v.SetDefault("gateway.scheduling.fallback_max_waiting", 100)
+	v.SetDefault("gateway.scheduling.fallback_selection_mode", "last_used")
+	v.SetDefault("gateway.scheduling.load_batch_enabled", true)
+	v.SetDefault("gateway.scheduling.slot_cleanup_interval", 30*time.Second)
+	v.SetDefault("gateway.scheduling.db_fallback_enabled", true)
+	v.SetDefault("gateway.scheduling.db_fallback_timeout_seconds", 0)
+	v.SetDefault("gateway.scheduling.db_fallback_max_qps", 0)
+	v.SetDefault("gateway.scheduling.outbox_poll_interval_seconds", 1)
+	v.SetDefault("gateway.scheduling.outbox_lag_warn_seconds", 5)
+	v.SetDefault("gateway.scheduling.outbox_lag_rebuild_seconds", 10)
+	v.SetDefault("gateway.scheduling.outbox_lag_rebuild_failures", 3)
+	v.SetDefault("gateway.scheduling.outbox_backlog_rebuild_rows", 10000)
+	v.SetDefault("gateway.scheduling.full_rebuild_interval_seconds", 300)
+	v.SetDefault("gateway.scheduling.context_estimate_tolerance_percent", 10)
+	v.SetDefault("gateway.scheduling.quota_aware.enabled", false)
+	v.SetDefault("gateway.scheduling.quota_aware.refresh_interval_seconds", 180)
+	v.SetDefault("gateway.scheduling.quota_aware.deprioritize_threshold", 80.0)
+	v.SetDefault("gateway.scheduling.quota_aware.skip_threshold", 98.0)
+	v.SetDefault("gateway.scheduling.strategy.default", "default")
+	v.SetDefault("gateway.scheduling.strategy.cost_window_seconds", 18000)
+	v.SetDefault("gateway.scheduling.strategy.latency_ewma_alpha", 0.2)
+	v.SetDefault("gateway.scheduling.fair_queue.enabled", false)
+	v.SetDefault("gateway.scheduling.fair_queue.admit_window", 4)
+	v.SetDefault("gateway.scheduling.fair_queue.ticket_ttl_seconds", 30)
 	// TLS指纹伪装配置（默认关闭，需要账号级别单独启用）
-	viper.SetDefault("gateway.tls_fingerprint.enabled", true)
-	viper.SetDefault("concurrency.ping_interval", 10)
+	v.SetDefault("gateway.tls_fingerprint.enabled", true)
+	v.SetDefault("concurrency.ping_interval", 10)
 
 	// TokenRefresh
-	viper.SetDefault("token_refresh.enabled", true)
-	viper.SetDefault("token_refresh.check_interval_minutes", 5)        // 每5分钟检查一次
-	viper.SetDefault("token_refresh.refresh_before_expiry_hours", 0.5) // 提前30分钟刷新（适配Google 1小时token）
-	viper.SetDefault("token_refresh.max_retries", 3)                   // 最多重试3次
-	viper.SetDefault("token_refresh.retry_backoff_seconds", 2)         // 重试退避基础2秒
-	viper.SetDefault("token_refresh.startup_jitter_seconds", 20)       // 启动抖动20秒，降低多实例同刻触发
-	viper.SetDefault("token_refresh.cycle_jitter_seconds", 8)          // 周期抖动8秒，降低周期性瞬时竞争
-	viper.SetDefault("token_refresh.leader_lock_enabled", true)        // 默认启用分布式 leader 锁
-	viper.SetDefault("token_refresh.leader_lock_ttl_seconds", 90)      // leader 锁 TTL 90秒
+	v.SetDefault("token_refresh.enabled", true)
+	v.SetDefault("token_refresh.check_interval_minutes", 5)        // 每5分钟检查一次
+	v.SetDefault("token_refresh.refresh_before_expiry_hours", 0.5) // 提前30分钟刷新（适配Google 1小时token）
+	v.SetDefault("token_refresh.max_retries", 3)                   // 最多重试3次
+	v.SetDefault("token_refresh.retry_backoff_seconds", 2)         // 重试退避基础2秒
+	v.SetDefault("token_refresh.startup_jitter_seconds", 20)       // 启动抖动20秒，降低多实例同刻触发
+	v.SetDefault("token_refresh.cycle_jitter_seconds", 8)          // 周期抖动8秒，降低周期性瞬时竞争
+	v.SetDefault("token_refresh.leader_lock_enabled", true)        // 默认启用分布式 leader 锁
+	v.SetDefault("token_refresh.leader_lock_ttl_seconds", 90)      // leader 锁 TTL 90秒
 
 	// Gemini OAuth - configure via environment variables or config file
 	// GEMINI_OAUTH_CLIENT_ID and GEMINI_OAUTH_CLIENT_SECRET
 	// Default: uses Gemini CLI public credentials (set via environment)
-	viper.SetDefault("gemini.oauth.client_id", "")
-	viper.SetDefault("gemini.oauth.client_secret", "")
-	viper.SetDefault("gemini.oauth.scopes", "")
-	viper.SetDefault("gemini.quota.policy", "")
+	v.SetDefault("gemini.oauth.client_id", "")
+	v.SetDefault("gemini.oauth.client_secret", "")
+	v.SetDefault("gemini.oauth.scopes", "")
+	v.SetDefault("gemini.quota.policy", "")
+
+	// Config reload
+	v.SetDefault("config_reload.watch_enabled", true)
+	v.SetDefault("config_reload.watch_interval_seconds", 10)
 }
 
 func (c *Config) Validate() error {
@@ -970,6 +1178,15 @@ func (c *Config) Validate() error {
 	if c.JWT.RefreshWindowMinutes < 0 {
 		return fmt.Errorf("jwt.refresh_window_minutes must be non-negative")
 	}
+	if c.Server.ShutdownDrainDelay < 0 {
+		return fmt.Errorf("server.shutdown_drain_delay must be non-negative")
+	}
+	if c.Server.ShutdownDrainTimeout < 0 {
+		return fmt.Errorf("server.shutdown_drain_timeout must be non-negative")
+	}
+	if c.Server.ReadinessSnapshotMaxAge < 0 {
+		return fmt.Errorf("server.readiness_snapshot_max_age must be non-negative")
+	}
 	if c.Security.CSP.Enabled && strings.TrimSpace(c.Security.CSP.Policy) == "" {
 		return fmt.Errorf("security.csp.policy is required when CSP is enabled")
 	}
@@ -1039,6 +1256,31 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("billing.circuit_breaker.half_open_requests must be positive")
 		}
 	}
+	if c.Billing.Reservation.Enabled {
+		if c.Billing.Reservation.TTLSeconds <= 0 {
+			return fmt.Errorf("billing.reservation.ttl_seconds must be positive")
+		}
+		if c.Billing.Reservation.DefaultMaxOutputTokens <= 0 {
+			return fmt.Errorf("billing.reservation.default_max_output_tokens must be positive")
+		}
+	}
+	if c.Billing.UsageOutbox.Enabled {
+		if c.Billing.UsageOutbox.Workers <= 0 {
+			return fmt.Errorf("billing.usage_outbox.workers must be positive")
+		}
+		if c.Billing.UsageOutbox.BatchSize <= 0 {
+			return fmt.Errorf("billing.usage_outbox.batch_size must be positive")
+		}
+		if c.Billing.UsageOutbox.ClaimIdleSeconds <= 0 {
+			return fmt.Errorf("billing.usage_outbox.claim_idle_seconds must be positive")
+		}
+		if c.Billing.UsageOutbox.MaxDeliveries <= 0 {
+			return fmt.Errorf("billing.usage_outbox.max_deliveries must be positive")
+		}
+		if c.Billing.UsageOutbox.RetryBackoffMaxSeconds <= 0 {
+			return fmt.Errorf("billing.usage_outbox.retry_backoff_max_seconds must be positive")
+		}
+	}
 	if c.Database.MaxOpenConns <= 0 {
 		return fmt.Errorf("database.max_open_conns must be positive")
 	}
@@ -1054,6 +1296,11 @@ func (c *Config) Validate() error {
 	if c.Database.ConnMaxIdleTimeMinutes < 0 {
 		return fmt.Errorf("database.conn_max_idle_time_minutes must be non-negative")
 	}
+	switch c.Redis.Mode {
+	case "", RedisModeExternal, RedisModeEmbedded:
+	default:
+		return fmt.Errorf("redis.mode must be one of: %s, %s", RedisModeExternal, RedisModeEmbedded)
+	}
 	if c.Redis.DialTimeoutSeconds <= 0 {
 		return fmt.Errorf("redis.dial_timeout_seconds must be positive")
 	}
@@ -1227,6 +1474,9 @@ func (c *Config) Validate() error {
 	if c.Gateway.ConcurrencySlotTTLMinutes <= 0 {
 		return fmt.Errorf("gateway.concurrency_slot_ttl_minutes must be positive")
 	}
+	if c.Gateway.FirstByteTimeout < 0 {
+		return fmt.Errorf("gateway.first_byte_timeout must be non-negative")
+	}
 	if c.Gateway.StreamDataIntervalTimeout < 0 {
 		return fmt.Errorf("gateway.stream_data_interval_timeout must be non-negative")
 	}
@@ -1280,9 +1530,43 @@ func (c *Config) Validate() error {
 	if c.Gateway.Scheduling.OutboxLagRebuildFailures <= 0 {
 		return fmt.Errorf("gateway.scheduling.outbox_lag_rebuild_failures must be positive")
 	}
+	if tolerance := c.Gateway.Scheduling.ContextEstimateTolerancePercent; tolerance < 0 || tolerance > 50 {
+		return fmt.Errorf("gateway.scheduling.context_estimate_tolerance_percent must be between 0 and 50")
+	}
 	if c.Gateway.Scheduling.OutboxBacklogRebuildRows < 0 {
 		return fmt.Errorf("gateway.scheduling.outbox_backlog_rebuild_rows must be non-negative")
 	}
+	if c.Gateway.Scheduling.QuotaAware.Enabled {
+		quotaCfg := c.Gateway.Scheduling.QuotaAware
+		if quotaCfg.RefreshIntervalSeconds <= 0 {
+			return fmt.Errorf("gateway.scheduling.quota_aware.refresh_interval_seconds must be positive")
+		}
+		if quotaCfg.DeprioritizeThreshold <= 0 || quotaCfg.DeprioritizeThreshold > 100 {
+			return fmt.Errorf("gateway.scheduling.quota_aware.deprioritize_threshold must be within (0, 100]")
+		}
+		if quotaCfg.SkipThreshold < quotaCfg.DeprioritizeThreshold || quotaCfg.SkipThreshold > 100 {
+			return fmt.Errorf("gateway.scheduling.quota_aware.skip_threshold must be within [deprioritize_threshold, 100]")
+		}
+	}
+	switch c.Gateway.Scheduling.Strategy.Default {
+	case "", "default", "weighted_round_robin", "least_cost", "least_latency", "fill_first":
+	default:
+		return fmt.Errorf("gateway.scheduling.strategy.default must be one of: default, weighted_round_robin, least_cost, least_latency, fill_first")
+	}
+	if c.Gateway.Scheduling.Strategy.CostWindowSeconds <= 0 {
+		return fmt.Errorf("gateway.scheduling.strategy.cost_window_seconds must be positive")
+	}
+	if alpha := c.Gateway.Scheduling.Strategy.LatencyEWMAAlpha; alpha <= 0 || alpha > 1 {
+		return fmt.Errorf("gateway.scheduling.strategy.latency_ewma_alpha must be within (0, 1]")
+	}
+	if c.Gateway.Scheduling.FairQueue.Enabled {
+		if c.Gateway.Scheduling.FairQueue.AdmitWindow <= 0 {
+			return fmt.Errorf("gateway.scheduling.fair_queue.admit_window must be positive")
+		}
+		if c.Gateway.Scheduling.FairQueue.TicketTTLSeconds <= 0 {
+			return fmt.Errorf("gateway.scheduling.fair_queue.ticket_ttl_seconds must be positive")
+		}
+	}
 	if c.Gateway.Scheduling.FullRebuildIntervalSeconds < 0 {
 		return fmt.Errorf("gateway.scheduling.full_rebuild_interval_seconds must be non-negative")
 	}
@@ -1306,9 +1590,18 @@ func (c *Config) Validate() error {
 	if c.Ops.Cleanup.Enabled && strings.TrimSpace(c.Ops.Cleanup.Schedule) == "" {
 		return fmt.Errorf("ops.cleanup.schedule is required when ops.cleanup.enabled=true")
 	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing.sample_ratio must be between 0-1")
+	}
+	if c.Tracing.Enabled && strings.TrimSpace(c.Tracing.Endpoint) == "" {
+		return fmt.Errorf("tracing.endpoint is required when tracing.enabled=true")
+	}
 	if c.Concurrency.PingInterval < 5 || c.Concurrency.PingInterval > 30 {
 		return fmt.Errorf("concurrency.ping_interval must be between 5-30 seconds")
 	}
+	if c.ConfigReload.WatchEnabled && c.ConfigReload.WatchIntervalSeconds <= 0 {
+		return fmt.Errorf("config_reload.watch_interval_seconds must be positive when config_reload.watch_enabled=true")
+	}
 	return nil
 }
 
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// hotReloadKeys 可热更新的配置项（按路径前缀匹配）。
+// 重新加载时生成新的配置快照并通过钩子发布，组件从各自持有的快照读取这些配置项。
+var hotReloadKeys = []string{
+	"gateway.scheduling",
+	"gateway.stream_data_interval_timeout",
+	"gateway.first_byte_timeout",
+	"gateway.stream_keepalive_interval",
+	"gateway.tls_fingerprint",
+	"rate_limit",
+	"pricing.remote_url",
+	"pricing.hash_url",
+}
+
+// restartKeys 位于可热更新前缀下、但在启动时固化的配置项，修改后仍需重启
+var restartKeys = []string{
+	"gateway.scheduling.slot_cleanup_interval",         // 槽位清理协程按启动时的周期运行
+	"gateway.scheduling.sticky_session_wait_timeout",   // 等待计数 TTL 在创建缓存时确定
+	"gateway.scheduling.fallback_wait_timeout",         // 同上
+	"gateway.scheduling.db_fallback_max_qps",           // 回源限流器在启动时创建
+	"gateway.scheduling.outbox_poll_interval_seconds",  // Outbox 轮询协程按启动时的周期运行
+	"gateway.scheduling.full_rebuild_interval_seconds", // 全量重建协程按启动时的周期运行
+	"gateway.scheduling.quota_aware.enabled",           // 使用率刷新协程只在启动时启动
+	"gateway.scheduling.quota_aware.refresh_interval_seconds",
+	"gateway.scheduling.strategy.cost_window_seconds", // 费用统计窗口在启动时创建
+	"gateway.scheduling.strategy.latency_ewma_alpha",  // 首字时间统计在启动时创建
+}
+
+// IsHotReloadable 判断配置项（mapstructure 路径，如 gateway.scheduling.fallback_max_waiting）能否热更新
+func IsHotReloadable(key string) bool {
+	for _, prefix := range restartKeys {
+		if matchKey(key, prefix) {
+			return false
+		}
+	}
+	for _, prefix := range hotReloadKeys {
+		if matchKey(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchKey key 等于 prefix 或位于 prefix 之下
+func matchKey(key, prefix string) bool {
+	return key == prefix || strings.HasPrefix(key, prefix+".")
+}
+
+// Diff 比较两份配置，返回值不同的配置项路径（已排序）。
+// 只返回路径不返回值，避免在日志与接口中暴露密钥。
+func Diff(old, new *Config) []string {
+	var keys []string
+	diffValue("", reflect.ValueOf(*old), reflect.ValueOf(*new), &keys)
+	sort.Strings(keys)
+	return keys
+}
+
+func diffValue(path string, a, b reflect.Value, keys *[]string) {
+	if a.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*keys = append(*keys, path)
+		}
+		return
+	}
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		diffValue(joinKey(path, fieldKey(field)), a.Field(i), b.Field(i), keys)
+	}
+}
+
+// ApplyHotReload 以 current 为基础生成新的配置快照，写入 src 中可热更新的配置项，返回新快照与已更新的配置项路径。
+// current 不会被修改：运行中的读取方可能正在无锁读取它，新快照发布后同样只读。
+func ApplyHotReload(current, src *Config) (*Config, []string) {
+	next := *current
+	var applied []string
+	for _, key := range Diff(current, src) {
+		if !IsHotReloadable(key) {
+			continue
+		}
+		// 按字段整体替换，映射与切片不会在两份快照之间原地修改
+		if setByKey(reflect.ValueOf(&next).Elem(), reflect.ValueOf(src).Elem(), strings.Split(key, ".")) {
+			applied = append(applied, key)
+		}
+	}
+	return &next, applied
+}
+
+func setByKey(dst, src reflect.Value, parts []string) bool {
+	if len(parts) == 0 {
+		dst.Set(src)
+		return true
+	}
+	if dst.Kind() != reflect.Struct {
+		return false
+	}
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && fieldKey(field) == parts[0] {
+			return setByKey(dst.Field(i), src.Field(i), parts[1:])
+		}
+	}
+	return false
+}
+
+// fieldKey 返回字段的 mapstructure 名称，未声明时与 viper 一致使用小写字段名
+func fieldKey(field reflect.StructField) string {
+	if tag := field.Tag.Get("mapstructure"); tag != "" {
+		if name, _, _ := strings.Cut(tag, ","); name != "" {
+			return name
+		}
+	}
+	return strings.ToLower(field.Name)
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,134 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestReloadKeepsGeneratedSecrets(t *testing.T) {
+	viper.Reset()
+
+	current, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	path := writeConfigFile(t, "gateway:\n  scheduling:\n    fallback_max_waiting: 42\n")
+
+	cfg, err := Reload(path, current)
+	if err != nil {
+		t.Fatalf("Reload() error: %v", err)
+	}
+	if cfg.JWT.Secret != current.JWT.Secret {
+		t.Fatalf("JWT secret should be inherited when not configured")
+	}
+	if cfg.Gateway.Scheduling.FallbackMaxWaiting != 42 {
+		t.Fatalf("FallbackMaxWaiting = %d, want 42", cfg.Gateway.Scheduling.FallbackMaxWaiting)
+	}
+	if cfg.Gateway.Scheduling.StickySessionMaxWaiting != 3 {
+		t.Fatalf("StickySessionMaxWaiting = %d, want default 3", cfg.Gateway.Scheduling.StickySessionMaxWaiting)
+	}
+}
+
+func TestReloadRejectsInvalidConfig(t *testing.T) {
+	viper.Reset()
+
+	current, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	path := writeConfigFile(t, "config_reload:\n  watch_enabled: true\n  watch_interval_seconds: 0\n")
+	if _, err := Reload(path, current); err == nil {
+		t.Fatalf("Reload() should reject invalid config")
+	}
+	if _, err := Reload(filepath.Join(t.TempDir(), "missing.yaml"), current); err == nil {
+		t.Fatalf("Reload() should fail when the file does not exist")
+	}
+}
+
+func TestIsHotReloadable(t *testing.T) {
+	tests := map[string]bool{
+		"gateway.scheduling.fallback_max_waiting":         true,
+		"gateway.scheduling.strategy.default":             true,
+		"gateway.scheduling.fair_queue.admit_window":      true,
+		"gateway.scheduling.slot_cleanup_interval":        false,
+		"gateway.scheduling.strategy.cost_window_seconds": false,
+		"gateway.stream_data_interval_timeout":            true,
+		"gateway.tls_fingerprint.profiles":                true,
+		"rate_limit.overload_cooldown_minutes":            true,
+		"pricing.remote_url":                              true,
+		"pricing.data_dir":                                false,
+		"gateway.max_body_size":                           false,
+		"server.port":                                     false,
+		"gateway.scheduling_extra":                        false,
+	}
+	for key, want := range tests {
+		if got := IsHotReloadable(key); got != want {
+			t.Errorf("IsHotReloadable(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestDiffAndApplyHotReload(t *testing.T) {
+	live := &Config{}
+	live.Server.Port = 8080
+	live.Gateway.Scheduling.FallbackMaxWaiting = 10
+	live.Gateway.Scheduling.SlotCleanupInterval = time.Minute
+
+	next := *live
+	next.Server.Port = 9090
+	next.Gateway.Scheduling.FallbackMaxWaiting = 20
+	next.Gateway.Scheduling.SlotCleanupInterval = 2 * time.Minute
+	next.Gateway.TLSFingerprint.Profiles = map[string]TLSProfileConfig{"custom": {Name: "Custom"}}
+	next.Pricing.RemoteURL = "https://example.com/prices.json"
+
+	changed := Diff(live, &next)
+	want := []string{
+		"gateway.scheduling.fallback_max_waiting",
+		"gateway.scheduling.slot_cleanup_interval",
+		"gateway.tls_fingerprint.profiles",
+		"pricing.remote_url",
+		"server.port",
+	}
+	if !reflect.DeepEqual(changed, want) {
+		t.Fatalf("Diff() = %v, want %v", changed, want)
+	}
+
+	reloaded, applied := ApplyHotReload(live, &next)
+	wantApplied := []string{
+		"gateway.scheduling.fallback_max_waiting",
+		"gateway.tls_fingerprint.profiles",
+		"pricing.remote_url",
+	}
+	if !reflect.DeepEqual(applied, wantApplied) {
+		t.Fatalf("ApplyHotReload() = %v, want %v", applied, wantApplied)
+	}
+	if reloaded.Gateway.Scheduling.FallbackMaxWaiting != 20 || reloaded.Pricing.RemoteURL != next.Pricing.RemoteURL {
+		t.Fatalf("hot keys were not applied")
+	}
+	if reloaded.Gateway.TLSFingerprint.Profiles["custom"].Name != "Custom" {
+		t.Fatalf("TLS profiles were not applied")
+	}
+	if reloaded.Server.Port != 8080 || reloaded.Gateway.Scheduling.SlotCleanupInterval != time.Minute {
+		t.Fatalf("restart-required keys must not be applied")
+	}
+	if remaining := Diff(reloaded, &next); !reflect.DeepEqual(remaining, []string{"gateway.scheduling.slot_cleanup_interval", "server.port"}) {
+		t.Fatalf("remaining diff = %v", remaining)
+	}
+	if live.Gateway.Scheduling.FallbackMaxWaiting != 10 || live.Gateway.TLSFingerprint.Profiles != nil {
+		t.Fatalf("the running snapshot must not be modified")
+	}
+}
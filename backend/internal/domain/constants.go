@@ -22,6 +22,7 @@ const (
 	PlatformOpenAI      = "openai"
 	PlatformGemini      = "gemini"
 	PlatformAntigravity = "antigravity"
+	PlatformCompatible  = "compatible" // 通用 OpenAI 兼容上游（DeepSeek/Qwen/Kimi/vLLM/Ollama 等）
 )
 
 // Account type constants
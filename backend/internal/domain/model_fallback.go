@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 模型降级触发条件
+const (
+	ModelFallbackTriggerNoAccount      = "no_account"       // 无可调度账号
+	ModelFallbackTriggerRateLimited    = "rate_limited"     // 所有账号均返回 429
+	ModelFallbackTriggerOverloaded     = "overloaded"       // 上游 529 过载
+	ModelFallbackTriggerContextTooLong = "context_too_long" // 上下文超长
+)
+
+// MaxModelFallbackChainLength 单条降级链最多允许的备选模型数量
+const MaxModelFallbackChainLength = 5
+
+var ErrModelFallbackInvalid = infraerrors.BadRequest("MODEL_FALLBACK_INVALID", "invalid model fallback rules")
+
+// ModelFallbackRule 分组级模型降级链：请求模型命中 Model 后，按 Fallbacks 顺序依次尝试。
+type ModelFallbackRule struct {
+	// Model 请求模型匹配模式（支持末尾 * 通配符）
+	Model string `json:"model"`
+	// Fallbacks 备选模型，按顺序尝试
+	Fallbacks []string `json:"fallbacks"`
+	// Triggers 触发条件，为空表示全部条件均触发
+	Triggers []string `json:"triggers,omitempty"`
+}
+
+// HasTrigger 判断规则是否响应指定触发条件
+func (r ModelFallbackRule) HasTrigger(trigger string) bool {
+	if len(r.Triggers) == 0 {
+		return true
+	}
+	for _, t := range r.Triggers {
+		if t == trigger {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidModelFallbackTrigger 检查触发条件是否合法
+func IsValidModelFallbackTrigger(trigger string) bool {
+	switch trigger {
+	case ModelFallbackTriggerNoAccount, ModelFallbackTriggerRateLimited,
+		ModelFallbackTriggerOverloaded, ModelFallbackTriggerContextTooLong:
+		return true
+	}
+	return false
+}
+
+// NormalizeModelFallbackRules 去除空白、校验并返回规范化后的规则列表
+func NormalizeModelFallbackRules(rules []ModelFallbackRule) ([]ModelFallbackRule, error) {
+	if len(rules) == 0 {
+		return []ModelFallbackRule{}, nil
+	}
+	out := make([]ModelFallbackRule, 0, len(rules))
+	seen := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		model := strings.TrimSpace(rule.Model)
+		if model == "" {
+			return nil, ErrModelFallbackInvalid.WithMetadata(map[string]string{"reason": "model is required"})
+		}
+		if _, ok := seen[model]; ok {
+			return nil, ErrModelFallbackInvalid.WithMetadata(map[string]string{"reason": "duplicate model", "model": model})
+		}
+		seen[model] = struct{}{}
+
+		fallbacks := make([]string, 0, len(rule.Fallbacks))
+		for _, fb := range rule.Fallbacks {
+			fb = strings.TrimSpace(fb)
+			if fb == "" {
+				continue
+			}
+			if strings.Contains(fb, "*") || fb == model {
+				return nil, ErrModelFallbackInvalid.WithMetadata(map[string]string{"reason": "invalid fallback model", "model": model, "fallback": fb})
+			}
+			fallbacks = append(fallbacks, fb)
+		}
+		if len(fallbacks) == 0 || len(fallbacks) > MaxModelFallbackChainLength {
+			return nil, ErrModelFallbackInvalid.WithMetadata(map[string]string{"reason": "fallbacks must contain 1-5 models", "model": model})
+		}
+
+		triggers := make([]string, 0, len(rule.Triggers))
+		for _, t := range rule.Triggers {
+			t = strings.TrimSpace(t)
+			if !IsValidModelFallbackTrigger(t) {
+				return nil, ErrModelFallbackInvalid.WithMetadata(map[string]string{"reason": "invalid trigger", "trigger": t})
+			}
+			triggers = append(triggers, t)
+		}
+		out = append(out, ModelFallbackRule{Model: model, Fallbacks: fallbacks, Triggers: triggers})
+	}
+	return out, nil
+}
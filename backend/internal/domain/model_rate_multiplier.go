@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var ErrModelRateMultiplierInvalid = infraerrors.BadRequest("MODEL_RATE_MULTIPLIER_INVALID", "invalid model rate multipliers")
+
+// NormalizeModelRateMultipliers 去除模型模式两端空白并校验分组内的模型倍率
+// 模式仅允许末尾使用 * 通配符，倍率必须为非负数
+func NormalizeModelRateMultipliers(multipliers map[string]float64) (map[string]float64, error) {
+	out := make(map[string]float64, len(multipliers))
+	for pattern, rate := range multipliers {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return nil, ErrModelRateMultiplierInvalid.WithMetadata(map[string]string{"reason": "model is required"})
+		}
+		if idx := strings.Index(pattern, "*"); idx >= 0 && idx != len(pattern)-1 {
+			return nil, ErrModelRateMultiplierInvalid.WithMetadata(map[string]string{"reason": "wildcard only allowed at the end", "model": pattern})
+		}
+		if rate < 0 {
+			return nil, ErrModelRateMultiplierInvalid.WithMetadata(map[string]string{"reason": "multiplier must be >= 0", "model": pattern})
+		}
+		if _, ok := out[pattern]; ok {
+			return nil, ErrModelRateMultiplierInvalid.WithMetadata(map[string]string{"reason": "duplicate model", "model": pattern})
+		}
+		out[pattern] = rate
+	}
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 账号调度策略
+const (
+	SchedulingStrategyDefault            = "default"              // 优先级 -> 负载率 -> 最久未用
+	SchedulingStrategyWeightedRoundRobin = "weighted_round_robin" // 按账号并发数平滑加权轮询
+	SchedulingStrategyLeastCost          = "least_cost"           // 窗口内费用最低
+	SchedulingStrategyLeastLatency       = "least_latency"        // 首字时间（EWMA）最低
+	SchedulingStrategyFillFirst          = "fill_first"           // 用满一个账号再用下一个
+)
+
+var ErrSchedulingStrategyInvalid = infraerrors.BadRequest("SCHEDULING_STRATEGY_INVALID", "invalid scheduling strategy")
+
+// NormalizeSchedulingStrategy 去除两端空白并校验分组调度策略，空字符串表示使用全局默认策略
+func NormalizeSchedulingStrategy(strategy string) (string, error) {
+	strategy = strings.TrimSpace(strategy)
+	switch strategy {
+	case "", SchedulingStrategyDefault, SchedulingStrategyWeightedRoundRobin,
+		SchedulingStrategyLeastCost, SchedulingStrategyLeastLatency, SchedulingStrategyFillFirst:
+		return strategy, nil
+	default:
+		return "", ErrSchedulingStrategyInvalid.WithMetadata(map[string]string{"strategy": strategy})
+	}
+}
@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// backupPassphraseHeader 归档口令通过请求头传递，避免出现在 URL 与访问日志中
+const backupPassphraseHeader = "X-Backup-Passphrase"
+
+// BackupHandler 处理全量备份与恢复的 HTTP 请求
+type BackupHandler struct {
+	backupService *service.BackupService
+}
+
+// NewBackupHandler 创建备份处理器
+func NewBackupHandler(backupService *service.BackupService) *BackupHandler {
+	return &BackupHandler{backupService: backupService}
+}
+
+// Backup 以附件形式流式下载全量备份归档
+// POST /api/v1/admin/system/backup?skip_usage_logs=true&usage_from=2026-01-01&usage_to=2026-02-01
+// 请求头 X-Backup-Passphrase 非空时加密归档
+func (h *BackupHandler) Backup(c *gin.Context) {
+	opts := service.BackupOptions{Passphrase: c.GetHeader(backupPassphraseHeader)}
+	if raw := c.Query("skip_usage_logs"); raw != "" {
+		skip, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.BadRequest(c, "Invalid skip_usage_logs parameter")
+			return
+		}
+		opts.SkipUsageLogs = skip
+	}
+	var err error
+	if opts.UsageFrom, err = parseBackupTime(c.Query("usage_from")); err != nil {
+		response.BadRequest(c, "Invalid usage_from parameter, use YYYY-MM-DD or RFC3339")
+		return
+	}
+	if opts.UsageTo, err = parseBackupTime(c.Query("usage_to")); err != nil {
+		response.BadRequest(c, "Invalid usage_to parameter, use YYYY-MM-DD or RFC3339")
+		return
+	}
+
+	filename := fmt.Sprintf("sub2api-backup-%s.s2abak", time.Now().UTC().Format("20060102-150405"))
+	w := &backupResponseWriter{c: c, filename: filename}
+	if _, err := h.backupService.Backup(c.Request.Context(), w, opts); err != nil {
+		if !w.started {
+			response.ErrorFrom(c, err)
+			return
+		}
+		// 已开始输出时无法再返回 JSON；归档缺少 trailer，恢复时会被识别为不完整
+		log.Printf("[Backup] backup stream aborted: %v", err)
+		c.Abort()
+	}
+}
+
+// Restore 上传归档并在单个事务中恢复
+// POST /api/v1/admin/system/restore?force=true
+// 请求体为归档文件内容；恢复后需清空 Redis 缓存并重启服务
+func (h *BackupHandler) Restore(c *gin.Context) {
+	opts := service.RestoreOptions{Passphrase: c.GetHeader(backupPassphraseHeader)}
+	if raw := c.Query("force"); raw != "" {
+		force, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.BadRequest(c, "Invalid force parameter")
+			return
+		}
+		opts.Force = force
+	}
+
+	result, err := h.backupService.Restore(c.Request.Context(), c.Request.Body, opts)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+func parseBackupTime(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse("2006-01-02", raw); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// backupResponseWriter 首次写入时才发送附件响应头，之前出错仍可返回 JSON 错误
+type backupResponseWriter struct {
+	c        *gin.Context
+	filename string
+	started  bool
+}
+
+func (w *backupResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.c.Header("Content-Type", "application/octet-stream")
+		w.c.Header("Content-Disposition", "attachment; filename="+w.filename)
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
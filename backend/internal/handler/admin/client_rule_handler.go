@@ -0,0 +1,263 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ClientRuleHandler 处理客户端准入规则的 HTTP 请求
+type ClientRuleHandler struct {
+	service *service.ClientRuleService
+}
+
+// NewClientRuleHandler 创建客户端准入规则处理器
+func NewClientRuleHandler(service *service.ClientRuleService) *ClientRuleHandler {
+	return &ClientRuleHandler{service: service}
+}
+
+// CreateClientRuleRequest 创建规则请求
+type CreateClientRuleRequest struct {
+	Name                  string   `json:"name" binding:"required"`
+	Enabled               *bool    `json:"enabled"`
+	Priority              int      `json:"priority"`
+	GroupIDs              []int64  `json:"group_ids"`
+	Preset                string   `json:"preset"`
+	UserAgentPattern      *string  `json:"user_agent_pattern"`
+	RequiredHeaders       []string `json:"required_headers"`
+	MetadataUserIDPattern *string  `json:"metadata_user_id_pattern"`
+	BodyFields            []string `json:"body_fields"`
+	FallbackGroupID       *int64   `json:"fallback_group_id"`
+	Description           *string  `json:"description"`
+}
+
+// UpdateClientRuleRequest 更新规则请求（部分更新，所有字段可选）
+type UpdateClientRuleRequest struct {
+	Name                  *string  `json:"name"`
+	Enabled               *bool    `json:"enabled"`
+	Priority              *int     `json:"priority"`
+	GroupIDs              []int64  `json:"group_ids"`
+	Preset                *string  `json:"preset"`
+	UserAgentPattern      *string  `json:"user_agent_pattern"`
+	RequiredHeaders       []string `json:"required_headers"`
+	MetadataUserIDPattern *string  `json:"metadata_user_id_pattern"`
+	BodyFields            []string `json:"body_fields"`
+	FallbackGroupID       *int64   `json:"fallback_group_id"`
+	Description           *string  `json:"description"`
+}
+
+// List 获取所有规则
+// GET /api/v1/admin/client-rules
+func (h *ClientRuleHandler) List(c *gin.Context) {
+	rules, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, rules)
+}
+
+// GetByID 根据 ID 获取规则
+// GET /api/v1/admin/client-rules/:id
+func (h *ClientRuleHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if rule == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Create 创建规则
+// POST /api/v1/admin/client-rules
+func (h *ClientRuleHandler) Create(c *gin.Context) {
+	var req CreateClientRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	rule := &model.ClientRule{
+		Name:                  req.Name,
+		Priority:              req.Priority,
+		GroupIDs:              req.GroupIDs,
+		Preset:                req.Preset,
+		UserAgentPattern:      emptyToNil(req.UserAgentPattern),
+		RequiredHeaders:       req.RequiredHeaders,
+		MetadataUserIDPattern: emptyToNil(req.MetadataUserIDPattern),
+		BodyFields:            req.BodyFields,
+		FallbackGroupID:       req.FallbackGroupID,
+		Description:           req.Description,
+	}
+
+	// 设置默认值
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	} else {
+		rule.Enabled = true
+	}
+	normalizeClientRuleSlices(rule)
+
+	created, err := h.service.Create(c.Request.Context(), rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新规则（支持部分更新）
+// PUT /api/v1/admin/client-rules/:id
+func (h *ClientRuleHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	var req UpdateClientRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	rule := *existing
+	rule.ID = id
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.GroupIDs != nil {
+		rule.GroupIDs = req.GroupIDs
+	}
+	if req.Preset != nil {
+		rule.Preset = *req.Preset
+	}
+	if req.UserAgentPattern != nil {
+		rule.UserAgentPattern = emptyToNil(req.UserAgentPattern)
+	}
+	if req.RequiredHeaders != nil {
+		rule.RequiredHeaders = req.RequiredHeaders
+	}
+	if req.MetadataUserIDPattern != nil {
+		rule.MetadataUserIDPattern = emptyToNil(req.MetadataUserIDPattern)
+	}
+	if req.BodyFields != nil {
+		rule.BodyFields = req.BodyFields
+	}
+	// fallback_group_id 传 0 表示清除降级分组
+	if req.FallbackGroupID != nil {
+		if *req.FallbackGroupID > 0 {
+			rule.FallbackGroupID = req.FallbackGroupID
+		} else {
+			rule.FallbackGroupID = nil
+		}
+	}
+	if req.Description != nil {
+		rule.Description = req.Description
+	}
+	normalizeClientRuleSlices(&rule)
+
+	updated, err := h.service.Update(c.Request.Context(), &rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除规则
+// DELETE /api/v1/admin/client-rules/:id
+func (h *ClientRuleHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Rule deleted successfully"})
+}
+
+// ResetRejectedCount 清零规则拒绝计数
+// POST /api/v1/admin/client-rules/:id/reset-counter
+func (h *ClientRuleHandler) ResetRejectedCount(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.ResetRejectedCount(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Counter reset successfully"})
+}
+
+// normalizeClientRuleSlices 确保切片不为 nil
+func normalizeClientRuleSlices(rule *model.ClientRule) {
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.RequiredHeaders == nil {
+		rule.RequiredHeaders = []string{}
+	}
+	if rule.BodyFields == nil {
+		rule.BodyFields = []string{}
+	}
+}
+
+// emptyToNil 将空白字符串视为未设置
+func emptyToNil(value *string) *string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ClusterHandler 处理集群节点注册表与节点操作的 HTTP 请求
+type ClusterHandler struct {
+	clusterService *service.ClusterService
+}
+
+// NewClusterHandler 创建集群节点处理器
+func NewClusterHandler(clusterService *service.ClusterService) *ClusterHandler {
+	return &ClusterHandler{clusterService: clusterService}
+}
+
+// NodeCommandRequest 节点操作请求
+type NodeCommandRequest struct {
+	Action string `json:"action" binding:"required,oneof=drain undrain restart"`
+}
+
+// ListNodes 列出在线节点
+// GET /api/v1/admin/system/nodes
+func (h *ClusterHandler) ListNodes(c *gin.Context) {
+	nodes, err := h.clusterService.ListNodes(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{
+		"self_node_id": h.clusterService.NodeID(),
+		"nodes":        nodes,
+	})
+}
+
+// SendCommand 向指定节点下发 drain / undrain / restart 指令
+// POST /api/v1/admin/system/nodes/:id/command
+func (h *ClusterHandler) SendCommand(c *gin.Context) {
+	var req NodeCommandRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	if err := h.clusterService.SendCommand(c.Request.Context(), c.Param("id"), req.Action); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Command sent"})
+}
+
+// GetRollingRestart 获取最近一次滚动重启状态
+// GET /api/v1/admin/system/rolling-restart
+func (h *ClusterHandler) GetRollingRestart(c *gin.Context) {
+	status, err := h.clusterService.GetRollingRestart(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, status)
+}
+
+// StartRollingRestart 依次重启所有节点
+// POST /api/v1/admin/system/rolling-restart
+func (h *ClusterHandler) StartRollingRestart(c *gin.Context) {
+	status, err := h.clusterService.StartRollingRestart(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, status)
+}
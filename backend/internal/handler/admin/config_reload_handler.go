@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ConfigReloadHandler 处理配置文件热更新的 HTTP 请求
+type ConfigReloadHandler struct {
+	configReloadService *service.ConfigReloadService
+}
+
+// NewConfigReloadHandler 创建配置热更新处理器
+func NewConfigReloadHandler(configReloadService *service.ConfigReloadService) *ConfigReloadHandler {
+	return &ConfigReloadHandler{configReloadService: configReloadService}
+}
+
+// GetStatus 获取配置文件监听状态与最近一次重新加载结果
+// GET /api/v1/admin/system/config/reload
+func (h *ConfigReloadHandler) GetStatus(c *gin.Context) {
+	response.Success(c, h.configReloadService.Status(c.Request.Context()))
+}
+
+// Reload 重新读取并校验配置文件，应用可热更新的配置项，返回已生效与需要重启的配置项
+// POST /api/v1/admin/system/config/reload
+func (h *ConfigReloadHandler) Reload(c *gin.Context) {
+	result, err := h.configReloadService.Reload(c.Request.Context(), service.ConfigReloadTriggerManual)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, result)
+}
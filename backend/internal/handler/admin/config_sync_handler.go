@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// configSyncMaxBodyBytes 声明式配置文件大小上限
+const configSyncMaxBodyBytes = 10 << 20
+
+// ConfigSyncHandler 处理声明式配置同步（plan / apply / export）的 HTTP 请求
+type ConfigSyncHandler struct {
+	configSyncService *service.ConfigSyncService
+}
+
+// NewConfigSyncHandler 创建声明式配置同步处理器
+func NewConfigSyncHandler(configSyncService *service.ConfigSyncService) *ConfigSyncHandler {
+	return &ConfigSyncHandler{configSyncService: configSyncService}
+}
+
+// Plan 对比声明式配置与当前状态，返回变更计划（不修改数据）
+// POST /api/v1/admin/config-sync/plan?prune=true
+// 请求体为 YAML 或 JSON 文档；${ENV} 引用需由客户端在提交前解析
+func (h *ConfigSyncHandler) Plan(c *gin.Context) {
+	doc, opts, ok := h.parseRequest(c)
+	if !ok {
+		return
+	}
+	plan, err := h.configSyncService.Plan(c.Request.Context(), doc, opts)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, plan)
+}
+
+// Apply 在单个事务中执行变更计划
+// POST /api/v1/admin/config-sync/apply?prune=true
+// 计划存在校验错误时不做任何修改，返回 applied=false 与错误列表
+func (h *ConfigSyncHandler) Apply(c *gin.Context) {
+	doc, opts, ok := h.parseRequest(c)
+	if !ok {
+		return
+	}
+	plan, err := h.configSyncService.Apply(c.Request.Context(), doc, opts)
+	if err != nil && !(errors.Is(err, service.ErrConfigSyncPlanInvalid) && plan != nil) {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, plan)
+}
+
+// Export 导出当前配置（不含账号凭证、代理密码与凭证类设置）
+// GET /api/v1/admin/config-sync/export
+func (h *ConfigSyncHandler) Export(c *gin.Context) {
+	doc, err := h.configSyncService.Export(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, doc)
+}
+
+func (h *ConfigSyncHandler) parseRequest(c *gin.Context) (*service.ConfigSyncDocument, service.ConfigSyncOptions, bool) {
+	opts := service.ConfigSyncOptions{}
+	if raw := c.Query("prune"); raw != "" {
+		prune, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.BadRequest(c, "Invalid prune parameter")
+			return nil, opts, false
+		}
+		opts.Prune = prune
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, configSyncMaxBodyBytes))
+	if err != nil {
+		response.BadRequest(c, "Failed to read request body: "+err.Error())
+		return nil, opts, false
+	}
+	doc, err := service.ParseConfigSyncDocument(body)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return nil, opts, false
+	}
+	return doc, opts, true
+}
@@ -27,7 +27,7 @@ func NewGroupHandler(adminService service.AdminService) *GroupHandler {
 type CreateGroupRequest struct {
 	Name             string   `json:"name" binding:"required"`
 	Description      string   `json:"description"`
-	Platform         string   `json:"platform" binding:"omitempty,oneof=anthropic openai gemini antigravity"`
+	Platform         string   `json:"platform" binding:"omitempty,oneof=anthropic openai gemini antigravity compatible"`
 	RateMultiplier   float64  `json:"rate_multiplier"`
 	IsExclusive      bool     `json:"is_exclusive"`
 	SubscriptionType string   `json:"subscription_type" binding:"omitempty,oneof=standard subscription"`
@@ -47,6 +47,14 @@ type CreateGroupRequest struct {
 	MCPXMLInject        *bool              `json:"mcp_xml_inject"`
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes []string `json:"supported_model_scopes"`
+	// 模型降级链
+	ModelFallbacks []service.ModelFallbackRule `json:"model_fallbacks"`
+	// 模型倍率：模型匹配模式 -> 倍率
+	ModelRateMultipliers map[string]float64 `json:"model_rate_multipliers"`
+	// 账号调度策略（空表示使用全局默认）
+	SchedulingStrategy string `json:"scheduling_strategy"`
+	// 流式首字节预算（秒），0 表示使用全局配置
+	FirstByteTimeoutSeconds int `json:"first_byte_timeout_seconds"`
 	// 从指定分组复制账号（创建后自动绑定）
 	CopyAccountsFromGroupIDs []int64 `json:"copy_accounts_from_group_ids"`
 }
@@ -55,7 +63,7 @@ type CreateGroupRequest struct {
 type UpdateGroupRequest struct {
 	Name             string   `json:"name"`
 	Description      string   `json:"description"`
-	Platform         string   `json:"platform" binding:"omitempty,oneof=anthropic openai gemini antigravity"`
+	Platform         string   `json:"platform" binding:"omitempty,oneof=anthropic openai gemini antigravity compatible"`
 	RateMultiplier   *float64 `json:"rate_multiplier"`
 	IsExclusive      *bool    `json:"is_exclusive"`
 	Status           string   `json:"status" binding:"omitempty,oneof=active inactive"`
@@ -76,6 +84,14 @@ type UpdateGroupRequest struct {
 	MCPXMLInject        *bool              `json:"mcp_xml_inject"`
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes *[]string `json:"supported_model_scopes"`
+	// 模型降级链（传入空数组表示清除）
+	ModelFallbacks *[]service.ModelFallbackRule `json:"model_fallbacks"`
+	// 模型倍率（传入空对象表示清除）
+	ModelRateMultipliers *map[string]float64 `json:"model_rate_multipliers"`
+	// 账号调度策略（传入空字符串表示使用全局默认）
+	SchedulingStrategy *string `json:"scheduling_strategy"`
+	// 流式首字节预算（秒，传入 0 表示使用全局配置）
+	FirstByteTimeoutSeconds *int `json:"first_byte_timeout_seconds"`
 	// 从指定分组复制账号（同步操作：先清空当前分组的账号绑定，再绑定源分组的账号）
 	CopyAccountsFromGroupIDs []int64 `json:"copy_accounts_from_group_ids"`
 }
@@ -186,6 +202,10 @@ func (h *GroupHandler) Create(c *gin.Context) {
 		ModelRoutingEnabled:             req.ModelRoutingEnabled,
 		MCPXMLInject:                    req.MCPXMLInject,
 		SupportedModelScopes:            req.SupportedModelScopes,
+		ModelFallbacks:                  req.ModelFallbacks,
+		ModelRateMultipliers:            req.ModelRateMultipliers,
+		SchedulingStrategy:              req.SchedulingStrategy,
+		FirstByteTimeoutSeconds:         req.FirstByteTimeoutSeconds,
 		CopyAccountsFromGroupIDs:        req.CopyAccountsFromGroupIDs,
 	})
 	if err != nil {
@@ -232,6 +252,10 @@ func (h *GroupHandler) Update(c *gin.Context) {
 		ModelRoutingEnabled:             req.ModelRoutingEnabled,
 		MCPXMLInject:                    req.MCPXMLInject,
 		SupportedModelScopes:            req.SupportedModelScopes,
+		ModelFallbacks:                  req.ModelFallbacks,
+		ModelRateMultipliers:            req.ModelRateMultipliers,
+		SchedulingStrategy:              req.SchedulingStrategy,
+		FirstByteTimeoutSeconds:         req.FirstByteTimeoutSeconds,
 		CopyAccountsFromGroupIDs:        req.CopyAccountsFromGroupIDs,
 	})
 	if err != nil {
@@ -0,0 +1,220 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GuardrailHandler 处理内容护栏规则的 HTTP 请求
+type GuardrailHandler struct {
+	service *service.GuardrailService
+}
+
+// NewGuardrailHandler 创建内容护栏规则处理器
+func NewGuardrailHandler(service *service.GuardrailService) *GuardrailHandler {
+	return &GuardrailHandler{service: service}
+}
+
+// CreateGuardrailRuleRequest 创建规则请求
+type CreateGuardrailRuleRequest struct {
+	Name         string   `json:"name" binding:"required"`
+	Enabled      *bool    `json:"enabled"`
+	Priority     int      `json:"priority"`
+	GroupIDs     []int64  `json:"group_ids"`
+	MatchType    string   `json:"match_type" binding:"required"`
+	Patterns     []string `json:"patterns"`
+	Action       string   `json:"action"`
+	BlockMessage *string  `json:"block_message"`
+	Description  *string  `json:"description"`
+}
+
+// UpdateGuardrailRuleRequest 更新规则请求（部分更新，所有字段可选）
+type UpdateGuardrailRuleRequest struct {
+	Name         *string  `json:"name"`
+	Enabled      *bool    `json:"enabled"`
+	Priority     *int     `json:"priority"`
+	GroupIDs     []int64  `json:"group_ids"`
+	MatchType    *string  `json:"match_type"`
+	Patterns     []string `json:"patterns"`
+	Action       *string  `json:"action"`
+	BlockMessage *string  `json:"block_message"`
+	Description  *string  `json:"description"`
+}
+
+// List 获取所有规则
+// GET /api/v1/admin/guardrail-rules
+func (h *GuardrailHandler) List(c *gin.Context) {
+	rules, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, rules)
+}
+
+// GetByID 根据 ID 获取规则
+// GET /api/v1/admin/guardrail-rules/:id
+func (h *GuardrailHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if rule == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Create 创建规则
+// POST /api/v1/admin/guardrail-rules
+func (h *GuardrailHandler) Create(c *gin.Context) {
+	var req CreateGuardrailRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	rule := &model.GuardrailRule{
+		Name:         req.Name,
+		Priority:     req.Priority,
+		GroupIDs:     req.GroupIDs,
+		MatchType:    req.MatchType,
+		Patterns:     req.Patterns,
+		Action:       req.Action,
+		BlockMessage: req.BlockMessage,
+		Description:  req.Description,
+	}
+
+	// 设置默认值
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	} else {
+		rule.Enabled = true
+	}
+	if rule.Action == "" {
+		rule.Action = model.GuardrailActionFlag
+	}
+	normalizeGuardrailRuleSlices(rule)
+
+	created, err := h.service.Create(c.Request.Context(), rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新规则（支持部分更新）
+// PUT /api/v1/admin/guardrail-rules/:id
+func (h *GuardrailHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	var req UpdateGuardrailRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	rule := *existing
+	rule.ID = id
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.GroupIDs != nil {
+		rule.GroupIDs = req.GroupIDs
+	}
+	if req.MatchType != nil {
+		rule.MatchType = *req.MatchType
+	}
+	if req.Patterns != nil {
+		rule.Patterns = req.Patterns
+	}
+	if req.Action != nil {
+		rule.Action = *req.Action
+	}
+	if req.BlockMessage != nil {
+		rule.BlockMessage = req.BlockMessage
+	}
+	if req.Description != nil {
+		rule.Description = req.Description
+	}
+	normalizeGuardrailRuleSlices(&rule)
+
+	updated, err := h.service.Update(c.Request.Context(), &rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除规则
+// DELETE /api/v1/admin/guardrail-rules/:id
+func (h *GuardrailHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Rule deleted successfully"})
+}
+
+// normalizeGuardrailRuleSlices 确保切片不为 nil
+func normalizeGuardrailRuleSlices(rule *model.GuardrailRule) {
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.Patterns == nil {
+		rule.Patterns = []string{}
+	}
+}
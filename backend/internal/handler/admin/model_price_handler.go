@@ -0,0 +1,295 @@
+package admin
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ModelPriceHandler 处理模型价格覆盖的 HTTP 请求
+type ModelPriceHandler struct {
+	service *service.ModelPriceService
+}
+
+// NewModelPriceHandler 创建模型价格处理器
+func NewModelPriceHandler(service *service.ModelPriceService) *ModelPriceHandler {
+	return &ModelPriceHandler{service: service}
+}
+
+// ModelPriceRequest 创建价格请求（价格单位 USD / 百万 token）
+type ModelPriceRequest struct {
+	Model           string     `json:"model" binding:"required"`
+	GroupID         *int64     `json:"group_id"`
+	Platform        string     `json:"platform"`
+	InputPrice      float64    `json:"input_price"`
+	OutputPrice     float64    `json:"output_price"`
+	CacheWritePrice float64    `json:"cache_write_price"`
+	CacheReadPrice  float64    `json:"cache_read_price"`
+	ImagePrice      *float64   `json:"image_price"`
+	EffectiveFrom   *time.Time `json:"effective_from"`
+	Enabled         *bool      `json:"enabled"`
+	Description     *string    `json:"description"`
+}
+
+// UpdateModelPriceRequest 更新价格请求（部分更新，所有字段可选）
+type UpdateModelPriceRequest struct {
+	Model           *string    `json:"model"`
+	GroupID         *int64     `json:"group_id"` // 传入 0 表示清除分组限定
+	Platform        *string    `json:"platform"`
+	InputPrice      *float64   `json:"input_price"`
+	OutputPrice     *float64   `json:"output_price"`
+	CacheWritePrice *float64   `json:"cache_write_price"`
+	CacheReadPrice  *float64   `json:"cache_read_price"`
+	ImagePrice      *float64   `json:"image_price"` // 传入负数表示清除图片价格
+	EffectiveFrom   *time.Time `json:"effective_from"`
+	Enabled         *bool      `json:"enabled"`
+	Description     *string    `json:"description"`
+}
+
+// ImportModelPricesRequest 导入价格请求
+type ImportModelPricesRequest struct {
+	Items   []ModelPriceRequest `json:"items" binding:"required,dive"`
+	Replace bool                `json:"replace"` // 是否替换全部现有价格
+}
+
+func (r *ModelPriceRequest) toModel() *model.ModelPrice {
+	price := &model.ModelPrice{
+		Model:           r.Model,
+		GroupID:         r.GroupID,
+		Platform:        r.Platform,
+		InputPrice:      r.InputPrice,
+		OutputPrice:     r.OutputPrice,
+		CacheWritePrice: r.CacheWritePrice,
+		CacheReadPrice:  r.CacheReadPrice,
+		ImagePrice:      r.ImagePrice,
+		Enabled:         true,
+		Description:     r.Description,
+	}
+	if r.EffectiveFrom != nil {
+		price.EffectiveFrom = *r.EffectiveFrom
+	}
+	if r.Enabled != nil {
+		price.Enabled = *r.Enabled
+	}
+	return price
+}
+
+// List 获取所有价格
+// GET /api/v1/admin/model-prices
+func (h *ModelPriceHandler) List(c *gin.Context) {
+	prices, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, prices)
+}
+
+// GetByID 根据 ID 获取价格
+// GET /api/v1/admin/model-prices/:id
+func (h *ModelPriceHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid price ID")
+		return
+	}
+
+	price, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if price == nil {
+		response.NotFound(c, "Model price not found")
+		return
+	}
+
+	response.Success(c, price)
+}
+
+// Create 创建价格
+// POST /api/v1/admin/model-prices
+func (h *ModelPriceHandler) Create(c *gin.Context) {
+	var req ModelPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	price := req.toModel()
+	if price.EffectiveFrom.IsZero() {
+		price.EffectiveFrom = time.Now()
+	}
+
+	created, err := h.service.Create(c.Request.Context(), price)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新价格（支持部分更新）
+// PUT /api/v1/admin/model-prices/:id
+func (h *ModelPriceHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid price ID")
+		return
+	}
+
+	var req UpdateModelPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Model price not found")
+		return
+	}
+
+	price := *existing
+	if req.Model != nil {
+		price.Model = *req.Model
+	}
+	if req.GroupID != nil {
+		if *req.GroupID > 0 {
+			price.GroupID = req.GroupID
+		} else {
+			price.GroupID = nil
+		}
+	}
+	if req.Platform != nil {
+		price.Platform = *req.Platform
+	}
+	if req.InputPrice != nil {
+		price.InputPrice = *req.InputPrice
+	}
+	if req.OutputPrice != nil {
+		price.OutputPrice = *req.OutputPrice
+	}
+	if req.CacheWritePrice != nil {
+		price.CacheWritePrice = *req.CacheWritePrice
+	}
+	if req.CacheReadPrice != nil {
+		price.CacheReadPrice = *req.CacheReadPrice
+	}
+	if req.ImagePrice != nil {
+		if *req.ImagePrice >= 0 {
+			price.ImagePrice = req.ImagePrice
+		} else {
+			price.ImagePrice = nil
+		}
+	}
+	if req.EffectiveFrom != nil {
+		price.EffectiveFrom = *req.EffectiveFrom
+	}
+	if req.Enabled != nil {
+		price.Enabled = *req.Enabled
+	}
+	if req.Description != nil {
+		price.Description = req.Description
+	}
+
+	updated, err := h.service.Update(c.Request.Context(), &price)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除价格
+// DELETE /api/v1/admin/model-prices/:id
+func (h *ModelPriceHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid price ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Model price deleted successfully"})
+}
+
+// Export 导出全部价格（格式与导入请求的 items 一致）
+// GET /api/v1/admin/model-prices/export
+func (h *ModelPriceHandler) Export(c *gin.Context) {
+	prices, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	items := make([]ModelPriceRequest, 0, len(prices))
+	for _, p := range prices {
+		effectiveFrom := p.EffectiveFrom
+		enabled := p.Enabled
+		items = append(items, ModelPriceRequest{
+			Model:           p.Model,
+			GroupID:         p.GroupID,
+			Platform:        p.Platform,
+			InputPrice:      p.InputPrice,
+			OutputPrice:     p.OutputPrice,
+			CacheWritePrice: p.CacheWritePrice,
+			CacheReadPrice:  p.CacheReadPrice,
+			ImagePrice:      p.ImagePrice,
+			EffectiveFrom:   &effectiveFrom,
+			Enabled:         &enabled,
+			Description:     p.Description,
+		})
+	}
+
+	response.Success(c, gin.H{"items": items})
+}
+
+// Import 批量导入价格
+// POST /api/v1/admin/model-prices/import
+func (h *ModelPriceHandler) Import(c *gin.Context) {
+	var req ImportModelPricesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	prices := make([]*model.ModelPrice, 0, len(req.Items))
+	for i := range req.Items {
+		prices = append(prices, req.Items[i].toModel())
+	}
+
+	count, err := h.service.Import(c.Request.Context(), prices, req.Replace)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"imported": count})
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/pkg/oneapi"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// OneAPIImportHandler 处理 one-api / new-api 迁移导入
+type OneAPIImportHandler struct {
+	importService *service.OneAPIImportService
+}
+
+// NewOneAPIImportHandler 创建导入处理器
+func NewOneAPIImportHandler(importService *service.OneAPIImportService) *OneAPIImportHandler {
+	return &OneAPIImportHandler{importService: importService}
+}
+
+// OneAPIImportRequest 导入请求；export 与 database 二选一
+type OneAPIImportRequest struct {
+	Flavor   string       `json:"flavor"`
+	Export   *oneapi.Dump `json:"export"`
+	Database *struct {
+		Driver string `json:"driver" binding:"required,oneof=postgres sqlite"`
+		DSN    string `json:"dsn" binding:"required"`
+	} `json:"database"`
+	QuotaPerUnit    float64        `json:"quota_per_unit" binding:"omitempty,gte=0"`
+	DefaultPlatform string         `json:"default_platform"`
+	EmailDomain     string         `json:"email_domain"`
+	TypeOverrides   map[int]string `json:"type_overrides"`
+	SkipChannels    bool           `json:"skip_channels"`
+	SkipUsers       bool           `json:"skip_users"`
+	SkipTokens      bool           `json:"skip_tokens"`
+	SkipRedemptions bool           `json:"skip_redemptions"`
+}
+
+func (r *OneAPIImportRequest) input() service.OneAPIImportInput {
+	input := service.OneAPIImportInput{
+		Source:          service.OneAPISource{Export: r.Export},
+		Flavor:          r.Flavor,
+		QuotaPerUnit:    r.QuotaPerUnit,
+		DefaultPlatform: r.DefaultPlatform,
+		EmailDomain:     r.EmailDomain,
+		TypeOverrides:   r.TypeOverrides,
+		SkipChannels:    r.SkipChannels,
+		SkipUsers:       r.SkipUsers,
+		SkipTokens:      r.SkipTokens,
+		SkipRedemptions: r.SkipRedemptions,
+	}
+	if r.Database != nil {
+		input.Source.Driver = r.Database.Driver
+		input.Source.DSN = r.Database.DSN
+	}
+	return input
+}
+
+// Preview 预览导入结果，不写入数据
+// POST /api/v1/admin/system/import/oneapi/preview
+func (h *OneAPIImportHandler) Preview(c *gin.Context) {
+	var req OneAPIImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	result, err := h.importService.Preview(c.Request.Context(), req.input())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, result)
+}
+
+// Import 执行导入并返回逐项报告
+// POST /api/v1/admin/system/import/oneapi
+func (h *OneAPIImportHandler) Import(c *gin.Context) {
+	var req OneAPIImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	result, err := h.importService.Import(c.Request.Context(), req.input())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, result)
+}
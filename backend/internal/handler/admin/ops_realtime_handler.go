@@ -248,3 +248,35 @@ func (h *OpsHandler) GetRealtimeTrafficSummary(c *gin.Context) {
 		"timestamp": endTime,
 	})
 }
+
+// GetUsageOutboxStats returns the usage billing outbox backlog and consumer lag.
+// GET /api/v1/admin/ops/usage-outbox
+func (h *OpsHandler) GetUsageOutboxStats(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	stats, err := h.opsService.GetUsageOutboxStats(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, stats)
+}
+
+// GetFairQueueStats returns per-group fair queue depth and estimated wait.
+// GET /api/v1/admin/ops/fair-queue
+func (h *OpsHandler) GetFairQueueStats(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	stats, err := h.opsService.GetFairQueueStats(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, stats)
+}
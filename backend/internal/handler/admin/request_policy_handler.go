@@ -0,0 +1,241 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestPolicyHandler 处理请求参数策略的 HTTP 请求
+type RequestPolicyHandler struct {
+	service *service.RequestPolicyService
+}
+
+// NewRequestPolicyHandler 创建请求参数策略处理器
+func NewRequestPolicyHandler(service *service.RequestPolicyService) *RequestPolicyHandler {
+	return &RequestPolicyHandler{service: service}
+}
+
+// CreateRequestPolicyRequest 创建策略请求
+type CreateRequestPolicyRequest struct {
+	Name          string   `json:"name" binding:"required"`
+	Enabled       *bool    `json:"enabled"`
+	Priority      int      `json:"priority"`
+	GroupIDs      []int64  `json:"group_ids"`
+	Protocols     []string `json:"protocols"`
+	Kind          string   `json:"kind" binding:"required"`
+	Action        string   `json:"action"`
+	DryRun        bool     `json:"dry_run"`
+	LimitValue    *int     `json:"limit_value"`
+	Params        []string `json:"params"`
+	RejectMessage *string  `json:"reject_message"`
+	Description   *string  `json:"description"`
+}
+
+// UpdateRequestPolicyRequest 更新策略请求（部分更新，所有字段可选）
+type UpdateRequestPolicyRequest struct {
+	Name          *string  `json:"name"`
+	Enabled       *bool    `json:"enabled"`
+	Priority      *int     `json:"priority"`
+	GroupIDs      []int64  `json:"group_ids"`
+	Protocols     []string `json:"protocols"`
+	Kind          *string  `json:"kind"`
+	Action        *string  `json:"action"`
+	DryRun        *bool    `json:"dry_run"`
+	LimitValue    *int     `json:"limit_value"`
+	Params        []string `json:"params"`
+	RejectMessage *string  `json:"reject_message"`
+	Description   *string  `json:"description"`
+}
+
+// List 获取所有策略
+// GET /api/v1/admin/request-policies
+func (h *RequestPolicyHandler) List(c *gin.Context) {
+	policies, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, policies)
+}
+
+// GetByID 根据 ID 获取策略
+// GET /api/v1/admin/request-policies/:id
+func (h *RequestPolicyHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid policy ID")
+		return
+	}
+
+	policy, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if policy == nil {
+		response.NotFound(c, "Policy not found")
+		return
+	}
+
+	response.Success(c, policy)
+}
+
+// Create 创建策略
+// POST /api/v1/admin/request-policies
+func (h *RequestPolicyHandler) Create(c *gin.Context) {
+	var req CreateRequestPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	policy := &model.RequestPolicy{
+		Name:          req.Name,
+		Priority:      req.Priority,
+		GroupIDs:      req.GroupIDs,
+		Protocols:     req.Protocols,
+		Kind:          req.Kind,
+		Action:        req.Action,
+		DryRun:        req.DryRun,
+		LimitValue:    req.LimitValue,
+		Params:        req.Params,
+		RejectMessage: req.RejectMessage,
+		Description:   req.Description,
+	}
+
+	// 设置默认值
+	if req.Enabled != nil {
+		policy.Enabled = *req.Enabled
+	} else {
+		policy.Enabled = true
+	}
+	if policy.Action == "" {
+		policy.Action = model.PolicyActionModify
+	}
+	normalizeRequestPolicySlices(policy)
+
+	created, err := h.service.Create(c.Request.Context(), policy)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新策略（支持部分更新）
+// PUT /api/v1/admin/request-policies/:id
+func (h *RequestPolicyHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid policy ID")
+		return
+	}
+
+	var req UpdateRequestPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Policy not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	policy := *existing
+	policy.ID = id
+	if req.Name != nil {
+		policy.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		policy.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		policy.Priority = *req.Priority
+	}
+	if req.GroupIDs != nil {
+		policy.GroupIDs = req.GroupIDs
+	}
+	if req.Protocols != nil {
+		policy.Protocols = req.Protocols
+	}
+	if req.Kind != nil {
+		policy.Kind = *req.Kind
+	}
+	if req.Action != nil {
+		policy.Action = *req.Action
+	}
+	if req.DryRun != nil {
+		policy.DryRun = *req.DryRun
+	}
+	if req.LimitValue != nil {
+		policy.LimitValue = req.LimitValue
+	}
+	if req.Params != nil {
+		policy.Params = req.Params
+	}
+	if req.RejectMessage != nil {
+		policy.RejectMessage = req.RejectMessage
+	}
+	if req.Description != nil {
+		policy.Description = req.Description
+	}
+	normalizeRequestPolicySlices(&policy)
+
+	updated, err := h.service.Update(c.Request.Context(), &policy)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除策略
+// DELETE /api/v1/admin/request-policies/:id
+func (h *RequestPolicyHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid policy ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Policy deleted successfully"})
+}
+
+// normalizeRequestPolicySlices 确保切片不为 nil
+func normalizeRequestPolicySlices(policy *model.RequestPolicy) {
+	if policy.GroupIDs == nil {
+		policy.GroupIDs = []int64{}
+	}
+	if policy.Protocols == nil {
+		policy.Protocols = []string{}
+	}
+	if policy.Params == nil {
+		policy.Params = []string{}
+	}
+}
@@ -0,0 +1,245 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// RoutingRuleHandler 处理请求路由规则的 HTTP 请求
+type RoutingRuleHandler struct {
+	service *service.RoutingRuleService
+}
+
+// NewRoutingRuleHandler 创建请求路由规则处理器
+func NewRoutingRuleHandler(service *service.RoutingRuleService) *RoutingRuleHandler {
+	return &RoutingRuleHandler{service: service}
+}
+
+// CreateRoutingRuleRequest 创建规则请求
+type CreateRoutingRuleRequest struct {
+	Name             string   `json:"name" binding:"required"`
+	Enabled          *bool    `json:"enabled"`
+	Priority         int      `json:"priority"`
+	GroupIDs         []int64  `json:"group_ids"`
+	ModelPatterns    []string `json:"model_patterns"`
+	MaxTokensBelow   *int     `json:"max_tokens_below"`
+	RequireNoTools   bool     `json:"require_no_tools"`
+	InputTokensBelow *int     `json:"input_tokens_below"`
+	TargetGroupID    *int64   `json:"target_group_id"`
+	TargetAccountIDs []int64  `json:"target_account_ids"`
+	Description      *string  `json:"description"`
+}
+
+// UpdateRoutingRuleRequest 更新规则请求（部分更新，所有字段可选）
+type UpdateRoutingRuleRequest struct {
+	Name             *string  `json:"name"`
+	Enabled          *bool    `json:"enabled"`
+	Priority         *int     `json:"priority"`
+	GroupIDs         []int64  `json:"group_ids"`
+	ModelPatterns    []string `json:"model_patterns"`
+	MaxTokensBelow   *int     `json:"max_tokens_below"`
+	RequireNoTools   *bool    `json:"require_no_tools"`
+	InputTokensBelow *int     `json:"input_tokens_below"`
+	TargetGroupID    *int64   `json:"target_group_id"`
+	TargetAccountIDs []int64  `json:"target_account_ids"`
+	Description      *string  `json:"description"`
+}
+
+// List 获取所有规则
+// GET /api/v1/admin/routing-rules
+func (h *RoutingRuleHandler) List(c *gin.Context) {
+	rules, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, rules)
+}
+
+// GetByID 根据 ID 获取规则
+// GET /api/v1/admin/routing-rules/:id
+func (h *RoutingRuleHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if rule == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Create 创建规则
+// POST /api/v1/admin/routing-rules
+func (h *RoutingRuleHandler) Create(c *gin.Context) {
+	var req CreateRoutingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	rule := &model.RoutingRule{
+		Name:             req.Name,
+		Priority:         req.Priority,
+		GroupIDs:         req.GroupIDs,
+		ModelPatterns:    req.ModelPatterns,
+		MaxTokensBelow:   req.MaxTokensBelow,
+		RequireNoTools:   req.RequireNoTools,
+		InputTokensBelow: req.InputTokensBelow,
+		TargetGroupID:    req.TargetGroupID,
+		TargetAccountIDs: req.TargetAccountIDs,
+		Description:      req.Description,
+	}
+
+	// 设置默认值
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	} else {
+		rule.Enabled = true
+	}
+	normalizeRoutingRuleSlices(rule)
+
+	created, err := h.service.Create(c.Request.Context(), rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新规则（支持部分更新）
+// PUT /api/v1/admin/routing-rules/:id
+func (h *RoutingRuleHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	var req UpdateRoutingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	rule := *existing
+	rule.ID = id
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.GroupIDs != nil {
+		rule.GroupIDs = req.GroupIDs
+	}
+	if req.ModelPatterns != nil {
+		rule.ModelPatterns = req.ModelPatterns
+	}
+	// 数值条件与目标分组传 0 表示清除
+	if req.MaxTokensBelow != nil {
+		if *req.MaxTokensBelow > 0 {
+			rule.MaxTokensBelow = req.MaxTokensBelow
+		} else {
+			rule.MaxTokensBelow = nil
+		}
+	}
+	if req.RequireNoTools != nil {
+		rule.RequireNoTools = *req.RequireNoTools
+	}
+	if req.InputTokensBelow != nil {
+		if *req.InputTokensBelow > 0 {
+			rule.InputTokensBelow = req.InputTokensBelow
+		} else {
+			rule.InputTokensBelow = nil
+		}
+	}
+	if req.TargetGroupID != nil {
+		if *req.TargetGroupID > 0 {
+			rule.TargetGroupID = req.TargetGroupID
+		} else {
+			rule.TargetGroupID = nil
+		}
+	}
+	if req.TargetAccountIDs != nil {
+		rule.TargetAccountIDs = req.TargetAccountIDs
+	}
+	if req.Description != nil {
+		rule.Description = req.Description
+	}
+	normalizeRoutingRuleSlices(&rule)
+
+	updated, err := h.service.Update(c.Request.Context(), &rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除规则
+// DELETE /api/v1/admin/routing-rules/:id
+func (h *RoutingRuleHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Rule deleted successfully"})
+}
+
+// normalizeRoutingRuleSlices 确保切片不为 nil
+func normalizeRoutingRuleSlices(rule *model.RoutingRule) {
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.ModelPatterns == nil {
+		rule.ModelPatterns = []string{}
+	}
+	if rule.TargetAccountIDs == nil {
+		rule.TargetAccountIDs = []int64{}
+	}
+}
@@ -39,6 +39,7 @@ type CreateUserRequest struct {
 	Notes         string  `json:"notes"`
 	Balance       float64 `json:"balance"`
 	Concurrency   int     `json:"concurrency"`
+	QueueWeight   int     `json:"queue_weight" binding:"omitempty,min=1,max=100"`
 	AllowedGroups []int64 `json:"allowed_groups"`
 }
 
@@ -51,6 +52,7 @@ type UpdateUserRequest struct {
 	Notes         *string  `json:"notes"`
 	Balance       *float64 `json:"balance"`
 	Concurrency   *int     `json:"concurrency"`
+	QueueWeight   *int     `json:"queue_weight" binding:"omitempty,min=1,max=100"`
 	Status        string   `json:"status" binding:"omitempty,oneof=active disabled"`
 	AllowedGroups *[]int64 `json:"allowed_groups"`
 	// GroupRates 用户专属分组倍率配置
@@ -179,6 +181,7 @@ func (h *UserHandler) Create(c *gin.Context) {
 		Notes:         req.Notes,
 		Balance:       req.Balance,
 		Concurrency:   req.Concurrency,
+		QueueWeight:   req.QueueWeight,
 		AllowedGroups: req.AllowedGroups,
 	})
 	if err != nil {
@@ -212,6 +215,7 @@ func (h *UserHandler) Update(c *gin.Context) {
 		Notes:         req.Notes,
 		Balance:       req.Balance,
 		Concurrency:   req.Concurrency,
+		QueueWeight:   req.QueueWeight,
 		Status:        req.Status,
 		AllowedGroups: req.AllowedGroups,
 		GroupRates:    req.GroupRates,
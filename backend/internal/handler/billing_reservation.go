@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"github.com/tidwall/gjson"
+)
+
+// maxOutputTokenPaths 各协议声明最大输出 token 数的字段
+var maxOutputTokenPaths = []string{
+	"max_tokens",
+	"max_completion_tokens",
+	"max_output_tokens",
+	"generationConfig.maxOutputTokens",
+}
+
+// requestMaxOutputTokens 从请求体中提取客户端声明的最大输出 token 数，未声明返回 0
+func requestMaxOutputTokens(body []byte) int {
+	for _, path := range maxOutputTokenPaths {
+		if v := gjson.GetBytes(body, path); v.Exists() && v.Int() > 0 {
+			return int(v.Int())
+		}
+	}
+	return 0
+}
@@ -0,0 +1,18 @@
+//go:build unit
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestMaxOutputTokens(t *testing.T) {
+	require.Equal(t, 1024, requestMaxOutputTokens([]byte(`{"model":"claude-opus-4-5","max_tokens":1024}`)))
+	require.Equal(t, 2048, requestMaxOutputTokens([]byte(`{"model":"gpt-4o","max_completion_tokens":2048}`)))
+	require.Equal(t, 512, requestMaxOutputTokens([]byte(`{"model":"gpt-5","max_output_tokens":512}`)))
+	require.Equal(t, 256, requestMaxOutputTokens([]byte(`{"generationConfig":{"maxOutputTokens":256}}`)))
+	require.Zero(t, requestMaxOutputTokens([]byte(`{"model":"gpt-4o","max_tokens":0}`)))
+	require.Zero(t, requestMaxOutputTokens([]byte(`{}`)))
+}
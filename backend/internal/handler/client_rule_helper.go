@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// applyClientRules 检查请求客户端是否满足分组准入规则
+//
+// 满足规则时原样返回 apiKey；转入降级分组时返回绑定降级分组的 apiKey 副本并返回 true，
+// 同时把降级分组写入请求上下文，调度策略与首字节超时按降级分组生效；
+// 调用方需按非订阅请求处理（降级分组不允许为订阅分组）；
+// 不满足且没有可用降级分组时返回 *service.ClientRuleRejectedError。
+func applyClientRules(c *gin.Context, svc *service.ClientRuleService, apiKey *service.APIKey, body []byte) (*service.APIKey, bool, error) {
+	if svc == nil || apiKey == nil || apiKey.Group == nil {
+		return apiKey, false, nil
+	}
+	// 与 claude_code_only 一致：强制平台路由不检查客户端限制
+	if middleware2.HasForcePlatform(c) {
+		return apiKey, false, nil
+	}
+	fallback, err := svc.Check(c.Request.Context(), apiKey.Group, c.Request, body)
+	if err != nil {
+		return apiKey, false, err
+	}
+	if fallback == nil {
+		return apiKey, false, nil
+	}
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.Group, fallback))
+	return cloneAPIKeyWithGroup(apiKey, fallback), true, nil
+}
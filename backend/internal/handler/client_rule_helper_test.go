@@ -0,0 +1,79 @@
+//go:build unit
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type clientRuleHelperRepoStub struct {
+	service.ClientRuleRepository
+	rules []*model.ClientRule
+}
+
+func (r *clientRuleHelperRepoStub) List(context.Context) ([]*model.ClientRule, error) {
+	return r.rules, nil
+}
+
+type clientRuleHelperGroupRepoStub struct {
+	service.GroupRepository
+	groups map[int64]*service.Group
+}
+
+func (r *clientRuleHelperGroupRepoStub) GetByIDLite(_ context.Context, id int64) (*service.Group, error) {
+	if g, ok := r.groups[id]; ok {
+		return g, nil
+	}
+	return nil, service.ErrGroupNotFound
+}
+
+// TestApplyClientRules_FallbackGroupInContext 验证转入降级分组后请求上下文中的分组同步切换，
+// 调度策略与首字节预算按降级分组生效
+func TestApplyClientRules_FallbackGroupInContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	fallbackID := int64(9)
+	uaPattern := `^my-agent/`
+	fallback := &service.Group{
+		ID: fallbackID, Platform: service.PlatformAnthropic, Status: service.StatusActive, Hydrated: true,
+		SubscriptionType: service.SubscriptionTypeStandard, SchedulingStrategy: "round_robin", FirstByteTimeoutSeconds: 5,
+	}
+	svc := service.NewClientRuleService(
+		&clientRuleHelperRepoStub{rules: []*model.ClientRule{{
+			ID: 1, Name: "my agent", Enabled: true, UserAgentPattern: &uaPattern, GroupIDs: []int64{1}, FallbackGroupID: &fallbackID,
+		}}},
+		nil,
+		&clientRuleHelperGroupRepoStub{groups: map[int64]*service.Group{fallbackID: fallback}},
+	)
+	original := &service.Group{ID: 1, Platform: service.PlatformAnthropic, Status: service.StatusActive, Hydrated: true}
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader("{}"))
+	c.Request.Header.Set("User-Agent", "curl/8")
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.Group, original))
+
+	apiKey, fellBack, err := applyClientRules(c, svc, &service.APIKey{ID: 1, GroupID: &original.ID, Group: original}, []byte(`{}`))
+	require.NoError(t, err)
+	require.True(t, fellBack)
+	require.Equal(t, fallbackID, apiKey.Group.ID)
+	require.Same(t, apiKey.Group, c.Request.Context().Value(ctxkey.Group))
+	require.Equal(t, *fallback, *apiKey.Group)
+
+	// 满足规则时保持原分组上下文
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.Group, original))
+	c.Request.Header.Set("User-Agent", "my-agent/1.0")
+	_, fellBack, err = applyClientRules(c, svc, &service.APIKey{ID: 1, GroupID: &original.ID, Group: original}, []byte(`{}`))
+	require.NoError(t, err)
+	require.False(t, fellBack)
+	require.Same(t, original, c.Request.Context().Value(ctxkey.Group))
+}
@@ -0,0 +1,482 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// CompatibleGatewayHandler handles OpenAI Chat Completions requests for compatible-platform groups
+type CompatibleGatewayHandler struct {
+	gatewayService           *service.GatewayService
+	compatibleGatewayService *service.CompatibleGatewayService
+	billingCacheService      *service.BillingCacheService
+	apiKeyService            *service.APIKeyService
+	errorPassthroughService  *service.ErrorPassthroughService
+	requestPolicyService     *service.RequestPolicyService
+	guardrailService         *service.GuardrailService
+	clientRuleService        *service.ClientRuleService
+	routingRuleService       *service.RoutingRuleService
+	concurrencyHelper        *ConcurrencyHelper
+	maxAccountSwitches       int
+}
+
+// NewCompatibleGatewayHandler creates a new CompatibleGatewayHandler
+func NewCompatibleGatewayHandler(
+	gatewayService *service.GatewayService,
+	compatibleGatewayService *service.CompatibleGatewayService,
+	concurrencyService *service.ConcurrencyService,
+	fairQueueService *service.FairQueueService,
+	billingCacheService *service.BillingCacheService,
+	apiKeyService *service.APIKeyService,
+	errorPassthroughService *service.ErrorPassthroughService,
+	requestPolicyService *service.RequestPolicyService,
+	guardrailService *service.GuardrailService,
+	clientRuleService *service.ClientRuleService,
+	routingRuleService *service.RoutingRuleService,
+	cfg *config.Config,
+) *CompatibleGatewayHandler {
+	pingInterval := time.Duration(0)
+	maxAccountSwitches := 3
+	if cfg != nil {
+		pingInterval = time.Duration(cfg.Concurrency.PingInterval) * time.Second
+		if cfg.Gateway.MaxAccountSwitches > 0 {
+			maxAccountSwitches = cfg.Gateway.MaxAccountSwitches
+		}
+	}
+	return &CompatibleGatewayHandler{
+		gatewayService:           gatewayService,
+		compatibleGatewayService: compatibleGatewayService,
+		billingCacheService:      billingCacheService,
+		apiKeyService:            apiKeyService,
+		errorPassthroughService:  errorPassthroughService,
+		requestPolicyService:     requestPolicyService,
+		guardrailService:         guardrailService,
+		clientRuleService:        clientRuleService,
+		routingRuleService:       routingRuleService,
+		concurrencyHelper:        NewConcurrencyHelper(concurrencyService, fairQueueService, SSEPingFormatComment, pingInterval),
+		maxAccountSwitches:       maxAccountSwitches,
+	}
+}
+
+// ChatCompletions handles OpenAI Chat Completions API endpoint
+// POST /v1/chat/completions
+func (h *CompatibleGatewayHandler) ChatCompletions(c *gin.Context) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		h.errorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		h.errorResponse(c, http.StatusInternalServerError, "api_error", "User context not found")
+		return
+	}
+
+	// 仅 compatible 平台分组可使用 Chat Completions 入口
+	if apiKey.Group == nil || apiKey.Group.Platform != service.PlatformCompatible {
+		h.errorResponse(c, http.StatusNotFound, "not_found_error", "Chat Completions API is only available for compatible platform groups")
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			h.errorResponse(c, http.StatusRequestEntityTooLarge, "invalid_request_error", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to read request body")
+		return
+	}
+	if len(body) == 0 {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Request body is empty")
+		return
+	}
+	if !gjson.ValidBytes(body) {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+		return
+	}
+
+	// 执行分组请求参数策略（可能改写请求体或直接拒绝）
+	policyBody, _, err := applyRequestPolicies(c, h.requestPolicyService, apiKey, model.RequestProtocolOpenAI, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	body = policyBody
+
+	// 执行分组内容护栏（可能脱敏请求文本或直接拦截）
+	body, _, err = applyGuardrails(c, h.guardrailService, apiKey, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
+	// 检查分组客户端准入规则（不满足时可能转入降级分组或直接拒绝）
+	originalGroupID := groupIDOf(apiKey)
+	var clientFallback bool
+	apiKey, clientFallback, err = applyClientRules(c, h.clientRuleService, apiKey, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", err.Error())
+		return
+	}
+	// 按分组路由规则将廉价/后台请求转入其他分组或账号子集
+	apiKey = applyRoutingRules(c, h.routingRuleService, apiKey, body)
+	// 转入其他分组后按新分组再执行策略与护栏
+	body, _, err = applyTargetGroupRules(c, h.requestPolicyService, h.guardrailService, originalGroupID, apiKey, model.RequestProtocolOpenAI, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	// 估算输入长度，调度时优先选择上下文容量足够的账号
+	c.Request = c.Request.WithContext(service.WithEstimatedInputTokens(c.Request.Context(), body))
+
+	reqModel := gjson.GetBytes(body, "model").String()
+	reqStream := gjson.GetBytes(body, "stream").Bool()
+	if reqModel == "" {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "model is required")
+		return
+	}
+
+	setOpsRequestContext(c, reqModel, reqStream, body)
+
+	// Track if we've started streaming (for error handling)
+	streamStarted := false
+
+	// 绑定错误透传服务，允许 service 层在非 failover 错误场景复用规则。
+	if h.errorPassthroughService != nil {
+		service.BindErrorPassthroughService(c, h.errorPassthroughService)
+	}
+
+	subscription, _ := middleware2.GetSubscriptionFromContext(c)
+	// 降级分组按非订阅请求处理（路由规则不会把订阅分组的请求转入其他分组）
+	if clientFallback {
+		subscription = nil
+	}
+
+	// 0. Check if wait queue is full
+	maxWait := service.CalculateMaxWait(subject.Concurrency)
+	canWait, err := h.concurrencyHelper.IncrementWaitCount(c.Request.Context(), subject.UserID, maxWait)
+	waitCounted := false
+	if err != nil {
+		log.Printf("Increment wait count failed: %v", err)
+	} else if !canWait {
+		h.errorResponse(c, http.StatusTooManyRequests, "rate_limit_error", "Too many pending requests, please retry later")
+		return
+	}
+	if err == nil && canWait {
+		waitCounted = true
+	}
+	defer func() {
+		if waitCounted {
+			h.concurrencyHelper.DecrementWaitCount(c.Request.Context(), subject.UserID)
+		}
+	}()
+
+	// 1. First acquire user concurrency slot
+	userReleaseFunc, err := h.concurrencyHelper.AcquireUserSlotWithWait(c, subject.UserID, subject.Concurrency, reqStream, &streamStarted)
+	if err != nil {
+		log.Printf("User concurrency acquire failed: %v", err)
+		h.handleStreamingAwareError(c, http.StatusTooManyRequests, "rate_limit_error", "Concurrency limit exceeded for user, please retry later", streamStarted)
+		return
+	}
+	if waitCounted {
+		h.concurrencyHelper.DecrementWaitCount(c.Request.Context(), subject.UserID)
+		waitCounted = false
+	}
+	userReleaseFunc = wrapReleaseOnDone(c.Request.Context(), userReleaseFunc)
+	if userReleaseFunc != nil {
+		defer userReleaseFunc()
+	}
+
+	// 2. Re-check billing eligibility after wait
+	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
+		log.Printf("Billing eligibility check failed after wait: %v", err)
+		status, code, message := billingErrorDetails(err)
+		h.handleStreamingAwareError(c, status, code, message, streamStarted)
+		return
+	}
+
+	// 3. 预留本次请求的最大费用，避免并发请求透支余额/订阅额度
+	reservationInput := service.CostReservationInput{
+		User:         apiKey.User,
+		Group:        apiKey.Group,
+		Subscription: subscription,
+		Model:        reqModel,
+		BodySize:     len(body),
+		MaxTokens:    requestMaxOutputTokens(body),
+	}
+	reservation, err := h.billingCacheService.ReserveCost(c.Request.Context(), reservationInput)
+	if err != nil {
+		log.Printf("Billing cost reservation failed: %v", err)
+		status, code, message := billingErrorDetails(err)
+		h.handleStreamingAwareError(c, status, code, message, streamStarted)
+		return
+	}
+	// 模型降级会替换预留，释放时以最新的预留为准
+	defer func() { reservation.Release() }()
+
+	switchCount := 0
+	failedAccountIDs := make(map[int64]struct{})
+	var lastFailoverErr *service.UpstreamFailoverError
+
+	// 分组模型降级链：切换模型后重置账号排除列表，重新调度
+	requestedModel := reqModel
+	modelFallback := service.NewModelFallbackChain(apiKey.Group, reqModel)
+	switchModel := func(trigger string) bool {
+		nextModel, ok := modelFallback.Next(trigger)
+		if !ok {
+			return false
+		}
+		newBody, err := sjson.SetBytes(body, "model", nextModel)
+		if err != nil {
+			log.Printf("[Compatible Handler] Model fallback rewrite failed: model=%s err=%v", nextModel, err)
+			return false
+		}
+		// 按降级后的请求重新估算预留；额度不足以覆盖新模型时不降级
+		nextInput := reservationInput
+		nextInput.Model = nextModel
+		nextInput.BodySize = len(newBody)
+		nextInput.MaxTokens = requestMaxOutputTokens(newBody)
+		nextReservation, err := h.billingCacheService.ReestimateCost(c.Request.Context(), reservation, nextInput)
+		reservation = nextReservation
+		if err != nil {
+			log.Printf("[Compatible Handler] Model fallback reservation failed: model=%s err=%v", nextModel, err)
+			return false
+		}
+		reservationInput = nextInput
+		log.Printf("[Compatible Handler] Model fallback: group=%d trigger=%s %s -> %s", apiKey.Group.ID, trigger, reqModel, nextModel)
+		body = newBody
+		reqModel = nextModel
+		setOpsRequestContext(c, reqModel, reqStream, body)
+		c.Header(servedModelHeader, reqModel)
+		switchCount = 0
+		failedAccountIDs = make(map[int64]struct{})
+		lastFailoverErr = nil
+		return true
+	}
+
+	for {
+		selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), apiKey.GroupID, "", reqModel, failedAccountIDs, "")
+		if err != nil {
+			log.Printf("[Compatible Handler] SelectAccount failed: %v", err)
+			var contextErr *service.ContextTooLongError
+			if errors.As(err, &contextErr) {
+				h.handleStreamingAwareError(c, http.StatusBadRequest, "invalid_request_error", contextErr.Error(), streamStarted)
+				return
+			}
+			if len(failedAccountIDs) == 0 {
+				if switchModel(service.ModelFallbackTriggerNoAccount) {
+					continue
+				}
+				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts: "+err.Error(), streamStarted)
+				return
+			}
+			if lastFailoverErr != nil && switchModel(service.ModelFallbackTriggerForStatus(lastFailoverErr.StatusCode)) {
+				continue
+			}
+			h.handleFailoverExhausted(c, lastFailoverErr, streamStarted)
+			return
+		}
+		account := selection.Account
+		setOpsSelectedAccount(c, account.ID)
+
+		// 3. Acquire account concurrency slot
+		accountReleaseFunc := selection.ReleaseFunc
+		if !selection.Acquired {
+			if selection.WaitPlan == nil {
+				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts", streamStarted)
+				return
+			}
+			accountWaitCounted := false
+			canWait, err := h.concurrencyHelper.IncrementAccountWaitCount(c.Request.Context(), account.ID, selection.WaitPlan.MaxWaiting)
+			if err != nil {
+				log.Printf("Increment account wait count failed: %v", err)
+			} else if !canWait {
+				log.Printf("Account wait queue full: account=%d", account.ID)
+				h.handleStreamingAwareError(c, http.StatusTooManyRequests, "rate_limit_error", "Too many pending requests, please retry later", streamStarted)
+				return
+			}
+			if err == nil && canWait {
+				accountWaitCounted = true
+			}
+			defer func() {
+				if accountWaitCounted {
+					h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
+				}
+			}()
+
+			accountReleaseFunc, err = h.concurrencyHelper.AcquireAccountSlotWithWaitTimeout(
+				c,
+				account.ID,
+				selection.WaitPlan.MaxConcurrency,
+				selection.WaitPlan.Timeout,
+				reqStream,
+				&streamStarted,
+			)
+			if err != nil {
+				log.Printf("Account concurrency acquire failed: %v", err)
+				h.handleStreamingAwareError(c, http.StatusTooManyRequests, "rate_limit_error", "Concurrency limit exceeded for account, please retry later", streamStarted)
+				return
+			}
+			if accountWaitCounted {
+				h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
+				accountWaitCounted = false
+			}
+		}
+		accountReleaseFunc = wrapReleaseOnDone(c.Request.Context(), accountReleaseFunc)
+
+		requestCtx := c.Request.Context()
+		if modelFallback.CanFallback(service.ModelFallbackTriggerContextTooLong) {
+			requestCtx = context.WithValue(requestCtx, ctxkey.ModelFallbackOnContextTooLong, true)
+		}
+		result, err := h.compatibleGatewayService.ForwardChatCompletions(requestCtx, c, account, body)
+		if accountReleaseFunc != nil {
+			accountReleaseFunc()
+		}
+		if err != nil {
+			var promptTooLongErr *service.PromptTooLongError
+			if errors.As(err, &promptTooLongErr) {
+				if switchModel(service.ModelFallbackTriggerContextTooLong) {
+					continue
+				}
+				h.handleStreamingAwareError(c, promptTooLongErr.StatusCode, "invalid_request_error", service.ExtractUpstreamErrorMessage(promptTooLongErr.Body), streamStarted)
+				return
+			}
+			var failoverErr *service.UpstreamFailoverError
+			if errors.As(err, &failoverErr) {
+				failedAccountIDs[account.ID] = struct{}{}
+				lastFailoverErr = failoverErr
+				if switchCount >= h.maxAccountSwitches {
+					if switchModel(service.ModelFallbackTriggerForStatus(failoverErr.StatusCode)) {
+						continue
+					}
+					h.handleFailoverExhausted(c, failoverErr, streamStarted)
+					return
+				}
+				switchCount++
+				log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, h.maxAccountSwitches)
+				continue
+			}
+			// Error response already handled in Forward, just log
+			log.Printf("Account %d: Forward request failed: %v", account.ID, err)
+			return
+		}
+
+		userAgent := c.GetHeader("User-Agent")
+		clientIP := ip.GetClientIP(c)
+		// 保留 trace 信息但不随请求取消，使用量记录 span 归属同一条链路
+		usageCtx := context.WithoutCancel(c.Request.Context())
+
+		settleReservation := reservation.Handoff()
+		go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string) {
+			defer settleReservation()
+			ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
+			defer cancel()
+			if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
+				Result:         result,
+				APIKey:         apiKey,
+				User:           apiKey.User,
+				Account:        usedAccount,
+				Subscription:   subscription,
+				UserAgent:      ua,
+				IPAddress:      clientIP,
+				APIKeyService:  h.apiKeyService,
+				RequestedModel: requestedModel,
+				Reservation:    reservation,
+			}); err != nil {
+				log.Printf("Record usage failed: %v", err)
+			}
+		}(result, account, userAgent, clientIP)
+		return
+	}
+}
+
+func (h *CompatibleGatewayHandler) handleFailoverExhausted(c *gin.Context, failoverErr *service.UpstreamFailoverError, streamStarted bool) {
+	statusCode := http.StatusBadGateway
+	var responseBody []byte
+	if failoverErr != nil {
+		statusCode = failoverErr.StatusCode
+		responseBody = failoverErr.ResponseBody
+	}
+
+	if h.errorPassthroughService != nil && len(responseBody) > 0 {
+		if rule := h.errorPassthroughService.MatchRule(service.PlatformCompatible, statusCode, responseBody); rule != nil {
+			respCode := statusCode
+			if !rule.PassthroughCode && rule.ResponseCode != nil {
+				respCode = *rule.ResponseCode
+			}
+			msg := service.ExtractUpstreamErrorMessage(responseBody)
+			if !rule.PassthroughBody && rule.CustomMessage != nil {
+				msg = *rule.CustomMessage
+			}
+			if rule.SkipMonitoring {
+				c.Set(service.OpsSkipPassthroughKey, true)
+			}
+			h.handleStreamingAwareError(c, respCode, "upstream_error", msg, streamStarted)
+			return
+		}
+	}
+
+	status, errType, errMsg := h.mapUpstreamError(statusCode)
+	h.handleStreamingAwareError(c, status, errType, errMsg, streamStarted)
+}
+
+func (h *CompatibleGatewayHandler) mapUpstreamError(statusCode int) (int, string, string) {
+	switch statusCode {
+	case 401:
+		return http.StatusBadGateway, "upstream_error", "Upstream authentication failed, please contact administrator"
+	case 402:
+		return http.StatusBadGateway, "upstream_error", "Upstream payment required: insufficient balance or billing issue"
+	case 403:
+		return http.StatusBadGateway, "upstream_error", "Upstream access forbidden, please contact administrator"
+	case 429:
+		return http.StatusTooManyRequests, "rate_limit_error", "Upstream rate limit exceeded, please retry later"
+	case 529:
+		return http.StatusServiceUnavailable, "upstream_error", "Upstream service overloaded, please retry later"
+	default:
+		return http.StatusBadGateway, "upstream_error", "Upstream request failed"
+	}
+}
+
+// handleStreamingAwareError handles errors that may occur after streaming has started
+func (h *CompatibleGatewayHandler) handleStreamingAwareError(c *gin.Context, status int, errType, message string, streamStarted bool) {
+	if streamStarted {
+		flusher, ok := c.Writer.(http.Flusher)
+		if ok {
+			errorEvent := fmt.Sprintf(`event: error`+"\n"+`data: {"error": {"type": "%s", "message": "%s"}}`+"\n\n", errType, message)
+			if _, err := fmt.Fprint(c.Writer, errorEvent); err != nil {
+				_ = c.Error(err)
+			}
+			flusher.Flush()
+		}
+		return
+	}
+	h.errorResponse(c, status, errType, message)
+}
+
+// errorResponse returns OpenAI API format error response
+func (h *CompatibleGatewayHandler) errorResponse(c *gin.Context, status int, errType, message string) {
+	c.JSON(status, gin.H{
+		"error": gin.H{
+			"type":    errType,
+			"message": message,
+		},
+	})
+}
@@ -58,9 +58,10 @@ func UserFromServiceAdmin(u *service.User) *AdminUser {
 		return nil
 	}
 	return &AdminUser{
-		User:       *base,
-		Notes:      u.Notes,
-		GroupRates: u.GroupRates,
+		User:        *base,
+		Notes:       u.Notes,
+		QueueWeight: u.QueueWeight,
+		GroupRates:  u.GroupRates,
 	}
 }
 
@@ -109,13 +110,16 @@ func GroupFromServiceAdmin(g *service.Group) *AdminGroup {
 		return nil
 	}
 	out := &AdminGroup{
-		Group:                groupFromServiceBase(g),
-		ModelRouting:         g.ModelRouting,
-		ModelRoutingEnabled:  g.ModelRoutingEnabled,
-		MCPXMLInject:         g.MCPXMLInject,
-		SupportedModelScopes: g.SupportedModelScopes,
-		AccountCount:         g.AccountCount,
-		SortOrder:            g.SortOrder,
+		Group:                   groupFromServiceBase(g),
+		ModelRouting:            g.ModelRouting,
+		ModelRoutingEnabled:     g.ModelRoutingEnabled,
+		MCPXMLInject:            g.MCPXMLInject,
+		SupportedModelScopes:    g.SupportedModelScopes,
+		ModelFallbacks:          g.ModelFallbacks,
+		SchedulingStrategy:      g.SchedulingStrategy,
+		FirstByteTimeoutSeconds: g.FirstByteTimeoutSeconds,
+		AccountCount:            g.AccountCount,
+		SortOrder:               g.SortOrder,
 	}
 	if len(g.AccountGroups) > 0 {
 		out.AccountGroups = make([]AccountGroup, 0, len(g.AccountGroups))
@@ -147,6 +151,7 @@ func groupFromServiceBase(g *service.Group) Group {
 		FallbackGroupID:  g.FallbackGroupID,
 		// 无效请求兜底分组
 		FallbackGroupIDOnInvalidRequest: g.FallbackGroupIDOnInvalidRequest,
+		ModelRateMultipliers:            g.ModelRateMultipliers,
 		CreatedAt:                       g.CreatedAt,
 		UpdatedAt:                       g.UpdatedAt,
 	}
@@ -376,6 +381,7 @@ func usageLogFromServiceUser(l *service.UsageLog) UsageLog {
 		RequestID:             l.RequestID,
 		Model:                 l.Model,
 		ReasoningEffort:       l.ReasoningEffort,
+		RequestedModel:        l.RequestedModel,
 		GroupID:               l.GroupID,
 		SubscriptionID:        l.SubscriptionID,
 		InputTokens:           l.InputTokens,
@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
 
 type User struct {
 	ID            int64     `json:"id"`
@@ -24,6 +28,8 @@ type AdminUser struct {
 	User
 
 	Notes string `json:"notes"`
+	// QueueWeight 账号饱和时分组公平队列中的权重
+	QueueWeight int `json:"queue_weight"`
 	// GroupRates 用户专属分组倍率配置
 	// map[groupID]rateMultiplier
 	GroupRates map[int64]float64 `json:"group_rates,omitempty"`
@@ -73,6 +79,9 @@ type Group struct {
 	// 无效请求兜底分组
 	FallbackGroupIDOnInvalidRequest *int64 `json:"fallback_group_id_on_invalid_request"`
 
+	// 模型倍率：在分组倍率之上按模型叠加
+	ModelRateMultipliers map[string]float64 `json:"model_rate_multipliers,omitempty"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -94,6 +103,14 @@ type AdminGroup struct {
 	AccountGroups        []AccountGroup `json:"account_groups,omitempty"`
 	AccountCount         int64          `json:"account_count,omitempty"`
 
+	// 模型降级链
+	ModelFallbacks []service.ModelFallbackRule `json:"model_fallbacks"`
+
+	// 账号调度策略，空表示使用全局默认
+	SchedulingStrategy string `json:"scheduling_strategy"`
+	// 流式首字节预算（秒），0 表示使用全局配置
+	FirstByteTimeoutSeconds int `json:"first_byte_timeout_seconds"`
+
 	// 分组排序
 	SortOrder int `json:"sort_order"`
 }
@@ -241,6 +258,8 @@ type UsageLog struct {
 	// ReasoningEffort is the request's reasoning effort level (OpenAI Responses API).
 	// nil means not provided / not applicable.
 	ReasoningEffort *string `json:"reasoning_effort,omitempty"`
+	// RequestedModel 触发模型降级时客户端原始请求的模型（Model 为实际服务的模型）
+	RequestedModel *string `json:"requested_model,omitempty"`
 
 	GroupID        *int64 `json:"group_id"`
 	SubscriptionID *int64 `json:"subscription_id"`
@@ -15,6 +15,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/antigravity"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
@@ -32,11 +33,16 @@ type GatewayHandler struct {
 	gatewayService            *service.GatewayService
 	geminiCompatService       *service.GeminiMessagesCompatService
 	antigravityGatewayService *service.AntigravityGatewayService
+	compatibleGatewayService  *service.CompatibleGatewayService
 	userService               *service.UserService
 	billingCacheService       *service.BillingCacheService
 	usageService              *service.UsageService
 	apiKeyService             *service.APIKeyService
 	errorPassthroughService   *service.ErrorPassthroughService
+	requestPolicyService      *service.RequestPolicyService
+	guardrailService          *service.GuardrailService
+	clientRuleService         *service.ClientRuleService
+	routingRuleService        *service.RoutingRuleService
 	concurrencyHelper         *ConcurrencyHelper
 	maxAccountSwitches        int
 	maxAccountSwitchesGemini  int
@@ -47,12 +53,18 @@ func NewGatewayHandler(
 	gatewayService *service.GatewayService,
 	geminiCompatService *service.GeminiMessagesCompatService,
 	antigravityGatewayService *service.AntigravityGatewayService,
+	compatibleGatewayService *service.CompatibleGatewayService,
 	userService *service.UserService,
 	concurrencyService *service.ConcurrencyService,
+	fairQueueService *service.FairQueueService,
 	billingCacheService *service.BillingCacheService,
 	usageService *service.UsageService,
 	apiKeyService *service.APIKeyService,
 	errorPassthroughService *service.ErrorPassthroughService,
+	requestPolicyService *service.RequestPolicyService,
+	guardrailService *service.GuardrailService,
+	clientRuleService *service.ClientRuleService,
+	routingRuleService *service.RoutingRuleService,
 	cfg *config.Config,
 ) *GatewayHandler {
 	pingInterval := time.Duration(0)
@@ -71,12 +83,17 @@ func NewGatewayHandler(
 		gatewayService:            gatewayService,
 		geminiCompatService:       geminiCompatService,
 		antigravityGatewayService: antigravityGatewayService,
+		compatibleGatewayService:  compatibleGatewayService,
 		userService:               userService,
 		billingCacheService:       billingCacheService,
 		usageService:              usageService,
 		apiKeyService:             apiKeyService,
 		errorPassthroughService:   errorPassthroughService,
-		concurrencyHelper:         NewConcurrencyHelper(concurrencyService, SSEPingFormatClaude, pingInterval),
+		requestPolicyService:      requestPolicyService,
+		guardrailService:          guardrailService,
+		clientRuleService:         clientRuleService,
+		routingRuleService:        routingRuleService,
+		concurrencyHelper:         NewConcurrencyHelper(concurrencyService, fairQueueService, SSEPingFormatClaude, pingInterval),
 		maxAccountSwitches:        maxAccountSwitches,
 		maxAccountSwitchesGemini:  maxAccountSwitchesGemini,
 	}
@@ -121,6 +138,31 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
 		return
 	}
+
+	// 执行分组请求参数策略（可能改写请求体/anthropic-beta 或直接拒绝）
+	policyBody, policyModified, err := applyRequestPolicies(c, h.requestPolicyService, apiKey, model.RequestProtocolAnthropic, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	if policyModified {
+		body = policyBody
+	}
+	// 执行分组内容护栏（可能脱敏请求文本或直接拦截）
+	guardedBody, guarded, err := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	if guarded {
+		body = guardedBody
+	}
+	if policyModified || guarded {
+		if parsedReq, err = service.ParseGatewayRequest(body, domain.PlatformAnthropic); err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+			return
+		}
+	}
 	reqModel := parsedReq.Model
 	reqStream := parsedReq.Stream
 
@@ -135,8 +177,35 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	SetClaudeCodeClientContext(c, body)
 	isClaudeCodeClient := service.IsClaudeCodeClient(c.Request.Context())
 
+	// 检查分组客户端准入规则（不满足时可能转入降级分组或直接拒绝）
+	originalGroupID := groupIDOf(apiKey)
+	var clientFallback bool
+	apiKey, clientFallback, err = applyClientRules(c, h.clientRuleService, apiKey, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", err.Error())
+		return
+	}
+	// 按分组路由规则将廉价/后台请求转入其他分组或账号子集
+	apiKey = applyRoutingRules(c, h.routingRuleService, apiKey, body)
+	// 转入其他分组后按新分组再执行策略与护栏
+	targetBody, targetModified, err := applyTargetGroupRules(c, h.requestPolicyService, h.guardrailService, originalGroupID, apiKey, model.RequestProtocolAnthropic, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	if targetModified {
+		body = targetBody
+		if parsedReq, err = service.ParseGatewayRequest(body, domain.PlatformAnthropic); err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+			return
+		}
+		reqModel, reqStream = parsedReq.Model, parsedReq.Stream
+	}
+
 	// 在请求上下文中记录 thinking 状态，供 Antigravity 最终模型 key 推导/模型维度限流使用
 	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.ThinkingEnabled, parsedReq.ThinkingEnabled))
+	// 估算输入长度，调度时优先选择上下文容量足够的账号（超过 200K 需开通 1M 上下文的账号）
+	c.Request = c.Request.WithContext(service.WithEstimatedInputTokens(c.Request.Context(), body))
 
 	setOpsRequestContext(c, reqModel, reqStream, body)
 
@@ -156,6 +225,10 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 
 	// 获取订阅信息（可能为nil）- 提前获取用于后续检查
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
+	// 降级分组按非订阅请求处理（路由规则不会把订阅分组的请求转入其他分组）
+	if clientFallback {
+		subscription = nil
+	}
 
 	// 0. 检查wait队列是否已满
 	maxWait := service.CalculateMaxWait(subject.Concurrency)
@@ -204,6 +277,25 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		return
 	}
 
+	// 3. 预留本次请求的最大费用，避免并发请求透支余额/订阅额度
+	reservationInput := service.CostReservationInput{
+		User:         apiKey.User,
+		Group:        apiKey.Group,
+		Subscription: subscription,
+		Model:        reqModel,
+		BodySize:     len(body),
+		MaxTokens:    parsedReq.MaxTokens,
+	}
+	reservation, err := h.billingCacheService.ReserveCost(c.Request.Context(), reservationInput)
+	if err != nil {
+		log.Printf("Billing cost reservation failed: %v", err)
+		status, code, message := billingErrorDetails(err)
+		h.handleStreamingAwareError(c, status, code, message, streamStarted)
+		return
+	}
+	// 模型降级会替换预留，释放时以最新的预留为准
+	defer func() { reservation.Release() }()
+
 	// 计算粘性会话hash
 	parsedReq.SessionContext = &service.SessionContext{
 		ClientIP:  ip.GetClientIP(c),
@@ -250,6 +342,11 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		for {
 			selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), apiKey.GroupID, sessionKey, reqModel, failedAccountIDs, "") // Gemini 不使用会话限制
 			if err != nil {
+				var contextErr *service.ContextTooLongError
+				if errors.As(err, &contextErr) {
+					h.handleStreamingAwareError(c, http.StatusBadRequest, "invalid_request_error", contextErr.Error(), streamStarted)
+					return
+				}
 				if len(failedAccountIDs) == 0 {
 					msg := h.buildNoAvailableAccountsMessage(c.Request.Context(), apiKey.GroupID, platform, err, "No available accounts")
 					h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", msg, streamStarted)
@@ -405,10 +502,14 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 			userAgent := c.GetHeader("User-Agent")
 			clientIP := ip.GetClientIP(c)
+			// 保留 trace 信息但不随请求取消，使用量记录 span 归属同一条链路
+			usageCtx := context.WithoutCancel(c.Request.Context())
 
-			// 异步记录使用量（subscription已在函数开头获取）
+			// 异步记录使用量（subscription已在函数开头获取），费用预留随用量事件在扣费提交后释放
+			settleReservation := reservation.Handoff()
 			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string, fcb bool) {
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer settleReservation()
+				ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 				defer cancel()
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 					Result:            result,
@@ -420,6 +521,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					IPAddress:         clientIP,
 					ForceCacheBilling: fcb,
 					APIKeyService:     h.apiKeyService,
+					Reservation:       reservation,
 				}); err != nil {
 					log.Printf("Record usage failed: %v", err)
 				}
@@ -436,6 +538,43 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	}
 	fallbackUsed := false
 
+	// 分组模型降级链：满足触发条件时改写请求模型，重新走一轮账号调度
+	requestedModel := reqModel
+	modelFallback := service.NewModelFallbackChain(apiKey.Group, reqModel)
+	switchModel := func(trigger string) bool {
+		nextModel, ok := modelFallback.Next(trigger)
+		if !ok {
+			return false
+		}
+		fallbackReq, err := rewriteRequestModel(parsedReq, nextModel)
+		if err != nil {
+			log.Printf("Model fallback rewrite failed: model=%s err=%v", nextModel, err)
+			return false
+		}
+		// 按降级后的请求重新估算预留；额度不足以覆盖新模型时不降级
+		nextInput := service.CostReservationInput{
+			User:         currentAPIKey.User,
+			Group:        currentAPIKey.Group,
+			Subscription: currentSubscription,
+			Model:        nextModel,
+			BodySize:     len(fallbackReq.Body),
+			MaxTokens:    fallbackReq.MaxTokens,
+		}
+		nextReservation, err := h.billingCacheService.ReestimateCost(c.Request.Context(), reservation, nextInput)
+		reservation = nextReservation
+		if err != nil {
+			log.Printf("Model fallback reservation failed: model=%s err=%v", nextModel, err)
+			return false
+		}
+		log.Printf("Model fallback: group=%d trigger=%s %s -> %s", currentAPIKey.GroupID, trigger, reqModel, nextModel)
+		parsedReq = fallbackReq
+		body = fallbackReq.Body
+		reqModel = nextModel
+		setOpsRequestContext(c, reqModel, reqStream, body)
+		c.Header(servedModelHeader, reqModel)
+		return true
+	}
+
 	// 单账号分组提前设置 SingleAccountRetry 标记，让 Service 层首次 503 就不设模型限流标记。
 	// 避免单账号分组收到 503 (MODEL_CAPACITY_EXHAUSTED) 时设 29s 限流，导致后续请求连续快速失败。
 	if h.gatewayService.IsSingleAntigravityAccountGroup(c.Request.Context(), currentAPIKey.GroupID) {
@@ -456,7 +595,16 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			// 选择支持该模型的账号
 			selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), currentAPIKey.GroupID, sessionKey, reqModel, failedAccountIDs, parsedReq.MetadataUserID)
 			if err != nil {
+				var contextErr *service.ContextTooLongError
+				if errors.As(err, &contextErr) {
+					h.handleStreamingAwareError(c, http.StatusBadRequest, "invalid_request_error", contextErr.Error(), streamStarted)
+					return
+				}
 				if len(failedAccountIDs) == 0 {
+					if switchModel(service.ModelFallbackTriggerNoAccount) {
+						retryWithFallback = true
+						break
+					}
 					msg := h.buildNoAvailableAccountsMessage(c.Request.Context(), currentAPIKey.GroupID, platform, err, "No available accounts")
 					h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", msg, streamStarted)
 					return
@@ -474,6 +622,10 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 						continue
 					}
 				}
+				if lastFailoverErr != nil && switchModel(service.ModelFallbackTriggerForStatus(lastFailoverErr.StatusCode)) {
+					retryWithFallback = true
+					break
+				}
 				if lastFailoverErr != nil {
 					h.handleFailoverExhausted(c, lastFailoverErr, platform, streamStarted)
 				} else {
@@ -555,8 +707,13 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			if switchCount > 0 {
 				requestCtx = context.WithValue(requestCtx, ctxkey.AccountSwitchCount, switchCount)
 			}
+			if modelFallback.CanFallback(service.ModelFallbackTriggerContextTooLong) {
+				requestCtx = context.WithValue(requestCtx, ctxkey.ModelFallbackOnContextTooLong, true)
+			}
 			if account.Platform == service.PlatformAntigravity && account.Type != service.AccountTypeAPIKey {
 				result, err = h.antigravityGatewayService.Forward(requestCtx, c, account, body, hasBoundSession)
+			} else if account.Platform == service.PlatformCompatible {
+				result, err = h.compatibleGatewayService.Forward(requestCtx, c, account, body)
 			} else {
 				result, err = h.gatewayService.Forward(requestCtx, c, account, parsedReq)
 			}
@@ -566,6 +723,11 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			if err != nil {
 				var promptTooLongErr *service.PromptTooLongError
 				if errors.As(err, &promptTooLongErr) {
+					// 分组模型降级链优先于无效请求兜底分组
+					if switchModel(service.ModelFallbackTriggerContextTooLong) {
+						retryWithFallback = true
+						break
+					}
 					log.Printf("Prompt too long from antigravity: group=%d fallback_group_id=%v fallback_used=%v", currentAPIKey.GroupID, fallbackGroupID, fallbackUsed)
 					if !fallbackUsed && fallbackGroupID != nil && *fallbackGroupID > 0 {
 						fallbackGroup, err := h.gatewayService.ResolveGroupByID(c.Request.Context(), *fallbackGroupID)
@@ -624,6 +786,10 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 
 					failedAccountIDs[account.ID] = struct{}{}
 					if switchCount >= maxAccountSwitches {
+						if switchModel(service.ModelFallbackTriggerForStatus(failoverErr.StatusCode)) {
+							retryWithFallback = true
+							break
+						}
 						h.handleFailoverExhausted(c, failoverErr, account.Platform, streamStarted)
 						return
 					}
@@ -644,10 +810,14 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 			userAgent := c.GetHeader("User-Agent")
 			clientIP := ip.GetClientIP(c)
+			// 保留 trace 信息但不随请求取消，使用量记录 span 归属同一条链路
+			usageCtx := context.WithoutCancel(c.Request.Context())
 
-			// 异步记录使用量（subscription已在函数开头获取）
+			// 异步记录使用量（subscription已在函数开头获取），费用预留随用量事件在扣费提交后释放
+			settleReservation := reservation.Handoff()
 			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string, fcb bool) {
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer settleReservation()
+				ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 				defer cancel()
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 					Result:            result,
@@ -659,6 +829,8 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					IPAddress:         clientIP,
 					ForceCacheBilling: fcb,
 					APIKeyService:     h.apiKeyService,
+					RequestedModel:    requestedModel,
+					Reservation:       reservation,
 				}); err != nil {
 					log.Printf("Record usage failed: %v", err)
 				}
@@ -1457,6 +1629,9 @@ func sendMockInterceptResponse(c *gin.Context, model string, interceptType Inter
 }
 
 func billingErrorDetails(err error) (status int, code, message string) {
+	if errors.Is(err, service.ErrBillingReservationFailed) {
+		return http.StatusTooManyRequests, "rate_limit_error", pkgerrors.Message(err)
+	}
 	if errors.Is(err, service.ErrBillingServiceUnavailable) {
 		msg := pkgerrors.Message(err)
 		if msg == "" {
@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"math/rand"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // claudeCodeValidator is a singleton validator for Claude Code client detection
@@ -86,17 +90,20 @@ func (e *ConcurrencyError) Error() string {
 // ConcurrencyHelper provides common concurrency slot management for gateway handlers
 type ConcurrencyHelper struct {
 	concurrencyService *service.ConcurrencyService
+	fairQueue          *service.FairQueueService
 	pingFormat         SSEPingFormat
 	pingInterval       time.Duration
 }
 
 // NewConcurrencyHelper creates a new ConcurrencyHelper
-func NewConcurrencyHelper(concurrencyService *service.ConcurrencyService, pingFormat SSEPingFormat, pingInterval time.Duration) *ConcurrencyHelper {
+// fairQueue 可为 nil，此时账号槽位等待沿用先到先得的轮询竞争。
+func NewConcurrencyHelper(concurrencyService *service.ConcurrencyService, fairQueue *service.FairQueueService, pingFormat SSEPingFormat, pingInterval time.Duration) *ConcurrencyHelper {
 	if pingInterval <= 0 {
 		pingInterval = defaultPingInterval
 	}
 	return &ConcurrencyHelper{
 		concurrencyService: concurrencyService,
+		fairQueue:          fairQueue,
 		pingFormat:         pingFormat,
 		pingInterval:       pingInterval,
 	}
@@ -179,8 +186,8 @@ func (h *ConcurrencyHelper) AcquireUserSlotWithWait(c *gin.Context, userID int64
 func (h *ConcurrencyHelper) AcquireAccountSlotWithWait(c *gin.Context, accountID int64, maxConcurrency int, isStream bool, streamStarted *bool) (func(), error) {
 	ctx := c.Request.Context()
 
-	// Try to acquire immediately
-	result, err := h.concurrencyService.AcquireAccountSlot(ctx, accountID, maxConcurrency)
+	// Try to acquire immediately (only when nobody is queued for this account)
+	result, err := h.concurrencyService.AcquireAccountSlotUnlessQueued(ctx, accountID, maxConcurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -201,16 +208,20 @@ func (h *ConcurrencyHelper) waitForSlotWithPing(c *gin.Context, slotType string,
 
 // waitForSlotWithPingTimeout waits for a concurrency slot with a custom timeout.
 func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType string, id int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	spanCtx, span := tracing.Start(c.Request.Context(), "concurrency.wait_"+slotType+"_slot", attribute.Int64("sub2api.slot_owner_id", id))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(spanCtx, timeout)
 	defer cancel()
 
 	// Try immediate acquire first (avoid unnecessary wait)
+	// 账号已有排队者时不走快速路径，避免新请求插到队列前面
 	var result *service.AcquireResult
 	var err error
 	if slotType == "user" {
 		result, err = h.concurrencyService.AcquireUserSlot(ctx, id, maxConcurrency)
 	} else {
-		result, err = h.concurrencyService.AcquireAccountSlot(ctx, id, maxConcurrency)
+		result, err = h.concurrencyService.AcquireAccountSlotUnlessQueued(ctx, id, maxConcurrency)
 	}
 	if err != nil {
 		return nil, err
@@ -219,6 +230,23 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 		return result.ReleaseFunc, nil
 	}
 
+	// 账号槽位已满：加入分组公平队列，只有准入窗口内的请求参与竞争（窗口按所等待的账号计算）
+	var ticket *service.FairQueueTicket
+	var queueStatus *service.FairQueueStatus
+	acquired := false
+	if slotType == "account" {
+		ticket = h.joinFairQueue(c, id)
+	}
+	if ticket != nil {
+		defer func() {
+			if acquired {
+				ticket.Admit()
+			} else {
+				ticket.Leave()
+			}
+		}()
+	}
+
 	// Determine if ping is needed (streaming + ping format defined)
 	needPing := isStream && h.pingFormat != ""
 
@@ -261,12 +289,27 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 				c.Header("X-Accel-Buffering", "no")
 				*streamStarted = true
 			}
-			if _, err := fmt.Fprint(c.Writer, string(h.pingFormat)); err != nil {
+			if _, err := fmt.Fprint(c.Writer, h.pingPayload(queueStatus)); err != nil {
 				return nil, err
 			}
 			flusher.Flush()
 
 		case <-timer.C:
+			if ticket != nil {
+				status, err := ticket.Poll(ctx)
+				if err != nil {
+					// Redis 异常时降级为直接竞争，避免请求卡死在队列中
+					log.Printf("Warning: fair queue poll failed: %v", err)
+					status = &service.FairQueueStatus{CanAcquire: true}
+				}
+				queueStatus = status
+				if !status.CanAcquire {
+					backoff = nextBackoff(backoff, rng)
+					timer.Reset(backoff)
+					continue
+				}
+			}
+
 			// Try to acquire slot
 			var result *service.AcquireResult
 			var err error
@@ -282,6 +325,7 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 			}
 
 			if result.Acquired {
+				acquired = true
 				return result.ReleaseFunc, nil
 			}
 			backoff = nextBackoff(backoff, rng)
@@ -290,6 +334,39 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 	}
 }
 
+// joinFairQueue 将等待指定账号的请求加入所属分组的公平队列，未启用或无法确定分组时返回 nil
+func (h *ConcurrencyHelper) joinFairQueue(c *gin.Context, accountID int64) *service.FairQueueTicket {
+	if !h.fairQueue.Enabled() {
+		return nil
+	}
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok || apiKey == nil || apiKey.GroupID == nil || apiKey.User == nil {
+		return nil
+	}
+	ticket, err := h.fairQueue.Join(c.Request.Context(), *apiKey.GroupID, accountID, apiKey.User.ID, apiKey.User.QueueWeight)
+	if err != nil {
+		log.Printf("Warning: join fair queue failed for group %d: %v", *apiKey.GroupID, err)
+		return nil
+	}
+	return ticket
+}
+
+// pingPayload 返回等待期间发送的 ping
+// 排队中时在 ping 之前附带一行 SSE 注释（: queue_position=...），ping 事件本身保持不变，
+// 客户端按 SSE 规范忽略注释，不会解析到协议之外的字段。
+func (h *ConcurrencyHelper) pingPayload(status *service.FairQueueStatus) string {
+	if status == nil || status.Position <= 0 {
+		return string(h.pingFormat)
+	}
+	queue := fmt.Sprintf(": queue_position=%d queue_depth=%d estimated_wait_seconds=%d\n\n",
+		status.Position, status.Depth, int64(status.EstimatedWait/time.Second))
+	if h.pingFormat == SSEPingFormatComment {
+		// 注释本身即可保活，无需再发送空注释
+		return queue
+	}
+	return queue + string(h.pingFormat)
+}
+
 // AcquireAccountSlotWithWaitTimeout acquires an account slot with a custom timeout (keeps SSE ping).
 func (h *ConcurrencyHelper) AcquireAccountSlotWithWaitTimeout(c *gin.Context, accountID int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
 	return h.waitForSlotWithPingTimeout(c, "account", accountID, maxConcurrency, timeout, isStream, streamStarted)
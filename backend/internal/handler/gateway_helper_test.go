@@ -6,6 +6,9 @@ import (
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
 )
 
 // TestWrapReleaseOnDone_NoGoroutineLeak 验证 wrapReleaseOnDone 修复后不会泄露 goroutine
@@ -139,3 +142,15 @@ func BenchmarkWrapReleaseOnDone(b *testing.B) {
 		release()
 	}
 }
+
+func TestConcurrencyHelper_PingPayload(t *testing.T) {
+	status := &service.FairQueueStatus{Position: 3, Depth: 7, EstimatedWait: 12500 * time.Millisecond}
+
+	claude := NewConcurrencyHelper(nil, nil, SSEPingFormatClaude, 0)
+	require.Equal(t, string(SSEPingFormatClaude), claude.pingPayload(nil))
+	require.Equal(t, ": queue_position=3 queue_depth=7 estimated_wait_seconds=12\n\n"+string(SSEPingFormatClaude), claude.pingPayload(status), "ping 事件保持原样，队列位置放在 SSE 注释中")
+
+	comment := NewConcurrencyHelper(nil, nil, SSEPingFormatComment, 0)
+	require.Equal(t, ": queue_position=3 queue_depth=7 estimated_wait_seconds=12\n\n", comment.pingPayload(status))
+	require.Equal(t, string(SSEPingFormatComment), comment.pingPayload(&service.FairQueueStatus{CanAcquire: true}), "不在队列中时发送普通 ping")
+}
@@ -181,11 +181,36 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 
 	setOpsRequestContext(c, modelName, stream, body)
 
+	// 执行分组内容护栏（可能脱敏请求文本或直接拦截）
+	body, _, err = applyGuardrails(c, h.guardrailService, apiKey, body)
+	if err != nil {
+		googleError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 检查分组客户端准入规则（不满足时可能转入降级分组或直接拒绝）
+	originalGroupID := groupIDOf(apiKey)
+	apiKey, clientFallback, err := applyClientRules(c, h.clientRuleService, apiKey, body)
+	if err != nil {
+		googleError(c, http.StatusForbidden, err.Error())
+		return
+	}
+	// 转入降级分组后按新分组再执行护栏
+	body, _, err = applyTargetGroupRules(c, nil, h.guardrailService, originalGroupID, apiKey, "", body)
+	if err != nil {
+		googleError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	// Get subscription (may be nil)
 	subscription, _ := middleware.GetSubscriptionFromContext(c)
+	// 降级分组按非订阅请求处理
+	if clientFallback {
+		subscription = nil
+	}
 
 	// For Gemini native API, do not send Claude-style ping frames.
-	geminiConcurrency := NewConcurrencyHelper(h.concurrencyHelper.concurrencyService, SSEPingFormatNone, 0)
+	geminiConcurrency := NewConcurrencyHelper(h.concurrencyHelper.concurrencyService, h.concurrencyHelper.fairQueue, SSEPingFormatNone, 0)
 
 	// 0) wait queue check
 	maxWait := service.CalculateMaxWait(authSubject.Concurrency)
@@ -233,6 +258,22 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		return
 	}
 
+	// 2.1) 预留本次请求的最大费用，避免并发请求透支余额/订阅额度
+	reservation, err := h.billingCacheService.ReserveCost(c.Request.Context(), service.CostReservationInput{
+		User:         apiKey.User,
+		Group:        apiKey.Group,
+		Subscription: subscription,
+		Model:        modelName,
+		BodySize:     len(body),
+		MaxTokens:    requestMaxOutputTokens(body),
+	})
+	if err != nil {
+		status, _, message := billingErrorDetails(err)
+		googleError(c, status, message)
+		return
+	}
+	defer reservation.Release()
+
 	// 3) select account (sticky session based on request body)
 	// 优先使用 Gemini CLI 的会话标识（privileged-user-id + tmp 目录哈希）
 	sessionHash := extractGeminiCLISessionHash(c, body)
@@ -480,6 +521,8 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 		userAgent := c.GetHeader("User-Agent")
 		clientIP := ip.GetClientIP(c)
+		// 保留 trace 信息但不随请求取消，使用量记录 span 归属同一条链路
+		usageCtx := context.WithoutCancel(c.Request.Context())
 
 		// 保存 Gemini 内容摘要会话（用于 Fallback 匹配）
 		if useDigestFallback && geminiDigestChain != "" && geminiPrefixHash != "" {
@@ -496,9 +539,11 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 			}
 		}
 
-		// 6) record usage async (Gemini 使用长上下文双倍计费)
+		// 6) record usage async (Gemini 使用长上下文双倍计费)，费用预留随用量事件在扣费提交后释放
+		settleReservation := reservation.Handoff()
 		go func(result *service.ForwardResult, usedAccount *service.Account, ua, ip string, fcb bool) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer settleReservation()
+			ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 			defer cancel()
 
 			if err := h.gatewayService.RecordUsageWithLongContext(ctx, &service.RecordUsageLongContextInput{
@@ -513,6 +558,7 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 				LongContextMultiplier: 2.0,    // 超出部分双倍计费
 				ForceCacheBilling:     fcb,
 				APIKeyService:         h.apiKeyService,
+				Reservation:           reservation,
 			}); err != nil {
 				log.Printf("Record usage failed: %v", err)
 			}
@@ -3,10 +3,18 @@
 package handler
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
 // TestGeminiV1BetaHandler_PlatformRoutingInvariant 文档化并验证 Handler 层的平台路由逻辑不变量
@@ -141,3 +149,37 @@ func TestGeminiV1BetaHandler_GetModelAntigravityFallback(t *testing.T) {
 		})
 	}
 }
+
+type geminiGuardrailRepoStub struct {
+	service.GuardrailRuleRepository
+	rules []*model.GuardrailRule
+}
+
+func (r *geminiGuardrailRepoStub) List(context.Context) ([]*model.GuardrailRule, error) {
+	return r.rules, nil
+}
+
+// TestGeminiV1BetaHandler_GuardrailBlocksNativeRequest 验证 Gemini 原生接口在排队与选号之前执行内容护栏
+func TestGeminiV1BetaHandler_GuardrailBlocksNativeRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	guardrails := service.NewGuardrailService(&geminiGuardrailRepoStub{rules: []*model.GuardrailRule{{
+		ID: 1, Name: "blocklist", Enabled: true, MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionBlock, Patterns: []string{"forbidden"},
+	}}}, nil)
+	h := &GatewayHandler{guardrailService: guardrails}
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	body := `{"contents":[{"role":"user","parts":[{"text":"tell me the FORBIDDEN thing"}]}]}`
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1beta/models/gemini-2.5-pro:generateContent", strings.NewReader(body))
+	c.Params = gin.Params{{Key: "modelAction", Value: "/gemini-2.5-pro:generateContent"}}
+	c.Set(string(middleware.ContextKeyAPIKey), &service.APIKey{ID: 1, Group: &service.Group{ID: 2, Platform: service.PlatformGemini}})
+	c.Set(string(middleware.ContextKeyUser), middleware.AuthSubject{UserID: 3, Concurrency: 1})
+
+	h.GeminiV1BetaModels(c)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, gjson.Get(rec.Body.String(), "error.message").String(), "blocklist")
+	hits, ok := c.Get(opsGuardrailHitsKey)
+	require.True(t, ok)
+	require.Len(t, hits, 1)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// applyGuardrails 在转发前执行分组内容护栏
+//
+// 返回脱敏后的请求体与是否改写；命中 block 规则时返回 *service.GuardrailBlockedError。
+// 有命中时将命中记录写入 context 供运维日志使用，并把运维日志中的请求体替换为脱敏版本。
+func applyGuardrails(c *gin.Context, svc *service.GuardrailService, apiKey *service.APIKey, body []byte) ([]byte, bool, error) {
+	if svc == nil {
+		return body, false, nil
+	}
+	var groupID int64
+	if apiKey != nil && apiKey.Group != nil {
+		groupID = apiKey.Group.ID
+	}
+	result, err := svc.Scan(groupID, body)
+	if len(result.Hits) > 0 {
+		// 请求转入其他分组后会再次执行护栏，命中记录累加
+		hits := result.Hits
+		if v, ok := c.Get(opsGuardrailHitsKey); ok {
+			if previous, ok := v.([]service.GuardrailHit); ok {
+				hits = append(append([]service.GuardrailHit(nil), previous...), hits...)
+			}
+		}
+		c.Set(opsGuardrailHitsKey, hits)
+		redacted := result.RedactedBody
+		if redacted == nil {
+			redacted = []byte{}
+		}
+		c.Set(opsGuardrailBodyKey, redacted)
+		c.Set(opsRequestBodyKey, redacted)
+	}
+	if err != nil {
+		return body, false, err
+	}
+	return result.Body, result.Modified, nil
+}
+
+// recordOpsGuardrailHits 将 mask/flag 命中记录到运维日志（block 命中由错误响应路径记录）
+func recordOpsGuardrailHits(c *gin.Context, ops *service.OpsService, status int) {
+	v, ok := c.Get(opsGuardrailHitsKey)
+	if !ok {
+		return
+	}
+	hits, ok := v.([]service.GuardrailHit)
+	if !ok || len(hits) == 0 {
+		return
+	}
+
+	parts := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		parts = append(parts, fmt.Sprintf("%s[%s] %s x%d (%s)", hit.Name, hit.MatchType, hit.Label, hit.Count, hit.Action))
+	}
+	message := truncateString("Guardrail hit: "+strings.Join(parts, "; "), 2048)
+
+	apiKey, _ := middleware2.GetAPIKeyFromContext(c)
+	clientRequestID, _ := c.Request.Context().Value(ctxkey.ClientRequestID).(string)
+	modelName, _ := c.Get(opsModelKey)
+	stream, _ := c.Get(opsStreamKey)
+
+	requestID := c.Writer.Header().Get("X-Request-Id")
+	if requestID == "" {
+		requestID = c.Writer.Header().Get("x-request-id")
+	}
+
+	entry := &service.OpsInsertErrorLogInput{
+		RequestID:       requestID,
+		ClientRequestID: clientRequestID,
+		TraceID:         tracing.TraceID(c.Request.Context()),
+
+		Platform:    resolveOpsPlatform(apiKey, guessPlatformFromPath(c.Request.URL.Path)),
+		RequestPath: c.Request.URL.Path,
+		UserAgent:   c.GetHeader("User-Agent"),
+
+		ErrorPhase: "request",
+		ErrorType:  "guardrail_flagged",
+		Severity:   "P3",
+		StatusCode: status,
+		// 护栏命中属于内容审计，不计入 SLA/错误率
+		IsBusinessLimited: true,
+		IsCountTokens:     isCountTokensRequest(c),
+
+		ErrorMessage: message,
+		ErrorSource:  "client_request",
+		ErrorOwner:   "client",
+		CreatedAt:    time.Now(),
+	}
+	if s, ok := modelName.(string); ok {
+		entry.Model = s
+	}
+	if b, ok := stream.(bool); ok {
+		entry.Stream = b
+	}
+	if apiKey != nil {
+		entry.APIKeyID = &apiKey.ID
+		if apiKey.User != nil {
+			entry.UserID = &apiKey.User.ID
+		}
+		if apiKey.GroupID != nil {
+			entry.GroupID = apiKey.GroupID
+		}
+		if apiKey.Group != nil && apiKey.Group.Platform != "" {
+			entry.Platform = apiKey.Group.Platform
+		}
+	}
+	if clientIP := strings.TrimSpace(ip.GetClientIP(c)); clientIP != "" {
+		entry.ClientIP = &clientIP
+	}
+
+	var requestBody []byte
+	if v, ok := c.Get(opsGuardrailBodyKey); ok {
+		if b, ok := v.([]byte); ok && len(b) > 0 {
+			requestBody = b
+		}
+	}
+	enqueueOpsErrorLog(ops, entry, requestBody)
+}
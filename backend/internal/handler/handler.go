@@ -25,22 +25,34 @@ type AdminHandlers struct {
 	Usage            *admin.UsageHandler
 	UserAttribute    *admin.UserAttributeHandler
 	ErrorPassthrough *admin.ErrorPassthroughHandler
+	ModelPrice       *admin.ModelPriceHandler
+	RequestPolicy    *admin.RequestPolicyHandler
+	Guardrail        *admin.GuardrailHandler
+	ClientRule       *admin.ClientRuleHandler
+	RoutingRule      *admin.RoutingRuleHandler
+	Cluster          *admin.ClusterHandler
+	ConfigSync       *admin.ConfigSyncHandler
+	Backup           *admin.BackupHandler
+	OneAPIImport     *admin.OneAPIImportHandler
+	ConfigReload     *admin.ConfigReloadHandler
 }
 
 // Handlers contains all HTTP handlers
 type Handlers struct {
-	Auth          *AuthHandler
-	User          *UserHandler
-	APIKey        *APIKeyHandler
-	Usage         *UsageHandler
-	Redeem        *RedeemHandler
-	Subscription  *SubscriptionHandler
-	Announcement  *AnnouncementHandler
-	Admin         *AdminHandlers
-	Gateway       *GatewayHandler
-	OpenAIGateway *OpenAIGatewayHandler
-	Setting       *SettingHandler
-	Totp          *TotpHandler
+	Auth              *AuthHandler
+	User              *UserHandler
+	APIKey            *APIKeyHandler
+	Usage             *UsageHandler
+	Redeem            *RedeemHandler
+	Subscription      *SubscriptionHandler
+	Announcement      *AnnouncementHandler
+	Admin             *AdminHandlers
+	Gateway           *GatewayHandler
+	OpenAIGateway     *OpenAIGatewayHandler
+	CompatibleGateway *CompatibleGatewayHandler
+	Setting           *SettingHandler
+	Totp              *TotpHandler
+	Health            *HealthHandler
 }
 
 // BuildInfo contains build-time information
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthHandler 存活/就绪检查
+type HealthHandler struct {
+	healthService *service.HealthService
+}
+
+// NewHealthHandler 创建健康检查处理器
+func NewHealthHandler(healthService *service.HealthService) *HealthHandler {
+	return &HealthHandler{healthService: healthService}
+}
+
+// Livez 存活检查：进程可以处理 HTTP 请求即视为存活，draining 期间同样返回 200，避免停机过程中被重启
+// GET /livez
+func (h *HealthHandler) Livez(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz 就绪检查：依赖不可用或处于 draining 状态时返回 503
+// GET /readyz
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	report := h.healthService.CheckReadiness(c.Request.Context())
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
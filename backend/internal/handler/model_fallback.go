@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/tidwall/sjson"
+)
+
+// servedModelHeader 分组模型降级链生效时，告知客户端实际服务请求的模型
+const servedModelHeader = "X-Served-Model"
+
+// rewriteRequestModel 将请求体中的 model 替换为备选模型并重新解析，保留会话上下文以维持粘性会话
+func rewriteRequestModel(parsed *service.ParsedRequest, model string) (*service.ParsedRequest, error) {
+	body, err := sjson.SetBytes(parsed.Body, "model", model)
+	if err != nil {
+		return nil, err
+	}
+	out, err := service.ParseGatewayRequest(body, domain.PlatformAnthropic)
+	if err != nil {
+		return nil, err
+	}
+	out.SessionContext = parsed.SessionContext
+	return out, nil
+}
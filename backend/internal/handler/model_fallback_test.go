@@ -0,0 +1,28 @@
+//go:build unit
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestRewriteRequestModel(t *testing.T) {
+	session := &service.SessionContext{ClientIP: "1.2.3.4", APIKeyID: 7}
+	parsed, err := service.ParseGatewayRequest([]byte(`{"model":"claude-opus-4-5","stream":true,"max_tokens":16,"messages":[{"role":"user","content":"hi"}]}`), "anthropic")
+	require.NoError(t, err)
+	parsed.SessionContext = session
+
+	out, err := rewriteRequestModel(parsed, "claude-sonnet-4-5")
+	require.NoError(t, err)
+	require.Equal(t, "claude-sonnet-4-5", out.Model)
+	require.Equal(t, "claude-sonnet-4-5", gjson.GetBytes(out.Body, "model").String())
+	require.True(t, out.Stream)
+	require.Equal(t, 16, out.MaxTokens)
+	require.Len(t, out.Messages, 1)
+	require.Same(t, session, out.SessionContext)
+	require.Equal(t, "claude-opus-4-5", parsed.Model, "原请求不应被修改")
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
@@ -26,6 +27,10 @@ type OpenAIGatewayHandler struct {
 	billingCacheService     *service.BillingCacheService
 	apiKeyService           *service.APIKeyService
 	errorPassthroughService *service.ErrorPassthroughService
+	requestPolicyService    *service.RequestPolicyService
+	guardrailService        *service.GuardrailService
+	clientRuleService       *service.ClientRuleService
+	routingRuleService      *service.RoutingRuleService
 	concurrencyHelper       *ConcurrencyHelper
 	maxAccountSwitches      int
 }
@@ -34,9 +39,14 @@ type OpenAIGatewayHandler struct {
 func NewOpenAIGatewayHandler(
 	gatewayService *service.OpenAIGatewayService,
 	concurrencyService *service.ConcurrencyService,
+	fairQueueService *service.FairQueueService,
 	billingCacheService *service.BillingCacheService,
 	apiKeyService *service.APIKeyService,
 	errorPassthroughService *service.ErrorPassthroughService,
+	requestPolicyService *service.RequestPolicyService,
+	guardrailService *service.GuardrailService,
+	clientRuleService *service.ClientRuleService,
+	routingRuleService *service.RoutingRuleService,
 	cfg *config.Config,
 ) *OpenAIGatewayHandler {
 	pingInterval := time.Duration(0)
@@ -52,7 +62,11 @@ func NewOpenAIGatewayHandler(
 		billingCacheService:     billingCacheService,
 		apiKeyService:           apiKeyService,
 		errorPassthroughService: errorPassthroughService,
-		concurrencyHelper:       NewConcurrencyHelper(concurrencyService, SSEPingFormatComment, pingInterval),
+		requestPolicyService:    requestPolicyService,
+		guardrailService:        guardrailService,
+		clientRuleService:       clientRuleService,
+		routingRuleService:      routingRuleService,
+		concurrencyHelper:       NewConcurrencyHelper(concurrencyService, fairQueueService, SSEPingFormatComment, pingInterval),
 		maxAccountSwitches:      maxAccountSwitches,
 	}
 }
@@ -91,6 +105,38 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 
 	setOpsRequestContext(c, "", false, body)
 
+	// 执行分组请求参数策略（可能改写请求体或直接拒绝）
+	policyBody, _, err := applyRequestPolicies(c, h.requestPolicyService, apiKey, model.RequestProtocolOpenAI, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	body = policyBody
+
+	// 执行分组内容护栏（可能脱敏请求文本或直接拦截）
+	body, _, err = applyGuardrails(c, h.guardrailService, apiKey, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
+	// 检查分组客户端准入规则（不满足时可能转入降级分组或直接拒绝）
+	originalGroupID := groupIDOf(apiKey)
+	var clientFallback bool
+	apiKey, clientFallback, err = applyClientRules(c, h.clientRuleService, apiKey, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", err.Error())
+		return
+	}
+	// 按分组路由规则将廉价/后台请求转入其他分组或账号子集
+	apiKey = applyRoutingRules(c, h.routingRuleService, apiKey, body)
+	// 转入其他分组后按新分组再执行策略与护栏
+	body, _, err = applyTargetGroupRules(c, h.requestPolicyService, h.guardrailService, originalGroupID, apiKey, model.RequestProtocolOpenAI, body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
 	// Parse request body to map for potential modification
 	var reqBody map[string]any
 	if err := json.Unmarshal(body, &reqBody); err != nil {
@@ -156,6 +202,10 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 
 	// Get subscription info (may be nil)
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
+	// 降级分组按非订阅请求处理（路由规则不会把订阅分组的请求转入其他分组）
+	if clientFallback {
+		subscription = nil
+	}
 
 	// 0. Check if wait queue is full
 	maxWait := service.CalculateMaxWait(subject.Concurrency)
@@ -203,6 +253,23 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		return
 	}
 
+	// 3. 预留本次请求的最大费用，避免并发请求透支余额/订阅额度
+	reservation, err := h.billingCacheService.ReserveCost(c.Request.Context(), service.CostReservationInput{
+		User:         apiKey.User,
+		Group:        apiKey.Group,
+		Subscription: subscription,
+		Model:        reqModel,
+		BodySize:     len(body),
+		MaxTokens:    requestMaxOutputTokens(body),
+	})
+	if err != nil {
+		log.Printf("Billing cost reservation failed: %v", err)
+		status, code, message := billingErrorDetails(err)
+		h.handleStreamingAwareError(c, status, code, message, streamStarted)
+		return
+	}
+	defer reservation.Release()
+
 	// Generate session hash (header first; fallback to prompt_cache_key)
 	sessionHash := h.gatewayService.GenerateSessionHash(c, reqBody)
 
@@ -307,10 +374,14 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 		userAgent := c.GetHeader("User-Agent")
 		clientIP := ip.GetClientIP(c)
+		// 保留 trace 信息但不随请求取消，使用量记录 span 归属同一条链路
+		usageCtx := context.WithoutCancel(c.Request.Context())
 
-		// Async record usage
+		// Async record usage; the cost reservation is released once the charge commits
+		settleReservation := reservation.Handoff()
 		go func(result *service.OpenAIForwardResult, usedAccount *service.Account, ua, ip string) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer settleReservation()
+			ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 			defer cancel()
 			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
 				Result:        result,
@@ -321,6 +392,7 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 				UserAgent:     ua,
 				IPAddress:     ip,
 				APIKeyService: h.apiKeyService,
+				Reservation:   reservation,
 			}); err != nil {
 				log.Printf("Record usage failed: %v", err)
 			}
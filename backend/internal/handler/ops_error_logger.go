@@ -16,6 +16,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/gin-gonic/gin"
@@ -26,6 +27,10 @@ const (
 	opsStreamKey      = "ops_stream"
 	opsRequestBodyKey = "ops_request_body"
 	opsAccountIDKey   = "ops_account_id"
+
+	// 内容护栏命中记录与脱敏后的请求体
+	opsGuardrailHitsKey = "ops_guardrail_hits"
+	opsGuardrailBodyKey = "ops_guardrail_body"
 )
 
 const (
@@ -257,6 +262,10 @@ func setOpsRequestContext(c *gin.Context, model string, stream bool, requestBody
 	}
 	c.Set(opsModelKey, model)
 	c.Set(opsStreamKey, stream)
+	// 内容护栏命中时只记录脱敏后的请求体
+	if v, ok := c.Get(opsGuardrailBodyKey); ok {
+		requestBody, _ = v.([]byte)
+	}
 	if len(requestBody) > 0 {
 		c.Set(opsRequestBodyKey, requestBody)
 	}
@@ -319,6 +328,9 @@ func OpsErrorLoggerMiddleware(ops *service.OpsService) gin.HandlerFunc {
 
 		status := c.Writer.Status()
 		if status < 400 {
+			// 内容护栏 mask/flag 命中单独记录（请求本身成功）
+			recordOpsGuardrailHits(c, ops, status)
+
 			// Even when the client request succeeds, we still want to persist upstream error attempts
 			// (retries/failover) so ops can observe upstream instability that gets "covered" by retries.
 			var events []*service.OpsUpstreamErrorEvent
@@ -471,6 +483,7 @@ func OpsErrorLoggerMiddleware(ops *service.OpsService) gin.HandlerFunc {
 			entry := &service.OpsInsertErrorLogInput{
 				RequestID:       requestID,
 				ClientRequestID: clientRequestID,
+				TraceID:         tracing.TraceID(c.Request.Context()),
 
 				AccountID: accountID,
 				Platform:  platform,
@@ -601,6 +614,7 @@ func OpsErrorLoggerMiddleware(ops *service.OpsService) gin.HandlerFunc {
 		entry := &service.OpsInsertErrorLogInput{
 			RequestID:       requestID,
 			ClientRequestID: clientRequestID,
+			TraceID:         tracing.TraceID(c.Request.Context()),
 
 			AccountID: accountID,
 			Platform:  platform,
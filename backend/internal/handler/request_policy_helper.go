@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// applyRequestPolicies 在转发前执行分组请求参数策略
+//
+// 返回执行策略后的请求体与是否改写；请求被 reject 策略拒绝时返回 *service.RequestPolicyViolationError。
+// anthropic-beta 相关策略会直接改写 c.Request.Header。
+func applyRequestPolicies(c *gin.Context, svc *service.RequestPolicyService, apiKey *service.APIKey, protocol string, body []byte) ([]byte, bool, error) {
+	if svc == nil {
+		return body, false, nil
+	}
+	var groupID int64
+	if apiKey != nil && apiKey.Group != nil {
+		groupID = apiKey.Group.ID
+	}
+	result, err := svc.Apply(groupID, protocol, body, c.Request.Header)
+	if err != nil {
+		return body, false, err
+	}
+	return result.Body, result.Modified, nil
+}
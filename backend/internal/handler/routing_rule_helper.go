@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// applyRoutingRules 按分组路由规则将请求转入目标分组或账号子集
+//
+// 命中时返回绑定路由后分组的 apiKey 副本（后续按该分组计费），并把该分组写入请求上下文供调度器读取账号子集。
+// 订阅分组的请求只会限定本分组内的账号子集，不会转入其他分组，订阅计费不受影响。
+func applyRoutingRules(c *gin.Context, svc *service.RoutingRuleService, apiKey *service.APIKey, body []byte) *service.APIKey {
+	if svc == nil || apiKey == nil || apiKey.Group == nil {
+		return apiKey
+	}
+	decision := svc.Route(c.Request.Context(), apiKey.Group, body)
+	if decision == nil {
+		return apiKey
+	}
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.Group, decision.Group))
+	return cloneAPIKeyWithGroup(apiKey, decision.Group)
+}
+
+// applyTargetGroupRules 请求转入其他分组（客户端降级或路由规则）后，按新分组再执行一遍请求参数策略与内容护栏
+//
+// 原分组的策略与护栏已在转入前执行，转入后两个分组的规则都生效；分组未变化时原样返回。
+// policies 为 nil 时只执行护栏（如 Gemini 原生接口）。
+func applyTargetGroupRules(c *gin.Context, policies *service.RequestPolicyService, guardrails *service.GuardrailService, originalGroupID int64, apiKey *service.APIKey, protocol string, body []byte) ([]byte, bool, error) {
+	if apiKey == nil || apiKey.Group == nil || apiKey.Group.ID == originalGroupID {
+		return body, false, nil
+	}
+	policyBody, policyModified, err := applyRequestPolicies(c, policies, apiKey, protocol, body)
+	if err != nil {
+		return body, false, err
+	}
+	guardedBody, guarded, err := applyGuardrails(c, guardrails, apiKey, policyBody)
+	if err != nil {
+		return body, false, err
+	}
+	return guardedBody, policyModified || guarded, nil
+}
+
+// groupIDOf 返回 apiKey 绑定分组的 ID，未绑定时返回 0
+func groupIDOf(apiKey *service.APIKey) int64 {
+	if apiKey == nil || apiKey.Group == nil {
+		return 0
+	}
+	return apiKey.Group.ID
+}
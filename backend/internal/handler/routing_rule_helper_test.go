@@ -0,0 +1,58 @@
+//go:build unit
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApplyTargetGroupRules 验证请求转入其他分组后按目标分组再执行护栏，分组未变化时不重复执行
+func TestApplyTargetGroupRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	guardrails := service.NewGuardrailService(&geminiGuardrailRepoStub{rules: []*model.GuardrailRule{
+		{ID: 1, Name: "source mask", Enabled: true, GroupIDs: []int64{1}, MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionMask, Patterns: []string{"secret"}},
+		{ID: 2, Name: "target block", Enabled: true, GroupIDs: []int64{9}, MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionBlock, Patterns: []string{"forbidden"}},
+		{ID: 3, Name: "target mask", Enabled: true, GroupIDs: []int64{9}, MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionMask, Patterns: []string{"internal"}},
+	}}, nil)
+	source := &service.APIKey{ID: 1, Group: &service.Group{ID: 1, Platform: service.PlatformAnthropic}}
+	target := cloneAPIKeyWithGroup(source, &service.Group{ID: 9, Platform: service.PlatformAnthropic})
+	newContext := func() *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader("{}"))
+		return c
+	}
+
+	// 分组未变化：目标分组规则不执行
+	body := []byte(`{"messages":[{"role":"user","content":"a forbidden internal note"}]}`)
+	out, modified, err := applyTargetGroupRules(newContext(), nil, guardrails, 1, source, model.RequestProtocolAnthropic, body)
+	require.NoError(t, err)
+	require.False(t, modified)
+	require.Equal(t, body, out)
+
+	// 转入目标分组：目标分组的拦截规则生效
+	_, _, err = applyTargetGroupRules(newContext(), nil, guardrails, 1, target, model.RequestProtocolAnthropic, body)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "target block")
+
+	// 两个分组的命中记录累加
+	c := newContext()
+	body, guarded, err := applyGuardrails(c, guardrails, source, []byte(`{"messages":[{"role":"user","content":"secret and internal"}]}`))
+	require.NoError(t, err)
+	require.True(t, guarded)
+	out, modified, err = applyTargetGroupRules(c, nil, guardrails, 1, target, model.RequestProtocolAnthropic, body)
+	require.NoError(t, err)
+	require.True(t, modified)
+	require.NotContains(t, string(out), "secret")
+	require.NotContains(t, string(out), "internal")
+	hits, ok := c.Get(opsGuardrailHitsKey)
+	require.True(t, ok)
+	require.Len(t, hits, 2)
+}
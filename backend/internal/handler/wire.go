@@ -28,6 +28,16 @@ func ProvideAdminHandlers(
 	usageHandler *admin.UsageHandler,
 	userAttributeHandler *admin.UserAttributeHandler,
 	errorPassthroughHandler *admin.ErrorPassthroughHandler,
+	modelPriceHandler *admin.ModelPriceHandler,
+	requestPolicyHandler *admin.RequestPolicyHandler,
+	guardrailHandler *admin.GuardrailHandler,
+	clientRuleHandler *admin.ClientRuleHandler,
+	routingRuleHandler *admin.RoutingRuleHandler,
+	clusterHandler *admin.ClusterHandler,
+	configSyncHandler *admin.ConfigSyncHandler,
+	backupHandler *admin.BackupHandler,
+	oneAPIImportHandler *admin.OneAPIImportHandler,
+	configReloadHandler *admin.ConfigReloadHandler,
 ) *AdminHandlers {
 	return &AdminHandlers{
 		Dashboard:        dashboardHandler,
@@ -49,6 +59,16 @@ func ProvideAdminHandlers(
 		Usage:            usageHandler,
 		UserAttribute:    userAttributeHandler,
 		ErrorPassthrough: errorPassthroughHandler,
+		ModelPrice:       modelPriceHandler,
+		RequestPolicy:    requestPolicyHandler,
+		Guardrail:        guardrailHandler,
+		ClientRule:       clientRuleHandler,
+		RoutingRule:      routingRuleHandler,
+		Cluster:          clusterHandler,
+		ConfigSync:       configSyncHandler,
+		Backup:           backupHandler,
+		OneAPIImport:     oneAPIImportHandler,
+		ConfigReload:     configReloadHandler,
 	}
 }
 
@@ -74,22 +94,26 @@ func ProvideHandlers(
 	adminHandlers *AdminHandlers,
 	gatewayHandler *GatewayHandler,
 	openaiGatewayHandler *OpenAIGatewayHandler,
+	compatibleGatewayHandler *CompatibleGatewayHandler,
 	settingHandler *SettingHandler,
 	totpHandler *TotpHandler,
+	healthHandler *HealthHandler,
 ) *Handlers {
 	return &Handlers{
-		Auth:          authHandler,
-		User:          userHandler,
-		APIKey:        apiKeyHandler,
-		Usage:         usageHandler,
-		Redeem:        redeemHandler,
-		Subscription:  subscriptionHandler,
-		Announcement:  announcementHandler,
-		Admin:         adminHandlers,
-		Gateway:       gatewayHandler,
-		OpenAIGateway: openaiGatewayHandler,
-		Setting:       settingHandler,
-		Totp:          totpHandler,
+		Auth:              authHandler,
+		User:              userHandler,
+		APIKey:            apiKeyHandler,
+		Usage:             usageHandler,
+		Redeem:            redeemHandler,
+		Subscription:      subscriptionHandler,
+		Announcement:      announcementHandler,
+		Admin:             adminHandlers,
+		Gateway:           gatewayHandler,
+		OpenAIGateway:     openaiGatewayHandler,
+		CompatibleGateway: compatibleGatewayHandler,
+		Setting:           settingHandler,
+		Totp:              totpHandler,
+		Health:            healthHandler,
 	}
 }
 
@@ -105,7 +129,9 @@ var ProviderSet = wire.NewSet(
 	NewAnnouncementHandler,
 	NewGatewayHandler,
 	NewOpenAIGatewayHandler,
+	NewCompatibleGatewayHandler,
 	NewTotpHandler,
+	NewHealthHandler,
 	ProvideSettingHandler,
 
 	// Admin handlers
@@ -128,6 +154,16 @@ var ProviderSet = wire.NewSet(
 	admin.NewUsageHandler,
 	admin.NewUserAttributeHandler,
 	admin.NewErrorPassthroughHandler,
+	admin.NewModelPriceHandler,
+	admin.NewRequestPolicyHandler,
+	admin.NewGuardrailHandler,
+	admin.NewClientRuleHandler,
+	admin.NewRoutingRuleHandler,
+	admin.NewConfigSyncHandler,
+	admin.NewBackupHandler,
+	admin.NewOneAPIImportHandler,
+	admin.NewClusterHandler,
+	admin.NewConfigReloadHandler,
 
 	// AdminHandlers and Handlers constructors
 	ProvideAdminHandlers,
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/memredis"
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 )
@@ -38,6 +39,30 @@ end
 return {current, repaired}
 `)
 
+// 内置缓存后端（redis.mode=embedded）使用的等价 Go 实现
+func init() {
+	memredis.RegisterScript(rateLimitScript.Hash(), func(call memredis.CallFunc, keys, args []string) (any, error) {
+		current, err := memredis.CallInt(call, "INCR", keys[0])
+		if err != nil {
+			return nil, err
+		}
+		ttl, err := memredis.CallInt(call, "PTTL", keys[0])
+		if err != nil {
+			return nil, err
+		}
+		repaired := int64(0)
+		if current == 1 || ttl == -1 {
+			if _, err := call("PEXPIRE", keys[0], args[0]); err != nil {
+				return nil, err
+			}
+			if current != 1 {
+				repaired = 1
+			}
+		}
+		return []any{current, repaired}, nil
+	})
+}
+
 // rateLimitRun 允许测试覆写脚本执行逻辑
 var rateLimitRun = func(ctx context.Context, client *redis.Client, key string, windowMillis int64) (int64, bool, error) {
 	values, err := rateLimitScript.Run(ctx, client, []string{key}, windowMillis).Slice()
@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/memredis"
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
@@ -91,6 +92,15 @@ func performRequest(router *gin.Engine) *httptest.ResponseRecorder {
 
 func startRedis(t *testing.T, ctx context.Context) *redis.Client {
 	t.Helper()
+	if os.Getenv("INTEGRATION_REDIS_BACKEND") == "embedded" {
+		srv := memredis.New()
+		rdb := redis.NewClient(&redis.Options{Addr: "embedded", Dialer: srv.Dial})
+		t.Cleanup(func() {
+			_ = rdb.Close()
+			_ = srv.Close()
+		})
+		return rdb
+	}
 	ensureDockerAvailable(t)
 
 	redisContainer, err := tcredis.Run(ctx, redisImageTag)
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ClientRule 分组客户端准入规则
+// claude_code_only 的通用化版本：分组命中任一规则即放行，全部不满足时转入降级分组或拒绝
+type ClientRule struct {
+	ID                    int64     `json:"id"`
+	Name                  string    `json:"name"`                     // 规则名称
+	Enabled               bool      `json:"enabled"`                  // 是否启用
+	Priority              int       `json:"priority"`                 // 优先级（数字越小越先匹配）
+	GroupIDs              []int64   `json:"group_ids"`                // 适用分组，空表示所有分组
+	Preset                string    `json:"preset"`                   // 内置预设，空表示仅自定义匹配
+	UserAgentPattern      *string   `json:"user_agent_pattern"`       // User-Agent 正则
+	RequiredHeaders       []string  `json:"required_headers"`         // 必需请求头（"Name" 或 "Name: 正则"）
+	MetadataUserIDPattern *string   `json:"metadata_user_id_pattern"` // metadata.user_id 正则
+	BodyFields            []string  `json:"body_fields"`              // 请求体必需字段（gjson 路径）
+	FallbackGroupID       *int64    `json:"fallback_group_id"`        // 降级分组
+	Description           *string   `json:"description"`              // 规则描述
+	RejectedCount         int64     `json:"rejected_count"`           // 被拒绝/降级的请求数（来自 Redis 计数器）
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// 客户端预设常量
+const (
+	ClientPresetClaudeCode = "claude_code"
+	ClientPresetCodexCLI   = "codex_cli"
+	ClientPresetGeminiCLI  = "gemini_cli"
+)
+
+// AllClientPresets 返回所有支持的客户端预设
+func AllClientPresets() []string {
+	return []string{ClientPresetClaudeCode, ClientPresetCodexCLI, ClientPresetGeminiCLI}
+}
+
+// SplitRequiredHeader 解析必需请求头配置，返回请求头名称与可选的值正则
+func SplitRequiredHeader(entry string) (name, pattern string) {
+	name, pattern, _ = strings.Cut(entry, ":")
+	return strings.TrimSpace(name), strings.TrimSpace(pattern)
+}
+
+// Validate 验证规则配置的有效性
+func (r *ClientRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if r.Preset != "" {
+		valid := false
+		for _, p := range AllClientPresets() {
+			if r.Preset == p {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return &ValidationError{Field: "preset", Message: "unsupported preset: " + r.Preset}
+		}
+	}
+
+	hasCustom := false
+	if r.UserAgentPattern != nil && strings.TrimSpace(*r.UserAgentPattern) != "" {
+		if _, err := regexp.Compile(*r.UserAgentPattern); err != nil {
+			return &ValidationError{Field: "user_agent_pattern", Message: "invalid regex: " + err.Error()}
+		}
+		hasCustom = true
+	}
+	if r.MetadataUserIDPattern != nil && strings.TrimSpace(*r.MetadataUserIDPattern) != "" {
+		if _, err := regexp.Compile(*r.MetadataUserIDPattern); err != nil {
+			return &ValidationError{Field: "metadata_user_id_pattern", Message: "invalid regex: " + err.Error()}
+		}
+		hasCustom = true
+	}
+	for _, entry := range nonEmptyStrings(r.RequiredHeaders) {
+		name, pattern := SplitRequiredHeader(entry)
+		if name == "" {
+			return &ValidationError{Field: "required_headers", Message: "header name is required: " + entry}
+		}
+		if pattern != "" {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return &ValidationError{Field: "required_headers", Message: "invalid regex for " + name + ": " + err.Error()}
+			}
+		}
+		hasCustom = true
+	}
+	if len(nonEmptyStrings(r.BodyFields)) > 0 {
+		hasCustom = true
+	}
+	if r.Preset == "" && !hasCustom {
+		return &ValidationError{Field: "preset", Message: "a preset or at least one custom matcher is required"}
+	}
+
+	if r.FallbackGroupID != nil && *r.FallbackGroupID <= 0 {
+		return &ValidationError{Field: "fallback_group_id", Message: "fallback_group_id must be greater than 0"}
+	}
+	for _, id := range r.GroupIDs {
+		if r.FallbackGroupID != nil && id == *r.FallbackGroupID {
+			return &ValidationError{Field: "fallback_group_id", Message: "fallback group cannot be one of the rule's groups"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// GuardrailRule 内容护栏规则
+// 在转发前扫描请求文本，命中时拦截、脱敏或仅标记
+type GuardrailRule struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`          // 规则名称
+	Enabled      bool      `json:"enabled"`       // 是否启用
+	Priority     int       `json:"priority"`      // 优先级（数字越小越先执行）
+	GroupIDs     []int64   `json:"group_ids"`     // 适用分组，空表示所有分组
+	MatchType    string    `json:"match_type"`    // keyword / regex / pii
+	Patterns     []string  `json:"patterns"`      // 关键词/正则列表，pii 时为检测器名称
+	Action       string    `json:"action"`        // block / mask / flag
+	BlockMessage *string   `json:"block_message"` // 拦截时的错误信息
+	Description  *string   `json:"description"`   // 规则描述
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// 匹配方式常量
+const (
+	GuardrailMatchKeyword = "keyword"
+	GuardrailMatchRegex   = "regex"
+	GuardrailMatchPII     = "pii"
+)
+
+// 处理动作常量
+const (
+	GuardrailActionBlock = "block"
+	GuardrailActionMask  = "mask"
+	GuardrailActionFlag  = "flag"
+)
+
+// PII 检测器常量
+const (
+	PIIEmail  = "email"
+	PIIPhone  = "phone"
+	PIICard   = "card"
+	PIIAPIKey = "api_key"
+)
+
+// AllPIITypes 返回所有支持的 PII 检测器
+func AllPIITypes() []string {
+	return []string{PIIEmail, PIIPhone, PIICard, PIIAPIKey}
+}
+
+// Validate 验证规则配置的有效性
+func (r *GuardrailRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	switch r.Action {
+	case GuardrailActionBlock, GuardrailActionMask, GuardrailActionFlag:
+	default:
+		return &ValidationError{Field: "action", Message: "action must be 'block', 'mask' or 'flag'"}
+	}
+
+	patterns := nonEmptyStrings(r.Patterns)
+	if len(patterns) == 0 {
+		return &ValidationError{Field: "patterns", Message: "at least one pattern is required"}
+	}
+	switch r.MatchType {
+	case GuardrailMatchKeyword:
+	case GuardrailMatchRegex:
+		for _, p := range patterns {
+			if _, err := regexp.Compile(p); err != nil {
+				return &ValidationError{Field: "patterns", Message: "invalid regex '" + p + "': " + err.Error()}
+			}
+		}
+	case GuardrailMatchPII:
+		for _, p := range patterns {
+			if !isValidPIIType(p) {
+				return &ValidationError{Field: "patterns", Message: "unsupported pii type: " + p}
+			}
+		}
+	default:
+		return &ValidationError{Field: "match_type", Message: "match_type must be 'keyword', 'regex' or 'pii'"}
+	}
+	return nil
+}
+
+func isValidPIIType(value string) bool {
+	value = strings.TrimSpace(value)
+	for _, t := range AllPIITypes() {
+		if value == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// ModelPrice 管理员维护的模型价格覆盖
+// 价格单位为 USD / 百万 token（ImagePrice 为 USD / 张），计费时优先于 LiteLLM 动态价格
+type ModelPrice struct {
+	ID              int64     `json:"id"`
+	Model           string    `json:"model"`             // 模型名称，支持末尾 * 通配
+	GroupID         *int64    `json:"group_id"`          // 限定分组（为空表示不限分组）
+	Platform        string    `json:"platform"`          // 限定平台（为空表示全部平台）
+	InputPrice      float64   `json:"input_price"`       // 输入价格
+	OutputPrice     float64   `json:"output_price"`      // 输出价格
+	CacheWritePrice float64   `json:"cache_write_price"` // 缓存写入价格
+	CacheReadPrice  float64   `json:"cache_read_price"`  // 缓存读取价格
+	ImagePrice      *float64  `json:"image_price"`       // 单张图片价格（为空表示沿用默认）
+	EffectiveFrom   time.Time `json:"effective_from"`    // 生效时间
+	Enabled         bool      `json:"enabled"`           // 是否启用
+	Description     *string   `json:"description"`       // 描述
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Validate 验证价格配置的有效性
+func (p *ModelPrice) Validate() error {
+	p.Model = strings.TrimSpace(p.Model)
+	if p.Model == "" {
+		return &ValidationError{Field: "model", Message: "model is required"}
+	}
+	if i := strings.Index(p.Model, "*"); i >= 0 && i != len(p.Model)-1 {
+		return &ValidationError{Field: "model", Message: "wildcard * is only allowed at the end"}
+	}
+	if p.GroupID != nil && *p.GroupID <= 0 {
+		return &ValidationError{Field: "group_id", Message: "group_id must be positive"}
+	}
+	if p.Platform != "" {
+		valid := false
+		for _, platform := range AllPlatforms() {
+			if p.Platform == platform {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return &ValidationError{Field: "platform", Message: "unsupported platform"}
+		}
+	}
+	if p.InputPrice < 0 || p.OutputPrice < 0 || p.CacheWritePrice < 0 || p.CacheReadPrice < 0 {
+		return &ValidationError{Field: "price", Message: "prices must not be negative"}
+	}
+	if p.ImagePrice != nil && *p.ImagePrice < 0 {
+		return &ValidationError{Field: "image_price", Message: "image_price must not be negative"}
+	}
+	if p.EffectiveFrom.IsZero() {
+		return &ValidationError{Field: "effective_from", Message: "effective_from is required"}
+	}
+	return nil
+}
+
+// MatchesModel 检查价格是否适用于指定模型（不区分大小写）
+// 返回是否匹配以及是否为精确匹配
+func (p *ModelPrice) MatchesModel(model string) (matched bool, exact bool) {
+	pattern := strings.ToLower(p.Model)
+	model = strings.ToLower(model)
+	if pattern == model {
+		return true, true
+	}
+	if strings.HasSuffix(pattern, "*") && strings.HasPrefix(model, strings.TrimSuffix(pattern, "*")) {
+		return true, false
+	}
+	return false, false
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// RequestPolicy 分组请求参数策略
+// 在转发前校验/改写客户端请求参数
+type RequestPolicy struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`           // 策略名称
+	Enabled       bool      `json:"enabled"`        // 是否启用
+	Priority      int       `json:"priority"`       // 优先级（数字越小越先执行）
+	GroupIDs      []int64   `json:"group_ids"`      // 适用分组，空表示所有分组
+	Protocols     []string  `json:"protocols"`      // 适用请求协议，空表示所有协议
+	Kind          string    `json:"kind"`           // 策略类型
+	Action        string    `json:"action"`         // reject / modify / warn
+	DryRun        bool      `json:"dry_run"`        // 试运行：仅记录日志
+	LimitValue    *int      `json:"limit_value"`    // 数值上限（max_tokens / thinking_budget）
+	Params        []string  `json:"params"`         // 字符串参数（beta 标记 / 工具类型 / effort）
+	RejectMessage *string   `json:"reject_message"` // 拒绝时的错误信息
+	Description   *string   `json:"description"`    // 策略描述
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// 请求协议常量
+const (
+	RequestProtocolAnthropic = "anthropic"
+	RequestProtocolOpenAI    = "openai"
+)
+
+// 策略类型常量
+const (
+	// PolicyKindMaxTokens 限制最大输出 token（max_tokens / max_output_tokens / max_completion_tokens）
+	PolicyKindMaxTokens = "max_tokens"
+	// PolicyKindThinkingBudget 限制 thinking.budget_tokens
+	PolicyKindThinkingBudget = "thinking_budget"
+	// PolicyKindAnthropicBetaDeny 禁止指定的 anthropic-beta 标记
+	PolicyKindAnthropicBetaDeny = "anthropic_beta_deny"
+	// PolicyKindAnthropicBetaForce 强制携带指定的 anthropic-beta 标记
+	PolicyKindAnthropicBetaForce = "anthropic_beta_force"
+	// PolicyKindServerTools 禁用指定类型的服务端工具（按类型前缀匹配，如 web_search）
+	PolicyKindServerTools = "server_tools"
+	// PolicyKindReasoningEffort 强制 OpenAI reasoning effort
+	PolicyKindReasoningEffort = "reasoning_effort"
+	// PolicyKindImageInput 禁止图片输入
+	PolicyKindImageInput = "image_input"
+)
+
+// 策略动作常量
+const (
+	PolicyActionReject = "reject"
+	PolicyActionModify = "modify"
+	PolicyActionWarn   = "warn"
+)
+
+// AllPolicyKinds 返回所有支持的策略类型
+func AllPolicyKinds() []string {
+	return []string{
+		PolicyKindMaxTokens,
+		PolicyKindThinkingBudget,
+		PolicyKindAnthropicBetaDeny,
+		PolicyKindAnthropicBetaForce,
+		PolicyKindServerTools,
+		PolicyKindReasoningEffort,
+		PolicyKindImageInput,
+	}
+}
+
+// Validate 验证策略配置的有效性
+func (p *RequestPolicy) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	switch p.Action {
+	case PolicyActionReject, PolicyActionModify, PolicyActionWarn:
+	default:
+		return &ValidationError{Field: "action", Message: "action must be 'reject', 'modify' or 'warn'"}
+	}
+	for _, protocol := range p.Protocols {
+		if protocol != RequestProtocolAnthropic && protocol != RequestProtocolOpenAI {
+			return &ValidationError{Field: "protocols", Message: "protocols must be 'anthropic' or 'openai'"}
+		}
+	}
+
+	switch p.Kind {
+	case PolicyKindMaxTokens, PolicyKindThinkingBudget:
+		if p.LimitValue == nil || *p.LimitValue <= 0 {
+			return &ValidationError{Field: "limit_value", Message: "limit_value must be greater than 0"}
+		}
+	case PolicyKindAnthropicBetaDeny, PolicyKindAnthropicBetaForce, PolicyKindServerTools:
+		if len(nonEmptyStrings(p.Params)) == 0 {
+			return &ValidationError{Field: "params", Message: "at least one param is required"}
+		}
+	case PolicyKindReasoningEffort:
+		if len(nonEmptyStrings(p.Params)) != 1 {
+			return &ValidationError{Field: "params", Message: "exactly one reasoning effort is required"}
+		}
+	case PolicyKindImageInput:
+		// 图片无法安全地从消息中剔除，只支持拒绝或告警
+		if p.Action == PolicyActionModify {
+			return &ValidationError{Field: "action", Message: "image_input only supports 'reject' or 'warn'"}
+		}
+	default:
+		return &ValidationError{Field: "kind", Message: "unsupported kind: " + p.Kind}
+	}
+	return nil
+}
+
+func nonEmptyStrings(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// RoutingRule 分组请求路由规则
+// 将满足条件的廉价/后台请求（如 haiku 标题生成、max_tokens=1 探测）转入其他分组或账号子集，
+// 使昂贵账号只承接真实负载；转入其他分组时按目标分组计费
+type RoutingRule struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`               // 规则名称
+	Enabled          bool      `json:"enabled"`            // 是否启用
+	Priority         int       `json:"priority"`           // 优先级（数字越小越先匹配，命中即停止）
+	GroupIDs         []int64   `json:"group_ids"`          // 适用分组，空表示所有分组
+	ModelPatterns    []string  `json:"model_patterns"`     // 模型匹配（精确或末尾 * 通配），空表示不限
+	MaxTokensBelow   *int      `json:"max_tokens_below"`   // max_tokens 小于该值时匹配
+	RequireNoTools   bool      `json:"require_no_tools"`   // 仅匹配未携带工具定义的请求
+	InputTokensBelow *int      `json:"input_tokens_below"` // 估算输入 token 小于该值时匹配
+	TargetGroupID    *int64    `json:"target_group_id"`    // 目标分组（用于调度与计费）
+	TargetAccountIDs []int64   `json:"target_account_ids"` // 目标账号子集（在目标分组内严格限定调度范围）
+	Description      *string   `json:"description"`        // 规则描述
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// Validate 验证规则配置的有效性
+func (r *RoutingRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+
+	hasCondition := len(nonEmptyStrings(r.ModelPatterns)) > 0 || r.RequireNoTools
+	if r.MaxTokensBelow != nil {
+		if *r.MaxTokensBelow <= 0 {
+			return &ValidationError{Field: "max_tokens_below", Message: "max_tokens_below must be greater than 0"}
+		}
+		hasCondition = true
+	}
+	if r.InputTokensBelow != nil {
+		if *r.InputTokensBelow <= 0 {
+			return &ValidationError{Field: "input_tokens_below", Message: "input_tokens_below must be greater than 0"}
+		}
+		hasCondition = true
+	}
+	// 没有任何条件的规则会接管分组内所有请求，应直接修改 API Key 分组
+	if !hasCondition {
+		return &ValidationError{Field: "model_patterns", Message: "at least one condition is required"}
+	}
+
+	if r.TargetGroupID == nil && len(r.TargetAccountIDs) == 0 {
+		return &ValidationError{Field: "target_group_id", Message: "a target group or target accounts are required"}
+	}
+	if r.TargetGroupID != nil {
+		if *r.TargetGroupID <= 0 {
+			return &ValidationError{Field: "target_group_id", Message: "target_group_id must be greater than 0"}
+		}
+		for _, id := range r.GroupIDs {
+			if id == *r.TargetGroupID {
+				return &ValidationError{Field: "target_group_id", Message: "target group cannot be one of the rule's groups"}
+			}
+		}
+	}
+	for _, id := range r.TargetAccountIDs {
+		if id <= 0 {
+			return &ValidationError{Field: "target_account_ids", Message: "account IDs must be greater than 0"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Package backup 实现全量备份归档格式：带版本的 gzip JSON Lines 流，可选口令加密。
+//
+// 文件布局：
+//
+//	magic(8) | version(1) | flags(1) | [salt(16) | nonce prefix(7)] | payload
+//
+// payload 为 gzip 压缩的 JSON Lines：首行是 manifest，随后每行一条表记录，末行是各表行数（trailer），
+// 用于在恢复时发现截断。加密时 payload 以 64KB 为单位经 AES-256-GCM 分块加密，密钥由 scrypt 从口令派生。
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// FormatVersion 当前归档格式版本
+const FormatVersion = 1
+
+const (
+	magic         = "S2ABAKUP"
+	flagEncrypted = 1 << 0
+)
+
+var (
+	// ErrNotArchive 输入不是备份归档
+	ErrNotArchive = errors.New("backup: not a sub2api backup archive")
+	// ErrUnsupportedVersion 归档格式版本不受支持
+	ErrUnsupportedVersion = errors.New("backup: unsupported archive format version")
+	// ErrPassphraseRequired 归档已加密但未提供口令
+	ErrPassphraseRequired = errors.New("backup: archive is encrypted, passphrase required")
+	// ErrInvalidPassphrase 口令错误
+	ErrInvalidPassphrase = errors.New("backup: invalid passphrase")
+	// ErrCorrupted 归档损坏或被截断
+	ErrCorrupted = errors.New("backup: archive is corrupted")
+)
+
+// UsageRange 用量记录的时间范围（左闭右开），字段为空表示不限
+type UsageRange struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+// Manifest 归档元信息
+type Manifest struct {
+	FormatVersion int       `json:"format_version"`
+	SchemaVersion string    `json:"schema_version"`
+	AppVersion    string    `json:"app_version,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	Encrypted     bool      `json:"encrypted"`
+	// Tables 归档包含的表（按写入顺序）
+	Tables []string `json:"tables"`
+	// UsageLogs 是否包含用量记录，以及包含的时间范围
+	UsageLogs  bool        `json:"usage_logs"`
+	UsageRange *UsageRange `json:"usage_range,omitempty"`
+}
+
+type record struct {
+	Manifest *Manifest       `json:"manifest,omitempty"`
+	Table    string          `json:"table,omitempty"`
+	Row      json.RawMessage `json:"row,omitempty"`
+	Trailer  map[string]int  `json:"trailer,omitempty"`
+}
+
+// Writer 备份归档写入器
+type Writer struct {
+	enc    *encryptWriter
+	gz     *gzip.Writer
+	buf    *bufio.Writer
+	json   *json.Encoder
+	counts map[string]int
+}
+
+// NewWriter 写入文件头与 manifest；passphrase 非空时加密归档
+func NewWriter(w io.Writer, manifest Manifest, passphrase string) (*Writer, error) {
+	header := []byte(magic)
+	header = append(header, FormatVersion, 0)
+
+	out := w
+	var enc *encryptWriter
+	if passphrase != "" {
+		header[len(header)-1] |= flagEncrypted
+		salt := make([]byte, saltSize+noncePrefixSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(passphrase, salt[:saltSize])
+		if err != nil {
+			return nil, err
+		}
+		header = append(header, salt...)
+		enc = newEncryptWriter(w, aead, salt[saltSize:])
+		out = enc
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(out)
+	buf := bufio.NewWriterSize(gz, 256<<10)
+	bw := &Writer{enc: enc, gz: gz, buf: buf, json: json.NewEncoder(buf), counts: map[string]int{}}
+
+	manifest.FormatVersion = FormatVersion
+	manifest.Encrypted = passphrase != ""
+	if err := bw.json.Encode(record{Manifest: &manifest}); err != nil {
+		return nil, err
+	}
+	return bw, nil
+}
+
+// WriteRow 写入一条表记录（行为 JSON 对象）
+func (w *Writer) WriteRow(table string, row json.RawMessage) error {
+	w.counts[table]++
+	return w.json.Encode(record{Table: table, Row: row})
+}
+
+// Counts 已写入的各表行数
+func (w *Writer) Counts() map[string]int {
+	return w.counts
+}
+
+// Close 写入 trailer 并刷新所有缓冲；不关闭底层 writer
+func (w *Writer) Close() error {
+	if err := w.json.Encode(record{Trailer: w.counts}); err != nil {
+		return err
+	}
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if err := w.gz.Close(); err != nil {
+		return err
+	}
+	if w.enc != nil {
+		return w.enc.Close()
+	}
+	return nil
+}
+
+// Reader 备份归档读取器
+type Reader struct {
+	manifest Manifest
+	dec      *json.Decoder
+	counts   map[string]int
+	done     bool
+}
+
+// NewReader 读取文件头与 manifest；归档加密时需提供口令
+func NewReader(r io.Reader, passphrase string) (*Reader, error) {
+	header := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:len(magic)]) != magic {
+		return nil, ErrNotArchive
+	}
+	if header[len(magic)] != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header[len(magic)])
+	}
+
+	in := r
+	if header[len(magic)+1]&flagEncrypted != 0 {
+		if passphrase == "" {
+			return nil, ErrPassphraseRequired
+		}
+		salt := make([]byte, saltSize+noncePrefixSize)
+		if _, err := io.ReadFull(r, salt); err != nil {
+			return nil, ErrCorrupted
+		}
+		aead, err := newAEAD(passphrase, salt[:saltSize])
+		if err != nil {
+			return nil, err
+		}
+		in = newDecryptReader(r, aead, salt[saltSize:])
+	}
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPassphrase) || errors.Is(err, ErrCorrupted) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+	dec := json.NewDecoder(gz)
+	dec.UseNumber()
+
+	var first record
+	if err := dec.Decode(&first); err != nil || first.Manifest == nil {
+		return nil, fmt.Errorf("%w: missing manifest", ErrCorrupted)
+	}
+	return &Reader{manifest: *first.Manifest, dec: dec, counts: map[string]int{}}, nil
+}
+
+// Manifest 归档元信息
+func (r *Reader) Manifest() Manifest {
+	return r.manifest
+}
+
+// Next 读取下一条表记录；读完并校验 trailer 后返回 io.EOF
+func (r *Reader) Next() (string, json.RawMessage, error) {
+	if r.done {
+		return "", nil, io.EOF
+	}
+	var rec record
+	if err := r.dec.Decode(&rec); err != nil {
+		if errors.Is(err, ErrInvalidPassphrase) || errors.Is(err, ErrCorrupted) {
+			return "", nil, err
+		}
+		return "", nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+	if rec.Trailer != nil {
+		r.done = true
+		for table, want := range rec.Trailer {
+			if r.counts[table] != want {
+				return "", nil, fmt.Errorf("%w: table %s has %d rows, trailer says %d", ErrCorrupted, table, r.counts[table], want)
+			}
+		}
+		if len(rec.Trailer) != len(r.counts) {
+			return "", nil, fmt.Errorf("%w: trailer does not match archive contents", ErrCorrupted)
+		}
+		return "", nil, io.EOF
+	}
+	if rec.Table == "" || len(rec.Row) == 0 {
+		return "", nil, fmt.Errorf("%w: malformed record", ErrCorrupted)
+	}
+	r.counts[rec.Table]++
+	return rec.Table, rec.Row, nil
+}
@@ -0,0 +1,108 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeArchive(t *testing.T, passphrase string, rows int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Manifest{
+		SchemaVersion: "064_example.sql",
+		CreatedAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Tables:        []string{"users", "accounts"},
+	}, passphrase)
+	require.NoError(t, err)
+	for i := 0; i < rows; i++ {
+		// 填充较长字段，确保加密流跨越多个分块
+		row := fmt.Sprintf(`{"id":%d,"email":"user%d@example.com","balance":12.345678901234567890,"notes":%q}`, i+1, i, strings.Repeat("x", 200))
+		require.NoError(t, w.WriteRow("users", json.RawMessage(row)))
+	}
+	require.NoError(t, w.WriteRow("accounts", json.RawMessage(`{"id":7,"name":"a"}`)))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func readAll(t *testing.T, r *Reader) map[string][]json.RawMessage {
+	t.Helper()
+	out := map[string][]json.RawMessage{}
+	for {
+		table, row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return out
+		}
+		require.NoError(t, err)
+		out[table] = append(out[table], row)
+	}
+}
+
+func TestArchive_RoundTripPlain(t *testing.T) {
+	data := writeArchive(t, "", 3)
+
+	r, err := NewReader(bytes.NewReader(data), "")
+	require.NoError(t, err)
+	m := r.Manifest()
+	require.Equal(t, FormatVersion, m.FormatVersion)
+	require.Equal(t, "064_example.sql", m.SchemaVersion)
+	require.False(t, m.Encrypted)
+
+	rows := readAll(t, r)
+	require.Len(t, rows["users"], 3)
+	require.Len(t, rows["accounts"], 1)
+	// 数值保持原始精度
+	require.Contains(t, string(rows["users"][0]), "12.345678901234567890")
+}
+
+func TestArchive_RoundTripEncrypted(t *testing.T) {
+	data := writeArchive(t, "s3cret", 1000)
+	require.NotContains(t, string(data), "example.com")
+
+	r, err := NewReader(bytes.NewReader(data), "s3cret")
+	require.NoError(t, err)
+	require.True(t, r.Manifest().Encrypted)
+	rows := readAll(t, r)
+	require.Len(t, rows["users"], 1000)
+}
+
+func TestArchive_EncryptedRequiresPassphrase(t *testing.T) {
+	data := writeArchive(t, "s3cret", 1)
+
+	_, err := NewReader(bytes.NewReader(data), "")
+	require.ErrorIs(t, err, ErrPassphraseRequired)
+
+	_, err = NewReader(bytes.NewReader(data), "wrong")
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+}
+
+func TestArchive_DetectsTruncation(t *testing.T) {
+	for _, passphrase := range []string{"", "s3cret"} {
+		data := writeArchive(t, passphrase, 1000)
+		truncated := data[:len(data)*2/3]
+
+		// 压缩后数据较小时截断可能在读取 manifest 阶段即被发现
+		r, err := NewReader(bytes.NewReader(truncated), passphrase)
+		for err == nil {
+			_, _, err = r.Next()
+		}
+		require.ErrorIs(t, err, ErrCorrupted, "passphrase=%q", passphrase)
+	}
+}
+
+func TestArchive_RejectsForeignInput(t *testing.T) {
+	_, err := NewReader(strings.NewReader("not an archive at all"), "")
+	require.ErrorIs(t, err, ErrNotArchive)
+
+	data := writeArchive(t, "", 1)
+	data[len(magic)] = FormatVersion + 1
+	_, err = NewReader(bytes.NewReader(data), "")
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
@@ -0,0 +1,170 @@
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	saltSize        = 16
+	noncePrefixSize = 7
+	chunkSize       = 64 << 10
+	maxFrameSize    = chunkSize + 64
+
+	// scrypt 参数（交互式推荐值），派生 AES-256 密钥
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce 分块 nonce：随机前缀 + 块序号 + 结束标记，防止块被重排、截断或拼接
+func chunkNonce(prefix []byte, counter uint32, final bool) []byte {
+	nonce := make([]byte, 12)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if final {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// encryptWriter 以 64KB 为单位分块加密（AES-256-GCM），每块前写入 4 字节长度
+type encryptWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+func newEncryptWriter(w io.Writer, aead cipher.AEAD, prefix []byte) *encryptWriter {
+	return &encryptWriter{w: w, aead: aead, prefix: prefix, buf: make([]byte, 0, chunkSize)}
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("backup: write to closed archive")
+	}
+	n := len(p)
+	for len(p) > 0 {
+		take := chunkSize - len(e.buf)
+		if take > len(p) {
+			take = len(p)
+		}
+		e.buf = append(e.buf, p[:take]...)
+		p = p[take:]
+		// 缓冲区满且还有后续数据时才写出，保证最后一块总是由 Close 以结束标记写出
+		if len(e.buf) == chunkSize && len(p) > 0 {
+			if err := e.flush(false); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (e *encryptWriter) flush(final bool) error {
+	if e.counter == ^uint32(0) {
+		return errors.New("backup: archive too large")
+	}
+	sealed := e.aead.Seal(nil, chunkNonce(e.prefix, e.counter, final), e.buf, nil)
+	e.counter++
+	e.buf = e.buf[:0]
+
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(sealed)))
+	if _, err := e.w.Write(size[:]); err != nil {
+		return err
+	}
+	_, err := e.w.Write(sealed)
+	return err
+}
+
+// Close 写出最后一块（带结束标记）
+func (e *encryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.flush(true)
+}
+
+// decryptReader 逐块解密并校验块序号与结束标记
+type decryptReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	done    bool
+}
+
+func newDecryptReader(r io.Reader, aead cipher.AEAD, prefix []byte) *decryptReader {
+	return &decryptReader{r: r, aead: aead, prefix: prefix}
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptReader) next() error {
+	var size [4]byte
+	if _, err := io.ReadFull(d.r, size[:]); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("%w: truncated encrypted stream", ErrCorrupted)
+		}
+		return err
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > maxFrameSize {
+		return fmt.Errorf("%w: invalid chunk size", ErrCorrupted)
+	}
+	sealed := make([]byte, n)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		return fmt.Errorf("%w: truncated encrypted stream", ErrCorrupted)
+	}
+
+	plain, err := d.aead.Open(nil, chunkNonce(d.prefix, d.counter, false), sealed, nil)
+	if err != nil {
+		plain, err = d.aead.Open(nil, chunkNonce(d.prefix, d.counter, true), sealed, nil)
+		if err != nil {
+			if d.counter == 0 {
+				return ErrInvalidPassphrase
+			}
+			return fmt.Errorf("%w: chunk %d failed authentication", ErrCorrupted, d.counter)
+		}
+		d.done = true
+	}
+	d.counter++
+	d.buf = plain
+	return nil
+}
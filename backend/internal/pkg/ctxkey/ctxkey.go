@@ -32,4 +32,12 @@ const (
 	// SingleAccountRetry 标识当前请求处于单账号 503 退避重试模式。
 	// 在此模式下，Service 层的模型限流预检查将等待限流过期而非直接切换账号。
 	SingleAccountRetry Key = "ctx_single_account_retry"
+
+	// ModelFallbackOnContextTooLong 标识当前请求在上下文超长时可切换到分组降级链中的备选模型。
+	// 设置后，Service 层遇到上下文超长错误时返回 PromptTooLongError 交由 Handler 处理，而不是直接写回客户端。
+	ModelFallbackOnContextTooLong Key = "ctx_model_fallback_on_context_too_long"
+
+	// EstimatedInputTokens 网关在选择账号前估算的请求输入 token 数。
+	// 设置后，调度层只会选择上下文容量足以承载该请求的账号（见 Account.GetMaxContextTokens）。
+	EstimatedInputTokens Key = "ctx_estimated_input_tokens"
 )
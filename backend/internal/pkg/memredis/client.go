@@ -0,0 +1,260 @@
+package memredis
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// client 服务端视角的一条连接
+type client struct {
+	srv  *Server
+	conn *memConn
+
+	wmu sync.Mutex
+	w   *bufio.Writer
+
+	// 以下字段只在连接自身的协程中访问
+	db         int
+	inMulti    bool
+	multiDirty bool
+	queued     [][]string
+
+	// 订阅关系由 srv.mu 保护
+	channels map[string]struct{}
+	patterns map[string]struct{}
+}
+
+func (c *client) serve() {
+	defer func() {
+		_ = c.conn.Close()
+		c.srv.removeClient(c)
+	}()
+
+	r := bufio.NewReader(c.conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			if err == errProtocol {
+				c.write(errorReply("ERR Protocol error"), true)
+			}
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+		reply, quit := c.handle(args)
+		c.write(reply, quit || r.Buffered() == 0)
+		if quit {
+			return
+		}
+	}
+}
+
+// write 写出回复；flush 为 false 时等待同批 pipeline 的其余回复一起写出
+func (c *client) write(reply any, flush bool) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if _, ok := reply.(noReply); !ok {
+		writeReply(c.w, reply)
+	}
+	if flush {
+		_ = c.w.Flush()
+	}
+}
+
+// noReply 回复已由命令自行写出（SUBSCRIBE 等多条回复的命令）
+type noReply struct{}
+
+func (c *client) handle(args []string) (any, bool) {
+	name := strings.ToLower(args[0])
+
+	if c.inMulti {
+		switch name {
+		case "exec":
+			return c.execMulti(), false
+		case "discard":
+			c.inMulti, c.multiDirty, c.queued = false, false, nil
+			return simpleString("OK"), false
+		case "multi":
+			return errNestedMulti, false
+		}
+		spec, ok := commands[name]
+		if !ok || !spec.checkArity(len(args)) || spec.noScript {
+			c.multiDirty = true
+			if !ok {
+				return errorReply("ERR unknown command '" + args[0] + "'"), false
+			}
+			return errorReply("ERR wrong number of arguments for '" + name + "' command"), false
+		}
+		c.queued = append(c.queued, args)
+		return simpleString("QUEUED"), false
+	}
+
+	if c.subscribed() {
+		switch name {
+		case "subscribe", "unsubscribe", "psubscribe", "punsubscribe", "ping", "quit", "reset":
+		default:
+			return errorReply("ERR Can't execute '" + name + "': only (P|S)SUBSCRIBE / (P|S)UNSUBSCRIBE / PING / QUIT / RESET are allowed in this context"), false
+		}
+	}
+
+	switch name {
+	case "quit":
+		return simpleString("OK"), true
+	case "hello":
+		// 仅支持 RESP2，客户端收到错误后回退到 RESP2
+		return errorReply("ERR unknown command 'HELLO'"), false
+	case "auth":
+		return simpleString("OK"), false
+	case "client":
+		return c.clientCommand(args[1:]), false
+	case "select":
+		if len(args) != 2 {
+			return errorReply("ERR wrong number of arguments for 'select' command"), false
+		}
+		idx, err := strconv.Atoi(args[1])
+		if err != nil {
+			return errNotInteger, false
+		}
+		if idx < 0 || idx >= maxDatabases {
+			return errDBIndex, false
+		}
+		c.db = idx
+		return simpleString("OK"), false
+	case "multi":
+		c.inMulti = true
+		return simpleString("OK"), false
+	case "exec":
+		return errExecNoMulti, false
+	case "discard":
+		return errDiscardMulti, false
+	case "watch", "unwatch":
+		return simpleString("OK"), false
+	case "subscribe", "psubscribe":
+		c.subscribe(name == "psubscribe", args[1:])
+		return noReply{}, false
+	case "unsubscribe", "punsubscribe":
+		c.unsubscribe(name == "punsubscribe", args[1:])
+		return noReply{}, false
+	case "ping":
+		if c.subscribed() {
+			payload := ""
+			if len(args) > 1 {
+				payload = args[1]
+			}
+			return []any{"pong", payload}, false
+		}
+	case "reset":
+		c.inMulti, c.multiDirty, c.queued, c.db = false, false, nil, 0
+		c.srv.mu.Lock()
+		c.srv.unsubscribeAllLocked(c)
+		c.srv.mu.Unlock()
+		return simpleString("RESET"), false
+	case "xreadgroup":
+		return c.blockingRead(args), false
+	}
+
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	return c.srv.exec(c.db, args, false), false
+}
+
+// execMulti 原子执行事务队列
+func (c *client) execMulti() any {
+	queued, dirty := c.queued, c.multiDirty
+	c.inMulti, c.multiDirty, c.queued = false, false, nil
+	if dirty {
+		return errExecAbort
+	}
+
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+	results := make([]any, 0, len(queued))
+	for _, args := range queued {
+		results = append(results, c.srv.exec(c.db, args, false))
+	}
+	return results
+}
+
+func (c *client) clientCommand(args []string) any {
+	if len(args) == 0 {
+		return errorReply("ERR wrong number of arguments for 'client' command")
+	}
+	switch strings.ToLower(args[0]) {
+	case "id":
+		return int64(1)
+	case "getname":
+		return nil
+	case "setname", "setinfo":
+		return simpleString("OK")
+	default:
+		// 未实现的子命令（如 MAINT_NOTIFICATIONS）返回错误，客户端据此关闭对应特性
+		return errorReply("ERR unknown subcommand '" + args[0] + "'")
+	}
+}
+
+// blockingRead 执行 XREADGROUP；带 BLOCK 且暂无消息时释放锁等待新消息写入
+func (c *client) blockingRead(args []string) any {
+	block, hasBlock := parseBlockOption(args)
+
+	var deadline time.Time
+	if hasBlock && block > 0 {
+		deadline = time.Now().Add(block)
+	}
+	for {
+		c.srv.mu.Lock()
+		reply := c.srv.exec(c.db, args, false)
+		if _, empty := reply.(nullArray); !empty || !hasBlock {
+			c.srv.mu.Unlock()
+			return reply
+		}
+		signal := c.srv.streamSignal
+		c.srv.mu.Unlock()
+
+		if !c.waitSignal(signal, deadline) {
+			return nullArray{}
+		}
+	}
+}
+
+// waitSignal 等待新消息写入，超时或连接关闭时返回 false
+func (c *client) waitSignal(signal <-chan struct{}, deadline time.Time) bool {
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return false
+		}
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-signal:
+		return true
+	case <-timeout:
+		return false
+	case <-c.conn.in.done:
+		return false
+	}
+}
+
+// parseBlockOption 解析 XREADGROUP 的 BLOCK 参数（毫秒）
+func parseBlockOption(args []string) (time.Duration, bool) {
+	for i := 1; i+1 < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "streams":
+			return 0, false
+		case "block":
+			ms, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil || ms < 0 {
+				return 0, false
+			}
+			return time.Duration(ms) * time.Millisecond, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,242 @@
+package memredis
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+var hashCommands = map[string]cmdSpec{
+	"hset":         {fn: hsetCommand(false), arity: -4},
+	"hmset":        {fn: hsetCommand(true), arity: -4},
+	"hsetnx":       {fn: cmdHSetNX, arity: 4},
+	"hget":         {fn: cmdHGet, arity: 3},
+	"hmget":        {fn: cmdHMGet, arity: -3},
+	"hgetall":      {fn: cmdHGetAll, arity: 2},
+	"hdel":         {fn: cmdHDel, arity: -3},
+	"hexists":      {fn: cmdHExists, arity: 3},
+	"hlen":         {fn: cmdHLen, arity: 2},
+	"hkeys":        {fn: cmdHKeys, arity: 2},
+	"hvals":        {fn: cmdHVals, arity: 2},
+	"hincrby":      {fn: cmdHIncrBy, arity: 4},
+	"hincrbyfloat": {fn: cmdHIncrByFloat, arity: 4},
+}
+
+func hsetCommand(legacy bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		if len(args[1:])%2 != 0 {
+			if legacy {
+				return wrongArgs("hmset")
+			}
+			return wrongArgs("hset")
+		}
+		e, errReply := x.ks.getOrCreate(args[0], kindHash, x.now)
+		if errReply != nil {
+			return errReply
+		}
+		added := int64(0)
+		for i := 1; i < len(args); i += 2 {
+			if _, ok := e.hash[args[i]]; !ok {
+				added++
+			}
+			e.hash[args[i]] = args[i+1]
+		}
+		if legacy {
+			return simpleString("OK")
+		}
+		return added
+	}
+}
+
+func cmdHSetNX(x *execCtx, args []string) any {
+	e, errReply := x.ks.getOrCreate(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if _, ok := e.hash[args[1]]; ok {
+		return int64(0)
+	}
+	e.hash[args[1]] = args[2]
+	return int64(1)
+}
+
+func cmdHGet(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return nil
+	}
+	value, ok := e.hash[args[1]]
+	if !ok {
+		return nil
+	}
+	return value
+}
+
+func cmdHMGet(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	values := make([]any, 0, len(args)-1)
+	for _, field := range args[1:] {
+		if e == nil {
+			values = append(values, nil)
+			continue
+		}
+		if value, ok := e.hash[field]; ok {
+			values = append(values, value)
+		} else {
+			values = append(values, nil)
+		}
+	}
+	return values
+}
+
+// sortedFields 按字段名排序，保证输出稳定
+func sortedFields(hash map[string]string) []string {
+	fields := make([]string, 0, len(hash))
+	for field := range hash {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func cmdHGetAll(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return []any{}
+	}
+	out := make([]string, 0, len(e.hash)*2)
+	for _, field := range sortedFields(e.hash) {
+		out = append(out, field, e.hash[field])
+	}
+	return out
+}
+
+func cmdHDel(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	removed := int64(0)
+	for _, field := range args[1:] {
+		if _, ok := e.hash[field]; ok {
+			delete(e.hash, field)
+			removed++
+		}
+	}
+	x.ks.dropIfEmpty(args[0], e)
+	return removed
+}
+
+func cmdHExists(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	if _, ok := e.hash[args[1]]; ok {
+		return int64(1)
+	}
+	return int64(0)
+}
+
+func cmdHLen(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	return int64(len(e.hash))
+}
+
+func cmdHKeys(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return []any{}
+	}
+	return sortedFields(e.hash)
+}
+
+func cmdHVals(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return []any{}
+	}
+	values := make([]string, 0, len(e.hash))
+	for _, field := range sortedFields(e.hash) {
+		values = append(values, e.hash[field])
+	}
+	return values
+}
+
+func cmdHIncrBy(x *execCtx, args []string) any {
+	delta, ok := parseInt(args[2])
+	if !ok {
+		return errNotInteger
+	}
+	e, errReply := x.ks.getOrCreate(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	current := int64(0)
+	if raw, exists := e.hash[args[1]]; exists {
+		n, ok := parseInt(raw)
+		if !ok {
+			return errorReply("ERR hash value is not an integer")
+		}
+		current = n
+	}
+	if (delta > 0 && current > math.MaxInt64-delta) || (delta < 0 && current < math.MinInt64-delta) {
+		return errOverflow
+	}
+	current += delta
+	e.hash[args[1]] = strconv.FormatInt(current, 10)
+	return current
+}
+
+func cmdHIncrByFloat(x *execCtx, args []string) any {
+	delta, ok := parseFloat(args[2])
+	if !ok {
+		return errNotFloat
+	}
+	e, errReply := x.ks.getOrCreate(args[0], kindHash, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	current := 0.0
+	if raw, exists := e.hash[args[1]]; exists {
+		f, ok := parseFloat(raw)
+		if !ok {
+			return errorReply("ERR hash value is not a float")
+		}
+		current = f
+	}
+	current += delta
+	if math.IsInf(current, 0) || math.IsNaN(current) {
+		return errorReply("ERR increment would produce NaN or Infinity")
+	}
+	formatted := formatFloat(current)
+	e.hash[args[1]] = formatted
+	return formatted
+}
@@ -0,0 +1,218 @@
+package memredis
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var keyCommands = map[string]cmdSpec{
+	"del":       {fn: cmdDel, arity: -2},
+	"unlink":    {fn: cmdDel, arity: -2},
+	"exists":    {fn: cmdExists, arity: -2},
+	"expire":    {fn: expireCommand(time.Second, false), arity: -3},
+	"pexpire":   {fn: expireCommand(time.Millisecond, false), arity: -3},
+	"expireat":  {fn: expireCommand(time.Second, true), arity: -3},
+	"pexpireat": {fn: expireCommand(time.Millisecond, true), arity: -3},
+	"persist":   {fn: cmdPersist, arity: 2},
+	"ttl":       {fn: ttlCommand(time.Second), arity: 2},
+	"pttl":      {fn: ttlCommand(time.Millisecond), arity: 2},
+	"type":      {fn: cmdType, arity: 2},
+	"keys":      {fn: cmdKeys, arity: 2},
+	"scan":      {fn: cmdScan, arity: -2},
+	"rename":    {fn: cmdRename, arity: 3},
+}
+
+func cmdDel(x *execCtx, args []string) any {
+	removed := int64(0)
+	for _, key := range args {
+		if x.ks.lookup(key, x.now) != nil {
+			delete(x.ks.data, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+func cmdExists(x *execCtx, args []string) any {
+	count := int64(0)
+	for _, key := range args {
+		if x.ks.lookup(key, x.now) != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// expireCommand 实现 EXPIRE / PEXPIRE / EXPIREAT / PEXPIREAT（含 NX / XX / GT / LT 选项）
+func expireCommand(unit time.Duration, absolute bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		n, ok := parseInt(args[1])
+		if !ok {
+			return errNotInteger
+		}
+		var nx, xx, gt, lt bool
+		for _, opt := range args[2:] {
+			switch strings.ToLower(opt) {
+			case "nx":
+				nx = true
+			case "xx":
+				xx = true
+			case "gt":
+				gt = true
+			case "lt":
+				lt = true
+			default:
+				return errorReply("ERR Unsupported option " + opt)
+			}
+		}
+
+		e := x.ks.lookup(args[0], x.now)
+		if e == nil {
+			return int64(0)
+		}
+		var at time.Time
+		if absolute {
+			at = time.Unix(0, 0).Add(time.Duration(n) * unit)
+		} else {
+			at = x.now.Add(time.Duration(n) * unit)
+		}
+
+		hasTTL := !e.expireAt.IsZero()
+		switch {
+		case nx && hasTTL, xx && !hasTTL:
+			return int64(0)
+		case gt && (!hasTTL || !at.After(e.expireAt)):
+			return int64(0)
+		case lt && hasTTL && !at.Before(e.expireAt):
+			return int64(0)
+		}
+
+		if !at.After(x.now) {
+			delete(x.ks.data, args[0])
+			return int64(1)
+		}
+		e.expireAt = at
+		return int64(1)
+	}
+}
+
+func cmdPersist(x *execCtx, args []string) any {
+	e := x.ks.lookup(args[0], x.now)
+	if e == nil || e.expireAt.IsZero() {
+		return int64(0)
+	}
+	e.expireAt = time.Time{}
+	return int64(1)
+}
+
+func ttlCommand(unit time.Duration) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		e := x.ks.lookup(args[0], x.now)
+		if e == nil {
+			return int64(-2)
+		}
+		if e.expireAt.IsZero() {
+			return int64(-1)
+		}
+		remaining := e.expireAt.Sub(x.now)
+		if unit == time.Second {
+			return int64((remaining + 500*time.Millisecond) / time.Second)
+		}
+		return int64(remaining / time.Millisecond)
+	}
+}
+
+func cmdType(x *execCtx, args []string) any {
+	e := x.ks.lookup(args[0], x.now)
+	if e == nil {
+		return simpleString("none")
+	}
+	return simpleString(e.kind.typeName())
+}
+
+// matchingKeys 返回匹配模式且未过期的键（按字典序，保证 SCAN 游标稳定）
+func (x *execCtx) matchingKeys(pattern string, kind valueKind) []string {
+	keys := make([]string, 0, len(x.ks.data))
+	for key, e := range x.ks.data {
+		if e.expired(x.now) {
+			continue
+		}
+		if kind != 0 && e.kind != kind {
+			continue
+		}
+		if pattern != "*" && !globMatch(pattern, key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func cmdKeys(x *execCtx, args []string) any {
+	return x.matchingKeys(args[0], 0)
+}
+
+// cmdScan 游标为有序键列表中的偏移量
+func cmdScan(x *execCtx, args []string) any {
+	cursor, ok := parseInt(args[0])
+	if !ok || cursor < 0 {
+		return errorReply("ERR invalid cursor")
+	}
+	pattern := "*"
+	count := int64(10)
+	var kind valueKind
+	for i := 1; i < len(args); i += 2 {
+		if i+1 >= len(args) {
+			return errSyntax
+		}
+		switch strings.ToLower(args[i]) {
+		case "match":
+			pattern = args[i+1]
+		case "count":
+			n, ok := parseInt(args[i+1])
+			if !ok || n <= 0 {
+				return errSyntax
+			}
+			count = n
+		case "type":
+			kind = kindByName(strings.ToLower(args[i+1]))
+		default:
+			return errSyntax
+		}
+	}
+
+	keys := x.matchingKeys(pattern, kind)
+	start := int(cursor)
+	if start > len(keys) {
+		start = len(keys)
+	}
+	end := start + int(count)
+	next := int64(end)
+	if end >= len(keys) {
+		end = len(keys)
+		next = 0
+	}
+	return []any{strconv.FormatInt(next, 10), keys[start:end]}
+}
+
+func kindByName(name string) valueKind {
+	for _, kind := range []valueKind{kindString, kindHash, kindSet, kindZSet, kindList, kindStream} {
+		if kind.typeName() == name {
+			return kind
+		}
+	}
+	return -1
+}
+
+func cmdRename(x *execCtx, args []string) any {
+	e := x.ks.lookup(args[0], x.now)
+	if e == nil {
+		return errorReply("ERR no such key")
+	}
+	delete(x.ks.data, args[0])
+	x.ks.data[args[1]] = e
+	return simpleString("OK")
+}
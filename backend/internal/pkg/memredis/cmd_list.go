@@ -0,0 +1,193 @@
+package memredis
+
+var listCommands = map[string]cmdSpec{
+	"rpush":  {fn: pushCommand(false), arity: -3},
+	"lpush":  {fn: pushCommand(true), arity: -3},
+	"lrange": {fn: cmdLRange, arity: 4},
+	"llen":   {fn: cmdLLen, arity: 2},
+	"lpop":   {fn: popCommand(true), arity: -2},
+	"rpop":   {fn: popCommand(false), arity: -2},
+	"ltrim":  {fn: cmdLTrim, arity: 4},
+	"lindex": {fn: cmdLIndex, arity: 3},
+	"lrem":   {fn: cmdLRem, arity: 4},
+}
+
+func pushCommand(head bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		e, errReply := x.ks.getOrCreate(args[0], kindList, x.now)
+		if errReply != nil {
+			return errReply
+		}
+		for _, value := range args[1:] {
+			if head {
+				e.list = append([]string{value}, e.list...)
+			} else {
+				e.list = append(e.list, value)
+			}
+		}
+		return int64(len(e.list))
+	}
+}
+
+func cmdLRange(x *execCtx, args []string) any {
+	start, ok1 := parseInt(args[1])
+	stop, ok2 := parseInt(args[2])
+	if !ok1 || !ok2 {
+		return errNotInteger
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindList, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return []any{}
+	}
+	from, to, ok := normalizeRange(start, stop, len(e.list))
+	if !ok {
+		return []any{}
+	}
+	return append([]string(nil), e.list[from:to]...)
+}
+
+func cmdLLen(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindList, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	return int64(len(e.list))
+}
+
+// popCommand 实现 LPOP / RPOP（支持可选的 count 参数）
+func popCommand(head bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		count, withCount := int64(1), len(args) > 1
+		if len(args) > 2 {
+			return errSyntax
+		}
+		if withCount {
+			n, ok := parseInt(args[1])
+			if !ok || n < 0 {
+				return errorReply("ERR value is out of range, must be positive")
+			}
+			count = n
+		}
+		e, errReply := x.ks.lookupKind(args[0], kindList, x.now)
+		if errReply != nil {
+			return errReply
+		}
+		if e == nil {
+			if withCount {
+				return nullArray{}
+			}
+			return nil
+		}
+		if count > int64(len(e.list)) {
+			count = int64(len(e.list))
+		}
+		var popped []string
+		if head {
+			popped = append(popped, e.list[:count]...)
+			e.list = e.list[count:]
+		} else {
+			for i := int64(0); i < count; i++ {
+				popped = append(popped, e.list[len(e.list)-1-int(i)])
+			}
+			e.list = e.list[:int64(len(e.list))-count]
+		}
+		x.ks.dropIfEmpty(args[0], e)
+		if withCount {
+			return popped
+		}
+		return popped[0]
+	}
+}
+
+func cmdLTrim(x *execCtx, args []string) any {
+	start, ok1 := parseInt(args[1])
+	stop, ok2 := parseInt(args[2])
+	if !ok1 || !ok2 {
+		return errNotInteger
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindList, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return simpleString("OK")
+	}
+	from, to, ok := normalizeRange(start, stop, len(e.list))
+	if !ok {
+		e.list = nil
+	} else {
+		e.list = append([]string(nil), e.list[from:to]...)
+	}
+	x.ks.dropIfEmpty(args[0], e)
+	return simpleString("OK")
+}
+
+func cmdLIndex(x *execCtx, args []string) any {
+	idx, ok := parseInt(args[1])
+	if !ok {
+		return errNotInteger
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindList, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return nil
+	}
+	if idx < 0 {
+		idx += int64(len(e.list))
+	}
+	if idx < 0 || idx >= int64(len(e.list)) {
+		return nil
+	}
+	return e.list[idx]
+}
+
+// cmdLRem count > 0 从头删除，count < 0 从尾删除，count = 0 删除全部
+func cmdLRem(x *execCtx, args []string) any {
+	count, ok := parseInt(args[1])
+	if !ok {
+		return errNotInteger
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindList, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+	removed := int64(0)
+	keep := make([]bool, len(e.list))
+	for i := range keep {
+		keep[i] = true
+	}
+	for step := 0; step < len(e.list); step++ {
+		i := step
+		if count < 0 {
+			i = len(e.list) - 1 - step
+		}
+		if e.list[i] == args[2] && (limit == 0 || removed < limit) {
+			keep[i] = false
+			removed++
+		}
+	}
+	kept := e.list[:0]
+	for i, value := range e.list {
+		if keep[i] {
+			kept = append(kept, value)
+		}
+	}
+	e.list = kept
+	x.ks.dropIfEmpty(args[0], e)
+	return removed
+}
@@ -0,0 +1,82 @@
+package memredis
+
+import (
+	"strconv"
+	"strings"
+)
+
+var serverCommands = map[string]cmdSpec{
+	"ping":     {fn: cmdPing, arity: -1},
+	"echo":     {fn: cmdEcho, arity: 2},
+	"time":     {fn: cmdTime, arity: 1},
+	"dbsize":   {fn: cmdDBSize, arity: 1},
+	"flushdb":  {fn: cmdFlushDB, arity: -1},
+	"flushall": {fn: cmdFlushAll, arity: -1},
+	"info":     {fn: cmdInfo, arity: -1},
+	"command":  {fn: cmdCommand, arity: -1},
+	"config":   {fn: cmdConfig, arity: -2, noScript: true},
+}
+
+func cmdPing(_ *execCtx, args []string) any {
+	if len(args) > 0 {
+		return args[0]
+	}
+	return simpleString("PONG")
+}
+
+func cmdEcho(_ *execCtx, args []string) any {
+	return args[0]
+}
+
+func cmdTime(x *execCtx, _ []string) any {
+	return []any{
+		strconv.FormatInt(x.now.Unix(), 10),
+		strconv.FormatInt(int64(x.now.Nanosecond()/1000), 10),
+	}
+}
+
+func cmdDBSize(x *execCtx, _ []string) any {
+	count := int64(0)
+	for _, e := range x.ks.data {
+		if !e.expired(x.now) {
+			count++
+		}
+	}
+	return count
+}
+
+func cmdFlushDB(x *execCtx, _ []string) any {
+	x.ks.data = make(map[string]*entry)
+	return simpleString("OK")
+}
+
+func cmdFlushAll(x *execCtx, _ []string) any {
+	for _, ks := range x.srv.dbs {
+		ks.data = make(map[string]*entry)
+	}
+	return simpleString("OK")
+}
+
+func cmdInfo(x *execCtx, _ []string) any {
+	var b strings.Builder
+	b.WriteString("# Server\r\nredis_version:7.0.0\r\nredis_mode:standalone\r\nmemredis:1\r\n")
+	b.WriteString("# Keyspace\r\n")
+	for i, ks := range x.srv.dbs {
+		if len(ks.data) > 0 {
+			b.WriteString("db" + strconv.Itoa(i) + ":keys=" + strconv.Itoa(len(ks.data)) + "\r\n")
+		}
+	}
+	return b.String()
+}
+
+func cmdCommand(_ *execCtx, _ []string) any {
+	return []any{}
+}
+
+// cmdConfig 兼容 CONFIG GET/SET 探测，返回空结果
+func cmdConfig(_ *execCtx, args []string) any {
+	if strings.EqualFold(args[0], "get") {
+		return []any{}
+	}
+	return simpleString("OK")
+}
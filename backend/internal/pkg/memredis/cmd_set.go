@@ -0,0 +1,86 @@
+package memredis
+
+import "sort"
+
+var setCommands = map[string]cmdSpec{
+	"sadd":      {fn: cmdSAdd, arity: -3},
+	"srem":      {fn: cmdSRem, arity: -3},
+	"smembers":  {fn: cmdSMembers, arity: 2},
+	"sismember": {fn: cmdSIsMember, arity: 3},
+	"scard":     {fn: cmdSCard, arity: 2},
+}
+
+func cmdSAdd(x *execCtx, args []string) any {
+	e, errReply := x.ks.getOrCreate(args[0], kindSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	added := int64(0)
+	for _, member := range args[1:] {
+		if _, ok := e.set[member]; !ok {
+			e.set[member] = struct{}{}
+			added++
+		}
+	}
+	return added
+}
+
+func cmdSRem(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	removed := int64(0)
+	for _, member := range args[1:] {
+		if _, ok := e.set[member]; ok {
+			delete(e.set, member)
+			removed++
+		}
+	}
+	x.ks.dropIfEmpty(args[0], e)
+	return removed
+}
+
+func cmdSMembers(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return []any{}
+	}
+	members := make([]string, 0, len(e.set))
+	for member := range e.set {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	return members
+}
+
+func cmdSIsMember(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	if _, ok := e.set[args[1]]; ok {
+		return int64(1)
+	}
+	return int64(0)
+}
+
+func cmdSCard(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	return int64(len(e.set))
+}
@@ -0,0 +1,739 @@
+package memredis
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// streamID Stream 消息 ID（<毫秒时间戳>-<序号>）
+type streamID struct {
+	ms  uint64
+	seq uint64
+}
+
+func (id streamID) String() string {
+	return strconv.FormatUint(id.ms, 10) + "-" + strconv.FormatUint(id.seq, 10)
+}
+
+func (id streamID) less(other streamID) bool {
+	if id.ms != other.ms {
+		return id.ms < other.ms
+	}
+	return id.seq < other.seq
+}
+
+func (id streamID) next() streamID {
+	if id.seq == math.MaxUint64 {
+		return streamID{ms: id.ms + 1}
+	}
+	return streamID{ms: id.ms, seq: id.seq + 1}
+}
+
+// parseStreamID 解析完整或省略序号的 ID；省略序号时使用 missingSeq
+func parseStreamID(s string, missingSeq uint64) (streamID, bool) {
+	msPart, seqPart, hasSeq := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return streamID{}, false
+	}
+	if !hasSeq {
+		return streamID{ms: ms, seq: missingSeq}, true
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return streamID{}, false
+	}
+	return streamID{ms: ms, seq: seq}, true
+}
+
+// parseRangeBound 解析 XRANGE 区间端点，支持 - / + 与 "(" 开区间
+func parseRangeBound(s string, isStart bool) (streamID, bool) {
+	exclusive := strings.HasPrefix(s, "(")
+	if exclusive {
+		s = s[1:]
+	}
+	var id streamID
+	switch {
+	case s == "-":
+		id = streamID{}
+	case s == "+":
+		id = streamID{ms: math.MaxUint64, seq: math.MaxUint64}
+	default:
+		missing := uint64(0)
+		if !isStart {
+			missing = math.MaxUint64
+		}
+		var ok bool
+		if id, ok = parseStreamID(s, missing); !ok {
+			return id, false
+		}
+	}
+	if exclusive {
+		if isStart {
+			if id.ms == math.MaxUint64 && id.seq == math.MaxUint64 {
+				return id, false
+			}
+			id = id.next()
+		} else {
+			if id.ms == 0 && id.seq == 0 {
+				return id, false
+			}
+			if id.seq == 0 {
+				id = streamID{ms: id.ms - 1, seq: math.MaxUint64}
+			} else {
+				id.seq--
+			}
+		}
+	}
+	return id, true
+}
+
+type streamEntry struct {
+	id     streamID
+	fields []string
+}
+
+type pendingEntry struct {
+	consumer    string
+	deliveredAt time.Time
+	deliveries  int64
+}
+
+type consumerGroup struct {
+	lastID  streamID
+	pending map[streamID]*pendingEntry
+	// consumers 记录已出现过的消费者（XPENDING 汇总时只统计有待确认消息的消费者）
+	consumers map[string]struct{}
+}
+
+// stream 按 ID 升序保存消息
+type stream struct {
+	entries []streamEntry
+	lastID  streamID
+	groups  map[string]*consumerGroup
+}
+
+func newStream() *stream {
+	return &stream{groups: make(map[string]*consumerGroup)}
+}
+
+func (s *stream) search(id streamID) int {
+	return sort.Search(len(s.entries), func(i int) bool { return !s.entries[i].id.less(id) })
+}
+
+func (s *stream) find(id streamID) (streamEntry, bool) {
+	idx := s.search(id)
+	if idx < len(s.entries) && s.entries[idx].id == id {
+		return s.entries[idx], true
+	}
+	return streamEntry{}, false
+}
+
+// rangeEntries 返回 [start, end] 内的消息
+func (s *stream) rangeEntries(start, end streamID) []streamEntry {
+	from := s.search(start)
+	to := from
+	for to < len(s.entries) && !end.less(s.entries[to].id) {
+		to++
+	}
+	return s.entries[from:to]
+}
+
+func (s *stream) delete(id streamID) bool {
+	idx := s.search(id)
+	if idx < len(s.entries) && s.entries[idx].id == id {
+		s.entries = append(s.entries[:idx], s.entries[idx+1:]...)
+		return true
+	}
+	return false
+}
+
+func (s *stream) trimMaxLen(maxLen int64) int64 {
+	if maxLen < 0 || int64(len(s.entries)) <= maxLen {
+		return 0
+	}
+	removed := int64(len(s.entries)) - maxLen
+	s.entries = append([]streamEntry(nil), s.entries[removed:]...)
+	return removed
+}
+
+func entryReply(e streamEntry) []any {
+	return []any{e.id.String(), append([]string(nil), e.fields...)}
+}
+
+var streamCommands = map[string]cmdSpec{
+	"xadd":       {fn: cmdXAdd, arity: -5},
+	"xlen":       {fn: cmdXLen, arity: 2},
+	"xrange":     {fn: xrangeCommand(false), arity: -4},
+	"xrevrange":  {fn: xrangeCommand(true), arity: -4},
+	"xdel":       {fn: cmdXDel, arity: -3},
+	"xtrim":      {fn: cmdXTrim, arity: -4},
+	"xgroup":     {fn: cmdXGroup, arity: -2},
+	"xreadgroup": {fn: cmdXReadGroup, arity: -7},
+	"xack":       {fn: cmdXAck, arity: -4},
+	"xpending":   {fn: cmdXPending, arity: -3},
+	"xclaim":     {fn: cmdXClaim, arity: -6},
+}
+
+func (x *execCtx) lookupStream(key string) (*stream, any) {
+	e, errReply := x.ks.lookupKind(key, kindStream, x.now)
+	if errReply != nil || e == nil {
+		return nil, errReply
+	}
+	return e.stream, nil
+}
+
+// parseTrim 解析 MAXLEN [=|~] n；返回消耗的参数个数
+func parseTrim(args []string) (maxLen int64, consumed int, errReply any) {
+	if len(args) < 2 {
+		return 0, 0, errSyntax
+	}
+	i := 1
+	if args[i] == "~" || args[i] == "=" {
+		i++
+		if i >= len(args) {
+			return 0, 0, errSyntax
+		}
+	}
+	n, ok := parseInt(args[i])
+	if !ok || n < 0 {
+		return 0, 0, errorReply("ERR The MAXLEN argument must be >= 0.")
+	}
+	i++
+	if i+1 < len(args) && strings.EqualFold(args[i], "limit") {
+		i += 2
+	}
+	return n, i, nil
+}
+
+// cmdXAdd 实现 XADD key [NOMKSTREAM] [MAXLEN [=|~] n [LIMIT c]] *|id field value ...
+func cmdXAdd(x *execCtx, args []string) any {
+	key := args[0]
+	noMkStream := false
+	maxLen := int64(-1)
+	i := 1
+loop:
+	for i < len(args) {
+		switch strings.ToLower(args[i]) {
+		case "nomkstream":
+			noMkStream = true
+			i++
+		case "maxlen":
+			n, consumed, errReply := parseTrim(args[i:])
+			if errReply != nil {
+				return errReply
+			}
+			maxLen = n
+			i += consumed
+		default:
+			break loop
+		}
+	}
+	if i >= len(args) {
+		return errSyntax
+	}
+	idArg, fields := args[i], args[i+1:]
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return wrongArgs("xadd")
+	}
+
+	if noMkStream {
+		if s, errReply := x.lookupStream(key); errReply != nil || s == nil {
+			return errReply
+		}
+	}
+	e, errReply := x.ks.getOrCreate(key, kindStream, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	s := e.stream
+
+	var id streamID
+	if idArg == "*" {
+		id = streamID{ms: uint64(x.now.UnixMilli())}
+		if !s.lastID.less(id) {
+			id = s.lastID.next()
+		}
+	} else {
+		var ok bool
+		if strings.HasSuffix(idArg, "-*") {
+			id, ok = parseStreamID(strings.TrimSuffix(idArg, "-*"), 0)
+			if ok && id.ms == s.lastID.ms {
+				id = s.lastID.next()
+			}
+		} else {
+			id, ok = parseStreamID(idArg, 0)
+		}
+		if !ok {
+			return errorReply("ERR Invalid stream ID specified as stream command argument")
+		}
+		if id == (streamID{}) {
+			return errorReply("ERR The ID specified in XADD must be greater than 0-0")
+		}
+		if !s.lastID.less(id) {
+			return errorReply("ERR The ID specified in XADD is equal or smaller than the target stream top item")
+		}
+	}
+
+	s.entries = append(s.entries, streamEntry{id: id, fields: append([]string(nil), fields...)})
+	s.lastID = id
+	s.trimMaxLen(maxLen)
+	x.srv.notifyStreamsLocked()
+	return id.String()
+}
+
+func cmdXLen(x *execCtx, args []string) any {
+	s, errReply := x.lookupStream(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	if s == nil {
+		return int64(0)
+	}
+	return int64(len(s.entries))
+}
+
+func xrangeCommand(reverse bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		startArg, endArg := args[1], args[2]
+		if reverse {
+			startArg, endArg = endArg, startArg
+		}
+		start, ok1 := parseRangeBound(startArg, true)
+		end, ok2 := parseRangeBound(endArg, false)
+		if !ok1 || !ok2 {
+			return errorReply("ERR Invalid stream ID specified as stream command argument")
+		}
+		count := int64(-1)
+		if len(args) > 3 {
+			if len(args) != 5 || !strings.EqualFold(args[3], "count") {
+				return errSyntax
+			}
+			n, ok := parseInt(args[4])
+			if !ok {
+				return errNotInteger
+			}
+			count = n
+		}
+
+		s, errReply := x.lookupStream(args[0])
+		if errReply != nil {
+			return errReply
+		}
+		out := []any{}
+		if s == nil || end.less(start) {
+			return out
+		}
+		entries := s.rangeEntries(start, end)
+		for i := range entries {
+			if count >= 0 && int64(len(out)) >= count {
+				break
+			}
+			e := entries[i]
+			if reverse {
+				e = entries[len(entries)-1-i]
+			}
+			out = append(out, entryReply(e))
+		}
+		return out
+	}
+}
+
+func cmdXDel(x *execCtx, args []string) any {
+	s, errReply := x.lookupStream(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	if s == nil {
+		return int64(0)
+	}
+	removed := int64(0)
+	for _, raw := range args[1:] {
+		id, ok := parseStreamID(raw, 0)
+		if !ok {
+			return errorReply("ERR Invalid stream ID specified as stream command argument")
+		}
+		if s.delete(id) {
+			removed++
+		}
+	}
+	return removed
+}
+
+func cmdXTrim(x *execCtx, args []string) any {
+	if !strings.EqualFold(args[1], "maxlen") {
+		return errSyntax
+	}
+	maxLen, _, errReply := parseTrim(args[1:])
+	if errReply != nil {
+		return errReply
+	}
+	s, errReply := x.lookupStream(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	if s == nil {
+		return int64(0)
+	}
+	return s.trimMaxLen(maxLen)
+}
+
+// cmdXGroup 实现 XGROUP CREATE / DESTROY / SETID
+func cmdXGroup(x *execCtx, args []string) any {
+	sub := strings.ToLower(args[0])
+	switch sub {
+	case "create":
+		if len(args) < 4 {
+			return wrongArgs("xgroup|create")
+		}
+		mkStream := len(args) > 4 && strings.EqualFold(args[4], "mkstream")
+		s, errReply := x.lookupStream(args[1])
+		if errReply != nil {
+			return errReply
+		}
+		if s == nil {
+			if !mkStream {
+				return errorReply("ERR The XGROUP subcommand requires the key to exist. Note that for CREATE you may want to use the MKSTREAM option to create an empty stream automatically.")
+			}
+			e, _ := x.ks.getOrCreate(args[1], kindStream, x.now)
+			s = e.stream
+		}
+		if _, exists := s.groups[args[2]]; exists {
+			return errorReply("BUSYGROUP Consumer Group name already exists")
+		}
+		lastID, errReply := groupStartID(s, args[3])
+		if errReply != nil {
+			return errReply
+		}
+		s.groups[args[2]] = &consumerGroup{
+			lastID:    lastID,
+			pending:   make(map[streamID]*pendingEntry),
+			consumers: make(map[string]struct{}),
+		}
+		return simpleString("OK")
+	case "destroy":
+		if len(args) != 3 {
+			return wrongArgs("xgroup|destroy")
+		}
+		s, errReply := x.lookupStream(args[1])
+		if errReply != nil {
+			return errReply
+		}
+		if s == nil {
+			return int64(0)
+		}
+		if _, exists := s.groups[args[2]]; !exists {
+			return int64(0)
+		}
+		delete(s.groups, args[2])
+		return int64(1)
+	case "setid":
+		if len(args) < 4 {
+			return wrongArgs("xgroup|setid")
+		}
+		g, _, errReply := x.lookupGroup(args[1], args[2])
+		if errReply != nil {
+			return errReply
+		}
+		s, _ := x.lookupStream(args[1])
+		lastID, errReply := groupStartID(s, args[3])
+		if errReply != nil {
+			return errReply
+		}
+		g.lastID = lastID
+		return simpleString("OK")
+	default:
+		return errorReply("ERR unknown subcommand '" + args[0] + "'")
+	}
+}
+
+func groupStartID(s *stream, raw string) (streamID, any) {
+	if raw == "$" {
+		return s.lastID, nil
+	}
+	id, ok := parseStreamID(raw, 0)
+	if !ok {
+		return streamID{}, errorReply("ERR Invalid stream ID specified as stream command argument")
+	}
+	return id, nil
+}
+
+// lookupGroup 查找消费组；不存在时返回 NOGROUP 错误
+func (x *execCtx) lookupGroup(key, group string) (*consumerGroup, *stream, any) {
+	s, errReply := x.lookupStream(key)
+	if errReply != nil {
+		return nil, nil, errReply
+	}
+	if s != nil {
+		if g, ok := s.groups[group]; ok {
+			return g, s, nil
+		}
+	}
+	return nil, nil, errorReply("NOGROUP No such key '" + key + "' or consumer group '" + group + "'")
+}
+
+// cmdXReadGroup 实现 XREADGROUP GROUP g c [COUNT n] [BLOCK ms] [NOACK] STREAMS key... id...
+// BLOCK 的等待由连接层处理，这里只做一次非阻塞读取
+func cmdXReadGroup(x *execCtx, args []string) any {
+	if !strings.EqualFold(args[0], "group") {
+		return errSyntax
+	}
+	group, consumer := args[1], args[2]
+	count := int64(-1)
+	noAck := false
+	i := 3
+	for ; i < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "count":
+			if i+1 >= len(args) {
+				return errSyntax
+			}
+			n, ok := parseInt(args[i+1])
+			if !ok {
+				return errNotInteger
+			}
+			if n > 0 {
+				count = n
+			}
+			i++
+		case "block":
+			if i+1 >= len(args) {
+				return errSyntax
+			}
+			if _, ok := parseInt(args[i+1]); !ok {
+				return errTimeout
+			}
+			i++
+		case "noack":
+			noAck = true
+		case "streams":
+			goto streams
+		default:
+			return errSyntax
+		}
+	}
+	return errSyntax
+
+streams:
+	rest := args[i+1:]
+	if len(rest) == 0 || len(rest)%2 != 0 {
+		return errorReply("ERR Unbalanced 'xreadgroup' list of streams: for each stream key an ID or '>' must be specified.")
+	}
+	keys, ids := rest[:len(rest)/2], rest[len(rest)/2:]
+
+	var out []any
+	for k, key := range keys {
+		g, s, errReply := x.lookupGroup(key, group)
+		if errReply != nil {
+			return errReply
+		}
+		g.consumers[consumer] = struct{}{}
+
+		var messages []any
+		if ids[k] == ">" {
+			from := s.search(g.lastID.next())
+			for _, e := range s.entries[from:] {
+				if count >= 0 && int64(len(messages)) >= count {
+					break
+				}
+				g.lastID = e.id
+				if !noAck {
+					g.pending[e.id] = &pendingEntry{consumer: consumer, deliveredAt: x.now, deliveries: 1}
+				}
+				messages = append(messages, entryReply(e))
+			}
+			if len(messages) == 0 {
+				continue
+			}
+		} else {
+			// 读取该消费者的待确认历史
+			start, ok := parseStreamID(ids[k], 0)
+			if !ok {
+				return errorReply("ERR Invalid stream ID specified as stream command argument")
+			}
+			pendingIDs := g.pendingIDs(consumer, start, streamID{ms: math.MaxUint64, seq: math.MaxUint64})
+			messages = []any{}
+			for _, id := range pendingIDs {
+				if count >= 0 && int64(len(messages)) >= count {
+					break
+				}
+				if e, ok := s.find(id); ok {
+					messages = append(messages, entryReply(e))
+				} else {
+					messages = append(messages, []any{id.String(), nullArray{}})
+				}
+			}
+		}
+		out = append(out, []any{key, messages})
+	}
+	if len(out) == 0 {
+		return nullArray{}
+	}
+	return out
+}
+
+// pendingIDs 按 ID 升序返回待确认消息；consumer 为空时返回全部
+func (g *consumerGroup) pendingIDs(consumer string, start, end streamID) []streamID {
+	ids := make([]streamID, 0, len(g.pending))
+	for id, p := range g.pending {
+		if consumer != "" && p.consumer != consumer {
+			continue
+		}
+		if id.less(start) || end.less(id) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].less(ids[j]) })
+	return ids
+}
+
+func cmdXAck(x *execCtx, args []string) any {
+	g, _, errReply := x.lookupGroup(args[0], args[1])
+	if errReply != nil {
+		// 与 Redis 一致：键或消费组不存在时返回 0
+		return int64(0)
+	}
+	acked := int64(0)
+	for _, raw := range args[2:] {
+		id, ok := parseStreamID(raw, 0)
+		if !ok {
+			return errorReply("ERR Invalid stream ID specified as stream command argument")
+		}
+		if _, exists := g.pending[id]; exists {
+			delete(g.pending, id)
+			acked++
+		}
+	}
+	return acked
+}
+
+// cmdXPending 实现汇总形式 XPENDING key group 与扩展形式 XPENDING key group [IDLE ms] start end count [consumer]
+func cmdXPending(x *execCtx, args []string) any {
+	g, _, errReply := x.lookupGroup(args[0], args[1])
+	if errReply != nil {
+		return errReply
+	}
+
+	if len(args) == 2 {
+		ids := g.pendingIDs("", streamID{}, streamID{ms: math.MaxUint64, seq: math.MaxUint64})
+		if len(ids) == 0 {
+			return []any{int64(0), nil, nil, nullArray{}}
+		}
+		perConsumer := make(map[string]int64)
+		for _, id := range ids {
+			perConsumer[g.pending[id].consumer]++
+		}
+		names := make([]string, 0, len(perConsumer))
+		for name := range perConsumer {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		consumers := make([]any, 0, len(names))
+		for _, name := range names {
+			consumers = append(consumers, []any{name, strconv.FormatInt(perConsumer[name], 10)})
+		}
+		return []any{int64(len(ids)), ids[0].String(), ids[len(ids)-1].String(), consumers}
+	}
+
+	rest := args[2:]
+	minIdle := int64(0)
+	if strings.EqualFold(rest[0], "idle") {
+		if len(rest) < 2 {
+			return errSyntax
+		}
+		n, ok := parseInt(rest[1])
+		if !ok {
+			return errNotInteger
+		}
+		minIdle = n
+		rest = rest[2:]
+	}
+	if len(rest) < 3 || len(rest) > 4 {
+		return errSyntax
+	}
+	start, ok1 := parseRangeBound(rest[0], true)
+	end, ok2 := parseRangeBound(rest[1], false)
+	if !ok1 || !ok2 {
+		return errorReply("ERR Invalid stream ID specified as stream command argument")
+	}
+	count, ok := parseInt(rest[2])
+	if !ok {
+		return errNotInteger
+	}
+	consumer := ""
+	if len(rest) == 4 {
+		consumer = rest[3]
+	}
+
+	out := []any{}
+	for _, id := range g.pendingIDs(consumer, start, end) {
+		if int64(len(out)) >= count {
+			break
+		}
+		p := g.pending[id]
+		idle := x.now.Sub(p.deliveredAt).Milliseconds()
+		if idle < minIdle {
+			continue
+		}
+		out = append(out, []any{id.String(), p.consumer, idle, p.deliveries})
+	}
+	return out
+}
+
+// cmdXClaim 实现 XCLAIM key group consumer min-idle id ... [JUSTID]
+func cmdXClaim(x *execCtx, args []string) any {
+	g, s, errReply := x.lookupGroup(args[0], args[1])
+	if errReply != nil {
+		return errReply
+	}
+	consumer := args[2]
+	minIdle, ok := parseInt(args[3])
+	if !ok {
+		return errorReply("ERR Invalid min-idle-time argument for XCLAIM")
+	}
+
+	justID := false
+	var ids []streamID
+	for i := 4; i < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "justid":
+			justID = true
+			continue
+		case "force", "lastid", "idle", "time", "retrycount":
+			return errorReply("ERR memredis: XCLAIM option " + args[i] + " is not supported")
+		}
+		id, ok := parseStreamID(args[i], 0)
+		if !ok {
+			return errorReply("ERR Invalid stream ID specified as stream command argument")
+		}
+		ids = append(ids, id)
+	}
+	g.consumers[consumer] = struct{}{}
+
+	out := []any{}
+	for _, id := range ids {
+		p, exists := g.pending[id]
+		if !exists || x.now.Sub(p.deliveredAt).Milliseconds() < minIdle {
+			continue
+		}
+		e, found := s.find(id)
+		if !found {
+			// 消息已被删除，与 Redis 7 一致从待确认列表中移除
+			delete(g.pending, id)
+			continue
+		}
+		p.consumer = consumer
+		p.deliveredAt = x.now
+		if justID {
+			out = append(out, id.String())
+			continue
+		}
+		p.deliveries++
+		out = append(out, entryReply(e))
+	}
+	return out
+}
@@ -0,0 +1,290 @@
+package memredis
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var stringCommands = map[string]cmdSpec{
+	"get":         {fn: cmdGet, arity: 2},
+	"set":         {fn: cmdSet, arity: -3},
+	"setnx":       {fn: cmdSetNX, arity: 3},
+	"setex":       {fn: setExCommand(time.Second), arity: 4},
+	"psetex":      {fn: setExCommand(time.Millisecond), arity: 4},
+	"getset":      {fn: cmdGetSet, arity: 3},
+	"getdel":      {fn: cmdGetDel, arity: 2},
+	"mget":        {fn: cmdMGet, arity: -2},
+	"mset":        {fn: cmdMSet, arity: -3},
+	"incr":        {fn: incrCommand(1, false), arity: 2},
+	"decr":        {fn: incrCommand(-1, false), arity: 2},
+	"incrby":      {fn: incrCommand(1, true), arity: 3},
+	"decrby":      {fn: incrCommand(-1, true), arity: 3},
+	"incrbyfloat": {fn: cmdIncrByFloat, arity: 3},
+	"append":      {fn: cmdAppend, arity: 3},
+	"strlen":      {fn: cmdStrlen, arity: 2},
+}
+
+// getString 读取字符串值；键不存在时 ok 为 false
+func (x *execCtx) getString(key string) (value string, ok bool, errReply any) {
+	e, errReply := x.ks.lookupKind(key, kindString, x.now)
+	if errReply != nil || e == nil {
+		return "", false, errReply
+	}
+	return e.str, true, nil
+}
+
+// setString 写入字符串值；keepTTL 为 false 时清除原有过期时间
+func (x *execCtx) setString(key, value string, expireAt time.Time, keepTTL bool) {
+	if old := x.ks.lookup(key, x.now); old != nil && keepTTL && old.kind == kindString {
+		old.str = value
+		return
+	}
+	x.ks.data[key] = &entry{kind: kindString, str: value, expireAt: expireAt}
+}
+
+func cmdGet(x *execCtx, args []string) any {
+	value, ok, errReply := x.getString(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	if !ok {
+		return nil
+	}
+	return value
+}
+
+// cmdSet 实现 SET key value [NX|XX] [GET] [EX s|PX ms|EXAT ts|PXAT ts-ms|KEEPTTL]
+func cmdSet(x *execCtx, args []string) any {
+	key, value := args[0], args[1]
+	var nx, xx, get, keepTTL bool
+	var expireAt time.Time
+	for i := 2; i < len(args); i++ {
+		opt := strings.ToLower(args[i])
+		switch opt {
+		case "nx":
+			nx = true
+		case "xx":
+			xx = true
+		case "get":
+			get = true
+		case "keepttl":
+			keepTTL = true
+		case "ex", "px", "exat", "pxat":
+			if i+1 >= len(args) || !expireAt.IsZero() {
+				return errSyntax
+			}
+			n, ok := parseInt(args[i+1])
+			if !ok {
+				return errNotInteger
+			}
+			if n <= 0 {
+				return errorReply("ERR invalid expire time in 'set' command")
+			}
+			i++
+			switch opt {
+			case "ex":
+				expireAt = x.now.Add(time.Duration(n) * time.Second)
+			case "px":
+				expireAt = x.now.Add(time.Duration(n) * time.Millisecond)
+			case "exat":
+				expireAt = time.Unix(n, 0)
+			case "pxat":
+				expireAt = time.UnixMilli(n)
+			}
+		default:
+			return errSyntax
+		}
+	}
+	if (nx && xx) || (keepTTL && !expireAt.IsZero()) {
+		return errSyntax
+	}
+
+	var old any
+	existing := x.ks.lookup(key, x.now)
+	if get && existing != nil {
+		if existing.kind != kindString {
+			return errWrongType
+		}
+		old = existing.str
+	}
+	if (nx && existing != nil) || (xx && existing == nil) {
+		if get {
+			return old
+		}
+		return nil
+	}
+
+	x.setString(key, value, expireAt, keepTTL)
+	if get {
+		return old
+	}
+	return simpleString("OK")
+}
+
+func cmdSetNX(x *execCtx, args []string) any {
+	if x.ks.lookup(args[0], x.now) != nil {
+		return int64(0)
+	}
+	x.setString(args[0], args[1], time.Time{}, false)
+	return int64(1)
+}
+
+func setExCommand(unit time.Duration) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		n, ok := parseInt(args[1])
+		if !ok {
+			return errNotInteger
+		}
+		if n <= 0 {
+			return errorReply("ERR invalid expire time in 'setex' command")
+		}
+		x.setString(args[0], args[2], x.now.Add(time.Duration(n)*unit), false)
+		return simpleString("OK")
+	}
+}
+
+func cmdGetSet(x *execCtx, args []string) any {
+	value, ok, errReply := x.getString(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	x.setString(args[0], args[1], time.Time{}, false)
+	if !ok {
+		return nil
+	}
+	return value
+}
+
+func cmdGetDel(x *execCtx, args []string) any {
+	value, ok, errReply := x.getString(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	if !ok {
+		return nil
+	}
+	delete(x.ks.data, args[0])
+	return value
+}
+
+func cmdMGet(x *execCtx, args []string) any {
+	values := make([]any, 0, len(args))
+	for _, key := range args {
+		e := x.ks.lookup(key, x.now)
+		if e == nil || e.kind != kindString {
+			values = append(values, nil)
+			continue
+		}
+		values = append(values, e.str)
+	}
+	return values
+}
+
+func cmdMSet(x *execCtx, args []string) any {
+	if len(args)%2 != 0 {
+		return wrongArgs("mset")
+	}
+	for i := 0; i < len(args); i += 2 {
+		x.setString(args[i], args[i+1], time.Time{}, false)
+	}
+	return simpleString("OK")
+}
+
+// incrCommand 实现 INCR / DECR / INCRBY / DECRBY；sign 为 -1 时取反增量
+func incrCommand(sign int64, withDelta bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		delta := int64(1)
+		if withDelta {
+			n, ok := parseInt(args[1])
+			if !ok {
+				return errNotInteger
+			}
+			delta = n
+		}
+		if sign < 0 {
+			if delta == math.MinInt64 {
+				return errOverflow
+			}
+			delta = -delta
+		}
+		return x.incrBy(args[0], delta)
+	}
+}
+
+func (x *execCtx) incrBy(key string, delta int64) any {
+	e, errReply := x.ks.lookupKind(key, kindString, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	current := int64(0)
+	if e != nil {
+		n, ok := parseInt(e.str)
+		if !ok {
+			return errNotInteger
+		}
+		current = n
+	}
+	if (delta > 0 && current > math.MaxInt64-delta) || (delta < 0 && current < math.MinInt64-delta) {
+		return errOverflow
+	}
+	current += delta
+	if e != nil {
+		e.str = strconv.FormatInt(current, 10)
+	} else {
+		x.ks.data[key] = &entry{kind: kindString, str: strconv.FormatInt(current, 10)}
+	}
+	return current
+}
+
+func cmdIncrByFloat(x *execCtx, args []string) any {
+	delta, ok := parseFloat(args[1])
+	if !ok {
+		return errNotFloat
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindString, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	current := 0.0
+	if e != nil {
+		f, ok := parseFloat(e.str)
+		if !ok {
+			return errNotFloat
+		}
+		current = f
+	}
+	current += delta
+	if math.IsInf(current, 0) || math.IsNaN(current) {
+		return errorReply("ERR increment would produce NaN or Infinity")
+	}
+	formatted := formatFloat(current)
+	if e != nil {
+		e.str = formatted
+	} else {
+		x.ks.data[args[0]] = &entry{kind: kindString, str: formatted}
+	}
+	return formatted
+}
+
+func cmdAppend(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindString, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		x.ks.data[args[0]] = &entry{kind: kindString, str: args[1]}
+		return int64(len(args[1]))
+	}
+	e.str += args[1]
+	return int64(len(e.str))
+}
+
+func cmdStrlen(x *execCtx, args []string) any {
+	value, _, errReply := x.getString(args[0])
+	if errReply != nil {
+		return errReply
+	}
+	return int64(len(value))
+}
@@ -0,0 +1,528 @@
+package memredis
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// zmember 有序集合元素
+type zmember struct {
+	member string
+	score  float64
+}
+
+func zless(a, b zmember) bool {
+	if a.score != b.score {
+		return a.score < b.score
+	}
+	return a.member < b.member
+}
+
+// sortedSet 有序集合：map 用于按成员查分数，切片按 (score, member) 升序维护排名
+type sortedSet struct {
+	scores map[string]float64
+	items  []zmember
+}
+
+func newSortedSet() *sortedSet {
+	return &sortedSet{scores: make(map[string]float64)}
+}
+
+func (z *sortedSet) len() int { return len(z.items) }
+
+func (z *sortedSet) search(item zmember) int {
+	return sort.Search(len(z.items), func(i int) bool { return !zless(z.items[i], item) })
+}
+
+// add 写入成员，返回是否为新成员
+func (z *sortedSet) add(member string, score float64) bool {
+	old, exists := z.scores[member]
+	if exists {
+		if old == score {
+			return false
+		}
+		z.remove(member)
+	}
+	item := zmember{member: member, score: score}
+	idx := z.search(item)
+	z.items = append(z.items, zmember{})
+	copy(z.items[idx+1:], z.items[idx:])
+	z.items[idx] = item
+	z.scores[member] = score
+	return !exists
+}
+
+func (z *sortedSet) remove(member string) bool {
+	score, ok := z.scores[member]
+	if !ok {
+		return false
+	}
+	idx := z.search(zmember{member: member, score: score})
+	z.items = append(z.items[:idx], z.items[idx+1:]...)
+	delete(z.scores, member)
+	return true
+}
+
+func (z *sortedSet) rank(member string) (int, bool) {
+	score, ok := z.scores[member]
+	if !ok {
+		return 0, false
+	}
+	return z.search(zmember{member: member, score: score}), true
+}
+
+// scoreBound 分数区间端点，支持 "(" 开区间与 ±inf
+type scoreBound struct {
+	value     float64
+	exclusive bool
+}
+
+func parseScoreBound(s string) (scoreBound, bool) {
+	var b scoreBound
+	if strings.HasPrefix(s, "(") {
+		b.exclusive = true
+		s = s[1:]
+	}
+	f, ok := parseFloat(s)
+	if !ok {
+		return b, false
+	}
+	b.value = f
+	return b, true
+}
+
+func (b scoreBound) aboveMin(score float64) bool {
+	if b.exclusive {
+		return score > b.value
+	}
+	return score >= b.value
+}
+
+func (b scoreBound) belowMax(score float64) bool {
+	if b.exclusive {
+		return score < b.value
+	}
+	return score <= b.value
+}
+
+// rangeByScore 返回分数区间内的成员（升序）
+func (z *sortedSet) rangeByScore(min, max scoreBound) []zmember {
+	start := sort.Search(len(z.items), func(i int) bool { return min.aboveMin(z.items[i].score) })
+	end := start
+	for end < len(z.items) && max.belowMax(z.items[end].score) {
+		end++
+	}
+	return z.items[start:end]
+}
+
+// normalizeRange 将 Redis 风格的 start/stop（可为负数）转换为切片区间
+func normalizeRange(start, stop int64, length int) (int, int, bool) {
+	n := int64(length)
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	if start > stop || start >= n {
+		return 0, 0, false
+	}
+	return int(start), int(stop) + 1, true
+}
+
+var sortedSetCommands = map[string]cmdSpec{
+	"zadd":             {fn: cmdZAdd, arity: -4},
+	"zrem":             {fn: cmdZRem, arity: -3},
+	"zscore":           {fn: cmdZScore, arity: 3},
+	"zcard":            {fn: cmdZCard, arity: 2},
+	"zcount":           {fn: cmdZCount, arity: 4},
+	"zincrby":          {fn: cmdZIncrBy, arity: 4},
+	"zrank":            {fn: zrankCommand(false), arity: 3},
+	"zrevrank":         {fn: zrankCommand(true), arity: 3},
+	"zrange":           {fn: zrangeCommand(false), arity: -4},
+	"zrevrange":        {fn: zrangeCommand(true), arity: -4},
+	"zrangebyscore":    {fn: zrangeByScoreCommand(false), arity: -4},
+	"zrevrangebyscore": {fn: zrangeByScoreCommand(true), arity: -4},
+	"zremrangebyscore": {fn: cmdZRemRangeByScore, arity: 4},
+	"zremrangebyrank":  {fn: cmdZRemRangeByRank, arity: 4},
+}
+
+// cmdZAdd 实现 ZADD key [NX|XX] [GT|LT] [CH] [INCR] score member ...
+func cmdZAdd(x *execCtx, args []string) any {
+	key := args[0]
+	var nx, xx, gt, lt, ch, incr bool
+	i := 1
+	for ; i < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "nx":
+			nx = true
+		case "xx":
+			xx = true
+		case "gt":
+			gt = true
+		case "lt":
+			lt = true
+		case "ch":
+			ch = true
+		case "incr":
+			incr = true
+		default:
+			goto pairs
+		}
+	}
+pairs:
+	rest := args[i:]
+	if len(rest) == 0 || len(rest)%2 != 0 || (nx && xx) || (gt && lt) || (nx && (gt || lt)) {
+		return errSyntax
+	}
+	if incr && len(rest) != 2 {
+		return errorReply("ERR INCR option supports a single increment-element pair")
+	}
+	scores := make([]float64, 0, len(rest)/2)
+	for j := 0; j < len(rest); j += 2 {
+		score, ok := parseFloat(rest[j])
+		if !ok {
+			return errNotFloat
+		}
+		scores = append(scores, score)
+	}
+
+	e, errReply := x.ks.getOrCreate(key, kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	defer x.ks.dropIfEmpty(key, e)
+
+	added, changed := int64(0), int64(0)
+	for j := 0; j < len(rest); j += 2 {
+		member, score := rest[j+1], scores[j/2]
+		old, exists := e.zset.scores[member]
+		if (nx && exists) || (xx && !exists) {
+			if incr {
+				return nil
+			}
+			continue
+		}
+		if incr && exists {
+			score += old
+			if math.IsNaN(score) {
+				return errorReply("ERR resulting score is not a number (NaN)")
+			}
+		}
+		if exists && ((gt && score <= old) || (lt && score >= old)) {
+			if incr {
+				return nil
+			}
+			continue
+		}
+		if e.zset.add(member, score) {
+			added++
+		} else if exists && old != score {
+			changed++
+		}
+		if incr {
+			return formatFloat(score)
+		}
+	}
+	if ch {
+		return added + changed
+	}
+	return added
+}
+
+func cmdZRem(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	removed := int64(0)
+	for _, member := range args[1:] {
+		if e.zset.remove(member) {
+			removed++
+		}
+	}
+	x.ks.dropIfEmpty(args[0], e)
+	return removed
+}
+
+func cmdZScore(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return nil
+	}
+	score, ok := e.zset.scores[args[1]]
+	if !ok {
+		return nil
+	}
+	return formatFloat(score)
+}
+
+func cmdZCard(x *execCtx, args []string) any {
+	e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	return int64(e.zset.len())
+}
+
+func cmdZCount(x *execCtx, args []string) any {
+	min, ok1 := parseScoreBound(args[1])
+	max, ok2 := parseScoreBound(args[2])
+	if !ok1 || !ok2 {
+		return errorReply("ERR min or max is not a float")
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	return int64(len(e.zset.rangeByScore(min, max)))
+}
+
+func cmdZIncrBy(x *execCtx, args []string) any {
+	delta, ok := parseFloat(args[1])
+	if !ok {
+		return errNotFloat
+	}
+	e, errReply := x.ks.getOrCreate(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	score := e.zset.scores[args[2]] + delta
+	if math.IsNaN(score) {
+		x.ks.dropIfEmpty(args[0], e)
+		return errorReply("ERR resulting score is not a number (NaN)")
+	}
+	e.zset.add(args[2], score)
+	return formatFloat(score)
+}
+
+func zrankCommand(reverse bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+		if errReply != nil {
+			return errReply
+		}
+		if e == nil {
+			return nil
+		}
+		rank, ok := e.zset.rank(args[1])
+		if !ok {
+			return nil
+		}
+		if reverse {
+			rank = e.zset.len() - 1 - rank
+		}
+		return int64(rank)
+	}
+}
+
+// zmembersReply 输出成员列表，withScores 时成员与分数交替输出
+func zmembersReply(items []zmember, reverse, withScores bool) []string {
+	out := make([]string, 0, len(items)*2)
+	for i := range items {
+		item := items[i]
+		if reverse {
+			item = items[len(items)-1-i]
+		}
+		out = append(out, item.member)
+		if withScores {
+			out = append(out, formatFloat(item.score))
+		}
+	}
+	return out
+}
+
+// zrangeCommand 实现 ZRANGE / ZREVRANGE 按排名查询（ZRANGE 额外支持 BYSCORE / REV / LIMIT）
+func zrangeCommand(defaultReverse bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		reverse := defaultReverse
+		withScores, byScore := false, false
+		var limit []string
+		for i := 3; i < len(args); i++ {
+			switch strings.ToLower(args[i]) {
+			case "withscores":
+				withScores = true
+			case "byscore":
+				byScore = true
+			case "rev":
+				reverse = !reverse
+			case "limit":
+				if i+2 >= len(args) {
+					return errSyntax
+				}
+				limit = args[i+1 : i+3]
+				i += 2
+			default:
+				return errSyntax
+			}
+		}
+		if byScore {
+			// REV 时参数顺序已是 max min，与 ZREVRANGEBYSCORE 一致
+			rest := []string{args[0], args[1], args[2]}
+			if withScores {
+				rest = append(rest, "withscores")
+			}
+			if limit != nil {
+				rest = append(rest, "limit", limit[0], limit[1])
+			}
+			return zrangeByScoreCommand(reverse)(x, rest)
+		}
+		if limit != nil {
+			return errSyntax
+		}
+
+		start, ok1 := parseInt(args[1])
+		stop, ok2 := parseInt(args[2])
+		if !ok1 || !ok2 {
+			return errNotInteger
+		}
+		e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+		if errReply != nil {
+			return errReply
+		}
+		if e == nil {
+			return []any{}
+		}
+		from, to, ok := normalizeRange(start, stop, e.zset.len())
+		if !ok {
+			return []any{}
+		}
+		if reverse {
+			n := e.zset.len()
+			from, to = n-to, n-from
+		}
+		return zmembersReply(e.zset.items[from:to], reverse, withScores)
+	}
+}
+
+// zrangeByScoreCommand 实现 ZRANGEBYSCORE / ZREVRANGEBYSCORE（REV 时参数顺序为 max min）
+func zrangeByScoreCommand(reverse bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		minArg, maxArg := args[1], args[2]
+		if reverse {
+			minArg, maxArg = maxArg, minArg
+		}
+		min, ok1 := parseScoreBound(minArg)
+		max, ok2 := parseScoreBound(maxArg)
+		if !ok1 || !ok2 {
+			return errorReply("ERR min or max is not a float")
+		}
+		withScores := false
+		offset, count := int64(0), int64(-1)
+		for i := 3; i < len(args); i++ {
+			switch strings.ToLower(args[i]) {
+			case "withscores":
+				withScores = true
+			case "limit":
+				if i+2 >= len(args) {
+					return errSyntax
+				}
+				var ok1, ok2 bool
+				offset, ok1 = parseInt(args[i+1])
+				count, ok2 = parseInt(args[i+2])
+				if !ok1 || !ok2 {
+					return errNotInteger
+				}
+				i += 2
+			default:
+				return errSyntax
+			}
+		}
+
+		e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+		if errReply != nil {
+			return errReply
+		}
+		if e == nil {
+			return []any{}
+		}
+		items := e.zset.rangeByScore(min, max)
+		if reverse {
+			ordered := make([]zmember, len(items))
+			for i := range items {
+				ordered[i] = items[len(items)-1-i]
+			}
+			items = ordered
+		}
+		if offset < 0 || offset >= int64(len(items)) {
+			return []any{}
+		}
+		end := int64(len(items))
+		if count >= 0 && offset+count < end {
+			end = offset + count
+		}
+		return zmembersReply(items[offset:end], false, withScores)
+	}
+}
+
+func cmdZRemRangeByScore(x *execCtx, args []string) any {
+	min, ok1 := parseScoreBound(args[1])
+	max, ok2 := parseScoreBound(args[2])
+	if !ok1 || !ok2 {
+		return errorReply("ERR min or max is not a float")
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	items := e.zset.rangeByScore(min, max)
+	members := make([]string, len(items))
+	for i, item := range items {
+		members[i] = item.member
+	}
+	for _, member := range members {
+		e.zset.remove(member)
+	}
+	x.ks.dropIfEmpty(args[0], e)
+	return int64(len(members))
+}
+
+func cmdZRemRangeByRank(x *execCtx, args []string) any {
+	start, ok1 := parseInt(args[1])
+	stop, ok2 := parseInt(args[2])
+	if !ok1 || !ok2 {
+		return errNotInteger
+	}
+	e, errReply := x.ks.lookupKind(args[0], kindZSet, x.now)
+	if errReply != nil {
+		return errReply
+	}
+	if e == nil {
+		return int64(0)
+	}
+	from, to, ok := normalizeRange(start, stop, e.zset.len())
+	if !ok {
+		return int64(0)
+	}
+	members := make([]string, 0, to-from)
+	for _, item := range e.zset.items[from:to] {
+		members = append(members, item.member)
+	}
+	for _, member := range members {
+		e.zset.remove(member)
+	}
+	x.ks.dropIfEmpty(args[0], e)
+	return int64(len(members))
+}
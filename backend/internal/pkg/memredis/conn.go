@@ -0,0 +1,140 @@
+package memredis
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// memAddr 进程内连接的地址
+type memAddr struct{}
+
+func (memAddr) Network() string { return "memory" }
+func (memAddr) String() string  { return "embedded" }
+
+// pipeBuffer 单向无界缓冲区
+//
+// 与 net.Pipe 不同，写入永不阻塞：客户端一次写出整批 pipeline 命令时，
+// 服务端可以边读边回写而不会互相等待导致死锁（等价于 TCP 的内核缓冲区）。
+type pipeBuffer struct {
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	closed   bool
+	notify   chan struct{}
+	done     chan struct{}
+	deadline time.Time
+}
+
+func newPipeBuffer() *pipeBuffer {
+	return &pipeBuffer{notify: make(chan struct{}, 1), done: make(chan struct{})}
+}
+
+func (p *pipeBuffer) signal() {
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (p *pipeBuffer) write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return 0, io.ErrClosedPipe
+	}
+	n, _ := p.buf.Write(b)
+	p.signal()
+	return n, nil
+}
+
+func (p *pipeBuffer) read(b []byte) (int, error) {
+	for {
+		p.mu.Lock()
+		if p.buf.Len() > 0 {
+			n, _ := p.buf.Read(b)
+			p.mu.Unlock()
+			return n, nil
+		}
+		if p.closed {
+			p.mu.Unlock()
+			return 0, io.EOF
+		}
+		deadline := p.deadline
+		p.mu.Unlock()
+
+		if deadline.IsZero() {
+			<-p.notify
+			continue
+		}
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return 0, os.ErrDeadlineExceeded
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-p.notify:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p *pipeBuffer) close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.done)
+	}
+	p.mu.Unlock()
+	p.signal()
+}
+
+func (p *pipeBuffer) setDeadline(t time.Time) {
+	p.mu.Lock()
+	p.deadline = t
+	p.mu.Unlock()
+	p.signal()
+}
+
+// memConn 进程内全双工连接
+type memConn struct {
+	in        *pipeBuffer
+	out       *pipeBuffer
+	closeOnce sync.Once
+}
+
+// newConnPair 创建一对互联的进程内连接
+func newConnPair() (client, server *memConn) {
+	a, b := newPipeBuffer(), newPipeBuffer()
+	return &memConn{in: a, out: b}, &memConn{in: b, out: a}
+}
+
+func (c *memConn) Read(b []byte) (int, error)  { return c.in.read(b) }
+func (c *memConn) Write(b []byte) (int, error) { return c.out.write(b) }
+
+func (c *memConn) Close() error {
+	c.closeOnce.Do(func() {
+		c.in.close()
+		c.out.close()
+	})
+	return nil
+}
+
+func (c *memConn) LocalAddr() net.Addr  { return memAddr{} }
+func (c *memConn) RemoteAddr() net.Addr { return memAddr{} }
+
+func (c *memConn) SetDeadline(t time.Time) error {
+	c.in.setDeadline(t)
+	return nil
+}
+
+func (c *memConn) SetReadDeadline(t time.Time) error {
+	c.in.setDeadline(t)
+	return nil
+}
+
+// SetWriteDeadline 写入不会阻塞，无需处理写超时
+func (c *memConn) SetWriteDeadline(time.Time) error { return nil }
@@ -0,0 +1,157 @@
+package memredis
+
+import (
+	"time"
+)
+
+type valueKind int
+
+const (
+	kindString valueKind = iota + 1
+	kindHash
+	kindSet
+	kindZSet
+	kindList
+	kindStream
+)
+
+func (k valueKind) typeName() string {
+	switch k {
+	case kindString:
+		return "string"
+	case kindHash:
+		return "hash"
+	case kindSet:
+		return "set"
+	case kindZSet:
+		return "zset"
+	case kindList:
+		return "list"
+	case kindStream:
+		return "stream"
+	default:
+		return "none"
+	}
+}
+
+// entry 键值条目，按 kind 使用对应字段
+type entry struct {
+	kind     valueKind
+	str      string
+	hash     map[string]string
+	set      map[string]struct{}
+	zset     *sortedSet
+	list     []string
+	stream   *stream
+	expireAt time.Time
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// empty 集合类型元素清空后由调用方删除键（与 Redis 一致；Stream 除外）
+func (e *entry) empty() bool {
+	switch e.kind {
+	case kindHash:
+		return len(e.hash) == 0
+	case kindSet:
+		return len(e.set) == 0
+	case kindZSet:
+		return e.zset.len() == 0
+	case kindList:
+		return len(e.list) == 0
+	default:
+		return false
+	}
+}
+
+const (
+	// 主动过期：每轮最多检查的键数量与最小间隔（惰性过期之外的补充，避免过期键长期占用内存）
+	activeExpireSample   = 200
+	activeExpireInterval = time.Second
+)
+
+// keyspace 单个逻辑库
+type keyspace struct {
+	data       map[string]*entry
+	lastExpire time.Time
+}
+
+func newKeyspace() *keyspace {
+	return &keyspace{data: make(map[string]*entry)}
+}
+
+// lookup 返回未过期的条目，过期条目顺带删除
+func (ks *keyspace) lookup(key string, now time.Time) *entry {
+	e, ok := ks.data[key]
+	if !ok {
+		return nil
+	}
+	if e.expired(now) {
+		delete(ks.data, key)
+		return nil
+	}
+	return e
+}
+
+// lookupKind 返回指定类型的条目；类型不符时返回 WRONGTYPE 错误
+func (ks *keyspace) lookupKind(key string, kind valueKind, now time.Time) (*entry, any) {
+	e := ks.lookup(key, now)
+	if e == nil {
+		return nil, nil
+	}
+	if e.kind != kind {
+		return nil, errWrongType
+	}
+	return e, nil
+}
+
+// getOrCreate 返回指定类型的条目，不存在时创建
+func (ks *keyspace) getOrCreate(key string, kind valueKind, now time.Time) (*entry, any) {
+	e, errReply := ks.lookupKind(key, kind, now)
+	if errReply != nil {
+		return nil, errReply
+	}
+	if e != nil {
+		return e, nil
+	}
+	e = &entry{kind: kind}
+	switch kind {
+	case kindHash:
+		e.hash = make(map[string]string)
+	case kindSet:
+		e.set = make(map[string]struct{})
+	case kindZSet:
+		e.zset = newSortedSet()
+	case kindStream:
+		e.stream = newStream()
+	}
+	ks.data[key] = e
+	return e, nil
+}
+
+// dropIfEmpty 集合类型元素清空后删除键
+func (ks *keyspace) dropIfEmpty(key string, e *entry) {
+	if e != nil && e.empty() {
+		delete(ks.data, key)
+	}
+}
+
+// activeExpire 抽样清理过期键
+func (ks *keyspace) activeExpire(now time.Time) {
+	if now.Sub(ks.lastExpire) < activeExpireInterval {
+		return
+	}
+	ks.lastExpire = now
+	checked := 0
+	for key, e := range ks.data {
+		if e.expired(now) {
+			delete(ks.data, key)
+		}
+		checked++
+		if checked >= activeExpireSample {
+			break
+		}
+	}
+}
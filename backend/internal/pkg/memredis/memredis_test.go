@@ -0,0 +1,280 @@
+package memredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) (*Server, *redis.Client) {
+	t.Helper()
+	srv := New()
+	rdb := redis.NewClient(&redis.Options{Addr: "embedded", Dialer: srv.Dial})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+		_ = srv.Close()
+	})
+	return srv, rdb
+}
+
+// fakeClock 可控时钟，用于验证过期逻辑
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+func TestStringsAndTTL(t *testing.T) {
+	srv, rdb := newTestClient(t)
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	srv.now = clock.Now
+	ctx := context.Background()
+
+	require.NoError(t, rdb.Set(ctx, "k", "v", 10*time.Second).Err())
+	got, err := rdb.Get(ctx, "k").Result()
+	require.NoError(t, err)
+	require.Equal(t, "v", got)
+
+	ttl, err := rdb.TTL(ctx, "k").Result()
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, ttl)
+
+	ok, err := rdb.SetNX(ctx, "k", "other", 0).Result()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	clock.Advance(10 * time.Second)
+	_, err = rdb.Get(ctx, "k").Result()
+	require.ErrorIs(t, err, redis.Nil)
+	require.Equal(t, int64(0), rdb.Exists(ctx, "k").Val())
+
+	n, err := rdb.IncrBy(ctx, "counter", 5).Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), n)
+	require.Equal(t, time.Duration(-1), rdb.TTL(ctx, "counter").Val())
+	require.Equal(t, time.Duration(-2), rdb.TTL(ctx, "missing").Val())
+
+	f, err := rdb.IncrByFloat(ctx, "float", 1.5).Result()
+	require.NoError(t, err)
+	require.InDelta(t, 1.5, f, 1e-9)
+
+	require.NoError(t, rdb.HSet(ctx, "h", "a", "1").Err())
+	err = rdb.Get(ctx, "h").Err()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "WRONGTYPE")
+}
+
+func TestPipelineAndTransaction(t *testing.T) {
+	_, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	// 大批量 pipeline 不应因回写阻塞而死锁
+	pipe := rdb.Pipeline()
+	for i := 0; i < 5000; i++ {
+		pipe.Set(ctx, fmt.Sprintf("key:%d", i), i, 0)
+	}
+	_, err := pipe.Exec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(5000), rdb.DBSize(ctx).Val())
+
+	tx := rdb.TxPipeline()
+	incr := tx.Incr(ctx, "tx")
+	tx.Expire(ctx, "tx", time.Minute)
+	_, err = tx.Exec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), incr.Val())
+	require.Equal(t, time.Minute, rdb.TTL(ctx, "tx").Val())
+
+	var cursor uint64
+	var keys []string
+	for {
+		batch, next, err := rdb.Scan(ctx, cursor, "key:1*", 100).Result()
+		require.NoError(t, err)
+		keys = append(keys, batch...)
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+	require.Len(t, keys, 1111)
+}
+
+func TestSortedSetAndHash(t *testing.T) {
+	_, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	require.NoError(t, rdb.ZAdd(ctx, "z",
+		redis.Z{Score: 3, Member: "c"},
+		redis.Z{Score: 1, Member: "a"},
+		redis.Z{Score: 2, Member: "b"},
+	).Err())
+	require.Equal(t, []string{"a", "b", "c"}, rdb.ZRange(ctx, "z", 0, -1).Val())
+	require.Equal(t, []string{"c", "b"}, rdb.ZRevRange(ctx, "z", 0, 1).Val())
+	require.Equal(t, int64(2), rdb.ZCount(ctx, "z", "(1", "+inf").Val())
+	require.Equal(t, int64(1), rdb.ZRank(ctx, "z", "b").Val())
+
+	removed, err := rdb.ZRemRangeByScore(ctx, "z", "-inf", "2").Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), removed)
+	require.Equal(t, []redis.Z{{Score: 3, Member: "c"}}, rdb.ZRangeWithScores(ctx, "z", 0, -1).Val())
+
+	// 元素清空后键被删除
+	require.NoError(t, rdb.ZRem(ctx, "z", "c").Err())
+	require.Equal(t, int64(0), rdb.Exists(ctx, "z").Val())
+
+	require.NoError(t, rdb.HSet(ctx, "h", "a", "1", "b", "2").Err())
+	require.Equal(t, map[string]string{"a": "1", "b": "2"}, rdb.HGetAll(ctx, "h").Val())
+	require.Equal(t, int64(11), rdb.HIncrBy(ctx, "h", "a", 10).Val())
+	require.NoError(t, rdb.HDel(ctx, "h", "a", "b").Err())
+	require.Equal(t, int64(0), rdb.Exists(ctx, "h").Val())
+}
+
+func TestPubSub(t *testing.T) {
+	_, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	sub := rdb.Subscribe(ctx, "events")
+	defer func() { _ = sub.Close() }()
+	_, err := sub.Receive(ctx)
+	require.NoError(t, err)
+
+	psub := rdb.PSubscribe(ctx, "ev*")
+	defer func() { _ = psub.Close() }()
+	_, err = psub.Receive(ctx)
+	require.NoError(t, err)
+
+	receivers, err := rdb.Publish(ctx, "events", "hello").Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), receivers)
+
+	msg, err := sub.ReceiveMessage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "events", msg.Channel)
+	require.Equal(t, "hello", msg.Payload)
+
+	pmsg, err := psub.ReceiveMessage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ev*", pmsg.Pattern)
+	require.Equal(t, "hello", pmsg.Payload)
+}
+
+func TestStreamConsumerGroup(t *testing.T) {
+	_, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	require.NoError(t, rdb.XGroupCreateMkStream(ctx, "s", "g", "0").Err())
+	err := rdb.XGroupCreateMkStream(ctx, "s", "g", "0").Err()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "BUSYGROUP")
+
+	// 阻塞读取在新消息写入后被唤醒
+	done := make(chan []redis.XStream, 1)
+	go func() {
+		streams, _ := rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group: "g", Consumer: "c1", Streams: []string{"s", ">"}, Count: 10, Block: 5 * time.Second,
+		}).Result()
+		done <- streams
+	}()
+	time.Sleep(50 * time.Millisecond)
+	id, err := rdb.XAdd(ctx, &redis.XAddArgs{Stream: "s", Values: map[string]any{"payload": "p1"}}).Result()
+	require.NoError(t, err)
+
+	select {
+	case streams := <-done:
+		require.Len(t, streams, 1)
+		require.Len(t, streams[0].Messages, 1)
+		require.Equal(t, id, streams[0].Messages[0].ID)
+		require.Equal(t, "p1", streams[0].Messages[0].Values["payload"])
+	case <-time.After(3 * time.Second):
+		t.Fatal("blocking XREADGROUP was not woken up")
+	}
+
+	pending, err := rdb.XPending(ctx, "s", "g").Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pending.Count)
+	require.Equal(t, map[string]int64{"c1": 1}, pending.Consumers)
+
+	ext, err := rdb.XPendingExt(ctx, &redis.XPendingExtArgs{Stream: "s", Group: "g", Start: "-", End: "+", Count: 10}).Result()
+	require.NoError(t, err)
+	require.Len(t, ext, 1)
+	require.Equal(t, int64(1), ext[0].RetryCount)
+
+	claimed, err := rdb.XClaim(ctx, &redis.XClaimArgs{Stream: "s", Group: "g", Consumer: "c2", Messages: []string{id}}).Result()
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	require.Equal(t, int64(1), rdb.XAck(ctx, "s", "g", id).Val())
+	require.Equal(t, int64(1), rdb.XDel(ctx, "s", id).Val())
+	require.Equal(t, int64(0), rdb.XLen(ctx, "s").Val())
+
+	empty, err := rdb.XPending(ctx, "s", "g").Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), empty.Count)
+
+	_, err = rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group: "g", Consumer: "c1", Streams: []string{"s", ">"}, Block: 20 * time.Millisecond,
+	}).Result()
+	require.ErrorIs(t, err, redis.Nil)
+
+	err = rdb.XReadGroup(ctx, &redis.XReadGroupArgs{Group: "missing", Consumer: "c1", Streams: []string{"s", ">"}}).Err()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "NOGROUP")
+}
+
+func TestScriptRegistry(t *testing.T) {
+	_, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	script := redis.NewScript(`return redis.call('INCRBY', KEYS[1], ARGV[1])`)
+	RegisterScript(script.Hash(), func(call CallFunc, keys, args []string) (any, error) {
+		return call("INCRBY", keys[0], args[0])
+	})
+
+	// 首次 EVALSHA 返回 NOSCRIPT，go-redis 自动回退到 EVAL
+	err := rdb.EvalSha(ctx, script.Hash(), []string{"n"}, 1).Err()
+	require.Error(t, err)
+	require.True(t, redis.HasErrorPrefix(err, "NOSCRIPT"))
+
+	n, err := script.Run(ctx, rdb, []string{"n"}, 2).Int64()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	n, err = rdb.EvalSha(ctx, script.Hash(), []string{"n"}, 3).Int64()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), n)
+
+	// 脚本内命令出错时整体返回错误
+	require.NoError(t, rdb.HSet(ctx, "h", "f", "v").Err())
+	err = script.Run(ctx, rdb, []string{"h"}, 1).Err()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "WRONGTYPE")
+
+	err = redis.NewScript(`return 1`).Run(ctx, rdb, nil).Err()
+	require.Error(t, err)
+	require.False(t, errors.Is(err, redis.Nil))
+	require.Contains(t, err.Error(), "no Go implementation registered")
+}
+
+func TestLuaNumber(t *testing.T) {
+	require.Equal(t, "3", LuaNumber(3))
+	require.Equal(t, "0.1", LuaNumber(0.1))
+	require.Equal(t, "1.5", LuaNumber(1.5))
+	require.Equal(t, "0.33333333333333", LuaNumber(1.0/3))
+}
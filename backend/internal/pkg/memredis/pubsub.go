@@ -0,0 +1,153 @@
+package memredis
+
+import (
+	"sort"
+	"strings"
+)
+
+var pubsubCommands = map[string]cmdSpec{
+	"publish": {fn: cmdPublish, arity: 3},
+	"pubsub":  {fn: cmdPubSub, arity: -2},
+	// 订阅类命令由连接层处理，这里登记仅用于事务与脚本内的拒绝判断
+	"subscribe":    {fn: cmdSubscribeInContext, arity: -2, noScript: true},
+	"psubscribe":   {fn: cmdSubscribeInContext, arity: -2, noScript: true},
+	"unsubscribe":  {fn: cmdSubscribeInContext, arity: -1, noScript: true},
+	"punsubscribe": {fn: cmdSubscribeInContext, arity: -1, noScript: true},
+}
+
+func cmdSubscribeInContext(_ *execCtx, _ []string) any {
+	return errorReply("ERR subscribe commands are not allowed in this context")
+}
+
+// cmdPublish 向频道与匹配的模式订阅者推送消息，返回接收者数量
+func cmdPublish(x *execCtx, args []string) any {
+	channel, payload := args[0], args[1]
+	receivers := int64(0)
+	for c := range x.srv.channels[channel] {
+		c.write([]any{"message", channel, payload}, true)
+		receivers++
+	}
+	for pattern, subs := range x.srv.patterns {
+		if !globMatch(pattern, channel) {
+			continue
+		}
+		for c := range subs {
+			c.write([]any{"pmessage", pattern, channel, payload}, true)
+			receivers++
+		}
+	}
+	return receivers
+}
+
+func cmdPubSub(x *execCtx, args []string) any {
+	switch strings.ToLower(args[0]) {
+	case "channels":
+		pattern := "*"
+		if len(args) > 1 {
+			pattern = args[1]
+		}
+		names := make([]string, 0, len(x.srv.channels))
+		for name := range x.srv.channels {
+			if globMatch(pattern, name) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		return names
+	case "numsub":
+		out := make([]any, 0, (len(args)-1)*2)
+		for _, name := range args[1:] {
+			out = append(out, name, int64(len(x.srv.channels[name])))
+		}
+		return out
+	case "numpat":
+		return int64(len(x.srv.patterns))
+	default:
+		return errorReply("ERR unknown subcommand '" + args[0] + "'")
+	}
+}
+
+// subscribed 连接是否处于订阅模式（订阅关系只在连接自身协程中修改）
+func (c *client) subscribed() bool {
+	return len(c.channels) > 0 || len(c.patterns) > 0
+}
+
+func (c *client) subscriptionCount() int64 {
+	return int64(len(c.channels) + len(c.patterns))
+}
+
+// subscribe 处理 SUBSCRIBE / PSUBSCRIBE，每个频道回复一条确认
+func (c *client) subscribe(pattern bool, names []string) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+
+	kind, own, registry := "subscribe", &c.channels, c.srv.channels
+	if pattern {
+		kind, own, registry = "psubscribe", &c.patterns, c.srv.patterns
+	}
+	if *own == nil {
+		*own = make(map[string]struct{})
+	}
+	for _, name := range names {
+		(*own)[name] = struct{}{}
+		subs := registry[name]
+		if subs == nil {
+			subs = make(map[*client]struct{})
+			registry[name] = subs
+		}
+		subs[c] = struct{}{}
+		c.write([]any{kind, name, c.subscriptionCount()}, false)
+	}
+}
+
+// unsubscribe 处理 UNSUBSCRIBE / PUNSUBSCRIBE；未指定名称时退订该类型的全部订阅
+func (c *client) unsubscribe(pattern bool, names []string) {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+
+	kind, own, registry := "unsubscribe", c.channels, c.srv.channels
+	if pattern {
+		kind, own, registry = "punsubscribe", c.patterns, c.srv.patterns
+	}
+	if len(names) == 0 {
+		for name := range own {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			c.write([]any{kind, nil, c.subscriptionCount()}, false)
+			return
+		}
+	}
+	for _, name := range names {
+		delete(own, name)
+		if subs := registry[name]; subs != nil {
+			delete(subs, c)
+			if len(subs) == 0 {
+				delete(registry, name)
+			}
+		}
+		c.write([]any{kind, name, c.subscriptionCount()}, false)
+	}
+}
+
+// unsubscribeAllLocked 连接关闭时清理订阅关系，调用方需持有 s.mu
+func (s *Server) unsubscribeAllLocked(c *client) {
+	for name := range c.channels {
+		if subs := s.channels[name]; subs != nil {
+			delete(subs, c)
+			if len(subs) == 0 {
+				delete(s.channels, name)
+			}
+		}
+	}
+	for name := range c.patterns {
+		if subs := s.patterns[name]; subs != nil {
+			delete(subs, c)
+			if len(subs) == 0 {
+				delete(s.patterns, name)
+			}
+		}
+	}
+	c.channels, c.patterns = nil, nil
+}
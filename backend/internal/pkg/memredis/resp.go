@@ -0,0 +1,107 @@
+package memredis
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// 命令回复的内部表示：
+//   - simpleString → +OK
+//   - errorReply   → -ERR ...
+//   - int64        → :1
+//   - string       → $3\r\nfoo
+//   - nil          → $-1（nil bulk）
+//   - []any        → *N
+//   - nullArray    → *-1
+type (
+	simpleString string
+	errorReply   string
+	nullArray    struct{}
+)
+
+func (e errorReply) Error() string { return string(e) }
+
+const maxBulkLen = 512 << 20
+
+var errProtocol = errors.New("memredis: protocol error")
+
+// readCommand 读取一条 RESP 数组形式的命令
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 || line[0] != '*' {
+		return nil, errProtocol
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 0 {
+		return nil, errProtocol
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(line) == 0 || line[0] != '$' {
+			return nil, errProtocol
+		}
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 || size > maxBulkLen {
+			return nil, errProtocol
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return "", errProtocol
+	}
+	return line[:len(line)-2], nil
+}
+
+// writeReply 按 RESP2 编码回复
+func writeReply(w *bufio.Writer, v any) {
+	switch r := v.(type) {
+	case nil:
+		_, _ = w.WriteString("$-1\r\n")
+	case simpleString:
+		_, _ = w.WriteString("+" + string(r) + "\r\n")
+	case errorReply:
+		_, _ = w.WriteString("-" + string(r) + "\r\n")
+	case int64:
+		_, _ = w.WriteString(":" + strconv.FormatInt(r, 10) + "\r\n")
+	case int:
+		_, _ = w.WriteString(":" + strconv.Itoa(r) + "\r\n")
+	case string:
+		_, _ = w.WriteString("$" + strconv.Itoa(len(r)) + "\r\n" + r + "\r\n")
+	case nullArray:
+		_, _ = w.WriteString("*-1\r\n")
+	case []any:
+		_, _ = w.WriteString("*" + strconv.Itoa(len(r)) + "\r\n")
+		for _, item := range r {
+			writeReply(w, item)
+		}
+	case []string:
+		_, _ = w.WriteString("*" + strconv.Itoa(len(r)) + "\r\n")
+		for _, item := range r {
+			writeReply(w, item)
+		}
+	default:
+		writeReply(w, errorReply(fmt.Sprintf("ERR memredis: unsupported reply type %T", v)))
+	}
+}
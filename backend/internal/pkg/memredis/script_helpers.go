@@ -0,0 +1,74 @@
+package memredis
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// 以下辅助函数供各包编写 Lua 脚本的 Go 实现时使用
+
+// Number 等价于脚本中对 tonumber 结果直接参与运算：无法转换时返回错误（Lua 中会抛出运行时错误）
+func Number(v any) (float64, error) {
+	n, ok := ToNumber(v)
+	if !ok {
+		return 0, fmt.Errorf("user_script: attempt to perform arithmetic on a non-number value (%v)", v)
+	}
+	return n, nil
+}
+
+// NumberOr 等价于 tonumber(v or def)：v 为 nil（Lua 中的 false）时使用默认值
+func NumberOr(v any, def float64) (float64, error) {
+	if v == nil {
+		return def, nil
+	}
+	return Number(v)
+}
+
+// ServerTime 等价于 redis.call('TIME')，返回秒与微秒
+func ServerTime(call CallFunc) (sec, usec int64, err error) {
+	reply, err := call("TIME")
+	if err != nil {
+		return 0, 0, err
+	}
+	parts, ok := reply.([]any)
+	if !ok || len(parts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected TIME reply %v", reply)
+	}
+	sec, err = strconv.ParseInt(ArgString(parts[0]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	usec, err = strconv.ParseInt(ArgString(parts[1]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return sec, usec, nil
+}
+
+// CallInt 执行命令并将整数回复转换为 int64
+func CallInt(call CallFunc, args ...any) (int64, error) {
+	reply, err := call(args...)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected reply %v for %v", reply, args[0])
+	}
+	return n, nil
+}
+
+// CompareAndDelete 常见的分布式锁释放脚本的 Go 实现：
+//
+//	if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end
+//	return 0
+func CompareAndDelete(call CallFunc, keys, args []string) (any, error) {
+	current, err := call("GET", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := current.(string); ok && value == args[0] {
+		return call("DEL", keys[0])
+	}
+	return int64(0), nil
+}
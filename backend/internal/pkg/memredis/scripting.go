@@ -0,0 +1,285 @@
+package memredis
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CallFunc 脚本内执行 Redis 命令，等价于 Lua 中的 redis.call。
+//
+// 回复转换规则：整数 → int64，字符串 → string，nil 回复 → nil，数组 → []any，
+// 状态回复（如 OK）→ string；命令返回错误时以 error 返回，脚本应直接向上返回以中止执行。
+type CallFunc func(args ...any) (any, error)
+
+// ScriptFunc Lua 脚本的 Go 实现，在服务端全局锁内原子执行。
+//
+// 返回值按 Lua → Redis 的规则转换：int / int64 → 整数，float64 → 截断为整数，
+// string → 字符串，true → 1，false / nil → nil 回复，[]any 与 []string → 数组。
+type ScriptFunc func(call CallFunc, keys, args []string) (any, error)
+
+var (
+	scriptsMu sync.RWMutex
+	scripts   = make(map[string]ScriptFunc)
+)
+
+// RegisterScript 为脚本源码的 SHA1（即 redis.Script.Hash()）注册 Go 实现
+func RegisterScript(sha string, fn ScriptFunc) {
+	scriptsMu.Lock()
+	defer scriptsMu.Unlock()
+	scripts[strings.ToLower(sha)] = fn
+}
+
+func registeredScript(sha string) (ScriptFunc, bool) {
+	scriptsMu.RLock()
+	defer scriptsMu.RUnlock()
+	fn, ok := scripts[strings.ToLower(sha)]
+	return fn, ok
+}
+
+func scriptSHA(source string) string {
+	sum := sha1.Sum([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
+
+var scriptCommands = map[string]cmdSpec{
+	"eval":       {fn: evalCommand(false), arity: -3, noScript: true},
+	"eval_ro":    {fn: evalCommand(false), arity: -3, noScript: true},
+	"evalsha":    {fn: evalCommand(true), arity: -3, noScript: true},
+	"evalsha_ro": {fn: evalCommand(true), arity: -3, noScript: true},
+	"script":     {fn: cmdScript, arity: -2, noScript: true},
+}
+
+var errNoScript = errorReply("NOSCRIPT No matching script. Please use EVAL.")
+
+func errUnregisteredScript(sha string) errorReply {
+	return errorReply("ERR memredis: no Go implementation registered for script " + sha)
+}
+
+// loadScript 将脚本标记为已加载（与 Redis 一致，EVALSHA 只接受已加载的脚本）
+func (s *Server) loadScript(source string) (string, any) {
+	sha := scriptSHA(source)
+	if _, ok := registeredScript(sha); !ok {
+		return "", errUnregisteredScript(sha)
+	}
+	s.scripts[sha] = struct{}{}
+	return sha, nil
+}
+
+func evalCommand(bySHA bool) func(*execCtx, []string) any {
+	return func(x *execCtx, args []string) any {
+		numKeys, ok := parseInt(args[1])
+		if !ok {
+			return errNotInteger
+		}
+		if numKeys < 0 || numKeys > int64(len(args)-2) {
+			return errorReply("ERR Number of keys can't be greater than number of args")
+		}
+		keys := args[2 : 2+numKeys]
+		argv := args[2+numKeys:]
+
+		var sha string
+		if bySHA {
+			sha = strings.ToLower(args[0])
+			if _, loaded := x.srv.scripts[sha]; !loaded {
+				return errNoScript
+			}
+		} else {
+			var errReply any
+			if sha, errReply = x.srv.loadScript(args[0]); errReply != nil {
+				return errReply
+			}
+		}
+		fn, ok := registeredScript(sha)
+		if !ok {
+			return errUnregisteredScript(sha)
+		}
+
+		result, err := fn(x.scriptCall, append([]string(nil), keys...), append([]string(nil), argv...))
+		if err != nil {
+			if reply, ok := err.(errorReply); ok {
+				return reply
+			}
+			return errorReply("ERR " + err.Error())
+		}
+		return scriptResult(result)
+	}
+}
+
+// scriptCall 实现 redis.call：在同一逻辑库内执行命令
+func (x *execCtx) scriptCall(args ...any) (any, error) {
+	if len(args) == 0 {
+		return nil, errorReply("ERR Please specify at least one argument for this redis lib call")
+	}
+	strArgs := make([]string, len(args))
+	for i, arg := range args {
+		strArgs[i] = ArgString(arg)
+	}
+	reply := x.srv.exec(x.db, strArgs, true)
+	return callResult(reply)
+}
+
+func callResult(reply any) (any, error) {
+	switch r := reply.(type) {
+	case errorReply:
+		return nil, r
+	case simpleString:
+		return string(r), nil
+	case nullArray:
+		return nil, nil
+	case int:
+		return int64(r), nil
+	case []string:
+		out := make([]any, len(r))
+		for i, item := range r {
+			out[i] = item
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(r))
+		for i, item := range r {
+			converted, err := callResult(item)
+			if err != nil {
+				out[i] = err
+				continue
+			}
+			out[i] = converted
+		}
+		return out, nil
+	default:
+		return r, nil
+	}
+}
+
+// scriptResult 将 Go 实现的返回值按 Lua → Redis 规则转换为回复
+func scriptResult(v any) any {
+	switch r := v.(type) {
+	case nil:
+		return nil
+	case bool:
+		if r {
+			return int64(1)
+		}
+		return nil
+	case int:
+		return int64(r)
+	case int64:
+		return r
+	case float64:
+		return int64(r)
+	case string:
+		return r
+	case []string:
+		return r
+	case []any:
+		out := make([]any, 0, len(r))
+		for _, item := range r {
+			out = append(out, scriptResult(item))
+		}
+		return out
+	case errorReply:
+		return r
+	default:
+		return errorReply(fmt.Sprintf("ERR memredis: unsupported script result type %T", v))
+	}
+}
+
+func cmdScript(x *execCtx, args []string) any {
+	switch strings.ToLower(args[0]) {
+	case "load":
+		if len(args) != 2 {
+			return wrongArgs("script|load")
+		}
+		sha, errReply := x.srv.loadScript(args[1])
+		if errReply != nil {
+			return errReply
+		}
+		return sha
+	case "exists":
+		out := make([]any, 0, len(args)-1)
+		for _, sha := range args[1:] {
+			if _, ok := x.srv.scripts[strings.ToLower(sha)]; ok {
+				out = append(out, int64(1))
+			} else {
+				out = append(out, int64(0))
+			}
+		}
+		return out
+	case "flush":
+		x.srv.scripts = make(map[string]struct{})
+		return simpleString("OK")
+	default:
+		return errorReply("ERR unknown subcommand '" + args[0] + "'")
+	}
+}
+
+// ArgString 按 Lua 向 redis.call 传参的方式将值转换为字符串（数字格式同 LuaNumber）
+func ArgString(v any) string {
+	switch a := v.(type) {
+	case string:
+		return a
+	case []byte:
+		return string(a)
+	case int:
+		return strconv.Itoa(a)
+	case int64:
+		return strconv.FormatInt(a, 10)
+	case float64:
+		return LuaNumber(a)
+	case bool:
+		if a {
+			return "1"
+		}
+		return "0"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// LuaNumber 按 Lua 5.1 tostring 的规则格式化数字（整数值不带小数点，其余为 %.14g）
+func LuaNumber(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case f == math.Trunc(f) && math.Abs(f) < 1e15:
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'g', 14, 64)
+}
+
+// ToNumber 等价于 Lua 的 tonumber：支持整数、浮点与数字字符串，无法转换时返回 false
+func ToNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		s := strings.TrimSpace(n)
+		if s == "" {
+			return 0, false
+		}
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return float64(i), true
+		}
+		if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+			i, err := strconv.ParseInt(s[2:], 16, 64)
+			return float64(i), err == nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(f) {
+			return 0, false
+		}
+		return f, true
+	default:
+		return 0, false
+	}
+}
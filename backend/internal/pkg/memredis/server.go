@@ -0,0 +1,182 @@
+// Package memredis 提供进程内的 Redis 兼容存储，用于单节点部署时替代外部 Redis。
+//
+// 服务端实现 RESP2 协议与项目用到的命令子集（字符串、哈希、集合、有序集合、列表、Stream、
+// Pub/Sub、事务），go-redis 客户端通过 Dialer 建立进程内连接，上层缓存代码无需任何修改。
+// Lua 脚本不做解释执行，而是由各包通过 RegisterScript 按脚本 SHA1 注册等价的 Go 实现，
+// 在全局锁内原子执行，语义与 Redis 中的 EVAL 一致。
+package memredis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	errWrongType    = errorReply("WRONGTYPE Operation against a key holding the wrong kind of value")
+	errNotInteger   = errorReply("ERR value is not an integer or out of range")
+	errNotFloat     = errorReply("ERR value is not a valid float")
+	errSyntax       = errorReply("ERR syntax error")
+	errTimeout      = errorReply("ERR timeout is not an integer or out of range")
+	errOverflow     = errorReply("ERR increment or decrement would overflow")
+	errNestedMulti  = errorReply("ERR MULTI calls can not be nested")
+	errExecNoMulti  = errorReply("ERR EXEC without MULTI")
+	errDiscardMulti = errorReply("ERR DISCARD without MULTI")
+	errExecAbort    = errorReply("EXECABORT Transaction discarded because of previous errors.")
+	errDBIndex      = errorReply("ERR DB index is out of range")
+
+	// ErrServerClosed 服务端已关闭
+	ErrServerClosed = errors.New("memredis: server closed")
+)
+
+const maxDatabases = 16
+
+// Server 进程内 Redis 兼容服务端
+type Server struct {
+	mu      sync.Mutex
+	dbs     [maxDatabases]*keyspace
+	now     func() time.Time
+	clients map[*client]struct{}
+	closed  bool
+
+	// Pub/Sub 订阅关系
+	channels map[string]map[*client]struct{}
+	patterns map[string]map[*client]struct{}
+
+	// streamSignal 在 XADD 时关闭并替换，用于唤醒阻塞的 XREADGROUP
+	streamSignal chan struct{}
+
+	// scripts 已通过 EVAL / SCRIPT LOAD 加载的脚本 SHA1
+	scripts map[string]struct{}
+}
+
+// New 创建进程内服务端
+func New() *Server {
+	s := &Server{
+		now:          time.Now,
+		clients:      make(map[*client]struct{}),
+		channels:     make(map[string]map[*client]struct{}),
+		patterns:     make(map[string]map[*client]struct{}),
+		streamSignal: make(chan struct{}),
+		scripts:      make(map[string]struct{}),
+	}
+	for i := range s.dbs {
+		s.dbs[i] = newKeyspace()
+	}
+	return s
+}
+
+// Dial 建立一条进程内连接，签名与 redis.Options.Dialer 一致
+func (s *Server) Dial(_ context.Context, _, _ string) (net.Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrServerClosed
+	}
+	clientConn, serverConn := newConnPair()
+	c := &client{srv: s, conn: serverConn, w: bufio.NewWriter(serverConn)}
+	s.clients[c] = struct{}{}
+	go c.serve()
+	return clientConn, nil
+}
+
+// Close 关闭服务端与全部连接，数据随之丢弃
+func (s *Server) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	clients := make([]*client, 0, len(s.clients))
+	for c := range s.clients {
+		clients = append(clients, c)
+	}
+	s.mu.Unlock()
+
+	for _, c := range clients {
+		_ = c.conn.Close()
+	}
+	return nil
+}
+
+func (s *Server) removeClient(c *client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients, c)
+	s.unsubscribeAllLocked(c)
+}
+
+// notifyStreamsLocked 唤醒等待新消息的阻塞读取，调用方需持有 s.mu
+func (s *Server) notifyStreamsLocked() {
+	close(s.streamSignal)
+	s.streamSignal = make(chan struct{})
+}
+
+// execCtx 单条命令的执行上下文，执行期间持有 s.mu
+type execCtx struct {
+	srv *Server
+	ks  *keyspace
+	db  int
+	now time.Time
+	// inScript 脚本内执行时禁止阻塞与 Pub/Sub 订阅等命令
+	inScript bool
+}
+
+// exec 执行一条命令并返回回复，调用方需持有 s.mu
+func (s *Server) exec(db int, args []string, inScript bool) any {
+	name := strings.ToLower(args[0])
+	spec, ok := commands[name]
+	if !ok {
+		return errorReply("ERR unknown command '" + args[0] + "'")
+	}
+	if !spec.checkArity(len(args)) {
+		return errorReply("ERR wrong number of arguments for '" + name + "' command")
+	}
+	if inScript && spec.noScript {
+		return errorReply("ERR This Redis command is not allowed from script")
+	}
+	x := &execCtx{srv: s, ks: s.dbs[db], db: db, now: s.now(), inScript: inScript}
+	x.ks.activeExpire(x.now)
+	return spec.fn(x, args[1:])
+}
+
+// cmdSpec 命令定义；arity 遵循 Redis 约定：正数为精确参数个数（含命令名），负数为最少参数个数
+type cmdSpec struct {
+	fn       func(x *execCtx, args []string) any
+	arity    int
+	noScript bool
+}
+
+func (c cmdSpec) checkArity(n int) bool {
+	if c.arity >= 0 {
+		return n == c.arity
+	}
+	return n >= -c.arity
+}
+
+var commands map[string]cmdSpec
+
+func init() {
+	commands = make(map[string]cmdSpec)
+	for _, group := range []map[string]cmdSpec{
+		serverCommands,
+		keyCommands,
+		stringCommands,
+		hashCommands,
+		setCommands,
+		sortedSetCommands,
+		listCommands,
+		streamCommands,
+		pubsubCommands,
+		scriptCommands,
+	} {
+		for name, spec := range group {
+			commands[name] = spec
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package oneapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	// 支持读取 PostgreSQL 与 SQLite 部署；MySQL 部署需先导出为 JSON
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// 支持的数据库驱动
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Load 以只读方式连接 one-api / new-api 数据库并读取全部数据。
+// 不存在的表视为空表，额外的列会被忽略，因此可兼容不同版本与分支的表结构。
+func Load(ctx context.Context, driver, dsn string) (*Dump, error) {
+	if driver != DriverPostgres && driver != DriverSQLite {
+		return nil, fmt.Errorf("unsupported database driver %q (supported: %s, %s)", driver, DriverPostgres, DriverSQLite)
+	}
+	if driver == DriverSQLite && !strings.Contains(dsn, "mode=") {
+		// 避免在路径错误时创建空库
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		dsn = "file:" + strings.TrimPrefix(dsn, "file:") + sep + "mode=ro"
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = db.Close() }()
+	if err := db.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("connect one-api database: %w", err)
+	}
+
+	dump := &Dump{}
+	tables := []struct {
+		name string
+		dest any
+	}{
+		{"channels", &dump.Channels},
+		{"users", &dump.Users},
+		{"tokens", &dump.Tokens},
+		{"redemptions", &dump.Redemptions},
+		{"options", &dump.Options},
+	}
+	for _, table := range tables {
+		exists, err := tableExists(ctx, db, driver, table.name)
+		if err != nil {
+			return nil, fmt.Errorf("check table %s: %w", table.name, err)
+		}
+		if !exists {
+			continue
+		}
+		if err := loadTable(ctx, db, table.name, table.dest); err != nil {
+			return nil, fmt.Errorf("read table %s: %w", table.name, err)
+		}
+	}
+	return dump, nil
+}
+
+func tableExists(ctx context.Context, db *sql.DB, driver, table string) (bool, error) {
+	query := "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	if driver == DriverSQLite {
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = $1"
+	}
+	var count int
+	if err := db.QueryRowContext(ctx, query, table).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// loadTable 将整表读取为 列名 -> 值 的对象，再经 JSON 解码到结构体切片
+func loadTable(ctx context.Context, db *sql.DB, table string, dest any) error {
+	rows, err := db.QueryContext(ctx, "SELECT * FROM "+table)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	var records []map[string]any
+	for rows.Next() {
+		values := make([]any, len(columns))
+		ptrs := make([]any, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return err
+		}
+		record := make(map[string]any, len(columns))
+		for i, column := range columns {
+			record[strings.ToLower(column)] = normalizeValue(values[i])
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dest)
+}
+
+func normalizeValue(v any) any {
+	switch value := v.(type) {
+	case []byte:
+		return string(value)
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	}
+	return v
+}
@@ -0,0 +1,174 @@
+// Package oneapi 读取 one-api / new-api 实例的数据（数据库或 JSON 导出），供导入服务使用。
+//
+// JSON 导出格式与数据库表一一对应，字段名即列名：
+//
+//	{"channels": [...], "users": [...], "tokens": [...], "redemptions": [...], "options": [{"key": "...", "value": "..."}]}
+package oneapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 数据来源
+const (
+	FlavorOneAPI = "one-api"
+	FlavorNewAPI = "new-api"
+)
+
+// DefaultQuotaPerUnit one-api 默认的额度换算：500000 额度 = 1 USD
+const DefaultQuotaPerUnit = 500000
+
+// 渠道 / 用户 / 令牌 / 兑换码状态
+const (
+	StatusEnabled = 1
+	// StatusDisabled 手动禁用；渠道另有自动禁用（3），令牌另有已过期（3）与额度耗尽（4）
+	StatusDisabled = 2
+
+	TokenStatusExpired   = 3
+	TokenStatusExhausted = 4
+
+	RedemptionStatusUsed = 3
+)
+
+// Channel 渠道（上游）
+type Channel struct {
+	ID      int64   `json:"id"`
+	Type    int     `json:"type"`
+	Key     string  `json:"key"`
+	Status  int     `json:"status"`
+	Name    string  `json:"name"`
+	BaseURL *string `json:"base_url"`
+	// Models 逗号分隔的模型列表
+	Models string `json:"models"`
+	// Group 逗号分隔的分组列表
+	Group string `json:"group"`
+	// ModelMapping JSON 对象：请求模型 -> 上游模型
+	ModelMapping *string `json:"model_mapping"`
+	// Priority 越大越优先
+	Priority *int64 `json:"priority"`
+	Weight   *int64 `json:"weight"`
+}
+
+// User 用户；Password 为 bcrypt 哈希
+type User struct {
+	ID          int64   `json:"id"`
+	Username    string  `json:"username"`
+	Password    string  `json:"password"`
+	DisplayName string  `json:"display_name"`
+	Role        int     `json:"role"`
+	Status      int     `json:"status"`
+	Email       string  `json:"email"`
+	Group       string  `json:"group"`
+	Quota       int64   `json:"quota"`
+	UsedQuota   int64   `json:"used_quota"`
+	DeletedAt   *string `json:"deleted_at"`
+}
+
+// Token 令牌（API Key），Key 不含 sk- 前缀
+type Token struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Key    string `json:"key"`
+	Status int    `json:"status"`
+	Name   string `json:"name"`
+	// ExpiredTime Unix 秒，-1 表示永不过期
+	ExpiredTime    int64 `json:"expired_time"`
+	RemainQuota    int64 `json:"remain_quota"`
+	UnlimitedQuota Bool  `json:"unlimited_quota"`
+	UsedQuota      int64 `json:"used_quota"`
+	// Group new-api 令牌级分组，为空时使用用户分组
+	Group     string  `json:"group"`
+	DeletedAt *string `json:"deleted_at"`
+}
+
+// Redemption 兑换码
+type Redemption struct {
+	ID     int64  `json:"id"`
+	Key    string `json:"key"`
+	Status int    `json:"status"`
+	Name   string `json:"name"`
+	Quota  int64  `json:"quota"`
+}
+
+// Option 系统设置项
+type Option struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Dump 一次读取的全部数据
+type Dump struct {
+	Channels    []Channel    `json:"channels"`
+	Users       []User       `json:"users"`
+	Tokens      []Token      `json:"tokens"`
+	Redemptions []Redemption `json:"redemptions"`
+	Options     []Option     `json:"options"`
+}
+
+// Decode 解析 JSON 导出
+func Decode(r io.Reader) (*Dump, error) {
+	var dump Dump
+	if err := json.NewDecoder(r).Decode(&dump); err != nil {
+		return nil, fmt.Errorf("decode one-api export: %w", err)
+	}
+	return &dump, nil
+}
+
+// Option 返回设置项的值，不存在时返回空字符串
+func (d *Dump) Option(key string) string {
+	for _, opt := range d.Options {
+		if opt.Key == key {
+			return opt.Value
+		}
+	}
+	return ""
+}
+
+// QuotaPerUnit 返回源实例的额度换算（每 USD 额度），未配置时返回默认值
+func (d *Dump) QuotaPerUnit() float64 {
+	if v, err := strconv.ParseFloat(strings.TrimSpace(d.Option("QuotaPerUnit")), 64); err == nil && v > 0 {
+		return v
+	}
+	return DefaultQuotaPerUnit
+}
+
+// GroupRatios 返回分组倍率（GroupRatio 设置项），解析失败时返回空
+func (d *Dump) GroupRatios() map[string]float64 {
+	ratios := map[string]float64{}
+	if raw := strings.TrimSpace(d.Option("GroupRatio")); raw != "" {
+		_ = json.Unmarshal([]byte(raw), &ratios)
+	}
+	return ratios
+}
+
+// SplitList 拆分逗号分隔的列表，去除空白与空项
+func SplitList(raw string) []string {
+	var out []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Bool 兼容 SQLite 以整数存储的布尔值
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(bytes.TrimSpace(data), `"`)
+	switch strings.ToLower(string(data)) {
+	case "true", "1", "t":
+		*b = true
+	case "false", "0", "f", "", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %q", data)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package oneapi
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	dump, err := Decode(strings.NewReader(`{
+		"channels": [{"id": 1, "type": 14, "key": "sk-ant", "status": 1, "name": "claude", "models": "a, b,,c", "group": "default,vip", "priority": 5}],
+		"tokens": [{"id": 2, "user_id": 3, "key": "abc", "unlimited_quota": 1, "expired_time": -1}],
+		"options": [{"key": "QuotaPerUnit", "value": "1000"}, {"key": "GroupRatio", "value": "{\"vip\": 0.5}"}]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, dump.Channels, 1)
+	require.Equal(t, []string{"a", "b", "c"}, SplitList(dump.Channels[0].Models))
+	require.Equal(t, int64(5), *dump.Channels[0].Priority)
+	require.True(t, bool(dump.Tokens[0].UnlimitedQuota))
+	require.Equal(t, float64(1000), dump.QuotaPerUnit())
+	require.Equal(t, map[string]float64{"vip": 0.5}, dump.GroupRatios())
+
+	empty, err := Decode(strings.NewReader(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, float64(DefaultQuotaPerUnit), empty.QuotaPerUnit())
+}
+
+func TestLoadSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "one-api.db")
+	db, err := sql.Open(DriverSQLite, path)
+	require.NoError(t, err)
+	for _, stmt := range []string{
+		"CREATE TABLE channels (id integer primary key, type integer, key text, status integer, name text, base_url text, models text, `group` text, model_mapping text, priority integer, weight integer, created_time integer)",
+		"INSERT INTO channels VALUES (1, 1, 'sk-openai', 1, 'openai', NULL, 'gpt-4o', 'default', '{\"gpt-4\":\"gpt-4o\"}', 0, 1, 1700000000)",
+		"CREATE TABLE users (id integer primary key, username text, password text, display_name text, role integer, status integer, email text, `group` text, quota integer, used_quota integer, deleted_at datetime)",
+		"INSERT INTO users VALUES (7, 'alice', '$2a$10$hash', 'Alice', 1, 1, 'alice@example.com', 'default', 1000000, 5, NULL)",
+		"CREATE TABLE tokens (id integer primary key, user_id integer, key text, status integer, name text, expired_time integer, remain_quota integer, unlimited_quota numeric, used_quota integer)",
+		"INSERT INTO tokens VALUES (9, 7, 'tok', 1, 'default', -1, 500000, 0, 0)",
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+	require.NoError(t, db.Close())
+
+	dump, err := Load(context.Background(), DriverSQLite, path)
+	require.NoError(t, err)
+	require.Len(t, dump.Channels, 1)
+	require.Equal(t, "default", dump.Channels[0].Group)
+	require.Equal(t, `{"gpt-4":"gpt-4o"}`, *dump.Channels[0].ModelMapping)
+	require.Nil(t, dump.Channels[0].BaseURL)
+	require.Len(t, dump.Users, 1)
+	require.Equal(t, "alice", dump.Users[0].Username)
+	require.Equal(t, int64(1000000), dump.Users[0].Quota)
+	require.Len(t, dump.Tokens, 1)
+	require.False(t, bool(dump.Tokens[0].UnlimitedQuota))
+	// 缺失的表视为空
+	require.Empty(t, dump.Redemptions)
+	require.Empty(t, dump.Options)
+
+	_, err = Load(context.Background(), DriverSQLite, filepath.Join(t.TempDir(), "missing.db"))
+	require.Error(t, err)
+	_, err = Load(context.Background(), "mysql", "dsn")
+	require.Error(t, err)
+}
@@ -0,0 +1,446 @@
+package openapi
+
+import (
+	"go/ast"
+	"go/types"
+	"net/http"
+	"strings"
+)
+
+// responseKind 响应的写出方式
+type responseKind int
+
+const (
+	// kindEnvelope response.Success / response.Created：统一包装 {code, message, data}
+	kindEnvelope responseKind = iota
+	// kindPage response.Paginated：统一包装，data 为分页结构
+	kindPage
+	// kindRaw c.JSON：直接输出
+	kindRaw
+)
+
+type responseCandidate struct {
+	status int
+	kind   responseKind
+	expr   ast.Expr
+	pkg    *srcPackage
+}
+
+// bodyCandidate 请求体绑定的变量
+type bodyCandidate struct {
+	expr     ast.Expr
+	pkg      *srcPackage
+	optional bool // 绑定错误被忽略，请求体可省略
+}
+
+// handlerFacts handler 静态分析结果
+type handlerFacts struct {
+	jsonBodies   []bodyCandidate
+	queryStructs []bodyCandidate
+	queries      []string
+	kinds        map[string]string // 参数名 -> integer/number/boolean
+	queryArrays  map[string]bool
+	formFiles    []string
+	formFields   []string
+	rawBody      bool
+	responses    []responseCandidate
+	contentTypes map[int][]string // 非 JSON 响应：状态码 -> Content-Type
+	headers      []string
+	upgrade      bool
+	redirect     int
+}
+
+// analyzer 分析 handler 函数体（跟随接收 *gin.Context 的同包辅助函数）
+type analyzer struct {
+	prog    *program
+	method  string
+	facts   *handlerFacts
+	visited map[*ast.FuncDecl]bool
+	sources map[types.Object]string // 局部变量 -> 来源参数名
+}
+
+func analyzeHandler(prog *program, pkg *srcPackage, handler ast.Expr, method string) *handlerFacts {
+	a := &analyzer{
+		prog:   prog,
+		method: method,
+		facts: &handlerFacts{
+			kinds:        map[string]string{},
+			queryArrays:  map[string]bool{},
+			contentTypes: map[int][]string{},
+		},
+		visited: map[*ast.FuncDecl]bool{},
+		sources: map[types.Object]string{},
+	}
+	switch h := ast.Unparen(handler).(type) {
+	case *ast.FuncLit:
+		a.inspect(pkg, h.Body, 0)
+	default:
+		if ref, ok := prog.lookupFunc(pkg, h); ok {
+			a.function(ref, 0)
+		}
+	}
+	return a.facts
+}
+
+func (a *analyzer) function(ref funcRef, depth int) {
+	if depth > 4 || a.visited[ref.decl] || ref.decl.Body == nil {
+		return
+	}
+	a.visited[ref.decl] = true
+	a.inspect(ref.pkg, ref.decl.Body, depth)
+}
+
+func (a *analyzer) inspect(pkg *srcPackage, body ast.Node, depth int) {
+	ignored := ignoredCalls(body)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			a.trackSources(pkg, n)
+		case *ast.SelectorExpr:
+			// c.Request.Body 直接读取原始请求体
+			if n.Sel.Name == "Body" {
+				if inner, ok := n.X.(*ast.SelectorExpr); ok && inner.Sel.Name == "Request" && a.isContext(pkg, inner.X) {
+					a.facts.rawBody = true
+				}
+			}
+		case *ast.CompositeLit:
+			a.capturedContext(pkg, n, depth)
+		case *ast.CallExpr:
+			a.call(pkg, n, ignored[n], depth)
+		}
+		return true
+	})
+}
+
+func (a *analyzer) call(pkg *srcPackage, call *ast.CallExpr, ignored bool, depth int) {
+	info := pkg.info
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if isSel && a.isContext(pkg, sel.X) {
+		a.contextCall(pkg, sel.Sel.Name, call, ignored)
+		return
+	}
+	// c.Writer.Header().Set("Content-Type", ...)
+	if isSel && sel.Sel.Name == "Set" && len(call.Args) == 2 && a.isWriterHeader(pkg, sel.X) {
+		key, _ := constString(info, call.Args[0])
+		if value, ok := constString(info, call.Args[1]); ok && strings.EqualFold(key, "Content-Type") {
+			a.addContentType(http.StatusOK, value)
+		}
+		return
+	}
+
+	fn := calledFunc(info, call)
+	if fn != nil && fn.Pkg() != nil {
+		switch fn.Pkg().Path() {
+		case a.prog.modulePath + "/internal/pkg/response":
+			a.responseCall(pkg, fn.Name(), call)
+			return
+		case "strconv":
+			a.conversion(pkg, fn.Name(), call)
+		}
+		// websocket 升级：upgrader.Upgrade(c.Writer, c.Request, ...)
+		if fn.Name() == "Upgrade" && len(call.Args) >= 2 {
+			a.facts.upgrade = true
+		}
+	}
+
+	// 跟随把 *gin.Context 传入的函数；不在源码分析范围内的（如 service 包）仅做语法扫描
+	for _, arg := range call.Args {
+		if a.isContext(pkg, arg) {
+			if ref, ok := a.prog.lookupFunc(pkg, call.Fun); ok {
+				a.function(ref, depth+1)
+			} else if fn != nil {
+				for _, ct := range a.prog.externalContentTypes(fn) {
+					a.addContentType(http.StatusOK, ct)
+				}
+			}
+			break
+		}
+	}
+}
+
+// capturedContext 处理保存了 *gin.Context 的结构（如自定义 ResponseWriter），分析其全部方法
+func (a *analyzer) capturedContext(pkg *srcPackage, lit *ast.CompositeLit, depth int) {
+	captures := false
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			elt = kv.Value
+		}
+		if a.isContext(pkg, elt) {
+			captures = true
+			break
+		}
+	}
+	if !captures {
+		return
+	}
+	named, ok := types.Unalias(exprType(pkg, lit)).(*types.Named)
+	if !ok {
+		return
+	}
+	methods := types.NewMethodSet(types.NewPointer(named))
+	for i := 0; i < methods.Len(); i++ {
+		if fn, ok := methods.At(i).Obj().(*types.Func); ok {
+			if ref, ok := a.prog.funcs[fn.FullName()]; ok {
+				a.function(ref, depth+1)
+			}
+		}
+	}
+}
+
+// isWriterHeader 判断表达式是否为 c.Writer.Header()
+func (a *analyzer) isWriterHeader(pkg *srcPackage, expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	header, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || header.Sel.Name != "Header" {
+		return false
+	}
+	writer, ok := header.X.(*ast.SelectorExpr)
+	return ok && writer.Sel.Name == "Writer" && a.isContext(pkg, writer.X)
+}
+
+func (a *analyzer) contextCall(pkg *srcPackage, name string, call *ast.CallExpr, ignored bool) {
+	info := pkg.info
+	arg := func(i int) ast.Expr {
+		if i < len(call.Args) {
+			return call.Args[i]
+		}
+		return nil
+	}
+	switch name {
+	case "ShouldBindJSON", "BindJSON", "ShouldBindBodyWithJSON":
+		a.facts.jsonBodies = append(a.facts.jsonBodies, bodyCandidate{expr: arg(0), pkg: pkg, optional: ignored})
+	case "ShouldBindBodyWith":
+		a.facts.jsonBodies = append(a.facts.jsonBodies, bodyCandidate{expr: arg(0), pkg: pkg, optional: ignored})
+	case "ShouldBind", "Bind":
+		if a.method == http.MethodGet || a.method == http.MethodDelete {
+			a.facts.queryStructs = append(a.facts.queryStructs, bodyCandidate{expr: arg(0), pkg: pkg})
+		} else {
+			a.facts.jsonBodies = append(a.facts.jsonBodies, bodyCandidate{expr: arg(0), pkg: pkg, optional: ignored})
+		}
+	case "ShouldBindQuery", "BindQuery":
+		a.facts.queryStructs = append(a.facts.queryStructs, bodyCandidate{expr: arg(0), pkg: pkg})
+	case "Query", "DefaultQuery", "GetQuery":
+		if q, ok := constString(info, arg(0)); ok {
+			a.addQuery(q)
+		}
+	case "QueryArray", "GetQueryArray":
+		if q, ok := constString(info, arg(0)); ok {
+			a.addQuery(q)
+			a.facts.queryArrays[q] = true
+		}
+	case "FormFile":
+		if f, ok := constString(info, arg(0)); ok {
+			a.facts.formFiles = append(a.facts.formFiles, f)
+		}
+	case "PostForm", "DefaultPostForm":
+		if f, ok := constString(info, arg(0)); ok {
+			a.facts.formFields = append(a.facts.formFields, f)
+		}
+	case "GetRawData":
+		a.facts.rawBody = true
+	case "JSON", "IndentedJSON", "PureJSON":
+		// 状态码非常量时（如就绪检查按结果返回 200/503）按成功响应记录
+		status, ok := constInt(info, arg(0))
+		if !ok {
+			status = http.StatusOK
+		}
+		if status >= 200 && status < 300 {
+			a.facts.responses = append(a.facts.responses, responseCandidate{status: status, kind: kindRaw, expr: arg(1), pkg: pkg})
+		}
+	case "GetHeader":
+		// 业务自定义请求头（X-*）作为 header 参数
+		if h, ok := constString(info, arg(0)); ok && len(h) > 2 && strings.EqualFold(h[:2], "x-") && !strings.EqualFold(h, "x-api-key") {
+			a.addHeader(h)
+		}
+	case "Data", "DataFromReader":
+		status, ok := constInt(info, arg(0))
+		if !ok {
+			status = http.StatusOK
+		}
+		ctArg := arg(1)
+		if name == "DataFromReader" {
+			ctArg = arg(2)
+		}
+		ct, ok := constString(info, ctArg)
+		if !ok {
+			ct = "application/octet-stream"
+		}
+		a.addContentType(status, ct)
+	case "File", "FileAttachment":
+		a.addContentType(http.StatusOK, "application/octet-stream")
+	case "SSEvent", "Stream":
+		a.addContentType(http.StatusOK, "text/event-stream")
+	case "Header":
+		key, _ := constString(info, arg(0))
+		value, ok := constString(info, arg(1))
+		if ok && strings.EqualFold(key, "Content-Type") {
+			a.addContentType(http.StatusOK, value)
+		}
+	case "Redirect":
+		if status, ok := constInt(info, arg(0)); ok {
+			a.facts.redirect = status
+		}
+	}
+}
+
+func (a *analyzer) responseCall(pkg *srcPackage, name string, call *ast.CallExpr) {
+	switch name {
+	case "Success":
+		a.facts.responses = append(a.facts.responses, responseCandidate{status: http.StatusOK, kind: kindEnvelope, expr: call.Args[1], pkg: pkg})
+	case "Created":
+		a.facts.responses = append(a.facts.responses, responseCandidate{status: http.StatusCreated, kind: kindEnvelope, expr: call.Args[1], pkg: pkg})
+	case "Paginated", "PaginatedWithResult":
+		a.facts.responses = append(a.facts.responses, responseCandidate{status: http.StatusOK, kind: kindPage, expr: call.Args[1], pkg: pkg})
+	case "ParsePagination":
+		a.addQuery("page")
+		a.addQuery("page_size")
+		a.facts.kinds["page"] = "integer"
+		a.facts.kinds["page_size"] = "integer"
+	}
+}
+
+// conversion 记录 strconv 解析过的参数类型
+func (a *analyzer) conversion(pkg *srcPackage, name string, call *ast.CallExpr) {
+	var kind string
+	switch name {
+	case "ParseInt", "ParseUint", "Atoi":
+		kind = "integer"
+	case "ParseFloat":
+		kind = "number"
+	case "ParseBool":
+		kind = "boolean"
+	default:
+		return
+	}
+	if len(call.Args) == 0 {
+		return
+	}
+	if param := a.sourceOf(pkg, call.Args[0]); param != "" {
+		if _, seen := a.facts.kinds[param]; !seen {
+			a.facts.kinds[param] = kind
+		}
+	}
+}
+
+// trackSources 记录由 c.Query / c.Param 派生的局部变量
+func (a *analyzer) trackSources(pkg *srcPackage, assign *ast.AssignStmt) {
+	if len(assign.Rhs) != 1 || len(assign.Lhs) == 0 {
+		return
+	}
+	param := a.sourceOf(pkg, assign.Rhs[0])
+	if param == "" {
+		return
+	}
+	id, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return
+	}
+	obj := pkg.info.Defs[id]
+	if obj == nil {
+		obj = pkg.info.Uses[id]
+	}
+	if obj != nil {
+		a.sources[obj] = param
+	}
+}
+
+// sourceOf 返回表达式所依赖的查询/路径参数名
+func (a *analyzer) sourceOf(pkg *srcPackage, expr ast.Expr) string {
+	var found string
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if found != "" {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.Ident:
+			if param, ok := a.sources[pkg.info.Uses[n]]; ok {
+				found = param
+			}
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok || !a.isContext(pkg, sel.X) || len(n.Args) == 0 {
+				return true
+			}
+			switch sel.Sel.Name {
+			case "Query", "DefaultQuery", "Param":
+				if name, ok := constString(pkg.info, n.Args[0]); ok {
+					found = name
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func (a *analyzer) addQuery(name string) {
+	for _, q := range a.facts.queries {
+		if q == name {
+			return
+		}
+	}
+	a.facts.queries = append(a.facts.queries, name)
+}
+
+func (a *analyzer) addHeader(name string) {
+	for _, h := range a.facts.headers {
+		if strings.EqualFold(h, name) {
+			return
+		}
+	}
+	a.facts.headers = append(a.facts.headers, name)
+}
+
+func (a *analyzer) addContentType(status int, ct string) {
+	for _, existing := range a.facts.contentTypes[status] {
+		if existing == ct {
+			return
+		}
+	}
+	a.facts.contentTypes[status] = append(a.facts.contentTypes[status], ct)
+}
+
+func (a *analyzer) isContext(pkg *srcPackage, expr ast.Expr) bool {
+	tv, ok := pkg.info.Types[expr]
+	return ok && tv.Type != nil && isGinType(tv.Type, "Context")
+}
+
+func calledFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch f := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = f
+	case *ast.SelectorExpr:
+		ident = f.Sel
+	default:
+		return nil
+	}
+	fn, _ := info.Uses[ident].(*types.Func)
+	return fn
+}
+
+// ignoredCalls 返回结果被忽略的调用（独立语句或赋给 _），用于判断请求体是否可选
+func ignoredCalls(body ast.Node) map[*ast.CallExpr]bool {
+	out := map[*ast.CallExpr]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			if call, ok := n.X.(*ast.CallExpr); ok {
+				out[call] = true
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+				if id, ok := n.Lhs[0].(*ast.Ident); ok && id.Name == "_" {
+					if call, ok := n.Rhs[0].(*ast.CallExpr); ok {
+						out[call] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	return out
+}
@@ -0,0 +1,474 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// clientRuntime 生成代码依赖的运行时标识符（定义在客户端包的手写部分），结构名不得与之冲突
+var clientRuntime = map[string]bool{"Client": true, "ClientOption": true, "APIError": true, "Page": true}
+
+// GenerateClient 由文档生成 Go 客户端代码：components 中的结构体，以及每个非网关操作对应的方法。
+// 生成代码依赖客户端包手写的运行时（Client、Page、call/stream 等），输出已经 gofmt 格式化。
+func GenerateClient(doc *Document, pkg string) ([]byte, error) {
+	g := &clientGen{doc: doc, imports: map[string]bool{"context": true, "net/http": true}}
+	for name := range doc.Components.Schemas {
+		if clientRuntime[name] {
+			return nil, fmt.Errorf("schema %s conflicts with client runtime", name)
+		}
+	}
+
+	var methods bytes.Buffer
+	var failed error
+	doc.Operations(func(method, path string, op *Operation) {
+		if failed != nil || !clientOperation(op) {
+			return
+		}
+		failed = g.method(&methods, method, path, op)
+	})
+	if failed != nil {
+		return nil, failed
+	}
+
+	var types bytes.Buffer
+	for _, name := range sortedKeys(doc.Components.Schemas) {
+		g.typeDecl(&types, name, doc.Components.Schemas[name])
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by cmd/openapigen from the OpenAPI document. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	out.WriteString("import (\n")
+	for _, imp := range sortedKeys(g.imports) {
+		fmt.Fprintf(&out, "\t%q\n", imp)
+	}
+	out.WriteString(")\n\n")
+	out.Write(types.Bytes())
+	out.Write(methods.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated client: %w", err)
+	}
+	return formatted, nil
+}
+
+// clientOperation 判断操作是否生成客户端方法：网关接口沿用各上游 SDK，WebSocket 不适用
+func clientOperation(op *Operation) bool {
+	for _, tag := range op.Tags {
+		if tag == TagGateway {
+			return false
+		}
+	}
+	_, upgrade := op.Responses[strconv.Itoa(http.StatusSwitchingProtocols)]
+	return !upgrade
+}
+
+type clientGen struct {
+	doc     *Document
+	imports map[string]bool
+}
+
+func (g *clientGen) typeDecl(b *bytes.Buffer, name string, s *Schema) {
+	if s.Description != "" {
+		writeComment(b, "", name+" "+s.Description)
+	}
+	if s.Type == "object" && len(s.Properties) > 0 {
+		fmt.Fprintf(b, "type %s %s\n\n", name, g.structType(s))
+		return
+	}
+	fmt.Fprintf(b, "type %s = %s\n\n", name, g.goType(s))
+}
+
+// structType 生成结构体：必填且非 nullable 的字段不带 omitempty
+func (g *clientGen) structType(s *Schema) string {
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	used := map[string]bool{}
+	for _, prop := range sortedKeys(s.Properties) {
+		ps := s.Properties[prop]
+		field := uniqueName(goIdent(prop), used)
+		tag := prop
+		if !required[prop] || isNullable(ps) {
+			tag += ",omitempty"
+		}
+		if ps.Description != "" {
+			writeComment(&b, "\t", field+" "+ps.Description)
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:%q`\n", field, g.goType(ps), tag)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// goType 将结构映射为 Go 类型；nullable 的标量与结构体映射为指针
+func (g *clientGen) goType(s *Schema) string {
+	if s == nil {
+		return g.rawType()
+	}
+	if name := s.RefName(); name != "" {
+		return name
+	}
+	if len(s.AllOf) == 1 && s.AllOf[0].RefName() != "" {
+		return pointerIf(s.Nullable, s.AllOf[0].RefName())
+	}
+	if len(s.AllOf) > 0 || len(s.AnyOf) > 0 {
+		return g.rawType()
+	}
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			g.imports["time"] = true
+			return pointerIf(s.Nullable, "time.Time")
+		case "byte", "binary":
+			return "[]byte"
+		}
+		return pointerIf(s.Nullable, "string")
+	case "integer":
+		if s.Format == "int32" {
+			return pointerIf(s.Nullable, "int32")
+		}
+		return pointerIf(s.Nullable, "int64")
+	case "number":
+		return pointerIf(s.Nullable, "float64")
+	case "boolean":
+		return pointerIf(s.Nullable, "bool")
+	case "array":
+		return "[]" + g.goType(s.Items)
+	case "object":
+		if len(s.Properties) > 0 {
+			return pointerIf(s.Nullable, g.structType(s))
+		}
+		if s.AdditionalProperties != nil {
+			return "map[string]" + g.goType(s.AdditionalProperties)
+		}
+	}
+	return g.rawType()
+}
+
+func (g *clientGen) rawType() string {
+	g.imports["encoding/json"] = true
+	return "json.RawMessage"
+}
+
+// clientParam 方法的路径参数或参数结构体字段
+type clientParam struct {
+	name   string
+	field  string
+	in     string
+	goType string
+}
+
+// method 生成一个操作的客户端方法
+func (g *clientGen) method(b *bytes.Buffer, method, path string, op *Operation) error {
+	name := exportedIdent(op.OperationID)
+	if name == "" {
+		return fmt.Errorf("%s %s: missing operationId", method, path)
+	}
+
+	args := []string{"ctx context.Context"}
+	pathExpr, pathParams := g.pathExpr(path, op)
+	for _, p := range pathParams {
+		args = append(args, p.name+" "+p.goType)
+	}
+
+	var options []*clientParam
+	used := map[string]bool{}
+	for _, p := range op.Parameters {
+		if p.In != "query" && p.In != "header" {
+			continue
+		}
+		goType := g.goType(p.Schema)
+		if !strings.HasPrefix(goType, "[]") && !strings.HasPrefix(goType, "*") {
+			goType = "*" + goType
+		}
+		options = append(options, &clientParam{name: p.Name, field: uniqueName(goIdent(p.Name), used), in: p.In, goType: goType})
+	}
+	paramsType := name + "Params"
+	if len(options) > 0 {
+		if _, ok := g.doc.Components.Schemas[paramsType]; ok {
+			return fmt.Errorf("%s: params type %s conflicts with schema", op.OperationID, paramsType)
+		}
+		writeComment(b, "", paramsType+" "+name+" 的查询与请求头参数")
+		fmt.Fprintf(b, "type %s struct {\n", paramsType)
+		for _, p := range options {
+			fmt.Fprintf(b, "\t%s %s\n", p.field, p.goType)
+		}
+		b.WriteString("}\n\n")
+		args = append(args, "params *"+paramsType)
+	}
+
+	reqFields := []string{"method: http.Method" + methodName(method), "path: " + pathExpr}
+	if op.RequestBody != nil {
+		if media := op.RequestBody.Content["application/json"]; media != nil {
+			bodyType := g.goType(media.Schema)
+			if bodyType != "json.RawMessage" && !strings.HasPrefix(bodyType, "[]") && !strings.HasPrefix(bodyType, "map[") {
+				bodyType = "*" + bodyType
+			}
+			args = append(args, "body "+bodyType)
+			reqFields = append(reqFields, "body: body")
+		} else {
+			g.imports["io"] = true
+			args = append(args, "body io.Reader")
+			contentType := sortedKeys(op.RequestBody.Content)[0]
+			reqFields = append(reqFields, "raw: body", fmt.Sprintf("contentType: %q", contentType))
+		}
+	}
+
+	status, resp := successResponse(op)
+	comment := fmt.Sprintf("%s 对应 %s %s", name, method, path)
+	if op.Summary != "" {
+		comment += "\n\n" + op.Summary
+	}
+	writeComment(b, "", comment)
+
+	var result string
+	var call string
+	envelope := false
+	switch {
+	case resp == nil || len(resp.Content) == 0:
+		call = "return c.call(ctx, req, false, nil)"
+		if status >= 300 && status < 400 {
+			result = "*http.Response"
+			call = "return c.stream(ctx, req)"
+		}
+	case resp.Content["application/json"] == nil:
+		result = "*http.Response"
+		call = "return c.stream(ctx, req)"
+	default:
+		schema := resp.Content["application/json"].Schema
+		if schema != nil && schema.Envelope {
+			envelope = true
+			schema = schema.Properties["data"]
+		}
+		if schema != nil {
+			result = g.resultType(schema)
+		}
+	}
+
+	signature := fmt.Sprintf("func (c *Client) %s(%s)", name, strings.Join(args, ", "))
+	switch {
+	case result == "" && call == "":
+		fmt.Fprintf(b, "%s error {\n", signature)
+		call = fmt.Sprintf("return c.call(ctx, req, %t, nil)", envelope)
+	case result == "":
+		fmt.Fprintf(b, "%s error {\n", signature)
+	default:
+		fmt.Fprintf(b, "%s (%s, error) {\n", signature, result)
+	}
+
+	fmt.Fprintf(b, "\treq := &request{%s}\n", strings.Join(reqFields, ", "))
+	if len(options) > 0 {
+		b.WriteString("\tif params != nil {\n")
+		for _, p := range options {
+			fn := "setQuery"
+			if p.in == "header" {
+				fn = "setHeader"
+			}
+			fmt.Fprintf(b, "\t\treq.%s(%q, params.%s)\n", fn, p.name, p.field)
+		}
+		b.WriteString("\t}\n")
+	}
+	if call != "" {
+		fmt.Fprintf(b, "\t%s\n}\n\n", call)
+		return nil
+	}
+	fmt.Fprintf(b, "\tvar out %s\n", result)
+	fmt.Fprintf(b, "\terr := c.call(ctx, req, %t, &out)\n", envelope)
+	b.WriteString("\treturn out, err\n}\n\n")
+	return nil
+}
+
+// resultType 方法返回类型：结构体与分页返回指针，其余按 goType
+func (g *clientGen) resultType(s *Schema) string {
+	if s.Paginated {
+		return "*Page[" + g.goType(s.Properties["items"].Items) + "]"
+	}
+	if name := s.RefName(); name != "" {
+		return "*" + name
+	}
+	t := g.goType(s)
+	if strings.HasPrefix(t, "struct {") {
+		return "*" + t
+	}
+	return t
+}
+
+// pathExpr 生成拼接路径的表达式，路径参数按出现顺序成为方法参数
+func (g *clientGen) pathExpr(path string, op *Operation) (string, []*clientParam) {
+	types := map[string]string{}
+	for _, p := range op.Parameters {
+		if p.In == "path" {
+			types[p.Name] = "string"
+			if p.Schema != nil && p.Schema.Type == "integer" {
+				types[p.Name] = "int64"
+			}
+		}
+	}
+	var parts []string
+	var params []*clientParam
+	used := map[string]bool{"ctx": true, "params": true, "body": true, "req": true, "out": true, "err": true, "c": true}
+	rest := path
+	for _, m := range pathParamPattern.FindAllStringSubmatchIndex(path, -1) {
+		prefix := path[len(path)-len(rest) : m[0]]
+		if prefix != "" {
+			parts = append(parts, strconv.Quote(prefix))
+		}
+		raw := path[m[2]:m[3]]
+		goType := types[raw]
+		if goType == "" {
+			goType = "string"
+		}
+		name := uniqueName(lowerFirst(goIdent(raw)), used)
+		if isGoKeyword(name) {
+			name += "Param"
+		}
+		parts = append(parts, "pathParam("+name+")")
+		params = append(params, &clientParam{name: name, goType: goType, in: "path"})
+		rest = path[m[1]:]
+	}
+	if rest != "" || len(parts) == 0 {
+		parts = append(parts, strconv.Quote(rest))
+	}
+	return strings.Join(parts, " + "), params
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// successResponse 返回状态码最小的 2xx/3xx 响应
+func successResponse(op *Operation) (int, *Response) {
+	codes := make([]int, 0, len(op.Responses))
+	for key := range op.Responses {
+		if code, err := strconv.Atoi(key); err == nil && code >= 200 && code < 400 {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return 0, nil
+	}
+	sort.Ints(codes)
+	return codes[0], op.Responses[strconv.Itoa(codes[0])]
+}
+
+func isNullable(s *Schema) bool {
+	return s != nil && s.Nullable
+}
+
+func pointerIf(cond bool, t string) string {
+	if cond {
+		return "*" + t
+	}
+	return t
+}
+
+func methodName(method string) string {
+	return string(method[0]) + strings.ToLower(method[1:])
+}
+
+// goInitialisms 按 Go 命名习惯保持全大写的缩写
+var goInitialisms = map[string]bool{
+	"API": true, "CPU": true, "CRS": true, "CSV": true, "DB": true, "DNS": true, "HTML": true,
+	"HTTP": true, "HTTPS": true, "ID": true, "IDS": true, "IP": true, "JSON": true, "JWT": true,
+	"QPS": true, "RPM": true, "SMTP": true, "SQL": true, "SSE": true, "SSL": true, "TLS": true,
+	"TPM": true, "TPS": true, "TTL": true, "UI": true, "UID": true, "URI": true, "URL": true,
+	"UUID": true, "WS": true,
+}
+
+// goIdent 将 JSON 字段名（snake_case、kebab-case 或 camelCase）转换为导出的 Go 标识符
+func goIdent(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		upper := strings.ToUpper(word)
+		if upper == "IDS" {
+			b.WriteString("IDs")
+			continue
+		}
+		if goInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(capitalize(word))
+	}
+	out := b.String()
+	if out == "" || !unicode.IsLetter([]rune(out)[0]) {
+		out = "X" + out
+	}
+	return out
+}
+
+// exportedIdent 将 lowerCamel 的 operationId 转换为导出名，缩写单词整体大写
+func exportedIdent(id string) string {
+	words := splitWords(id)
+	if len(words) == 0 {
+		return ""
+	}
+	for i, w := range words {
+		if upper := strings.ToUpper(w); goInitialisms[upper] {
+			words[i] = upper
+		} else {
+			words[i] = capitalize(w)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// splitWords 按非字母数字字符与小写到大写的边界拆分单词
+func splitWords(s string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		start := 0
+		r := []rune(part)
+		for i := 1; i < len(r); i++ {
+			if unicode.IsUpper(r[i]) && unicode.IsLower(r[i-1]) {
+				words = append(words, string(r[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(r[start:]))
+	}
+	return words
+}
+
+func uniqueName(name string, used map[string]bool) string {
+	out := name
+	for i := 2; used[out]; i++ {
+		out = name + strconv.Itoa(i)
+	}
+	used[out] = true
+	return out
+}
+
+func isGoKeyword(s string) bool {
+	switch s {
+	case "break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough",
+		"for", "func", "go", "goto", "if", "import", "interface", "map", "package", "range",
+		"return", "select", "struct", "switch", "type", "var":
+		return true
+	}
+	return false
+}
+
+func writeComment(b interface{ WriteString(string) (int, error) }, indent, text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			b.WriteString(indent + "//\n")
+			continue
+		}
+		b.WriteString(indent + "// " + line + "\n")
+	}
+}
@@ -0,0 +1,215 @@
+package openapi
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// externalPackage 仅做语法解析的包（未参与类型检查），用于扫描接收 *gin.Context 的 service 函数
+type externalPackage struct {
+	funcs map[string]*ast.FuncDecl // "Recv.Name" 或 "Name" -> 声明
+}
+
+// externalContentTypes 扫描源码包之外、接收 *gin.Context 的函数设置的响应 Content-Type。
+// 这类函数（如账号测试的 SSE 输出）位于 service 包，只能按语法匹配 Header().Set / c.Header 字面量。
+func (prog *program) externalContentTypes(fn *types.Func) []string {
+	if fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), prog.modulePath+"/") {
+		return nil
+	}
+	pos := prog.fset.Position(fn.Pos())
+	if !pos.IsValid() || pos.Filename == "" {
+		return nil
+	}
+	ext := prog.externalPackage(filepath.Dir(pos.Filename))
+	if ext == nil {
+		return nil
+	}
+	key := fn.Name()
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		recv := sig.Recv().Type()
+		if p, ok := recv.(*types.Pointer); ok {
+			recv = p.Elem()
+		}
+		if named, ok := types.Unalias(recv).(*types.Named); ok {
+			key = named.Obj().Name() + "." + key
+		}
+	}
+	decl := ext.funcs[key]
+	if decl == nil {
+		return nil
+	}
+	var out []string
+	seen := map[*ast.FuncDecl]bool{}
+	ext.scan(decl, seen, &out, 0)
+	return out
+}
+
+func (prog *program) externalPackage(dir string) *externalPackage {
+	if prog.external == nil {
+		prog.external = map[string]*externalPackage{}
+	}
+	if ext, ok := prog.external[dir]; ok {
+		return ext
+	}
+	ext := &externalPackage{funcs: map[string]*ast.FuncDecl{}}
+	prog.external[dir] = ext
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			key := fd.Name.Name
+			if fd.Recv != nil && len(fd.Recv.List) > 0 {
+				recv := fd.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				if id, ok := recv.(*ast.Ident); ok {
+					key = id.Name + "." + key
+				}
+			}
+			ext.funcs[key] = fd
+		}
+	}
+	return ext
+}
+
+// scan 在函数体中查找 Content-Type 字面量，并跟随同样传入 context 参数的同包调用
+func (ext *externalPackage) scan(fd *ast.FuncDecl, seen map[*ast.FuncDecl]bool, out *[]string, depth int) {
+	if fd == nil || fd.Body == nil || seen[fd] || depth > 4 {
+		return
+	}
+	seen[fd] = true
+	ctxName := contextParamName(fd)
+	recvType, recvName := "", ""
+	if fd.Recv != nil && len(fd.Recv.List) > 0 {
+		recv := fd.Recv.List[0]
+		t := recv.Type
+		if star, ok := t.(*ast.StarExpr); ok {
+			t = star.X
+		}
+		if id, ok := t.(*ast.Ident); ok {
+			recvType = id.Name
+		}
+		if len(recv.Names) > 0 {
+			recvName = recv.Names[0].Name
+		}
+	}
+
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if ctxName == "" {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && len(call.Args) == 2 && isResponseHeader(sel, ctxName) {
+			key, ok1 := stringLit(call.Args[0])
+			value, ok2 := stringLit(call.Args[1])
+			if ok1 && ok2 && strings.EqualFold(key, "Content-Type") && !containsString(*out, value) {
+				*out = append(*out, value)
+			}
+		}
+		passesContext := false
+		for _, arg := range call.Args {
+			if id, ok := arg.(*ast.Ident); ok && id.Name == ctxName {
+				passesContext = true
+				break
+			}
+		}
+		if !passesContext {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			ext.scan(ext.funcs[fun.Name], seen, out, depth+1)
+		case *ast.SelectorExpr:
+			if id, ok := fun.X.(*ast.Ident); ok && id.Name == recvName && recvType != "" {
+				ext.scan(ext.funcs[recvType+"."+fun.Sel.Name], seen, out, depth+1)
+			}
+		}
+		return true
+	})
+}
+
+// isResponseHeader 匹配 c.Header(k, v) 与 c.Writer.Header().Set(k, v)
+func isResponseHeader(sel *ast.SelectorExpr, ctxName string) bool {
+	switch sel.Sel.Name {
+	case "Header":
+		id, ok := sel.X.(*ast.Ident)
+		return ok && id.Name == ctxName
+	case "Set":
+		call, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		header, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || header.Sel.Name != "Header" {
+			return false
+		}
+		writer, ok := header.X.(*ast.SelectorExpr)
+		if !ok || writer.Sel.Name != "Writer" {
+			return false
+		}
+		id, ok := writer.X.(*ast.Ident)
+		return ok && id.Name == ctxName
+	}
+	return false
+}
+
+// contextParamName 返回 *gin.Context 参数名
+func contextParamName(fd *ast.FuncDecl) string {
+	for _, field := range fd.Type.Params.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		sel, ok := star.X.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Context" {
+			continue
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "gin" && len(field.Names) > 0 {
+			return field.Names[0].Name
+		}
+	}
+	return ""
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
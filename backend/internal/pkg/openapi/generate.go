@@ -0,0 +1,706 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 认证方式名称
+const (
+	SecurityBearer      = "bearerAuth"
+	SecurityAdminAPIKey = "adminApiKey"
+	SecurityAPIKey      = "apiKey"
+)
+
+// TagGateway 网关（上游兼容）接口的分组，这些接口沿用 Anthropic/OpenAI/Gemini 的请求与响应格式
+const TagGateway = "gateway"
+
+// Generate 分析模块根目录 dir 下的路由与 handler 生成 OpenAPI 文档
+func Generate(dir string) (*Document, error) {
+	prog, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := collectRoutes(prog)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{prog: prog, schemas: newSchemaBuilder(), opIDs: map[string]bool{}}
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "Sub2API",
+			Version:     "1.0.0",
+			Description: "Sub2API 的管理、用户、认证与网关 HTTP API。除网关接口外，响应统一包装为 {code, message, data}，code 为 0 表示成功。本文档由 cmd/openapigen 根据路由注册与 handler 代码生成，请勿手动修改。",
+		},
+		Paths: map[string]*PathItem{},
+	}
+	tags := map[string]bool{}
+	for _, rt := range routes {
+		p, params := openAPIPath(rt.path)
+		op, err := g.operation(rt, params)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", rt.method, rt.path, err)
+		}
+		item := doc.Paths[p]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[p] = item
+		}
+		method := strings.ToLower(rt.method)
+		if _, dup := (*item)[method]; dup {
+			return nil, fmt.Errorf("duplicate route %s %s", rt.method, rt.path)
+		}
+		(*item)[method] = op
+		for _, tag := range op.Tags {
+			tags[tag] = true
+		}
+	}
+	for _, tag := range sortedKeys(tags) {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+
+	doc.Components.Schemas = g.schemas.finish()
+	doc.Components.Schemas["ErrorResponse"] = &Schema{
+		Type:     "object",
+		Required: []string{"code", "message"},
+		Properties: map[string]*Schema{
+			"code":     {Type: "integer", Format: "int64"},
+			"message":  {Type: "string"},
+			"reason":   {Type: "string"},
+			"metadata": {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		},
+	}
+	doc.Components.Responses = map[string]*Response{
+		"Error": {
+			Description: "错误响应，code 为 HTTP 状态码，reason 为机器可读的错误原因",
+			Content:     jsonContent(refSchema("ErrorResponse")),
+		},
+		"GatewayError": {
+			Description: "错误响应，格式与对应上游 API 一致",
+			Content:     jsonContent(&Schema{Type: "object"}),
+		},
+	}
+	doc.Components.SecuritySchemes = map[string]*SecurityScheme{
+		SecurityBearer: {
+			Type:         "http",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+			Description:  "登录接口返回的 access_token",
+		},
+		SecurityAdminAPIKey: {
+			Type:        "apiKey",
+			In:          "header",
+			Name:        "x-api-key",
+			Description: "管理员 API Key（系统设置中生成），仅用于管理接口",
+		},
+		SecurityAPIKey: {
+			Type:        "apiKey",
+			In:          "header",
+			Name:        "x-api-key",
+			Description: "用户 API Key，也可通过 Authorization: Bearer 或 x-goog-api-key 传递",
+		},
+	}
+	return doc, nil
+}
+
+type generator struct {
+	prog    *program
+	schemas *schemaBuilder
+	opIDs   map[string]bool
+}
+
+func (g *generator) operation(rt *route, pathParams []string) (*Operation, error) {
+	name := handlerName(rt)
+	op := &Operation{
+		OperationID: g.operationID(rt, name),
+		Responses:   map[string]*Response{},
+	}
+	op.Summary, op.Description = g.docs(rt)
+	if ref, ok := g.prog.lookupFunc(rt.pkg, rt.handler); ok {
+		op.Handler = strings.TrimPrefix(ref.pkg.path, g.prog.modulePath+"/") + "." + funcDisplayName(ref.decl)
+	}
+	op.Tags = []string{operationTag(name)}
+
+	gateway := false
+	switch securityOf(rt.middleware) {
+	case SecurityAdminAPIKey:
+		op.Security = []map[string][]string{{SecurityBearer: {}}, {SecurityAdminAPIKey: {}}}
+	case SecurityBearer:
+		op.Security = []map[string][]string{{SecurityBearer: {}}}
+	case SecurityAPIKey:
+		op.Security = []map[string][]string{{SecurityAPIKey: {}}}
+		op.Tags = []string{TagGateway}
+		gateway = true
+	}
+
+	if gateway {
+		// 网关接口透传上游协议，请求与响应不做结构约束
+		for _, p := range pathParams {
+			op.Parameters = append(op.Parameters, &Parameter{Name: p, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+		if rt.method != http.MethodGet {
+			op.RequestBody = &RequestBody{Required: true, Content: jsonContent(&Schema{Type: "object"})}
+		}
+		op.Responses["200"] = &Response{
+			Description: "上游兼容的响应；stream 请求以 SSE 返回",
+			Content: map[string]*MediaType{
+				"application/json":  {Schema: &Schema{Type: "object"}},
+				"text/event-stream": {Schema: &Schema{Type: "string"}},
+			},
+		}
+		op.Responses["default"] = &Response{Ref: "#/components/responses/GatewayError"}
+		return op, nil
+	}
+
+	facts := analyzeHandler(g.prog, rt.pkg, rt.handler, rt.method)
+	for _, p := range pathParams {
+		op.Parameters = append(op.Parameters, &Parameter{Name: p, In: "path", Required: true, Schema: paramSchema(facts.kinds[p], false)})
+	}
+	op.Parameters = append(op.Parameters, g.queryParameters(facts)...)
+	for _, h := range facts.headers {
+		op.Parameters = append(op.Parameters, &Parameter{Name: h, In: "header", Schema: &Schema{Type: "string"}})
+	}
+	body, err := g.requestBody(facts, op.OperationID)
+	if err != nil {
+		return nil, err
+	}
+	op.RequestBody = body
+	g.responses(op, facts)
+	op.Responses["default"] = &Response{Ref: "#/components/responses/Error"}
+	return op, nil
+}
+
+func (g *generator) queryParameters(facts *handlerFacts) []*Parameter {
+	var params []*Parameter
+	seen := map[string]bool{}
+	for _, q := range facts.queries {
+		seen[q] = true
+		params = append(params, &Parameter{Name: q, In: "query", Schema: paramSchema(facts.kinds[q], facts.queryArrays[q])})
+	}
+	for _, qs := range facts.queryStructs {
+		st, ok := structOf(qs.pkg, qs.expr)
+		if !ok {
+			continue
+		}
+		for _, p := range g.formParameters(st) {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				params = append(params, p)
+			}
+		}
+	}
+	return params
+}
+
+// formParameters 由 ShouldBindQuery 绑定的结构生成查询参数（form 标签）
+func (g *generator) formParameters(st *types.Struct) []*Parameter {
+	var params []*Parameter
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		name, _, hasName := parseJSONTag(tag.Get("form"))
+		if field.Embedded() && !hasName {
+			if inner, ok := derefStruct(field.Type()); ok {
+				params = append(params, g.formParameters(inner)...)
+				continue
+			}
+		}
+		if !field.Exported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name()
+		}
+		schema := g.schemas.schemaOf(field.Type())
+		schema.Nullable = false
+		required := applyBinding(schema, tag.Get("binding"))
+		params = append(params, &Parameter{Name: name, In: "query", Required: required, Schema: schema})
+	}
+	return params
+}
+
+func (g *generator) requestBody(facts *handlerFacts, opID string) (*RequestBody, error) {
+	switch {
+	case len(facts.formFiles) > 0:
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		for _, f := range facts.formFiles {
+			schema.Properties[f] = &Schema{Type: "string", Format: "binary"}
+			schema.Required = append(schema.Required, f)
+		}
+		for _, f := range facts.formFields {
+			schema.Properties[f] = &Schema{Type: "string"}
+		}
+		return &RequestBody{Required: true, Content: map[string]*MediaType{"multipart/form-data": {Schema: schema}}}, nil
+	case len(facts.jsonBodies) > 0:
+		// 先按 map 读取原始字段再绑定结构的 handler，以结构为准
+		var candidates []bodyCandidate
+		for _, b := range facts.jsonBodies {
+			if t := exprType(b.pkg, b.expr); t != nil {
+				if _, isMap := t.Underlying().(*types.Map); isMap && len(facts.jsonBodies) > 1 {
+					continue
+				}
+			}
+			candidates = append(candidates, b)
+		}
+		if len(candidates) == 0 {
+			candidates = facts.jsonBodies
+		}
+		var schemas []*Schema
+		optional := true
+		for _, b := range candidates {
+			t := exprType(b.pkg, b.expr)
+			if t == nil {
+				return nil, fmt.Errorf("cannot resolve request body type")
+			}
+			schemas = append(schemas, g.schemas.schemaOf(t))
+			optional = optional && b.optional
+		}
+		schema := g.named(mergeSchemas(schemas), pascal(opID)+"Request")
+		return &RequestBody{Required: !optional, Content: jsonContent(schema)}, nil
+	case facts.rawBody:
+		return &RequestBody{Required: true, Content: map[string]*MediaType{
+			"application/octet-stream": {Schema: &Schema{Type: "string", Format: "binary"}},
+		}}, nil
+	}
+	return nil, nil
+}
+
+func (g *generator) responses(op *Operation, facts *handlerFacts) {
+	byStatus := map[int][]*Schema{}
+	envelope := map[int]bool{}
+	for _, rc := range facts.responses {
+		data := g.dataSchema(rc, op.OperationID)
+		if rc.kind != kindRaw {
+			envelope[rc.status] = true
+		}
+		byStatus[rc.status] = append(byStatus[rc.status], data)
+	}
+	for status, schemas := range byStatus {
+		schema := g.named(mergeSchemas(schemas), pascal(op.OperationID)+"Response")
+		if envelope[status] {
+			schema = &Schema{
+				Type:     "object",
+				Envelope: true,
+				Required: []string{"code", "message"},
+				Properties: map[string]*Schema{
+					"code":    {Type: "integer", Format: "int64"},
+					"message": {Type: "string"},
+					"data":    schema,
+				},
+			}
+		}
+		op.Responses[strconv.Itoa(status)] = &Response{Description: http.StatusText(status), Content: jsonContent(schema)}
+	}
+	for status, cts := range facts.contentTypes {
+		resp := op.Responses[strconv.Itoa(status)]
+		if resp == nil {
+			resp = &Response{Description: http.StatusText(status), Content: map[string]*MediaType{}}
+			op.Responses[strconv.Itoa(status)] = resp
+		}
+		for _, ct := range cts {
+			switch {
+			case strings.HasPrefix(ct, "application/json"):
+				if resp.Content["application/json"] == nil {
+					resp.Content["application/json"] = &MediaType{Schema: &Schema{}}
+				}
+			case ct == "text/event-stream":
+				resp.Content[ct] = &MediaType{Schema: &Schema{Type: "string"}}
+			default:
+				resp.Content[strings.TrimSpace(strings.Split(ct, ";")[0])] = &MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+			}
+		}
+	}
+	if facts.upgrade {
+		op.Responses["101"] = &Response{Description: "升级为 WebSocket 连接"}
+	}
+	if facts.redirect != 0 {
+		op.Responses[strconv.Itoa(facts.redirect)] = &Response{Description: "重定向"}
+	}
+	if len(op.Responses) == 0 {
+		op.Responses["200"] = &Response{Description: http.StatusText(http.StatusOK)}
+	}
+}
+
+// dataSchema 响应数据的 schema；gin.H 字面量按键展开
+func (g *generator) dataSchema(rc responseCandidate, opID string) *Schema {
+	if rc.kind == kindPage {
+		item := &Schema{}
+		if t := exprType(rc.pkg, rc.expr); t != nil {
+			if slice, ok := t.Underlying().(*types.Slice); ok {
+				item = g.named(g.schemas.schemaOf(slice.Elem()), pascal(opID)+"Item")
+			}
+		}
+		return &Schema{
+			Type:      "object",
+			Paginated: true,
+			Required:  []string{"items", "total", "page", "page_size", "pages"},
+			Properties: map[string]*Schema{
+				"items":     {Type: "array", Items: item, Nullable: true},
+				"total":     {Type: "integer", Format: "int64"},
+				"page":      {Type: "integer", Format: "int64"},
+				"page_size": {Type: "integer", Format: "int64"},
+				"pages":     {Type: "integer", Format: "int64"},
+			},
+		}
+	}
+	return g.exprSchema(rc.pkg, rc.expr)
+}
+
+func (g *generator) exprSchema(pkg *srcPackage, expr ast.Expr) *Schema {
+	expr = ast.Unparen(expr)
+	if lit, ok := expr.(*ast.CompositeLit); ok {
+		t := exprType(pkg, lit)
+		if t != nil {
+			switch u := t.Underlying().(type) {
+			case *types.Map:
+				if isStringKeyedAnyMap(u) {
+					return g.literalObject(pkg, lit)
+				}
+			case *types.Slice:
+				if m, ok := u.Elem().Underlying().(*types.Map); ok && isStringKeyedAnyMap(m) {
+					var items []*Schema
+					for _, elt := range lit.Elts {
+						items = append(items, g.exprSchema(pkg, elt))
+					}
+					item := &Schema{Type: "object"}
+					if len(items) > 0 {
+						item = mergeSchemas(items)
+					}
+					return &Schema{Type: "array", Items: item, Nullable: true}
+				}
+			}
+		}
+	}
+	t := exprType(pkg, expr)
+	if t == nil {
+		return &Schema{}
+	}
+	if basic, ok := t.(*types.Basic); ok && basic.Kind() == types.UntypedNil {
+		return &Schema{}
+	}
+	if m, ok := t.Underlying().(*types.Map); ok && isStringKeyedAnyMap(m) {
+		// 非字面量的 gin.H：字段未知
+		return &Schema{Type: "object", Nullable: true}
+	}
+	return g.schemas.schemaOf(t)
+}
+
+// literalObject gin.H{...} 字面量：每个键都会输出，视为必有字段
+func (g *generator) literalObject(pkg *srcPackage, lit *ast.CompositeLit) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := constString(pkg.info, kv.Key)
+		if !ok {
+			// 动态键：无法枚举字段
+			return &Schema{Type: "object"}
+		}
+		s.Properties[key] = g.exprSchema(pkg, kv.Value)
+		s.Required = append(s.Required, key)
+	}
+	sort.Strings(s.Required)
+	if len(s.Properties) == 0 {
+		s.Properties = nil
+	}
+	return s
+}
+
+// named 将内联对象登记为命名结构，便于客户端生成类型
+func (g *generator) named(s *Schema, name string) *Schema {
+	if s.Ref == "" && s.Type == "object" && len(s.Properties) > 0 && !s.Paginated {
+		return g.schemas.define(name, s)
+	}
+	if s.Type == "array" && s.Items != nil && s.Items.Ref == "" && s.Items.Type == "object" && len(s.Items.Properties) > 0 {
+		s.Items = g.schemas.define(strings.TrimSuffix(strings.TrimSuffix(name, "Response"), "Item")+"Item", s.Items)
+	}
+	return s
+}
+
+func (g *generator) docs(rt *route) (summary, description string) {
+	text := rt.comment
+	if ref, ok := g.prog.lookupFunc(rt.pkg, rt.handler); ok && ref.decl.Doc != nil {
+		text = ref.decl.Doc.Text()
+		if name := ref.decl.Name.Name; strings.HasPrefix(text, name+" ") {
+			text = strings.TrimPrefix(text, name+" ")
+			text = strings.TrimPrefix(text, "handles ")
+		}
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		line = strings.TrimSpace(line)
+		if method, _, ok := strings.Cut(line, " /"); ok && routeMethods[method] {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return "", ""
+	}
+	return capitalize(lines[0]), strings.TrimSpace(strings.Join(lines[1:], "\n"))
+}
+
+// operationID 由 handler 表达式生成，如 h.Admin.User.List -> adminUserList；
+// 同一 handler 注册到多个路径时，后续路径追加路径名区分
+func (g *generator) operationID(rt *route, name string) string {
+	var id string
+	if name != "" {
+		id = lowerCamel(strings.Split(name, "."))
+	} else {
+		id = lowerCamel(append([]string{strings.ToLower(rt.method)}, pathWords(rt.path)...))
+	}
+	if g.opIDs[id] {
+		id += pascal(lowerCamel(pathWords(rt.path)))
+	}
+	g.opIDs[id] = true
+	return id
+}
+
+// handlerName handler 表达式去掉接收者，如 h.Admin.User.List -> Admin.User.List；
+// 包级函数返回函数名，匿名函数返回空字符串
+func handlerName(rt *route) string {
+	if id, ok := ast.Unparen(rt.handler).(*ast.Ident); ok {
+		return id.Name
+	}
+	sel, ok := ast.Unparen(rt.handler).(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	name := types.ExprString(sel)
+	if _, rest, ok := strings.Cut(name, "."); ok {
+		return rest
+	}
+	return name
+}
+
+// operationTag 按 handler 分组，如 Admin.User.List -> admin.user
+func operationTag(name string) string {
+	parts := strings.Split(name, ".")
+	if len(parts) < 2 {
+		return "system"
+	}
+	return strings.ToLower(strings.Join(parts[:len(parts)-1], "."))
+}
+
+// securityOf 根据路由中间件判断认证方式
+func securityOf(middleware []string) string {
+	var out string
+	for _, mw := range middleware {
+		lower := strings.ToLower(mw)
+		switch {
+		case strings.Contains(lower, "adminauth"):
+			return SecurityAdminAPIKey
+		case strings.Contains(lower, "jwtauth"):
+			out = SecurityBearer
+		case strings.Contains(lower, "apikeyauth"):
+			out = SecurityAPIKey
+		}
+	}
+	return out
+}
+
+func paramSchema(kind string, array bool) *Schema {
+	var s *Schema
+	switch kind {
+	case "integer":
+		s = &Schema{Type: "integer", Format: "int64"}
+	case "number":
+		s = &Schema{Type: "number", Format: "double"}
+	case "boolean":
+		s = &Schema{Type: "boolean"}
+	default:
+		s = &Schema{Type: "string"}
+	}
+	if array {
+		return &Schema{Type: "array", Items: s}
+	}
+	return s
+}
+
+// mergeSchemas 合并同一位置的多个候选 schema：
+// 去重后仅剩一个直接返回；都是对象字面量时按字段合并；否则使用 anyOf
+func mergeSchemas(schemas []*Schema) *Schema {
+	var specific []*Schema
+	for _, s := range schemas {
+		if !isOpaque(s) {
+			specific = append(specific, s)
+		}
+	}
+	if len(specific) == 0 {
+		specific = schemas[:1]
+	}
+	unique := dedupeSchemas(specific)
+	if len(unique) == 1 {
+		return unique[0]
+	}
+	allObjects := true
+	for _, s := range unique {
+		if s.Type != "object" || s.Ref != "" || len(s.Properties) == 0 {
+			allObjects = false
+		}
+	}
+	if !allObjects {
+		return &Schema{AnyOf: unique}
+	}
+	merged := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	candidates := map[string][]*Schema{}
+	requiredCount := map[string]int{}
+	for _, s := range unique {
+		for name, prop := range s.Properties {
+			candidates[name] = append(candidates[name], prop)
+		}
+		for _, name := range s.Required {
+			requiredCount[name]++
+		}
+	}
+	for name, props := range candidates {
+		merged.Properties[name] = mergeSchemas(props)
+		if requiredCount[name] == len(unique) {
+			merged.Required = append(merged.Required, name)
+		}
+	}
+	sort.Strings(merged.Required)
+	return merged
+}
+
+// isOpaque 字段未知的对象、任意值或其数组，在有更具体的候选时忽略
+func isOpaque(s *Schema) bool {
+	if s.Type == "array" && s.Items != nil {
+		return isOpaque(s.Items)
+	}
+	return isAnySchema(s) || (s.Type == "object" && s.Ref == "" && len(s.Properties) == 0 && s.AdditionalProperties == nil)
+}
+
+func dedupeSchemas(schemas []*Schema) []*Schema {
+	seen := map[string]bool{}
+	var out []*Schema
+	for _, s := range schemas {
+		raw, _ := json.Marshal(s)
+		if seen[string(raw)] {
+			continue
+		}
+		seen[string(raw)] = true
+		out = append(out, s)
+	}
+	return out
+}
+
+func jsonContent(s *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: s}}
+}
+
+func exprType(pkg *srcPackage, expr ast.Expr) types.Type {
+	if expr == nil {
+		return nil
+	}
+	if u, ok := ast.Unparen(expr).(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = u.X
+	}
+	tv, ok := pkg.info.Types[expr]
+	if !ok {
+		return nil
+	}
+	return tv.Type
+}
+
+func structOf(pkg *srcPackage, expr ast.Expr) (*types.Struct, bool) {
+	t := exprType(pkg, expr)
+	if t == nil {
+		return nil, false
+	}
+	return derefStruct(t)
+}
+
+func derefStruct(t types.Type) (*types.Struct, bool) {
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	return st, ok
+}
+
+func isStringKeyedAnyMap(m *types.Map) bool {
+	key, ok := m.Key().Underlying().(*types.Basic)
+	if !ok || key.Kind() != types.String {
+		return false
+	}
+	iface, ok := m.Elem().Underlying().(*types.Interface)
+	return ok && iface.Empty()
+}
+
+func funcDisplayName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return fd.Name.Name
+	}
+	recv := fd.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	return types.ExprString(recv) + "." + fd.Name.Name
+}
+
+// pathWords 路径拆分为单词，如 /api/v1/openapi.json -> api v1 openapi json
+func pathWords(p string) []string {
+	return strings.FieldsFunc(p, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func lowerCamel(words []string) string {
+	var b strings.Builder
+	for i, w := range words {
+		if w == "" {
+			continue
+		}
+		if i == 0 {
+			b.WriteString(lowerFirst(w))
+		} else {
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return b.String()
+}
+
+// lowerFirst 小写开头的大写字母串，保留紧邻小写字母的最后一个大写（APIKey -> apiKey）
+func lowerFirst(w string) string {
+	r := []rune(w)
+	n := 0
+	for n < len(r) && unicode.IsUpper(r[n]) {
+		n++
+	}
+	if n > 1 && n < len(r) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+func pascal(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func capitalize(s string) string {
+	r := []rune(s)
+	if len(r) > 0 {
+		r[0] = unicode.ToUpper(r[0])
+	}
+	return string(r)
+}
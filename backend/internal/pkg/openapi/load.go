@@ -0,0 +1,194 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// sourcePackages 需要从源码分析的包（相对模块路径），其余依赖使用编译器导出数据
+var sourcePackages = []string{
+	"internal/handler/admin",
+	"internal/handler",
+	"internal/server/routes",
+	"internal/server",
+}
+
+// entryPackage 路由注册入口所在包
+const entryPackage = "internal/server"
+
+type listedPackage struct {
+	ImportPath string
+	Dir        string
+	Export     string
+	GoFiles    []string
+	Error      *struct{ Err string }
+}
+
+type srcPackage struct {
+	path  string
+	pkg   *types.Package
+	info  *types.Info
+	files []*ast.File
+}
+
+type funcRef struct {
+	decl *ast.FuncDecl
+	pkg  *srcPackage
+}
+
+// program 类型检查后的源码包及函数索引
+type program struct {
+	fset       *token.FileSet
+	modulePath string
+	packages   map[string]*srcPackage
+	funcs      map[string]funcRef // types.Func.FullName() -> 声明
+	external   map[string]*externalPackage
+}
+
+// load 通过 go list 获取依赖的导出数据，并对 sourcePackages 做源码类型检查
+func load(dir string) (*program, error) {
+	cmd := exec.Command("go", "list", "-deps", "-export", "-json=ImportPath,Dir,Export,GoFiles,Error", "./"+entryPackage)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var listed []*listedPackage
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var p listedPackage
+		if err := dec.Decode(&p); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("decode go list output: %w", err)
+		}
+		if p.Error != nil {
+			return nil, fmt.Errorf("package %s: %s", p.ImportPath, p.Error.Err)
+		}
+		listed = append(listed, &p)
+	}
+	if len(listed) == 0 {
+		return nil, errors.New("go list returned no packages")
+	}
+	// -deps 按依赖顺序输出，入口包位于最后
+	entry := listed[len(listed)-1].ImportPath
+	modulePath := strings.TrimSuffix(entry, "/"+entryPackage)
+
+	prog := &program{
+		fset:       token.NewFileSet(),
+		modulePath: modulePath,
+		packages:   map[string]*srcPackage{},
+		funcs:      map[string]funcRef{},
+	}
+	exports := map[string]string{}
+	for _, p := range listed {
+		exports[p.ImportPath] = p.Export
+	}
+	gc := importer.ForCompiler(prog.fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok || file == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})
+	imp := importerFunc(func(path string) (*types.Package, error) {
+		if p, ok := prog.packages[path]; ok {
+			return p.pkg, nil
+		}
+		return gc.Import(path)
+	})
+
+	wanted := map[string]bool{}
+	for _, rel := range sourcePackages {
+		wanted[modulePath+"/"+rel] = true
+	}
+	for _, p := range listed {
+		if !wanted[p.ImportPath] {
+			continue
+		}
+		sp, err := prog.check(p, imp)
+		if err != nil {
+			return nil, err
+		}
+		prog.packages[p.ImportPath] = sp
+	}
+	for _, rel := range sourcePackages {
+		if _, ok := prog.packages[modulePath+"/"+rel]; !ok {
+			return nil, fmt.Errorf("package %s not found", rel)
+		}
+	}
+	return prog, nil
+}
+
+func (prog *program) check(p *listedPackage, imp types.Importer) (*srcPackage, error) {
+	files := make([]*ast.File, 0, len(p.GoFiles))
+	for _, name := range p.GoFiles {
+		f, err := parser.ParseFile(prog.fset, filepath.Join(p.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	info := &types.Info{
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Defs:       map[*ast.Ident]types.Object{},
+		Uses:       map[*ast.Ident]types.Object{},
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+	}
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check(p.ImportPath, prog.fset, files, info)
+	if err != nil {
+		return nil, fmt.Errorf("type-check %s: %w", p.ImportPath, err)
+	}
+	sp := &srcPackage{path: p.ImportPath, pkg: pkg, info: info, files: files}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if fn, ok := info.Defs[fd.Name].(*types.Func); ok {
+				prog.funcs[fn.FullName()] = funcRef{decl: fd, pkg: sp}
+			}
+		}
+	}
+	return sp, nil
+}
+
+// lookupFunc 返回调用目标在源码包中的声明
+func (prog *program) lookupFunc(pkg *srcPackage, fun ast.Expr) (funcRef, bool) {
+	var ident *ast.Ident
+	switch f := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		ident = f
+	case *ast.SelectorExpr:
+		ident = f.Sel
+	default:
+		return funcRef{}, false
+	}
+	fn, ok := pkg.info.Uses[ident].(*types.Func)
+	if !ok {
+		return funcRef{}, false
+	}
+	ref, ok := prog.funcs[fn.Origin().FullName()]
+	return ref, ok
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
@@ -0,0 +1,217 @@
+// Package openapi 描述 sub2api HTTP API 的 OpenAPI 3 文档。
+//
+// 文档由 Generate 对路由注册代码与 handler 做静态分析生成：路由来自 server.registerRoutes
+// 的 Group/Use/GET 等调用，请求结构来自 ShouldBind* 绑定的类型，响应结构来自
+// response.Success/Paginated 与 c.JSON 的参数类型。GenerateClient 再由文档生成类型化的
+// Go 客户端。生成结果提交在仓库中，测试会检查其与代码保持一致。
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Version OpenAPI 规范版本
+const Version = "3.0.3"
+
+// 生成结果在仓库中的位置（相对 backend 模块根目录）
+const (
+	SpecFile      = "internal/server/routes/openapi.json"
+	ClientFile    = "pkg/apiclient/client_gen.go"
+	ClientPackage = "apiclient"
+)
+
+// Document OpenAPI 文档（仅包含本项目用到的字段）
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info 文档元信息
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Tag 操作分组
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem 同一路径下各 HTTP 方法的操作，键为小写方法名
+type PathItem map[string]*Operation
+
+// Operation 单个 API 操作
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationID string                `json:"operationId"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	// Handler 处理该操作的 Go 函数，便于从文档定位代码
+	Handler string `json:"x-handler,omitempty"`
+}
+
+// Parameter 路径或查询参数
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody 请求体
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response 响应
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType 某个 Content-Type 下的内容结构
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Components 可复用的结构定义
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	Responses       map[string]*Response       `json:"responses,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme 认证方式
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Description  string `json:"description,omitempty"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Name         string `json:"name,omitempty"`
+	In           string `json:"in,omitempty"`
+}
+
+// Schema JSON Schema（OpenAPI 3.0 子集）
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	// Envelope 标记统一响应包装 {code, message, data}
+	Envelope bool `json:"x-envelope,omitempty"`
+	// Paginated 标记分页数据 {items, total, page, page_size, pages}
+	Paginated bool `json:"x-paginated,omitempty"`
+}
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// RefName 返回 $ref 指向的结构名，非引用时返回空字符串
+func (s *Schema) RefName() string {
+	if s == nil || !strings.HasPrefix(s.Ref, schemaRefPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(s.Ref, schemaRefPrefix)
+}
+
+// refSchema 引用 components/schemas 下的结构
+func refSchema(name string) *Schema {
+	return &Schema{Ref: schemaRefPrefix + name}
+}
+
+// Operations 按路径、方法排序遍历所有操作
+func (d *Document) Operations(fn func(method, path string, op *Operation)) {
+	for _, path := range sortedKeys(d.Paths) {
+		item := d.Paths[path]
+		for _, method := range sortedKeys(*item) {
+			fn(strings.ToUpper(method), path, (*item)[method])
+		}
+	}
+}
+
+// Operation 按方法与路径查找操作。路径可以是文档中的模板（/users/{id}），
+// 也可以是实际请求路径（/users/1?page=1），后者按段匹配模板，字面量段优先
+func (d *Document) Operation(method, path string) *Operation {
+	path, _, _ = strings.Cut(path, "?")
+	method = strings.ToLower(method)
+	if item := d.Paths[path]; item != nil {
+		return (*item)[method]
+	}
+	segments := strings.Split(path, "/")
+	var best *Operation
+	bestLiterals := -1
+	for template, item := range d.Paths {
+		op := (*item)[method]
+		if op == nil {
+			continue
+		}
+		if literals, ok := matchTemplate(strings.Split(template, "/"), segments); ok && literals > bestLiterals {
+			best, bestLiterals = op, literals
+		}
+	}
+	return best
+}
+
+// matchTemplate 按段匹配路径模板，返回匹配的字面量段数
+func matchTemplate(template, segments []string) (int, bool) {
+	if len(template) != len(segments) {
+		return 0, false
+	}
+	literals := 0
+	for i, seg := range template {
+		switch {
+		case strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}"):
+			if segments[i] == "" {
+				return 0, false
+			}
+		case seg == segments[i]:
+			literals++
+		default:
+			return 0, false
+		}
+	}
+	return literals, true
+}
+
+// Marshal 输出带缩进的 JSON（以换行结尾，便于提交到仓库）
+func (d *Document) Marshal() ([]byte, error) {
+	raw, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(raw, '\n'), nil
+}
+
+// Parse 解析 JSON 格式的文档
+func Parse(raw []byte) (*Document, error) {
+	var doc Document
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -0,0 +1,154 @@
+package openapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testDocument() *Document {
+	user := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":         {Type: "integer", Format: "int64"},
+			"email":      {Type: "string"},
+			"role":       {Type: "string", Enum: []any{"admin", "user"}},
+			"expires_at": {Type: "string", Format: "date-time", Nullable: true},
+			"tags":       {Type: "array", Nullable: true, Items: &Schema{Type: "string"}},
+		},
+		Required: []string{"id", "email"},
+	}
+	envelope := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"code":    {Type: "integer", Format: "int64"},
+			"message": {Type: "string"},
+			"data":    {Nullable: true, AllOf: []*Schema{refSchema("User")}},
+		},
+		Required: []string{"code", "message"},
+		Envelope: true,
+	}
+	return &Document{
+		OpenAPI: Version,
+		Paths: map[string]*PathItem{
+			"/api/v1/users/{id}": {
+				"get": {
+					OperationID: "userGet",
+					Summary:     "Getting a user",
+					Parameters: []*Parameter{
+						{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "integer", Format: "int64"}},
+						{Name: "include", In: "query", Schema: &Schema{Type: "string"}},
+					},
+					Responses: map[string]*Response{
+						"200":     {Description: "OK", Content: jsonContent(envelope)},
+						"default": {Ref: "#/components/responses/Error"},
+					},
+				},
+			},
+			"/api/v1/users/me": {
+				"get": {OperationID: "userMe", Responses: map[string]*Response{"200": {Description: "OK"}}},
+			},
+		},
+		Components: Components{
+			Schemas: map[string]*Schema{
+				"User": user,
+				"ErrorResponse": {
+					Type:       "object",
+					Properties: map[string]*Schema{"code": {Type: "integer"}, "message": {Type: "string"}},
+					Required:   []string{"code", "message"},
+				},
+			},
+			Responses: map[string]*Response{
+				"Error": {Description: "错误", Content: jsonContent(refSchema("ErrorResponse"))},
+			},
+		},
+	}
+}
+
+func TestOperationMatchesConcretePaths(t *testing.T) {
+	doc := testDocument()
+	require.Equal(t, "userGet", doc.Operation("GET", "/api/v1/users/{id}").OperationID)
+	require.Equal(t, "userGet", doc.Operation("get", "/api/v1/users/42?include=groups").OperationID)
+	require.Equal(t, "userMe", doc.Operation("GET", "/api/v1/users/me").OperationID)
+	require.Nil(t, doc.Operation("POST", "/api/v1/users/42"))
+	require.Nil(t, doc.Operation("GET", "/api/v1/users/42/keys"))
+}
+
+func TestValidateResponse(t *testing.T) {
+	doc := testDocument()
+	valid := `{"code":0,"message":"success","data":{"id":1,"email":"a@example.com","role":"user","expires_at":null,"tags":null}}`
+	require.NoError(t, doc.ValidateResponse("GET", "/api/v1/users/1", 200, []byte(valid)))
+	require.NoError(t, doc.ValidateResponse("GET", "/api/v1/users/1", 200, []byte(`{"code":0,"message":"success","data":null}`)))
+	require.NoError(t, doc.ValidateResponse("GET", "/api/v1/users/1", 404, []byte(`{"code":404,"message":"not found"}`)))
+
+	cases := map[string]string{
+		"missing required": `{"code":0,"message":"success","data":{"id":1}}`,
+		"undocumented":     `{"code":0,"message":"success","data":{"id":1,"email":"a","password":"x"}}`,
+		"wrong type":       `{"code":0,"message":"success","data":{"id":"1","email":"a"}}`,
+		"not integer":      `{"code":0,"message":"success","data":{"id":1.5,"email":"a"}}`,
+		"bad enum":         `{"code":0,"message":"success","data":{"id":1,"email":"a","role":"root"}}`,
+		"null not allowed": `{"code":0,"message":"success","data":{"id":1,"email":null}}`,
+		"bad item":         `{"code":0,"message":"success","data":{"id":1,"email":"a","tags":[1]}}`,
+	}
+	for name, body := range cases {
+		require.Error(t, doc.ValidateResponse("GET", "/api/v1/users/1", 200, []byte(body)), name)
+	}
+	require.Error(t, doc.ValidateResponse("GET", "/api/v1/users/1", 404, []byte(`{"message":"not found"}`)))
+	require.Error(t, doc.ValidateResponse("DELETE", "/api/v1/users/1", 200, []byte(`{}`)))
+}
+
+func TestMergeSchemas(t *testing.T) {
+	a := &Schema{Type: "object", Properties: map[string]*Schema{"id": {Type: "integer"}, "name": {Type: "string"}}, Required: []string{"id", "name"}}
+	b := &Schema{Type: "object", Properties: map[string]*Schema{"id": {Type: "integer"}}, Required: []string{"id"}}
+
+	merged := mergeSchemas([]*Schema{a, b})
+	require.Equal(t, "object", merged.Type)
+	require.Len(t, merged.Properties, 2)
+	require.Equal(t, []string{"id"}, merged.Required)
+
+	// 不透明结构（any、空对象）让位于具体结构
+	require.Same(t, a, mergeSchemas([]*Schema{{}, a, {Type: "object"}}))
+	require.Same(t, a, mergeSchemas([]*Schema{a, a}))
+
+	mixed := mergeSchemas([]*Schema{refSchema("User"), {Type: "string"}})
+	require.Len(t, mixed.AnyOf, 2)
+}
+
+func TestNames(t *testing.T) {
+	require.Equal(t, "apiKeyList", lowerCamel([]string{"APIKey", "List"}))
+	require.Equal(t, "adminUserList", lowerCamel([]string{"Admin", "User", "List"}))
+	require.Equal(t, "url", lowerFirst("URL"))
+
+	require.Equal(t, "APIKeyList", exportedIdent("apiKeyList"))
+	require.Equal(t, "PostAPIEventLoggingBatch", exportedIdent("postApiEventLoggingBatch"))
+	require.Equal(t, "GroupIDs", goIdent("group_ids"))
+	require.Equal(t, "XBackupPassphrase", goIdent("X-Backup-Passphrase"))
+	require.Equal(t, "BaseURL", goIdent("base_url"))
+	require.Equal(t, "X5h", goIdent("5h"))
+
+	path, params := openAPIPath("/api/v1/users/:id/files/*path")
+	require.Equal(t, "/api/v1/users/{id}/files/{path}", path)
+	require.Equal(t, []string{"id", "path"}, params)
+	require.Equal(t, "/a/b/", joinPaths("/a", "b/"))
+}
+
+func TestGenerateClient(t *testing.T) {
+	src, err := GenerateClient(testDocument(), "apiclient")
+	require.NoError(t, err)
+	code := string(src)
+
+	require.True(t, strings.HasPrefix(code, "// Code generated"))
+	require.Contains(t, code, "type User struct {")
+	require.Contains(t, code, "Email     string     `json:\"email\"`")
+	require.Contains(t, code, "ExpiresAt *time.Time `json:\"expires_at,omitempty\"`")
+	require.Contains(t, code, "func (c *Client) UserGet(ctx context.Context, id int64, params *UserGetParams) (*User, error) {")
+	require.Contains(t, code, `path: "/api/v1/users/" + pathParam(id)`)
+	require.Contains(t, code, `req.setQuery("include", params.Include)`)
+	require.Contains(t, code, "func (c *Client) UserMe(ctx context.Context) error {")
+
+	doc := testDocument()
+	doc.Components.Schemas["Page"] = &Schema{Type: "object"}
+	_, err = GenerateClient(doc, "apiclient")
+	require.Error(t, err)
+}
@@ -0,0 +1,269 @@
+package openapi
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"path"
+	"strings"
+)
+
+// route 一条注册的路由
+type route struct {
+	method     string
+	path       string // gin 形式，如 /users/:id
+	middleware []string
+	handler    ast.Expr
+	pkg        *srcPackage // handler 表达式所在包
+	comment    string      // 注册语句上方的注释
+}
+
+// group 路由组的前缀与中间件（中间件以源码表达式表示）
+type group struct {
+	prefix     string
+	middleware []string
+}
+
+func (g *group) child(rel string, middleware []string) *group {
+	return &group{
+		prefix:     joinPaths(g.prefix, rel),
+		middleware: append(append([]string(nil), g.middleware...), middleware...),
+	}
+}
+
+var routeMethods = map[string]bool{
+	"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "HEAD": true, "OPTIONS": true,
+}
+
+// routeWalker 对路由注册函数做符号执行，收集所有路由
+type routeWalker struct {
+	prog   *program
+	routes []*route
+}
+
+// collectRoutes 从 server.registerRoutes 开始收集路由；参数 r 为根路由
+func collectRoutes(prog *program) ([]*route, error) {
+	entry := prog.packages[prog.modulePath+"/"+entryPackage]
+	obj, ok := entry.pkg.Scope().Lookup("registerRoutes").(*types.Func)
+	if !ok {
+		return nil, fmt.Errorf("%s.registerRoutes not found", entryPackage)
+	}
+	ref, ok := prog.funcs[obj.FullName()]
+	if !ok {
+		return nil, fmt.Errorf("%s.registerRoutes has no source", entryPackage)
+	}
+	env := map[types.Object]*group{}
+	for _, field := range ref.decl.Type.Params.List {
+		for _, name := range field.Names {
+			if isGinType(ref.pkg.info.Defs[name].Type(), "Engine") {
+				env[ref.pkg.info.Defs[name]] = &group{}
+			}
+		}
+	}
+	w := &routeWalker{prog: prog}
+	w.walk(ref, env, 0)
+	if len(w.routes) == 0 {
+		return nil, fmt.Errorf("no routes found")
+	}
+	return w.routes, nil
+}
+
+func (w *routeWalker) walk(ref funcRef, env map[types.Object]*group, depth int) {
+	if depth > 8 || ref.decl.Body == nil {
+		return
+	}
+	info := ref.pkg.info
+	comments := w.commentMap(ref)
+
+	ast.Inspect(ref.decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+				if g := w.groupOf(ref.pkg, env, n.Rhs[0]); g != nil {
+					if id, ok := n.Lhs[0].(*ast.Ident); ok {
+						obj := info.Defs[id]
+						if obj == nil {
+							obj = info.Uses[id]
+						}
+						if obj != nil {
+							env[obj] = g
+						}
+					}
+					return false
+				}
+			}
+		case *ast.ExprStmt:
+			call, ok := n.X.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			w.call(ref, env, call, comments[n], depth)
+			return false
+		}
+		return true
+	})
+}
+
+// call 处理一条调用语句：Use / 路由注册 / 继续进入其他注册函数
+func (w *routeWalker) call(ref funcRef, env map[types.Object]*group, call *ast.CallExpr, comment string, depth int) {
+	info := ref.pkg.info
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if g := w.groupOf(ref.pkg, env, sel.X); g != nil {
+			method := sel.Sel.Name
+			switch {
+			case method == "Use":
+				for _, arg := range call.Args {
+					g.middleware = append(g.middleware, types.ExprString(arg))
+				}
+				return
+			case routeMethods[method] && len(call.Args) >= 2:
+				w.addRoute(ref.pkg, g, method, call.Args[0], call.Args[1:], comment)
+				return
+			case method == "Handle" && len(call.Args) >= 3:
+				if m, ok := constString(info, call.Args[0]); ok {
+					w.addRoute(ref.pkg, g, strings.ToUpper(m), call.Args[1], call.Args[2:], comment)
+				}
+				return
+			}
+		}
+	}
+
+	callee, ok := w.prog.lookupFunc(ref.pkg, call.Fun)
+	if !ok {
+		return
+	}
+	calleeEnv := map[types.Object]*group{}
+	var params []*ast.Ident
+	for _, field := range callee.decl.Type.Params.List {
+		params = append(params, field.Names...)
+	}
+	for i, arg := range call.Args {
+		if i >= len(params) {
+			break
+		}
+		if g := w.groupOf(ref.pkg, env, arg); g != nil {
+			calleeEnv[callee.pkg.info.Defs[params[i]]] = g
+		}
+	}
+	if len(calleeEnv) > 0 {
+		w.walk(callee, calleeEnv, depth+1)
+	}
+}
+
+func (w *routeWalker) addRoute(pkg *srcPackage, g *group, method string, pathExpr ast.Expr, handlers []ast.Expr, comment string) {
+	rel, ok := constString(pkg.info, pathExpr)
+	if !ok {
+		return
+	}
+	middleware := append([]string(nil), g.middleware...)
+	for _, h := range handlers[:len(handlers)-1] {
+		middleware = append(middleware, types.ExprString(h))
+	}
+	w.routes = append(w.routes, &route{
+		method:     method,
+		path:       joinPaths(g.prefix, rel),
+		middleware: middleware,
+		handler:    handlers[len(handlers)-1],
+		pkg:        pkg,
+		comment:    comment,
+	})
+}
+
+// groupOf 求值路由组表达式：已知变量，或 x.Group("...") 调用
+func (w *routeWalker) groupOf(pkg *srcPackage, env map[types.Object]*group, expr ast.Expr) *group {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return env[pkg.info.Uses[e]]
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Group" || len(e.Args) == 0 {
+			return nil
+		}
+		parent := w.groupOf(pkg, env, sel.X)
+		if parent == nil {
+			return nil
+		}
+		rel, ok := constString(pkg.info, e.Args[0])
+		if !ok {
+			return nil
+		}
+		middleware := make([]string, 0, len(e.Args)-1)
+		for _, arg := range e.Args[1:] {
+			middleware = append(middleware, types.ExprString(arg))
+		}
+		return parent.child(rel, middleware)
+	}
+	return nil
+}
+
+// commentMap 返回函数体内语句上方的注释
+func (w *routeWalker) commentMap(ref funcRef) map[ast.Node]string {
+	out := map[ast.Node]string{}
+	for _, f := range ref.pkg.files {
+		if f.Pos() > ref.decl.Pos() || ref.decl.End() > f.End() {
+			continue
+		}
+		for node, groups := range ast.NewCommentMap(w.prog.fset, f, f.Comments) {
+			if len(groups) > 0 {
+				out[node] = strings.TrimSpace(groups[0].Text())
+			}
+		}
+	}
+	return out
+}
+
+// joinPaths 与 gin 的路径拼接规则一致
+func joinPaths(abs, rel string) string {
+	if rel == "" {
+		return abs
+	}
+	final := path.Join(abs, rel)
+	if strings.HasSuffix(rel, "/") && !strings.HasSuffix(final, "/") {
+		return final + "/"
+	}
+	return final
+}
+
+// openAPIPath 将 gin 路径参数（:id、*path）转换为 {id} 形式，并返回参数名
+func openAPIPath(ginPath string) (string, []string) {
+	segments := strings.Split(ginPath, "/")
+	var params []string
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			params = append(params, seg[1:])
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+func constString(info *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+func constInt(info *types.Info, expr ast.Expr) (int, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return 0, false
+	}
+	v, ok := constant.Int64Val(tv.Value)
+	return int(v), ok
+}
+
+// isGinType 判断 t 是否为 *gin.<name>
+func isGinType(t types.Type, name string) bool {
+	p, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := types.Unalias(p.Elem()).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "github.com/gin-gonic/gin" && named.Obj().Name() == name
+}
@@ -0,0 +1,340 @@
+package openapi
+
+import (
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// schemaBuilder 将 Go 类型转换为 schema，命名 struct 类型收集到 components 中。
+// 引用先以类型全名占位，全部操作分析完毕后由 finish 统一分配结构名（重名时加包名前缀）。
+type schemaBuilder struct {
+	named   map[string]*namedSchema // 类型全名 -> 结构
+	pending []*Schema               // 使用占位引用的 schema
+}
+
+type namedSchema struct {
+	pkg    string
+	name   string
+	schema *Schema
+	// assigned 最终的结构名
+	assigned string
+}
+
+const placeholderPrefix = "\x00"
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{named: map[string]*namedSchema{}}
+}
+
+// define 以指定名称登记匿名结构（如内联请求体），返回对它的引用
+func (b *schemaBuilder) define(name string, s *Schema) *Schema {
+	key := "inline." + name
+	b.named[key] = &namedSchema{name: name, schema: s, assigned: name}
+	return b.ref(key)
+}
+
+func (b *schemaBuilder) ref(key string) *Schema {
+	s := &Schema{Ref: placeholderPrefix + key}
+	b.pending = append(b.pending, s)
+	return s
+}
+
+// schemaOf 转换类型；tag 为所在结构字段的 binding 标签，用于推导约束
+func (b *schemaBuilder) schemaOf(t types.Type) *Schema {
+	switch t := t.(type) {
+	case *types.Alias:
+		return b.schemaOf(types.Unalias(t))
+	case *types.Pointer:
+		s := b.schemaOf(t.Elem())
+		return nullable(s)
+	case *types.Named:
+		if s, ok := b.wellKnown(t); ok {
+			return s
+		}
+		if _, ok := t.Underlying().(*types.Struct); ok {
+			key := typeKey(t)
+			if _, ok := b.named[key]; !ok {
+				entry := &namedSchema{pkg: pkgName(t), name: typeName(t)}
+				b.named[key] = entry
+				entry.schema = b.structSchema(t.Underlying().(*types.Struct))
+			}
+			return b.ref(key)
+		}
+		return b.schemaOf(t.Underlying())
+	case *types.Basic:
+		return basicSchema(t)
+	case *types.Slice:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return &Schema{Type: "string", Format: "byte", Nullable: true}
+		}
+		return &Schema{Type: "array", Items: b.schemaOf(t.Elem()), Nullable: true}
+	case *types.Array:
+		return &Schema{Type: "array", Items: b.schemaOf(t.Elem())}
+	case *types.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schemaOf(t.Elem()), Nullable: true}
+	case *types.Struct:
+		return b.structSchema(t)
+	case *types.Interface:
+		return &Schema{}
+	}
+	return &Schema{}
+}
+
+// wellKnown 处理自定义 JSON 编码的类型
+func (b *schemaBuilder) wellKnown(t *types.Named) (*Schema, bool) {
+	switch typeKey(t) {
+	case "time.Time":
+		return &Schema{Type: "string", Format: "date-time"}, true
+	case "time.Duration":
+		return &Schema{Type: "integer", Format: "int64"}, true
+	case "encoding/json.RawMessage", "encoding/json.Number":
+		return &Schema{}, true
+	}
+	if hasMethod(t, "MarshalJSON") {
+		return &Schema{}, true
+	}
+	if hasMethod(t, "MarshalText") {
+		return &Schema{Type: "string"}, true
+	}
+	return nil, false
+}
+
+func (b *schemaBuilder) structSchema(st *types.Struct) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	b.addFields(s, st)
+	if len(s.Properties) == 0 {
+		s.Properties = nil
+	}
+	return s
+}
+
+func (b *schemaBuilder) addFields(s *Schema, st *types.Struct) {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		name, opts, hasName := parseJSONTag(tag.Get("json"))
+		if name == "-" && opts == "" {
+			continue
+		}
+		if field.Embedded() && !hasName {
+			// 匿名嵌入的 struct 字段提升到外层
+			ft := field.Type()
+			if p, ok := ft.Underlying().(*types.Pointer); ok {
+				ft = p.Elem()
+			}
+			if inner, ok := ft.Underlying().(*types.Struct); ok {
+				b.addFields(s, inner)
+				continue
+			}
+		}
+		if !field.Exported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name()
+		}
+		prop := b.schemaOf(field.Type())
+		if strings.Contains(opts, "string") {
+			prop = &Schema{Type: "string", Nullable: prop.Nullable}
+		}
+		required := applyBinding(prop, tag.Get("binding"))
+		s.Properties[name] = prop
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// applyBinding 将 gin binding 标签中的约束写入 schema，返回是否必填
+func applyBinding(s *Schema, binding string) bool {
+	if binding == "" {
+		return false
+	}
+	required := false
+	for _, rule := range strings.Split(binding, ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "dive":
+			// dive 之后的规则作用于元素，不再处理
+			return required
+		case "required":
+			required = true
+		case "oneof":
+			s.Enum = nil
+			for _, v := range strings.Fields(value) {
+				if s.Type == "integer" {
+					if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+						s.Enum = append(s.Enum, n)
+						continue
+					}
+				}
+				s.Enum = append(s.Enum, v)
+			}
+		case "email":
+			s.Format = "email"
+		case "url", "http_url":
+			s.Format = "uri"
+		case "min", "gte", "max", "lte":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			lower := key == "min" || key == "gte"
+			switch s.Type {
+			case "integer", "number":
+				if lower {
+					s.Minimum = &n
+				} else {
+					s.Maximum = &n
+				}
+			case "string":
+				setInt(lower, int(n), &s.MinLength, &s.MaxLength)
+			case "array":
+				setInt(lower, int(n), &s.MinItems, &s.MaxItems)
+			}
+		}
+	}
+	return required
+}
+
+func setInt(lower bool, n int, min, max **int) {
+	if lower {
+		*min = &n
+	} else {
+		*max = &n
+	}
+}
+
+func basicSchema(t *types.Basic) *Schema {
+	switch t.Kind() {
+	case types.Bool, types.UntypedBool:
+		return &Schema{Type: "boolean"}
+	case types.Int32, types.Int16, types.Int8, types.Uint8, types.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case types.Int, types.Int64, types.Uint, types.Uint32, types.Uint64, types.UntypedInt:
+		return &Schema{Type: "integer", Format: "int64"}
+	case types.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case types.Float64, types.UntypedFloat:
+		return &Schema{Type: "number", Format: "double"}
+	case types.String, types.UntypedString:
+		return &Schema{Type: "string"}
+	}
+	return &Schema{}
+}
+
+// nullable 标记可为 null；引用不能直接附加属性，使用 allOf 包装
+func nullable(s *Schema) *Schema {
+	if s.Ref != "" {
+		return &Schema{AllOf: []*Schema{s}, Nullable: true}
+	}
+	if isAnySchema(s) {
+		return s
+	}
+	s.Nullable = true
+	return s
+}
+
+// finish 为命名结构分配最终名称并替换占位引用
+func (b *schemaBuilder) finish() map[string]*Schema {
+	byName := map[string][]*namedSchema{}
+	for _, entry := range b.named {
+		if entry.assigned == "" {
+			byName[entry.name] = append(byName[entry.name], entry)
+		}
+	}
+	for name, entries := range byName {
+		if len(entries) == 1 {
+			entries[0].assigned = name
+			continue
+		}
+		for _, entry := range entries {
+			entry.assigned = exportName(entry.pkg) + name
+		}
+	}
+	schemas := map[string]*Schema{}
+	for _, entry := range b.named {
+		schemas[entry.assigned] = entry.schema
+	}
+	for _, s := range b.pending {
+		key := strings.TrimPrefix(s.Ref, placeholderPrefix)
+		s.Ref = schemaRefPrefix + b.named[key].assigned
+	}
+	b.pending = nil
+	return schemas
+}
+
+func typeKey(t *types.Named) string {
+	obj := t.Obj()
+	key := obj.Name()
+	if obj.Pkg() != nil {
+		key = obj.Pkg().Path() + "." + key
+	}
+	if args := t.TypeArgs(); args != nil {
+		parts := make([]string, args.Len())
+		for i := range parts {
+			parts[i] = types.TypeString(args.At(i), nil)
+		}
+		key += "[" + strings.Join(parts, ",") + "]"
+	}
+	return key
+}
+
+func pkgName(t *types.Named) string {
+	if t.Obj().Pkg() == nil {
+		return ""
+	}
+	return t.Obj().Pkg().Name()
+}
+
+// typeName 结构名：类型名首字母大写，泛型实例拼接类型参数名
+func typeName(t *types.Named) string {
+	name := exportName(t.Obj().Name())
+	if args := t.TypeArgs(); args != nil {
+		for i := 0; i < args.Len(); i++ {
+			if named, ok := types.Unalias(args.At(i)).(*types.Named); ok {
+				name += typeName(named)
+			} else {
+				name += exportName(types.TypeString(args.At(i), nil))
+			}
+		}
+	}
+	return name
+}
+
+func exportName(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func hasMethod(t *types.Named, name string) bool {
+	for _, recv := range []types.Type{t, types.NewPointer(t)} {
+		obj, _, _ := types.LookupFieldOrMethod(recv, false, t.Obj().Pkg(), name)
+		if _, ok := obj.(*types.Func); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseJSONTag(tag string) (name, opts string, hasName bool) {
+	name, opts, _ = strings.Cut(tag, ",")
+	return name, opts, name != ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,193 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidateResponse 校验操作在给定状态码下返回的 JSON 响应体是否符合文档。
+// 对象中出现文档未声明的字段同样视为错误，以便及时发现 DTO 与文档不一致。
+func (d *Document) ValidateResponse(method, path string, status int, body []byte) error {
+	op := d.Operation(method, path)
+	if op == nil {
+		return fmt.Errorf("openapi: no operation for %s %s", method, path)
+	}
+	resp := op.Responses[strconv.Itoa(status)]
+	if resp == nil {
+		resp = op.Responses["default"]
+	}
+	if resp == nil {
+		return fmt.Errorf("openapi: %s %s does not document status %d", method, path, status)
+	}
+	if name, ok := strings.CutPrefix(resp.Ref, "#/components/responses/"); ok {
+		resp = d.Components.Responses[name]
+		if resp == nil {
+			return fmt.Errorf("openapi: unknown response %q", name)
+		}
+	}
+	media := resp.Content["application/json"]
+	if media == nil || media.Schema == nil {
+		return fmt.Errorf("openapi: %s %s status %d has no JSON schema", method, path, status)
+	}
+	return d.Validate(media.Schema, body)
+}
+
+// Validate 校验 JSON 文本是否符合 schema
+func (d *Document) Validate(schema *Schema, body []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("openapi: invalid JSON: %w", err)
+	}
+	return d.validate(schema, v, "$")
+}
+
+func (d *Document) validate(s *Schema, v any, at string) error {
+	if s == nil {
+		return nil
+	}
+	if name := s.RefName(); name != "" {
+		target := d.Components.Schemas[name]
+		if target == nil {
+			return fmt.Errorf("%s: unknown schema %q", at, name)
+		}
+		return d.validate(target, v, at)
+	}
+	if v == nil {
+		if s.Nullable || isAnySchema(s) {
+			return nil
+		}
+		return fmt.Errorf("%s: null is not allowed", at)
+	}
+	for _, sub := range s.AllOf {
+		if err := d.validate(sub, v, at); err != nil {
+			return err
+		}
+	}
+	if len(s.AnyOf) > 0 {
+		var errs []string
+		for _, sub := range s.AnyOf {
+			err := d.validate(sub, v, at)
+			if err == nil {
+				errs = nil
+				break
+			}
+			errs = append(errs, err.Error())
+		}
+		if errs != nil {
+			return fmt.Errorf("%s: matches none of anyOf: %s", at, strings.Join(errs, "; "))
+		}
+	}
+	if len(s.Enum) > 0 && !enumContains(s.Enum, v) {
+		return fmt.Errorf("%s: %v is not one of %v", at, v, s.Enum)
+	}
+
+	switch s.Type {
+	case "":
+		return nil
+	case "string":
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("%s: expected string, got %s", at, jsonKind(v))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s: expected boolean, got %s", at, jsonKind(v))
+		}
+	case "integer", "number":
+		n, ok := v.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: expected %s, got %s", at, s.Type, jsonKind(v))
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return fmt.Errorf("%s: invalid number %s", at, n)
+		}
+		if s.Type == "integer" && f != math.Trunc(f) {
+			return fmt.Errorf("%s: expected integer, got %s", at, n)
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected array, got %s", at, jsonKind(v))
+		}
+		for i, item := range items {
+			if err := d.validate(s.Items, item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %s", at, jsonKind(v))
+		}
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				return fmt.Errorf("%s: missing required property %q", at, name)
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := at + "." + k
+			if prop, ok := s.Properties[k]; ok {
+				if err := d.validate(prop, obj[k], child); err != nil {
+					return err
+				}
+				continue
+			}
+			if s.AdditionalProperties != nil {
+				if err := d.validate(s.AdditionalProperties, obj[k], child); err != nil {
+					return err
+				}
+				continue
+			}
+			if len(s.Properties) > 0 {
+				return fmt.Errorf("%s: property is not documented", child)
+			}
+		}
+	default:
+		return fmt.Errorf("%s: unsupported schema type %q", at, s.Type)
+	}
+	return nil
+}
+
+// isAnySchema 无任何约束的 schema（对应 Go 的 any / json.RawMessage）
+func isAnySchema(s *Schema) bool {
+	return s.Type == "" && s.Ref == "" && len(s.AllOf) == 0 && len(s.AnyOf) == 0 && len(s.Enum) == 0
+}
+
+func enumContains(enum []any, v any) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
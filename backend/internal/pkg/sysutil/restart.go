@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"runtime"
+	"syscall"
 	"time"
 )
 
@@ -46,3 +47,32 @@ func RestartServiceAsync() {
 		log.Println("Please restart the service manually: sudo systemctl restart sub2api")
 	}
 }
+
+// RestartServiceGracefully triggers a service restart by sending SIGTERM to the
+// current process.
+//
+// Unlike RestartService, the process goes through its normal graceful shutdown
+// (drain, wait for in-flight requests, stop background workers) before exiting,
+// and relies on the same systemd Restart=always (or container restart policy)
+// to start it again.
+func RestartServiceGracefully() error {
+	if runtime.GOOS != "linux" {
+		log.Println("Service restart via exit only works on Linux with systemd")
+		return nil
+	}
+
+	log.Println("Initiating graceful service restart (SIGTERM to self)...")
+	process, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		return err
+	}
+	return process.Signal(syscall.SIGTERM)
+}
+
+// RestartServiceGracefullyAsync is a fire-and-forget version of RestartServiceGracefully.
+func RestartServiceGracefullyAsync() {
+	if err := RestartServiceGracefully(); err != nil {
+		log.Printf("Service restart failed: %v", err)
+		log.Println("Please restart the service manually: sudo systemctl restart sub2api")
+	}
+}
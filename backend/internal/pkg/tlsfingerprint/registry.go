@@ -19,6 +19,7 @@ type Registry struct {
 	mu           sync.RWMutex
 	profiles     map[string]*Profile
 	profileNames []string // Sorted list of profile names for deterministic selection
+	generation   uint64   // Incremented on every LoadConfig, used to invalidate cached clients
 }
 
 // NewRegistry creates a new TLS fingerprint profile registry.
@@ -39,29 +40,46 @@ func NewRegistry() *Registry {
 // If the config has custom profiles defined, they will be merged with the built-in default.
 func NewRegistryFromConfig(cfg *config.TLSFingerprintConfig) *Registry {
 	r := NewRegistry()
+	r.LoadConfig(cfg)
+	return r
+}
 
+// LoadConfig replaces all profiles with the built-in default plus the profiles from config.
+// It is used both at startup and when the config file is hot-reloaded; every call bumps
+// the registry generation so that HTTP clients built from old profiles can be discarded.
+func (r *Registry) LoadConfig(cfg *config.TLSFingerprintConfig) {
+	staged := NewRegistry()
 	if cfg == nil || !cfg.Enabled {
 		slog.Debug("tls_registry_disabled", "reason", "disabled or no config")
-		return r
-	}
-
-	// Load custom profiles from config
-	for name, profileCfg := range cfg.Profiles {
-		profile := &Profile{
-			Name:         profileCfg.Name,
-			EnableGREASE: profileCfg.EnableGREASE,
-			CipherSuites: profileCfg.CipherSuites,
-			Curves:       profileCfg.Curves,
-			PointFormats: profileCfg.PointFormats,
+	} else {
+		// Load custom profiles from config
+		for name, profileCfg := range cfg.Profiles {
+			// If the profile has empty values, they will use defaults in dialer
+			staged.RegisterProfile(name, &Profile{
+				Name:         profileCfg.Name,
+				EnableGREASE: profileCfg.EnableGREASE,
+				CipherSuites: profileCfg.CipherSuites,
+				Curves:       profileCfg.Curves,
+				PointFormats: profileCfg.PointFormats,
+			})
+			slog.Debug("tls_registry_loaded_profile", "key", name, "name", profileCfg.Name)
 		}
-
-		// If the profile has empty values, they will use defaults in dialer
-		r.RegisterProfile(name, profile)
-		slog.Debug("tls_registry_loaded_profile", "key", name, "name", profileCfg.Name)
 	}
 
-	slog.Debug("tls_registry_initialized", "profile_count", len(r.profileNames), "profiles", r.profileNames)
-	return r
+	// Swap in one step so concurrent lookups never observe a partially loaded set
+	r.mu.Lock()
+	r.profiles = staged.profiles
+	r.profileNames = staged.profileNames
+	r.generation++
+	r.mu.Unlock()
+	slog.Debug("tls_registry_initialized", "profile_count", len(staged.profileNames), "profiles", staged.profileNames)
+}
+
+// Generation returns the number of times profiles have been (re)loaded from config.
+func (r *Registry) Generation() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.generation
 }
 
 // registerBuiltinProfile adds the default Claude CLI profile to the registry.
@@ -164,8 +182,7 @@ func GlobalRegistry() *Registry {
 // This should be called during application startup.
 // It is safe to call multiple times; subsequent calls will update the registry.
 func InitGlobalRegistry(cfg *config.TLSFingerprintConfig) *Registry {
-	globalRegistryOnce.Do(func() {
-		globalRegistry = NewRegistryFromConfig(cfg)
-	})
-	return globalRegistry
+	r := GlobalRegistry()
+	r.LoadConfig(cfg)
+	return r
 }
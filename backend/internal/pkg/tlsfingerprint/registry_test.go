@@ -241,3 +241,33 @@ func TestConcurrentAccess(t *testing.T) {
 
 	// Test should pass without data races (run with -race flag)
 }
+
+func TestLoadConfigReplacesProfiles(t *testing.T) {
+	r := NewRegistryFromConfig(&config.TLSFingerprintConfig{
+		Enabled:  true,
+		Profiles: map[string]config.TLSProfileConfig{"custom1": {Name: "Custom Profile 1"}},
+	})
+	gen := r.Generation()
+
+	r.LoadConfig(&config.TLSFingerprintConfig{
+		Enabled:  true,
+		Profiles: map[string]config.TLSProfileConfig{"custom2": {Name: "Custom Profile 2"}},
+	})
+	if r.GetProfile("custom1") != nil {
+		t.Error("expected custom1 profile to be removed after reload")
+	}
+	if p := r.GetProfile("custom2"); p == nil || p.Name != "Custom Profile 2" {
+		t.Error("expected custom2 profile to exist after reload")
+	}
+	if r.GetDefaultProfile() == nil {
+		t.Error("expected default profile to survive reload")
+	}
+	if r.Generation() != gen+1 {
+		t.Errorf("expected generation %d, got %d", gen+1, r.Generation())
+	}
+
+	r.LoadConfig(&config.TLSFingerprintConfig{Enabled: false})
+	if r.ProfileCount() != 1 {
+		t.Errorf("expected only the default profile when disabled, got %d", r.ProfileCount())
+	}
+}
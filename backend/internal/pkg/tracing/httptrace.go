@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"crypto/tls"
+	"net/http/httptrace"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ClientTrace 将连接获取、代理/上游拨号、TLS 握手与首字节等阶段记录为 span 事件
+func ClientTrace(span trace.Span) *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GetConn: func(hostPort string) {
+			span.AddEvent("get_conn", trace.WithAttributes(attribute.String("host_port", hostPort)))
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			span.AddEvent("got_conn", trace.WithAttributes(
+				attribute.Bool("reused", info.Reused),
+				attribute.Bool("was_idle", info.WasIdle),
+			))
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			span.AddEvent("dns_start")
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			span.AddEvent("dns_done", trace.WithAttributes(attribute.Bool("error", info.Err != nil)))
+		},
+		ConnectStart: func(network, addr string) {
+			span.AddEvent("connect_start", trace.WithAttributes(attribute.String("addr", addr)))
+		},
+		ConnectDone: func(network, addr string, err error) {
+			span.AddEvent("connect_done", trace.WithAttributes(
+				attribute.String("addr", addr),
+				attribute.Bool("error", err != nil),
+			))
+		},
+		TLSHandshakeStart: func() {
+			span.AddEvent("tls_handshake_start")
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			span.AddEvent("tls_handshake_done", trace.WithAttributes(attribute.Bool("error", err != nil)))
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			span.AddEvent("wrote_request")
+		},
+		GotFirstResponseByte: func() {
+			span.AddEvent("first_response_byte")
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// Package tracing 提供基于 OpenTelemetry 的请求链路追踪。
+//
+// 未启用时全局 TracerProvider 保持 noop，各处埋点的开销可以忽略。
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/Wei-Shaw/sub2api"
+
+// 常用 span 属性
+const (
+	AttrClientRequestID = attribute.Key("sub2api.client_request_id")
+	AttrUserID          = attribute.Key("sub2api.user_id")
+	AttrAPIKeyID        = attribute.Key("sub2api.api_key_id")
+	AttrGroupID         = attribute.Key("sub2api.group_id")
+	AttrAccountID       = attribute.Key("sub2api.account_id")
+	AttrPlatform        = attribute.Key("sub2api.platform")
+	AttrModel           = attribute.Key("sub2api.model")
+)
+
+var enabled atomic.Bool
+
+// Init 按配置初始化全局 TracerProvider 与 W3C 传播器
+// 返回的 shutdown 用于退出前刷新未导出的 span，未启用时为空操作
+func Init(ctx context.Context, cfg config.TracingConfig, version string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	endpoint := strings.TrimSpace(cfg.Endpoint)
+	var opts []otlptracehttp.Option
+	if strings.Contains(endpoint, "://") {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	} else {
+		opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create otlp trace exporter: %w", err)
+	}
+
+	serviceName := strings.TrimSpace(cfg.ServiceName)
+	if serviceName == "" {
+		serviceName = "sub2api"
+	}
+	res, err := resource.New(ctx,
+		resource.WithHost(),
+		resource.WithProcessPID(),
+		resource.WithAttributes(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// 请求已携带 traceparent 时沿用上游采样决策，否则按比例采样
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	enabled.Store(true)
+	return provider.Shutdown, nil
+}
+
+// Enabled 返回是否已启用链路追踪导出
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Tracer 返回项目统一的 Tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start 在 ctx 下创建子 span
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End 结束 span，err 非空时标记为失败
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SetAttributes 为 ctx 中当前 span 追加属性
+func SetAttributes(ctx context.Context, attrs ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+// TraceID 返回 ctx 中已采样 span 的 trace ID，用于与运维错误日志互相关联
+// 未采样或没有 span 时返回空字符串
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || !sc.IsSampled() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
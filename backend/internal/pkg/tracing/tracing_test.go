@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceID(t *testing.T) {
+	if got := TraceID(context.Background()); got != "" {
+		t.Fatalf("TraceID without span = %q, want empty", got)
+	}
+
+	traceID := trace.TraceID{0x01, 0x02}
+	spanID := trace.SpanID{0x03}
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	if got := TraceID(sampled); got != traceID.String() {
+		t.Fatalf("TraceID(sampled) = %q, want %q", got, traceID.String())
+	}
+
+	unsampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	if got := TraceID(unsampled); got != "" {
+		t.Fatalf("TraceID(unsampled) = %q, want empty", got)
+	}
+}
+
+func TestStartAndEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	ctx, parent := Start(context.Background(), "parent", AttrModel.String("claude-sonnet-4-5"))
+	_, child := Start(ctx, "child")
+	End(child, errors.New("upstream failed"))
+	End(parent, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want 2", len(spans))
+	}
+	childSpan, parentSpan := spans[0], spans[1]
+	if childSpan.Parent().SpanID() != parentSpan.SpanContext().SpanID() {
+		t.Fatalf("child span is not linked to parent")
+	}
+	if childSpan.Status().Code != codes.Error || childSpan.Status().Description != "upstream failed" {
+		t.Fatalf("child status = %+v, want error", childSpan.Status())
+	}
+	if parentSpan.Status().Code != codes.Unset {
+		t.Fatalf("parent status = %+v, want unset", parentSpan.Status())
+	}
+}
@@ -124,6 +124,7 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				user.FieldRole,
 				user.FieldBalance,
 				user.FieldConcurrency,
+				user.FieldQueueWeight,
 			)
 		}).
 		WithGroup(func(q *dbent.GroupQuery) {
@@ -147,6 +148,10 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				group.FieldModelRouting,
 				group.FieldMcpXMLInject,
 				group.FieldSupportedModelScopes,
+				group.FieldModelFallbacks,
+				group.FieldModelRateMultipliers,
+				group.FieldSchedulingStrategy,
+				group.FieldFirstByteTimeoutSeconds,
 			)
 		}).
 		Only(ctx)
@@ -376,26 +381,12 @@ func (r *apiKeyRepository) ListKeysByGroupID(ctx context.Context, groupID int64)
 }
 
 // IncrementQuotaUsed atomically increments the quota_used field and returns the new value
+// Runs inside the transaction carried by ctx, if any, so it commits together with the usage log
 func (r *apiKeyRepository) IncrementQuotaUsed(ctx context.Context, id int64, amount float64) (float64, error) {
-	// Use raw SQL for atomic increment to avoid race conditions
-	// First get current value
-	m, err := r.activeQuery().
-		Where(apikey.IDEQ(id)).
-		Select(apikey.FieldQuotaUsed).
-		Only(ctx)
-	if err != nil {
-		if dbent.IsNotFound(err) {
-			return 0, service.ErrAPIKeyNotFound
-		}
-		return 0, err
-	}
-
-	newValue := m.QuotaUsed + amount
-
-	// Update with new value
-	affected, err := r.client.APIKey.Update().
+	client := clientFromContext(ctx, r.client)
+	affected, err := client.APIKey.Update().
 		Where(apikey.IDEQ(id), apikey.DeletedAtIsNil()).
-		SetQuotaUsed(newValue).
+		AddQuotaUsed(amount).
 		Save(ctx)
 	if err != nil {
 		return 0, err
@@ -404,7 +395,17 @@ func (r *apiKeyRepository) IncrementQuotaUsed(ctx context.Context, id int64, amo
 		return 0, service.ErrAPIKeyNotFound
 	}
 
-	return newValue, nil
+	m, err := client.APIKey.Query().
+		Where(apikey.IDEQ(id)).
+		Select(apikey.FieldQuotaUsed).
+		Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return 0, service.ErrAPIKeyNotFound
+		}
+		return 0, err
+	}
+	return m.QuotaUsed, nil
 }
 
 func apiKeyEntityToService(m *dbent.APIKey) *service.APIKey {
@@ -448,6 +449,7 @@ func userEntityToService(u *dbent.User) *service.User {
 		Role:                u.Role,
 		Balance:             u.Balance,
 		Concurrency:         u.Concurrency,
+		QueueWeight:         u.QueueWeight,
 		Status:              u.Status,
 		TotpSecretEncrypted: u.TotpSecretEncrypted,
 		TotpEnabled:         u.TotpEnabled,
@@ -485,6 +487,10 @@ func groupEntityToService(g *dbent.Group) *service.Group {
 		ModelRoutingEnabled:             g.ModelRoutingEnabled,
 		MCPXMLInject:                    g.McpXMLInject,
 		SupportedModelScopes:            g.SupportedModelScopes,
+		ModelFallbacks:                  g.ModelFallbacks,
+		ModelRateMultipliers:            g.ModelRateMultipliers,
+		SchedulingStrategy:              g.SchedulingStrategy,
+		FirstByteTimeoutSeconds:         g.FirstByteTimeoutSeconds,
 		SortOrder:                       g.SortOrder,
 		CreatedAt:                       g.CreatedAt,
 		UpdatedAt:                       g.UpdatedAt,
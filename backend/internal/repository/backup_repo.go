@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+// backupRepository 以原生 SQL 按表导出 / 写入 JSON 行，列集合来自 information_schema，
+// 因此新增列无需修改备份代码。表名与列名均来自服务层的固定清单或数据库元数据。
+type backupRepository struct {
+	db *sql.DB
+
+	mu      sync.Mutex
+	columns map[string]map[string]struct{}
+}
+
+func NewBackupRepository(db *sql.DB) service.BackupRepository {
+	return &backupRepository{db: db, columns: map[string]map[string]struct{}{}}
+}
+
+func (r *backupRepository) SchemaVersion(ctx context.Context) (string, error) {
+	var version string
+	err := scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.db), "SELECT COALESCE(MAX(filename), '') FROM schema_migrations", nil, &version)
+	return version, err
+}
+
+func (r *backupRepository) Export(ctx context.Context, tables []service.BackupExportTable, fn func(table string, row json.RawMessage) error) error {
+	// 所有表在同一个可重复读快照中导出，保证引用关系一致
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, table := range tables {
+		if err := r.exportTable(ctx, tx, table, fn); err != nil {
+			return fmt.Errorf("%s: %w", table.Name, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *backupRepository) exportTable(ctx context.Context, tx *sql.Tx, table service.BackupExportTable, fn func(table string, row json.RawMessage) error) error {
+	columns, err := r.tableColumns(ctx, tx, table.Name)
+	if err != nil {
+		return err
+	}
+
+	query := "SELECT row_to_json(t)::text FROM " + pq.QuoteIdentifier(table.Name) + " t"
+	var (
+		conditions []string
+		args       []any
+	)
+	if table.CreatedFrom != nil {
+		args = append(args, *table.CreatedFrom)
+		conditions = append(conditions, fmt.Sprintf("t.created_at >= $%d", len(args)))
+	}
+	if table.CreatedTo != nil {
+		args = append(args, *table.CreatedTo)
+		conditions = append(conditions, fmt.Sprintf("t.created_at < $%d", len(args)))
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if _, ok := columns["id"]; ok {
+		query += " ORDER BY t.id"
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+		if err := fn(table.Name, row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *backupRepository) CountRows(ctx context.Context, table string) (int64, error) {
+	var count int64
+	err := scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.db), "SELECT COUNT(*) FROM "+pq.QuoteIdentifier(table), nil, &count)
+	return count, err
+}
+
+func (r *backupRepository) InsertRow(ctx context.Context, table string, row map[string]any) (int64, error) {
+	exec := sqlExecutorFromContext(ctx, r.db)
+	columns, err := r.tableColumns(ctx, exec, table)
+	if err != nil {
+		return 0, err
+	}
+	names, payload, err := backupRowPayload(columns, row)
+	if err != nil {
+		return 0, err
+	}
+	_, hasID := columns["id"]
+
+	quoted := pq.QuoteIdentifier(table)
+	var query string
+	if len(names) == 0 {
+		query = "INSERT INTO " + quoted + " DEFAULT VALUES"
+	} else {
+		list := backupColumnList(names)
+		query = "INSERT INTO " + quoted + " (" + list + ") SELECT " + list +
+			" FROM json_populate_record(NULL::" + quoted + ", $1::json)"
+	}
+	if !hasID {
+		// 关联表（复合主键）重复导入时忽略
+		query += " ON CONFLICT DO NOTHING"
+		if len(names) == 0 {
+			_, err = exec.ExecContext(ctx, query)
+		} else {
+			_, err = exec.ExecContext(ctx, query, payload)
+		}
+		return 0, err
+	}
+
+	var id int64
+	query += " RETURNING id"
+	if len(names) == 0 {
+		err = scanSingleRow(ctx, exec, query, nil, &id)
+	} else {
+		err = scanSingleRow(ctx, exec, query, []any{payload}, &id)
+	}
+	return id, err
+}
+
+func (r *backupRepository) UpdateRow(ctx context.Context, table string, id int64, row map[string]any) error {
+	exec := sqlExecutorFromContext(ctx, r.db)
+	columns, err := r.tableColumns(ctx, exec, table)
+	if err != nil {
+		return err
+	}
+	names, payload, err := backupRowPayload(columns, row)
+	if err != nil || len(names) == 0 {
+		return err
+	}
+
+	quoted := pq.QuoteIdentifier(table)
+	list := backupColumnList(names)
+	query := "UPDATE " + quoted + " SET (" + list + ") = (SELECT " + list +
+		" FROM json_populate_record(NULL::" + quoted + ", $1::json)) WHERE id = $2"
+	_, err = exec.ExecContext(ctx, query, payload, id)
+	return err
+}
+
+func (r *backupRepository) FindID(ctx context.Context, table string, match map[string]any, activeOnly bool) (int64, bool, error) {
+	names := make([]string, 0, len(match))
+	for name := range match {
+		if match[name] == nil {
+			return 0, false, nil
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	conditions := make([]string, 0, len(names)+1)
+	args := make([]any, 0, len(names))
+	for _, name := range names {
+		args = append(args, fmt.Sprint(match[name]))
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", pq.QuoteIdentifier(name), len(args)))
+	}
+	if activeOnly {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+
+	var id int64
+	query := "SELECT id FROM " + pq.QuoteIdentifier(table) + " WHERE " + strings.Join(conditions, " AND ") + " ORDER BY id LIMIT 1"
+	err := scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.db), query, args, &id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return id, true, nil
+}
+
+// tableColumns 返回表的列集合（进程内缓存，schema 在运行期间不变）
+func (r *backupRepository) tableColumns(ctx context.Context, q sqlQueryer, table string) (map[string]struct{}, error) {
+	r.mu.Lock()
+	cached, ok := r.columns[table]
+	r.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+	`, table)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	columns := map[string]struct{}{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns[name] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s does not exist", table)
+	}
+
+	r.mu.Lock()
+	r.columns[table] = columns
+	r.mu.Unlock()
+	return columns, nil
+}
+
+// backupRowPayload 只保留表中存在的列（id 由序列生成），返回排序后的列名与 JSON 载荷
+func backupRowPayload(columns map[string]struct{}, row map[string]any) ([]string, []byte, error) {
+	filtered := make(map[string]any, len(row))
+	names := make([]string, 0, len(row))
+	for name, value := range row {
+		if _, ok := columns[name]; !ok || name == "id" {
+			continue
+		}
+		filtered[name] = value
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	payload, err := json.Marshal(filtered)
+	if err != nil {
+		return nil, nil, err
+	}
+	return names, payload, nil
+}
+
+func backupColumnList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = pq.QuoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,76 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRepository_RowRoundTrip(t *testing.T) {
+	tx := testEntTx(t)
+	ctx := dbent.NewTxContext(context.Background(), tx)
+	repo := NewBackupRepository(integrationDB)
+
+	version, err := repo.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(version, ".sql"), version)
+
+	groupID, err := repo.InsertRow(ctx, "groups", map[string]any{
+		"name":            "backup-restore-group",
+		"platform":        "anthropic",
+		"rate_multiplier": json.Number("1.2500"),
+		"model_routing":   map[string]any{"claude-*": []int64{1, 2}},
+		"unknown_column":  "ignored",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, groupID)
+
+	found, ok, err := repo.FindID(ctx, "groups", map[string]any{"name": "backup-restore-group"}, true)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, groupID, found)
+
+	require.NoError(t, repo.UpdateRow(ctx, "groups", groupID, map[string]any{"description": "restored"}))
+	var (
+		description  string
+		multiplier   float64
+		modelRouting string
+	)
+	require.NoError(t, scanSingleRow(ctx, tx.Client(), "SELECT description, rate_multiplier, model_routing::text FROM groups WHERE id = $1", []any{groupID}, &description, &multiplier, &modelRouting))
+	require.Equal(t, "restored", description)
+	require.Equal(t, 1.25, multiplier)
+	require.JSONEq(t, `{"claude-*":[1,2]}`, modelRouting)
+
+	accountID, err := repo.InsertRow(ctx, "accounts", map[string]any{
+		"name":        "backup-restore-account",
+		"platform":    "anthropic",
+		"type":        "apikey",
+		"credentials": map[string]any{"api_key": "sk-test"},
+	})
+	require.NoError(t, err)
+
+	// 关联表没有 id 列，重复插入被忽略
+	link := map[string]any{"account_id": accountID, "group_id": groupID}
+	for i := 0; i < 2; i++ {
+		id, err := repo.InsertRow(ctx, "account_groups", link)
+		require.NoError(t, err)
+		require.Zero(t, id)
+	}
+
+	var links int64
+	require.NoError(t, scanSingleRow(ctx, tx.Client(), "SELECT COUNT(*) FROM account_groups WHERE account_id = $1", []any{accountID}, &links))
+	require.Equal(t, int64(1), links)
+
+	_, ok, err = repo.FindID(ctx, "groups", map[string]any{"name": "missing-group"}, true)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+var _ service.BackupRepository = (*backupRepository)(nil)
@@ -15,6 +15,7 @@ import (
 const (
 	billingBalanceKeyPrefix = "billing:balance:"
 	billingSubKeyPrefix     = "billing:sub:"
+	billingReserveKeyPrefix = "billing:reserve:"
 	billingCacheTTL         = 5 * time.Minute
 )
 
@@ -28,6 +29,12 @@ func billingSubKey(userID, groupID int64) string {
 	return fmt.Sprintf("%s%d:%d", billingSubKeyPrefix, userID, groupID)
 }
 
+// billingReserveKey generates the Redis key for in-flight cost reservations.
+// groupID 0 表示余额模式的预留。
+func billingReserveKey(userID, groupID int64) string {
+	return fmt.Sprintf("%s%d:%d", billingReserveKeyPrefix, userID, groupID)
+}
+
 const (
 	subFieldStatus       = "status"
 	subFieldExpiresAt    = "expires_at"
@@ -61,6 +68,43 @@ var (
 		redis.call('EXPIRE', KEYS[1], ARGV[2])
 		return 1
 	`)
+
+	// reserveCostScript 清理过期预留并汇总未过期预留金额，
+	// 可用额度扣除已预留金额后仍足以覆盖本次预留金额时写入新预留，返回预留金额。
+	// 没有其他在途预留时按可用额度封顶预留（可用额度耗尽返回 -1），
+	// 只有被其他在途预留占用时才返回 0。
+	// Hash field 为预留 ID，value 为 "金额:过期时间戳"。
+	reserveCostScript = redis.NewScript(`
+		local now = tonumber(ARGV[1])
+		local ttl = tonumber(ARGV[2])
+		local amount = tonumber(ARGV[4])
+		local available = tonumber(ARGV[5])
+		local held = 0
+		local entries = redis.call('HGETALL', KEYS[1])
+		for i = 1, #entries, 2 do
+			local value = entries[i + 1]
+			local sep = string.find(value, ':', 1, true)
+			local expiresAt = sep and tonumber(string.sub(value, sep + 1)) or 0
+			if expiresAt <= now then
+				redis.call('HDEL', KEYS[1], entries[i])
+			else
+				held = held + (tonumber(string.sub(value, 1, sep - 1)) or 0)
+			end
+		end
+		if held == 0 then
+			if available <= 0 then
+				return '-1'
+			end
+			if amount > available then
+				amount = available
+			end
+		elseif available - held < amount then
+			return '0'
+		end
+		redis.call('HSET', KEYS[1], ARGV[3], tostring(amount) .. ':' .. tostring(now + ttl))
+		redis.call('EXPIRE', KEYS[1], ttl)
+		return tostring(amount)
+	`)
 )
 
 type billingCache struct {
@@ -181,3 +225,21 @@ func (c *billingCache) InvalidateSubscriptionCache(ctx context.Context, userID,
 	key := billingSubKey(userID, groupID)
 	return c.rdb.Del(ctx, key).Err()
 }
+
+func (c *billingCache) ReserveCost(ctx context.Context, userID, groupID int64, reservationID string, amount, available float64, ttl time.Duration) (float64, error) {
+	key := billingReserveKey(userID, groupID)
+	ttlSeconds := int64(ttl.Seconds())
+	if ttlSeconds <= 0 {
+		ttlSeconds = 1
+	}
+	res, err := reserveCostScript.Run(ctx, c.rdb, []string{key}, time.Now().Unix(), ttlSeconds, reservationID, amount, available).Text()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(res, 64)
+}
+
+func (c *billingCache) ReleaseCostReservation(ctx context.Context, userID, groupID int64, reservationID string) error {
+	key := billingReserveKey(userID, groupID)
+	return c.rdb.HDel(ctx, key, reservationID).Err()
+}
@@ -278,6 +278,54 @@ func (s *BillingCacheSuite) TestSubscriptionCache() {
 	}
 }
 
+func (s *BillingCacheSuite) TestCostReservation() {
+	rdb := testRedis(s.T())
+	cache := NewBillingCache(rdb)
+	ctx := context.Background()
+	userID := int64(7)
+	reserveKey := billingReserveKey(userID, 0)
+
+	reserved, err := cache.ReserveCost(ctx, userID, 0, "r1", 0.8, 1.0, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost r1")
+	require.Equal(s.T(), 0.8, reserved, "first reservation should succeed")
+
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r2", 0.5, 1.0, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost r2")
+	require.Zero(s.T(), reserved, "remaining 0.2 should not admit a 0.5 reservation")
+
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r3", 0.1, 1.0, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost r3")
+	require.Equal(s.T(), 0.1, reserved, "remaining 0.2 should admit a 0.1 reservation")
+
+	ttl, err := rdb.TTL(ctx, reserveKey).Result()
+	require.NoError(s.T(), err, "TTL")
+	s.AssertTTLWithin(ttl, 1*time.Second, time.Minute)
+
+	require.NoError(s.T(), cache.ReleaseCostReservation(ctx, userID, 0, "r1"), "ReleaseCostReservation")
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r2", 0.5, 1.0, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost r2 after release")
+	require.Equal(s.T(), 0.5, reserved, "released hold should free headroom")
+
+	// 过期预留在下次预留时被清理
+	require.NoError(s.T(), rdb.HSet(ctx, reserveKey, "stale", fmt.Sprintf("5:%d", time.Now().Add(-time.Second).Unix())).Err(), "HSet stale")
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r4", 0.01, 1.0, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost r4")
+	require.Equal(s.T(), 0.01, reserved, "expired reservation should not be counted")
+	exists, err := rdb.HExists(ctx, reserveKey, "stale").Result()
+	require.NoError(s.T(), err, "HExists")
+	require.False(s.T(), exists, "expired reservation should be removed")
+
+	// 没有在途预留时按可用额度封顶，额度耗尽时返回负数
+	otherUser := userID + 1
+	reserved, err = cache.ReserveCost(ctx, otherUser, 0, "c1", 5, 0.3, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost c1")
+	require.Equal(s.T(), 0.3, reserved, "reservation without holds should be capped at available")
+	require.NoError(s.T(), cache.ReleaseCostReservation(ctx, otherUser, 0, "c1"), "ReleaseCostReservation c1")
+	reserved, err = cache.ReserveCost(ctx, otherUser, 0, "c2", 0.1, 0, time.Minute)
+	require.NoError(s.T(), err, "ReserveCost c2")
+	require.Negative(s.T(), reserved, "exhausted balance without holds should be reported")
+}
+
 func TestBillingCacheSuite(t *testing.T) {
 	suite.Run(t, new(BillingCacheSuite))
 }
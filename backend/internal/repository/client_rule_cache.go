@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	clientRuleCacheKey  = "client_rules"
+	clientRulePubSubKey = "client_rules_updated"
+
+	// clientRuleRejectionsKey 各规则拒绝计数（Hash：rule_id -> count）
+	clientRuleRejectionsKey = "client_rule_rejections"
+)
+
+// clientRuleCache 在通用规则列表缓存之上维护各规则的拒绝计数
+type clientRuleCache struct {
+	*ruleListCache[model.ClientRule]
+	rdb *redis.Client
+}
+
+// NewClientRuleCache 创建客户端准入规则缓存
+func NewClientRuleCache(rdb *redis.Client) service.ClientRuleCache {
+	return &clientRuleCache{
+		ruleListCache: newRuleListCache[model.ClientRule](rdb, "ClientRuleCache", clientRuleCacheKey, clientRulePubSubKey),
+		rdb:           rdb,
+	}
+}
+
+// IncrementRejected 规则拒绝计数 +1
+func (c *clientRuleCache) IncrementRejected(ctx context.Context, ruleID int64) error {
+	return c.rdb.HIncrBy(ctx, clientRuleRejectionsKey, strconv.FormatInt(ruleID, 10), 1).Err()
+}
+
+// GetRejectedCounts 获取所有规则的拒绝计数
+func (c *clientRuleCache) GetRejectedCounts(ctx context.Context) (map[int64]int64, error) {
+	raw, err := c.rdb.HGetAll(ctx, clientRuleRejectionsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64, len(raw))
+	for field, value := range raw {
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		counts[id] = n
+	}
+	return counts, nil
+}
+
+// ResetRejected 清零规则拒绝计数
+func (c *clientRuleCache) ResetRejected(ctx context.Context, ruleID int64) error {
+	return c.rdb.HDel(ctx, clientRuleRejectionsKey, strconv.FormatInt(ruleID, 10)).Err()
+}
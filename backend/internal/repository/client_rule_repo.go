@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/clientrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type clientRuleRepository struct {
+	client *ent.Client
+}
+
+// NewClientRuleRepository 创建客户端准入规则仓库
+func NewClientRuleRepository(client *ent.Client) service.ClientRuleRepository {
+	return &clientRuleRepository{client: client}
+}
+
+// List 获取所有规则
+func (r *clientRuleRepository) List(ctx context.Context) ([]*model.ClientRule, error) {
+	rules, err := r.client.ClientRule.Query().
+		Order(ent.Asc(clientrule.FieldPriority), ent.Asc(clientrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.ClientRule, len(rules))
+	for i, rule := range rules {
+		result[i] = r.toModel(rule)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取规则
+func (r *clientRuleRepository) GetByID(ctx context.Context, id int64) (*model.ClientRule, error) {
+	rule, err := r.client.ClientRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(rule), nil
+}
+
+// Create 创建规则
+func (r *clientRuleRepository) Create(ctx context.Context, rule *model.ClientRule) (*model.ClientRule, error) {
+	builder := r.client.ClientRule.Create().
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetPreset(rule.Preset)
+
+	if len(rule.GroupIDs) > 0 {
+		builder.SetGroupIds(rule.GroupIDs)
+	}
+	if rule.UserAgentPattern != nil {
+		builder.SetUserAgentPattern(*rule.UserAgentPattern)
+	}
+	if len(rule.RequiredHeaders) > 0 {
+		builder.SetRequiredHeaders(rule.RequiredHeaders)
+	}
+	if rule.MetadataUserIDPattern != nil {
+		builder.SetMetadataUserIDPattern(*rule.MetadataUserIDPattern)
+	}
+	if len(rule.BodyFields) > 0 {
+		builder.SetBodyFields(rule.BodyFields)
+	}
+	if rule.FallbackGroupID != nil {
+		builder.SetFallbackGroupID(*rule.FallbackGroupID)
+	}
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新规则
+func (r *clientRuleRepository) Update(ctx context.Context, rule *model.ClientRule) (*model.ClientRule, error) {
+	builder := r.client.ClientRule.UpdateOneID(rule.ID).
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetPreset(rule.Preset)
+
+	// 处理可选字段
+	if len(rule.GroupIDs) > 0 {
+		builder.SetGroupIds(rule.GroupIDs)
+	} else {
+		builder.ClearGroupIds()
+	}
+	if rule.UserAgentPattern != nil {
+		builder.SetUserAgentPattern(*rule.UserAgentPattern)
+	} else {
+		builder.ClearUserAgentPattern()
+	}
+	if len(rule.RequiredHeaders) > 0 {
+		builder.SetRequiredHeaders(rule.RequiredHeaders)
+	} else {
+		builder.ClearRequiredHeaders()
+	}
+	if rule.MetadataUserIDPattern != nil {
+		builder.SetMetadataUserIDPattern(*rule.MetadataUserIDPattern)
+	} else {
+		builder.ClearMetadataUserIDPattern()
+	}
+	if len(rule.BodyFields) > 0 {
+		builder.SetBodyFields(rule.BodyFields)
+	} else {
+		builder.ClearBodyFields()
+	}
+	if rule.FallbackGroupID != nil {
+		builder.SetFallbackGroupID(*rule.FallbackGroupID)
+	} else {
+		builder.ClearFallbackGroupID()
+	}
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除规则
+func (r *clientRuleRepository) Delete(ctx context.Context, id int64) error {
+	return r.client.ClientRule.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *clientRuleRepository) toModel(e *ent.ClientRule) *model.ClientRule {
+	rule := &model.ClientRule{
+		ID:                    e.ID,
+		Name:                  e.Name,
+		Enabled:               e.Enabled,
+		Priority:              e.Priority,
+		GroupIDs:              e.GroupIds,
+		Preset:                e.Preset,
+		UserAgentPattern:      e.UserAgentPattern,
+		RequiredHeaders:       e.RequiredHeaders,
+		MetadataUserIDPattern: e.MetadataUserIDPattern,
+		BodyFields:            e.BodyFields,
+		FallbackGroupID:       e.FallbackGroupID,
+		Description:           e.Description,
+		CreatedAt:             e.CreatedAt,
+		UpdatedAt:             e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.RequiredHeaders == nil {
+		rule.RequiredHeaders = []string{}
+	}
+	if rule.BodyFields == nil {
+		rule.BodyFields = []string{}
+	}
+
+	return rule
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	clusterNodeKeyPrefix        = "cluster:node:"
+	clusterNodeIndexKey         = "cluster:nodes"
+	clusterNodeCommandKeySuffix = ":commands"
+	clusterRollingStatusKey     = "cluster:rolling_restart"
+	clusterRollingLockKey       = "cluster:rolling_restart:lock"
+	// clusterRollingStatusRetention 滚动重启结束后状态的保留时长
+	clusterRollingStatusRetention = 24 * time.Hour
+)
+
+func clusterNodeKey(nodeID string) string {
+	return clusterNodeKeyPrefix + nodeID
+}
+
+func clusterNodeCommandKey(nodeID string) string {
+	return clusterNodeKeyPrefix + nodeID + clusterNodeCommandKeySuffix
+}
+
+type clusterNodeCache struct {
+	rdb *redis.Client
+}
+
+func NewClusterNodeCache(rdb *redis.Client) service.ClusterNodeCache {
+	return &clusterNodeCache{rdb: rdb}
+}
+
+func (c *clusterNodeCache) UpsertNode(ctx context.Context, node *service.ClusterNode, ttl time.Duration) error {
+	payload, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.Set(ctx, clusterNodeKey(node.NodeID), payload, ttl)
+	pipe.SAdd(ctx, clusterNodeIndexKey, node.NodeID)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (c *clusterNodeCache) RemoveNode(ctx context.Context, nodeID string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.Del(ctx, clusterNodeKey(nodeID), clusterNodeCommandKey(nodeID))
+	pipe.SRem(ctx, clusterNodeIndexKey, nodeID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *clusterNodeCache) ListNodes(ctx context.Context) ([]service.ClusterNode, error) {
+	ids, err := c.rdb.SMembers(ctx, clusterNodeIndexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []service.ClusterNode{}, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, clusterNodeKey(id))
+	}
+	values, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]service.ClusterNode, 0, len(ids))
+	expired := make([]any, 0)
+	for i, raw := range values {
+		str, ok := raw.(string)
+		if !ok {
+			// 心跳已过期：顺带清理索引
+			expired = append(expired, ids[i])
+			continue
+		}
+		var node service.ClusterNode
+		if err := json.Unmarshal([]byte(str), &node); err != nil {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	if len(expired) > 0 {
+		_ = c.rdb.SRem(ctx, clusterNodeIndexKey, expired...).Err()
+	}
+	return nodes, nil
+}
+
+func (c *clusterNodeCache) PushNodeCommand(ctx context.Context, nodeID string, cmd service.ClusterNodeCommand, ttl time.Duration) error {
+	payload, err := json.Marshal(cmd)
+	if err != nil {
+		return err
+	}
+	key := clusterNodeCommandKey(nodeID)
+	pipe := c.rdb.TxPipeline()
+	pipe.RPush(ctx, key, payload)
+	pipe.Expire(ctx, key, ttl)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (c *clusterNodeCache) PopNodeCommands(ctx context.Context, nodeID string) ([]service.ClusterNodeCommand, error) {
+	key := clusterNodeCommandKey(nodeID)
+	pipe := c.rdb.TxPipeline()
+	rangeCmd := pipe.LRange(ctx, key, 0, -1)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	raw := rangeCmd.Val()
+	commands := make([]service.ClusterNodeCommand, 0, len(raw))
+	for _, item := range raw {
+		var cmd service.ClusterNodeCommand
+		if err := json.Unmarshal([]byte(item), &cmd); err != nil {
+			continue
+		}
+		commands = append(commands, cmd)
+	}
+	return commands, nil
+}
+
+func (c *clusterNodeCache) CreateRollingRestart(ctx context.Context, status *service.ClusterRollingRestartStatus, lockTTL time.Duration) (bool, error) {
+	ok, err := c.rdb.SetNX(ctx, clusterRollingLockKey, status.ID, lockTTL).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+	if err := c.SaveRollingRestart(ctx, status, lockTTL); err != nil {
+		_ = c.rdb.Del(ctx, clusterRollingLockKey).Err()
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *clusterNodeCache) SaveRollingRestart(ctx context.Context, status *service.ClusterRollingRestartStatus, lockTTL time.Duration) error {
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.Set(ctx, clusterRollingStatusKey, payload, clusterRollingStatusRetention)
+	if status.State == service.ClusterRollingRestartRunning {
+		pipe.Expire(ctx, clusterRollingLockKey, lockTTL)
+	} else {
+		pipe.Del(ctx, clusterRollingLockKey)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (c *clusterNodeCache) GetRollingRestart(ctx context.Context) (*service.ClusterRollingRestartStatus, error) {
+	raw, err := c.rdb.Get(ctx, clusterRollingStatusKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var status service.ClusterRollingRestartStatus
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return nil, err
+	}
+
+	if status.State == service.ClusterRollingRestartRunning {
+		exists, err := c.rdb.Exists(ctx, clusterRollingLockKey).Result()
+		if err != nil {
+			return nil, err
+		}
+		if exists == 0 {
+			status.State = service.ClusterRollingRestartFailed
+			status.Error = "orchestrator node exited before the rolling restart finished"
+		}
+	}
+	return &status, nil
+}
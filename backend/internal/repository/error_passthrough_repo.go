@@ -48,7 +48,7 @@ func (r *errorPassthroughRepository) GetByID(ctx context.Context, id int64) (*mo
 
 // Create 创建规则
 func (r *errorPassthroughRepository) Create(ctx context.Context, rule *model.ErrorPassthroughRule) (*model.ErrorPassthroughRule, error) {
-	builder := r.client.ErrorPassthroughRule.Create().
+	builder := clientFromContext(ctx, r.client).ErrorPassthroughRule.Create().
 		SetName(rule.Name).
 		SetEnabled(rule.Enabled).
 		SetPriority(rule.Priority).
@@ -85,7 +85,7 @@ func (r *errorPassthroughRepository) Create(ctx context.Context, rule *model.Err
 
 // Update 更新规则
 func (r *errorPassthroughRepository) Update(ctx context.Context, rule *model.ErrorPassthroughRule) (*model.ErrorPassthroughRule, error) {
-	builder := r.client.ErrorPassthroughRule.UpdateOneID(rule.ID).
+	builder := clientFromContext(ctx, r.client).ErrorPassthroughRule.UpdateOneID(rule.ID).
 		SetName(rule.Name).
 		SetEnabled(rule.Enabled).
 		SetPriority(rule.Priority).
@@ -135,7 +135,7 @@ func (r *errorPassthroughRepository) Update(ctx context.Context, rule *model.Err
 
 // Delete 删除规则
 func (r *errorPassthroughRepository) Delete(ctx context.Context, id int64) error {
-	return r.client.ErrorPassthroughRule.DeleteOneID(id).Exec(ctx)
+	return clientFromContext(ctx, r.client).ErrorPassthroughRule.DeleteOneID(id).Exec(ctx)
 }
 
 // toModel 将 Ent 实体转换为服务模型
@@ -30,6 +30,15 @@ func clientFromContext(ctx context.Context, defaultClient *dbent.Client) *dbent.
 	return defaultClient
 }
 
+// sqlExecutorFromContext 从 context 中获取事务绑定的原生 SQL 执行器，不存在事务时返回默认执行器。
+// 用于 repository 中混用 ent 与原生 SQL 的写路径（如调度 outbox），保证两者处于同一事务。
+func sqlExecutorFromContext(ctx context.Context, defaultExec sqlExecutor) sqlExecutor {
+	if tx := dbent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return defaultExec
+}
+
 // translatePersistenceError 将数据库层错误翻译为业务层错误。
 //
 // 这是 Repository 层的核心错误处理函数，确保数据库细节不会泄露到业务层。
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+// 分组公平队列缓存
+//
+// 每个分组使用以下键：
+//   - fairq:{groupID}:queue  有序集合，成员为票据，分数为起始标签（虚拟时间）
+//   - fairq:{groupID}:hb     有序集合，成员为票据，分数为最近心跳（毫秒），用于清理崩溃实例遗留的票据
+//   - fairq:{groupID}:users  哈希，userID -> 该用户最后一个请求的结束标签
+//   - fairq:{groupID}:state  哈希，clock（虚拟时钟）/ last_admit_ms / interval_ms（出队间隔 EWMA）
+//
+// fairq:groups 集合记录存在排队的分组，供运维面板统计；
+// fairq:account:{accountID} 有序集合记录等待该账号的票据（跨分组），分数为最近心跳（毫秒）。
+// 票据格式为 {纳秒时间戳}:{accountID}:{随机串}，准入判断按票据中的账号统计排在前面的同账号请求。
+const (
+	fairQueueKeyPrefix        = "fairq:"
+	fairQueueGroupsKey        = "fairq:groups"
+	fairQueueAccountKeyPrefix = "fairq:account:"
+	// 队列相关键的过期时间，入队/出队时刷新
+	fairQueueKeyTTLSeconds = 3600
+)
+
+// fairQueueLuaHelpers 公共函数：服务器毫秒时间与过期票据清理
+const fairQueueLuaHelpers = `
+	local function now_ms()
+		local t = redis.call('TIME')
+		return tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+	end
+
+	local function prune(queue, hb, expireBefore)
+		local stale = redis.call('ZRANGEBYSCORE', hb, '-inf', expireBefore)
+		for _, member in ipairs(stale) do
+			redis.call('ZREM', queue, member)
+		end
+		if #stale > 0 then
+			redis.call('ZREMRANGEBYSCORE', hb, '-inf', expireBefore)
+		end
+	end
+`
+
+var (
+	// fairQueueEnqueueScript 计算起始标签并入队
+	// KEYS[1] = queue, KEYS[2] = hb, KEYS[3] = users, KEYS[4] = state, KEYS[5] = groups, KEYS[6] = account
+	// ARGV[1] = ticket, ARGV[2] = userID, ARGV[3] = weight, ARGV[4] = ticket TTL（毫秒）
+	// ARGV[5] = groupID, ARGV[6] = 键过期时间（秒）
+	fairQueueEnqueueScript = redis.NewScript(fairQueueLuaHelpers + `
+		local now = now_ms()
+		prune(KEYS[1], KEYS[2], now - tonumber(ARGV[4]))
+
+		-- 队列为空时开启新一轮公平周期，清除历史结束标签
+		if redis.call('ZCARD', KEYS[1]) == 0 then
+			redis.call('DEL', KEYS[3])
+		end
+
+		local clock = tonumber(redis.call('HGET', KEYS[4], 'clock') or '0')
+		local finish = tonumber(redis.call('HGET', KEYS[3], ARGV[2]) or '0')
+		local start = math.max(clock, finish)
+
+		redis.call('HSET', KEYS[3], ARGV[2], start + 1 / tonumber(ARGV[3]))
+		redis.call('ZADD', KEYS[1], start, ARGV[1])
+		redis.call('ZADD', KEYS[2], now, ARGV[1])
+		redis.call('ZADD', KEYS[6], now, ARGV[1])
+		redis.call('SADD', KEYS[5], ARGV[5])
+
+		local keyTTL = tonumber(ARGV[6])
+		redis.call('EXPIRE', KEYS[1], keyTTL)
+		redis.call('EXPIRE', KEYS[2], keyTTL)
+		redis.call('EXPIRE', KEYS[3], keyTTL)
+		redis.call('EXPIRE', KEYS[6], keyTTL)
+		return 1
+	`)
+
+	// fairQueuePositionScript 刷新心跳并返回 {rank, depth, interval_ms, ahead_same_account}
+	// ahead_same_account 为排在前面、等待同一账号的票据数
+	// KEYS[1] = queue, KEYS[2] = hb, KEYS[3] = state, KEYS[4] = account
+	// ARGV[1] = ticket, ARGV[2] = ticket TTL（毫秒）, ARGV[3] = accountID
+	fairQueuePositionScript = redis.NewScript(fairQueueLuaHelpers + `
+		local now = now_ms()
+		prune(KEYS[1], KEYS[2], now - tonumber(ARGV[2]))
+
+		local depth = redis.call('ZCARD', KEYS[1])
+		local interval = math.floor(tonumber(redis.call('HGET', KEYS[3], 'interval_ms') or '0'))
+		local rank = redis.call('ZRANK', KEYS[1], ARGV[1])
+		if rank == false then
+			return {-1, depth, interval, 0}
+		end
+		redis.call('ZADD', KEYS[2], now, ARGV[1])
+		redis.call('ZADD', KEYS[4], now, ARGV[1])
+
+		local ahead = 0
+		if rank > 0 then
+			for _, member in ipairs(redis.call('ZRANGE', KEYS[1], 0, rank - 1)) do
+				if string.match(member, '^%d+:(%d+):') == ARGV[3] then
+					ahead = ahead + 1
+				end
+			end
+		end
+		return {rank, depth, interval, ahead}
+	`)
+
+	// fairQueueAdmitScript 出队并推进虚拟时钟，同时更新出队间隔 EWMA
+	// KEYS[1] = queue, KEYS[2] = hb, KEYS[3] = state, KEYS[4] = account
+	// ARGV[1] = ticket, ARGV[2] = 间隔样本上限（毫秒）, ARGV[3] = 键过期时间（秒）
+	fairQueueAdmitScript = redis.NewScript(fairQueueLuaHelpers + `
+		redis.call('ZREM', KEYS[4], ARGV[1])
+		local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+		if score == false then
+			return 0
+		end
+		local now = now_ms()
+		redis.call('ZREM', KEYS[1], ARGV[1])
+		redis.call('ZREM', KEYS[2], ARGV[1])
+
+		local clock = tonumber(redis.call('HGET', KEYS[3], 'clock') or '0')
+		if tonumber(score) > clock then
+			redis.call('HSET', KEYS[3], 'clock', score)
+		end
+
+		local last = redis.call('HGET', KEYS[3], 'last_admit_ms')
+		if last then
+			local sample = math.min(now - tonumber(last), tonumber(ARGV[2]))
+			local old = redis.call('HGET', KEYS[3], 'interval_ms')
+			local ewma = sample
+			if old then
+				ewma = 0.8 * tonumber(old) + 0.2 * sample
+			end
+			redis.call('HSET', KEYS[3], 'interval_ms', ewma)
+		end
+		redis.call('HSET', KEYS[3], 'last_admit_ms', now)
+		redis.call('EXPIRE', KEYS[3], tonumber(ARGV[3]))
+		return 1
+	`)
+
+	// fairQueueDepthScript 清理过期票据并返回 {depth, interval_ms}
+	// KEYS[1] = queue, KEYS[2] = hb, KEYS[3] = state
+	// ARGV[1] = ticket TTL（毫秒）
+	fairQueueDepthScript = redis.NewScript(fairQueueLuaHelpers + `
+		prune(KEYS[1], KEYS[2], now_ms() - tonumber(ARGV[1]))
+		local interval = math.floor(tonumber(redis.call('HGET', KEYS[3], 'interval_ms') or '0'))
+		return {redis.call('ZCARD', KEYS[1]), interval}
+	`)
+
+	// fairQueueAccountWaitingScript 清理心跳过期的票据并返回等待该账号的票据数
+	// KEYS[1] = account, ARGV[1] = ticket TTL（毫秒）
+	fairQueueAccountWaitingScript = redis.NewScript(fairQueueLuaHelpers + `
+		redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now_ms() - tonumber(ARGV[1]))
+		return redis.call('ZCARD', KEYS[1])
+	`)
+)
+
+type fairQueueCache struct {
+	rdb *redis.Client
+}
+
+// NewFairQueueCache 创建分组公平队列缓存
+func NewFairQueueCache(rdb *redis.Client) service.FairQueueCache {
+	return &fairQueueCache{rdb: rdb}
+}
+
+func fairQueueKey(groupID int64, suffix string) string {
+	return fmt.Sprintf("%s%d:%s", fairQueueKeyPrefix, groupID, suffix)
+}
+
+func fairQueueAccountKey(accountID int64) string {
+	return fmt.Sprintf("%s%d", fairQueueAccountKeyPrefix, accountID)
+}
+
+func (c *fairQueueCache) Enqueue(ctx context.Context, groupID, accountID, userID int64, weight int, ticket string, ttl time.Duration) error {
+	keys := []string{
+		fairQueueKey(groupID, "queue"),
+		fairQueueKey(groupID, "hb"),
+		fairQueueKey(groupID, "users"),
+		fairQueueKey(groupID, "state"),
+		fairQueueGroupsKey,
+		fairQueueAccountKey(accountID),
+	}
+	return fairQueueEnqueueScript.Run(ctx, c.rdb, keys,
+		ticket, userID, weight, ttl.Milliseconds(), groupID, fairQueueKeyTTLSeconds).Err()
+}
+
+func (c *fairQueueCache) Position(ctx context.Context, groupID, accountID int64, ticket string, ttl time.Duration) (*service.FairQueuePosition, error) {
+	keys := []string{fairQueueKey(groupID, "queue"), fairQueueKey(groupID, "hb"), fairQueueKey(groupID, "state"), fairQueueAccountKey(accountID)}
+	values, err := fairQueuePositionScript.Run(ctx, c.rdb, keys, ticket, ttl.Milliseconds(), accountID).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 4 {
+		return nil, fmt.Errorf("unexpected fair queue position result: %v", values)
+	}
+	return &service.FairQueuePosition{Rank: values[0], Depth: values[1], AvgAdmitIntervalMs: values[2], AheadSameAccount: values[3]}, nil
+}
+
+func (c *fairQueueCache) Admit(ctx context.Context, groupID, accountID int64, ticket string) error {
+	keys := []string{fairQueueKey(groupID, "queue"), fairQueueKey(groupID, "hb"), fairQueueKey(groupID, "state"), fairQueueAccountKey(accountID)}
+	// 间隔样本上限取 1 分钟，避免空闲期拉高出队间隔估计
+	return fairQueueAdmitScript.Run(ctx, c.rdb, keys, ticket, time.Minute.Milliseconds(), fairQueueKeyTTLSeconds).Err()
+}
+
+func (c *fairQueueCache) Leave(ctx context.Context, groupID, accountID int64, ticket string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRem(ctx, fairQueueKey(groupID, "queue"), ticket)
+	pipe.ZRem(ctx, fairQueueKey(groupID, "hb"), ticket)
+	pipe.ZRem(ctx, fairQueueAccountKey(accountID), ticket)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *fairQueueCache) AccountWaiting(ctx context.Context, accountID int64, ttl time.Duration) (int64, error) {
+	return fairQueueAccountWaitingScript.Run(ctx, c.rdb, []string{fairQueueAccountKey(accountID)}, ttl.Milliseconds()).Int64()
+}
+
+func (c *fairQueueCache) Stats(ctx context.Context, ttl time.Duration) ([]service.FairQueueGroupStats, error) {
+	members, err := c.rdb.SMembers(ctx, fairQueueGroupsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]service.FairQueueGroupStats, 0, len(members))
+	for _, member := range members {
+		groupID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			_ = c.rdb.SRem(ctx, fairQueueGroupsKey, member).Err()
+			continue
+		}
+		keys := []string{fairQueueKey(groupID, "queue"), fairQueueKey(groupID, "hb"), fairQueueKey(groupID, "state")}
+		values, err := fairQueueDepthScript.Run(ctx, c.rdb, keys, ttl.Milliseconds()).Int64Slice()
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != 2 {
+			return nil, fmt.Errorf("unexpected fair queue depth result: %v", values)
+		}
+		if values[0] == 0 {
+			// 队列已清空，移出统计集合（下次入队时重新加入）
+			_ = c.rdb.SRem(ctx, fairQueueGroupsKey, member).Err()
+			continue
+		}
+		stats = append(stats, service.FairQueueGroupStats{GroupID: groupID, Depth: values[0], AvgAdmitIntervalMs: values[1]})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Depth != stats[j].Depth {
+			return stats[i].Depth > stats[j].Depth
+		}
+		return stats[i].GroupID < stats[j].GroupID
+	})
+	return stats, nil
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type FairQueueCacheSuite struct {
+	IntegrationRedisSuite
+	cache service.FairQueueCache
+}
+
+func (s *FairQueueCacheSuite) SetupTest() {
+	s.IntegrationRedisSuite.SetupTest()
+	s.cache = NewFairQueueCache(s.rdb)
+}
+
+func (s *FairQueueCacheSuite) rank(groupID int64, ticket string) int64 {
+	pos, err := s.cache.Position(s.ctx, groupID, 1, ticket, time.Minute)
+	require.NoError(s.T(), err, "Position")
+	return pos.Rank
+}
+
+func (s *FairQueueCacheSuite) TestHeavyUserDoesNotStarveOthers() {
+	groupID := int64(1)
+	ttl := time.Minute
+
+	// 用户 1 先排入 3 个请求，用户 2 随后排入 1 个
+	for _, ticket := range []string{"a1", "a2", "a3"} {
+		require.NoError(s.T(), s.cache.Enqueue(s.ctx, groupID, 1, 1, 1, ticket, ttl))
+	}
+	require.NoError(s.T(), s.cache.Enqueue(s.ctx, groupID, 1, 2, 1, "b1", ttl))
+
+	require.Equal(s.T(), int64(0), s.rank(groupID, "a1"))
+	require.Equal(s.T(), int64(1), s.rank(groupID, "b1"), "用户 2 的请求排在用户 1 的后续请求之前")
+	require.Equal(s.T(), int64(2), s.rank(groupID, "a2"))
+
+	require.NoError(s.T(), s.cache.Admit(s.ctx, groupID, 1, "a1"))
+	require.NoError(s.T(), s.cache.Leave(s.ctx, groupID, 1, "a3"))
+	pos, err := s.cache.Position(s.ctx, groupID, 1, "a2", ttl)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), pos.Depth)
+	require.Equal(s.T(), int64(-1), s.rank(groupID, "a3"))
+}
+
+func (s *FairQueueCacheSuite) TestWeightedOrdering() {
+	groupID := int64(2)
+	ttl := time.Minute
+
+	// 权重 2 的用户每个请求只推进 0.5 个虚拟时间单位
+	for _, ticket := range []string{"h1", "h2", "h3"} {
+		require.NoError(s.T(), s.cache.Enqueue(s.ctx, groupID, 1, 1, 2, ticket, ttl))
+	}
+	for _, ticket := range []string{"l1", "l2"} {
+		require.NoError(s.T(), s.cache.Enqueue(s.ctx, groupID, 1, 2, 1, ticket, ttl))
+	}
+
+	// 起始标签：h1=0 h2=0.5 h3=1 l1=0 l2=1
+	require.Less(s.T(), s.rank(groupID, "h2"), s.rank(groupID, "l2"))
+	require.Less(s.T(), s.rank(groupID, "l1"), s.rank(groupID, "h2"))
+}
+
+func (s *FairQueueCacheSuite) TestStaleTicketsArePruned() {
+	groupID := int64(3)
+	require.NoError(s.T(), s.cache.Enqueue(s.ctx, groupID, 1, 1, 1, "stale", 50*time.Millisecond))
+	time.Sleep(100 * time.Millisecond)
+
+	pos, err := s.cache.Position(s.ctx, groupID, 1, "stale", 50*time.Millisecond)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(-1), pos.Rank)
+	require.Zero(s.T(), pos.Depth)
+}
+
+func (s *FairQueueCacheSuite) TestStats() {
+	ttl := time.Minute
+	require.NoError(s.T(), s.cache.Enqueue(s.ctx, 10, 1, 1, 1, "x1", ttl))
+	require.NoError(s.T(), s.cache.Enqueue(s.ctx, 11, 1, 1, 1, "y1", ttl))
+	require.NoError(s.T(), s.cache.Enqueue(s.ctx, 11, 1, 2, 1, "y2", ttl))
+	require.NoError(s.T(), s.cache.Leave(s.ctx, 10, 1, "x1"))
+
+	stats, err := s.cache.Stats(s.ctx, ttl)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), stats, 1, "空队列不计入统计")
+	require.Equal(s.T(), int64(11), stats[0].GroupID)
+	require.Equal(s.T(), int64(2), stats[0].Depth)
+}
+
+func (s *FairQueueCacheSuite) TestAheadSameAccount() {
+	groupID := int64(4)
+	ttl := time.Minute
+
+	// 前两个请求等待账号 7，第三个等待账号 8
+	tickets := []struct {
+		account int64
+		id      string
+	}{{7, "0000000000000000001:7:a"}, {7, "0000000000000000002:7:b"}, {8, "0000000000000000003:8:c"}}
+	for i, ticket := range tickets {
+		require.NoError(s.T(), s.cache.Enqueue(s.ctx, groupID, ticket.account, int64(i+1), 1, ticket.id, ttl))
+	}
+
+	pos, err := s.cache.Position(s.ctx, groupID, 8, tickets[2].id, ttl)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), pos.Rank)
+	require.Zero(s.T(), pos.AheadSameAccount, "前面的请求都在等待其他账号")
+
+	pos, err = s.cache.Position(s.ctx, groupID, 7, tickets[1].id, ttl)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(1), pos.AheadSameAccount)
+
+	waiting, err := s.cache.AccountWaiting(s.ctx, 7, ttl)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), waiting)
+
+	require.NoError(s.T(), s.cache.Admit(s.ctx, groupID, 7, tickets[0].id))
+	require.NoError(s.T(), s.cache.Leave(s.ctx, groupID, 7, tickets[1].id))
+	waiting, err = s.cache.AccountWaiting(s.ctx, 7, ttl)
+	require.NoError(s.T(), err)
+	require.Zero(s.T(), waiting)
+}
+
+func TestFairQueueCacheSuite(t *testing.T) {
+	suite.Run(t, new(FairQueueCacheSuite))
+}
@@ -33,7 +33,7 @@ func newGroupRepositoryWithSQL(client *dbent.Client, sqlq sqlExecutor) *groupRep
 }
 
 func (r *groupRepository) Create(ctx context.Context, groupIn *service.Group) error {
-	builder := r.client.Group.Create().
+	builder := clientFromContext(ctx, r.client).Group.Create().
 		SetName(groupIn.Name).
 		SetDescription(groupIn.Description).
 		SetPlatform(groupIn.Platform).
@@ -62,12 +62,21 @@ func (r *groupRepository) Create(ctx context.Context, groupIn *service.Group) er
 	// 设置支持的模型系列（始终设置，空数组表示不限制）
 	builder = builder.SetSupportedModelScopes(groupIn.SupportedModelScopes)
 
+	// 设置模型降级链（始终设置，空数组表示不降级）
+	builder = builder.SetModelFallbacks(modelFallbacksOrEmpty(groupIn.ModelFallbacks))
+
+	// 设置模型倍率（始终设置，空对象表示不额外加倍）
+	builder = builder.SetModelRateMultipliers(modelRateMultipliersOrEmpty(groupIn.ModelRateMultipliers))
+
+	builder = builder.SetSchedulingStrategy(groupIn.SchedulingStrategy)
+	builder = builder.SetFirstByteTimeoutSeconds(groupIn.FirstByteTimeoutSeconds)
+
 	created, err := builder.Save(ctx)
 	if err == nil {
 		groupIn.ID = created.ID
 		groupIn.CreatedAt = created.CreatedAt
 		groupIn.UpdatedAt = created.UpdatedAt
-		if err := enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventGroupChanged, nil, &groupIn.ID, nil); err != nil {
+		if err := enqueueSchedulerOutbox(ctx, sqlExecutorFromContext(ctx, r.sql), service.SchedulerOutboxEventGroupChanged, nil, &groupIn.ID, nil); err != nil {
 			log.Printf("[SchedulerOutbox] enqueue group create failed: group=%d err=%v", groupIn.ID, err)
 		}
 	}
@@ -96,7 +105,7 @@ func (r *groupRepository) GetByIDLite(ctx context.Context, id int64) (*service.G
 }
 
 func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) error {
-	builder := r.client.Group.UpdateOneID(groupIn.ID).
+	builder := clientFromContext(ctx, r.client).Group.UpdateOneID(groupIn.ID).
 		SetName(groupIn.Name).
 		SetDescription(groupIn.Description).
 		SetPlatform(groupIn.Platform).
@@ -138,12 +147,24 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 	// 处理 SupportedModelScopes（始终设置，空数组表示不限制）
 	builder = builder.SetSupportedModelScopes(groupIn.SupportedModelScopes)
 
+	// 处理 ModelFallbacks（始终设置，空数组表示不降级）
+	builder = builder.SetModelFallbacks(modelFallbacksOrEmpty(groupIn.ModelFallbacks))
+
+	// 处理 ModelRateMultipliers（始终设置，空对象表示不额外加倍）
+	builder = builder.SetModelRateMultipliers(modelRateMultipliersOrEmpty(groupIn.ModelRateMultipliers))
+
+	// 处理 SchedulingStrategy（空字符串表示使用全局默认）
+	builder = builder.SetSchedulingStrategy(groupIn.SchedulingStrategy)
+
+	// 处理 FirstByteTimeoutSeconds（0 表示使用全局配置）
+	builder = builder.SetFirstByteTimeoutSeconds(groupIn.FirstByteTimeoutSeconds)
+
 	updated, err := builder.Save(ctx)
 	if err != nil {
 		return translatePersistenceError(err, service.ErrGroupNotFound, service.ErrGroupExists)
 	}
 	groupIn.UpdatedAt = updated.UpdatedAt
-	if err := enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventGroupChanged, nil, &groupIn.ID, nil); err != nil {
+	if err := enqueueSchedulerOutbox(ctx, sqlExecutorFromContext(ctx, r.sql), service.SchedulerOutboxEventGroupChanged, nil, &groupIn.ID, nil); err != nil {
 		log.Printf("[SchedulerOutbox] enqueue group update failed: group=%d err=%v", groupIn.ID, err)
 	}
 	return nil
@@ -523,3 +544,17 @@ func (r *groupRepository) UpdateSortOrders(ctx context.Context, updates []servic
 
 	return nil
 }
+
+func modelFallbacksOrEmpty(rules []service.ModelFallbackRule) []service.ModelFallbackRule {
+	if rules == nil {
+		return []service.ModelFallbackRule{}
+	}
+	return rules
+}
+
+func modelRateMultipliersOrEmpty(multipliers map[string]float64) map[string]float64 {
+	if multipliers == nil {
+		return map[string]float64{}
+	}
+	return multipliers
+}
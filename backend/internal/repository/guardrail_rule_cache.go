@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	guardrailRuleCacheKey  = "guardrail_rules"
+	guardrailRulePubSubKey = "guardrail_rules_updated"
+)
+
+// NewGuardrailRuleCache 创建内容护栏规则缓存
+func NewGuardrailRuleCache(rdb *redis.Client) service.GuardrailRuleCache {
+	return newRuleListCache[model.GuardrailRule](rdb, "GuardrailRuleCache", guardrailRuleCacheKey, guardrailRulePubSubKey)
+}
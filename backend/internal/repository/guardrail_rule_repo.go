@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type guardrailRuleRepository struct {
+	client *ent.Client
+}
+
+// NewGuardrailRuleRepository 创建内容护栏规则仓库
+func NewGuardrailRuleRepository(client *ent.Client) service.GuardrailRuleRepository {
+	return &guardrailRuleRepository{client: client}
+}
+
+// List 获取所有规则
+func (r *guardrailRuleRepository) List(ctx context.Context) ([]*model.GuardrailRule, error) {
+	rules, err := r.client.GuardrailRule.Query().
+		Order(ent.Asc(guardrailrule.FieldPriority), ent.Asc(guardrailrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.GuardrailRule, len(rules))
+	for i, rule := range rules {
+		result[i] = r.toModel(rule)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取规则
+func (r *guardrailRuleRepository) GetByID(ctx context.Context, id int64) (*model.GuardrailRule, error) {
+	rule, err := r.client.GuardrailRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(rule), nil
+}
+
+// Create 创建规则
+func (r *guardrailRuleRepository) Create(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	builder := r.client.GuardrailRule.Create().
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetMatchType(rule.MatchType).
+		SetAction(rule.Action)
+
+	if len(rule.GroupIDs) > 0 {
+		builder.SetGroupIds(rule.GroupIDs)
+	}
+	if len(rule.Patterns) > 0 {
+		builder.SetPatterns(rule.Patterns)
+	}
+	if rule.BlockMessage != nil {
+		builder.SetBlockMessage(*rule.BlockMessage)
+	}
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新规则
+func (r *guardrailRuleRepository) Update(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	builder := r.client.GuardrailRule.UpdateOneID(rule.ID).
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetMatchType(rule.MatchType).
+		SetAction(rule.Action)
+
+	// 处理可选字段
+	if len(rule.GroupIDs) > 0 {
+		builder.SetGroupIds(rule.GroupIDs)
+	} else {
+		builder.ClearGroupIds()
+	}
+	if len(rule.Patterns) > 0 {
+		builder.SetPatterns(rule.Patterns)
+	} else {
+		builder.ClearPatterns()
+	}
+	if rule.BlockMessage != nil {
+		builder.SetBlockMessage(*rule.BlockMessage)
+	} else {
+		builder.ClearBlockMessage()
+	}
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除规则
+func (r *guardrailRuleRepository) Delete(ctx context.Context, id int64) error {
+	return r.client.GuardrailRule.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *guardrailRuleRepository) toModel(e *ent.GuardrailRule) *model.GuardrailRule {
+	rule := &model.GuardrailRule{
+		ID:           e.ID,
+		Name:         e.Name,
+		Enabled:      e.Enabled,
+		Priority:     e.Priority,
+		GroupIDs:     e.GroupIds,
+		MatchType:    e.MatchType,
+		Patterns:     e.Patterns,
+		Action:       e.Action,
+		BlockMessage: e.BlockMessage,
+		Description:  e.Description,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.Patterns == nil {
+		rule.Patterns = []string{}
+	}
+
+	return rule
+}
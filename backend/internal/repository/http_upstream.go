@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"strings"
 	"sync"
@@ -16,8 +17,10 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/proxyutil"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/tlsfingerprint"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // 默认配置常量
@@ -100,6 +103,9 @@ type httpUpstreamService struct {
 // 返回:
 //   - service.HTTPUpstream 接口实现
 func NewHTTPUpstream(cfg *config.Config) service.HTTPUpstream {
+	if cfg != nil {
+		tlsfingerprint.InitGlobalRegistry(&cfg.Gateway.TLSFingerprint)
+	}
 	return &httpUpstreamService{
 		cfg:     cfg,
 		clients: make(map[string]*upstreamClientEntry),
@@ -134,7 +140,7 @@ func (s *httpUpstreamService) Do(req *http.Request, proxyURL string, accountID i
 	}
 
 	// 执行请求
-	resp, err := entry.client.Do(req)
+	resp, err := doTracedRequest(entry.client, req, proxyURL, accountID)
 	if err != nil {
 		// 请求失败，立即减少计数
 		atomic.AddInt64(&entry.inFlight, -1)
@@ -152,6 +158,25 @@ func (s *httpUpstreamService) Do(req *http.Request, proxyURL string, accountID i
 	return resp, nil
 }
 
+// doTracedRequest 执行上游请求并记录 upstream.http span
+// span 在收到响应头时结束，流式响应体的读取耗时由外层 gateway.forward span 覆盖
+func doTracedRequest(client *http.Client, req *http.Request, proxyURL string, accountID int64) (*http.Response, error) {
+	ctx, span := tracing.Start(req.Context(), "upstream.http",
+		tracing.AttrAccountID.Int64(accountID),
+		attribute.String("server.address", req.URL.Host),
+		attribute.Bool("sub2api.proxied", proxyURL != ""),
+	)
+	if span.IsRecording() {
+		ctx = httptrace.WithClientTrace(ctx, tracing.ClientTrace(span))
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err == nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+	tracing.End(span, err)
+	return resp, err
+}
+
 // DoWithTLS 执行带 TLS 指纹伪装的 HTTP 请求
 // 根据 enableTLSFingerprint 参数决定是否使用 TLS 指纹
 //
@@ -206,7 +231,7 @@ func (s *httpUpstreamService) DoWithTLS(req *http.Request, proxyURL string, acco
 	}
 
 	// 执行请求
-	resp, err := entry.client.Do(req)
+	resp, err := doTracedRequest(entry.client, req, proxyURL, accountID)
 	if err != nil {
 		// 请求失败，立即减少计数
 		atomic.AddInt64(&entry.inFlight, -1)
@@ -238,7 +263,8 @@ func (s *httpUpstreamService) getClientEntryWithTLS(proxyURL string, accountID i
 	proxyKey, parsedProxy := normalizeProxyURL(proxyURL)
 	// TLS 指纹客户端使用独立的缓存键，加 "tls:" 前缀
 	cacheKey := "tls:" + buildCacheKey(isolation, proxyKey, accountID)
-	poolKey := s.buildPoolKey(isolation, accountConcurrency) + ":tls"
+	// 指纹模板热更新后 generation 变化，旧模板创建的客户端不再复用
+	poolKey := fmt.Sprintf("%s:tls:%d", s.buildPoolKey(isolation, accountConcurrency), tlsfingerprint.GlobalRegistry().Generation())
 
 	now := time.Now()
 	nowUnix := now.UnixNano()
@@ -17,6 +17,7 @@ import (
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
 	_ "github.com/Wei-Shaw/sub2api/ent/runtime"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/memredis"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -75,16 +76,6 @@ func TestMain(m *testing.M) {
 	}
 	defer func() { _ = pgContainer.Terminate(ctx) }()
 
-	redisContainer, err := tcredis.Run(
-		ctx,
-		redisImageTag,
-	)
-	if err != nil {
-		log.Printf("failed to start redis container: %v", err)
-		os.Exit(1)
-	}
-	defer func() { _ = redisContainer.Terminate(ctx) }()
-
 	dsn, err := pgContainer.ConnectionString(ctx, "sslmode=disable", "TimeZone=UTC")
 	if err != nil {
 		log.Printf("failed to get postgres dsn: %v", err)
@@ -105,21 +96,12 @@ func TestMain(m *testing.M) {
 	drv := entsql.OpenDB(dialect.Postgres, integrationDB)
 	integrationEntClient = dbent.NewClient(dbent.Driver(drv))
 
-	redisHost, err := redisContainer.Host(ctx)
+	var stopRedis func()
+	integrationRedis, stopRedis, err = startIntegrationRedis(ctx)
 	if err != nil {
-		log.Printf("failed to get redis host: %v", err)
+		log.Printf("failed to start redis: %v", err)
 		os.Exit(1)
 	}
-	redisPort, err := redisContainer.MappedPort(ctx, "6379/tcp")
-	if err != nil {
-		log.Printf("failed to get redis port: %v", err)
-		os.Exit(1)
-	}
-
-	integrationRedis = redisclient.NewClient(&redisclient.Options{
-		Addr: fmt.Sprintf("%s:%d", redisHost, redisPort.Int()),
-		DB:   0,
-	})
 	if err := integrationRedis.Ping(ctx).Err(); err != nil {
 		log.Printf("failed to ping redis: %v", err)
 		os.Exit(1)
@@ -129,11 +111,45 @@ func TestMain(m *testing.M) {
 
 	_ = integrationEntClient.Close()
 	_ = integrationRedis.Close()
+	stopRedis()
 	_ = integrationDB.Close()
 
 	os.Exit(code)
 }
 
+// startIntegrationRedis 按 INTEGRATION_REDIS_BACKEND 选择缓存后端：
+// 默认启动 Redis 容器；设为 embedded 时使用进程内的 memredis，
+// 用同一套缓存测试验证内置后端（redis.mode=embedded）与真实 Redis 行为一致。
+func startIntegrationRedis(ctx context.Context) (*redisclient.Client, func(), error) {
+	if os.Getenv("INTEGRATION_REDIS_BACKEND") == "embedded" {
+		log.Printf("using embedded redis backend (memredis)")
+		srv := memredis.New()
+		client := redisclient.NewClient(&redisclient.Options{Addr: "embedded", Dialer: srv.Dial})
+		return client, func() { _ = srv.Close() }, nil
+	}
+
+	redisContainer, err := tcredis.Run(ctx, redisImageTag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("start redis container: %w", err)
+	}
+	stop := func() { _ = redisContainer.Terminate(ctx) }
+	redisHost, err := redisContainer.Host(ctx)
+	if err != nil {
+		stop()
+		return nil, nil, fmt.Errorf("get redis host: %w", err)
+	}
+	redisPort, err := redisContainer.MappedPort(ctx, "6379/tcp")
+	if err != nil {
+		stop()
+		return nil, nil, fmt.Errorf("get redis port: %w", err)
+	}
+	client := redisclient.NewClient(&redisclient.Options{
+		Addr: fmt.Sprintf("%s:%d", redisHost, redisPort.Int()),
+		DB:   0,
+	})
+	return client, stop, nil
+}
+
 func dockerIsAvailable(ctx context.Context) bool {
 	cmd := exec.CommandContext(ctx, "docker", "info")
 	cmd.Env = os.Environ()
@@ -330,6 +346,7 @@ func (h prefixHook) prefixCmd(cmd redisclient.Cmder) {
 	switch strings.ToLower(cmd.Name()) {
 	case "get", "set", "setnx", "setex", "psetex", "incr", "decr", "incrby", "expire", "pexpire", "ttl", "pttl",
 		"hgetall", "hget", "hset", "hdel", "hincrbyfloat", "exists",
+		"sadd", "srem", "smembers", "sismember", "scard",
 		"zadd", "zcard", "zrange", "zrangebyscore", "zrem", "zremrangebyscore", "zrevrange", "zrevrangebyscore", "zscore":
 		prefixOne(1)
 	case "del", "unlink":
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	modelPriceCacheKey  = "model_prices"
+	modelPricePubSubKey = "model_prices_updated"
+)
+
+// NewModelPriceCache 创建模型价格缓存
+func NewModelPriceCache(rdb *redis.Client) service.ModelPriceCache {
+	return newRuleListCache[model.ModelPrice](rdb, "ModelPriceCache", modelPriceCacheKey, modelPricePubSubKey)
+}
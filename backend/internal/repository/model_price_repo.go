@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type modelPriceRepository struct {
+	client *ent.Client
+}
+
+// NewModelPriceRepository 创建模型价格仓库
+func NewModelPriceRepository(client *ent.Client) service.ModelPriceRepository {
+	return &modelPriceRepository{client: client}
+}
+
+// List 获取所有价格（按模型、生效时间排序）
+func (r *modelPriceRepository) List(ctx context.Context) ([]*model.ModelPrice, error) {
+	prices, err := r.client.ModelPrice.Query().
+		Order(ent.Asc(modelprice.FieldModel), ent.Asc(modelprice.FieldEffectiveFrom)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.ModelPrice, len(prices))
+	for i, p := range prices {
+		result[i] = r.toModel(p)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取价格
+func (r *modelPriceRepository) GetByID(ctx context.Context, id int64) (*model.ModelPrice, error) {
+	p, err := r.client.ModelPrice.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(p), nil
+}
+
+// Create 创建价格
+func (r *modelPriceRepository) Create(ctx context.Context, price *model.ModelPrice) (*model.ModelPrice, error) {
+	created, err := r.createBuilder(clientFromContext(ctx, r.client), price).Save(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, nil, service.ErrModelPriceExists)
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新价格
+func (r *modelPriceRepository) Update(ctx context.Context, price *model.ModelPrice) (*model.ModelPrice, error) {
+	builder := clientFromContext(ctx, r.client).ModelPrice.UpdateOneID(price.ID).
+		SetModel(price.Model).
+		SetPlatform(price.Platform).
+		SetInputPrice(price.InputPrice).
+		SetOutputPrice(price.OutputPrice).
+		SetCacheWritePrice(price.CacheWritePrice).
+		SetCacheReadPrice(price.CacheReadPrice).
+		SetEffectiveFrom(price.EffectiveFrom).
+		SetEnabled(price.Enabled)
+
+	if price.GroupID != nil {
+		builder.SetGroupID(*price.GroupID)
+	} else {
+		builder.ClearGroupID()
+	}
+	if price.ImagePrice != nil {
+		builder.SetImagePrice(*price.ImagePrice)
+	} else {
+		builder.ClearImagePrice()
+	}
+	if price.Description != nil {
+		builder.SetDescription(*price.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrModelPriceNotFound, service.ErrModelPriceExists)
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除价格
+func (r *modelPriceRepository) Delete(ctx context.Context, id int64) error {
+	err := clientFromContext(ctx, r.client).ModelPrice.DeleteOneID(id).Exec(ctx)
+	return translatePersistenceError(err, service.ErrModelPriceNotFound, nil)
+}
+
+// Import 在同一事务内批量写入价格；replace 为 true 时先清空现有价格
+func (r *modelPriceRepository) Import(ctx context.Context, prices []*model.ModelPrice, replace bool) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if replace {
+		if _, err := tx.ModelPrice.Delete().Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	for i, price := range prices {
+		if _, err := r.createBuilder(tx.Client(), price).Save(ctx); err != nil {
+			return 0, fmt.Errorf("import item %d (%s): %w", i, price.Model, translatePersistenceError(err, nil, service.ErrModelPriceExists))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(prices), nil
+}
+
+func (r *modelPriceRepository) createBuilder(client *ent.Client, price *model.ModelPrice) *ent.ModelPriceCreate {
+	builder := client.ModelPrice.Create().
+		SetModel(price.Model).
+		SetPlatform(price.Platform).
+		SetInputPrice(price.InputPrice).
+		SetOutputPrice(price.OutputPrice).
+		SetCacheWritePrice(price.CacheWritePrice).
+		SetCacheReadPrice(price.CacheReadPrice).
+		SetEffectiveFrom(price.EffectiveFrom).
+		SetEnabled(price.Enabled).
+		SetNillableGroupID(price.GroupID).
+		SetNillableImagePrice(price.ImagePrice).
+		SetNillableDescription(price.Description)
+	return builder
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *modelPriceRepository) toModel(e *ent.ModelPrice) *model.ModelPrice {
+	return &model.ModelPrice{
+		ID:              e.ID,
+		Model:           e.Model,
+		GroupID:         e.GroupID,
+		Platform:        e.Platform,
+		InputPrice:      e.InputPrice,
+		OutputPrice:     e.OutputPrice,
+		CacheWritePrice: e.CacheWritePrice,
+		CacheReadPrice:  e.CacheReadPrice,
+		ImagePrice:      e.ImagePrice,
+		EffectiveFrom:   e.EffectiveFrom,
+		Enabled:         e.Enabled,
+		Description:     e.Description,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"crypto/tls"
+	"sync"
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/memredis"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -21,7 +24,11 @@ import (
 // 2. MinIdleConns: 保持最小空闲连接，减少冷启动延迟（默认 10）
 // 3. DialTimeout/ReadTimeout/WriteTimeout: 精确控制各阶段超时
 func InitRedis(cfg *config.Config) *redis.Client {
-	return redis.NewClient(buildRedisOptions(cfg))
+	client := redis.NewClient(buildRedisOptions(cfg))
+	if tracing.Enabled() {
+		client.AddHook(redisTracingHook{})
+	}
+	return client
 }
 
 // buildRedisOptions 构建 Redis 连接选项
@@ -38,6 +45,14 @@ func buildRedisOptions(cfg *config.Config) *redis.Options {
 		MinIdleConns: cfg.Redis.MinIdleConns,                                     // 最小空闲连接
 	}
 
+	if cfg.Redis.Embedded() {
+		// 内置模式：通过进程内连接访问 memredis，地址、密码与 TLS 均无意义
+		opts.Addr = "embedded"
+		opts.Password = ""
+		opts.Dialer = embeddedRedisServer().Dial
+		return opts
+	}
+
 	if cfg.Redis.EnableTLS {
 		opts.TLSConfig = &tls.Config{
 			MinVersion: tls.VersionTLS12,
@@ -47,3 +62,16 @@ func buildRedisOptions(cfg *config.Config) *redis.Options {
 
 	return opts
 }
+
+var (
+	embeddedRedisOnce sync.Once
+	embeddedRedis     *memredis.Server
+)
+
+// embeddedRedisServer 返回进程内唯一的 memredis 实例（随进程退出释放）
+func embeddedRedisServer() *memredis.Server {
+	embeddedRedisOnce.Do(func() {
+		embeddedRedis = memredis.New()
+	})
+	return embeddedRedis
+}
@@ -0,0 +1,734 @@
+package repository
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/memredis"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// 内置缓存后端（redis.mode=embedded）不解释 Lua，以下为本包全部脚本的等价 Go 实现，
+// 按脚本 SHA1 注册，语义需与对应 Lua 源码保持一致：修改任一脚本时须同步修改这里，
+// 否则内置模式下 EVAL 会因找不到实现而报错。redis_script_parity_test.go 的用例
+// 在内置后端与真实 Redis（集成测试）上使用同一组期望，新增脚本时须补充用例。
+func init() {
+	memredis.RegisterScript(acquireScript.Hash(), embeddedAcquireSlot)
+	memredis.RegisterScript(getCountScript.Hash(), embeddedSlotCount)
+	memredis.RegisterScript(incrementWaitScript.Hash(), embeddedIncrementWait)
+	memredis.RegisterScript(incrementAccountWaitScript.Hash(), embeddedIncrementWait)
+	memredis.RegisterScript(decrementWaitScript.Hash(), embeddedDecrementWait)
+	memredis.RegisterScript(getAccountsLoadBatchScript.Hash(), embeddedLoadBatch(accountSlotKeyPrefix, accountWaitKeyPrefix))
+	memredis.RegisterScript(getUsersLoadBatchScript.Hash(), embeddedLoadBatch(userSlotKeyPrefix, waitQueueKeyPrefix))
+	memredis.RegisterScript(cleanupExpiredSlotsScript.Hash(), embeddedCleanupExpiredSlots)
+
+	memredis.RegisterScript(deductBalanceScript.Hash(), embeddedDeductBalance)
+	memredis.RegisterScript(updateSubUsageScript.Hash(), embeddedUpdateSubUsage)
+	memredis.RegisterScript(reserveCostScript.Hash(), embeddedReserveCost)
+
+	memredis.RegisterScript(fairQueueEnqueueScript.Hash(), embeddedFairQueueEnqueue)
+	memredis.RegisterScript(fairQueuePositionScript.Hash(), embeddedFairQueuePosition)
+	memredis.RegisterScript(fairQueueAdmitScript.Hash(), embeddedFairQueueAdmit)
+	memredis.RegisterScript(fairQueueDepthScript.Hash(), embeddedFairQueueDepth)
+	memredis.RegisterScript(fairQueueAccountWaitingScript.Hash(), embeddedFairQueueAccountWaiting)
+
+	memredis.RegisterScript(timeoutCounterIncrScript.Hash(), embeddedTimeoutCounterIncr)
+	memredis.RegisterScript(tempUnschedSetScript.Hash(), embeddedTempUnschedSet)
+
+	memredis.RegisterScript(registerSessionScript.Hash(), embeddedRegisterSession)
+	memredis.RegisterScript(refreshSessionScript.Hash(), embeddedRefreshSession)
+	memredis.RegisterScript(getActiveSessionCountScript.Hash(), embeddedSlotCount)
+	memredis.RegisterScript(isSessionActiveScript.Hash(), embeddedIsSessionActive)
+
+	// service 层直接执行的脚本也在这里注册，service 包不依赖内置后端
+	memredis.RegisterScript(service.OpsLeaderLockReleaseScript.Hash(), memredis.CompareAndDelete)
+}
+
+// embeddedPruneByAge 清理分数早于 now-ttl 的成员，返回当前秒级时间
+func embeddedPruneByAge(call memredis.CallFunc, key string, ttl float64) (float64, error) {
+	sec, _, err := memredis.ServerTime(call)
+	if err != nil {
+		return 0, err
+	}
+	now := float64(sec)
+	if _, err := call("ZREMRANGEBYSCORE", key, "-inf", now-ttl); err != nil {
+		return 0, err
+	}
+	return now, nil
+}
+
+// embeddedAcquireSlot 对应 acquireScript
+func embeddedAcquireSlot(call memredis.CallFunc, keys, args []string) (any, error) {
+	return embeddedAddMember(call, keys[0], args[0], args[1], args[2], 0)
+}
+
+// embeddedAddMember acquireScript 与 registerSessionScript 的共同逻辑：
+// 清理过期成员，已存在则刷新时间戳，否则在未达上限时加入；extraTTL 为键过期时间的额外秒数
+func embeddedAddMember(call memredis.CallFunc, key, limitArg, ttlArg, member string, extraTTL float64) (any, error) {
+	limit, err := memredis.Number(limitArg)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := memredis.Number(ttlArg)
+	if err != nil {
+		return nil, err
+	}
+	now, err := embeddedPruneByAge(call, key, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := call("ZSCORE", key, member)
+	if err != nil {
+		return nil, err
+	}
+	if exists == nil {
+		count, err := memredis.CallInt(call, "ZCARD", key)
+		if err != nil {
+			return nil, err
+		}
+		if float64(count) >= limit {
+			return int64(0), nil
+		}
+	}
+	if _, err := call("ZADD", key, now, member); err != nil {
+		return nil, err
+	}
+	if _, err := call("EXPIRE", key, ttl+extraTTL); err != nil {
+		return nil, err
+	}
+	return int64(1), nil
+}
+
+// embeddedSlotCount 对应 getCountScript / getActiveSessionCountScript
+func embeddedSlotCount(call memredis.CallFunc, keys, args []string) (any, error) {
+	ttl, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := embeddedPruneByAge(call, keys[0], ttl); err != nil {
+		return nil, err
+	}
+	return call("ZCARD", keys[0])
+}
+
+// embeddedIncrementWait 对应 incrementWaitScript / incrementAccountWaitScript
+func embeddedIncrementWait(call memredis.CallFunc, keys, args []string) (any, error) {
+	raw, err := call("GET", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	current, err := memredis.NumberOr(raw, 0)
+	if err != nil {
+		return nil, err
+	}
+	maxWait, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if current >= maxWait {
+		return int64(0), nil
+	}
+	if _, err := call("INCR", keys[0]); err != nil {
+		return nil, err
+	}
+	if _, err := call("EXPIRE", keys[0], args[1]); err != nil {
+		return nil, err
+	}
+	return int64(1), nil
+}
+
+// embeddedDecrementWait 对应 decrementWaitScript
+func embeddedDecrementWait(call memredis.CallFunc, keys, _ []string) (any, error) {
+	raw, err := call("GET", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if raw != nil {
+		current, err := memredis.Number(raw)
+		if err != nil {
+			return nil, err
+		}
+		if current > 0 {
+			if _, err := call("DECR", keys[0]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return int64(1), nil
+}
+
+// embeddedLoadBatch 对应 getAccountsLoadBatchScript / getUsersLoadBatchScript
+func embeddedLoadBatch(slotPrefix, waitPrefix string) memredis.ScriptFunc {
+	return func(call memredis.CallFunc, _, args []string) (any, error) {
+		slotTTL, err := memredis.Number(args[0])
+		if err != nil {
+			return nil, err
+		}
+		sec, _, err := memredis.ServerTime(call)
+		if err != nil {
+			return nil, err
+		}
+		cutoff := float64(sec) - slotTTL
+
+		result := []any{}
+		for i := 1; i < len(args); i += 2 {
+			id := args[i]
+			var maxConcurrency float64
+			if i+1 < len(args) {
+				if maxConcurrency, err = memredis.Number(args[i+1]); err != nil {
+					return nil, err
+				}
+			}
+
+			slotKey := slotPrefix + id
+			if _, err := call("ZREMRANGEBYSCORE", slotKey, "-inf", cutoff); err != nil {
+				return nil, err
+			}
+			current, err := memredis.CallInt(call, "ZCARD", slotKey)
+			if err != nil {
+				return nil, err
+			}
+			raw, err := call("GET", waitPrefix+id)
+			if err != nil {
+				return nil, err
+			}
+			waiting, err := memredis.NumberOr(raw, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			loadRate := 0.0
+			if maxConcurrency > 0 {
+				loadRate = math.Floor((float64(current) + waiting) * 100 / maxConcurrency)
+			}
+			result = append(result, id, current, waiting, loadRate)
+		}
+		return result, nil
+	}
+}
+
+// embeddedCleanupExpiredSlots 对应 cleanupExpiredSlotsScript
+func embeddedCleanupExpiredSlots(call memredis.CallFunc, keys, args []string) (any, error) {
+	ttl, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	sec, _, err := memredis.ServerTime(call)
+	if err != nil {
+		return nil, err
+	}
+	return call("ZREMRANGEBYSCORE", keys[0], "-inf", float64(sec)-ttl)
+}
+
+// embeddedDeductBalance 对应 deductBalanceScript
+func embeddedDeductBalance(call memredis.CallFunc, keys, args []string) (any, error) {
+	raw, err := call("GET", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return int64(0), nil
+	}
+	current, err := memredis.Number(raw)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := call("SET", keys[0], current-amount); err != nil {
+		return nil, err
+	}
+	if _, err := call("EXPIRE", keys[0], args[1]); err != nil {
+		return nil, err
+	}
+	return int64(1), nil
+}
+
+// embeddedUpdateSubUsage 对应 updateSubUsageScript
+func embeddedUpdateSubUsage(call memredis.CallFunc, keys, args []string) (any, error) {
+	exists, err := memredis.CallInt(call, "EXISTS", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		return int64(0), nil
+	}
+	cost, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range []string{subFieldDailyUsage, subFieldWeeklyUsage, subFieldMonthlyUsage} {
+		if _, err := call("HINCRBYFLOAT", keys[0], field, cost); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := call("EXPIRE", keys[0], args[1]); err != nil {
+		return nil, err
+	}
+	return int64(1), nil
+}
+
+// embeddedReserveCost 对应 reserveCostScript
+func embeddedReserveCost(call memredis.CallFunc, keys, args []string) (any, error) {
+	var nums [4]float64
+	for i, idx := range []int{0, 1, 3, 4} {
+		n, err := memredis.Number(args[idx])
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	now, ttl, amount, available := nums[0], nums[1], nums[2], nums[3]
+
+	reply, err := call("HGETALL", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	entries, _ := reply.([]any)
+	held := 0.0
+	for i := 0; i+1 < len(entries); i += 2 {
+		value := memredis.ArgString(entries[i+1])
+		amountPart, expiresPart, found := strings.Cut(value, ":")
+		expiresAt := 0.0
+		if found {
+			expiresAt, _ = memredis.ToNumber(expiresPart)
+		}
+		if expiresAt <= now {
+			if _, err := call("HDEL", keys[0], entries[i]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		heldAmount, _ := memredis.ToNumber(amountPart)
+		held += heldAmount
+	}
+	if held == 0 {
+		if available <= 0 {
+			return "-1", nil
+		}
+		if amount > available {
+			amount = available
+		}
+	} else if available-held < amount {
+		return "0", nil
+	}
+	value := memredis.LuaNumber(amount) + ":" + memredis.LuaNumber(now+ttl)
+	if _, err := call("HSET", keys[0], args[2], value); err != nil {
+		return nil, err
+	}
+	if _, err := call("EXPIRE", keys[0], ttl); err != nil {
+		return nil, err
+	}
+	return memredis.LuaNumber(amount), nil
+}
+
+// embeddedNowMillis 对应 fairQueueLuaHelpers 中的 now_ms
+func embeddedNowMillis(call memredis.CallFunc) (float64, error) {
+	sec, usec, err := memredis.ServerTime(call)
+	if err != nil {
+		return 0, err
+	}
+	return float64(sec*1000 + usec/1000), nil
+}
+
+// embeddedFairQueuePrune 对应 fairQueueLuaHelpers 中的 prune
+func embeddedFairQueuePrune(call memredis.CallFunc, queue, hb string, expireBefore float64) error {
+	reply, err := call("ZRANGEBYSCORE", hb, "-inf", expireBefore)
+	if err != nil {
+		return err
+	}
+	stale, _ := reply.([]any)
+	for _, member := range stale {
+		if _, err := call("ZREM", queue, member); err != nil {
+			return err
+		}
+	}
+	if len(stale) > 0 {
+		if _, err := call("ZREMRANGEBYSCORE", hb, "-inf", expireBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// embeddedHashNumber 等价于 tonumber(redis.call('HGET', key, field) or '0')
+func embeddedHashNumber(call memredis.CallFunc, key, field string) (float64, error) {
+	raw, err := call("HGET", key, field)
+	if err != nil {
+		return 0, err
+	}
+	return memredis.NumberOr(raw, 0)
+}
+
+// embeddedFairQueueEnqueue 对应 fairQueueEnqueueScript
+func embeddedFairQueueEnqueue(call memredis.CallFunc, keys, args []string) (any, error) {
+	now, err := embeddedNowMillis(call)
+	if err != nil {
+		return nil, err
+	}
+	ticketTTL, err := memredis.Number(args[3])
+	if err != nil {
+		return nil, err
+	}
+	if err := embeddedFairQueuePrune(call, keys[0], keys[1], now-ticketTTL); err != nil {
+		return nil, err
+	}
+
+	depth, err := memredis.CallInt(call, "ZCARD", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if depth == 0 {
+		if _, err := call("DEL", keys[2]); err != nil {
+			return nil, err
+		}
+	}
+
+	clock, err := embeddedHashNumber(call, keys[3], "clock")
+	if err != nil {
+		return nil, err
+	}
+	finish, err := embeddedHashNumber(call, keys[2], args[1])
+	if err != nil {
+		return nil, err
+	}
+	start := math.Max(clock, finish)
+	weight, err := memredis.Number(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := call("HSET", keys[2], args[1], start+1/weight); err != nil {
+		return nil, err
+	}
+	if _, err := call("ZADD", keys[0], start, args[0]); err != nil {
+		return nil, err
+	}
+	if _, err := call("ZADD", keys[1], now, args[0]); err != nil {
+		return nil, err
+	}
+	if _, err := call("ZADD", keys[5], now, args[0]); err != nil {
+		return nil, err
+	}
+	if _, err := call("SADD", keys[4], args[4]); err != nil {
+		return nil, err
+	}
+	keyTTL, err := memredis.Number(args[5])
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{keys[0], keys[1], keys[2], keys[5]} {
+		if _, err := call("EXPIRE", key, keyTTL); err != nil {
+			return nil, err
+		}
+	}
+	return int64(1), nil
+}
+
+// embeddedFairQueuePosition 对应 fairQueuePositionScript
+func embeddedFairQueuePosition(call memredis.CallFunc, keys, args []string) (any, error) {
+	now, err := embeddedNowMillis(call)
+	if err != nil {
+		return nil, err
+	}
+	ticketTTL, err := memredis.Number(args[1])
+	if err != nil {
+		return nil, err
+	}
+	if err := embeddedFairQueuePrune(call, keys[0], keys[1], now-ticketTTL); err != nil {
+		return nil, err
+	}
+
+	depth, err := memredis.CallInt(call, "ZCARD", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	interval, err := embeddedHashNumber(call, keys[2], "interval_ms")
+	if err != nil {
+		return nil, err
+	}
+	interval = math.Floor(interval)
+	rank, err := call("ZRANK", keys[0], args[0])
+	if err != nil {
+		return nil, err
+	}
+	if rank == nil {
+		return []any{int64(-1), depth, interval, int64(0)}, nil
+	}
+	if _, err := call("ZADD", keys[1], now, args[0]); err != nil {
+		return nil, err
+	}
+	if _, err := call("ZADD", keys[3], now, args[0]); err != nil {
+		return nil, err
+	}
+
+	rankValue, err := memredis.Number(rank)
+	if err != nil {
+		return nil, err
+	}
+	ahead := int64(0)
+	if rankValue > 0 {
+		reply, err := call("ZRANGE", keys[0], 0, int64(rankValue)-1)
+		if err != nil {
+			return nil, err
+		}
+		members, _ := reply.([]any)
+		for _, member := range members {
+			if embeddedFairQueueTicketAccount(member) == args[2] {
+				ahead++
+			}
+		}
+	}
+	return []any{rank, depth, interval, ahead}, nil
+}
+
+// embeddedFairQueueTicketAccount 对应 string.match(member, '^%d+:(%d+):')
+func embeddedFairQueueTicketAccount(member any) string {
+	text, _ := member.(string)
+	parts := strings.SplitN(text, ":", 3)
+	if len(parts) != 3 || !embeddedIsDigits(parts[0]) || !embeddedIsDigits(parts[1]) {
+		return ""
+	}
+	return parts[1]
+}
+
+func embeddedIsDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// embeddedFairQueueAdmit 对应 fairQueueAdmitScript
+func embeddedFairQueueAdmit(call memredis.CallFunc, keys, args []string) (any, error) {
+	if _, err := call("ZREM", keys[3], args[0]); err != nil {
+		return nil, err
+	}
+	score, err := call("ZSCORE", keys[0], args[0])
+	if err != nil {
+		return nil, err
+	}
+	if score == nil {
+		return int64(0), nil
+	}
+	now, err := embeddedNowMillis(call)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := call("ZREM", keys[0], args[0]); err != nil {
+		return nil, err
+	}
+	if _, err := call("ZREM", keys[1], args[0]); err != nil {
+		return nil, err
+	}
+
+	clock, err := embeddedHashNumber(call, keys[2], "clock")
+	if err != nil {
+		return nil, err
+	}
+	scoreValue, err := memredis.Number(score)
+	if err != nil {
+		return nil, err
+	}
+	if scoreValue > clock {
+		if _, err := call("HSET", keys[2], "clock", score); err != nil {
+			return nil, err
+		}
+	}
+
+	last, err := call("HGET", keys[2], "last_admit_ms")
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		lastValue, err := memredis.Number(last)
+		if err != nil {
+			return nil, err
+		}
+		maxSample, err := memredis.Number(args[1])
+		if err != nil {
+			return nil, err
+		}
+		sample := math.Min(now-lastValue, maxSample)
+		ewma := sample
+		old, err := call("HGET", keys[2], "interval_ms")
+		if err != nil {
+			return nil, err
+		}
+		if old != nil {
+			oldValue, err := memredis.Number(old)
+			if err != nil {
+				return nil, err
+			}
+			ewma = 0.8*oldValue + 0.2*sample
+		}
+		if _, err := call("HSET", keys[2], "interval_ms", ewma); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := call("HSET", keys[2], "last_admit_ms", now); err != nil {
+		return nil, err
+	}
+	keyTTL, err := memredis.Number(args[2])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := call("EXPIRE", keys[2], keyTTL); err != nil {
+		return nil, err
+	}
+	return int64(1), nil
+}
+
+// embeddedFairQueueDepth 对应 fairQueueDepthScript
+func embeddedFairQueueDepth(call memredis.CallFunc, keys, args []string) (any, error) {
+	now, err := embeddedNowMillis(call)
+	if err != nil {
+		return nil, err
+	}
+	ticketTTL, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := embeddedFairQueuePrune(call, keys[0], keys[1], now-ticketTTL); err != nil {
+		return nil, err
+	}
+	interval, err := embeddedHashNumber(call, keys[2], "interval_ms")
+	if err != nil {
+		return nil, err
+	}
+	depth, err := memredis.CallInt(call, "ZCARD", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	return []any{depth, math.Floor(interval)}, nil
+}
+
+// embeddedFairQueueAccountWaiting 对应 fairQueueAccountWaitingScript
+func embeddedFairQueueAccountWaiting(call memredis.CallFunc, keys, args []string) (any, error) {
+	now, err := embeddedNowMillis(call)
+	if err != nil {
+		return nil, err
+	}
+	ticketTTL, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := call("ZREMRANGEBYSCORE", keys[0], "-inf", now-ticketTTL); err != nil {
+		return nil, err
+	}
+	return memredis.CallInt(call, "ZCARD", keys[0])
+}
+
+// embeddedTimeoutCounterIncr 对应 timeoutCounterIncrScript
+func embeddedTimeoutCounterIncr(call memredis.CallFunc, keys, args []string) (any, error) {
+	ttl, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	count, err := memredis.CallInt(call, "INCR", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if count == 1 {
+		if _, err := call("EXPIRE", keys[0], ttl); err != nil {
+			return nil, err
+		}
+	}
+	return count, nil
+}
+
+// embeddedTempUnschedSet 对应 tempUnschedSetScript（只延长不缩短）
+func embeddedTempUnschedSet(call memredis.CallFunc, keys, args []string) (any, error) {
+	newUntil, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	newTTL, err := memredis.Number(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := call("GET", keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if raw, ok := existing.(string); ok {
+		var data map[string]any
+		if json.Unmarshal([]byte(raw), &data) == nil {
+			if existingUntil, ok := memredis.ToNumber(data["until_unix"]); ok && newUntil <= existingUntil {
+				return int64(0), nil
+			}
+		}
+	}
+
+	if _, err := call("SET", keys[0], args[1], "EX", newTTL); err != nil {
+		return nil, err
+	}
+	return int64(1), nil
+}
+
+// embeddedRegisterSession 对应 registerSessionScript
+func embeddedRegisterSession(call memredis.CallFunc, keys, args []string) (any, error) {
+	return embeddedAddMember(call, keys[0], args[0], args[1], args[2], 60)
+}
+
+// embeddedRefreshSession 对应 refreshSessionScript
+func embeddedRefreshSession(call memredis.CallFunc, keys, args []string) (any, error) {
+	idleTimeout, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	sec, _, err := memredis.ServerTime(call)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := call("ZSCORE", keys[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	if exists != nil {
+		if _, err := call("ZADD", keys[0], sec, args[1]); err != nil {
+			return nil, err
+		}
+		if _, err := call("EXPIRE", keys[0], idleTimeout+60); err != nil {
+			return nil, err
+		}
+	}
+	return int64(1), nil
+}
+
+// embeddedIsSessionActive 对应 isSessionActiveScript
+func embeddedIsSessionActive(call memredis.CallFunc, keys, args []string) (any, error) {
+	idleTimeout, err := memredis.Number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	sec, _, err := memredis.ServerTime(call)
+	if err != nil {
+		return nil, err
+	}
+	score, err := call("ZSCORE", keys[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	if score == nil {
+		return int64(0), nil
+	}
+	scoreValue, err := memredis.Number(score)
+	if err != nil {
+		return nil, err
+	}
+	if scoreValue <= float64(sec)-idleTimeout {
+		return int64(0), nil
+	}
+	return int64(1), nil
+}
@@ -0,0 +1,11 @@
+//go:build integration
+
+package repository
+
+import "testing"
+
+// TestRedisScriptCases_Integration 在集成测试的 Redis 上运行脚本用例；
+// 默认为真实 Redis，与 TestRedisScriptCases_Embedded 使用同一组期望，保证内置实现与 Lua 语义一致。
+func TestRedisScriptCases_Integration(t *testing.T) {
+	runRedisScriptCases(t, integrationRedis)
+}
@@ -0,0 +1,531 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// redisScriptCase 单个 Lua 脚本的行为用例
+//
+// 同一组用例既在内置后端（memredis 的 Go 实现）上运行，也在集成测试的真实 Redis 上运行，
+// 两边都必须得到 want，以此保证 redis_embedded_scripts.go 与 Lua 源码语义一致。
+// 依赖服务器时间的分数不直接比较，只比较由其推导出的计数、排名与成员。
+type redisScriptCase struct {
+	// name 为脚本变量名，非本包脚本带包名前缀
+	name   string
+	script *redis.Script
+	run    func(s *scriptRunner) []any
+	want   []any
+}
+
+// scriptRunner 在隔离的键空间内执行脚本与读取状态，命令出错时直接判定失败
+type scriptRunner struct {
+	t   *testing.T
+	ctx context.Context
+	rdb *redis.Client
+	ns  string
+	// id 为本次运行专用的数字 ID，用于脚本内部拼接固定前缀键的场景
+	id int64
+}
+
+func (s *scriptRunner) key(name string) string {
+	return s.ns + name
+}
+
+func (s *scriptRunner) eval(script *redis.Script, keys []string, args ...any) any {
+	s.t.Helper()
+	v, err := script.Run(s.ctx, s.rdb, keys, args...).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	require.NoError(s.t, err)
+	return v
+}
+
+func (s *scriptRunner) do(args ...any) any {
+	s.t.Helper()
+	v, err := s.rdb.Do(s.ctx, args...).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	require.NoError(s.t, err)
+	return v
+}
+
+// nowSec / nowMs 服务器时间，用于构造未过期的成员
+func (s *scriptRunner) nowSec() int64 {
+	s.t.Helper()
+	now, err := s.rdb.Time(s.ctx).Result()
+	require.NoError(s.t, err)
+	return now.Unix()
+}
+
+func (s *scriptRunner) nowMs() int64 {
+	s.t.Helper()
+	now, err := s.rdb.Time(s.ctx).Result()
+	require.NoError(s.t, err)
+	return now.UnixMilli()
+}
+
+func (s *scriptRunner) hasTTL(key string) bool {
+	s.t.Helper()
+	ttl, err := s.rdb.TTL(s.ctx, key).Result()
+	require.NoError(s.t, err)
+	return ttl > 0
+}
+
+func (s *scriptRunner) members(key string) []string {
+	s.t.Helper()
+	v, err := s.rdb.ZRange(s.ctx, key, 0, -1).Result()
+	require.NoError(s.t, err)
+	return v
+}
+
+func (s *scriptRunner) hash(key string) map[string]string {
+	s.t.Helper()
+	v, err := s.rdb.HGetAll(s.ctx, key).Result()
+	require.NoError(s.t, err)
+	return v
+}
+
+var redisScriptCases = []redisScriptCase{
+	{
+		name:   "deductBalanceScript",
+		script: deductBalanceScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("balance")
+			missing := s.eval(deductBalanceScript, []string{k}, 2.5, 60)
+			s.do("SET", k, "10")
+			return []any{missing, s.eval(deductBalanceScript, []string{k}, 2.5, 60), s.do("GET", k), s.hasTTL(k)}
+		},
+		want: []any{int64(0), int64(1), "7.5", true},
+	},
+	{
+		name:   "updateSubUsageScript",
+		script: updateSubUsageScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("sub")
+			missing := s.eval(updateSubUsageScript, []string{k}, 0.5, 60)
+			s.do("HSET", k, "daily_usage", "1", "weekly_usage", "2", "monthly_usage", "3")
+			return []any{missing, s.eval(updateSubUsageScript, []string{k}, 0.5, 60), s.hash(k), s.hasTTL(k)}
+		},
+		want: []any{int64(0), int64(1), map[string]string{"daily_usage": "1.5", "weekly_usage": "2.5", "monthly_usage": "3.5"}, true},
+	},
+	{
+		name:   "reserveCostScript",
+		script: reserveCostScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("reserve")
+			return []any{
+				s.eval(reserveCostScript, []string{k}, 1000, 60, "r1", 5, 3), // 无在途预留：按可用额度封顶
+				s.eval(reserveCostScript, []string{k}, 1000, 60, "r2", 1, 3), // 被在途预留占满
+				s.eval(reserveCostScript, []string{k}, 2000, 60, "r3", 2, 0), // r1 已过期，额度耗尽
+				s.eval(reserveCostScript, []string{k}, 2000, 60, "r4", 2, 10),
+				s.hash(k),
+				s.hasTTL(k),
+			}
+		},
+		want: []any{"3", "0", "-1", "2", map[string]string{"r4": "2:2060"}, true},
+	},
+	{
+		name:   "acquireScript",
+		script: acquireScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("slots")
+			s.do("ZADD", k, 1, "stale")
+			return []any{
+				s.eval(acquireScript, []string{k}, 1, 60, "a"),
+				s.eval(acquireScript, []string{k}, 1, 60, "b"),
+				s.eval(acquireScript, []string{k}, 1, 60, "a"),
+				s.members(k),
+				s.hasTTL(k),
+			}
+		},
+		want: []any{int64(1), int64(0), int64(1), []string{"a"}, true},
+	},
+	{
+		name:   "getCountScript",
+		script: getCountScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("slots")
+			s.do("ZADD", k, 1, "stale", s.nowSec(), "live")
+			return []any{s.eval(getCountScript, []string{k}, 60), s.members(k)}
+		},
+		want: []any{int64(1), []string{"live"}},
+	},
+	{
+		name:   "incrementWaitScript",
+		script: incrementWaitScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("wait")
+			return []any{
+				s.eval(incrementWaitScript, []string{k}, 2, 60),
+				s.eval(incrementWaitScript, []string{k}, 2, 60),
+				s.eval(incrementWaitScript, []string{k}, 2, 60),
+				s.do("GET", k),
+				s.hasTTL(k),
+			}
+		},
+		want: []any{int64(1), int64(1), int64(0), "2", true},
+	},
+	{
+		name:   "incrementAccountWaitScript",
+		script: incrementAccountWaitScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("wait")
+			s.do("SET", k, "1")
+			return []any{
+				s.eval(incrementAccountWaitScript, []string{k}, 2, 60),
+				s.eval(incrementAccountWaitScript, []string{k}, 2, 60),
+				s.do("GET", k),
+				s.hasTTL(k),
+			}
+		},
+		want: []any{int64(1), int64(0), "2", true},
+	},
+	{
+		name:   "decrementWaitScript",
+		script: decrementWaitScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("wait")
+			missing := s.eval(decrementWaitScript, []string{k})
+			exists := s.do("EXISTS", k)
+			s.do("SET", k, "1")
+			return []any{
+				missing,
+				exists,
+				s.eval(decrementWaitScript, []string{k}),
+				s.eval(decrementWaitScript, []string{k}),
+				s.do("GET", k),
+			}
+		},
+		want: []any{int64(1), int64(0), int64(1), int64(1), "0"},
+	},
+	{
+		name:   "getAccountsLoadBatchScript",
+		script: getAccountsLoadBatchScript,
+		run: func(s *scriptRunner) []any {
+			busy, idle := strconv.FormatInt(s.id, 10), strconv.FormatInt(s.id+1, 10)
+			s.do("ZADD", accountSlotKeyPrefix+busy, 1, "stale", s.nowSec(), "r1", s.nowSec(), "r2")
+			s.do("SET", accountWaitKeyPrefix+busy, "1")
+			return []any{s.eval(getAccountsLoadBatchScript, []string{}, 60, busy, 4, idle, 0)}
+		},
+		want: []any{[]any{"$id", int64(2), int64(1), int64(75), "$id+1", int64(0), int64(0), int64(0)}},
+	},
+	{
+		name:   "getUsersLoadBatchScript",
+		script: getUsersLoadBatchScript,
+		run: func(s *scriptRunner) []any {
+			busy, idle := strconv.FormatInt(s.id, 10), strconv.FormatInt(s.id+1, 10)
+			s.do("ZADD", userSlotKeyPrefix+busy, 1, "stale", s.nowSec(), "r1")
+			s.do("SET", waitQueueKeyPrefix+busy, "2")
+			return []any{s.eval(getUsersLoadBatchScript, []string{}, 60, busy, 2, idle, 3)}
+		},
+		want: []any{[]any{"$id", int64(1), int64(2), int64(150), "$id+1", int64(0), int64(0), int64(0)}},
+	},
+	{
+		name:   "cleanupExpiredSlotsScript",
+		script: cleanupExpiredSlotsScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("slots")
+			s.do("ZADD", k, 1, "stale1", 2, "stale2", s.nowSec(), "live")
+			return []any{s.eval(cleanupExpiredSlotsScript, []string{k}, 60), s.members(k)}
+		},
+		want: []any{int64(2), []string{"live"}},
+	},
+	{
+		name:   "fairQueueEnqueueScript",
+		script: fairQueueEnqueueScript,
+		run: func(s *scriptRunner) []any {
+			queue, hb, users, state, groups, account := s.key("queue"), s.key("hb"), s.key("users"), s.key("state"), s.key("groups"), s.key("account")
+			keys := []string{queue, hb, users, state, groups, account}
+			s.do("HSET", users, "u9", "7") // 队列为空时清除历史结束标签
+			return []any{
+				s.eval(fairQueueEnqueueScript, keys, "1:7:a", "u1", 1, 60000, "g", 3600),
+				s.eval(fairQueueEnqueueScript, keys, "2:7:b", "u1", 1, 60000, "g", 3600),
+				s.eval(fairQueueEnqueueScript, keys, "3:8:c", "u2", 2, 60000, "g", 3600),
+				s.members(queue),
+				s.hash(users),
+				s.do("ZCARD", account),
+				s.do("SMEMBERS", groups),
+				s.hasTTL(queue),
+			}
+		},
+		want: []any{int64(1), int64(1), int64(1), []string{"1:7:a", "3:8:c", "2:7:b"}, map[string]string{"u1": "2", "u2": "0.5"}, int64(3), []any{"g"}, true},
+	},
+	{
+		name:   "fairQueuePositionScript",
+		script: fairQueuePositionScript,
+		run: func(s *scriptRunner) []any {
+			queue, hb, state, account := s.key("queue"), s.key("hb"), s.key("state"), s.key("account")
+			keys := []string{queue, hb, state, account}
+			now := s.nowMs()
+			s.do("ZADD", queue, 0, "0:7:stale", 0, "1:7:a", 0, "2:8:b", 1, "3:7:c")
+			s.do("ZADD", hb, 1, "0:7:stale", now, "1:7:a", now, "2:8:b", now, "3:7:c")
+			s.do("HSET", state, "interval_ms", "250.7")
+			return []any{
+				s.eval(fairQueuePositionScript, keys, "3:7:c", 60000, "7"),
+				s.eval(fairQueuePositionScript, keys, "9:7:z", 60000, "7"),
+				s.members(account),
+			}
+		},
+		want: []any{[]any{int64(2), int64(3), int64(250), int64(1)}, []any{int64(-1), int64(3), int64(250), int64(0)}, []string{"3:7:c"}},
+	},
+	{
+		name:   "fairQueueAdmitScript",
+		script: fairQueueAdmitScript,
+		run: func(s *scriptRunner) []any {
+			queue, hb, state, account := s.key("queue"), s.key("hb"), s.key("state"), s.key("account")
+			keys := []string{queue, hb, state, account}
+			s.do("ZADD", queue, 5, "1:7:a", 3, "2:7:b")
+			s.do("ZADD", hb, s.nowMs(), "1:7:a", s.nowMs(), "2:7:b")
+			s.do("ZADD", account, s.nowMs(), "1:7:a")
+			// last_admit_ms 远早于当前时间，间隔样本按上限 1000 计入 EWMA：0.8*500 + 0.2*1000
+			s.do("HSET", state, "clock", "2", "last_admit_ms", "0", "interval_ms", "500")
+			admitted := s.eval(fairQueueAdmitScript, keys, "1:7:a", 1000, 3600)
+			clock, interval := s.do("HGET", state, "clock"), s.do("HGET", state, "interval_ms")
+			behind := s.eval(fairQueueAdmitScript, keys, "2:7:b", 1000, 3600) // 起始标签落后于时钟，不回拨
+			return []any{
+				admitted,
+				clock,
+				interval,
+				behind,
+				s.do("HGET", state, "clock"),
+				s.eval(fairQueueAdmitScript, keys, "9:7:z", 1000, 3600),
+				s.members(queue),
+				s.members(hb),
+				s.members(account),
+				s.hasTTL(state),
+			}
+		},
+		want: []any{int64(1), "5", "600", int64(1), "5", int64(0), []string{}, []string{}, []string{}, true},
+	},
+	{
+		name:   "fairQueueDepthScript",
+		script: fairQueueDepthScript,
+		run: func(s *scriptRunner) []any {
+			queue, hb, state := s.key("queue"), s.key("hb"), s.key("state")
+			now := s.nowMs()
+			s.do("ZADD", queue, 0, "0:7:stale", 0, "1:7:a", 1, "2:8:b")
+			s.do("ZADD", hb, 1, "0:7:stale", now, "1:7:a", now, "2:8:b")
+			s.do("HSET", state, "interval_ms", "99.9")
+			return []any{s.eval(fairQueueDepthScript, []string{queue, hb, state}, 60000), s.members(hb)}
+		},
+		want: []any{[]any{int64(2), int64(99)}, []string{"1:7:a", "2:8:b"}},
+	},
+	{
+		name:   "fairQueueAccountWaitingScript",
+		script: fairQueueAccountWaitingScript,
+		run: func(s *scriptRunner) []any {
+			account := s.key("account")
+			s.do("ZADD", account, 1, "0:7:stale", s.nowMs(), "1:7:a")
+			return []any{s.eval(fairQueueAccountWaitingScript, []string{account}, 60000), s.members(account)}
+		},
+		want: []any{int64(1), []string{"1:7:a"}},
+	},
+	{
+		name:   "registerSessionScript",
+		script: registerSessionScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("sessions")
+			s.do("ZADD", k, 1, "stale")
+			return []any{
+				s.eval(registerSessionScript, []string{k}, 1, 300, "s1"),
+				s.eval(registerSessionScript, []string{k}, 1, 300, "s2"),
+				s.eval(registerSessionScript, []string{k}, 1, 300, "s1"),
+				s.members(k),
+				s.hasTTL(k),
+			}
+		},
+		want: []any{int64(1), int64(0), int64(1), []string{"s1"}, true},
+	},
+	{
+		name:   "refreshSessionScript",
+		script: refreshSessionScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("sessions")
+			s.do("ZADD", k, 1, "s1")
+			refreshed := s.eval(refreshSessionScript, []string{k}, 300, "s1")
+			score, err := s.rdb.ZScore(s.ctx, k, "s1").Result()
+			require.NoError(s.t, err)
+			return []any{refreshed, s.eval(refreshSessionScript, []string{k}, 300, "s2"), score > 1, s.members(k), s.hasTTL(k)}
+		},
+		want: []any{int64(1), int64(1), true, []string{"s1"}, true},
+	},
+	{
+		name:   "getActiveSessionCountScript",
+		script: getActiveSessionCountScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("sessions")
+			s.do("ZADD", k, 1, "stale", s.nowSec(), "live")
+			return []any{s.eval(getActiveSessionCountScript, []string{k}, 300), s.members(k)}
+		},
+		want: []any{int64(1), []string{"live"}},
+	},
+	{
+		name:   "isSessionActiveScript",
+		script: isSessionActiveScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("sessions")
+			s.do("ZADD", k, 1, "stale", s.nowSec(), "live")
+			return []any{
+				s.eval(isSessionActiveScript, []string{k}, 300, "live"),
+				s.eval(isSessionActiveScript, []string{k}, 300, "stale"),
+				s.eval(isSessionActiveScript, []string{k}, 300, "missing"),
+			}
+		},
+		want: []any{int64(1), int64(0), int64(0)},
+	},
+	{
+		name:   "tempUnschedSetScript",
+		script: tempUnschedSetScript,
+		run: func(s *scriptRunner) []any {
+			k, garbage := s.key("temp"), s.key("garbage")
+			s.do("SET", garbage, "not-json")
+			return []any{
+				s.eval(tempUnschedSetScript, []string{k}, 100, `{"until_unix":100}`, 60),
+				s.eval(tempUnschedSetScript, []string{k}, 50, `{"until_unix":50}`, 60), // 只延长不缩短
+				s.eval(tempUnschedSetScript, []string{k}, 200, `{"until_unix":200}`, 60),
+				s.do("GET", k),
+				s.hasTTL(k),
+				s.eval(tempUnschedSetScript, []string{garbage}, 10, `{"until_unix":10}`, 60),
+				s.do("GET", garbage),
+			}
+		},
+		want: []any{int64(1), int64(0), int64(1), `{"until_unix":200}`, true, int64(1), `{"until_unix":10}`},
+	},
+	{
+		name:   "timeoutCounterIncrScript",
+		script: timeoutCounterIncrScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("timeouts")
+			return []any{
+				s.eval(timeoutCounterIncrScript, []string{k}, 60),
+				s.eval(timeoutCounterIncrScript, []string{k}, 60),
+				s.hasTTL(k),
+			}
+		},
+		want: []any{int64(1), int64(2), true},
+	},
+	{
+		name:   "service.OpsLeaderLockReleaseScript",
+		script: service.OpsLeaderLockReleaseScript,
+		run: func(s *scriptRunner) []any {
+			k := s.key("leader")
+			s.do("SET", k, "instance-a")
+			return []any{
+				s.eval(service.OpsLeaderLockReleaseScript, []string{k}, "instance-b"),
+				s.do("GET", k),
+				s.eval(service.OpsLeaderLockReleaseScript, []string{k}, "instance-a"),
+				s.do("EXISTS", k),
+			}
+		},
+		want: []any{int64(0), "instance-a", int64(1), int64(0)},
+	},
+}
+
+// runRedisScriptCases 在给定后端上逐个运行脚本用例
+func runRedisScriptCases(t *testing.T, rdb *redis.Client) {
+	base := time.Now().UnixNano() / 1000 * 1000
+	for i, tc := range redisScriptCases {
+		id := base + int64(i)*10
+		t.Run(tc.name, func(t *testing.T) {
+			s := &scriptRunner{t: t, ctx: context.Background(), rdb: rdb, ns: "script-case:" + strconv.FormatInt(id, 10) + ":", id: id}
+			want := make([]any, len(tc.want))
+			for j, v := range tc.want {
+				want[j] = expandScriptCaseID(v, id)
+			}
+			require.Equal(t, want, tc.run(s))
+		})
+	}
+}
+
+// expandScriptCaseID 将期望值中的 $id 占位符替换为本次运行的数字 ID
+func expandScriptCaseID(v any, id int64) any {
+	switch x := v.(type) {
+	case string:
+		switch x {
+		case "$id":
+			return strconv.FormatInt(id, 10)
+		case "$id+1":
+			return strconv.FormatInt(id+1, 10)
+		}
+	case []any:
+		out := make([]any, len(x))
+		for i, item := range x {
+			out[i] = expandScriptCaseID(item, id)
+		}
+		return out
+	}
+	return v
+}
+
+func TestRedisScriptCases_Embedded(t *testing.T) {
+	client := redis.NewClient(buildRedisOptions(&config.Config{Redis: config.RedisConfig{Mode: config.RedisModeEmbedded}}))
+	defer func() { _ = client.Close() }()
+	runRedisScriptCases(t, client)
+}
+
+// TestRedisScriptCases_CoverAllScripts 本包新增 Lua 脚本时必须同时补充用例（并在内置后端注册 Go 实现）
+func TestRedisScriptCases_CoverAllScripts(t *testing.T) {
+	covered := make(map[string]bool, len(redisScriptCases))
+	for _, tc := range redisScriptCases {
+		covered[tc.name] = true
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	var scripts []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+					for i, value := range vs.Values {
+						if isRedisNewScriptCall(value) {
+							scripts = append(scripts, vs.Names[i].Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	require.NotEmpty(t, scripts)
+	for _, name := range scripts {
+		require.True(t, covered[name], "script %s has no case in redisScriptCases", name)
+	}
+}
+
+func isRedisNewScriptCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "NewScript" {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "redis"
+}
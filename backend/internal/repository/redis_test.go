@@ -1,10 +1,13 @@
 package repository
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +48,107 @@ func TestBuildRedisOptions(t *testing.T) {
 	require.NotNil(t, optsTLS.TLSConfig)
 	require.Equal(t, "localhost", optsTLS.TLSConfig.ServerName)
 }
+
+func TestBuildRedisOptionsEmbedded(t *testing.T) {
+	cfg := &config.Config{
+		Redis: config.RedisConfig{
+			Mode:                config.RedisModeEmbedded,
+			Host:                "ignored",
+			Password:            "ignored",
+			EnableTLS:           true,
+			DialTimeoutSeconds:  5,
+			ReadTimeoutSeconds:  3,
+			WriteTimeoutSeconds: 3,
+			PoolSize:            16,
+		},
+	}
+
+	opts := buildRedisOptions(cfg)
+	require.Equal(t, "embedded", opts.Addr)
+	require.Empty(t, opts.Password)
+	require.Nil(t, opts.TLSConfig)
+	require.NotNil(t, opts.Dialer)
+
+	client := redis.NewClient(opts)
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+	require.NoError(t, client.Set(ctx, "embedded:ping", "1", time.Minute).Err())
+	require.Equal(t, "1", client.Get(ctx, "embedded:ping").Val())
+}
+
+func TestEmbeddedReserveCostRequiresFullAmount(t *testing.T) {
+	client := redis.NewClient(buildRedisOptions(&config.Config{Redis: config.RedisConfig{Mode: config.RedisModeEmbedded}}))
+	defer func() { _ = client.Close() }()
+	cache := NewBillingCache(client)
+	ctx := context.Background()
+	userID := time.Now().UnixNano()
+
+	reserved, err := cache.ReserveCost(ctx, userID, 0, "r1", 0.8, 1.0, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 0.8, reserved)
+
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r2", 0.5, 1.0, time.Minute)
+	require.NoError(t, err)
+	require.Zero(t, reserved, "remaining 0.2 should not admit a 0.5 reservation")
+
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r3", 0.1, 1.0, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 0.1, reserved)
+}
+
+func TestEmbeddedReserveCostCapsWithoutHolds(t *testing.T) {
+	client := redis.NewClient(buildRedisOptions(&config.Config{Redis: config.RedisConfig{Mode: config.RedisModeEmbedded}}))
+	defer func() { _ = client.Close() }()
+	cache := NewBillingCache(client)
+	ctx := context.Background()
+	userID := time.Now().UnixNano()
+
+	reserved, err := cache.ReserveCost(ctx, userID, 0, "r1", 5, 0.3, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 0.3, reserved, "a request with nothing in flight should reserve up to the available amount")
+
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r2", 0.1, 0.3, time.Minute)
+	require.NoError(t, err)
+	require.Zero(t, reserved, "an in-flight hold should block further reservations")
+
+	require.NoError(t, cache.ReleaseCostReservation(ctx, userID, 0, "r1"))
+	reserved, err = cache.ReserveCost(ctx, userID, 0, "r3", 0.1, 0, time.Minute)
+	require.NoError(t, err)
+	require.Negative(t, reserved, "an exhausted balance with nothing in flight is not retryable")
+}
+
+func TestEmbeddedFairQueueAheadSameAccount(t *testing.T) {
+	client := redis.NewClient(buildRedisOptions(&config.Config{Redis: config.RedisConfig{Mode: config.RedisModeEmbedded}}))
+	defer func() { _ = client.Close() }()
+	cache := NewFairQueueCache(client)
+	ctx := context.Background()
+	groupID := time.Now().UnixNano()
+	accountA, accountB := groupID+1, groupID+2
+	ttl := time.Minute
+
+	ticketA1 := fmt.Sprintf("%019d:%d:x", 1, accountA)
+	ticketA2 := fmt.Sprintf("%019d:%d:y", 2, accountA)
+	ticketB := fmt.Sprintf("%019d:%d:z", 3, accountB)
+	require.NoError(t, cache.Enqueue(ctx, groupID, accountA, 1, 1, ticketA1, ttl))
+	require.NoError(t, cache.Enqueue(ctx, groupID, accountA, 2, 1, ticketA2, ttl))
+	require.NoError(t, cache.Enqueue(ctx, groupID, accountB, 3, 1, ticketB, ttl))
+
+	pos, err := cache.Position(ctx, groupID, accountB, ticketB, ttl)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pos.Rank)
+	require.Zero(t, pos.AheadSameAccount)
+
+	pos, err = cache.Position(ctx, groupID, accountA, ticketA2, ttl)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pos.AheadSameAccount)
+
+	waiting, err := cache.AccountWaiting(ctx, accountA, ttl)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), waiting)
+
+	require.NoError(t, cache.Admit(ctx, groupID, accountA, ticketA1))
+	require.NoError(t, cache.Leave(ctx, groupID, accountA, ticketA2))
+	waiting, err = cache.AccountWaiting(ctx, accountA, ttl)
+	require.NoError(t, err)
+	require.Zero(t, waiting)
+}
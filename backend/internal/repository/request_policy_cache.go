@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	requestPolicyCacheKey  = "request_policies"
+	requestPolicyPubSubKey = "request_policies_updated"
+)
+
+// NewRequestPolicyCache 创建请求参数策略缓存
+func NewRequestPolicyCache(rdb *redis.Client) service.RequestPolicyCache {
+	return newRuleListCache[model.RequestPolicy](rdb, "RequestPolicyCache", requestPolicyCacheKey, requestPolicyPubSubKey)
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/requestpolicy"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type requestPolicyRepository struct {
+	client *ent.Client
+}
+
+// NewRequestPolicyRepository 创建请求参数策略仓库
+func NewRequestPolicyRepository(client *ent.Client) service.RequestPolicyRepository {
+	return &requestPolicyRepository{client: client}
+}
+
+// List 获取所有策略
+func (r *requestPolicyRepository) List(ctx context.Context) ([]*model.RequestPolicy, error) {
+	policies, err := r.client.RequestPolicy.Query().
+		Order(ent.Asc(requestpolicy.FieldPriority), ent.Asc(requestpolicy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.RequestPolicy, len(policies))
+	for i, policy := range policies {
+		result[i] = r.toModel(policy)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取策略
+func (r *requestPolicyRepository) GetByID(ctx context.Context, id int64) (*model.RequestPolicy, error) {
+	policy, err := r.client.RequestPolicy.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(policy), nil
+}
+
+// Create 创建策略
+func (r *requestPolicyRepository) Create(ctx context.Context, policy *model.RequestPolicy) (*model.RequestPolicy, error) {
+	builder := r.client.RequestPolicy.Create().
+		SetName(policy.Name).
+		SetEnabled(policy.Enabled).
+		SetPriority(policy.Priority).
+		SetKind(policy.Kind).
+		SetAction(policy.Action).
+		SetDryRun(policy.DryRun)
+
+	if len(policy.GroupIDs) > 0 {
+		builder.SetGroupIds(policy.GroupIDs)
+	}
+	if len(policy.Protocols) > 0 {
+		builder.SetProtocols(policy.Protocols)
+	}
+	if len(policy.Params) > 0 {
+		builder.SetParams(policy.Params)
+	}
+	if policy.LimitValue != nil {
+		builder.SetLimitValue(*policy.LimitValue)
+	}
+	if policy.RejectMessage != nil {
+		builder.SetRejectMessage(*policy.RejectMessage)
+	}
+	if policy.Description != nil {
+		builder.SetDescription(*policy.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新策略
+func (r *requestPolicyRepository) Update(ctx context.Context, policy *model.RequestPolicy) (*model.RequestPolicy, error) {
+	builder := r.client.RequestPolicy.UpdateOneID(policy.ID).
+		SetName(policy.Name).
+		SetEnabled(policy.Enabled).
+		SetPriority(policy.Priority).
+		SetKind(policy.Kind).
+		SetAction(policy.Action).
+		SetDryRun(policy.DryRun)
+
+	// 处理可选字段
+	if len(policy.GroupIDs) > 0 {
+		builder.SetGroupIds(policy.GroupIDs)
+	} else {
+		builder.ClearGroupIds()
+	}
+	if len(policy.Protocols) > 0 {
+		builder.SetProtocols(policy.Protocols)
+	} else {
+		builder.ClearProtocols()
+	}
+	if len(policy.Params) > 0 {
+		builder.SetParams(policy.Params)
+	} else {
+		builder.ClearParams()
+	}
+	if policy.LimitValue != nil {
+		builder.SetLimitValue(*policy.LimitValue)
+	} else {
+		builder.ClearLimitValue()
+	}
+	if policy.RejectMessage != nil {
+		builder.SetRejectMessage(*policy.RejectMessage)
+	} else {
+		builder.ClearRejectMessage()
+	}
+	if policy.Description != nil {
+		builder.SetDescription(*policy.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除策略
+func (r *requestPolicyRepository) Delete(ctx context.Context, id int64) error {
+	return r.client.RequestPolicy.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *requestPolicyRepository) toModel(e *ent.RequestPolicy) *model.RequestPolicy {
+	policy := &model.RequestPolicy{
+		ID:            e.ID,
+		Name:          e.Name,
+		Enabled:       e.Enabled,
+		Priority:      e.Priority,
+		GroupIDs:      e.GroupIds,
+		Protocols:     e.Protocols,
+		Kind:          e.Kind,
+		Action:        e.Action,
+		DryRun:        e.DryRun,
+		LimitValue:    e.LimitValue,
+		Params:        e.Params,
+		RejectMessage: e.RejectMessage,
+		Description:   e.Description,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if policy.GroupIDs == nil {
+		policy.GroupIDs = []int64{}
+	}
+	if policy.Protocols == nil {
+		policy.Protocols = []string{}
+	}
+	if policy.Params == nil {
+		policy.Params = []string{}
+	}
+
+	return policy
+}
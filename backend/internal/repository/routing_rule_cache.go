@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	routingRuleCacheKey  = "routing_rules"
+	routingRulePubSubKey = "routing_rules_updated"
+)
+
+// NewRoutingRuleCache 创建请求路由规则缓存
+func NewRoutingRuleCache(rdb *redis.Client) service.RoutingRuleCache {
+	return newRuleListCache[model.RoutingRule](rdb, "RoutingRuleCache", routingRuleCacheKey, routingRulePubSubKey)
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/routingrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type routingRuleRepository struct {
+	client *ent.Client
+}
+
+// NewRoutingRuleRepository 创建请求路由规则仓库
+func NewRoutingRuleRepository(client *ent.Client) service.RoutingRuleRepository {
+	return &routingRuleRepository{client: client}
+}
+
+// List 获取所有规则
+func (r *routingRuleRepository) List(ctx context.Context) ([]*model.RoutingRule, error) {
+	rules, err := r.client.RoutingRule.Query().
+		Order(ent.Asc(routingrule.FieldPriority), ent.Asc(routingrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.RoutingRule, len(rules))
+	for i, rule := range rules {
+		result[i] = r.toModel(rule)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取规则
+func (r *routingRuleRepository) GetByID(ctx context.Context, id int64) (*model.RoutingRule, error) {
+	rule, err := r.client.RoutingRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(rule), nil
+}
+
+// Create 创建规则
+func (r *routingRuleRepository) Create(ctx context.Context, rule *model.RoutingRule) (*model.RoutingRule, error) {
+	builder := clientFromContext(ctx, r.client).RoutingRule.Create().
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetRequireNoTools(rule.RequireNoTools)
+
+	if len(rule.GroupIDs) > 0 {
+		builder.SetGroupIds(rule.GroupIDs)
+	}
+	if len(rule.ModelPatterns) > 0 {
+		builder.SetModelPatterns(rule.ModelPatterns)
+	}
+	if rule.MaxTokensBelow != nil {
+		builder.SetMaxTokensBelow(*rule.MaxTokensBelow)
+	}
+	if rule.InputTokensBelow != nil {
+		builder.SetInputTokensBelow(*rule.InputTokensBelow)
+	}
+	if rule.TargetGroupID != nil {
+		builder.SetTargetGroupID(*rule.TargetGroupID)
+	}
+	if len(rule.TargetAccountIDs) > 0 {
+		builder.SetTargetAccountIds(rule.TargetAccountIDs)
+	}
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新规则
+func (r *routingRuleRepository) Update(ctx context.Context, rule *model.RoutingRule) (*model.RoutingRule, error) {
+	builder := clientFromContext(ctx, r.client).RoutingRule.UpdateOneID(rule.ID).
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetRequireNoTools(rule.RequireNoTools)
+
+	// 处理可选字段
+	if len(rule.GroupIDs) > 0 {
+		builder.SetGroupIds(rule.GroupIDs)
+	} else {
+		builder.ClearGroupIds()
+	}
+	if len(rule.ModelPatterns) > 0 {
+		builder.SetModelPatterns(rule.ModelPatterns)
+	} else {
+		builder.ClearModelPatterns()
+	}
+	if rule.MaxTokensBelow != nil {
+		builder.SetMaxTokensBelow(*rule.MaxTokensBelow)
+	} else {
+		builder.ClearMaxTokensBelow()
+	}
+	if rule.InputTokensBelow != nil {
+		builder.SetInputTokensBelow(*rule.InputTokensBelow)
+	} else {
+		builder.ClearInputTokensBelow()
+	}
+	if rule.TargetGroupID != nil {
+		builder.SetTargetGroupID(*rule.TargetGroupID)
+	} else {
+		builder.ClearTargetGroupID()
+	}
+	if len(rule.TargetAccountIDs) > 0 {
+		builder.SetTargetAccountIds(rule.TargetAccountIDs)
+	} else {
+		builder.ClearTargetAccountIds()
+	}
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除规则
+func (r *routingRuleRepository) Delete(ctx context.Context, id int64) error {
+	return clientFromContext(ctx, r.client).RoutingRule.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *routingRuleRepository) toModel(e *ent.RoutingRule) *model.RoutingRule {
+	rule := &model.RoutingRule{
+		ID:               e.ID,
+		Name:             e.Name,
+		Enabled:          e.Enabled,
+		Priority:         e.Priority,
+		GroupIDs:         e.GroupIds,
+		ModelPatterns:    e.ModelPatterns,
+		MaxTokensBelow:   e.MaxTokensBelow,
+		RequireNoTools:   e.RequireNoTools,
+		InputTokensBelow: e.InputTokensBelow,
+		TargetGroupID:    e.TargetGroupID,
+		TargetAccountIDs: e.TargetAccountIds,
+		Description:      e.Description,
+		CreatedAt:        e.CreatedAt,
+		UpdatedAt:        e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.ModelPatterns == nil {
+		rule.ModelPatterns = []string{}
+	}
+	if rule.TargetAccountIDs == nil {
+		rule.TargetAccountIDs = []int64{}
+	}
+
+	return rule
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ruleListCacheTTL 规则列表在 Redis 中的过期时间
+const ruleListCacheTTL = 24 * time.Hour
+
+// ruleListCache 管理后台维护的规则列表缓存（请求策略、模型价格、内容护栏、客户端准入与路由规则）
+//
+// 全量列表以 JSON 存入 Redis 并在进程内保留一份；写操作后通过 Pub/Sub 通知其他实例刷新。
+type ruleListCache[T any] struct {
+	rdb       *redis.Client
+	name      string // 日志前缀
+	cacheKey  string
+	pubSubKey string
+
+	localCache []*T
+	localMu    sync.RWMutex
+}
+
+func newRuleListCache[T any](rdb *redis.Client, name, cacheKey, pubSubKey string) *ruleListCache[T] {
+	return &ruleListCache[T]{
+		rdb:       rdb,
+		name:      name,
+		cacheKey:  cacheKey,
+		pubSubKey: pubSubKey,
+	}
+}
+
+// Get 从缓存获取规则列表
+func (c *ruleListCache[T]) Get(ctx context.Context) ([]*T, bool) {
+	// 先检查本地缓存
+	c.localMu.RLock()
+	if c.localCache != nil {
+		items := c.localCache
+		c.localMu.RUnlock()
+		return items, true
+	}
+	c.localMu.RUnlock()
+
+	// 从 Redis 获取
+	data, err := c.rdb.Get(ctx, c.cacheKey).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("[%s] Failed to get from Redis: %v", c.name, err)
+		}
+		return nil, false
+	}
+
+	var items []*T
+	if err := json.Unmarshal(data, &items); err != nil {
+		log.Printf("[%s] Failed to unmarshal: %v", c.name, err)
+		return nil, false
+	}
+
+	c.setLocal(items)
+	return items, true
+}
+
+// Set 设置缓存
+func (c *ruleListCache[T]) Set(ctx context.Context, items []*T) error {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	if err := c.rdb.Set(ctx, c.cacheKey, data, ruleListCacheTTL).Err(); err != nil {
+		return err
+	}
+	c.setLocal(items)
+	return nil
+}
+
+// Invalidate 使缓存失效
+func (c *ruleListCache[T]) Invalidate(ctx context.Context) error {
+	c.setLocal(nil)
+	return c.rdb.Del(ctx, c.cacheKey).Err()
+}
+
+// NotifyUpdate 通知其他实例刷新缓存
+func (c *ruleListCache[T]) NotifyUpdate(ctx context.Context) error {
+	return c.rdb.Publish(ctx, c.pubSubKey, "refresh").Err()
+}
+
+// SubscribeUpdates 订阅缓存更新通知
+func (c *ruleListCache[T]) SubscribeUpdates(ctx context.Context, handler func()) {
+	go func() {
+		sub := c.rdb.Subscribe(ctx, c.pubSubKey)
+		defer func() { _ = sub.Close() }()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-ch:
+				if msg == nil {
+					return
+				}
+				// 清除本地缓存，下次访问时会从 Redis 或数据库重新加载
+				c.setLocal(nil)
+				handler()
+			}
+		}
+	}()
+}
+
+func (c *ruleListCache[T]) setLocal(items []*T) {
+	c.localMu.Lock()
+	c.localCache = items
+	c.localMu.Unlock()
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedRuleListCacheSharesAcrossInstances(t *testing.T) {
+	client := redis.NewClient(buildRedisOptions(&config.Config{Redis: config.RedisConfig{Mode: config.RedisModeEmbedded}}))
+	defer func() { _ = client.Close() }()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	writer := newRuleListCache[model.RequestPolicy](client, "test", "rules:"+suffix, "rules_updated:"+suffix)
+	reader := newRuleListCache[model.RequestPolicy](client, "test", "rules:"+suffix, "rules_updated:"+suffix)
+
+	_, ok := reader.Get(ctx)
+	require.False(t, ok)
+
+	require.NoError(t, writer.Set(ctx, []*model.RequestPolicy{{ID: 1, Name: "cap"}}))
+	items, ok := reader.Get(ctx)
+	require.True(t, ok)
+	require.Len(t, items, 1)
+	require.Equal(t, "cap", items[0].Name)
+
+	notified := make(chan struct{}, 1)
+	reader.SubscribeUpdates(ctx, func() { notified <- struct{}{} })
+
+	// 订阅是异步建立的，重复发布直到收到通知
+	require.NoError(t, writer.Invalidate(ctx))
+	require.Eventually(t, func() bool {
+		_ = writer.NotifyUpdate(ctx)
+		select {
+		case <-notified:
+			return true
+		default:
+			return false
+		}
+	}, 2*time.Second, 20*time.Millisecond)
+
+	_, ok = reader.Get(ctx)
+	require.False(t, ok, "通知后丢弃本地副本，重新读取 Redis")
+}
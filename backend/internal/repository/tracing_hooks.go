@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/intercept"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// installEntTracing 为 Ent 查询与变更创建 db span
+// 原生 SQL 仓储直接使用 *sql.DB，不在此覆盖范围内
+func installEntTracing(client *ent.Client) {
+	client.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, query ent.Query) (ent.Value, error) {
+			name := "db.query"
+			if q, err := intercept.NewQuery(query); err == nil {
+				name += " " + q.Type()
+			}
+			ctx, span := tracing.Start(ctx, name, attribute.String("db.system", "postgresql"))
+			value, err := next.Query(ctx, query)
+			tracing.End(span, err)
+			return value, err
+		})
+	}))
+	client.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ctx, span := tracing.Start(ctx, "db.mutate "+m.Type()+" "+m.Op().String(), attribute.String("db.system", "postgresql"))
+			value, err := next.Mutate(ctx, m)
+			tracing.End(span, err)
+			return value, err
+		})
+	})
+}
+
+// redisTracingHook 为 Redis 命令与 pipeline 创建 span
+type redisTracingHook struct{}
+
+func (redisTracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		ctx, span := tracing.Start(ctx, "redis.dial", attribute.String("server.address", addr))
+		conn, err := next(ctx, network, addr)
+		tracing.End(span, err)
+		return conn, err
+	}
+}
+
+func (redisTracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := tracing.Start(ctx, "redis."+cmd.Name(), attribute.String("db.system", "redis"))
+		err := next(ctx, cmd)
+		tracing.End(span, ignoreRedisNil(err))
+		return err
+	}
+}
+
+func (redisTracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		names := make([]string, 0, len(cmds))
+		for _, cmd := range cmds {
+			names = append(names, cmd.Name())
+		}
+		ctx, span := tracing.Start(ctx, "redis.pipeline",
+			attribute.String("db.system", "redis"),
+			attribute.String("db.operation.name", strings.Join(names, " ")),
+			attribute.Int("db.operation.batch.size", len(cmds)),
+		)
+		err := next(ctx, cmds)
+		tracing.End(span, ignoreRedisNil(err))
+		return err
+	}
+}
+
+// ignoreRedisNil 键不存在属于正常结果，不应标记为失败
+func ignoreRedisNil(err error) error {
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
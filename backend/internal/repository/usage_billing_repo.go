@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// NewUsageBillingRepository 创建计费出队使用的批量写入仓储
+func NewUsageBillingRepository(client *dbent.Client, sqlDB *sql.DB) service.UsageBillingRepository {
+	return newUsageLogRepositoryWithSQL(client, sqlDB)
+}
+
+type usageLogInsertKey struct {
+	requestID string
+	apiKeyID  int64
+}
+
+// BatchCreateUsageLogs 使用单条多行 INSERT 批量写入使用日志
+//
+// 依赖 (request_id, api_key_id) 唯一约束去重，返回值与 logs 一一对应，
+// 表示该条日志是否为本次新插入（重复投递的日志返回 false）。
+func (r *usageLogRepository) BatchCreateUsageLogs(ctx context.Context, logs []*service.UsageLog) (inserted []bool, err error) {
+	inserted = make([]bool, len(logs))
+	if len(logs) == 0 {
+		return inserted, nil
+	}
+
+	sqlq := r.sql
+	if tx := dbent.TxFromContext(ctx); tx != nil {
+		sqlq = tx.Client()
+	}
+
+	var query strings.Builder
+	query.WriteString("INSERT INTO usage_logs (" + usageLogInsertColumns + ") VALUES ")
+	args := make([]any, 0, len(logs)*usageLogInsertColumnCount)
+	// 带 request_id 的日志按唯一键回填；无 request_id 的日志不会冲突，按顺序回填
+	pending := make(map[usageLogInsertKey][]int, len(logs))
+	var anonymous []int
+	for i, log := range logs {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(" + usageLogInsertPlaceholders(len(args)) + ")")
+		args = append(args, usageLogInsertArgs(log)...)
+		if log.RequestID == "" {
+			anonymous = append(anonymous, i)
+			continue
+		}
+		key := usageLogInsertKey{requestID: log.RequestID, apiKeyID: log.APIKeyID}
+		pending[key] = append(pending[key], i)
+	}
+	query.WriteString(" ON CONFLICT (request_id, api_key_id) DO NOTHING RETURNING id, request_id, api_key_id, created_at")
+
+	rows, err := sqlq.QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+			inserted = nil
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			id        int64
+			requestID sql.NullString
+			apiKeyID  int64
+			createdAt time.Time
+		)
+		if err = rows.Scan(&id, &requestID, &apiKeyID, &createdAt); err != nil {
+			return nil, err
+		}
+		idx := -1
+		if !requestID.Valid || requestID.String == "" {
+			if len(anonymous) > 0 {
+				idx, anonymous = anonymous[0], anonymous[1:]
+			}
+		} else {
+			key := usageLogInsertKey{requestID: requestID.String, apiKeyID: apiKeyID}
+			if idxs := pending[key]; len(idxs) > 0 {
+				idx, pending[key] = idxs[0], idxs[1:]
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		inserted[idx] = true
+		logs[idx].ID = id
+		logs[idx].CreatedAt = createdAt
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return inserted, nil
+}
@@ -22,7 +22,72 @@ import (
 	"github.com/lib/pq"
 )
 
-const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, reasoning_effort, created_at"
+const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, reasoning_effort, requested_model, created_at"
+
+// usageLogInsertColumns 插入列，顺序与 usageLogInsertArgs 一致
+const usageLogInsertColumns = "user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, reasoning_effort, requested_model, created_at"
+
+const usageLogInsertColumnCount = 32
+
+// usageLogInsertPlaceholders 生成一行插入值的占位符，offset 为此前已占用的参数个数
+func usageLogInsertPlaceholders(offset int) string {
+	var b strings.Builder
+	for i := 1; i <= usageLogInsertColumnCount; i++ {
+		if i > 1 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "$%d", offset+i)
+	}
+	return b.String()
+}
+
+// usageLogInsertArgs 规范化 request_id 与 created_at 后返回插入参数
+func usageLogInsertArgs(log *service.UsageLog) []any {
+	if log.CreatedAt.IsZero() {
+		log.CreatedAt = time.Now()
+	}
+	log.RequestID = strings.TrimSpace(log.RequestID)
+
+	var requestIDArg any
+	if log.RequestID != "" {
+		requestIDArg = log.RequestID
+	}
+
+	return []any{
+		log.UserID,
+		log.APIKeyID,
+		log.AccountID,
+		requestIDArg,
+		log.Model,
+		nullInt64(log.GroupID),
+		nullInt64(log.SubscriptionID),
+		log.InputTokens,
+		log.OutputTokens,
+		log.CacheCreationTokens,
+		log.CacheReadTokens,
+		log.CacheCreation5mTokens,
+		log.CacheCreation1hTokens,
+		log.InputCost,
+		log.OutputCost,
+		log.CacheCreationCost,
+		log.CacheReadCost,
+		log.TotalCost,
+		log.ActualCost,
+		log.RateMultiplier,
+		log.AccountRateMultiplier,
+		log.BillingType,
+		log.Stream,
+		nullInt(log.DurationMs),
+		nullInt(log.FirstTokenMs),
+		nullString(log.UserAgent),
+		nullString(log.IPAddress),
+		log.ImageCount,
+		nullString(log.ImageSize),
+		nullString(log.ReasoningEffort),
+		nullString(log.RequestedModel),
+		log.CreatedAt,
+	}
+}
 
 type usageLogRepository struct {
 	client *dbent.Client
@@ -73,108 +138,17 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 		sqlq = tx.Client()
 	}
 
-	createdAt := log.CreatedAt
-	if createdAt.IsZero() {
-		createdAt = time.Now()
-	}
-
-	requestID := strings.TrimSpace(log.RequestID)
-	log.RequestID = requestID
-
+	args := usageLogInsertArgs(log)
+	requestID := log.RequestID
 	rateMultiplier := log.RateMultiplier
 
 	query := `
-		INSERT INTO usage_logs (
-			user_id,
-			api_key_id,
-			account_id,
-			request_id,
-			model,
-			group_id,
-			subscription_id,
-			input_tokens,
-			output_tokens,
-			cache_creation_tokens,
-			cache_read_tokens,
-			cache_creation_5m_tokens,
-			cache_creation_1h_tokens,
-			input_cost,
-			output_cost,
-			cache_creation_cost,
-			cache_read_cost,
-			total_cost,
-			actual_cost,
-			rate_multiplier,
-			account_rate_multiplier,
-			billing_type,
-			stream,
-			duration_ms,
-			first_token_ms,
-			user_agent,
-				ip_address,
-				image_count,
-				image_size,
-				reasoning_effort,
-				created_at
-			) VALUES (
-				$1, $2, $3, $4, $5,
-				$6, $7,
-				$8, $9, $10, $11,
-				$12, $13,
-				$14, $15, $16, $17, $18, $19,
-				$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31
-			)
-			ON CONFLICT (request_id, api_key_id) DO NOTHING
-			RETURNING id, created_at
-		`
-
-	groupID := nullInt64(log.GroupID)
-	subscriptionID := nullInt64(log.SubscriptionID)
-	duration := nullInt(log.DurationMs)
-	firstToken := nullInt(log.FirstTokenMs)
-	userAgent := nullString(log.UserAgent)
-	ipAddress := nullString(log.IPAddress)
-	imageSize := nullString(log.ImageSize)
-	reasoningEffort := nullString(log.ReasoningEffort)
-
-	var requestIDArg any
-	if requestID != "" {
-		requestIDArg = requestID
-	}
+		INSERT INTO usage_logs (` + usageLogInsertColumns + `)
+		VALUES (` + usageLogInsertPlaceholders(0) + `)
+		ON CONFLICT (request_id, api_key_id) DO NOTHING
+		RETURNING id, created_at
+	`
 
-	args := []any{
-		log.UserID,
-		log.APIKeyID,
-		log.AccountID,
-		requestIDArg,
-		log.Model,
-		groupID,
-		subscriptionID,
-		log.InputTokens,
-		log.OutputTokens,
-		log.CacheCreationTokens,
-		log.CacheReadTokens,
-		log.CacheCreation5mTokens,
-		log.CacheCreation1hTokens,
-		log.InputCost,
-		log.OutputCost,
-		log.CacheCreationCost,
-		log.CacheReadCost,
-		log.TotalCost,
-		log.ActualCost,
-		rateMultiplier,
-		log.AccountRateMultiplier,
-		log.BillingType,
-		log.Stream,
-		duration,
-		firstToken,
-		userAgent,
-		ipAddress,
-		log.ImageCount,
-		imageSize,
-		reasoningEffort,
-		createdAt,
-	}
 	if err := scanSingleRow(ctx, sqlq, query, args, &log.ID, &log.CreatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) && requestID != "" {
 			selectQuery := "SELECT id, created_at FROM usage_logs WHERE request_id = $1 AND api_key_id = $2"
@@ -2195,6 +2169,7 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		imageCount            int
 		imageSize             sql.NullString
 		reasoningEffort       sql.NullString
+		requestedModel        sql.NullString
 		createdAt             time.Time
 	)
 
@@ -2230,6 +2205,7 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		&imageCount,
 		&imageSize,
 		&reasoningEffort,
+		&requestedModel,
 		&createdAt,
 	); err != nil {
 		return nil, err
@@ -2292,6 +2268,9 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 	if reasoningEffort.Valid {
 		log.ReasoningEffort = &reasoningEffort.String
 	}
+	if requestedModel.Valid {
+		log.RequestedModel = &requestedModel.String
+	}
 
 	return log, nil
 }
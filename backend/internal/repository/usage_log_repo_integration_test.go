@@ -84,6 +84,26 @@ func (s *UsageLogRepoSuite) TestCreate() {
 	s.Require().NotZero(log.ID)
 }
 
+func (s *UsageLogRepoSuite) TestBatchCreateUsageLogs() {
+	user := mustCreateUser(s.T(), s.client, &service.User{Email: "batch@test.com"})
+	apiKey := mustCreateApiKey(s.T(), s.client, &service.APIKey{UserID: user.ID, Key: "sk-batch", Name: "k"})
+	account := mustCreateAccount(s.T(), s.client, &service.Account{Name: "acc-batch"})
+
+	newLog := func(requestID string) *service.UsageLog {
+		return &service.UsageLog{UserID: user.ID, APIKeyID: apiKey.ID, AccountID: account.ID, RequestID: requestID, Model: "claude-3", TotalCost: 1, ActualCost: 1}
+	}
+	_, err := s.repo.Create(s.ctx, newLog("batch-existing"))
+	s.Require().NoError(err, "Create")
+
+	logs := []*service.UsageLog{newLog("batch-1"), newLog("batch-existing"), newLog(""), newLog("batch-2")}
+	inserted, err := s.repo.BatchCreateUsageLogs(s.ctx, logs)
+	s.Require().NoError(err, "BatchCreateUsageLogs")
+	s.Require().Equal([]bool{true, false, true, true}, inserted)
+	s.Require().NotZero(logs[0].ID)
+	s.Require().NotZero(logs[2].ID)
+	s.Require().Zero(logs[1].ID)
+}
+
 func (s *UsageLogRepoSuite) TestGetByID() {
 	user := mustCreateUser(s.T(), s.client, &service.User{Email: "getbyid@test.com"})
 	apiKey := mustCreateApiKey(s.T(), s.client, &service.APIKey{UserID: user.ID, Key: "sk-getbyid", Name: "k"})
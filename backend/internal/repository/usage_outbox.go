@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	usageOutboxStreamKey     = "usage:events"
+	usageOutboxDeadStreamKey = "usage:events:dead"
+	usageOutboxGroup         = "usage-billing"
+	usageOutboxPayloadField  = "payload"
+	// 死信流只保留最近的记录，避免无限增长
+	usageOutboxDeadMaxLen = 10000
+)
+
+type usageOutbox struct {
+	rdb *redis.Client
+}
+
+// NewUsageOutbox 创建基于 Redis Stream 的用量事件流
+// 已确认的消息会立即删除，因此流长度即为积压量。
+func NewUsageOutbox(rdb *redis.Client) service.UsageOutbox {
+	return &usageOutbox{rdb: rdb}
+}
+
+func isRedisNoGroupErr(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "NOGROUP")
+}
+
+func (o *usageOutbox) EnsureGroup(ctx context.Context) error {
+	err := o.rdb.XGroupCreateMkStream(ctx, usageOutboxStreamKey, usageOutboxGroup, "0").Err()
+	if err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil
+	}
+	return err
+}
+
+func (o *usageOutbox) Append(ctx context.Context, event *service.UsageBillingEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return o.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: usageOutboxStreamKey,
+		Values: map[string]any{usageOutboxPayloadField: string(payload)},
+	}).Err()
+}
+
+func (o *usageOutbox) Claim(ctx context.Context, consumer string, count int, minIdle, block time.Duration) ([]service.UsageOutboxMessage, error) {
+	msgs, err := o.claimIdle(ctx, consumer, count, minIdle)
+	if err != nil || len(msgs) > 0 {
+		return msgs, err
+	}
+
+	streams, err := o.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    usageOutboxGroup,
+		Consumer: consumer,
+		Streams:  []string{usageOutboxStreamKey, ">"},
+		Count:    int64(count),
+		Block:    block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if isRedisNoGroupErr(err) {
+		// 事件流被清空或 Redis 重建后重新创建消费组
+		return nil, o.EnsureGroup(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var out []service.UsageOutboxMessage
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			out = append(out, decodeUsageOutboxMessage(msg, 1))
+		}
+	}
+	return out, nil
+}
+
+// claimIdle 接管其他消费者（通常是已崩溃的实例）长时间未确认的消息
+func (o *usageOutbox) claimIdle(ctx context.Context, consumer string, count int, minIdle time.Duration) ([]service.UsageOutboxMessage, error) {
+	pending, err := o.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: usageOutboxStreamKey,
+		Group:  usageOutboxGroup,
+		Idle:   minIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  int64(count),
+	}).Result()
+	if isRedisNoGroupErr(err) {
+		return nil, o.EnsureGroup(ctx)
+	}
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(pending))
+	deliveries := make(map[string]int64, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.ID)
+		deliveries[p.ID] = p.RetryCount + 1
+	}
+	claimed, err := o.rdb.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   usageOutboxStreamKey,
+		Group:    usageOutboxGroup,
+		Consumer: consumer,
+		MinIdle:  minIdle,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]service.UsageOutboxMessage, 0, len(claimed))
+	for _, msg := range claimed {
+		out = append(out, decodeUsageOutboxMessage(msg, deliveries[msg.ID]))
+	}
+	return out, nil
+}
+
+func decodeUsageOutboxMessage(msg redis.XMessage, deliveries int64) service.UsageOutboxMessage {
+	out := service.UsageOutboxMessage{ID: msg.ID, Deliveries: deliveries}
+	payload, ok := msg.Values[usageOutboxPayloadField].(string)
+	if !ok {
+		out.DecodeErr = errors.New("missing payload field")
+		return out
+	}
+	out.Payload = payload
+	var event service.UsageBillingEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		out.DecodeErr = err
+		return out
+	}
+	out.Event = &event
+	return out
+}
+
+func (o *usageOutbox) Ack(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	pipe := o.rdb.TxPipeline()
+	pipe.XAck(ctx, usageOutboxStreamKey, usageOutboxGroup, ids...)
+	pipe.XDel(ctx, usageOutboxStreamKey, ids...)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (o *usageOutbox) DeadLetter(ctx context.Context, msg service.UsageOutboxMessage, reason string) error {
+	pipe := o.rdb.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: usageOutboxDeadStreamKey,
+		MaxLen: usageOutboxDeadMaxLen,
+		Approx: true,
+		Values: map[string]any{
+			usageOutboxPayloadField: msg.Payload,
+			"source_id":             msg.ID,
+			"deliveries":            msg.Deliveries,
+			"reason":                reason,
+		},
+	})
+	pipe.XAck(ctx, usageOutboxStreamKey, usageOutboxGroup, msg.ID)
+	pipe.XDel(ctx, usageOutboxStreamKey, msg.ID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (o *usageOutbox) Stats(ctx context.Context) (*service.UsageOutboxStats, error) {
+	stats := &service.UsageOutboxStats{}
+
+	backlog, err := o.rdb.XLen(ctx, usageOutboxStreamKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	stats.Backlog = backlog
+
+	pending, err := o.rdb.XPending(ctx, usageOutboxStreamKey, usageOutboxGroup).Result()
+	if err != nil && !isRedisNoGroupErr(err) {
+		return nil, err
+	}
+	if pending != nil {
+		stats.Pending = pending.Count
+	}
+
+	oldest, err := o.rdb.XRangeN(ctx, usageOutboxStreamKey, "-", "+", 1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(oldest) > 0 {
+		if ts, ok := usageOutboxIDTime(oldest[0].ID); ok {
+			stats.OldestEventAgeSeconds = time.Since(ts).Seconds()
+		}
+	}
+
+	deadLetters, err := o.rdb.XLen(ctx, usageOutboxDeadStreamKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	stats.DeadLetters = deadLetters
+	return stats, nil
+}
+
+// usageOutboxIDTime 从 Stream 消息 ID（<毫秒时间戳>-<序号>）解析写入时间
+func usageOutboxIDTime(id string) (time.Time, bool) {
+	msPart, _, _ := strings.Cut(id, "-")
+	ms, err := strconv.ParseInt(msPart, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms), true
+}
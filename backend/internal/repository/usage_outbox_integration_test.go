@@ -0,0 +1,74 @@
+//go:build integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type UsageOutboxSuite struct {
+	IntegrationRedisSuite
+	outbox *usageOutbox
+}
+
+func (s *UsageOutboxSuite) SetupTest() {
+	s.IntegrationRedisSuite.SetupTest()
+	s.outbox = NewUsageOutbox(s.rdb).(*usageOutbox)
+	require.NoError(s.T(), s.outbox.EnsureGroup(s.ctx), "EnsureGroup")
+	require.NoError(s.T(), s.outbox.EnsureGroup(s.ctx), "EnsureGroup should be idempotent")
+}
+
+func (s *UsageOutboxSuite) TestAppendClaimAck() {
+	event := &service.UsageBillingEvent{Log: &service.UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-1"}, BalanceCost: 1.25}
+	require.NoError(s.T(), s.outbox.Append(s.ctx, event), "Append")
+
+	msgs, err := s.outbox.Claim(s.ctx, "c1", 10, time.Minute, 100*time.Millisecond)
+	require.NoError(s.T(), err, "Claim")
+	require.Len(s.T(), msgs, 1)
+	require.NoError(s.T(), msgs[0].DecodeErr)
+	require.Equal(s.T(), int64(1), msgs[0].Deliveries)
+	require.Equal(s.T(), "req-1", msgs[0].Event.Log.RequestID)
+	require.InDelta(s.T(), 1.25, msgs[0].Event.BalanceCost, 1e-9)
+
+	stats, err := s.outbox.Stats(s.ctx)
+	require.NoError(s.T(), err, "Stats")
+	require.Equal(s.T(), int64(1), stats.Backlog)
+	require.Equal(s.T(), int64(1), stats.Pending)
+
+	require.NoError(s.T(), s.outbox.Ack(s.ctx, msgs[0].ID), "Ack")
+	stats, err = s.outbox.Stats(s.ctx)
+	require.NoError(s.T(), err, "Stats")
+	require.Zero(s.T(), stats.Backlog)
+	require.Zero(s.T(), stats.Pending)
+}
+
+func (s *UsageOutboxSuite) TestClaimIdleAndDeadLetter() {
+	require.NoError(s.T(), s.outbox.Append(s.ctx, &service.UsageBillingEvent{Log: &service.UsageLog{RequestID: "req-2"}}))
+
+	msgs, err := s.outbox.Claim(s.ctx, "crashed", 10, time.Minute, 100*time.Millisecond)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), msgs, 1)
+
+	// 未确认的消息在空闲超时后被其他消费者接管，投递次数递增
+	time.Sleep(20 * time.Millisecond)
+	reclaimed, err := s.outbox.Claim(s.ctx, "c2", 10, 10*time.Millisecond, 100*time.Millisecond)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), reclaimed, 1)
+	require.Equal(s.T(), msgs[0].ID, reclaimed[0].ID)
+	require.Equal(s.T(), int64(2), reclaimed[0].Deliveries)
+
+	require.NoError(s.T(), s.outbox.DeadLetter(s.ctx, reclaimed[0], "test"), "DeadLetter")
+	stats, err := s.outbox.Stats(s.ctx)
+	require.NoError(s.T(), err)
+	require.Zero(s.T(), stats.Backlog)
+	require.Equal(s.T(), int64(1), stats.DeadLetters)
+}
+
+func TestUsageOutboxSuite(t *testing.T) {
+	suite.Run(t, new(UsageOutboxSuite))
+}
@@ -68,6 +68,13 @@ var ProviderSet = wire.NewSet(
 	NewUserAttributeValueRepository,
 	NewUserGroupRateRepository,
 	NewErrorPassthroughRepository,
+	NewModelPriceRepository,
+	NewRequestPolicyRepository,
+	NewGuardrailRuleRepository,
+	NewClientRuleRepository,
+	NewRoutingRuleRepository,
+	NewBackupRepository,
+	NewUsageBillingRepository,
 
 	// Cache implementations
 	NewGatewayCache,
@@ -89,6 +96,14 @@ var ProviderSet = wire.NewSet(
 	NewTotpCache,
 	NewRefreshTokenCache,
 	NewErrorPassthroughCache,
+	NewModelPriceCache,
+	NewRequestPolicyCache,
+	NewGuardrailRuleCache,
+	NewClientRuleCache,
+	NewRoutingRuleCache,
+	NewClusterNodeCache,
+	NewUsageOutbox,
+	NewFairQueueCache,
 
 	// Encryptors
 	NewAESEncryptor,
@@ -17,10 +17,13 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/handler"
 	adminhandler "github.com/Wei-Shaw/sub2api/internal/handler/admin"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/openapi"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/usagestats"
 	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/server/routes"
 	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/Wei-Shaw/sub2api/pkg/apiclient"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -28,6 +31,8 @@ import (
 
 func TestAPIContracts(t *testing.T) {
 	gin.SetMode(gin.TestMode)
+	doc, err := openapi.Parse(routes.OpenAPISpec())
+	require.NoError(t, err)
 
 	tests := []struct {
 		name       string
@@ -539,10 +544,53 @@ func TestAPIContracts(t *testing.T) {
 			status, body := doRequest(t, deps.router, tt.method, tt.path, tt.body, tt.headers)
 			require.Equal(t, tt.wantStatus, status)
 			require.JSONEq(t, tt.wantJSON, body)
+			// 实际响应必须符合 OpenAPI 文档
+			require.NoError(t, doc.ValidateResponse(tt.method, tt.path, status, []byte(body)))
 		})
 	}
 }
 
+func TestAPIClientAgainstHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	deps := newContractDeps(t)
+	deps.apiKeyRepo.MustSeed(&service.APIKey{
+		ID:        100,
+		UserID:    1,
+		Key:       "sk_custom_1234567890",
+		Name:      "Key One",
+		Status:    service.StatusActive,
+		CreatedAt: deps.now,
+		UpdatedAt: deps.now,
+	})
+	srv := httptest.NewServer(deps.router)
+	t.Cleanup(srv.Close)
+	client := apiclient.New(srv.URL, apiclient.WithBearerToken("test-token"))
+	ctx := context.Background()
+
+	me, err := client.AuthGetCurrentUser(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), me.ID)
+	require.Equal(t, "alice@example.com", me.Email)
+	require.True(t, me.CreatedAt.Equal(deps.now))
+
+	keys, err := client.APIKeyList(ctx, &apiclient.APIKeyListParams{Page: apiclient.Ptr[int64](1), PageSize: apiclient.Ptr[int64](10)})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), keys.Total)
+	require.Len(t, keys.Items, 1)
+	require.Equal(t, "sk_custom_1234567890", keys.Items[0].Key)
+	require.Nil(t, keys.Items[0].GroupID)
+
+	created, err := client.APIKeyCreate(ctx, &apiclient.CreateAPIKeyRequest{Name: "Key Two", CustomKey: apiclient.Ptr("sk_custom_abcdefghij")})
+	require.NoError(t, err)
+	require.Equal(t, "Key Two", created.Name)
+	require.Equal(t, "sk_custom_abcdefghij", created.Key)
+
+	_, err = client.APIKeyCreate(ctx, &apiclient.CreateAPIKeyRequest{})
+	var apiErr *apiclient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
+
 type contractDeps struct {
 	now         time.Time
 	router      http.Handler
@@ -607,7 +655,7 @@ func newContractDeps(t *testing.T) *contractDeps {
 	settingRepo := newStubSettingRepo()
 	settingService := service.NewSettingService(settingRepo, cfg)
 
-	adminService := service.NewAdminService(userRepo, groupRepo, &accountRepo, proxyRepo, apiKeyRepo, redeemRepo, nil, nil, nil, nil, nil)
+	adminService := service.NewAdminService(userRepo, groupRepo, &accountRepo, proxyRepo, apiKeyRepo, redeemRepo, nil, nil, nil, nil, nil, nil, nil)
 	authHandler := handler.NewAuthHandler(cfg, nil, userService, settingService, nil, redeemService, nil)
 	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
 	usageHandler := handler.NewUsageHandler(usageService, apiKeyService)
@@ -9,9 +9,11 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // NewAPIKeyAuthMiddleware 创建 API Key 认证中间件
@@ -57,8 +59,19 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			return
 		}
 
+		// 认证阶段单独记录 span；进入后续处理链前结束，避免把整个请求耗时计入认证
+		authCtx, authSpan := tracing.Start(c.Request.Context(), "middleware.api_key_auth")
+		authDone := false
+		endAuth := func() {
+			if !authDone {
+				authDone = true
+				authSpan.End()
+			}
+		}
+		defer endAuth()
+
 		// 从数据库验证API key
-		apiKey, err := apiKeyService.GetByKey(c.Request.Context(), apiKeyString)
+		apiKey, err := apiKeyService.GetByKey(authCtx, apiKeyString)
 		if err != nil {
 			if errors.Is(err, service.ErrAPIKeyNotFound) {
 				AbortWithError(c, 401, "INVALID_API_KEY", "Invalid API key")
@@ -126,6 +139,8 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			})
 			c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 			setGroupContext(c, apiKey.Group)
+			setAuthTraceAttributes(c, apiKey)
+			endAuth()
 			c.Next()
 			return
 		}
@@ -136,7 +151,7 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 		if isSubscriptionType && subscriptionService != nil {
 			// 订阅模式：验证订阅
 			subscription, err := subscriptionService.GetActiveSubscription(
-				c.Request.Context(),
+				authCtx,
 				apiKey.User.ID,
 				apiKey.Group.ID,
 			)
@@ -146,23 +161,23 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			}
 
 			// 验证订阅状态（是否过期、暂停等）
-			if err := subscriptionService.ValidateSubscription(c.Request.Context(), subscription); err != nil {
+			if err := subscriptionService.ValidateSubscription(authCtx, subscription); err != nil {
 				AbortWithError(c, 403, "SUBSCRIPTION_INVALID", err.Error())
 				return
 			}
 
 			// 激活滑动窗口（首次使用时）
-			if err := subscriptionService.CheckAndActivateWindow(c.Request.Context(), subscription); err != nil {
+			if err := subscriptionService.CheckAndActivateWindow(authCtx, subscription); err != nil {
 				log.Printf("Failed to activate subscription windows: %v", err)
 			}
 
 			// 检查并重置过期窗口
-			if err := subscriptionService.CheckAndResetWindows(c.Request.Context(), subscription); err != nil {
+			if err := subscriptionService.CheckAndResetWindows(authCtx, subscription); err != nil {
 				log.Printf("Failed to reset subscription windows: %v", err)
 			}
 
 			// 预检查用量限制（使用0作为额外费用进行预检查）
-			if err := subscriptionService.CheckUsageLimits(c.Request.Context(), subscription, apiKey.Group, 0); err != nil {
+			if err := subscriptionService.CheckUsageLimits(authCtx, subscription, apiKey.Group, 0); err != nil {
 				AbortWithError(c, 429, "USAGE_LIMIT_EXCEEDED", err.Error())
 				return
 			}
@@ -185,11 +200,25 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 		})
 		c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 		setGroupContext(c, apiKey.Group)
+		setAuthTraceAttributes(c, apiKey)
+		endAuth()
 
 		c.Next()
 	}
 }
 
+// setAuthTraceAttributes 在请求链路上记录认证结果
+func setAuthTraceAttributes(c *gin.Context, apiKey *service.APIKey) {
+	attrs := []attribute.KeyValue{
+		tracing.AttrAPIKeyID.Int64(apiKey.ID),
+		tracing.AttrUserID.Int64(apiKey.User.ID),
+	}
+	if apiKey.GroupID != nil {
+		attrs = append(attrs, tracing.AttrGroupID.Int64(*apiKey.GroupID))
+	}
+	tracing.SetAttributes(c.Request.Context(), attrs...)
+}
+
 // GetAPIKeyFromContext 从上下文中获取API key
 func GetAPIKeyFromContext(c *gin.Context) (*service.APIKey, bool) {
 	value, exists := c.Get(string(ContextKeyAPIKey))
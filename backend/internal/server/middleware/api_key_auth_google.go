@@ -6,6 +6,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/googleapi"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -32,7 +33,17 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			return
 		}
 
-		apiKey, err := apiKeyService.GetByKey(c.Request.Context(), apiKeyString)
+		authCtx, authSpan := tracing.Start(c.Request.Context(), "middleware.api_key_auth")
+		authDone := false
+		endAuth := func() {
+			if !authDone {
+				authDone = true
+				authSpan.End()
+			}
+		}
+		defer endAuth()
+
+		apiKey, err := apiKeyService.GetByKey(authCtx, apiKeyString)
 		if err != nil {
 			if errors.Is(err, service.ErrAPIKeyNotFound) {
 				abortWithGoogleError(c, 401, "Invalid API key")
@@ -64,6 +75,8 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			})
 			c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 			setGroupContext(c, apiKey.Group)
+			setAuthTraceAttributes(c, apiKey)
+			endAuth()
 			c.Next()
 			return
 		}
@@ -71,7 +84,7 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 		isSubscriptionType := apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 		if isSubscriptionType && subscriptionService != nil {
 			subscription, err := subscriptionService.GetActiveSubscription(
-				c.Request.Context(),
+				authCtx,
 				apiKey.User.ID,
 				apiKey.Group.ID,
 			)
@@ -79,13 +92,13 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 				abortWithGoogleError(c, 403, "No active subscription found for this group")
 				return
 			}
-			if err := subscriptionService.ValidateSubscription(c.Request.Context(), subscription); err != nil {
+			if err := subscriptionService.ValidateSubscription(authCtx, subscription); err != nil {
 				abortWithGoogleError(c, 403, err.Error())
 				return
 			}
-			_ = subscriptionService.CheckAndActivateWindow(c.Request.Context(), subscription)
-			_ = subscriptionService.CheckAndResetWindows(c.Request.Context(), subscription)
-			if err := subscriptionService.CheckUsageLimits(c.Request.Context(), subscription, apiKey.Group, 0); err != nil {
+			_ = subscriptionService.CheckAndActivateWindow(authCtx, subscription)
+			_ = subscriptionService.CheckAndResetWindows(authCtx, subscription)
+			if err := subscriptionService.CheckUsageLimits(authCtx, subscription, apiKey.Group, 0); err != nil {
 				abortWithGoogleError(c, 429, err.Error())
 				return
 			}
@@ -104,6 +117,8 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 		})
 		c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 		setGroupContext(c, apiKey.Group)
+		setAuthTraceAttributes(c, apiKey)
+		endAuth()
 		c.Next()
 	}
 }
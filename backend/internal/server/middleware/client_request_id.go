@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -25,6 +26,8 @@ func ClientRequestID() gin.HandlerFunc {
 
 		id := uuid.New().String()
 		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.ClientRequestID, id))
+		// 在链路上记录 client_request_id，便于与运维错误日志互相检索
+		tracing.SetAttributes(c.Request.Context(), tracing.AttrClientRequestID.String(id))
 		c.Next()
 	}
 }
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// drainRetryAfterSeconds 提示客户端稍后重试，届时请求将落到其他实例
+const drainRetryAfterSeconds = "5"
+
+const drainMessage = "Server is shutting down, please retry"
+
+// GatewayDrain 统计进行中的网关请求，并在 draining 期间以可重试的 503 拒绝新请求
+func GatewayDrain(health *service.HealthService) gin.HandlerFunc {
+	return gatewayDrain(health, func(c *gin.Context) {
+		AbortWithError(c, http.StatusServiceUnavailable, "SERVICE_DRAINING", drainMessage)
+	})
+}
+
+// GatewayDrainGoogle 与 GatewayDrain 相同，但返回 Google API 风格的错误体
+func GatewayDrainGoogle(health *service.HealthService) gin.HandlerFunc {
+	return gatewayDrain(health, func(c *gin.Context) {
+		abortWithGoogleError(c, http.StatusServiceUnavailable, drainMessage)
+	})
+}
+
+func gatewayDrain(health *service.HealthService, reject func(c *gin.Context)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if health.IsDraining() {
+			c.Header("Retry-After", drainRetryAfterSeconds)
+			c.Header("Connection", "close")
+			reject(c)
+			return
+		}
+		done := health.TrackRequest()
+		defer done()
+		c.Next()
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayDrain(t *testing.T) {
+	health := service.NewHealthService(nil, nil, nil, nil, nil)
+
+	var inFlightDuringRequest int64
+	r := gin.New()
+	r.POST("/v1/messages", GatewayDrain(health), func(c *gin.Context) {
+		inFlightDuringRequest = health.InFlight()
+		c.Status(http.StatusOK)
+	})
+	r.POST("/v1beta/models/*modelAction", GatewayDrainGoogle(health), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/messages", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, int64(1), inFlightDuringRequest)
+	require.Equal(t, int64(0), health.InFlight())
+
+	health.StartDraining()
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/messages", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, drainRetryAfterSeconds, w.Header().Get("Retry-After"))
+	require.Contains(t, w.Body.String(), "SERVICE_DRAINING")
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1beta/models/gemini-2.5-pro:generateContent", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Contains(t, w.Body.String(), `"code":503`)
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/openapi"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/server/routes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// moduleRoot backend 模块根目录（相对本包）
+const moduleRoot = "../.."
+
+// TestOpenAPICoversAllRoutes 注册真实路由表，确认每条路由都在文档中，且文档没有多余的操作
+func TestOpenAPICoversAllRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	doc, err := openapi.Parse(routes.OpenAPISpec())
+	require.NoError(t, err)
+
+	noop := func(c *gin.Context) { c.Next() }
+	r := gin.New()
+	registerRoutes(r, &handler.Handlers{Admin: &handler.AdminHandlers{}},
+		middleware2.JWTAuthMiddleware(noop), middleware2.AdminAuthMiddleware(noop), middleware2.APIKeyAuthMiddleware(noop),
+		nil, nil, nil, nil, &config.Config{}, nil)
+
+	registered := map[string]bool{}
+	for _, rt := range r.Routes() {
+		key := rt.Method + " " + ginToOpenAPIPath(rt.Path)
+		registered[key] = true
+		require.NotNil(t, doc.Operation(rt.Method, ginToOpenAPIPath(rt.Path)), "route %s missing from openapi.json; run go generate ./internal/server/routes", key)
+	}
+	var extra []string
+	doc.Operations(func(method, path string, _ *openapi.Operation) {
+		if !registered[method+" "+path] {
+			extra = append(extra, method+" "+path)
+		}
+	})
+	sort.Strings(extra)
+	require.Empty(t, extra, "openapi.json documents routes that are not registered")
+}
+
+// TestOpenAPIServed 文档端点返回内嵌的 JSON
+func TestOpenAPIServed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	routes.RegisterCommonRoutes(r, &handler.Handlers{})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Header().Get("Content-Type"), "application/json")
+	require.Equal(t, routes.OpenAPISpec(), w.Body.Bytes())
+}
+
+// TestOpenAPIUpToDate 重新分析源码生成文档与客户端，与仓库中的文件比较
+func TestOpenAPIUpToDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping source analysis in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not available")
+	}
+	doc, err := openapi.Generate(moduleRoot)
+	require.NoError(t, err)
+
+	spec, err := doc.Marshal()
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(routes.OpenAPISpec(), spec), "openapi.json is stale; run go generate ./internal/server/routes")
+
+	client, err := openapi.GenerateClient(doc, openapi.ClientPackage)
+	require.NoError(t, err)
+	current, err := os.ReadFile(filepath.Join(moduleRoot, filepath.FromSlash(openapi.ClientFile)))
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(current, client), "%s is stale; run go generate ./internal/server/routes", openapi.ClientFile)
+}
+
+// ginToOpenAPIPath /users/:id -> /users/{id}
+func ginToOpenAPIPath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
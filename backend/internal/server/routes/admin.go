@@ -70,6 +70,24 @@ func RegisterAdminRoutes(
 
 		// 错误透传规则管理
 		registerErrorPassthroughRoutes(admin, h)
+
+		// 模型价格覆盖管理
+		registerModelPriceRoutes(admin, h)
+
+		// 分组请求参数策略管理
+		registerRequestPolicyRoutes(admin, h)
+
+		// 内容护栏规则管理
+		registerGuardrailRoutes(admin, h)
+
+		// 客户端准入规则管理
+		registerClientRuleRoutes(admin, h)
+
+		// 请求路由规则管理
+		registerRoutingRuleRoutes(admin, h)
+
+		// 声明式配置同步
+		registerConfigSyncRoutes(admin, h)
 	}
 }
 
@@ -81,6 +99,8 @@ func registerOpsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		ops.GET("/user-concurrency", h.Admin.Ops.GetUserConcurrencyStats)
 		ops.GET("/account-availability", h.Admin.Ops.GetAccountAvailability)
 		ops.GET("/realtime-traffic", h.Admin.Ops.GetRealtimeTrafficSummary)
+		ops.GET("/usage-outbox", h.Admin.Ops.GetUsageOutboxStats)
+		ops.GET("/fair-queue", h.Admin.Ops.GetFairQueueStats)
 
 		// Alerts (rules + events)
 		ops.GET("/alert-rules", h.Admin.Ops.ListAlertRules)
@@ -356,6 +376,24 @@ func registerSystemRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		system.POST("/update", h.Admin.System.PerformUpdate)
 		system.POST("/rollback", h.Admin.System.Rollback)
 		system.POST("/restart", h.Admin.System.RestartService)
+
+		// 全量备份与恢复
+		system.POST("/backup", h.Admin.Backup.Backup)
+		system.POST("/restore", h.Admin.Backup.Restore)
+
+		// one-api / new-api 迁移导入
+		system.POST("/import/oneapi", h.Admin.OneAPIImport.Import)
+		system.POST("/import/oneapi/preview", h.Admin.OneAPIImport.Preview)
+
+		// 集群节点
+		system.GET("/nodes", h.Admin.Cluster.ListNodes)
+		system.POST("/nodes/:id/command", h.Admin.Cluster.SendCommand)
+		system.GET("/rolling-restart", h.Admin.Cluster.GetRollingRestart)
+		system.POST("/rolling-restart", h.Admin.Cluster.StartRollingRestart)
+
+		// 配置文件热更新
+		system.GET("/config/reload", h.Admin.ConfigReload.GetStatus)
+		system.POST("/config/reload", h.Admin.ConfigReload.Reload)
 	}
 }
 
@@ -413,3 +451,70 @@ func registerErrorPassthroughRoutes(admin *gin.RouterGroup, h *handler.Handlers)
 		rules.DELETE("/:id", h.Admin.ErrorPassthrough.Delete)
 	}
 }
+
+func registerRequestPolicyRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	policies := admin.Group("/request-policies")
+	{
+		policies.GET("", h.Admin.RequestPolicy.List)
+		policies.GET("/:id", h.Admin.RequestPolicy.GetByID)
+		policies.POST("", h.Admin.RequestPolicy.Create)
+		policies.PUT("/:id", h.Admin.RequestPolicy.Update)
+		policies.DELETE("/:id", h.Admin.RequestPolicy.Delete)
+	}
+}
+
+func registerGuardrailRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	rules := admin.Group("/guardrail-rules")
+	{
+		rules.GET("", h.Admin.Guardrail.List)
+		rules.GET("/:id", h.Admin.Guardrail.GetByID)
+		rules.POST("", h.Admin.Guardrail.Create)
+		rules.PUT("/:id", h.Admin.Guardrail.Update)
+		rules.DELETE("/:id", h.Admin.Guardrail.Delete)
+	}
+}
+
+func registerClientRuleRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	rules := admin.Group("/client-rules")
+	{
+		rules.GET("", h.Admin.ClientRule.List)
+		rules.GET("/:id", h.Admin.ClientRule.GetByID)
+		rules.POST("", h.Admin.ClientRule.Create)
+		rules.PUT("/:id", h.Admin.ClientRule.Update)
+		rules.DELETE("/:id", h.Admin.ClientRule.Delete)
+		rules.POST("/:id/reset-counter", h.Admin.ClientRule.ResetRejectedCount)
+	}
+}
+
+func registerRoutingRuleRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	rules := admin.Group("/routing-rules")
+	{
+		rules.GET("", h.Admin.RoutingRule.List)
+		rules.GET("/:id", h.Admin.RoutingRule.GetByID)
+		rules.POST("", h.Admin.RoutingRule.Create)
+		rules.PUT("/:id", h.Admin.RoutingRule.Update)
+		rules.DELETE("/:id", h.Admin.RoutingRule.Delete)
+	}
+}
+
+func registerConfigSyncRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	configSync := admin.Group("/config-sync")
+	{
+		configSync.POST("/plan", h.Admin.ConfigSync.Plan)
+		configSync.POST("/apply", h.Admin.ConfigSync.Apply)
+		configSync.GET("/export", h.Admin.ConfigSync.Export)
+	}
+}
+
+func registerModelPriceRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	prices := admin.Group("/model-prices")
+	{
+		prices.GET("", h.Admin.ModelPrice.List)
+		prices.GET("/export", h.Admin.ModelPrice.Export)
+		prices.POST("/import", h.Admin.ModelPrice.Import)
+		prices.GET("/:id", h.Admin.ModelPrice.GetByID)
+		prices.POST("", h.Admin.ModelPrice.Create)
+		prices.PUT("/:id", h.Admin.ModelPrice.Update)
+		prices.DELETE("/:id", h.Admin.ModelPrice.Delete)
+	}
+}
@@ -3,15 +3,23 @@ package routes
 import (
 	"net/http"
 
+	"github.com/Wei-Shaw/sub2api/internal/handler"
+
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterCommonRoutes 注册通用路由（健康检查、状态等）
-func RegisterCommonRoutes(r *gin.Engine) {
-	// 健康检查
+func RegisterCommonRoutes(r *gin.Engine, h *handler.Handlers) {
+	// 健康检查（保留旧端点，仅表示进程存活）
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
+	// 存活/就绪检查（供 Kubernetes 探针与负载均衡使用）
+	r.GET("/livez", h.Health.Livez)
+	r.GET("/readyz", h.Health.Readyz)
+
+	// OpenAPI 文档（覆盖除网关透传外的全部接口）
+	r.GET("/api/v1/openapi.json", serveOpenAPI)
 
 	// Claude Code 遥测日志（忽略，直接返回200）
 	r.POST("/api/event_logging/batch", func(c *gin.Context) {
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:generate go run ../../../cmd/openapigen
+
+// openAPISpec 由 cmd/openapigen 根据路由与 handler 生成的 OpenAPI 3 文档
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// OpenAPISpec 返回内嵌的 OpenAPI 文档（JSON）
+func OpenAPISpec() []byte {
+	return openAPISpec
+}
+
+// serveOpenAPI 提供 OpenAPI 文档，无需认证
+func serveOpenAPI(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", openAPISpec)
+}
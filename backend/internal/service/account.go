@@ -791,6 +791,32 @@ func (a *Account) GetMaxSessions() int {
 	return 0
 }
 
+// GetMaxContextTokens 获取账号可承载的最大上下文 token 数
+// 未配置时 Anthropic 账号按标准 200K 窗口处理（开通 1M 上下文 beta 的账号需显式配置），
+// 其他平台返回 0 表示不限制
+func (a *Account) GetMaxContextTokens() int {
+	if a.Extra != nil {
+		if v, ok := a.Extra["max_context_tokens"]; ok {
+			if val := parseExtraInt(v); val > 0 {
+				return val
+			}
+		}
+	}
+	if a.Platform == PlatformAnthropic {
+		return StandardContextWindowTokens
+	}
+	return 0
+}
+
+// SupportsContextTokens 检查账号上下文容量是否足以承载给定的输入 token 数
+func (a *Account) SupportsContextTokens(tokens int) bool {
+	if tokens <= 0 {
+		return true
+	}
+	limit := a.GetMaxContextTokens()
+	return limit <= 0 || tokens <= limit
+}
+
 // GetSessionIdleTimeoutMinutes 获取会话空闲超时分钟数
 // 默认值为 5 分钟
 func (a *Account) GetSessionIdleTimeoutMinutes() int {
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"strings"
+)
+
+// compatible 平台账号字段约定：
+//   - credentials.base_url: 上游地址，需包含版本前缀（如 https://api.deepseek.com/v1、http://127.0.0.1:8000/v1）
+//   - credentials.api_key:  上游密钥（本地 vLLM/Ollama 可留空）
+//   - credentials.models:   账号可服务的模型列表（支持 * 通配），为空表示不限制
+//   - credentials.model_mapping: 可选，请求模型 → 上游模型映射
+//   - extra.model_prices:   可选，账号级自定义价格，单位 USD / 百万 token
+//     {"deepseek-chat": {"input_price": 0.27, "output_price": 1.1, "cache_read_price": 0.07}}
+
+// IsCompatible 是否为通用 OpenAI 兼容上游账号
+func (a *Account) IsCompatible() bool {
+	return a.Platform == PlatformCompatible
+}
+
+// GetCompatibleBaseURL 返回兼容上游的 base URL（已去除末尾斜杠）
+func (a *Account) GetCompatibleBaseURL() string {
+	if !a.IsCompatible() {
+		return ""
+	}
+	return strings.TrimRight(strings.TrimSpace(a.GetCredential("base_url")), "/")
+}
+
+// GetCompatibleAPIKey 返回兼容上游的 API Key
+func (a *Account) GetCompatibleAPIKey() string {
+	if !a.IsCompatible() {
+		return ""
+	}
+	return strings.TrimSpace(a.GetCredential("api_key"))
+}
+
+// GetCompatibleModels 返回账号声明的模型列表
+func (a *Account) GetCompatibleModels() []string {
+	if a.Credentials == nil {
+		return nil
+	}
+	return parseTempUnschedStrings(a.Credentials["models"])
+}
+
+// IsCompatibleModelSupported 检查兼容账号是否可服务请求模型。
+// 模型列表与 model_mapping 均未配置时允许所有模型；任一命中即视为支持。
+func (a *Account) IsCompatibleModelSupported(requestedModel string) bool {
+	models := a.GetCompatibleModels()
+	mapping := a.GetModelMapping()
+	if len(models) == 0 && len(mapping) == 0 {
+		return true
+	}
+	for _, pattern := range models {
+		if matchWildcard(pattern, requestedModel) {
+			return true
+		}
+	}
+	return len(mapping) > 0 && a.IsModelSupported(requestedModel)
+}
+
+// GetCompatibleModelPricing 返回账号级自定义价格（extra.model_prices），未配置返回 nil。
+// 先精确匹配，再按通配符匹配（最长优先）。
+func (a *Account) GetCompatibleModelPricing(model string) *ModelPricing {
+	if a.Extra == nil || strings.TrimSpace(model) == "" {
+		return nil
+	}
+	prices, ok := a.Extra["model_prices"].(map[string]any)
+	if !ok || len(prices) == 0 {
+		return nil
+	}
+
+	raw, ok := prices[model]
+	if !ok {
+		bestLen := -1
+		for pattern, v := range prices {
+			if matchWildcard(pattern, model) && len(pattern) > bestLen {
+				raw = v
+				bestLen = len(pattern)
+			}
+		}
+		if bestLen < 0 {
+			return nil
+		}
+	}
+
+	entry, ok := raw.(map[string]any)
+	if !ok {
+		return nil
+	}
+	const perMillion = 1e-6
+	return &ModelPricing{
+		InputPricePerToken:         parseExtraFloat64(entry["input_price"]) * perMillion,
+		OutputPricePerToken:        parseExtraFloat64(entry["output_price"]) * perMillion,
+		CacheCreationPricePerToken: parseExtraFloat64(entry["cache_write_price"]) * perMillion,
+		CacheReadPricePerToken:     parseExtraFloat64(entry["cache_read_price"]) * perMillion,
+	}
+}
@@ -0,0 +1,83 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountIsCompatibleModelSupported(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials map[string]any
+		model       string
+		expected    bool
+	}{
+		{"未配置模型列表允许全部", map[string]any{}, "any-model", true},
+		{"精确匹配", map[string]any{"models": []any{"deepseek-chat"}}, "deepseek-chat", true},
+		{"通配匹配", map[string]any{"models": []any{"qwen-*"}}, "qwen-max", true},
+		{"不在列表中", map[string]any{"models": []any{"deepseek-chat"}}, "gpt-4o", false},
+		{"映射命中", map[string]any{"models": []any{"deepseek-chat"}, "model_mapping": map[string]any{"claude-sonnet-4": "deepseek-chat"}}, "claude-sonnet-4", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &Account{Platform: PlatformCompatible, Type: AccountTypeAPIKey, Credentials: tt.credentials}
+			require.Equal(t, tt.expected, account.IsCompatibleModelSupported(tt.model))
+		})
+	}
+}
+
+func TestAccountGetCompatibleBaseURL(t *testing.T) {
+	account := &Account{Platform: PlatformCompatible, Credentials: map[string]any{"base_url": " https://api.deepseek.com/v1/ "}}
+	require.Equal(t, "https://api.deepseek.com/v1", account.GetCompatibleBaseURL())
+
+	other := &Account{Platform: PlatformOpenAI, Credentials: map[string]any{"base_url": "https://api.openai.com"}}
+	require.Empty(t, other.GetCompatibleBaseURL())
+}
+
+func TestAccountGetCompatibleModelPricing(t *testing.T) {
+	account := &Account{
+		Platform: PlatformCompatible,
+		Extra: map[string]any{
+			"model_prices": map[string]any{
+				"deepseek-chat": map[string]any{"input_price": 0.27, "output_price": 1.1, "cache_read_price": 0.07},
+				"qwen-*":        map[string]any{"input_price": 1.0, "output_price": 2.0},
+				"qwen-max*":     map[string]any{"input_price": 3.0, "output_price": 6.0},
+			},
+		},
+	}
+
+	pricing := account.GetCompatibleModelPricing("deepseek-chat")
+	require.NotNil(t, pricing)
+	require.InDelta(t, 0.27e-6, pricing.InputPricePerToken, 1e-15)
+	require.InDelta(t, 0.07e-6, pricing.CacheReadPricePerToken, 1e-15)
+
+	pricing = account.GetCompatibleModelPricing("qwen-max-latest")
+	require.NotNil(t, pricing)
+	require.InDelta(t, 3e-6, pricing.InputPricePerToken, 1e-15, "最长通配优先")
+
+	require.Nil(t, account.GetCompatibleModelPricing("gpt-4o"))
+}
+
+func TestBillingServiceCalculateCostForAccount_CustomPricing(t *testing.T) {
+	svc := &BillingService{fallbackPrices: map[string]*ModelPricing{}}
+	svc.initFallbackPricing()
+
+	account := &Account{
+		Platform:    PlatformCompatible,
+		Type:        AccountTypeAPIKey,
+		Credentials: map[string]any{"model_mapping": map[string]any{"claude-sonnet-4": "deepseek-chat"}},
+		Extra: map[string]any{
+			"model_prices": map[string]any{
+				"deepseek-chat": map[string]any{"input_price": 1.0, "output_price": 2.0},
+			},
+		},
+	}
+
+	cost, err := svc.CalculateCostForAccount(account, nil, "claude-sonnet-4", UsageTokens{InputTokens: 1_000_000, OutputTokens: 1_000_000}, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 3.0, cost.TotalCost, 1e-9)
+	require.InDelta(t, 6.0, cost.ActualCost, 1e-9)
+}
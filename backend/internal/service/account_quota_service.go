@@ -0,0 +1,523 @@
+package service
+
+import (
+	"context"
+	"log"
+	mathrand "math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+)
+
+const (
+	accountQuotaFetchTimeout = 15 * time.Second
+	// accountQuotaFetchWorkers 单轮刷新并发查询 usage API 的账号数上限
+	accountQuotaFetchWorkers = 8
+	// accountQuotaResetSpread 5h 窗口重置时间与最早者相差不超过该值的账号视为同一批，在其中随机选择
+	accountQuotaResetSpread = 15 * time.Minute
+)
+
+// accountQuotaLeaderLockName 节点注册表中展示的锁名称
+const accountQuotaLeaderLockName = "account_quota:leader"
+
+var accountQuotaLeaderBucket = SchedulerBucket{
+	GroupID:  -1,
+	Platform: "system",
+	Mode:     "account_quota",
+}
+
+// AccountQuotaWindow 单个限额窗口的使用率
+type AccountQuotaWindow struct {
+	Utilization float64 // 使用率百分比（0-100）
+	ResetsAt    *time.Time
+}
+
+// active 窗口已重置时使用率不再有效
+func (w *AccountQuotaWindow) active(now time.Time) bool {
+	return w != nil && (w.ResetsAt == nil || w.ResetsAt.After(now))
+}
+
+// AccountQuota 账号额度快照
+// Claude OAuth 账号来自 usage API（由 leader 实例查询后写入 Extra 字段），OpenAI 账号来自 Codex 响应头写入的 Extra 字段。
+type AccountQuota struct {
+	FiveHour     *AccountQuotaWindow
+	SevenDay     *AccountQuotaWindow
+	SevenDayOpus *AccountQuotaWindow
+	UpdatedAt    time.Time
+}
+
+// PeakUtilization 返回与请求模型相关、尚未重置的窗口中的最高使用率
+// Opus 模型额外计入 seven_day_opus 窗口。
+func (q *AccountQuota) PeakUtilization(model string, now time.Time) float64 {
+	if q == nil {
+		return 0
+	}
+	windows := []*AccountQuotaWindow{q.FiveHour, q.SevenDay}
+	if isOpusModel(model) {
+		windows = append(windows, q.SevenDayOpus)
+	}
+	peak := 0.0
+	for _, w := range windows {
+		if w.active(now) && w.Utilization > peak {
+			peak = w.Utilization
+		}
+	}
+	return peak
+}
+
+// opusHeadroom 返回 seven_day_opus 窗口的剩余额度（0-100），无数据视为满额度
+func (q *AccountQuota) opusHeadroom(now time.Time) float64 {
+	if q == nil || !q.SevenDayOpus.active(now) {
+		return 100
+	}
+	headroom := 100 - q.SevenDayOpus.Utilization
+	if headroom < 0 {
+		return 0
+	}
+	return headroom
+}
+
+// fiveHourResetsAt 返回进行中的 5h 窗口的重置时间
+func (q *AccountQuota) fiveHourResetsAt(now time.Time) *time.Time {
+	if q == nil || !q.FiveHour.active(now) || q.FiveHour.ResetsAt == nil {
+		return nil
+	}
+	return q.FiveHour.ResetsAt
+}
+
+func isOpusModel(model string) bool {
+	return strings.Contains(strings.ToLower(model), "opus")
+}
+
+// quotaTier 额度分层：数值越小越优先
+type quotaTier int
+
+const (
+	quotaTierNormal quotaTier = iota
+	quotaTierDeprioritized
+	quotaTierExhausted
+)
+
+// AccountQuotaService 额度感知调度
+//
+// 周期性缓存 Claude OAuth 账号的 5h/7d/7d-Opus 使用率，调度时跳过或降级使用率过高的账号，
+// 优先使用窗口即将重置的账号，并按 Opus 剩余额度分散 Opus 流量。
+// 多实例部署时只有持有 leader 锁的实例查询 usage API 并写入账号 Extra，各实例从 Extra 加载快照。
+type AccountQuotaService struct {
+	accountRepo    AccountRepository
+	usageService   *AccountUsageService
+	schedulerCache SchedulerCache
+	cfg            atomic.Pointer[config.QuotaAwareSchedulingConfig]
+
+	quotas sync.Map // accountID -> *AccountQuota
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewAccountQuotaService 创建额度感知调度服务
+func NewAccountQuotaService(accountRepo AccountRepository, usageService *AccountUsageService, schedulerCache SchedulerCache, cfg *config.Config) *AccountQuotaService {
+	s := &AccountQuotaService{
+		accountRepo:    accountRepo,
+		usageService:   usageService,
+		schedulerCache: schedulerCache,
+		stopCh:         make(chan struct{}),
+	}
+	var quotaCfg config.QuotaAwareSchedulingConfig
+	if cfg != nil {
+		quotaCfg = cfg.Gateway.Scheduling.QuotaAware
+	}
+	s.cfg.Store(&quotaCfg)
+	return s
+}
+
+// ApplyConfig 应用热更新后的降级/跳过阈值；启用开关与刷新周期在启动时确定，修改后需重启
+func (s *AccountQuotaService) ApplyConfig(cfg *config.Config) {
+	if s == nil || cfg == nil {
+		return
+	}
+	next := *s.currentConfig()
+	next.DeprioritizeThreshold = cfg.Gateway.Scheduling.QuotaAware.DeprioritizeThreshold
+	next.SkipThreshold = cfg.Gateway.Scheduling.QuotaAware.SkipThreshold
+	s.cfg.Store(&next)
+}
+
+func (s *AccountQuotaService) currentConfig() *config.QuotaAwareSchedulingConfig {
+	if cfg := s.cfg.Load(); cfg != nil {
+		return cfg
+	}
+	return &config.QuotaAwareSchedulingConfig{}
+}
+
+// Enabled 是否启用额度感知调度
+func (s *AccountQuotaService) Enabled() bool {
+	return s != nil && s.currentConfig().Enabled
+}
+
+// Start 启动 Claude OAuth 使用率刷新
+func (s *AccountQuotaService) Start() {
+	if !s.Enabled() || s.accountRepo == nil || s.usageService == nil {
+		return
+	}
+	s.wg.Add(1)
+	go s.refreshLoop()
+	cfg := s.currentConfig()
+	log.Printf("[AccountQuota] Quota-aware scheduling started (refresh every %ds, deprioritize>=%.0f%%, skip>=%.0f%%)",
+		cfg.RefreshIntervalSeconds, cfg.DeprioritizeThreshold, cfg.SkipThreshold)
+}
+
+// Stop 停止刷新
+func (s *AccountQuotaService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *AccountQuotaService) refreshLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(time.Duration(s.currentConfig().RefreshIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	s.refreshAll()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.refreshAll()
+		}
+	}
+}
+
+// refreshAll 刷新所有可调度 Claude OAuth 账号的使用率
+// leader 实例并发查询 usage API 并写入账号 Extra；所有实例从 Extra 加载本地快照。
+func (s *AccountQuotaService) refreshAll() {
+	ctx, cancel := context.WithTimeout(context.Background(), accountQuotaFetchTimeout)
+	accounts, err := s.accountRepo.ListSchedulableByPlatform(ctx, PlatformAnthropic)
+	leader := err == nil && s.tryAcquireLeaderLock(ctx)
+	cancel()
+	if err != nil {
+		log.Printf("[AccountQuota] List accounts failed: %v", err)
+		return
+	}
+	if leader {
+		s.fetchUsage(accounts)
+	}
+
+	seen := make(map[int64]struct{}, len(accounts))
+	for i := range accounts {
+		account := &accounts[i]
+		if !account.CanGetUsage() {
+			continue
+		}
+		seen[account.ID] = struct{}{}
+		// 查询失败或 Extra 中没有快照时保留上一次的快照，避免短暂的查询失败导致调度抖动
+		if quota := quotaFromClaudeExtra(account.Extra); quota != nil {
+			s.quotas.Store(account.ID, quota)
+		}
+	}
+
+	// 清理已删除或不再可调度的账号
+	s.quotas.Range(func(key, _ any) bool {
+		if id, ok := key.(int64); ok {
+			if _, exists := seen[id]; !exists {
+				s.quotas.Delete(id)
+			}
+		}
+		return true
+	})
+}
+
+// tryAcquireLeaderLock 竞争本轮 usage 查询的 leader 锁；没有调度缓存时按单实例处理
+// 锁的 TTL 短于刷新周期，下一轮由各实例重新竞争。
+func (s *AccountQuotaService) tryAcquireLeaderLock(ctx context.Context) bool {
+	if s.schedulerCache == nil {
+		return true
+	}
+	ttl := time.Duration(s.currentConfig().RefreshIntervalSeconds) * time.Second / 2
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	locked, err := s.schedulerCache.TryLockBucket(ctx, accountQuotaLeaderBucket, ttl)
+	if err != nil {
+		log.Printf("[AccountQuota] Leader lock acquisition failed; skipping usage fetch: %v", err)
+		return false
+	}
+	if !locked {
+		return false
+	}
+	trackLeaderLock(accountQuotaLeaderLockName, ttl, nil)
+	return true
+}
+
+// fetchUsage 以有限并发查询账号的 usage API，成功的结果写入账号 Extra 并更新 accounts 中的副本
+func (s *AccountQuotaService) fetchUsage(accounts []Account) {
+	sem := make(chan struct{}, accountQuotaFetchWorkers)
+	var wg sync.WaitGroup
+	for i := range accounts {
+		account := &accounts[i]
+		if !account.CanGetUsage() {
+			continue
+		}
+		select {
+		case <-s.stopCh:
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			ctx, cancel := context.WithTimeout(context.Background(), accountQuotaFetchTimeout)
+			defer cancel()
+			resp, err := s.usageService.GetClaudeUsage(ctx, account)
+			if err != nil {
+				log.Printf("[AccountQuota] Fetch usage failed: account=%d err=%v", account.ID, err)
+				return
+			}
+			updates := claudeUsageExtra(resp, time.Now())
+			if err := s.accountRepo.UpdateExtra(ctx, account.ID, updates); err != nil {
+				log.Printf("[AccountQuota] Save usage snapshot failed: account=%d err=%v", account.ID, err)
+			}
+			extra := make(map[string]any, len(account.Extra)+len(updates))
+			for k, v := range account.Extra {
+				extra[k] = v
+			}
+			for k, v := range updates {
+				extra[k] = v
+			}
+			account.Extra = extra
+		}()
+	}
+	wg.Wait()
+}
+
+// claudeUsageExtra 将 Anthropic usage API 响应转换为写入账号 Extra 的快照字段
+func claudeUsageExtra(resp *ClaudeUsageResponse, now time.Time) map[string]any {
+	return map[string]any{
+		"claude_usage_updated_at":    now.UTC().Format(time.RFC3339),
+		"claude_5h_utilization":      resp.FiveHour.Utilization,
+		"claude_5h_resets_at":        resp.FiveHour.ResetsAt,
+		"claude_7d_utilization":      resp.SevenDay.Utilization,
+		"claude_7d_resets_at":        resp.SevenDay.ResetsAt,
+		"claude_7d_opus_utilization": resp.SevenDayOpus.Utilization,
+		"claude_7d_opus_resets_at":   resp.SevenDayOpus.ResetsAt,
+	}
+}
+
+// quotaFromClaudeExtra 从账号 Extra 中的 Claude 用量快照构建额度快照
+func quotaFromClaudeExtra(extra map[string]any) *AccountQuota {
+	if extra == nil {
+		return nil
+	}
+	updatedAtRaw, _ := extra["claude_usage_updated_at"].(string)
+	updatedAt, err := parseTime(updatedAtRaw)
+	if err != nil {
+		return nil
+	}
+	window := func(prefix string) *AccountQuotaWindow {
+		w := &AccountQuotaWindow{Utilization: parseExtraFloat64(extra[prefix+"_utilization"])}
+		if resetsAt, _ := extra[prefix+"_resets_at"].(string); resetsAt != "" {
+			if t, err := parseTime(resetsAt); err == nil {
+				w.ResetsAt = &t
+			}
+		}
+		return w
+	}
+	return &AccountQuota{
+		FiveHour:     window("claude_5h"),
+		SevenDay:     window("claude_7d"),
+		SevenDayOpus: window("claude_7d_opus"),
+		UpdatedAt:    updatedAt,
+	}
+}
+
+// quotaFromClaudeUsage 将 Anthropic usage API 响应转换为额度快照
+func quotaFromClaudeUsage(resp *ClaudeUsageResponse, now time.Time) *AccountQuota {
+	if resp == nil {
+		return nil
+	}
+	window := func(utilization float64, resetsAt string) *AccountQuotaWindow {
+		w := &AccountQuotaWindow{Utilization: utilization}
+		if resetsAt != "" {
+			if t, err := parseTime(resetsAt); err == nil {
+				w.ResetsAt = &t
+			}
+		}
+		return w
+	}
+	return &AccountQuota{
+		FiveHour:     window(resp.FiveHour.Utilization, resp.FiveHour.ResetsAt),
+		SevenDay:     window(resp.SevenDay.Utilization, resp.SevenDay.ResetsAt),
+		SevenDayOpus: window(resp.SevenDayOpus.Utilization, resp.SevenDayOpus.ResetsAt),
+		UpdatedAt:    now,
+	}
+}
+
+// quotaFromCodexExtra 从 OpenAI 账号 Extra 中的 Codex 用量快照构建额度快照
+func quotaFromCodexExtra(extra map[string]any) *AccountQuota {
+	if extra == nil {
+		return nil
+	}
+	updatedAtRaw, _ := extra["codex_usage_updated_at"].(string)
+	updatedAt, err := parseTime(updatedAtRaw)
+	if err != nil {
+		return nil
+	}
+	window := func(usedKey, resetKey string) *AccountQuotaWindow {
+		used, ok := extra[usedKey]
+		if !ok {
+			return nil
+		}
+		w := &AccountQuotaWindow{Utilization: parseExtraFloat64(used)}
+		if reset, ok := extra[resetKey]; ok {
+			resetsAt := updatedAt.Add(time.Duration(parseExtraInt(reset)) * time.Second)
+			w.ResetsAt = &resetsAt
+		}
+		return w
+	}
+	quota := &AccountQuota{
+		FiveHour:  window("codex_5h_used_percent", "codex_5h_reset_after_seconds"),
+		SevenDay:  window("codex_7d_used_percent", "codex_7d_reset_after_seconds"),
+		UpdatedAt: updatedAt,
+	}
+	if quota.FiveHour == nil && quota.SevenDay == nil {
+		return nil
+	}
+	return quota
+}
+
+// Quota 返回账号的额度快照，无数据返回 nil
+func (s *AccountQuotaService) Quota(account *Account) *AccountQuota {
+	if !s.Enabled() || account == nil {
+		return nil
+	}
+	switch account.Platform {
+	case PlatformAnthropic:
+		if v, ok := s.quotas.Load(account.ID); ok {
+			quota, _ := v.(*AccountQuota)
+			return quota
+		}
+	case PlatformOpenAI:
+		return quotaFromCodexExtra(account.Extra)
+	}
+	return nil
+}
+
+func (s *AccountQuotaService) tier(account *Account, model string, now time.Time) quotaTier {
+	quota := s.Quota(account)
+	if quota == nil {
+		return quotaTierNormal
+	}
+	peak := quota.PeakUtilization(model, now)
+	cfg := s.currentConfig()
+	switch {
+	case peak >= cfg.SkipThreshold:
+		return quotaTierExhausted
+	case peak >= cfg.DeprioritizeThreshold:
+		return quotaTierDeprioritized
+	default:
+		return quotaTierNormal
+	}
+}
+
+// IsExhausted 账号相关窗口的使用率是否已达到跳过阈值
+func (s *AccountQuotaService) IsExhausted(account *Account, model string) bool {
+	if !s.Enabled() {
+		return false
+	}
+	return s.tier(account, model, time.Now()) == quotaTierExhausted
+}
+
+// filterByQuotaTier 保留额度分层最优的账号集合
+// 已耗尽的账号仅在没有其他账号时保留，作为兜底。
+func (s *AccountQuotaService) filterByQuotaTier(accounts []accountWithLoad, model string) []accountWithLoad {
+	if !s.Enabled() || len(accounts) <= 1 {
+		return accounts
+	}
+	now := time.Now()
+	tiers := make([]quotaTier, len(accounts))
+	minTier := quotaTierExhausted
+	for i, acc := range accounts {
+		tiers[i] = s.tier(acc.account, model, now)
+		if tiers[i] < minTier {
+			minTier = tiers[i]
+		}
+	}
+	result := make([]accountWithLoad, 0, len(accounts))
+	for i, acc := range accounts {
+		if tiers[i] == minTier {
+			result = append(result, acc)
+		}
+	}
+	return result
+}
+
+// selectByQuota 在负载相同的候选中按额度选择账号
+//
+// Opus 请求按 seven_day_opus 剩余额度加权随机，分散 Opus 流量；
+// 其他请求优先选择 5h 窗口最早重置的一批账号（相差不超过 accountQuotaResetSpread），尽量用完即将重置的额度。
+// 没有可用于区分的额度数据时返回 nil，由调用方回退到 LRU。
+func (s *AccountQuotaService) selectByQuota(accounts []accountWithLoad, model string) *accountWithLoad {
+	if !s.Enabled() || len(accounts) <= 1 {
+		return nil
+	}
+	now := time.Now()
+
+	if isOpusModel(model) {
+		weights := make([]float64, len(accounts))
+		total, hasData := 0.0, false
+		for i, acc := range accounts {
+			quota := s.Quota(acc.account)
+			if quota != nil && quota.SevenDayOpus.active(now) {
+				hasData = true
+			}
+			weights[i] = quota.opusHeadroom(now)
+			total += weights[i]
+		}
+		if !hasData || total <= 0 {
+			return nil
+		}
+		pick := mathrand.Float64() * total
+		for i := range accounts {
+			pick -= weights[i]
+			if pick < 0 {
+				return &accounts[i]
+			}
+		}
+		return &accounts[len(accounts)-1]
+	}
+
+	// 5h 窗口重置时间接近最早者的账号在其中随机选择，避免流量全部集中到同一个账号
+	resets := make([]*time.Time, len(accounts))
+	var earliest *time.Time
+	for i := range accounts {
+		resets[i] = s.Quota(accounts[i].account).fiveHourResetsAt(now)
+		if resets[i] != nil && (earliest == nil || resets[i].Before(*earliest)) {
+			earliest = resets[i]
+		}
+	}
+	if earliest == nil {
+		return nil
+	}
+	cutoff := earliest.Add(accountQuotaResetSpread)
+	candidates := make([]int, 0, len(accounts))
+	for i, resetsAt := range resets {
+		if resetsAt != nil && !resetsAt.After(cutoff) {
+			candidates = append(candidates, i)
+		}
+	}
+	return &accounts[candidates[mathrand.Intn(len(candidates))]]
+}
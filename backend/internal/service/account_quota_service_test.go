@@ -0,0 +1,206 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccountQuotaServiceForTest() *AccountQuotaService {
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.QuotaAware = config.QuotaAwareSchedulingConfig{
+		Enabled:                true,
+		RefreshIntervalSeconds: 60,
+		DeprioritizeThreshold:  80,
+		SkipThreshold:          98,
+	}
+	return NewAccountQuotaService(nil, nil, nil, cfg)
+}
+
+func quotaWindow(utilization float64, resetsIn time.Duration) *AccountQuotaWindow {
+	resetsAt := time.Now().Add(resetsIn)
+	return &AccountQuotaWindow{Utilization: utilization, ResetsAt: &resetsAt}
+}
+
+func TestAccountQuota_PeakUtilization(t *testing.T) {
+	now := time.Now()
+	quota := &AccountQuota{
+		FiveHour:     quotaWindow(99, -time.Minute), // 已重置，不计入
+		SevenDay:     quotaWindow(50, time.Hour),
+		SevenDayOpus: quotaWindow(90, time.Hour),
+	}
+	require.Equal(t, 50.0, quota.PeakUtilization("claude-sonnet-4-5", now))
+	require.Equal(t, 90.0, quota.PeakUtilization("claude-opus-4-5", now))
+
+	var empty *AccountQuota
+	require.Zero(t, empty.PeakUtilization("claude-opus-4-5", now))
+}
+
+func TestAccountQuotaService_Disabled(t *testing.T) {
+	svc := NewAccountQuotaService(nil, nil, nil, &config.Config{})
+	account := &Account{ID: 1, Platform: PlatformAnthropic}
+	svc.quotas.Store(account.ID, &AccountQuota{FiveHour: quotaWindow(100, time.Hour)})
+
+	require.Nil(t, svc.Quota(account))
+	require.False(t, svc.IsExhausted(account, "claude-sonnet-4-5"))
+
+	var nilSvc *AccountQuotaService
+	require.False(t, nilSvc.IsExhausted(account, ""))
+	require.Nil(t, nilSvc.selectByQuota([]accountWithLoad{{account: account}, {account: account}}, ""))
+}
+
+func TestQuotaFromCodexExtra(t *testing.T) {
+	updatedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	quota := quotaFromCodexExtra(map[string]any{
+		"codex_5h_used_percent":        float64(42),
+		"codex_5h_reset_after_seconds": float64(600),
+		"codex_7d_used_percent":        "99",
+		"codex_usage_updated_at":       updatedAt.Format(time.RFC3339),
+	})
+	require.NotNil(t, quota)
+	require.Equal(t, 42.0, quota.FiveHour.Utilization)
+	require.Equal(t, updatedAt.Add(600*time.Second), *quota.FiveHour.ResetsAt)
+	require.Equal(t, 99.0, quota.SevenDay.Utilization)
+	require.Nil(t, quota.SevenDay.ResetsAt)
+
+	require.Nil(t, quotaFromCodexExtra(map[string]any{"codex_5h_used_percent": 10}), "缺少更新时间时忽略")
+
+	svc := newAccountQuotaServiceForTest()
+	account := &Account{ID: 1, Platform: PlatformOpenAI, Extra: map[string]any{
+		"codex_7d_used_percent":  float64(99),
+		"codex_usage_updated_at": updatedAt.Format(time.RFC3339),
+	}}
+	require.True(t, svc.IsExhausted(account, "gpt-5"))
+}
+
+func TestAccountQuotaService_FilterByQuotaTier(t *testing.T) {
+	svc := newAccountQuotaServiceForTest()
+	normal := &Account{ID: 1, Platform: PlatformAnthropic}
+	deprioritized := &Account{ID: 2, Platform: PlatformAnthropic}
+	exhausted := &Account{ID: 3, Platform: PlatformAnthropic}
+	svc.quotas.Store(normal.ID, &AccountQuota{FiveHour: quotaWindow(10, time.Hour)})
+	svc.quotas.Store(deprioritized.ID, &AccountQuota{SevenDay: quotaWindow(85, time.Hour)})
+	svc.quotas.Store(exhausted.ID, &AccountQuota{FiveHour: quotaWindow(99, time.Hour)})
+
+	all := []accountWithLoad{{account: exhausted}, {account: deprioritized}, {account: normal}}
+	got := svc.filterByQuotaTier(all, "claude-sonnet-4-5")
+	require.Len(t, got, 1)
+	require.Equal(t, normal.ID, got[0].account.ID)
+
+	got = svc.filterByQuotaTier([]accountWithLoad{{account: exhausted}, {account: deprioritized}}, "")
+	require.Len(t, got, 1)
+	require.Equal(t, deprioritized.ID, got[0].account.ID)
+
+	// 全部耗尽时保留作为兜底
+	got = svc.filterByQuotaTier([]accountWithLoad{{account: exhausted}}, "")
+	require.Len(t, got, 1)
+}
+
+func TestAccountQuotaService_SelectByQuota(t *testing.T) {
+	svc := newAccountQuotaServiceForTest()
+	later := &Account{ID: 1, Platform: PlatformAnthropic}
+	sooner := &Account{ID: 2, Platform: PlatformAnthropic}
+	unknown := &Account{ID: 3, Platform: PlatformAnthropic}
+	svc.quotas.Store(later.ID, &AccountQuota{FiveHour: quotaWindow(10, 3*time.Hour), SevenDayOpus: quotaWindow(100, time.Hour)})
+	svc.quotas.Store(sooner.ID, &AccountQuota{FiveHour: quotaWindow(60, time.Hour), SevenDayOpus: quotaWindow(0, time.Hour)})
+
+	candidates := []accountWithLoad{{account: later}, {account: unknown}, {account: sooner}}
+	selected := svc.selectByQuota(candidates, "claude-sonnet-4-5")
+	require.NotNil(t, selected)
+	require.Equal(t, sooner.ID, selected.account.ID, "优先选择 5h 窗口最早重置的账号")
+
+	// Opus 剩余额度为 0 的账号不会被选中
+	for i := 0; i < 50; i++ {
+		selected = svc.selectByQuota(candidates, "claude-opus-4-5")
+		require.NotNil(t, selected)
+		require.NotEqual(t, later.ID, selected.account.ID)
+	}
+
+	require.Nil(t, svc.selectByQuota([]accountWithLoad{{account: unknown}, {account: &Account{ID: 4}}}, ""), "无额度数据时回退 LRU")
+}
+
+func TestAccountQuotaService_SelectByQuotaSpreadsCloseResets(t *testing.T) {
+	svc := newAccountQuotaServiceForTest()
+	first := &Account{ID: 1, Platform: PlatformAnthropic}
+	nearby := &Account{ID: 2, Platform: PlatformAnthropic}
+	far := &Account{ID: 3, Platform: PlatformAnthropic}
+	svc.quotas.Store(first.ID, &AccountQuota{FiveHour: quotaWindow(10, time.Hour)})
+	svc.quotas.Store(nearby.ID, &AccountQuota{FiveHour: quotaWindow(10, time.Hour+5*time.Minute)})
+	svc.quotas.Store(far.ID, &AccountQuota{FiveHour: quotaWindow(10, 3*time.Hour)})
+
+	candidates := []accountWithLoad{{account: first}, {account: nearby}, {account: far}}
+	picked := map[int64]int{}
+	for i := 0; i < 200; i++ {
+		selected := svc.selectByQuota(candidates, "claude-sonnet-4-5")
+		require.NotNil(t, selected)
+		picked[selected.account.ID]++
+	}
+	require.Positive(t, picked[first.ID])
+	require.Positive(t, picked[nearby.ID], "重置时间接近的账号分摊流量")
+	require.Zero(t, picked[far.ID])
+}
+
+type accountQuotaRepoStub struct {
+	accountRepoStub
+	accounts []Account
+
+	mu      sync.Mutex
+	updates map[int64]map[string]any
+}
+
+func (r *accountQuotaRepoStub) ListSchedulableByPlatform(ctx context.Context, platform string) ([]Account, error) {
+	return append([]Account(nil), r.accounts...), nil
+}
+
+func (r *accountQuotaRepoStub) UpdateExtra(ctx context.Context, id int64, updates map[string]any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updates[id] = updates
+	return nil
+}
+
+func newAccountQuotaRefreshTest(t *testing.T, leader bool) (*AccountQuotaService, *accountQuotaRepoStub) {
+	t.Helper()
+	repo := &accountQuotaRepoStub{updates: map[int64]map[string]any{}}
+	usage := &AccountUsageService{cache: NewUsageCache()}
+	for id := int64(1); id <= 3; id++ {
+		resp := &ClaudeUsageResponse{}
+		resp.FiveHour.Utilization = float64(id * 10)
+		resp.FiveHour.ResetsAt = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		usage.cache.apiCache.Store(id, &apiUsageCache{response: resp, timestamp: time.Now()})
+		repo.accounts = append(repo.accounts, Account{ID: id, Platform: PlatformAnthropic, Type: AccountTypeOAuth})
+	}
+	svc := newAccountQuotaServiceForTest()
+	svc.accountRepo = repo
+	svc.usageService = usage
+	svc.schedulerCache = &tokenRefreshSchedulerCacheStub{lockResult: leader}
+	return svc, repo
+}
+
+func TestAccountQuotaService_RefreshAllLeaderPersistsUsage(t *testing.T) {
+	svc, repo := newAccountQuotaRefreshTest(t, true)
+	svc.refreshAll()
+
+	require.Len(t, repo.updates, 3, "leader 查询所有账号并写入 Extra")
+	quota := svc.Quota(&repo.accounts[1])
+	require.NotNil(t, quota)
+	require.Equal(t, 20.0, quota.FiveHour.Utilization)
+}
+
+func TestAccountQuotaService_RefreshAllFollowerLoadsExtra(t *testing.T) {
+	svc, repo := newAccountQuotaRefreshTest(t, false)
+	resp := &ClaudeUsageResponse{}
+	resp.SevenDay.Utilization = 99
+	repo.accounts[0].Extra = claudeUsageExtra(resp, time.Now())
+	svc.refreshAll()
+
+	require.Empty(t, repo.updates, "非 leader 实例不查询 usage API")
+	require.True(t, svc.IsExhausted(&repo.accounts[0], "claude-sonnet-4-5"), "从 leader 写入的 Extra 加载快照")
+	require.Nil(t, svc.Quota(&repo.accounts[1]))
+}
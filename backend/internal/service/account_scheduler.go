@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+)
+
+const (
+	SchedulingStrategyDefault            = domain.SchedulingStrategyDefault
+	SchedulingStrategyWeightedRoundRobin = domain.SchedulingStrategyWeightedRoundRobin
+	SchedulingStrategyLeastCost          = domain.SchedulingStrategyLeastCost
+	SchedulingStrategyLeastLatency       = domain.SchedulingStrategyLeastLatency
+	SchedulingStrategyFillFirst          = domain.SchedulingStrategyFillFirst
+)
+
+const (
+	defaultSchedulingCostWindow   = 5 * time.Hour
+	defaultSchedulingLatencyAlpha = 0.2
+	// 费用滑动窗口的分桶数量，窗口精度为 window/schedulingCostBuckets
+	schedulingCostBuckets = 30
+)
+
+// schedulingRequest 一次账号选择的上下文
+type schedulingRequest struct {
+	groupID     int64
+	model       string
+	preferOAuth bool
+}
+
+// SchedulingStrategy 账号调度策略
+//
+// pick 从候选账号中选出下一个尝试的账号。候选集合已按额度分层与优先级过滤、负载率均低于 100，且保证非空；
+// 获取槽位失败时调用方会移除该账号后再次调用 pick。
+type SchedulingStrategy interface {
+	Name() string
+	pick(req schedulingRequest, candidates []accountWithLoad) *accountWithLoad
+}
+
+// AccountScheduler 账号调度器
+//
+// Anthropic、OpenAI、Gemini 三个网关服务共用同一套选择逻辑：额度分层 → 优先级 → 分组策略。
+// 费用、首字时间、轮询状态均保存在当前实例内存中，多实例部署时各实例独立统计。
+type AccountScheduler struct {
+	defaultStrategy atomic.Value // string
+	quotaService    *AccountQuotaService
+	strategies      map[string]SchedulingStrategy
+
+	costs   *accountCostTracker
+	latency *accountLatencyTracker
+}
+
+// NewAccountScheduler 创建账号调度器
+func NewAccountScheduler(cfg *config.Config, quotaService *AccountQuotaService) *AccountScheduler {
+	costWindow := defaultSchedulingCostWindow
+	alpha := defaultSchedulingLatencyAlpha
+	if cfg != nil {
+		strategyCfg := cfg.Gateway.Scheduling.Strategy
+		if strategyCfg.CostWindowSeconds > 0 {
+			costWindow = time.Duration(strategyCfg.CostWindowSeconds) * time.Second
+		}
+		if strategyCfg.LatencyEWMAAlpha > 0 && strategyCfg.LatencyEWMAAlpha <= 1 {
+			alpha = strategyCfg.LatencyEWMAAlpha
+		}
+	}
+
+	s := &AccountScheduler{
+		quotaService: quotaService,
+		costs:        newAccountCostTracker(costWindow),
+		latency:      newAccountLatencyTracker(alpha),
+	}
+	s.strategies = map[string]SchedulingStrategy{
+		SchedulingStrategyDefault:            &defaultSchedulingStrategy{quotaService: quotaService},
+		SchedulingStrategyWeightedRoundRobin: newWeightedRoundRobinStrategy(),
+		SchedulingStrategyLeastCost:          &leastCostStrategy{costs: s.costs},
+		SchedulingStrategyLeastLatency:       &leastLatencyStrategy{latency: s.latency},
+		SchedulingStrategyFillFirst:          fillFirstStrategy{},
+	}
+	s.ApplyConfig(cfg)
+	return s
+}
+
+// ApplyConfig 应用（热更新后的）全局默认策略，未知策略回退到 default；
+// 费用窗口与首字时间平滑系数在启动时确定，修改后需重启
+func (s *AccountScheduler) ApplyConfig(cfg *config.Config) {
+	defaultStrategy := SchedulingStrategyDefault
+	if cfg != nil {
+		if _, ok := s.strategies[cfg.Gateway.Scheduling.Strategy.Default]; ok {
+			defaultStrategy = cfg.Gateway.Scheduling.Strategy.Default
+		}
+	}
+	s.defaultStrategy.Store(defaultStrategy)
+}
+
+// StrategyForGroup 返回分组使用的调度策略，未指定或未知策略时回退到全局默认
+func (s *AccountScheduler) StrategyForGroup(group *Group) SchedulingStrategy {
+	if s == nil {
+		return &defaultSchedulingStrategy{}
+	}
+	if group != nil {
+		if strategy, ok := s.strategies[group.SchedulingStrategy]; ok {
+			return strategy
+		}
+	}
+	defaultStrategy, _ := s.defaultStrategy.Load().(string)
+	return s.strategies[defaultStrategy]
+}
+
+// strategyFromContext 从鉴权中间件注入的分组上下文解析调度策略
+func (s *AccountScheduler) strategyFromContext(ctx context.Context, groupID *int64) SchedulingStrategy {
+	var group *Group
+	if groupID != nil {
+		if ctxGroup, ok := ctx.Value(ctxkey.Group).(*Group); ok && IsGroupContextValid(ctxGroup) && ctxGroup.ID == *groupID {
+			group = ctxGroup
+		}
+	}
+	return s.StrategyForGroup(group)
+}
+
+// Select 从候选账号中选出下一个尝试的账号，候选为空时返回 nil
+// 额度分层与优先级对所有策略生效，策略只在同一分层、同一优先级的账号之间做选择。
+// 返回值可能指向过滤后的副本，调用方需按账号 ID 从候选中移除已尝试的账号。
+func (s *AccountScheduler) Select(ctx context.Context, groupID *int64, candidates []accountWithLoad, model string, preferOAuth bool) *accountWithLoad {
+	if len(candidates) == 0 {
+		return nil
+	}
+	var quotaService *AccountQuotaService
+	if s != nil {
+		quotaService = s.quotaService
+	}
+	candidates = quotaService.filterByQuotaTier(candidates, model)
+	candidates = filterByMinPriority(candidates)
+	if len(candidates) == 1 {
+		return &candidates[0]
+	}
+	req := schedulingRequest{groupID: derefGroupID(groupID), model: model, preferOAuth: preferOAuth}
+	return s.strategyFromContext(ctx, groupID).pick(req, candidates)
+}
+
+// SelectAccount 在不感知负载的路径上选择账号，候选为空时返回 nil
+func (s *AccountScheduler) SelectAccount(ctx context.Context, groupID *int64, accounts []*Account, model string, preferOAuth bool) *Account {
+	candidates := make([]accountWithLoad, 0, len(accounts))
+	for _, acc := range accounts {
+		candidates = append(candidates, accountWithLoad{
+			account:  acc,
+			loadInfo: &AccountLoadInfo{AccountID: acc.ID},
+		})
+	}
+	selected := s.Select(ctx, groupID, candidates, model, preferOAuth)
+	if selected == nil {
+		return nil
+	}
+	return selected.account
+}
+
+// RecordUsage 记录一次请求的标准费用与首字时间，供 least_cost / least_latency 策略使用
+func (s *AccountScheduler) RecordUsage(accountID int64, standardCost float64, firstTokenMs *int) {
+	if s == nil || accountID <= 0 {
+		return
+	}
+	if standardCost > 0 {
+		s.costs.add(accountID, standardCost, time.Now())
+	}
+	if firstTokenMs != nil && *firstTokenMs >= 0 {
+		s.latency.observe(accountID, float64(*firstTokenMs))
+	}
+}
+
+// defaultSchedulingStrategy 负载率最低 → 额度（Opus 剩余额度 / 5h 窗口最早重置）→ 最久未用
+type defaultSchedulingStrategy struct {
+	quotaService *AccountQuotaService
+}
+
+func (*defaultSchedulingStrategy) Name() string { return SchedulingStrategyDefault }
+
+func (st *defaultSchedulingStrategy) pick(req schedulingRequest, candidates []accountWithLoad) *accountWithLoad {
+	candidates = filterByMinLoadRate(candidates)
+	if selected := st.quotaService.selectByQuota(candidates, req.model); selected != nil {
+		return selected
+	}
+	return selectByLRU(candidates, req.preferOAuth)
+}
+
+// weightedRoundRobinStrategy 平滑加权轮询（同 nginx），权重为账号并发数
+type weightedRoundRobinStrategy struct {
+	mu      sync.Mutex
+	current map[int64]map[int64]int // groupID -> accountID -> 当前权重
+}
+
+func newWeightedRoundRobinStrategy() *weightedRoundRobinStrategy {
+	return &weightedRoundRobinStrategy{current: make(map[int64]map[int64]int)}
+}
+
+func (*weightedRoundRobinStrategy) Name() string { return SchedulingStrategyWeightedRoundRobin }
+
+func (st *weightedRoundRobinStrategy) pick(req schedulingRequest, candidates []accountWithLoad) *accountWithLoad {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	prev := st.current[req.groupID]
+	// 只保留当前候选的状态，已下线账号的权重随之清理
+	next := make(map[int64]int, len(candidates))
+	total := 0
+	best := -1
+	for i, acc := range candidates {
+		weight := acc.account.Concurrency
+		if weight <= 0 {
+			weight = 1
+		}
+		total += weight
+		next[acc.account.ID] = prev[acc.account.ID] + weight
+		if best < 0 || next[acc.account.ID] > next[candidates[best].account.ID] {
+			best = i
+		}
+	}
+	next[candidates[best].account.ID] -= total
+	st.current[req.groupID] = next
+	return &candidates[best]
+}
+
+// leastCostStrategy 选择窗口内标准费用最低的账号，费用相同时最久未用优先
+type leastCostStrategy struct {
+	costs *accountCostTracker
+}
+
+func (*leastCostStrategy) Name() string { return SchedulingStrategyLeastCost }
+
+func (st *leastCostStrategy) pick(req schedulingRequest, candidates []accountWithLoad) *accountWithLoad {
+	now := time.Now()
+	return selectByMinScore(candidates, req.preferOAuth, func(acc *Account) float64 {
+		return st.costs.sum(acc.ID, now)
+	})
+}
+
+// leastLatencyStrategy 选择首字时间 EWMA 最低的账号
+// 尚无样本的账号按其他候选的平均值计分，不会因得分为 0 持续抢占流量；
+// 与最低得分持平时（如只剩一个已采样候选有空闲槽位）按 LRU 获得探测机会。
+type leastLatencyStrategy struct {
+	latency *accountLatencyTracker
+}
+
+func (*leastLatencyStrategy) Name() string { return SchedulingStrategyLeastLatency }
+
+func (st *leastLatencyStrategy) pick(req schedulingRequest, candidates []accountWithLoad) *accountWithLoad {
+	sampled := make(map[int64]float64, len(candidates))
+	sum := 0.0
+	for _, acc := range candidates {
+		if ms, ok := st.latency.get(acc.account.ID); ok {
+			sampled[acc.account.ID] = ms
+			sum += ms
+		}
+	}
+	mean := 0.0
+	if len(sampled) > 0 {
+		mean = sum / float64(len(sampled))
+	}
+	return selectByMinScore(candidates, req.preferOAuth, func(acc *Account) float64 {
+		if ms, ok := sampled[acc.ID]; ok {
+			return ms
+		}
+		return mean
+	})
+}
+
+// fillFirstStrategy 按账号 ID 固定顺序选择，前一个账号满载、限流或额度耗尽后才会使用下一个
+type fillFirstStrategy struct{}
+
+func (fillFirstStrategy) Name() string { return SchedulingStrategyFillFirst }
+
+func (fillFirstStrategy) pick(_ schedulingRequest, candidates []accountWithLoad) *accountWithLoad {
+	selected := &candidates[0]
+	for i := range candidates[1:] {
+		if candidates[i+1].account.ID < selected.account.ID {
+			selected = &candidates[i+1]
+		}
+	}
+	return selected
+}
+
+// selectByMinScore 选出得分最低的账号集合，再按 LRU 选择
+func selectByMinScore(candidates []accountWithLoad, preferOAuth bool, score func(*Account) float64) *accountWithLoad {
+	scores := make([]float64, len(candidates))
+	minScore := 0.0
+	for i, acc := range candidates {
+		scores[i] = score(acc.account)
+		if i == 0 || scores[i] < minScore {
+			minScore = scores[i]
+		}
+	}
+	best := make([]accountWithLoad, 0, len(candidates))
+	for i, acc := range candidates {
+		if scores[i] == minScore {
+			best = append(best, acc)
+		}
+	}
+	return selectByLRU(best, preferOAuth)
+}
+
+// accountCostTracker 按账号统计滑动窗口内的标准费用
+type accountCostTracker struct {
+	mu          sync.Mutex
+	bucketWidth time.Duration
+	accounts    map[int64]*accountCostBuckets
+}
+
+type accountCostBuckets struct {
+	slots  [schedulingCostBuckets]int64 // 分桶对应的时间槽序号
+	values [schedulingCostBuckets]float64
+}
+
+func newAccountCostTracker(window time.Duration) *accountCostTracker {
+	width := window / schedulingCostBuckets
+	if width <= 0 {
+		width = time.Second
+	}
+	return &accountCostTracker{
+		bucketWidth: width,
+		accounts:    make(map[int64]*accountCostBuckets),
+	}
+}
+
+func (t *accountCostTracker) add(accountID int64, cost float64, now time.Time) {
+	slot := now.UnixNano() / int64(t.bucketWidth)
+	idx := slot % schedulingCostBuckets
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b := t.accounts[accountID]
+	if b == nil {
+		b = &accountCostBuckets{}
+		t.accounts[accountID] = b
+	}
+	if b.slots[idx] != slot {
+		b.slots[idx] = slot
+		b.values[idx] = 0
+	}
+	b.values[idx] += cost
+}
+
+func (t *accountCostTracker) sum(accountID int64, now time.Time) float64 {
+	slot := now.UnixNano() / int64(t.bucketWidth)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b := t.accounts[accountID]
+	if b == nil {
+		return 0
+	}
+	total := 0.0
+	for i := range b.slots {
+		if b.slots[i] > slot-schedulingCostBuckets && b.slots[i] <= slot {
+			total += b.values[i]
+		}
+	}
+	return total
+}
+
+// accountLatencyTracker 按账号统计首字时间的指数加权移动平均（毫秒）
+type accountLatencyTracker struct {
+	mu       sync.Mutex
+	alpha    float64
+	accounts map[int64]float64
+}
+
+func newAccountLatencyTracker(alpha float64) *accountLatencyTracker {
+	return &accountLatencyTracker{alpha: alpha, accounts: make(map[int64]float64)}
+}
+
+func (t *accountLatencyTracker) observe(accountID int64, ms float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	prev, ok := t.accounts[accountID]
+	if !ok {
+		t.accounts[accountID] = ms
+		return
+	}
+	t.accounts[accountID] = t.alpha*ms + (1-t.alpha)*prev
+}
+
+// get 返回账号的首字时间 EWMA，尚无样本时 ok 为 false
+func (t *accountLatencyTracker) get(accountID int64) (float64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ms, ok := t.accounts[accountID]
+	return ms, ok
+}
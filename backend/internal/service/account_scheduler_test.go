@@ -0,0 +1,129 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/stretchr/testify/require"
+)
+
+func schedulingCandidates(accounts ...*Account) []accountWithLoad {
+	out := make([]accountWithLoad, 0, len(accounts))
+	for _, acc := range accounts {
+		out = append(out, accountWithLoad{account: acc, loadInfo: &AccountLoadInfo{AccountID: acc.ID}})
+	}
+	return out
+}
+
+func schedulingContext(groupID int64, strategy string) context.Context {
+	group := &Group{ID: groupID, Platform: PlatformAnthropic, Status: StatusActive, Hydrated: true, SchedulingStrategy: strategy}
+	return context.WithValue(context.Background(), ctxkey.Group, group)
+}
+
+func TestAccountScheduler_StrategyForGroup(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.Strategy.Default = SchedulingStrategyFillFirst
+	s := NewAccountScheduler(cfg, nil)
+
+	require.Equal(t, SchedulingStrategyFillFirst, s.StrategyForGroup(nil).Name())
+	require.Equal(t, SchedulingStrategyFillFirst, s.StrategyForGroup(&Group{}).Name(), "未指定策略时使用全局默认")
+	require.Equal(t, SchedulingStrategyLeastCost, s.StrategyForGroup(&Group{SchedulingStrategy: SchedulingStrategyLeastCost}).Name())
+
+	var nilScheduler *AccountScheduler
+	require.Equal(t, SchedulingStrategyDefault, nilScheduler.StrategyForGroup(&Group{SchedulingStrategy: SchedulingStrategyLeastCost}).Name())
+}
+
+func TestAccountScheduler_SelectRespectsPriority(t *testing.T) {
+	s := NewAccountScheduler(&config.Config{}, nil)
+	groupID := int64(1)
+	ctx := schedulingContext(groupID, SchedulingStrategyFillFirst)
+
+	candidates := schedulingCandidates(&Account{ID: 1, Priority: 2}, &Account{ID: 5, Priority: 1}, &Account{ID: 3, Priority: 1})
+	selected := s.Select(ctx, &groupID, candidates, "", false)
+	require.Equal(t, int64(3), selected.account.ID, "优先级过滤后按 ID 顺序用满")
+
+	require.Nil(t, s.Select(ctx, &groupID, nil, "", false))
+}
+
+func TestWeightedRoundRobinStrategy(t *testing.T) {
+	s := NewAccountScheduler(&config.Config{}, nil)
+	groupID := int64(1)
+	ctx := schedulingContext(groupID, SchedulingStrategyWeightedRoundRobin)
+
+	candidates := schedulingCandidates(&Account{ID: 1, Concurrency: 3}, &Account{ID: 2, Concurrency: 1})
+	counts := map[int64]int{}
+	var sequence []int64
+	for i := 0; i < 8; i++ {
+		selected := s.Select(ctx, &groupID, candidates, "", false)
+		counts[selected.account.ID]++
+		sequence = append(sequence, selected.account.ID)
+	}
+	require.Equal(t, 6, counts[1])
+	require.Equal(t, 2, counts[2])
+	require.Equal(t, []int64{1, 1, 2, 1}, sequence[:4], "平滑加权轮询不会连续集中到高权重账号")
+}
+
+func TestLeastCostStrategy(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.Strategy.CostWindowSeconds = 60
+	s := NewAccountScheduler(cfg, nil)
+	groupID := int64(1)
+	ctx := schedulingContext(groupID, SchedulingStrategyLeastCost)
+	candidates := schedulingCandidates(&Account{ID: 1}, &Account{ID: 2})
+
+	s.RecordUsage(1, 2.5, nil)
+	s.RecordUsage(2, 1.0, nil)
+	require.Equal(t, int64(2), s.Select(ctx, &groupID, candidates, "", false).account.ID)
+
+	s.RecordUsage(2, 2.0, nil)
+	require.Equal(t, int64(1), s.Select(ctx, &groupID, candidates, "", false).account.ID)
+
+	// 窗口外的费用不再计入
+	past := time.Now().Add(-2 * time.Minute)
+	s.costs.add(3, 100, past)
+	require.Zero(t, s.costs.sum(3, time.Now()))
+	require.InDelta(t, 100, s.costs.sum(3, past), 1e-9)
+}
+
+func TestLeastLatencyStrategy(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.Strategy.LatencyEWMAAlpha = 0.5
+	s := NewAccountScheduler(cfg, nil)
+	groupID := int64(1)
+	ctx := schedulingContext(groupID, SchedulingStrategyLeastLatency)
+	candidates := schedulingCandidates(&Account{ID: 1}, &Account{ID: 2})
+
+	fast, slow := 200, 800
+	s.RecordUsage(1, 0, &slow)
+	s.RecordUsage(2, 0, &fast)
+	require.Equal(t, int64(2), s.Select(ctx, &groupID, candidates, "", false).account.ID)
+
+	spike := 2000
+	s.RecordUsage(2, 0, &spike)
+	latency, ok := s.latency.get(2)
+	require.True(t, ok)
+	require.InDelta(t, 1100, latency, 1e-9)
+	require.Equal(t, int64(1), s.Select(ctx, &groupID, candidates, "", false).account.ID)
+
+	// 没有样本的账号按其他候选的平均值（950）计分，不会压过更快的账号
+	candidates = append(candidates, schedulingCandidates(&Account{ID: 3})...)
+	require.Equal(t, int64(1), s.Select(ctx, &groupID, candidates, "", false).account.ID)
+
+	// 与唯一的已采样候选持平时按 LRU 探测没有样本的账号
+	now := time.Now()
+	candidates = schedulingCandidates(&Account{ID: 2, LastUsedAt: &now}, &Account{ID: 3})
+	require.Equal(t, int64(3), s.Select(ctx, &groupID, candidates, "", false).account.ID)
+}
+
+func TestAccountScheduler_SelectAccount(t *testing.T) {
+	var s *AccountScheduler
+	now := time.Now()
+	accounts := []*Account{{ID: 1, LastUsedAt: &now}, {ID: 2}}
+	require.Equal(t, int64(2), s.SelectAccount(context.Background(), nil, accounts, "", false).ID, "默认策略优先最久未用")
+	require.Nil(t, s.SelectAccount(context.Background(), nil, nil, "", false))
+}
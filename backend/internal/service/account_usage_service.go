@@ -134,6 +134,7 @@ type UsageInfo struct {
 	FiveHour           *UsageProgress `json:"five_hour"`                      // 5小时窗口
 	SevenDay           *UsageProgress `json:"seven_day,omitempty"`            // 7天窗口
 	SevenDaySonnet     *UsageProgress `json:"seven_day_sonnet,omitempty"`     // 7天Sonnet窗口
+	SevenDayOpus       *UsageProgress `json:"seven_day_opus,omitempty"`       // 7天Opus窗口
 	GeminiSharedDaily  *UsageProgress `json:"gemini_shared_daily,omitempty"`  // Gemini shared pool RPD (Google One / Code Assist)
 	GeminiProDaily     *UsageProgress `json:"gemini_pro_daily,omitempty"`     // Gemini Pro 日配额
 	GeminiFlashDaily   *UsageProgress `json:"gemini_flash_daily,omitempty"`   // Gemini Flash 日配额
@@ -159,6 +160,10 @@ type ClaudeUsageResponse struct {
 		Utilization float64 `json:"utilization"`
 		ResetsAt    string  `json:"resets_at"`
 	} `json:"seven_day_sonnet"`
+	SevenDayOpus struct {
+		Utilization float64 `json:"utilization"`
+		ResetsAt    string  `json:"resets_at"`
+	} `json:"seven_day_opus"`
 }
 
 // ClaudeUsageFetchOptions 包含获取 Claude 用量数据所需的所有选项
@@ -256,34 +261,9 @@ func (s *AccountUsageService) GetUsage(ctx context.Context, accountID int64) (*U
 
 	// 只有oauth类型账号可以通过API获取usage（有profile scope）
 	if account.CanGetUsage() {
-		var apiResp *ClaudeUsageResponse
-
-		// 1. 检查 API 缓存（10 分钟）
-		if cached, ok := s.cache.apiCache.Load(accountID); ok {
-			if cache, ok := cached.(*apiUsageCache); ok && time.Since(cache.timestamp) < apiCacheTTL {
-				apiResp = cache.response
-			}
-		}
-
-		// 2. 如果没有缓存，从 API 获取
-		if apiResp == nil {
-			apiResp, err = s.fetchOAuthUsageRaw(ctx, account)
-			if err != nil {
-				if s.shouldAttemptOAuthAuthRecovery(err) && s.tryRecoverOAuthAuthError(ctx, account, err) {
-					apiResp, err = s.fetchOAuthUsageRaw(ctx, account)
-				}
-				if err != nil {
-					if s.isOAuthAuthError(err) {
-						return nil, s.wrapUsageAuthError(account.Platform, err)
-					}
-					return nil, s.wrapUsageFetchError(account.Platform, err)
-				}
-			}
-			// 缓存 API 响应
-			s.cache.apiCache.Store(accountID, &apiUsageCache{
-				response:  apiResp,
-				timestamp: time.Now(),
-			})
+		apiResp, err := s.GetClaudeUsage(ctx, account)
+		if err != nil {
+			return nil, err
 		}
 
 		// 3. 构建 UsageInfo（每次都重新计算 RemainingSeconds）
@@ -308,6 +288,37 @@ func (s *AccountUsageService) GetUsage(ctx context.Context, accountID int64) (*U
 	return nil, fmt.Errorf("account type %s does not support usage query", account.Type)
 }
 
+// GetClaudeUsage 获取 Anthropic OAuth 账号的原始使用率数据
+// 与账号用量展示共用 API 缓存，调度器的额度刷新不会额外增加上游请求。
+func (s *AccountUsageService) GetClaudeUsage(ctx context.Context, account *Account) (*ClaudeUsageResponse, error) {
+	// 1. 检查 API 缓存
+	if cached, ok := s.cache.apiCache.Load(account.ID); ok {
+		if cache, ok := cached.(*apiUsageCache); ok && time.Since(cache.timestamp) < apiCacheTTL {
+			return cache.response, nil
+		}
+	}
+
+	// 2. 如果没有缓存，从 API 获取
+	apiResp, err := s.fetchOAuthUsageRaw(ctx, account)
+	if err != nil {
+		if s.shouldAttemptOAuthAuthRecovery(err) && s.tryRecoverOAuthAuthError(ctx, account, err) {
+			apiResp, err = s.fetchOAuthUsageRaw(ctx, account)
+		}
+		if err != nil {
+			if s.isOAuthAuthError(err) {
+				return nil, s.wrapUsageAuthError(account.Platform, err)
+			}
+			return nil, s.wrapUsageFetchError(account.Platform, err)
+		}
+	}
+	// 缓存 API 响应
+	s.cache.apiCache.Store(account.ID, &apiUsageCache{
+		response:  apiResp,
+		timestamp: time.Now(),
+	})
+	return apiResp, nil
+}
+
 func (s *AccountUsageService) getGeminiUsage(ctx context.Context, account *Account) (*UsageInfo, error) {
 	now := time.Now()
 	usage := &UsageInfo{
@@ -787,6 +798,22 @@ func (s *AccountUsageService) buildUsageInfo(resp *ClaudeUsageResponse, updatedA
 		}
 	}
 
+	// 7天Opus窗口
+	if resp.SevenDayOpus.ResetsAt != "" {
+		if opusReset, err := parseTime(resp.SevenDayOpus.ResetsAt); err == nil {
+			info.SevenDayOpus = &UsageProgress{
+				Utilization:      resp.SevenDayOpus.Utilization,
+				ResetsAt:         &opusReset,
+				RemainingSeconds: int(time.Until(opusReset).Seconds()),
+			}
+		} else {
+			log.Printf("Failed to parse SevenDayOpus.ResetsAt: %s, error: %v", resp.SevenDayOpus.ResetsAt, err)
+			info.SevenDayOpus = &UsageProgress{
+				Utilization: resp.SevenDayOpus.Utilization,
+			}
+		}
+	}
+
 	return info
 }
 
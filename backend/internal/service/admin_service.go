@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/domain"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 )
 
@@ -83,6 +84,7 @@ type CreateUserInput struct {
 	Notes         string
 	Balance       float64
 	Concurrency   int
+	QueueWeight   int // 0 表示使用默认权重
 	AllowedGroups []int64
 }
 
@@ -93,6 +95,7 @@ type UpdateUserInput struct {
 	Notes         *string
 	Balance       *float64 // 使用指针区分"未提供"和"设置为0"
 	Concurrency   *int     // 使用指针区分"未提供"和"设置为0"
+	QueueWeight   *int
 	Status        string
 	AllowedGroups *[]int64 // 使用指针区分"未提供"和"设置为空数组"
 	// GroupRates 用户专属分组倍率配置
@@ -124,6 +127,14 @@ type CreateGroupInput struct {
 	MCPXMLInject        *bool
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes []string
+	// 模型降级链
+	ModelFallbacks []ModelFallbackRule
+	// 模型倍率：模型匹配模式 -> 倍率
+	ModelRateMultipliers map[string]float64
+	// 账号调度策略（空表示使用全局默认）
+	SchedulingStrategy string
+	// 流式首字节预算（秒），0 表示使用全局配置
+	FirstByteTimeoutSeconds int
 	// 从指定分组复制账号（创建分组后在同一事务内绑定）
 	CopyAccountsFromGroupIDs []int64
 }
@@ -153,6 +164,14 @@ type UpdateGroupInput struct {
 	MCPXMLInject        *bool
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes *[]string
+	// 模型降级链（非 nil 时整体替换）
+	ModelFallbacks *[]ModelFallbackRule
+	// 模型倍率（非 nil 时整体替换）
+	ModelRateMultipliers *map[string]float64
+	// 账号调度策略（非 nil 时更新，空字符串表示使用全局默认）
+	SchedulingStrategy *string
+	// 流式首字节预算（非 nil 时更新，0 表示使用全局配置）
+	FirstByteTimeoutSeconds *int
 	// 从指定分组复制账号（同步操作：先清空当前分组的账号绑定，再绑定源分组的账号）
 	CopyAccountsFromGroupIDs []int64
 }
@@ -305,6 +324,8 @@ type adminServiceImpl struct {
 	proxyProber          ProxyExitInfoProber
 	proxyLatencyCache    ProxyLatencyCache
 	authCacheInvalidator APIKeyAuthCacheInvalidator
+	routingRuleService   *RoutingRuleService
+	clientRuleService    *ClientRuleService
 }
 
 // NewAdminService creates a new AdminService
@@ -320,6 +341,8 @@ func NewAdminService(
 	proxyProber ProxyExitInfoProber,
 	proxyLatencyCache ProxyLatencyCache,
 	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	routingRuleService *RoutingRuleService,
+	clientRuleService *ClientRuleService,
 ) AdminService {
 	return &adminServiceImpl{
 		userRepo:             userRepo,
@@ -333,6 +356,8 @@ func NewAdminService(
 		proxyProber:          proxyProber,
 		proxyLatencyCache:    proxyLatencyCache,
 		authCacheInvalidator: authCacheInvalidator,
+		routingRuleService:   routingRuleService,
+		clientRuleService:    clientRuleService,
 	}
 }
 
@@ -382,6 +407,7 @@ func (s *adminServiceImpl) CreateUser(ctx context.Context, input *CreateUserInpu
 		Role:          RoleUser, // Always create as regular user, never admin
 		Balance:       input.Balance,
 		Concurrency:   input.Concurrency,
+		QueueWeight:   input.QueueWeight,
 		Status:        StatusActive,
 		AllowedGroups: input.AllowedGroups,
 	}
@@ -433,6 +459,10 @@ func (s *adminServiceImpl) UpdateUser(ctx context.Context, id int64, input *Upda
 		user.Concurrency = *input.Concurrency
 	}
 
+	if input.QueueWeight != nil {
+		user.QueueWeight = *input.QueueWeight
+	}
+
 	if input.AllowedGroups != nil {
 		user.AllowedGroups = *input.AllowedGroups
 	}
@@ -657,6 +687,22 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		}
 	}
 
+	modelFallbacks, err := domain.NormalizeModelFallbackRules(input.ModelFallbacks)
+	if err != nil {
+		return nil, err
+	}
+	modelRateMultipliers, err := domain.NormalizeModelRateMultipliers(input.ModelRateMultipliers)
+	if err != nil {
+		return nil, err
+	}
+	schedulingStrategy, err := domain.NormalizeSchedulingStrategy(input.SchedulingStrategy)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFirstByteTimeout(input.FirstByteTimeoutSeconds); err != nil {
+		return nil, err
+	}
+
 	// MCPXMLInject：默认为 true，仅当显式传入 false 时关闭
 	mcpXMLInject := true
 	if input.MCPXMLInject != nil {
@@ -715,6 +761,10 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		ModelRouting:                    input.ModelRouting,
 		MCPXMLInject:                    mcpXMLInject,
 		SupportedModelScopes:            input.SupportedModelScopes,
+		ModelFallbacks:                  modelFallbacks,
+		ModelRateMultipliers:            modelRateMultipliers,
+		SchedulingStrategy:              schedulingStrategy,
+		FirstByteTimeoutSeconds:         input.FirstByteTimeoutSeconds,
 	}
 	if err := s.groupRepo.Create(ctx, group); err != nil {
 		return nil, err
@@ -913,6 +963,41 @@ func (s *adminServiceImpl) UpdateGroup(ctx context.Context, id int64, input *Upd
 		group.SupportedModelScopes = *input.SupportedModelScopes
 	}
 
+	// 模型降级链
+	if input.ModelFallbacks != nil {
+		modelFallbacks, err := domain.NormalizeModelFallbackRules(*input.ModelFallbacks)
+		if err != nil {
+			return nil, err
+		}
+		group.ModelFallbacks = modelFallbacks
+	}
+
+	// 模型倍率
+	if input.ModelRateMultipliers != nil {
+		modelRateMultipliers, err := domain.NormalizeModelRateMultipliers(*input.ModelRateMultipliers)
+		if err != nil {
+			return nil, err
+		}
+		group.ModelRateMultipliers = modelRateMultipliers
+	}
+
+	// 账号调度策略
+	if input.SchedulingStrategy != nil {
+		schedulingStrategy, err := domain.NormalizeSchedulingStrategy(*input.SchedulingStrategy)
+		if err != nil {
+			return nil, err
+		}
+		group.SchedulingStrategy = schedulingStrategy
+	}
+
+	// 流式首字节预算
+	if input.FirstByteTimeoutSeconds != nil {
+		if err := validateFirstByteTimeout(*input.FirstByteTimeoutSeconds); err != nil {
+			return nil, err
+		}
+		group.FirstByteTimeoutSeconds = *input.FirstByteTimeoutSeconds
+	}
+
 	if err := s.groupRepo.Update(ctx, group); err != nil {
 		return nil, err
 	}
@@ -967,9 +1052,16 @@ func (s *adminServiceImpl) UpdateGroup(ctx context.Context, id int64, input *Upd
 	if s.authCacheInvalidator != nil {
 		s.authCacheInvalidator.InvalidateAuthCacheByGroupID(ctx, id)
 	}
+	s.invalidateRuleGroupTargets(id)
 	return group, nil
 }
 
+// invalidateRuleGroupTargets 分组变更后刷新把该分组作为路由目标或降级分组的规则快照
+func (s *adminServiceImpl) invalidateRuleGroupTargets(groupID int64) {
+	s.routingRuleService.InvalidateGroup(groupID)
+	s.clientRuleService.InvalidateGroup(groupID)
+}
+
 func (s *adminServiceImpl) DeleteGroup(ctx context.Context, id int64) error {
 	var groupKeys []string
 	if s.authCacheInvalidator != nil {
@@ -1003,6 +1095,7 @@ func (s *adminServiceImpl) DeleteGroup(ctx context.Context, id int64) error {
 			s.authCacheInvalidator.InvalidateAuthCacheByKey(ctx, key)
 		}
 	}
+	s.invalidateRuleGroupTargets(id)
 
 	return nil
 }
@@ -344,6 +344,14 @@ func (s *billingCacheStub) InvalidateSubscriptionCache(ctx context.Context, user
 	return nil
 }
 
+func (s *billingCacheStub) ReserveCost(ctx context.Context, userID, groupID int64, reservationID string, amount, available float64, ttl time.Duration) (float64, error) {
+	return amount, nil
+}
+
+func (s *billingCacheStub) ReleaseCostReservation(ctx context.Context, userID, groupID int64, reservationID string) error {
+	return nil
+}
+
 func waitForInvalidations(t *testing.T, ch <-chan subscriptionInvalidateCall, expected int) []subscriptionInvalidateCall {
 	t.Helper()
 	calls := make([]subscriptionInvalidateCall, 0, expected)
@@ -20,8 +20,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/antigravity"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -813,6 +815,8 @@ type AntigravityGatewayService struct {
 	settingService    *SettingService
 	cache             GatewayCache // 用于模型级限流时清除粘性会话绑定
 	schedulerSnapshot *SchedulerSnapshotService
+
+	hotConfig // 可热更新的配置项通过 getConfig 读取
 }
 
 func NewAntigravityGatewayService(
@@ -840,6 +844,14 @@ func (s *AntigravityGatewayService) GetTokenProvider() *AntigravityTokenProvider
 	return s.tokenProvider
 }
 
+// getConfig 获取当前配置（热更新后为最新发布的快照），未注入 SettingService 且未发布快照时返回 nil
+func (s *AntigravityGatewayService) getConfig() *config.Config {
+	if s.settingService == nil {
+		return s.load(nil)
+	}
+	return s.load(s.settingService.cfg)
+}
+
 // getLogConfig 获取上游错误日志配置
 // 返回是否记录日志体和最大字节数
 func (s *AntigravityGatewayService) getLogConfig() (logBody bool, maxBytes int) {
@@ -1284,6 +1296,9 @@ func isModelNotFoundError(statusCode int, body []byte) bool {
 //	          ├─ 成功 → 正常返回
 //	          └─ 失败 → 设置模型限流 + 清除粘性绑定 → 切换账号
 func (s *AntigravityGatewayService) Forward(ctx context.Context, c *gin.Context, account *Account, body []byte, isStickySession bool) (*ForwardResult, error) {
+	ctx, span := tracing.Start(ctx, "gateway.forward", tracing.AttrAccountID.Int64(account.ID), tracing.AttrPlatform.String(account.Platform))
+	defer span.End()
+
 	// 上游透传账号直接转发，不走 OAuth token 刷新
 	if account.Type == AccountTypeUpstream {
 		return s.ForwardUpstream(ctx, c, account, body)
@@ -1345,9 +1360,13 @@ func (s *AntigravityGatewayService) Forward(ctx context.Context, c *gin.Context,
 	// 如果客户端请求非流式，在响应处理阶段会收集完整流式响应后转换返回
 	action := "streamGenerateContent"
 
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.getConfig(), claudeReq.Stream)
+	defer firstByte.release()
+
 	// 执行带重试的请求
 	result, err := s.antigravityRetryLoop(antigravityRetryLoopParams{
-		ctx:             ctx,
+		ctx:             upstreamCtx,
 		prefix:          prefix,
 		account:         account,
 		proxyURL:        proxyURL,
@@ -1365,6 +1384,9 @@ func (s *AntigravityGatewayService) Forward(ctx context.Context, c *gin.Context,
 		sessionHash:     "",              // Forward 方法没有 sessionHash，由上层处理粘性会话清除
 	})
 	if err != nil {
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
 		// 检查是否是账号切换信号，转换为 UpstreamFailoverError 让 Handler 切换账号
 		if switchErr, ok := IsAntigravityAccountSwitchError(err); ok {
 			return nil, &UpstreamFailoverError{
@@ -1427,7 +1449,7 @@ func (s *AntigravityGatewayService) Forward(ctx context.Context, c *gin.Context,
 					continue
 				}
 				retryResult, retryErr := s.antigravityRetryLoop(antigravityRetryLoopParams{
-					ctx:             ctx,
+					ctx:             upstreamCtx,
 					prefix:          prefix,
 					account:         account,
 					proxyURL:        proxyURL,
@@ -1445,6 +1467,9 @@ func (s *AntigravityGatewayService) Forward(ctx context.Context, c *gin.Context,
 					sessionHash:     "", // Forward 方法没有 sessionHash，由上层处理粘性会话清除
 				})
 				if retryErr != nil {
+					if firstByte.expired() {
+						return nil, firstByte.failover(c, account)
+					}
 					appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
 						Platform:           account.Platform,
 						AccountID:          account.ID,
@@ -1598,7 +1623,7 @@ func (s *AntigravityGatewayService) Forward(ctx context.Context, c *gin.Context,
 	var clientDisconnect bool
 	if claudeReq.Stream {
 		// 客户端要求流式，直接透传转换
-		streamRes, err := s.handleClaudeStreamingResponse(c, resp, startTime, originalModel)
+		streamRes, err := s.handleClaudeStreamingResponse(c, resp, account, startTime, originalModel, firstByte)
 		if err != nil {
 			log.Printf("%s status=stream_error error=%v", prefix, err)
 			return nil, err
@@ -1658,9 +1683,20 @@ func isPromptTooLongError(respBody []byte) bool {
 	return strings.Contains(msg, "prompt is too long") ||
 		strings.Contains(msg, "request is too long") ||
 		strings.Contains(msg, "context length exceeded") ||
+		strings.Contains(msg, "context_length_exceeded") ||
+		strings.Contains(msg, "maximum context length") ||
 		strings.Contains(msg, "max_tokens")
 }
 
+// shouldReturnPromptTooLong 判断上下文超长错误是否应交由 Handler 做模型降级，而不是直接写回客户端
+func shouldReturnPromptTooLong(ctx context.Context, statusCode int, respBody []byte) bool {
+	if statusCode != http.StatusBadRequest {
+		return false
+	}
+	enabled, _ := ctx.Value(ctxkey.ModelFallbackOnContextTooLong).(bool)
+	return enabled && isPromptTooLongError(respBody)
+}
+
 // isPassthroughErrorMessage 检查错误消息是否在透传白名单中
 func isPassthroughErrorMessage(msg string) bool {
 	lower := strings.ToLower(msg)
@@ -2018,8 +2054,12 @@ func (s *AntigravityGatewayService) ForwardGemini(ctx context.Context, c *gin.Co
 	upstreamAction := "streamGenerateContent"
 
 	// 执行带重试的请求
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.getConfig(), stream)
+	defer firstByte.release()
+
 	result, err := s.antigravityRetryLoop(antigravityRetryLoopParams{
-		ctx:             ctx,
+		ctx:             upstreamCtx,
 		prefix:          prefix,
 		account:         account,
 		proxyURL:        proxyURL,
@@ -2037,6 +2077,9 @@ func (s *AntigravityGatewayService) ForwardGemini(ctx context.Context, c *gin.Co
 		sessionHash:     "",              // ForwardGemini 方法没有 sessionHash，由上层处理粘性会话清除
 	})
 	if err != nil {
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
 		// 检查是否是账号切换信号，转换为 UpstreamFailoverError 让 Handler 切换账号
 		if switchErr, ok := IsAntigravityAccountSwitchError(err); ok {
 			return nil, &UpstreamFailoverError{
@@ -2070,7 +2113,7 @@ func (s *AntigravityGatewayService) ForwardGemini(ctx context.Context, c *gin.Co
 
 				fallbackWrapped, err := s.wrapV1InternalRequest(projectID, fallbackModel, injectedBody)
 				if err == nil {
-					fallbackReq, err := antigravity.NewAPIRequest(ctx, upstreamAction, accessToken, fallbackWrapped)
+					fallbackReq, err := antigravity.NewAPIRequest(upstreamCtx, upstreamAction, accessToken, fallbackWrapped)
 					if err == nil {
 						fallbackResp, err := s.httpUpstream.Do(fallbackReq, proxyURL, account.ID, account.Concurrency)
 						if err == nil && fallbackResp.StatusCode < 400 {
@@ -2166,7 +2209,7 @@ handleSuccess:
 
 	if stream {
 		// 客户端要求流式，直接透传
-		streamRes, err := s.handleGeminiStreamingResponse(c, resp, startTime)
+		streamRes, err := s.handleGeminiStreamingResponse(c, resp, account, startTime, firstByte)
 		if err != nil {
 			log.Printf("%s status=stream_error error=%v", prefix, err)
 			return nil, err
@@ -2763,7 +2806,7 @@ func handleStreamReadError(err error, clientDisconnected bool, prefix string) (d
 	return false, false
 }
 
-func (s *AntigravityGatewayService) handleGeminiStreamingResponse(c *gin.Context, resp *http.Response, startTime time.Time) (*antigravityStreamResult, error) {
+func (s *AntigravityGatewayService) handleGeminiStreamingResponse(c *gin.Context, resp *http.Response, account *Account, startTime time.Time, firstByte *firstByteGuard) (*antigravityStreamResult, error) {
 	c.Status(resp.StatusCode)
 	c.Header("Cache-Control", "no-cache")
 	c.Header("Connection", "keep-alive")
@@ -2810,6 +2853,7 @@ func (s *AntigravityGatewayService) handleGeminiStreamingResponse(c *gin.Context
 	go func() {
 		defer close(events)
 		for scanner.Scan() {
+			firstByte.markReceived()
 			atomic.StoreInt64(&lastReadAt, time.Now().UnixNano())
 			if !sendEvent(scanEvent{line: scanner.Text()}) {
 				return
@@ -2823,8 +2867,8 @@ func (s *AntigravityGatewayService) handleGeminiStreamingResponse(c *gin.Context
 
 	// 上游数据间隔超时保护（防止上游挂起长期占用连接）
 	streamInterval := time.Duration(0)
-	if s.settingService.cfg != nil && s.settingService.cfg.Gateway.StreamDataIntervalTimeout > 0 {
-		streamInterval = time.Duration(s.settingService.cfg.Gateway.StreamDataIntervalTimeout) * time.Second
+	if cfg := s.getConfig(); cfg != nil && cfg.Gateway.StreamDataIntervalTimeout > 0 {
+		streamInterval = time.Duration(cfg.Gateway.StreamDataIntervalTimeout) * time.Second
 	}
 	var intervalTicker *time.Ticker
 	if streamInterval > 0 {
@@ -2856,6 +2900,10 @@ func (s *AntigravityGatewayService) handleGeminiStreamingResponse(c *gin.Context
 				return &antigravityStreamResult{usage: usage, firstTokenMs: firstTokenMs, clientDisconnect: cw.Disconnected()}, nil
 			}
 			if ev.err != nil {
+				// 首字节预算超时：尚未向客户端输出任何内容，切换账号重试
+				if firstByte.expired() {
+					return nil, firstByte.failover(c, account)
+				}
 				if disconnect, handled := handleStreamReadError(ev.err, cw.Disconnected(), "antigravity gemini"); handled {
 					return &antigravityStreamResult{usage: usage, firstTokenMs: firstTokenMs, clientDisconnect: disconnect}, nil
 				}
@@ -2983,8 +3031,8 @@ func (s *AntigravityGatewayService) handleGeminiStreamToNonStreaming(c *gin.Cont
 
 	// 上游数据间隔超时保护（防止上游挂起长期占用连接）
 	streamInterval := time.Duration(0)
-	if s.settingService.cfg != nil && s.settingService.cfg.Gateway.StreamDataIntervalTimeout > 0 {
-		streamInterval = time.Duration(s.settingService.cfg.Gateway.StreamDataIntervalTimeout) * time.Second
+	if cfg := s.getConfig(); cfg != nil && cfg.Gateway.StreamDataIntervalTimeout > 0 {
+		streamInterval = time.Duration(cfg.Gateway.StreamDataIntervalTimeout) * time.Second
 	}
 	var intervalTicker *time.Ticker
 	if streamInterval > 0 {
@@ -3444,8 +3492,8 @@ func (s *AntigravityGatewayService) handleClaudeStreamToNonStreaming(c *gin.Cont
 
 	// 上游数据间隔超时保护（防止上游挂起长期占用连接）
 	streamInterval := time.Duration(0)
-	if s.settingService.cfg != nil && s.settingService.cfg.Gateway.StreamDataIntervalTimeout > 0 {
-		streamInterval = time.Duration(s.settingService.cfg.Gateway.StreamDataIntervalTimeout) * time.Second
+	if cfg := s.getConfig(); cfg != nil && cfg.Gateway.StreamDataIntervalTimeout > 0 {
+		streamInterval = time.Duration(cfg.Gateway.StreamDataIntervalTimeout) * time.Second
 	}
 	var intervalTicker *time.Ticker
 	if streamInterval > 0 {
@@ -3566,7 +3614,7 @@ returnResponse:
 }
 
 // handleClaudeStreamingResponse 处理 Claude 流式响应（Gemini SSE → Claude SSE 转换）
-func (s *AntigravityGatewayService) handleClaudeStreamingResponse(c *gin.Context, resp *http.Response, startTime time.Time, originalModel string) (*antigravityStreamResult, error) {
+func (s *AntigravityGatewayService) handleClaudeStreamingResponse(c *gin.Context, resp *http.Response, account *Account, startTime time.Time, originalModel string, firstByte *firstByteGuard) (*antigravityStreamResult, error) {
 	c.Header("Content-Type", "text/event-stream")
 	c.Header("Cache-Control", "no-cache")
 	c.Header("Connection", "keep-alive")
@@ -3621,6 +3669,7 @@ func (s *AntigravityGatewayService) handleClaudeStreamingResponse(c *gin.Context
 	go func() {
 		defer close(events)
 		for scanner.Scan() {
+			firstByte.markReceived()
 			atomic.StoreInt64(&lastReadAt, time.Now().UnixNano())
 			if !sendEvent(scanEvent{line: scanner.Text()}) {
 				return
@@ -3633,8 +3682,8 @@ func (s *AntigravityGatewayService) handleClaudeStreamingResponse(c *gin.Context
 	defer close(done)
 
 	streamInterval := time.Duration(0)
-	if s.settingService.cfg != nil && s.settingService.cfg.Gateway.StreamDataIntervalTimeout > 0 {
-		streamInterval = time.Duration(s.settingService.cfg.Gateway.StreamDataIntervalTimeout) * time.Second
+	if cfg := s.getConfig(); cfg != nil && cfg.Gateway.StreamDataIntervalTimeout > 0 {
+		streamInterval = time.Duration(cfg.Gateway.StreamDataIntervalTimeout) * time.Second
 	}
 	var intervalTicker *time.Ticker
 	if streamInterval > 0 {
@@ -3677,6 +3726,10 @@ func (s *AntigravityGatewayService) handleClaudeStreamingResponse(c *gin.Context
 				return &antigravityStreamResult{usage: convertUsage(agUsage), firstTokenMs: firstTokenMs, clientDisconnect: cw.Disconnected()}, nil
 			}
 			if ev.err != nil {
+				// 首字节预算超时：尚未向客户端输出任何内容，切换账号重试
+				if firstByte.expired() {
+					return nil, firstByte.failover(c, account)
+				}
 				if disconnect, handled := handleStreamReadError(ev.err, cw.Disconnected(), "antigravity claude"); handled {
 					return &antigravityStreamResult{usage: finishUsage(), firstTokenMs: firstTokenMs, clientDisconnect: disconnect}, nil
 				}
@@ -3881,7 +3934,11 @@ func (s *AntigravityGatewayService) ForwardUpstream(ctx context.Context, c *gin.
 	upstreamURL := baseURL + "/v1/messages"
 
 	// 创建请求
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, upstreamURL, bytes.NewReader(body))
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.getConfig(), claudeReq.Stream)
+	defer firstByte.release()
+
+	req, err := http.NewRequestWithContext(upstreamCtx, http.MethodPost, upstreamURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("create upstream request: %w", err)
 	}
@@ -3908,6 +3965,9 @@ func (s *AntigravityGatewayService) ForwardUpstream(ctx context.Context, c *gin.
 	// 发送请求
 	resp, err := s.httpUpstream.Do(req, proxyURL, account.ID, account.Concurrency)
 	if err != nil {
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
 		log.Printf("%s upstream request failed: %v", prefix, err)
 		return nil, fmt.Errorf("upstream request failed: %w", err)
 	}
@@ -3945,7 +4005,10 @@ func (s *AntigravityGatewayService) ForwardUpstream(ctx context.Context, c *gin.
 		c.Header("X-Accel-Buffering", "no")
 		c.Status(http.StatusOK)
 
-		streamRes := s.streamUpstreamResponse(c, resp, startTime)
+		streamRes := s.streamUpstreamResponse(c, resp, startTime, firstByte)
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
 		usage = streamRes.usage
 		firstTokenMs = streamRes.firstTokenMs
 		clientDisconnect = streamRes.clientDisconnect
@@ -3984,7 +4047,7 @@ func (s *AntigravityGatewayService) ForwardUpstream(ctx context.Context, c *gin.
 }
 
 // streamUpstreamResponse 透传上游 SSE 流并提取 Claude usage
-func (s *AntigravityGatewayService) streamUpstreamResponse(c *gin.Context, resp *http.Response, startTime time.Time) *antigravityStreamResult {
+func (s *AntigravityGatewayService) streamUpstreamResponse(c *gin.Context, resp *http.Response, startTime time.Time, firstByte *firstByteGuard) *antigravityStreamResult {
 	usage := &ClaudeUsage{}
 	var firstTokenMs *int
 
@@ -4014,6 +4077,7 @@ func (s *AntigravityGatewayService) streamUpstreamResponse(c *gin.Context, resp
 	go func() {
 		defer close(events)
 		for scanner.Scan() {
+			firstByte.markReceived()
 			atomic.StoreInt64(&lastReadAt, time.Now().UnixNano())
 			if !sendEvent(scanEvent{line: scanner.Text()}) {
 				return
@@ -4026,8 +4090,8 @@ func (s *AntigravityGatewayService) streamUpstreamResponse(c *gin.Context, resp
 	defer close(done)
 
 	streamInterval := time.Duration(0)
-	if s.settingService.cfg != nil && s.settingService.cfg.Gateway.StreamDataIntervalTimeout > 0 {
-		streamInterval = time.Duration(s.settingService.cfg.Gateway.StreamDataIntervalTimeout) * time.Second
+	if cfg := s.getConfig(); cfg != nil && cfg.Gateway.StreamDataIntervalTimeout > 0 {
+		streamInterval = time.Duration(cfg.Gateway.StreamDataIntervalTimeout) * time.Second
 	}
 	var intervalTicker *time.Ticker
 	if streamInterval > 0 {
@@ -447,7 +447,7 @@ func TestStreamUpstreamResponse_NormalComplete(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result := svc.streamUpstreamResponse(c, resp, time.Now())
+	result := svc.streamUpstreamResponse(c, resp, time.Now(), nil)
 	_ = pr.Close()
 
 	require.NotNil(t, result)
@@ -488,7 +488,7 @@ func TestHandleGeminiStreamingResponse_NormalComplete(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result, err := svc.handleGeminiStreamingResponse(c, resp, time.Now())
+	result, err := svc.handleGeminiStreamingResponse(c, resp, nil, time.Now(), nil)
 	_ = pr.Close()
 
 	require.NoError(t, err)
@@ -533,7 +533,7 @@ func TestHandleClaudeStreamingResponse_NormalComplete(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result, err := svc.handleClaudeStreamingResponse(c, resp, time.Now(), "claude-sonnet-4-5")
+	result, err := svc.handleClaudeStreamingResponse(c, resp, nil, time.Now(), "claude-sonnet-4-5", nil)
 	_ = pr.Close()
 
 	require.NoError(t, err)
@@ -576,7 +576,7 @@ func TestHandleGeminiStreamingResponse_ThoughtsTokenCount(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result, err := svc.handleGeminiStreamingResponse(c, resp, time.Now())
+	result, err := svc.handleGeminiStreamingResponse(c, resp, nil, time.Now(), nil)
 	_ = pr.Close()
 
 	require.NoError(t, err)
@@ -610,7 +610,7 @@ func TestHandleClaudeStreamingResponse_ThoughtsTokenCount(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result, err := svc.handleClaudeStreamingResponse(c, resp, time.Now(), "gemini-2.5-pro")
+	result, err := svc.handleClaudeStreamingResponse(c, resp, nil, time.Now(), "gemini-2.5-pro", nil)
 	_ = pr.Close()
 
 	require.NoError(t, err)
@@ -650,7 +650,7 @@ func TestStreamUpstreamResponse_ClientDisconnectDrainsUsage(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result := svc.streamUpstreamResponse(c, resp, time.Now())
+	result := svc.streamUpstreamResponse(c, resp, time.Now(), nil)
 	_ = pr.Close()
 
 	require.NotNil(t, result)
@@ -675,7 +675,7 @@ func TestStreamUpstreamResponse_ContextCanceled(t *testing.T) {
 
 	resp := &http.Response{StatusCode: http.StatusOK, Body: cancelReadCloser{}, Header: http.Header{}}
 
-	result := svc.streamUpstreamResponse(c, resp, time.Now())
+	result := svc.streamUpstreamResponse(c, resp, time.Now(), nil)
 
 	require.NotNil(t, result)
 	require.True(t, result.clientDisconnect)
@@ -697,7 +697,7 @@ func TestStreamUpstreamResponse_Timeout(t *testing.T) {
 	pr, pw := io.Pipe()
 	resp := &http.Response{StatusCode: http.StatusOK, Body: pr, Header: http.Header{}}
 
-	result := svc.streamUpstreamResponse(c, resp, time.Now())
+	result := svc.streamUpstreamResponse(c, resp, time.Now(), nil)
 	_ = pw.Close()
 	_ = pr.Close()
 
@@ -727,7 +727,7 @@ func TestStreamUpstreamResponse_TimeoutAfterClientDisconnect(t *testing.T) {
 		// 不关闭 pw → 等待超时
 	}()
 
-	result := svc.streamUpstreamResponse(c, resp, time.Now())
+	result := svc.streamUpstreamResponse(c, resp, time.Now(), nil)
 	_ = pw.Close()
 	_ = pr.Close()
 
@@ -757,7 +757,7 @@ func TestHandleGeminiStreamingResponse_ClientDisconnect(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result, err := svc.handleGeminiStreamingResponse(c, resp, time.Now())
+	result, err := svc.handleGeminiStreamingResponse(c, resp, nil, time.Now(), nil)
 	_ = pr.Close()
 
 	require.NoError(t, err)
@@ -782,7 +782,7 @@ func TestHandleGeminiStreamingResponse_ContextCanceled(t *testing.T) {
 
 	resp := &http.Response{StatusCode: http.StatusOK, Body: cancelReadCloser{}, Header: http.Header{}}
 
-	result, err := svc.handleGeminiStreamingResponse(c, resp, time.Now())
+	result, err := svc.handleGeminiStreamingResponse(c, resp, nil, time.Now(), nil)
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -813,7 +813,7 @@ func TestHandleClaudeStreamingResponse_ClientDisconnect(t *testing.T) {
 		fmt.Fprintln(pw, "")
 	}()
 
-	result, err := svc.handleClaudeStreamingResponse(c, resp, time.Now(), "claude-sonnet-4-5")
+	result, err := svc.handleClaudeStreamingResponse(c, resp, nil, time.Now(), "claude-sonnet-4-5", nil)
 	_ = pr.Close()
 
 	require.NoError(t, err)
@@ -837,7 +837,7 @@ func TestHandleClaudeStreamingResponse_ContextCanceled(t *testing.T) {
 
 	resp := &http.Response{StatusCode: http.StatusOK, Body: cancelReadCloser{}, Header: http.Header{}}
 
-	result, err := svc.handleClaudeStreamingResponse(c, resp, time.Now(), "claude-sonnet-4-5")
+	result, err := svc.handleClaudeStreamingResponse(c, resp, nil, time.Now(), "claude-sonnet-4-5", nil)
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
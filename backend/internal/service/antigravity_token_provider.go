@@ -9,6 +9,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 )
 
 const (
@@ -46,6 +48,10 @@ func (p *AntigravityTokenProvider) GetAccessToken(ctx context.Context, account *
 	if account == nil {
 		return "", errors.New("account is nil")
 	}
+
+	ctx, span := tracing.Start(ctx, "token_provider.get_access_token", tracing.AttrAccountID.Int64(account.ID), tracing.AttrPlatform.String(account.Platform))
+	defer span.End()
+
 	if account.Platform != PlatformAntigravity {
 		return "", errors.New("not an antigravity account")
 	}
@@ -28,6 +28,7 @@ type APIKeyAuthUserSnapshot struct {
 	Role        string  `json:"role"`
 	Balance     float64 `json:"balance"`
 	Concurrency int     `json:"concurrency"`
+	QueueWeight int     `json:"queue_weight,omitempty"`
 }
 
 // APIKeyAuthGroupSnapshot 分组快照
@@ -56,6 +57,18 @@ type APIKeyAuthGroupSnapshot struct {
 
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes []string `json:"supported_model_scopes,omitempty"`
+
+	// 模型降级链在网关请求路径上使用，需要随快照缓存
+	ModelFallbacks []ModelFallbackRule `json:"model_fallbacks,omitempty"`
+
+	// 模型倍率在计费时读取 apiKey.Group，需要随快照缓存
+	ModelRateMultipliers map[string]float64 `json:"model_rate_multipliers,omitempty"`
+
+	// 调度策略在网关选择账号时读取上下文分组，需要随快照缓存
+	SchedulingStrategy string `json:"scheduling_strategy,omitempty"`
+
+	// 首字节预算在转发流式请求时读取上下文分组，需要随快照缓存
+	FirstByteTimeoutSeconds int `json:"first_byte_timeout_seconds,omitempty"`
 }
 
 // APIKeyAuthCacheEntry 缓存条目，支持负缓存
@@ -222,6 +222,7 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 			Role:        apiKey.User.Role,
 			Balance:     apiKey.User.Balance,
 			Concurrency: apiKey.User.Concurrency,
+			QueueWeight: apiKey.User.QueueWeight,
 		},
 	}
 	if apiKey.Group != nil {
@@ -245,6 +246,10 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 			ModelRoutingEnabled:             apiKey.Group.ModelRoutingEnabled,
 			MCPXMLInject:                    apiKey.Group.MCPXMLInject,
 			SupportedModelScopes:            apiKey.Group.SupportedModelScopes,
+			ModelFallbacks:                  apiKey.Group.ModelFallbacks,
+			ModelRateMultipliers:            apiKey.Group.ModelRateMultipliers,
+			SchedulingStrategy:              apiKey.Group.SchedulingStrategy,
+			FirstByteTimeoutSeconds:         apiKey.Group.FirstByteTimeoutSeconds,
 		}
 	}
 	return snapshot
@@ -271,6 +276,7 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 			Role:        snapshot.User.Role,
 			Balance:     snapshot.User.Balance,
 			Concurrency: snapshot.User.Concurrency,
+			QueueWeight: snapshot.User.QueueWeight,
 		},
 	}
 	if snapshot.Group != nil {
@@ -295,6 +301,10 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 			ModelRoutingEnabled:             snapshot.Group.ModelRoutingEnabled,
 			MCPXMLInject:                    snapshot.Group.MCPXMLInject,
 			SupportedModelScopes:            snapshot.Group.SupportedModelScopes,
+			ModelFallbacks:                  snapshot.Group.ModelFallbacks,
+			ModelRateMultipliers:            snapshot.Group.ModelRateMultipliers,
+			SchedulingStrategy:              snapshot.Group.SchedulingStrategy,
+			FirstByteTimeoutSeconds:         snapshot.Group.FirstByteTimeoutSeconds,
 		}
 	}
 	return apiKey
@@ -632,6 +632,7 @@ func (s *APIKeyService) SearchAPIKeys(ctx context.Context, userID int64, keyword
 
 // GetUserGroupRates 获取用户的专属分组倍率配置
 // 返回 map[groupID]rateMultiplier
+// 分组内的模型倍率随可用分组一起返回（Group.ModelRateMultipliers）
 func (s *APIKeyService) GetUserGroupRates(ctx context.Context, userID int64) (map[int64]float64, error) {
 	if s.userGroupRateRepo == nil {
 		return nil, nil
@@ -666,27 +667,38 @@ func (s *APIKeyService) UpdateQuotaUsed(ctx context.Context, apiKeyID int64, cos
 		return nil
 	}
 
-	// Use repository to atomically increment quota_used
+	newQuotaUsed, err := s.IncrementQuotaUsed(ctx, apiKeyID, cost)
+	if err != nil {
+		return err
+	}
+	s.RefreshQuotaStatus(ctx, apiKeyID, newQuotaUsed)
+	return nil
+}
+
+// IncrementQuotaUsed atomically increments quota_used and returns the new value
+// When ctx carries a transaction the increment commits with it; call RefreshQuotaStatus after commit
+func (s *APIKeyService) IncrementQuotaUsed(ctx context.Context, apiKeyID int64, cost float64) (float64, error) {
 	newQuotaUsed, err := s.apiKeyRepo.IncrementQuotaUsed(ctx, apiKeyID, cost)
 	if err != nil {
-		return fmt.Errorf("increment quota used: %w", err)
+		return 0, fmt.Errorf("increment quota used: %w", err)
 	}
+	return newQuotaUsed, nil
+}
 
-	// Check if quota is now exhausted and update status if needed
+// RefreshQuotaStatus marks the key as quota exhausted once quotaUsed reaches its quota
+func (s *APIKeyService) RefreshQuotaStatus(ctx context.Context, apiKeyID int64, quotaUsed float64) {
 	apiKey, err := s.apiKeyRepo.GetByID(ctx, apiKeyID)
 	if err != nil {
-		return nil // Don't fail the request, just log
+		return // Don't fail the request
 	}
 
 	// If quota is set and now exhausted, update status
-	if apiKey.Quota > 0 && newQuotaUsed >= apiKey.Quota {
+	if apiKey.Quota > 0 && quotaUsed >= apiKey.Quota {
 		apiKey.Status = StatusAPIKeyQuotaExhausted
 		if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
-			return nil // Don't fail the request
+			return // Don't fail the request
 		}
 		// Invalidate cache so next request sees the new status
 		s.InvalidateAuthCacheByKey(ctx, apiKey.Key)
 	}
-
-	return nil
 }
@@ -0,0 +1,607 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/backup"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrBackupPassphraseRequired = infraerrors.BadRequest("BACKUP_PASSPHRASE_REQUIRED", "backup archive is encrypted, passphrase required")
+	ErrBackupInvalidPassphrase  = infraerrors.BadRequest("BACKUP_INVALID_PASSPHRASE", "invalid backup passphrase")
+	ErrBackupInvalidArchive     = infraerrors.BadRequest("BACKUP_INVALID_ARCHIVE", "invalid or corrupted backup archive")
+	ErrBackupSchemaMismatch     = infraerrors.Conflict("BACKUP_SCHEMA_MISMATCH", "backup schema version does not match this database")
+	ErrBackupTargetNotEmpty     = infraerrors.Conflict("BACKUP_TARGET_NOT_EMPTY", "target database already contains business data")
+	ErrBackupInvalidUsageRange  = infraerrors.BadRequest("BACKUP_INVALID_USAGE_RANGE", "usage range start must be before end")
+)
+
+// backupUsageLogsTable 用量记录表，可跳过或按时间范围导出
+const backupUsageLogsTable = "usage_logs"
+
+// BackupExportTable 导出单表的参数
+type BackupExportTable struct {
+	Name string
+	// CreatedFrom / CreatedTo 按 created_at 过滤（左闭右开），为空表示不限
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// BackupRepository 全量备份与恢复的数据访问接口。
+// 行以 JSON 对象表示（列名 -> 值），写入时只使用对象中存在的列，缺失列取数据库默认值。
+type BackupRepository interface {
+	// SchemaVersion 返回已执行的最新迁移文件名
+	SchemaVersion(ctx context.Context) (string, error)
+	// Export 在同一只读快照中按顺序导出各表，每行调用一次 fn
+	Export(ctx context.Context, tables []BackupExportTable, fn func(table string, row json.RawMessage) error) error
+	CountRows(ctx context.Context, table string) (int64, error)
+	// InsertRow 插入一行并返回新 ID；无 id 列的表（关联表）冲突时忽略并返回 0
+	InsertRow(ctx context.Context, table string, row map[string]any) (int64, error)
+	UpdateRow(ctx context.Context, table string, id int64, row map[string]any) error
+	// FindID 按列值查找记录 ID；activeOnly 时只匹配未软删除的记录
+	FindID(ctx context.Context, table string, match map[string]any, activeOnly bool) (int64, bool, error)
+}
+
+// backupRef 列引用其他表的 ID
+type backupRef struct {
+	Column string
+	Table  string
+	// Optional 引用的记录不在归档中时置 NULL，否则恢复失败
+	Optional bool
+}
+
+// backupTableSpec 归档中单表的恢复规则
+type backupTableSpec struct {
+	Name  string
+	HasID bool
+	// NaturalKey 目标库已存在同键记录时合并到该记录（以归档内容覆盖），用于迁移种子数据与安装时创建的管理员
+	NaturalKey []string
+	ActiveOnly bool
+	Refs       []backupRef
+	// Deferred 自引用或引用后序表的列：插入时省略，所有表导入后再回填
+	Deferred []string
+	// RemapJSON 重写 JSON 列中的 ID 引用，找不到映射的 ID 被丢弃
+	RemapJSON func(row map[string]any, ids *backupIDMap)
+}
+
+// backupTables 按依赖顺序排列的备份表。
+// 未包含：运维监控日志与指标、用量看板聚合（可重建）、调度 outbox、计费对账与清理任务。
+var backupTables = []backupTableSpec{
+	{Name: "users", HasID: true, NaturalKey: []string{"email"}, ActiveOnly: true},
+	{Name: "user_attribute_definitions", HasID: true, NaturalKey: []string{"key"}, ActiveOnly: true},
+	{Name: "user_attribute_values", HasID: true, Refs: []backupRef{
+		{Column: "user_id", Table: "users"},
+		{Column: "attribute_id", Table: "user_attribute_definitions"},
+	}},
+	{Name: "proxies", HasID: true},
+	{Name: "groups", HasID: true, NaturalKey: []string{"name"}, ActiveOnly: true,
+		Refs: []backupRef{
+			{Column: "fallback_group_id", Table: "groups", Optional: true},
+			{Column: "fallback_group_id_on_invalid_request", Table: "groups", Optional: true},
+		},
+		Deferred: []string{"fallback_group_id", "fallback_group_id_on_invalid_request", "model_routing"},
+		RemapJSON: func(row map[string]any, ids *backupIDMap) {
+			routing, ok := row["model_routing"].(map[string]any)
+			if !ok {
+				return
+			}
+			for pattern, accountIDs := range routing {
+				routing[pattern] = ids.remapList("accounts", accountIDs)
+			}
+		},
+	},
+	{Name: "accounts", HasID: true, Refs: []backupRef{{Column: "proxy_id", Table: "proxies", Optional: true}}},
+	{Name: "account_groups", Refs: []backupRef{
+		{Column: "account_id", Table: "accounts"},
+		{Column: "group_id", Table: "groups"},
+	}},
+	{Name: "user_allowed_groups", Refs: []backupRef{
+		{Column: "user_id", Table: "users"},
+		{Column: "group_id", Table: "groups"},
+	}},
+	{Name: "user_group_rate_multipliers", Refs: []backupRef{
+		{Column: "user_id", Table: "users"},
+		{Column: "group_id", Table: "groups"},
+	}},
+	{Name: "api_keys", HasID: true, Refs: []backupRef{
+		{Column: "user_id", Table: "users"},
+		{Column: "group_id", Table: "groups", Optional: true},
+	}},
+	{Name: "user_subscriptions", HasID: true, Refs: []backupRef{
+		{Column: "user_id", Table: "users"},
+		{Column: "group_id", Table: "groups"},
+		{Column: "assigned_by", Table: "users", Optional: true},
+	}},
+	{Name: "redeem_codes", HasID: true, Refs: []backupRef{
+		{Column: "group_id", Table: "groups", Optional: true},
+		{Column: "used_by", Table: "users", Optional: true},
+	}},
+	{Name: "promo_codes", HasID: true},
+	{Name: "promo_code_usages", HasID: true, Refs: []backupRef{
+		{Column: "promo_code_id", Table: "promo_codes"},
+		{Column: "user_id", Table: "users"},
+	}},
+	{Name: "announcements", HasID: true,
+		Refs: []backupRef{
+			{Column: "created_by", Table: "users", Optional: true},
+			{Column: "updated_by", Table: "users", Optional: true},
+		},
+		RemapJSON: func(row map[string]any, ids *backupIDMap) {
+			targeting, _ := row["targeting"].(map[string]any)
+			anyOf, _ := targeting["any_of"].([]any)
+			for _, group := range anyOf {
+				groupMap, _ := group.(map[string]any)
+				allOf, _ := groupMap["all_of"].([]any)
+				for _, cond := range allOf {
+					if condMap, ok := cond.(map[string]any); ok && condMap["group_ids"] != nil {
+						condMap["group_ids"] = ids.remapList("groups", condMap["group_ids"])
+					}
+				}
+			}
+		},
+	},
+	{Name: "announcement_reads", HasID: true, Refs: []backupRef{
+		{Column: "announcement_id", Table: "announcements"},
+		{Column: "user_id", Table: "users"},
+	}},
+	{Name: "settings", HasID: true, NaturalKey: []string{"key"}},
+	{Name: "error_passthrough_rules", HasID: true},
+	{Name: "model_prices", HasID: true, Refs: []backupRef{{Column: "group_id", Table: "groups", Optional: true}}},
+	{Name: "request_policies", HasID: true, RemapJSON: remapBackupGroupIDs},
+	{Name: "guardrail_rules", HasID: true, RemapJSON: remapBackupGroupIDs},
+	{Name: "client_rules", HasID: true, Refs: []backupRef{{Column: "fallback_group_id", Table: "groups", Optional: true}},
+		RemapJSON: remapBackupGroupIDs},
+	{Name: "routing_rules", HasID: true, Refs: []backupRef{{Column: "target_group_id", Table: "groups", Optional: true}},
+		RemapJSON: func(row map[string]any, ids *backupIDMap) {
+			remapBackupGroupIDs(row, ids)
+			if row["target_account_ids"] != nil {
+				row["target_account_ids"] = ids.remapList("accounts", row["target_account_ids"])
+			}
+		},
+	},
+	{Name: "ops_alert_rules", HasID: true, NaturalKey: []string{"name"},
+		RemapJSON: func(row map[string]any, ids *backupIDMap) {
+			filters, ok := row["filters"].(map[string]any)
+			if !ok || filters["group_id"] == nil {
+				return
+			}
+			// 分组已不存在时移除过滤条件，与 JSON 数组中丢弃悬空 ID 的处理一致
+			if oldID, ok := backupInt64(filters["group_id"]); ok {
+				if newID, ok := ids.get("groups", oldID); ok {
+					filters["group_id"] = newID
+					return
+				}
+			}
+			delete(filters, "group_id")
+		},
+	},
+	{Name: backupUsageLogsTable, HasID: true, Refs: []backupRef{
+		{Column: "user_id", Table: "users"},
+		{Column: "api_key_id", Table: "api_keys"},
+		{Column: "account_id", Table: "accounts"},
+		{Column: "group_id", Table: "groups", Optional: true},
+		{Column: "subscription_id", Table: "user_subscriptions", Optional: true},
+	}},
+}
+
+// backupGuardTables 目标库中任一表非空即视为已有业务数据，默认拒绝恢复
+var backupGuardTables = []string{"accounts", "api_keys", "redeem_codes"}
+
+func remapBackupGroupIDs(row map[string]any, ids *backupIDMap) {
+	if row["group_ids"] != nil {
+		row["group_ids"] = ids.remapList("groups", row["group_ids"])
+	}
+}
+
+// backupIDMap 归档 ID -> 目标库 ID
+type backupIDMap struct {
+	tables map[string]map[int64]int64
+}
+
+func newBackupIDMap() *backupIDMap {
+	return &backupIDMap{tables: map[string]map[int64]int64{}}
+}
+
+func (m *backupIDMap) set(table string, oldID, newID int64) {
+	if m.tables[table] == nil {
+		m.tables[table] = map[int64]int64{}
+	}
+	m.tables[table][oldID] = newID
+}
+
+func (m *backupIDMap) get(table string, oldID int64) (int64, bool) {
+	newID, ok := m.tables[table][oldID]
+	return newID, ok
+}
+
+// remapList 重写 ID 数组，丢弃找不到映射的 ID
+func (m *backupIDMap) remapList(table string, value any) []int64 {
+	items, _ := value.([]any)
+	out := make([]int64, 0, len(items))
+	for _, item := range items {
+		if oldID, ok := backupInt64(item); ok {
+			if newID, ok := m.get(table, oldID); ok {
+				out = append(out, newID)
+			}
+		}
+	}
+	return out
+}
+
+func backupInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+// BackupOptions 备份参数
+type BackupOptions struct {
+	// Passphrase 非空时加密归档
+	Passphrase    string
+	SkipUsageLogs bool
+	// UsageFrom / UsageTo 用量记录的时间范围（左闭右开）
+	UsageFrom *time.Time
+	UsageTo   *time.Time
+}
+
+// RestoreOptions 恢复参数
+type RestoreOptions struct {
+	Passphrase string
+	// Force 目标库已有业务数据时仍然恢复（数据追加，可能与现有记录冲突）
+	Force bool
+}
+
+// BackupTableResult 单表备份 / 恢复统计
+type BackupTableResult struct {
+	Table    string `json:"table"`
+	Restored int    `json:"restored"`
+	// Merged 合并到目标库已有记录（按自然键匹配）的行数
+	Merged int `json:"merged"`
+}
+
+// RestoreResult 恢复结果
+type RestoreResult struct {
+	Manifest backup.Manifest     `json:"manifest"`
+	Tables   []BackupTableResult `json:"tables"`
+	// RestartRequired 恢复后需要清空 Redis 缓存并重启服务，使缓存与调度快照按新 ID 重建
+	RestartRequired bool `json:"restart_required"`
+}
+
+// BackupService 全量备份与恢复
+type BackupService struct {
+	repo      BackupRepository
+	entClient *dbent.Client
+	buildInfo BuildInfo
+}
+
+// NewBackupService 创建备份服务
+func NewBackupService(repo BackupRepository, entClient *dbent.Client, buildInfo BuildInfo) *BackupService {
+	return &BackupService{repo: repo, entClient: entClient, buildInfo: buildInfo}
+}
+
+// Backup 将全部业务数据写入归档。写入 w 的过程中出错时归档不完整，调用方应丢弃已写出的内容。
+func (s *BackupService) Backup(ctx context.Context, w io.Writer, opts BackupOptions) (*backup.Manifest, error) {
+	if opts.UsageFrom != nil && opts.UsageTo != nil && !opts.UsageFrom.Before(*opts.UsageTo) {
+		return nil, ErrBackupInvalidUsageRange
+	}
+	schemaVersion, err := s.repo.SchemaVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get schema version: %w", err)
+	}
+
+	manifest := backup.Manifest{
+		SchemaVersion: schemaVersion,
+		AppVersion:    s.buildInfo.Version,
+		CreatedAt:     time.Now().UTC(),
+		UsageLogs:     !opts.SkipUsageLogs,
+	}
+	tables := make([]BackupExportTable, 0, len(backupTables))
+	for _, spec := range backupTables {
+		table := BackupExportTable{Name: spec.Name}
+		if spec.Name == backupUsageLogsTable {
+			if opts.SkipUsageLogs {
+				continue
+			}
+			if opts.UsageFrom != nil || opts.UsageTo != nil {
+				table.CreatedFrom, table.CreatedTo = opts.UsageFrom, opts.UsageTo
+				manifest.UsageRange = &backup.UsageRange{From: opts.UsageFrom, To: opts.UsageTo}
+			}
+		}
+		tables = append(tables, table)
+		manifest.Tables = append(manifest.Tables, spec.Name)
+	}
+
+	writer, err := backup.NewWriter(w, manifest, opts.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Export(ctx, tables, writer.WriteRow); err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	manifest.Encrypted = opts.Passphrase != ""
+	manifest.FormatVersion = backup.FormatVersion
+	return &manifest, nil
+}
+
+// Restore 在单个事务中导入归档：校验 schema 版本，按依赖顺序插入并重映射所有 ID 引用。
+// 目标库可以是迁移刚创建的空库；迁移种子数据（默认分组、默认设置等）按自然键合并。
+func (s *BackupService) Restore(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreResult, error) {
+	reader, err := backup.NewReader(r, opts.Passphrase)
+	if err != nil {
+		return nil, translateBackupArchiveError(err)
+	}
+	manifest := reader.Manifest()
+
+	schemaVersion, err := s.repo.SchemaVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get schema version: %w", err)
+	}
+	if manifest.SchemaVersion != schemaVersion {
+		return nil, ErrBackupSchemaMismatch.WithMetadata(map[string]string{
+			"backup_schema_version": manifest.SchemaVersion,
+			"schema_version":        schemaVersion,
+		})
+	}
+	if !opts.Force {
+		for _, table := range backupGuardTables {
+			count, err := s.repo.CountRows(ctx, table)
+			if err != nil {
+				return nil, fmt.Errorf("count %s: %w", table, err)
+			}
+			if count > 0 {
+				return nil, ErrBackupTargetNotEmpty.WithMetadata(map[string]string{"table": table})
+			}
+		}
+	}
+
+	result := &RestoreResult{Manifest: manifest, RestartRequired: true}
+	err = s.runInTx(ctx, func(txCtx context.Context) error {
+		restorer := newBackupRestorer(s.repo)
+		if err := restorer.run(txCtx, reader); err != nil {
+			return err
+		}
+		result.Tables = restorer.results
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[Backup] restored archive created at %s (schema %s)", manifest.CreatedAt.Format(time.RFC3339), manifest.SchemaVersion)
+	return result, nil
+}
+
+func (s *BackupService) runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.entClient == nil {
+		return fn(ctx)
+	}
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(dbent.NewTxContext(ctx, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+func translateBackupArchiveError(err error) error {
+	switch {
+	case errors.Is(err, backup.ErrPassphraseRequired):
+		return ErrBackupPassphraseRequired
+	case errors.Is(err, backup.ErrInvalidPassphrase):
+		return ErrBackupInvalidPassphrase
+	case errors.Is(err, backup.ErrNotArchive), errors.Is(err, backup.ErrUnsupportedVersion), errors.Is(err, backup.ErrCorrupted):
+		return backupInvalidArchive(err)
+	}
+	return err
+}
+
+// backupInvalidArchive 归档内容错误，原因写入 metadata 以便在响应中展示
+func backupInvalidArchive(cause error) error {
+	return ErrBackupInvalidArchive.WithCause(cause).WithMetadata(map[string]string{"detail": cause.Error()})
+}
+
+// backupDeferredUpdate 所有表导入后回填的列
+type backupDeferredUpdate struct {
+	spec   *backupTableSpec
+	id     int64
+	values map[string]any
+}
+
+type backupRestorer struct {
+	repo     BackupRepository
+	ids      *backupIDMap
+	results  []BackupTableResult
+	deferred []backupDeferredUpdate
+}
+
+func newBackupRestorer(repo BackupRepository) *backupRestorer {
+	return &backupRestorer{repo: repo, ids: newBackupIDMap()}
+}
+
+func (b *backupRestorer) run(ctx context.Context, reader *backup.Reader) error {
+	specIndex := map[string]int{}
+	for i := range backupTables {
+		specIndex[backupTables[i].Name] = i
+	}
+
+	current := -1
+	for {
+		table, raw, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return translateBackupArchiveError(err)
+		}
+		idx, ok := specIndex[table]
+		if !ok {
+			return backupInvalidArchive(fmt.Errorf("unknown table %q", table))
+		}
+		// 表必须按依赖顺序出现，保证引用的记录先于引用方导入
+		if idx < current {
+			return backupInvalidArchive(fmt.Errorf("table %q out of order", table))
+		}
+		if idx != current {
+			current = idx
+			b.results = append(b.results, BackupTableResult{Table: table})
+		}
+		if err := b.restoreRow(ctx, &backupTables[idx], raw, &b.results[len(b.results)-1]); err != nil {
+			return err
+		}
+	}
+
+	for _, update := range b.deferred {
+		if err := b.remapRefs(update.spec, update.values); err != nil {
+			return err
+		}
+		if update.spec.RemapJSON != nil {
+			update.spec.RemapJSON(update.values, b.ids)
+		}
+		if err := b.repo.UpdateRow(ctx, update.spec.Name, update.id, update.values); err != nil {
+			return fmt.Errorf("restore %s id %d: %w", update.spec.Name, update.id, err)
+		}
+	}
+	return nil
+}
+
+func (b *backupRestorer) restoreRow(ctx context.Context, spec *backupTableSpec, raw json.RawMessage, result *BackupTableResult) error {
+	row, err := decodeBackupRow(raw)
+	if err != nil {
+		return backupInvalidArchive(fmt.Errorf("table %s: %w", spec.Name, err))
+	}
+
+	var oldID int64
+	if spec.HasID {
+		id, ok := backupInt64(row["id"])
+		if !ok {
+			return backupInvalidArchive(fmt.Errorf("table %s: row without id", spec.Name))
+		}
+		oldID = id
+		delete(row, "id")
+	}
+
+	var deferred map[string]any
+	for _, column := range spec.Deferred {
+		if value, ok := row[column]; ok {
+			if deferred == nil {
+				deferred = map[string]any{}
+			}
+			deferred[column] = value
+			delete(row, column)
+		}
+	}
+	if err := b.remapRefs(spec, row); err != nil {
+		return err
+	}
+	if spec.RemapJSON != nil {
+		spec.RemapJSON(row, b.ids)
+	}
+
+	var newID int64
+	merged := false
+	if len(spec.NaturalKey) > 0 {
+		match := make(map[string]any, len(spec.NaturalKey))
+		for _, column := range spec.NaturalKey {
+			match[column] = row[column]
+		}
+		// 归档中已软删除的记录不参与合并
+		if !spec.ActiveOnly || row["deleted_at"] == nil {
+			existingID, found, err := b.repo.FindID(ctx, spec.Name, match, spec.ActiveOnly)
+			if err != nil {
+				return fmt.Errorf("restore %s: %w", spec.Name, err)
+			}
+			if found {
+				if err := b.repo.UpdateRow(ctx, spec.Name, existingID, row); err != nil {
+					return fmt.Errorf("restore %s id %d: %w", spec.Name, oldID, err)
+				}
+				newID, merged = existingID, true
+			}
+		}
+	}
+	if !merged {
+		newID, err = b.repo.InsertRow(ctx, spec.Name, row)
+		if err != nil {
+			if spec.HasID {
+				return fmt.Errorf("restore %s id %d: %w", spec.Name, oldID, err)
+			}
+			return fmt.Errorf("restore %s: %w", spec.Name, err)
+		}
+	}
+
+	if spec.HasID {
+		b.ids.set(spec.Name, oldID, newID)
+	}
+	if deferred != nil {
+		b.deferred = append(b.deferred, backupDeferredUpdate{spec: spec, id: newID, values: deferred})
+	}
+	if merged {
+		result.Merged++
+	} else {
+		result.Restored++
+	}
+	return nil
+}
+
+// remapRefs 重写行中存在的 ID 引用列
+func (b *backupRestorer) remapRefs(spec *backupTableSpec, row map[string]any) error {
+	for _, ref := range spec.Refs {
+		value, ok := row[ref.Column]
+		if !ok || value == nil {
+			continue
+		}
+		oldID, ok := backupInt64(value)
+		if !ok {
+			return backupInvalidArchive(fmt.Errorf("table %s: invalid %s", spec.Name, ref.Column))
+		}
+		if newID, ok := b.ids.get(ref.Table, oldID); ok {
+			row[ref.Column] = newID
+			continue
+		}
+		if !ref.Optional {
+			return backupInvalidArchive(fmt.Errorf("table %s: %s %d not found in %s", spec.Name, ref.Column, oldID, ref.Table))
+		}
+		row[ref.Column] = nil
+	}
+	return nil
+}
+
+func decodeBackupRow(raw json.RawMessage) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var row map[string]any
+	if err := dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, errors.New("row is not an object")
+	}
+	return row, nil
+}
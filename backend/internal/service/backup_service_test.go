@@ -0,0 +1,261 @@
+//go:build unit
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// backupRepoStub 内存中的表，行以 JSON 对象保存；nextID 模拟序列
+type backupRepoStub struct {
+	schemaVersion string
+	tables        map[string][]map[string]any
+	nextID        int64
+	exported      []BackupExportTable
+}
+
+func newBackupRepoStub(nextID int64) *backupRepoStub {
+	return &backupRepoStub{schemaVersion: "064_add_ops_error_logs_trace_id.sql", tables: map[string][]map[string]any{}, nextID: nextID}
+}
+
+func (r *backupRepoStub) SchemaVersion(context.Context) (string, error) {
+	return r.schemaVersion, nil
+}
+
+func (r *backupRepoStub) Export(_ context.Context, tables []BackupExportTable, fn func(string, json.RawMessage) error) error {
+	r.exported = tables
+	for _, table := range tables {
+		for _, row := range r.tables[table.Name] {
+			raw, err := json.Marshal(row)
+			if err != nil {
+				return err
+			}
+			if err := fn(table.Name, raw); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *backupRepoStub) CountRows(_ context.Context, table string) (int64, error) {
+	return int64(len(r.tables[table])), nil
+}
+
+func (r *backupRepoStub) InsertRow(_ context.Context, table string, row map[string]any) (int64, error) {
+	stored := map[string]any{}
+	for k, v := range row {
+		stored[k] = v
+	}
+	var id int64
+	if table != "account_groups" && table != "user_allowed_groups" && table != "user_group_rate_multipliers" {
+		r.nextID++
+		id = r.nextID
+		stored["id"] = id
+	}
+	r.tables[table] = append(r.tables[table], stored)
+	return id, nil
+}
+
+func (r *backupRepoStub) UpdateRow(_ context.Context, table string, id int64, row map[string]any) error {
+	stored := r.find(table, id)
+	if stored == nil {
+		return fmt.Errorf("%s id %d not found", table, id)
+	}
+	for k, v := range row {
+		stored[k] = v
+	}
+	return nil
+}
+
+func (r *backupRepoStub) FindID(_ context.Context, table string, match map[string]any, activeOnly bool) (int64, bool, error) {
+	for _, row := range r.tables[table] {
+		if activeOnly && row["deleted_at"] != nil {
+			continue
+		}
+		matched := true
+		for k, v := range match {
+			if fmt.Sprint(row[k]) != fmt.Sprint(v) {
+				matched = false
+			}
+		}
+		if matched {
+			id, _ := backupInt64(row["id"])
+			return id, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func (r *backupRepoStub) find(table string, id int64) map[string]any {
+	for _, row := range r.tables[table] {
+		if rowID, _ := backupInt64(row["id"]); rowID == id {
+			return row
+		}
+	}
+	return nil
+}
+
+func (r *backupRepoStub) findBy(table, column string, value any) map[string]any {
+	for _, row := range r.tables[table] {
+		if fmt.Sprint(row[column]) == fmt.Sprint(value) {
+			return row
+		}
+	}
+	return nil
+}
+
+func newBackupSource() *backupRepoStub {
+	src := newBackupRepoStub(0)
+	src.tables = map[string][]map[string]any{
+		"users": {
+			{"id": 1, "email": "admin@example.com", "role": "admin", "balance": "12.50000000"},
+			{"id": 2, "email": "alice@example.com", "role": "user", "balance": "3.00000000"},
+		},
+		"groups": {
+			{"id": 1, "name": "default", "model_routing": map[string]any{}},
+			{"id": 5, "name": "claude", "fallback_group_id": 6, "model_routing": map[string]any{"claude-*": []any{10, 99}}},
+			{"id": 6, "name": "claude-backup", "fallback_group_id": nil},
+		},
+		"accounts":       {{"id": 10, "name": "acc-1", "proxy_id": nil}},
+		"account_groups": {{"account_id": 10, "group_id": 5}},
+		"api_keys":       {{"id": 20, "user_id": 2, "group_id": 5, "key": "sk-alice"}},
+		"routing_rules":  {{"id": 30, "name": "r", "group_ids": []any{5, 404}, "target_group_id": 6, "target_account_ids": []any{10}}},
+		"announcements": {{"id": 40, "title": "t", "created_by": 1, "targeting": map[string]any{
+			"any_of": []any{map[string]any{"all_of": []any{map[string]any{"type": "subscription", "group_ids": []any{6}}}}},
+		}}},
+		"settings":   {{"id": 50, "key": "site_name", "value": "Restored"}},
+		"usage_logs": {{"id": 60, "user_id": 2, "api_key_id": 20, "account_id": 10, "group_id": 5, "subscription_id": nil}},
+	}
+	return src
+}
+
+func backupAndRestore(t *testing.T, src, dst *backupRepoStub, backupOpts BackupOptions, restoreOpts RestoreOptions) (*RestoreResult, error) {
+	t.Helper()
+	var archive bytes.Buffer
+	_, err := NewBackupService(src, nil, BuildInfo{Version: "test"}).Backup(context.Background(), &archive, backupOpts)
+	require.NoError(t, err)
+	return NewBackupService(dst, nil, BuildInfo{Version: "test"}).Restore(context.Background(), &archive, restoreOpts)
+}
+
+func TestBackupService_RestoreRemapsIDs(t *testing.T) {
+	src := newBackupSource()
+	// 目标库为迁移新建的库：已有种子分组与设置，序列从 100 开始
+	dst := newBackupRepoStub(100)
+	dst.tables["groups"] = []map[string]any{{"id": 1, "name": "default"}}
+	dst.tables["settings"] = []map[string]any{{"id": 2, "key": "site_name", "value": "Sub2API"}}
+
+	result, err := backupAndRestore(t, src, dst, BackupOptions{Passphrase: "pw"}, RestoreOptions{Passphrase: "pw"})
+	require.NoError(t, err)
+	require.True(t, result.RestartRequired)
+	require.True(t, result.Manifest.Encrypted)
+
+	alice := dst.findBy("users", "email", "alice@example.com")
+	aliceID, _ := backupInt64(alice["id"])
+	claude := dst.findBy("groups", "name", "claude")
+	claudeID, _ := backupInt64(claude["id"])
+	backupGroup := dst.findBy("groups", "name", "claude-backup")
+	backupGroupID, _ := backupInt64(backupGroup["id"])
+	account := dst.findBy("accounts", "name", "acc-1")
+	accountID, _ := backupInt64(account["id"])
+	apiKey := dst.findBy("api_keys", "key", "sk-alice")
+	apiKeyID, _ := backupInt64(apiKey["id"])
+
+	// 种子记录按自然键合并
+	require.Len(t, dst.tables["groups"], 3)
+	require.Equal(t, "Restored", dst.findBy("settings", "key", "site_name")["value"])
+	require.Len(t, dst.tables["settings"], 1)
+
+	// 标量引用
+	require.Equal(t, aliceID, apiKey["user_id"])
+	require.Equal(t, claudeID, apiKey["group_id"])
+	require.Equal(t, []map[string]any{{"account_id": accountID, "group_id": claudeID}}, dst.tables["account_groups"])
+	usage := dst.tables["usage_logs"][0]
+	require.Equal(t, apiKeyID, usage["api_key_id"])
+	require.Equal(t, accountID, usage["account_id"])
+
+	// 分组自引用与 model_routing 在所有表导入后回填，悬空账号 ID 被丢弃
+	require.Equal(t, backupGroupID, claude["fallback_group_id"])
+	require.Equal(t, map[string]any{"claude-*": []int64{accountID}}, claude["model_routing"])
+
+	// JSON 列中的引用
+	rule := dst.tables["routing_rules"][0]
+	require.Equal(t, []int64{claudeID}, rule["group_ids"])
+	require.Equal(t, backupGroupID, rule["target_group_id"])
+	require.Equal(t, []int64{accountID}, rule["target_account_ids"])
+	targeting := dst.tables["announcements"][0]["targeting"].(map[string]any)
+	cond := targeting["any_of"].([]any)[0].(map[string]any)["all_of"].([]any)[0].(map[string]any)
+	require.Equal(t, []int64{backupGroupID}, cond["group_ids"])
+
+	counts := map[string]BackupTableResult{}
+	for _, table := range result.Tables {
+		counts[table.Table] = table
+	}
+	require.Equal(t, BackupTableResult{Table: "groups", Restored: 2, Merged: 1}, counts["groups"])
+	require.Equal(t, BackupTableResult{Table: "usage_logs", Restored: 1}, counts["usage_logs"])
+}
+
+func TestBackupService_UsageLogOptions(t *testing.T) {
+	src := newBackupSource()
+
+	var archive bytes.Buffer
+	svc := NewBackupService(src, nil, BuildInfo{})
+	manifest, err := svc.Backup(context.Background(), &archive, BackupOptions{SkipUsageLogs: true})
+	require.NoError(t, err)
+	require.False(t, manifest.UsageLogs)
+	require.NotContains(t, manifest.Tables, "usage_logs")
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	manifest, err = svc.Backup(context.Background(), &bytes.Buffer{}, BackupOptions{UsageFrom: &from, UsageTo: &to})
+	require.NoError(t, err)
+	require.NotNil(t, manifest.UsageRange)
+	last := src.exported[len(src.exported)-1]
+	require.Equal(t, "usage_logs", last.Name)
+	require.Equal(t, &from, last.CreatedFrom)
+	require.Equal(t, &to, last.CreatedTo)
+
+	_, err = svc.Backup(context.Background(), &bytes.Buffer{}, BackupOptions{UsageFrom: &to, UsageTo: &from})
+	require.ErrorIs(t, err, ErrBackupInvalidUsageRange)
+}
+
+func TestBackupService_RestoreChecks(t *testing.T) {
+	t.Run("schema mismatch", func(t *testing.T) {
+		dst := newBackupRepoStub(0)
+		dst.schemaVersion = "065_future.sql"
+		_, err := backupAndRestore(t, newBackupSource(), dst, BackupOptions{}, RestoreOptions{})
+		require.ErrorIs(t, err, ErrBackupSchemaMismatch)
+	})
+
+	t.Run("target not empty", func(t *testing.T) {
+		dst := newBackupRepoStub(0)
+		dst.tables["accounts"] = []map[string]any{{"id": 1, "name": "existing"}}
+		_, err := backupAndRestore(t, newBackupSource(), dst, BackupOptions{}, RestoreOptions{})
+		require.ErrorIs(t, err, ErrBackupTargetNotEmpty)
+
+		_, err = backupAndRestore(t, newBackupSource(), dst, BackupOptions{}, RestoreOptions{Force: true})
+		require.NoError(t, err)
+		require.Len(t, dst.tables["accounts"], 2)
+	})
+
+	t.Run("passphrase", func(t *testing.T) {
+		_, err := backupAndRestore(t, newBackupSource(), newBackupRepoStub(0), BackupOptions{Passphrase: "pw"}, RestoreOptions{})
+		require.ErrorIs(t, err, ErrBackupPassphraseRequired)
+		_, err = backupAndRestore(t, newBackupSource(), newBackupRepoStub(0), BackupOptions{Passphrase: "pw"}, RestoreOptions{Passphrase: "nope"})
+		require.ErrorIs(t, err, ErrBackupInvalidPassphrase)
+	})
+
+	t.Run("dangling required reference", func(t *testing.T) {
+		src := newBackupSource()
+		src.tables["api_keys"][0]["user_id"] = 404
+		_, err := backupAndRestore(t, src, newBackupRepoStub(0), BackupOptions{}, RestoreOptions{})
+		require.ErrorIs(t, err, ErrBackupInvalidArchive)
+	})
+}
@@ -0,0 +1,156 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+type reservationCacheStub struct {
+	billingCacheWorkerStub
+	balance float64
+	holds   map[string]float64
+}
+
+func (r *reservationCacheStub) GetUserBalance(ctx context.Context, userID int64) (float64, error) {
+	return r.balance, nil
+}
+
+func (r *reservationCacheStub) ReserveCost(ctx context.Context, userID, groupID int64, reservationID string, amount, available float64, ttl time.Duration) (float64, error) {
+	held := 0.0
+	for _, v := range r.holds {
+		held += v
+	}
+	if held == 0 {
+		if available <= 0 {
+			return -1, nil
+		}
+		amount = min(amount, available)
+	} else if available-held < amount {
+		return 0, nil
+	}
+	r.holds[reservationID] = amount
+	return amount, nil
+}
+
+func (r *reservationCacheStub) ReleaseCostReservation(ctx context.Context, userID, groupID int64, reservationID string) error {
+	delete(r.holds, reservationID)
+	return nil
+}
+
+func TestBillingCacheServiceReserveCost(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Default.RateMultiplier = 1
+	cfg.Billing.Reservation = config.BillingReservationConfig{Enabled: true, TTLSeconds: 60, DefaultMaxOutputTokens: 1000}
+	prices := NewModelPriceService(newStubModelPriceRepo(
+		&model.ModelPrice{ID: 1, Model: "test-model", OutputPrice: 1000, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+	), nil)
+	cache := &reservationCacheStub{balance: 2.5, holds: map[string]float64{}}
+	svc := NewBillingCacheService(cache, nil, nil, NewBillingService(cfg, nil, prices), cfg)
+	t.Cleanup(svc.Stop)
+
+	input := CostReservationInput{User: &User{ID: 1}, Model: "test-model"}
+	first, err := svc.ReserveCost(context.Background(), input)
+	require.NoError(t, err)
+	require.NotNil(t, first)
+	require.InDelta(t, 1.0, first.Amount, 1e-9, "未指定 max_tokens 时使用默认输出 token 估算")
+
+	second, err := svc.ReserveCost(context.Background(), input)
+	require.NoError(t, err)
+	require.NotNil(t, second)
+
+	// 剩余 0.5 不足以覆盖 1.0 的预估费用
+	_, err = svc.ReserveCost(context.Background(), input)
+	require.ErrorIs(t, err, ErrBillingReservationFailed)
+
+	// 移交后的预留不受 Release 影响，由结算方释放
+	settle := second.Handoff()
+	second.Release()
+	require.Len(t, cache.holds, 2)
+	settle()
+	require.Len(t, cache.holds, 1)
+
+	third, err := svc.ReserveCost(context.Background(), CostReservationInput{User: &User{ID: 1}, Model: "test-model", MaxTokens: 100})
+	require.NoError(t, err)
+	require.InDelta(t, 0.1, third.Amount, 1e-9)
+
+	first.Release()
+	third.Release()
+	require.Empty(t, cache.holds)
+
+	unknown, err := svc.ReserveCost(context.Background(), CostReservationInput{User: &User{ID: 1}, Model: ""})
+	require.NoError(t, err)
+	require.Nil(t, unknown, "无法估算费用时不预留")
+}
+
+func TestBillingCacheServiceReestimateCost(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Default.RateMultiplier = 1
+	cfg.Billing.Reservation = config.BillingReservationConfig{Enabled: true, TTLSeconds: 60, DefaultMaxOutputTokens: 1000}
+	prices := NewModelPriceService(newStubModelPriceRepo(
+		&model.ModelPrice{ID: 1, Model: "big-model", OutputPrice: 1000, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 2, Model: "small-model", OutputPrice: 100, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 3, Model: "huge-model", OutputPrice: 5000, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+	), nil)
+	cache := &reservationCacheStub{balance: 2, holds: map[string]float64{}}
+	svc := NewBillingCacheService(cache, nil, nil, NewBillingService(cfg, nil, prices), cfg)
+	t.Cleanup(svc.Stop)
+
+	input := CostReservationInput{User: &User{ID: 1}, Model: "big-model"}
+	first, err := svc.ReserveCost(context.Background(), input)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, first.Amount, 1e-9)
+
+	// 降级到更便宜的模型后，预留按新模型估算并替换原预留
+	input.Model = "small-model"
+	next, err := svc.ReestimateCost(context.Background(), first, input)
+	require.NoError(t, err)
+	require.InDelta(t, 0.1, next.Amount, 1e-9)
+	require.Len(t, cache.holds, 1)
+	require.InDelta(t, 0.1, cache.holds[next.id], 1e-9)
+
+	// 没有其他在途预留时按可用额度封顶
+	input.Model = "huge-model"
+	capped, err := svc.ReestimateCost(context.Background(), next, input)
+	require.NoError(t, err)
+	require.InDelta(t, 2.0, capped.Amount, 1e-9)
+	require.Len(t, cache.holds, 1)
+
+	// 额度被其他在途预留占用时失败，原预留已释放
+	other, err := svc.ReserveCost(context.Background(), CostReservationInput{User: &User{ID: 1}, Model: "small-model"})
+	require.ErrorIs(t, err, ErrBillingReservationFailed)
+	require.Nil(t, other)
+	_, err = svc.ReestimateCost(context.Background(), capped, CostReservationInput{User: &User{ID: 1}, Model: "huge-model"})
+	require.NoError(t, err, "released hold leaves nothing in flight")
+}
+
+func TestBillingCacheServiceReserveCostExhausted(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Default.RateMultiplier = 1
+	cfg.Billing.Reservation = config.BillingReservationConfig{Enabled: true, TTLSeconds: 60, DefaultMaxOutputTokens: 1000}
+	prices := NewModelPriceService(newStubModelPriceRepo(
+		&model.ModelPrice{ID: 1, Model: "test-model", OutputPrice: 1000, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+	), nil)
+	cache := &reservationCacheStub{balance: 0.3, holds: map[string]float64{}}
+	svc := NewBillingCacheService(cache, nil, nil, NewBillingService(cfg, nil, prices), cfg)
+	t.Cleanup(svc.Stop)
+
+	// 余额低于预估费用但没有在途请求：按余额封顶预留，不返回可重试的 429
+	input := CostReservationInput{User: &User{ID: 1}, Model: "test-model"}
+	res, err := svc.ReserveCost(context.Background(), input)
+	require.NoError(t, err)
+	require.InDelta(t, 0.3, res.Amount, 1e-9)
+	res.Release()
+
+	// 余额耗尽且没有在途请求：返回余额不足
+	cache.balance = 0
+	_, err = svc.ReserveCost(context.Background(), input)
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+	require.Empty(t, cache.holds)
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"github.com/google/uuid"
 )
 
 // 错误定义
@@ -18,6 +20,7 @@ import (
 var (
 	ErrSubscriptionInvalid       = infraerrors.Forbidden("SUBSCRIPTION_INVALID", "subscription is invalid or expired")
 	ErrBillingServiceUnavailable = infraerrors.ServiceUnavailable("BILLING_SERVICE_ERROR", "Billing service temporarily unavailable. Please retry later.")
+	ErrBillingReservationFailed  = infraerrors.TooManyRequests("BILLING_RESERVATION_EXCEEDED", "remaining balance or quota is held by in-flight requests, please retry later")
 )
 
 // subscriptionCacheData 订阅缓存数据结构（内部使用）
@@ -76,6 +79,7 @@ type BillingCacheService struct {
 	cache          BillingCache
 	userRepo       UserRepository
 	subRepo        UserSubscriptionRepository
+	billingService *BillingService
 	cfg            *config.Config
 	circuitBreaker *billingCircuitBreaker
 
@@ -90,12 +94,13 @@ type BillingCacheService struct {
 }
 
 // NewBillingCacheService 创建计费缓存服务
-func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo UserSubscriptionRepository, cfg *config.Config) *BillingCacheService {
+func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo UserSubscriptionRepository, billingService *BillingService, cfg *config.Config) *BillingCacheService {
 	svc := &BillingCacheService{
-		cache:    cache,
-		userRepo: userRepo,
-		subRepo:  subRepo,
-		cfg:      cfg,
+		cache:          cache,
+		userRepo:       userRepo,
+		subRepo:        subRepo,
+		billingService: billingService,
+		cfg:            cfg,
 	}
 	svc.circuitBreaker = newBillingCircuitBreaker(cfg.Billing.CircuitBreaker)
 	svc.startCacheWriteWorkers()
@@ -441,6 +446,218 @@ func (s *BillingCacheService) InvalidateSubscription(ctx context.Context, userID
 	return nil
 }
 
+// ============================================
+// 费用预留方法
+// ============================================
+
+// CostReservationInput 转发前预留费用所需的请求信息
+type CostReservationInput struct {
+	User         *User
+	Group        *Group
+	Subscription *UserSubscription
+	Model        string
+	// BodySize 请求体字节数，用于粗略估算输入 token
+	BodySize int
+	// MaxTokens 请求声明的最大输出 token 数，0 表示未指定
+	MaxTokens int
+}
+
+// CostReservation 已在 Redis 中持有的预留额度
+// 请求结束后必须释放；实例崩溃时由 TTL 自动过期
+type CostReservation struct {
+	svc     *BillingCacheService
+	userID  int64
+	groupID int64
+	id      string
+	Amount  float64
+
+	handedOff atomic.Bool
+	once      sync.Once
+}
+
+// Release 释放预留（幂等，nil 安全）；已通过 Handoff 移交的预留由移交方释放
+func (r *CostReservation) Release() {
+	if r == nil || r.handedOff.Load() {
+		return
+	}
+	r.release()
+}
+
+// Handoff 将预留移交给异步用量记录流程，返回的函数在实际费用结算后调用以释放剩余预留
+func (r *CostReservation) Handoff() func() {
+	if r == nil {
+		return func() {}
+	}
+	r.handedOff.Store(true)
+	return r.release
+}
+
+// Transfer 将预留转交给用量事件，由用量计费流水线在扣费提交后释放（nil 安全）
+// 转交后 Release 与 Handoff 返回的函数不再释放预留；预留已释放时返回 nil。
+func (r *CostReservation) Transfer() *CostReservationRef {
+	if r == nil {
+		return nil
+	}
+	transferred := false
+	r.once.Do(func() { transferred = true })
+	if !transferred {
+		return nil
+	}
+	return &CostReservationRef{UserID: r.userID, GroupID: r.groupID, ID: r.id}
+}
+
+func (r *CostReservation) release() {
+	r.once.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cacheWriteTimeout)
+		defer cancel()
+		r.svc.ReleaseReservation(ctx, &CostReservationRef{UserID: r.userID, GroupID: r.groupID, ID: r.id})
+	})
+}
+
+// CostReservationRef 随用量事件持久化的预留标识
+type CostReservationRef struct {
+	UserID  int64  `json:"user_id"`
+	GroupID int64  `json:"group_id,omitempty"`
+	ID      string `json:"id"`
+}
+
+// ReleaseReservation 释放预留（nil 安全，失败仅记录日志，残留的预留由 TTL 过期）
+func (s *BillingCacheService) ReleaseReservation(ctx context.Context, ref *CostReservationRef) {
+	if s == nil || s.cache == nil || ref == nil {
+		return
+	}
+	if err := s.cache.ReleaseCostReservation(ctx, ref.UserID, ref.GroupID, ref.ID); err != nil {
+		log.Printf("Warning: release cost reservation failed for user %d group %d: %v", ref.UserID, ref.GroupID, err)
+	}
+}
+
+// ReserveCost 估算请求的最大费用并在转发前原子地预留
+//
+// 可用额度（余额或订阅剩余限额）扣除其他在途请求的预留后仍足以覆盖本次预估费用时预留成功；
+// 没有其他在途预留时预留金额以可用额度封顶，额度耗尽时返回余额不足或订阅超限错误；
+// 只有额度被其他在途预留占用时才返回 ErrBillingReservationFailed（可重试）。
+// 无法估算费用或 Redis 异常时不预留（返回 nil），不影响请求转发。
+func (s *BillingCacheService) ReserveCost(ctx context.Context, input CostReservationInput) (*CostReservation, error) {
+	ctx, span := tracing.Start(ctx, "billing.reserve_cost")
+	defer span.End()
+
+	resCfg := s.cfg.Billing.Reservation
+	if s.cfg.RunMode == config.RunModeSimple || !resCfg.Enabled || s.cache == nil || s.billingService == nil || input.User == nil {
+		return nil, nil
+	}
+
+	amount := s.estimateReservationAmount(input)
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	userID := input.User.ID
+	var groupID int64
+	var available float64
+	if input.Group != nil && input.Group.IsSubscriptionType() && input.Subscription != nil {
+		groupID = input.Group.ID
+		remaining, limited, err := s.subscriptionRemaining(ctx, userID, input.Group)
+		if err != nil {
+			log.Printf("Warning: load subscription for cost reservation failed for user %d group %d: %v", userID, groupID, err)
+			return nil, nil
+		}
+		if !limited {
+			return nil, nil
+		}
+		available = remaining
+	} else {
+		balance, err := s.GetUserBalance(ctx, userID)
+		if err != nil {
+			log.Printf("Warning: load balance for cost reservation failed for user %d: %v", userID, err)
+			return nil, nil
+		}
+		available = balance
+	}
+
+	reservationID := uuid.New().String()
+	reserved, err := s.cache.ReserveCost(ctx, userID, groupID, reservationID, amount, available, time.Duration(resCfg.TTLSeconds)*time.Second)
+	if err != nil {
+		log.Printf("Warning: reserve cost failed for user %d group %d: %v", userID, groupID, err)
+		return nil, nil
+	}
+	if reserved < 0 {
+		return nil, s.exhaustedError(ctx, userID, input)
+	}
+	if reserved == 0 {
+		return nil, ErrBillingReservationFailed
+	}
+	return &CostReservation{svc: s, userID: userID, groupID: groupID, id: reservationID, Amount: reserved}, nil
+}
+
+// exhaustedError 可用额度耗尽且没有在途预留时返回的错误：余额模式为余额不足，订阅模式为对应周期的超限错误
+func (s *BillingCacheService) exhaustedError(ctx context.Context, userID int64, input CostReservationInput) error {
+	if input.Group != nil && input.Group.IsSubscriptionType() && input.Subscription != nil {
+		if err := s.checkSubscriptionEligibility(ctx, userID, input.Group, input.Subscription); err != nil {
+			return err
+		}
+		// 两次读取之间用量缓存被刷新时兜底
+		return ErrDailyLimitExceeded
+	}
+	return ErrInsufficientBalance
+}
+
+// ReestimateCost 请求模型改变（如分组模型降级）后按新的请求信息重新预留
+//
+// 先释放 previous 再按 input 预留，返回值替代 previous；预留失败时返回 ErrBillingReservationFailed。
+// previous 已移交给用量记录流程时保持不变。
+func (s *BillingCacheService) ReestimateCost(ctx context.Context, previous *CostReservation, input CostReservationInput) (*CostReservation, error) {
+	if previous != nil {
+		if previous.handedOff.Load() {
+			return previous, nil
+		}
+		previous.release()
+	}
+	return s.ReserveCost(ctx, input)
+}
+
+// estimateReservationAmount 按请求体大小（约 4 字节/token）与最大输出 token 估算最高费用
+func (s *BillingCacheService) estimateReservationAmount(input CostReservationInput) float64 {
+	if input.Model == "" {
+		return 0
+	}
+	maxTokens := input.MaxTokens
+	if maxTokens <= 0 {
+		maxTokens = s.cfg.Billing.Reservation.DefaultMaxOutputTokens
+	}
+	multiplier := s.cfg.Default.RateMultiplier
+	scope := PricingScope{}
+	if input.Group != nil {
+		multiplier = input.Group.RateMultiplier * input.Group.GetModelRateMultiplier(input.Model)
+		scope = PricingScope{GroupID: &input.Group.ID, Platform: input.Group.Platform}
+	}
+	amount, err := s.billingService.EstimateMaxCost(input.Model, scope, input.BodySize/4, maxTokens, multiplier)
+	if err != nil {
+		return 0
+	}
+	return amount
+}
+
+// subscriptionRemaining 返回订阅各周期限额中最小的剩余额度；未配置任何限额时 limited 为 false
+func (s *BillingCacheService) subscriptionRemaining(ctx context.Context, userID int64, group *Group) (remaining float64, limited bool, err error) {
+	subData, err := s.GetSubscriptionStatus(ctx, userID, group.ID)
+	if err != nil {
+		return 0, false, err
+	}
+	consider := func(limit *float64, usage float64) {
+		if limit == nil || *limit <= 0 {
+			return
+		}
+		left := *limit - usage
+		if !limited || left < remaining {
+			remaining, limited = left, true
+		}
+	}
+	consider(group.DailyLimitUSD, subData.DailyUsage)
+	consider(group.WeeklyLimitUSD, subData.WeeklyUsage)
+	consider(group.MonthlyLimitUSD, subData.MonthlyUsage)
+	return remaining, limited, nil
+}
+
 // ============================================
 // 统一检查方法
 // ============================================
@@ -449,6 +666,9 @@ func (s *BillingCacheService) InvalidateSubscription(ctx context.Context, userID
 // 余额模式：检查缓存余额 > 0
 // 订阅模式：检查缓存用量未超过限额（Group限额从参数传入）
 func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user *User, apiKey *APIKey, group *Group, subscription *UserSubscription) error {
+	ctx, span := tracing.Start(ctx, "billing.check_eligibility")
+	defer span.End()
+
 	// 简易模式：跳过所有计费检查
 	if s.cfg.RunMode == config.RunModeSimple {
 		return nil
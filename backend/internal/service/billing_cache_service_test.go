@@ -52,9 +52,17 @@ func (b *billingCacheWorkerStub) InvalidateSubscriptionCache(ctx context.Context
 	return nil
 }
 
+func (b *billingCacheWorkerStub) ReserveCost(ctx context.Context, userID, groupID int64, reservationID string, amount, available float64, ttl time.Duration) (float64, error) {
+	return amount, nil
+}
+
+func (b *billingCacheWorkerStub) ReleaseCostReservation(ctx context.Context, userID, groupID int64, reservationID string) error {
+	return nil
+}
+
 func TestBillingCacheServiceQueueHighLoad(t *testing.T) {
 	cache := &billingCacheWorkerStub{}
-	svc := NewBillingCacheService(cache, nil, nil, &config.Config{})
+	svc := NewBillingCacheService(cache, nil, nil, nil, &config.Config{})
 	t.Cleanup(svc.Stop)
 
 	start := time.Now()
@@ -6,6 +6,7 @@ import (
 
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 )
@@ -23,6 +24,12 @@ type BillingCache interface {
 	SetSubscriptionCache(ctx context.Context, userID, groupID int64, data *SubscriptionCacheData) error
 	UpdateSubscriptionUsage(ctx context.Context, userID, groupID int64, cost float64) error
 	InvalidateSubscriptionCache(ctx context.Context, userID, groupID int64) error
+
+	// Cost reservation operations (groupID 为 0 表示余额模式)
+	// ReserveCost 返回实际预留金额：没有其他在途预留时按 available 封顶；
+	// 0 表示剩余额度被其他在途预留占用，负数表示可用额度已耗尽且没有在途预留
+	ReserveCost(ctx context.Context, userID, groupID int64, reservationID string, amount, available float64, ttl time.Duration) (float64, error)
+	ReleaseCostReservation(ctx context.Context, userID, groupID int64, reservationID string) error
 }
 
 // ModelPricing 模型价格配置（per-token价格，与LiteLLM格式一致）
@@ -58,17 +65,19 @@ type CostBreakdown struct {
 
 // BillingService 计费服务
 type BillingService struct {
-	cfg            *config.Config
-	pricingService *PricingService
-	fallbackPrices map[string]*ModelPricing // 硬编码回退价格
+	cfg               *config.Config
+	pricingService    *PricingService
+	modelPriceService *ModelPriceService       // 管理员价格覆盖（优先于动态价格）
+	fallbackPrices    map[string]*ModelPricing // 硬编码回退价格
 }
 
 // NewBillingService 创建计费服务实例
-func NewBillingService(cfg *config.Config, pricingService *PricingService) *BillingService {
+func NewBillingService(cfg *config.Config, pricingService *PricingService, modelPriceService *ModelPriceService) *BillingService {
 	s := &BillingService{
-		cfg:            cfg,
-		pricingService: pricingService,
-		fallbackPrices: make(map[string]*ModelPricing),
+		cfg:               cfg,
+		pricingService:    pricingService,
+		modelPriceService: modelPriceService,
+		fallbackPrices:    make(map[string]*ModelPricing),
 	}
 
 	// 初始化硬编码回退价格（当动态价格不可用时使用）
@@ -163,11 +172,22 @@ func (s *BillingService) getFallbackPricing(model string) *ModelPricing {
 	return s.fallbackPrices["claude-sonnet-4"]
 }
 
-// GetModelPricing 获取模型价格配置
+// GetModelPricing 获取模型价格配置（仅匹配全局价格覆盖）
 func (s *BillingService) GetModelPricing(model string) (*ModelPricing, error) {
+	return s.GetModelPricingForScope(model, PricingScope{})
+}
+
+// GetModelPricingForScope 获取模型价格配置
+// 顺序：管理员价格覆盖（按分组/平台范围匹配）→ LiteLLM 动态价格 → 硬编码回退价格
+func (s *BillingService) GetModelPricingForScope(model string, scope PricingScope) (*ModelPricing, error) {
 	// 标准化模型名称（转小写）
 	model = strings.ToLower(model)
 
+	// 0. 管理员价格覆盖
+	if pricing := s.modelPriceService.GetModelPricing(model, scope, time.Now()); pricing != nil {
+		return pricing, nil
+	}
+
 	// 1. 优先从动态价格服务获取
 	if s.pricingService != nil {
 		litellmPricing := s.pricingService.GetModelPricing(model)
@@ -198,7 +218,47 @@ func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMul
 	if err != nil {
 		return nil, err
 	}
+	return s.calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
+}
+
+// CalculateCostForAccount 计算费用，按请求所属分组与账号平台匹配管理员价格覆盖，其次使用账号级自定义价格。
+// 顺序：管理员价格覆盖 → 账号自定义价格（请求模型 → 映射后模型）→ compatible 账号按上游模型查动态价格 → CalculateCost
+func (s *BillingService) CalculateCostForAccount(account *Account, groupID *int64, model string, tokens UsageTokens, rateMultiplier float64) (*CostBreakdown, error) {
+	scope := PricingScope{GroupID: groupID}
+	if account != nil {
+		scope.Platform = account.Platform
+	}
+	if pricing := s.modelPriceService.GetModelPricing(model, scope, time.Now()); pricing != nil {
+		return s.calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
+	}
+	if account == nil || !account.IsCompatible() {
+		return s.CalculateCost(model, tokens, rateMultiplier)
+	}
 
+	mappedModel := account.GetMappedModel(model)
+	if pricing := account.GetCompatibleModelPricing(model); pricing != nil {
+		return s.calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
+	}
+	if mappedModel != model {
+		if pricing := account.GetCompatibleModelPricing(mappedModel); pricing != nil {
+			return s.calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
+		}
+	}
+	// 请求模型可能是客户端别名（如 claude-sonnet-4），实际消耗按上游模型计价
+	if s.pricingService != nil {
+		if litellmPricing := s.pricingService.GetModelPricing(strings.ToLower(mappedModel)); litellmPricing != nil {
+			return s.calculateCostWithPricing(&ModelPricing{
+				InputPricePerToken:         litellmPricing.InputCostPerToken,
+				OutputPricePerToken:        litellmPricing.OutputCostPerToken,
+				CacheCreationPricePerToken: litellmPricing.CacheCreationInputTokenCost,
+				CacheReadPricePerToken:     litellmPricing.CacheReadInputTokenCost,
+			}, tokens, rateMultiplier), nil
+		}
+	}
+	return s.CalculateCost(model, tokens, rateMultiplier)
+}
+
+func (s *BillingService) calculateCostWithPricing(pricing *ModelPricing, tokens UsageTokens, rateMultiplier float64) *CostBreakdown {
 	breakdown := &CostBreakdown{}
 
 	// 计算输入token费用（使用per-token价格）
@@ -229,7 +289,7 @@ func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMul
 	}
 	breakdown.ActualCost = breakdown.TotalCost * rateMultiplier
 
-	return breakdown, nil
+	return breakdown
 }
 
 // CalculateCostWithConfig 使用配置中的默认倍率计算费用
@@ -248,16 +308,22 @@ func (s *BillingService) CalculateCostWithConfig(model string, tokens UsageToken
 // 示例：缓存 210k + 输入 10k = 220k，阈值 200k，倍率 2.0
 // 拆分为：范围内 (200k, 0) + 范围外 (10k, 10k)
 // 范围内正常计费，范围外 × 2 计费
-func (s *BillingService) CalculateCostWithLongContext(model string, tokens UsageTokens, rateMultiplier float64, threshold int, extraMultiplier float64) (*CostBreakdown, error) {
+// scope 用于按分组/平台匹配管理员价格覆盖，与 CalculateCostForAccount 一致
+func (s *BillingService) CalculateCostWithLongContext(model string, scope PricingScope, tokens UsageTokens, rateMultiplier float64, threshold int, extraMultiplier float64) (*CostBreakdown, error) {
+	pricing, err := s.GetModelPricingForScope(model, scope)
+	if err != nil {
+		return nil, err
+	}
+
 	// 未启用长上下文计费，直接走正常计费
 	if threshold <= 0 || extraMultiplier <= 1 {
-		return s.CalculateCost(model, tokens, rateMultiplier)
+		return s.calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
 	}
 
 	// 计算总输入 token（缓存读取 + 新输入）
 	total := tokens.CacheReadTokens + tokens.InputTokens
 	if total <= threshold {
-		return s.CalculateCost(model, tokens, rateMultiplier)
+		return s.calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
 	}
 
 	// 拆分成范围内和范围外
@@ -285,20 +351,14 @@ func (s *BillingService) CalculateCostWithLongContext(model string, tokens Usage
 		CacheCreationTokens: tokens.CacheCreationTokens,
 		CacheReadTokens:     inRangeCacheTokens,
 	}
-	inRangeCost, err := s.CalculateCost(model, inRangeTokens, rateMultiplier)
-	if err != nil {
-		return nil, err
-	}
+	inRangeCost := s.calculateCostWithPricing(pricing, inRangeTokens, rateMultiplier)
 
 	// 范围外部分：× extraMultiplier 计费
 	outRangeTokens := UsageTokens{
 		InputTokens:     outRangeInputTokens,
 		CacheReadTokens: outRangeCacheTokens,
 	}
-	outRangeCost, err := s.CalculateCost(model, outRangeTokens, rateMultiplier*extraMultiplier)
-	if err != nil {
-		return inRangeCost, nil // 出错时返回范围内成本
-	}
+	outRangeCost := s.calculateCostWithPricing(pricing, outRangeTokens, rateMultiplier*extraMultiplier)
 
 	// 合并成本
 	return &CostBreakdown{
@@ -346,6 +406,19 @@ func (s *BillingService) GetEstimatedCost(model string, estimatedInputTokens, es
 	return breakdown.ActualCost, nil
 }
 
+// EstimateMaxCost 按输入与最大输出 token 数估算请求可能产生的最高费用（用于转发前预留）
+func (s *BillingService) EstimateMaxCost(model string, scope PricingScope, inputTokens, maxOutputTokens int, rateMultiplier float64) (float64, error) {
+	pricing, err := s.GetModelPricingForScope(model, scope)
+	if err != nil {
+		return 0, err
+	}
+	breakdown := s.calculateCostWithPricing(pricing, UsageTokens{
+		InputTokens:  inputTokens,
+		OutputTokens: maxOutputTokens,
+	}, rateMultiplier)
+	return breakdown.ActualCost, nil
+}
+
 // GetPricingServiceStatus 获取价格服务状态
 func (s *BillingService) GetPricingServiceStatus() map[string]any {
 	if s.pricingService != nil {
@@ -430,8 +503,13 @@ func (s *BillingService) getImageUnitPrice(model string, imageSize string, group
 func (s *BillingService) getDefaultImagePrice(model string, imageSize string) float64 {
 	basePrice := 0.0
 
+	// 管理员价格覆盖中的图片价格优先
+	if imagePrice := s.modelPriceService.GetImagePrice(model, PricingScope{}, time.Now()); imagePrice != nil {
+		basePrice = *imagePrice
+	}
+
 	// 从 PricingService 获取 output_cost_per_image
-	if s.pricingService != nil {
+	if basePrice <= 0 && s.pricingService != nil {
 		pricing := s.pricingService.GetModelPricing(model)
 		if pricing != nil && pricing.OutputCostPerImage > 0 {
 			basePrice = pricing.OutputCostPerImage
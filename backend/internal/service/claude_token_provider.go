@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 )
 
 const (
@@ -42,6 +44,10 @@ func (p *ClaudeTokenProvider) GetAccessToken(ctx context.Context, account *Accou
 	if account == nil {
 		return "", errors.New("account is nil")
 	}
+
+	ctx, span := tracing.Start(ctx, "token_provider.get_access_token", tracing.AttrAccountID.Int64(account.ID), tracing.AttrPlatform.String(account.Platform))
+	defer span.End()
+
 	if account.Platform != PlatformAnthropic || account.Type != AccountTypeOAuth {
 		return "", errors.New("not an anthropic oauth account")
 	}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// ClientRuleRepository 定义客户端准入规则的数据访问接口
+type ClientRuleRepository interface {
+	// List 获取所有规则
+	List(ctx context.Context) ([]*model.ClientRule, error)
+	// GetByID 根据 ID 获取规则
+	GetByID(ctx context.Context, id int64) (*model.ClientRule, error)
+	// Create 创建规则
+	Create(ctx context.Context, rule *model.ClientRule) (*model.ClientRule, error)
+	// Update 更新规则
+	Update(ctx context.Context, rule *model.ClientRule) (*model.ClientRule, error)
+	// Delete 删除规则
+	Delete(ctx context.Context, id int64) error
+}
+
+// ClientRuleCache 定义客户端准入规则的缓存接口
+type ClientRuleCache interface {
+	// Get 从缓存获取规则列表
+	Get(ctx context.Context) ([]*model.ClientRule, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, rules []*model.ClientRule) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+	// IncrementRejected 规则拒绝计数 +1
+	IncrementRejected(ctx context.Context, ruleID int64) error
+	// GetRejectedCounts 获取所有规则的拒绝计数
+	GetRejectedCounts(ctx context.Context) (map[int64]int64, error)
+	// ResetRejected 清零规则拒绝计数
+	ResetRejected(ctx context.Context, ruleID int64) error
+}
+
+// ClientRuleService 分组客户端准入规则服务
+type ClientRuleService struct {
+	repo  ClientRuleRepository
+	cache ClientRuleCache
+
+	// 本地快照（预编译匹配器与降级分组，按优先级排序）
+	rules *ruleSnapshot[model.ClientRule, compiledClientRule]
+}
+
+// ClientRuleRejectedError 请求客户端不满足分组准入规则且没有可用的降级分组
+type ClientRuleRejectedError struct {
+	RuleID   int64
+	RuleName string
+	Message  string
+}
+
+func (e *ClientRuleRejectedError) Error() string {
+	return e.Message
+}
+
+// NewClientRuleService 创建客户端准入规则服务
+func NewClientRuleService(
+	repo ClientRuleRepository,
+	cache ClientRuleCache,
+	groupRepo GroupRepository,
+) *ClientRuleService {
+	compile := withRedirectTargets(groupRepo, compileClientRules, func(c *compiledClientRule) (*int64, **redirectTarget) {
+		return c.rule.FallbackGroupID, &c.fallback
+	})
+	return &ClientRuleService{
+		repo:  repo,
+		cache: cache,
+		rules: newRuleSnapshot("ClientRuleService", ruleLister[model.ClientRule](repo), ruleListCache[model.ClientRule](cache), compile),
+	}
+}
+
+// List 获取所有规则（附带拒绝计数）
+func (s *ClientRuleService) List(ctx context.Context) ([]*model.ClientRule, error) {
+	rules, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.cache != nil && len(rules) > 0 {
+		counts, err := s.cache.GetRejectedCounts(ctx)
+		if err != nil {
+			log.Printf("[ClientRuleService] Failed to get rejected counts: %v", err)
+		}
+		for _, rule := range rules {
+			rule.RejectedCount = counts[rule.ID]
+		}
+	}
+	return rules, nil
+}
+
+// GetByID 根据 ID 获取规则
+func (s *ClientRuleService) GetByID(ctx context.Context, id int64) (*model.ClientRule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建规则
+func (s *ClientRuleService) Create(ctx context.Context, rule *model.ClientRule) (*model.ClientRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return created, nil
+}
+
+// Update 更新规则
+func (s *ClientRuleService) Update(ctx context.Context, rule *model.ClientRule) (*model.ClientRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return updated, nil
+}
+
+// Delete 删除规则
+func (s *ClientRuleService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return nil
+}
+
+// ResetRejectedCount 清零规则拒绝计数
+func (s *ClientRuleService) ResetRejectedCount(ctx context.Context, id int64) error {
+	if s.cache == nil {
+		return nil
+	}
+	return s.cache.ResetRejected(ctx, id)
+}
+
+// Check 检查请求客户端是否满足分组准入规则
+//
+// 分组没有适用规则或命中任一规则时放行（返回 nil, nil）；全部不满足时累加各规则的拒绝计数，
+// 并按优先级取第一条配置了降级分组的规则返回降级分组；降级分组不可用或未配置时返回
+// *ClientRuleRejectedError。降级只跳转一次，不再检查降级分组自身的准入规则。
+func (s *ClientRuleService) Check(ctx context.Context, group *Group, r *http.Request, body []byte) (*Group, error) {
+	if s == nil || group == nil || r == nil {
+		return nil, nil
+	}
+	var applicable []*compiledClientRule
+	for _, compiled := range s.rules.items() {
+		if compiled.appliesToGroup(group.ID) {
+			applicable = append(applicable, compiled)
+		}
+	}
+	if len(applicable) == 0 {
+		return nil, nil
+	}
+
+	req := newClientRuleRequest(r, body)
+	for _, compiled := range applicable {
+		if compiled.matches(req) {
+			return nil, nil
+		}
+	}
+
+	for _, compiled := range applicable {
+		if s.cache != nil {
+			if err := s.cache.IncrementRejected(ctx, compiled.rule.ID); err != nil {
+				log.Printf("[ClientRuleService] Failed to increment rejected count: rule=%d err=%v", compiled.rule.ID, err)
+			}
+		}
+	}
+
+	first := applicable[0].rule
+	for _, compiled := range applicable {
+		if compiled.rule.FallbackGroupID == nil {
+			continue
+		}
+		fallback, err := compiled.fallback.forGroup(group)
+		if err != nil {
+			log.Printf("[ClientRule] fallback unavailable: group=%d rule=%d(%s) fallback=%d err=%v",
+				group.ID, compiled.rule.ID, compiled.rule.Name, *compiled.rule.FallbackGroupID, err)
+			break
+		}
+		log.Printf("[ClientRule] fallback: group=%d rule=%d(%s) -> group=%d ua=%q",
+			group.ID, compiled.rule.ID, compiled.rule.Name, fallback.ID, req.userAgent)
+		return fallback, nil
+	}
+
+	log.Printf("[ClientRule] rejected: group=%d rule=%d(%s) ua=%q", group.ID, first.ID, first.Name, req.userAgent)
+	return nil, &ClientRuleRejectedError{
+		RuleID:   first.ID,
+		RuleName: first.Name,
+		Message:  "This group does not allow requests from this client",
+	}
+}
+
+// InvalidateGroup 分组变更后刷新引用该分组作为降级分组的规则快照
+func (s *ClientRuleService) InvalidateGroup(groupID int64) {
+	if s == nil {
+		return
+	}
+	for _, compiled := range s.rules.items() {
+		if compiled.fallback.references(groupID) {
+			s.rules.invalidateAndNotify()
+			return
+		}
+	}
+}
+
+// compileClientRules 只保留启用的规则，预编译匹配器并按优先级排序
+func compileClientRules(rules []*model.ClientRule) []*compiledClientRule {
+	cached := make([]*compiledClientRule, 0, len(rules))
+	for _, r := range rules {
+		if r == nil || !r.Enabled {
+			continue
+		}
+		compiled, err := compileClientRule(r)
+		if err != nil {
+			log.Printf("[ClientRuleService] Skip rule %d(%s): %v", r.ID, r.Name, err)
+			continue
+		}
+		cached = append(cached, compiled)
+	}
+	sort.SliceStable(cached, func(i, j int) bool {
+		return cached[i].rule.Priority < cached[j].rule.Priority
+	})
+	return cached
+}
@@ -0,0 +1,173 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func clientRuleID(r *model.ClientRule) *int64 { return &r.ID }
+
+// clientRuleCacheStub 在通用规则缓存桩上补充拒绝计数
+type clientRuleCacheStub struct {
+	ruleListCacheStub[model.ClientRule]
+	rejected map[int64]int64
+}
+
+func (c *clientRuleCacheStub) IncrementRejected(_ context.Context, ruleID int64) error {
+	c.rejected[ruleID]++
+	return nil
+}
+
+func (c *clientRuleCacheStub) GetRejectedCounts(context.Context) (map[int64]int64, error) {
+	return c.rejected, nil
+}
+
+func (c *clientRuleCacheStub) ResetRejected(_ context.Context, ruleID int64) error {
+	delete(c.rejected, ruleID)
+	return nil
+}
+
+type clientRuleGroupRepoStub struct {
+	GroupRepository
+	groups map[int64]*Group
+	calls  int
+}
+
+func (r *clientRuleGroupRepoStub) GetByIDLite(_ context.Context, id int64) (*Group, error) {
+	r.calls++
+	if g, ok := r.groups[id]; ok {
+		return g, nil
+	}
+	return nil, ErrGroupNotFound
+}
+
+func newClientRuleServiceForTest(groups map[int64]*Group, rules ...*model.ClientRule) (*ClientRuleService, *clientRuleCacheStub) {
+	for i, rule := range rules {
+		rule.ID = int64(i + 1)
+		rule.Enabled = true
+	}
+	cache := &clientRuleCacheStub{rejected: map[int64]int64{}}
+	svc := NewClientRuleService(newRuleRepoStub(clientRuleID, rules...), cache, &clientRuleGroupRepoStub{groups: groups})
+	return svc, cache
+}
+
+func newClientRuleTestRequest(path, userAgent string, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}"))
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return req
+}
+
+func TestClientRule_Validate(t *testing.T) {
+	valid := &model.ClientRule{Name: "codex", Preset: model.ClientPresetCodexCLI}
+	require.NoError(t, valid.Validate())
+
+	bad := "("
+	fallback := int64(2)
+	cases := []*model.ClientRule{
+		{Name: "", Preset: model.ClientPresetCodexCLI},
+		{Name: "a", Preset: "cursor"},
+		{Name: "a"},
+		{Name: "a", UserAgentPattern: &bad},
+		{Name: "a", RequiredHeaders: []string{"X-App: ("}},
+		{Name: "a", Preset: model.ClientPresetCodexCLI, GroupIDs: []int64{2}, FallbackGroupID: &fallback},
+	}
+	requireInvalidRules(t, cases...)
+}
+
+func TestClientRule_CustomMatchers(t *testing.T) {
+	ua := `^my-agent/\d+`
+	userID := `^user_[a-z]+$`
+	svc, _ := newClientRuleServiceForTest(nil, &model.ClientRule{
+		Name:                  "custom",
+		UserAgentPattern:      &ua,
+		RequiredHeaders:       []string{"X-App", "X-Version: ^2\\."},
+		MetadataUserIDPattern: &userID,
+		BodyFields:            []string{"messages"},
+	})
+	group := &Group{ID: 1, Platform: PlatformAnthropic}
+	body := []byte(`{"messages":[],"metadata":{"user_id":"user_abc"}}`)
+	headers := map[string]string{"X-App": "cli", "X-Version": "2.1"}
+
+	fallback, err := svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/messages", "my-agent/3", headers), body)
+	require.NoError(t, err)
+	require.Nil(t, fallback)
+
+	// 任一匹配项不满足即拒绝
+	_, err = svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/messages", "my-agent/3", map[string]string{"X-App": "cli", "X-Version": "1.0"}), body)
+	require.Error(t, err)
+	_, err = svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/messages", "my-agent/3", headers), []byte(`{"messages":[],"metadata":{"user_id":"USER"}}`))
+	require.Error(t, err)
+	_, err = svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/messages", "curl/8", headers), body)
+	require.Error(t, err)
+}
+
+func TestClientRule_AnyRuleAllowsAndRejectionsAreCounted(t *testing.T) {
+	svc, cache := newClientRuleServiceForTest(nil,
+		&model.ClientRule{Name: "codex", Preset: model.ClientPresetCodexCLI, GroupIDs: []int64{1}},
+		&model.ClientRule{Name: "gemini", Preset: model.ClientPresetGeminiCLI, GroupIDs: []int64{1}},
+	)
+	group := &Group{ID: 1, Platform: PlatformOpenAI}
+
+	_, err := svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/responses", "codex_cli_rs/0.1.2", nil), nil)
+	require.NoError(t, err)
+	_, err = svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/responses", "GeminiCLI/0.1.5 (Windows; AMD64)", nil), nil)
+	require.NoError(t, err)
+
+	_, err = svc.Check(context.Background(), group, newClientRuleTestRequest("/v1/responses", "python-requests/2.31", nil), nil)
+	var rejected *ClientRuleRejectedError
+	require.True(t, errors.As(err, &rejected))
+	require.Equal(t, int64(1), rejected.RuleID)
+	require.Equal(t, map[int64]int64{1: 1, 2: 1}, cache.rejected)
+
+	// 其他分组不受影响
+	_, err = svc.Check(context.Background(), &Group{ID: 2, Platform: PlatformOpenAI}, newClientRuleTestRequest("/v1/responses", "python-requests/2.31", nil), nil)
+	require.NoError(t, err)
+
+	rules, err := svc.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rules[0].RejectedCount)
+}
+
+func TestClientRule_FallbackGroup(t *testing.T) {
+	fallbackID := int64(9)
+	groups := map[int64]*Group{
+		9: {ID: 9, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+	}
+	svc, _ := newClientRuleServiceForTest(groups, &model.ClientRule{
+		Name: "claude code", Preset: model.ClientPresetClaudeCode, GroupIDs: []int64{1}, FallbackGroupID: &fallbackID,
+	})
+
+	fallback, err := svc.Check(context.Background(), &Group{ID: 1, Platform: PlatformAnthropic}, newClientRuleTestRequest("/v1/messages", "curl/8", nil), []byte(`{}`))
+	require.NoError(t, err)
+	require.NotNil(t, fallback)
+	require.Equal(t, int64(9), fallback.ID)
+
+	// 降级分组平台不一致时拒绝
+	groups[9].Platform = PlatformOpenAI
+	_, err = svc.Check(context.Background(), &Group{ID: 1, Platform: PlatformAnthropic}, newClientRuleTestRequest("/v1/messages", "curl/8", nil), []byte(`{}`))
+	require.Error(t, err)
+}
+
+func TestClientRule_NilServiceAndNoRules(t *testing.T) {
+	var nilSvc *ClientRuleService
+	fallback, err := nilSvc.Check(context.Background(), &Group{ID: 1}, newClientRuleTestRequest("/v1/messages", "curl/8", nil), nil)
+	require.NoError(t, err)
+	require.Nil(t, fallback)
+
+	svc, _ := newClientRuleServiceForTest(nil)
+	fallback, err = svc.Check(context.Background(), &Group{ID: 1}, newClientRuleTestRequest("/v1/messages", "curl/8", nil), nil)
+	require.NoError(t, err)
+	require.Nil(t, fallback)
+}
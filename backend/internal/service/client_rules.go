@@ -0,0 +1,154 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/tidwall/gjson"
+)
+
+// compiledClientRule 预编译后的客户端准入规则
+type compiledClientRule struct {
+	rule           *model.ClientRule
+	userAgent      *regexp.Regexp
+	headers        []clientRuleHeader
+	metadataUserID *regexp.Regexp
+	bodyFields     []string
+	fallback       *redirectTarget // 构建快照时解析的降级分组，未配置时为 nil
+}
+
+// clientRuleHeader 必需请求头：pattern 为空时只要求值不为空
+type clientRuleHeader struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// clientRuleRequest 规则匹配所需的请求信息（请求体按需解析一次）
+type clientRuleRequest struct {
+	r         *http.Request
+	body      []byte
+	userAgent string
+	bodyMap   map[string]any
+	bodyOnce  bool
+}
+
+var geminiCLIUserAgentPattern = regexp.MustCompile(`(?i)^GeminiCLI/`)
+
+// clientRulePresets 内置客户端预设
+var clientRulePresets = map[string]func(req *clientRuleRequest) bool{
+	// Claude Code 复用 claude_code_only 使用的校验逻辑（UA + system prompt + 请求头 + metadata.user_id）
+	model.ClientPresetClaudeCode: func(req *clientRuleRequest) bool {
+		return claudeCodeValidatorForRules.Validate(req.r, req.parsedBody())
+	},
+	model.ClientPresetCodexCLI: func(req *clientRuleRequest) bool {
+		return openai.IsCodexCLIRequest(req.userAgent)
+	},
+	model.ClientPresetGeminiCLI: func(req *clientRuleRequest) bool {
+		return geminiCLIUserAgentPattern.MatchString(req.userAgent)
+	},
+}
+
+var claudeCodeValidatorForRules = NewClaudeCodeValidator()
+
+func newClientRuleRequest(r *http.Request, body []byte) *clientRuleRequest {
+	return &clientRuleRequest{r: r, body: body, userAgent: r.Header.Get("User-Agent")}
+}
+
+func (req *clientRuleRequest) parsedBody() map[string]any {
+	if !req.bodyOnce {
+		req.bodyOnce = true
+		if len(req.body) > 0 {
+			_ = json.Unmarshal(req.body, &req.bodyMap)
+		}
+	}
+	return req.bodyMap
+}
+
+func compileClientRule(rule *model.ClientRule) (*compiledClientRule, error) {
+	compiled := &compiledClientRule{rule: rule}
+	if rule.Preset != "" {
+		if _, ok := clientRulePresets[rule.Preset]; !ok {
+			return nil, fmt.Errorf("unsupported preset %q", rule.Preset)
+		}
+	}
+	if rule.UserAgentPattern != nil && strings.TrimSpace(*rule.UserAgentPattern) != "" {
+		re, err := regexp.Compile(*rule.UserAgentPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_agent_pattern: %w", err)
+		}
+		compiled.userAgent = re
+	}
+	if rule.MetadataUserIDPattern != nil && strings.TrimSpace(*rule.MetadataUserIDPattern) != "" {
+		re, err := regexp.Compile(*rule.MetadataUserIDPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata_user_id_pattern: %w", err)
+		}
+		compiled.metadataUserID = re
+	}
+	for _, entry := range rule.RequiredHeaders {
+		name, pattern := model.SplitRequiredHeader(entry)
+		if name == "" {
+			continue
+		}
+		header := clientRuleHeader{name: name}
+		if pattern != "" {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern for header %s: %w", name, err)
+			}
+			header.pattern = re
+		}
+		compiled.headers = append(compiled.headers, header)
+	}
+	for _, field := range rule.BodyFields {
+		if field = strings.TrimSpace(field); field != "" {
+			compiled.bodyFields = append(compiled.bodyFields, field)
+		}
+	}
+	return compiled, nil
+}
+
+func (c *compiledClientRule) appliesToGroup(groupID int64) bool {
+	if len(c.rule.GroupIDs) == 0 {
+		return true
+	}
+	for _, id := range c.rule.GroupIDs {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// matches 请求需同时满足预设与所有自定义匹配项
+func (c *compiledClientRule) matches(req *clientRuleRequest) bool {
+	if c.userAgent != nil && !c.userAgent.MatchString(req.userAgent) {
+		return false
+	}
+	for _, header := range c.headers {
+		value := req.r.Header.Get(header.name)
+		if value == "" {
+			return false
+		}
+		if header.pattern != nil && !header.pattern.MatchString(value) {
+			return false
+		}
+	}
+	if c.metadataUserID != nil && !c.metadataUserID.MatchString(gjson.GetBytes(req.body, "metadata.user_id").String()) {
+		return false
+	}
+	for _, field := range c.bodyFields {
+		if !gjson.GetBytes(req.body, field).Exists() {
+			return false
+		}
+	}
+	if c.rule.Preset != "" && !clientRulePresets[c.rule.Preset](req) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ClusterLeaderLock 本实例持有（或最近持有）的分布式 leader 锁
+type ClusterLeaderLock struct {
+	Key            string     `json:"key"`
+	Held           bool       `json:"held"`
+	LastAcquiredAt time.Time  `json:"last_acquired_at"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ReleasedAt     *time.Time `json:"released_at,omitempty"`
+}
+
+// leaderLockTracker 记录本进程内各后台任务的 leader 锁获取情况，随节点心跳上报
+// 各服务的锁值使用各自的 instanceID，无法从 Redis 反查节点，因此在获取处登记
+type leaderLockTracker struct {
+	mu    sync.Mutex
+	locks map[string]*ClusterLeaderLock
+}
+
+var nodeLeaderLocks = &leaderLockTracker{locks: make(map[string]*ClusterLeaderLock)}
+
+// trackLeaderLock 登记已获取的 leader 锁，返回包装后的释放函数（release 为 nil 时返回 nil，锁按 TTL 过期）
+func trackLeaderLock(key string, ttl time.Duration, release func()) func() {
+	now := time.Now()
+	nodeLeaderLocks.mu.Lock()
+	nodeLeaderLocks.locks[key] = &ClusterLeaderLock{Key: key, LastAcquiredAt: now, ExpiresAt: now.Add(ttl)}
+	nodeLeaderLocks.mu.Unlock()
+
+	if release == nil {
+		return nil
+	}
+	return func() {
+		release()
+		releasedAt := time.Now()
+		nodeLeaderLocks.mu.Lock()
+		if lock, ok := nodeLeaderLocks.locks[key]; ok && lock.LastAcquiredAt.Equal(now) {
+			lock.ReleasedAt = &releasedAt
+		}
+		nodeLeaderLocks.mu.Unlock()
+	}
+}
+
+// snapshot 返回按 key 排序的锁状态
+func (t *leaderLockTracker) snapshot(now time.Time) []ClusterLeaderLock {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]ClusterLeaderLock, 0, len(t.locks))
+	for _, lock := range t.locks {
+		item := *lock
+		item.Held = item.ReleasedAt == nil && now.Before(item.ExpiresAt)
+		out = append(out, item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
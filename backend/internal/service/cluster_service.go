@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/sysutil"
+
+	"github.com/google/uuid"
+)
+
+const (
+	clusterHeartbeatInterval = 5 * time.Second
+	// clusterNodeTTL 超过该时长未心跳的节点视为离线并从注册表消失
+	clusterNodeTTL        = 20 * time.Second
+	clusterCommandTTL     = 5 * time.Minute
+	clusterRollingPoll    = 3 * time.Second
+	clusterRejoinTimeout  = 3 * time.Minute
+	clusterShutdownMargin = time.Minute
+)
+
+// 节点指令
+const (
+	ClusterCommandDrain   = "drain"
+	ClusterCommandUndrain = "undrain"
+	ClusterCommandRestart = "restart"
+)
+
+// 滚动重启状态
+const (
+	ClusterRollingRestartRunning   = "running"
+	ClusterRollingRestartCompleted = "completed"
+	ClusterRollingRestartFailed    = "failed"
+)
+
+var (
+	ErrClusterNodeNotFound          = infraerrors.NotFound("CLUSTER_NODE_NOT_FOUND", "cluster node not found")
+	ErrClusterCommandInvalid        = infraerrors.BadRequest("CLUSTER_COMMAND_INVALID", "invalid cluster node command")
+	ErrClusterRollingRestartRunning = infraerrors.Conflict("CLUSTER_ROLLING_RESTART_RUNNING", "a rolling restart is already in progress")
+	ErrClusterRegistryUnavailable   = infraerrors.ServiceUnavailable("CLUSTER_REGISTRY_UNAVAILABLE", "cluster registry is unavailable")
+)
+
+// ClusterNode 网关实例心跳信息
+type ClusterNode struct {
+	NodeID           string              `json:"node_id"`
+	Host             string              `json:"host"`
+	PID              int                 `json:"pid"`
+	Address          string              `json:"address"`
+	Version          string              `json:"version"`
+	BuildType        string              `json:"build_type"`
+	GoVersion        string              `json:"go_version"`
+	StartedAt        time.Time           `json:"started_at"`
+	LastHeartbeatAt  time.Time           `json:"last_heartbeat_at"`
+	Draining         bool                `json:"draining"`
+	Restarting       bool                `json:"restarting"`
+	InFlightRequests int64               `json:"in_flight_requests"`
+	Goroutines       int                 `json:"goroutines"`
+	Locks            []ClusterLeaderLock `json:"locks"`
+
+	// Self 是否为处理本次请求的实例（读取时填充，不写入注册表）
+	Self bool `json:"self"`
+}
+
+// ClusterNodeCommand 下发给指定节点的指令，由目标节点在心跳时拉取执行
+type ClusterNodeCommand struct {
+	Action   string    `json:"action"`
+	IssuedAt time.Time `json:"issued_at"`
+}
+
+// ClusterRollingRestartStatus 滚动重启进度
+type ClusterRollingRestartStatus struct {
+	ID          string     `json:"id"`
+	State       string     `json:"state"`
+	InitiatedBy string     `json:"initiated_by"`
+	NodeIDs     []string   `json:"node_ids"`
+	Completed   int        `json:"completed"`
+	CurrentNode string     `json:"current_node,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+}
+
+// ClusterNodeCache 节点注册表存储（Redis）
+type ClusterNodeCache interface {
+	// UpsertNode 写入节点心跳，ttl 内未续期的节点自动过期
+	UpsertNode(ctx context.Context, node *ClusterNode, ttl time.Duration) error
+	RemoveNode(ctx context.Context, nodeID string) error
+	ListNodes(ctx context.Context) ([]ClusterNode, error)
+	// PushNodeCommand 向节点指令队列追加指令
+	PushNodeCommand(ctx context.Context, nodeID string, cmd ClusterNodeCommand, ttl time.Duration) error
+	// PopNodeCommands 取出并清空节点指令队列
+	PopNodeCommands(ctx context.Context, nodeID string) ([]ClusterNodeCommand, error)
+	// CreateRollingRestart 仅在没有进行中的滚动重启时写入状态，并持有编排锁 lockTTL
+	CreateRollingRestart(ctx context.Context, status *ClusterRollingRestartStatus, lockTTL time.Duration) (bool, error)
+	// SaveRollingRestart 更新状态；进行中时续期编排锁，结束时释放
+	SaveRollingRestart(ctx context.Context, status *ClusterRollingRestartStatus, lockTTL time.Duration) error
+	// GetRollingRestart 返回最近一次滚动重启状态，不存在时返回 nil；
+	// 状态为进行中但编排锁已过期（编排节点异常退出）时返回失败状态
+	GetRollingRestart(ctx context.Context) (*ClusterRollingRestartStatus, error)
+}
+
+// ClusterService 维护本实例在集群节点注册表中的心跳，并执行管理员下发的节点指令
+type ClusterService struct {
+	cache     ClusterNodeCache
+	health    *HealthService
+	cfg       *config.Config
+	buildInfo BuildInfo
+
+	nodeID    string
+	host      string
+	startedAt time.Time
+
+	restarting atomic.Bool
+	restartFn  func()
+
+	rollingMu sync.Mutex
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewClusterService 创建集群节点服务
+func NewClusterService(cache ClusterNodeCache, health *HealthService, cfg *config.Config, buildInfo BuildInfo) *ClusterService {
+	host, _ := os.Hostname()
+	return &ClusterService{
+		cache:     cache,
+		health:    health,
+		cfg:       cfg,
+		buildInfo: buildInfo,
+		nodeID:    uuid.NewString(),
+		host:      host,
+		startedAt: time.Now(),
+		restartFn: sysutil.RestartServiceGracefullyAsync,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// NodeID 返回本实例的节点 ID（每次启动重新生成）
+func (s *ClusterService) NodeID() string {
+	return s.nodeID
+}
+
+// Start 启动心跳
+func (s *ClusterService) Start() {
+	if s == nil || s.cache == nil {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(clusterHeartbeatInterval)
+		defer ticker.Stop()
+
+		s.heartbeat()
+		for {
+			select {
+			case <-ticker.C:
+				s.heartbeat()
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止心跳并从注册表注销
+func (s *ClusterService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+	if s.cache == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := s.cache.RemoveNode(ctx, s.nodeID); err != nil {
+		log.Printf("[Cluster] unregister node failed: %v", err)
+	}
+}
+
+func (s *ClusterService) heartbeat() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	commands, err := s.cache.PopNodeCommands(ctx, s.nodeID)
+	if err != nil {
+		log.Printf("[Cluster] pop node commands failed: %v", err)
+	}
+	for _, cmd := range commands {
+		s.executeCommand(cmd)
+	}
+
+	node := s.localNode()
+	if err := s.cache.UpsertNode(ctx, &node, clusterNodeTTL); err != nil {
+		log.Printf("[Cluster] heartbeat failed: %v", err)
+	}
+}
+
+func (s *ClusterService) localNode() ClusterNode {
+	now := time.Now()
+	address := ""
+	if s.cfg != nil {
+		address = s.cfg.Server.Address()
+	}
+	return ClusterNode{
+		NodeID:           s.nodeID,
+		Host:             s.host,
+		PID:              os.Getpid(),
+		Address:          address,
+		Version:          s.buildInfo.Version,
+		BuildType:        s.buildInfo.BuildType,
+		GoVersion:        runtime.Version(),
+		StartedAt:        s.startedAt,
+		LastHeartbeatAt:  now,
+		Draining:         s.health.IsDraining(),
+		Restarting:       s.restarting.Load(),
+		InFlightRequests: s.health.InFlight(),
+		Goroutines:       runtime.NumGoroutine(),
+		Locks:            nodeLeaderLocks.snapshot(now),
+	}
+}
+
+func (s *ClusterService) executeCommand(cmd ClusterNodeCommand) {
+	log.Printf("[Cluster] executing node command: action=%s issued_at=%s", cmd.Action, cmd.IssuedAt.Format(time.RFC3339))
+	switch cmd.Action {
+	case ClusterCommandDrain:
+		s.health.StartDraining()
+	case ClusterCommandUndrain:
+		if !s.restarting.Load() {
+			s.health.StopDraining()
+		}
+	case ClusterCommandRestart:
+		s.restartSelf()
+	default:
+		log.Printf("[Cluster] unknown node command: %s", cmd.Action)
+	}
+}
+
+// restartSelf 进入 draining 后触发优雅重启（摘流与等待进行中请求由进程的停机流程完成）
+func (s *ClusterService) restartSelf() {
+	if !s.restarting.CompareAndSwap(false, true) {
+		return
+	}
+	s.health.StartDraining()
+	if s.restartFn != nil {
+		s.restartFn()
+	}
+}
+
+// ListNodes 列出注册表中的在线节点，按启动时间排序
+func (s *ClusterService) ListNodes(ctx context.Context) ([]ClusterNode, error) {
+	if s.cache == nil {
+		return nil, ErrClusterRegistryUnavailable
+	}
+	nodes, err := s.cache.ListNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list cluster nodes: %w", err)
+	}
+	for i := range nodes {
+		nodes[i].Self = nodes[i].NodeID == s.nodeID
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].StartedAt.Before(nodes[j].StartedAt)
+	})
+	return nodes, nil
+}
+
+// SendCommand 向节点下发指令；目标为本实例时立即执行
+func (s *ClusterService) SendCommand(ctx context.Context, nodeID, action string) error {
+	switch action {
+	case ClusterCommandDrain, ClusterCommandUndrain, ClusterCommandRestart:
+	default:
+		return ErrClusterCommandInvalid
+	}
+	cmd := ClusterNodeCommand{Action: action, IssuedAt: time.Now()}
+	if nodeID == s.nodeID {
+		s.executeCommand(cmd)
+		return nil
+	}
+
+	nodes, err := s.ListNodes(ctx)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, node := range nodes {
+		if node.NodeID == nodeID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrClusterNodeNotFound
+	}
+	if err := s.cache.PushNodeCommand(ctx, nodeID, cmd, clusterCommandTTL); err != nil {
+		return fmt.Errorf("push cluster node command: %w", err)
+	}
+	return nil
+}
+
+// GetRollingRestart 返回最近一次滚动重启状态
+func (s *ClusterService) GetRollingRestart(ctx context.Context) (*ClusterRollingRestartStatus, error) {
+	if s.cache == nil {
+		return nil, ErrClusterRegistryUnavailable
+	}
+	return s.cache.GetRollingRestart(ctx)
+}
+
+// StartRollingRestart 依次重启所有节点：每次只重启一个，等待其下线且新实例重新加入后再继续，本实例最后重启
+func (s *ClusterService) StartRollingRestart(ctx context.Context) (*ClusterRollingRestartStatus, error) {
+	nodes, err := s.ListNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if !node.Self {
+			order = append(order, node.NodeID)
+		}
+	}
+	order = append(order, s.nodeID)
+
+	status := &ClusterRollingRestartStatus{
+		ID:          uuid.NewString(),
+		State:       ClusterRollingRestartRunning,
+		InitiatedBy: s.nodeID,
+		NodeIDs:     order,
+		StartedAt:   time.Now(),
+	}
+	created, err := s.cache.CreateRollingRestart(ctx, status, s.rollingLockTTL())
+	if err != nil {
+		return nil, fmt.Errorf("create rolling restart: %w", err)
+	}
+	if !created {
+		return nil, ErrClusterRollingRestartRunning
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.runRollingRestart(status, len(nodes))
+	}()
+	return status, nil
+}
+
+func (s *ClusterService) runRollingRestart(status *ClusterRollingRestartStatus, expectedNodes int) {
+	s.rollingMu.Lock()
+	defer s.rollingMu.Unlock()
+
+	for _, nodeID := range status.NodeIDs {
+		if nodeID == s.nodeID {
+			continue
+		}
+		status.CurrentNode = nodeID
+		s.saveRollingRestart(status)
+
+		if err := s.restartNodeAndWait(nodeID, expectedNodes); err != nil {
+			s.finishRollingRestart(status, err)
+			return
+		}
+		status.Completed++
+		s.saveRollingRestart(status)
+	}
+
+	// 本实例最后重启，重启后无法再更新状态，因此先标记完成
+	status.CurrentNode = s.nodeID
+	s.finishRollingRestart(status, nil)
+	s.restartSelf()
+}
+
+func (s *ClusterService) restartNodeAndWait(nodeID string, expectedNodes int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err := s.cache.PushNodeCommand(ctx, nodeID, ClusterNodeCommand{Action: ClusterCommandRestart, IssuedAt: time.Now()}, clusterCommandTTL)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("send restart to %s: %w", nodeID, err)
+	}
+
+	// 等待节点完成摘流并下线
+	if err := s.waitRolling(s.shutdownTimeout(), func(nodes []ClusterNode) bool {
+		for _, node := range nodes {
+			if node.NodeID == nodeID {
+				return false
+			}
+		}
+		return true
+	}); err != nil {
+		return fmt.Errorf("node %s did not shut down: %w", nodeID, err)
+	}
+
+	// 等待新实例加入且未处于 draining
+	if err := s.waitRolling(clusterRejoinTimeout, func(nodes []ClusterNode) bool {
+		active := 0
+		for _, node := range nodes {
+			if !node.Draining {
+				active++
+			}
+		}
+		return active >= expectedNodes
+	}); err != nil {
+		return fmt.Errorf("replacement for node %s did not join: %w", nodeID, err)
+	}
+	return nil
+}
+
+func (s *ClusterService) waitRolling(timeout time.Duration, done func([]ClusterNode) bool) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(clusterRollingPoll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			nodes, err := s.cache.ListNodes(ctx)
+			cancel()
+			if err == nil && done(nodes) {
+				return nil
+			}
+		case <-deadline.C:
+			return fmt.Errorf("timed out after %s", timeout)
+		case <-s.stopCh:
+			return errors.New("orchestrator node is shutting down")
+		}
+	}
+}
+
+// shutdownTimeout 单个节点从收到重启指令到下线的最长等待时间
+func (s *ClusterService) shutdownTimeout() time.Duration {
+	timeout := clusterShutdownMargin
+	if s.cfg != nil {
+		timeout += time.Duration(s.cfg.Server.ShutdownDrainDelay+s.cfg.Server.ShutdownDrainTimeout) * time.Second
+	}
+	return timeout
+}
+
+// rollingLockTTL 编排锁有效期覆盖单个节点的最长处理时间，编排节点异常退出后锁自动过期
+func (s *ClusterService) rollingLockTTL() time.Duration {
+	return s.shutdownTimeout() + clusterRejoinTimeout + clusterShutdownMargin
+}
+
+func (s *ClusterService) finishRollingRestart(status *ClusterRollingRestartStatus, err error) {
+	now := time.Now()
+	status.FinishedAt = &now
+	status.State = ClusterRollingRestartCompleted
+	if err != nil {
+		status.State = ClusterRollingRestartFailed
+		status.Error = err.Error()
+		log.Printf("[Cluster] rolling restart %s failed: %v", status.ID, err)
+	}
+	s.saveRollingRestart(status)
+}
+
+func (s *ClusterService) saveRollingRestart(status *ClusterRollingRestartStatus) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := s.cache.SaveRollingRestart(ctx, status, s.rollingLockTTL()); err != nil {
+		log.Printf("[Cluster] save rolling restart status failed: %v", err)
+	}
+}
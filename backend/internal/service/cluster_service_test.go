@@ -0,0 +1,220 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type clusterNodeCacheStub struct {
+	mu       sync.Mutex
+	nodes    map[string]ClusterNode
+	commands map[string][]ClusterNodeCommand
+	rolling  *ClusterRollingRestartStatus
+	locked   bool
+}
+
+func newClusterNodeCacheStub() *clusterNodeCacheStub {
+	return &clusterNodeCacheStub{
+		nodes:    make(map[string]ClusterNode),
+		commands: make(map[string][]ClusterNodeCommand),
+	}
+}
+
+func (s *clusterNodeCacheStub) UpsertNode(_ context.Context, node *ClusterNode, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes[node.NodeID] = *node
+	return nil
+}
+
+func (s *clusterNodeCacheStub) RemoveNode(_ context.Context, nodeID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.nodes, nodeID)
+	return nil
+}
+
+func (s *clusterNodeCacheStub) ListNodes(context.Context) ([]ClusterNode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]ClusterNode, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		out = append(out, node)
+	}
+	return out, nil
+}
+
+func (s *clusterNodeCacheStub) PushNodeCommand(_ context.Context, nodeID string, cmd ClusterNodeCommand, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commands[nodeID] = append(s.commands[nodeID], cmd)
+	return nil
+}
+
+func (s *clusterNodeCacheStub) PopNodeCommands(_ context.Context, nodeID string) ([]ClusterNodeCommand, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cmds := s.commands[nodeID]
+	delete(s.commands, nodeID)
+	return cmds, nil
+}
+
+func (s *clusterNodeCacheStub) CreateRollingRestart(_ context.Context, status *ClusterRollingRestartStatus, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.locked {
+		return false, nil
+	}
+	s.locked = true
+	copied := *status
+	s.rolling = &copied
+	return true, nil
+}
+
+func (s *clusterNodeCacheStub) SaveRollingRestart(_ context.Context, status *ClusterRollingRestartStatus, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *status
+	s.rolling = &copied
+	s.locked = status.State == ClusterRollingRestartRunning
+	return nil
+}
+
+func (s *clusterNodeCacheStub) GetRollingRestart(context.Context) (*ClusterRollingRestartStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rolling, nil
+}
+
+func newTestClusterService(cache ClusterNodeCache) (*ClusterService, *int) {
+	svc := NewClusterService(cache, NewHealthService(nil, nil, nil, nil, nil), nil, BuildInfo{Version: "test"})
+	restarts := 0
+	svc.restartFn = func() { restarts++ }
+	return svc, &restarts
+}
+
+func TestClusterService_SendCommandToSelfExecutesImmediately(t *testing.T) {
+	cache := newClusterNodeCacheStub()
+	svc, restarts := newTestClusterService(cache)
+
+	require.NoError(t, svc.SendCommand(context.Background(), svc.NodeID(), ClusterCommandDrain))
+	require.True(t, svc.health.IsDraining())
+
+	require.NoError(t, svc.SendCommand(context.Background(), svc.NodeID(), ClusterCommandUndrain))
+	require.False(t, svc.health.IsDraining())
+
+	require.NoError(t, svc.SendCommand(context.Background(), svc.NodeID(), ClusterCommandRestart))
+	require.NoError(t, svc.SendCommand(context.Background(), svc.NodeID(), ClusterCommandRestart))
+	require.Equal(t, 1, *restarts, "restart must only be triggered once")
+	require.True(t, svc.health.IsDraining())
+
+	// 重启中不允许撤销摘流
+	require.NoError(t, svc.SendCommand(context.Background(), svc.NodeID(), ClusterCommandUndrain))
+	require.True(t, svc.health.IsDraining())
+	require.Empty(t, cache.commands)
+}
+
+func TestClusterService_SendCommandToRemoteNode(t *testing.T) {
+	cache := newClusterNodeCacheStub()
+	svc, _ := newTestClusterService(cache)
+	cache.nodes["remote"] = ClusterNode{NodeID: "remote"}
+
+	require.NoError(t, svc.SendCommand(context.Background(), "remote", ClusterCommandDrain))
+	require.Len(t, cache.commands["remote"], 1)
+	require.Equal(t, ClusterCommandDrain, cache.commands["remote"][0].Action)
+	require.False(t, svc.health.IsDraining())
+
+	require.ErrorIs(t, svc.SendCommand(context.Background(), "missing", ClusterCommandDrain), ErrClusterNodeNotFound)
+	require.ErrorIs(t, svc.SendCommand(context.Background(), "remote", "shutdown"), ErrClusterCommandInvalid)
+}
+
+func TestClusterService_HeartbeatExecutesQueuedCommands(t *testing.T) {
+	cache := newClusterNodeCacheStub()
+	svc, _ := newTestClusterService(cache)
+	cache.commands[svc.NodeID()] = []ClusterNodeCommand{{Action: ClusterCommandDrain, IssuedAt: time.Now()}}
+
+	svc.heartbeat()
+
+	require.True(t, svc.health.IsDraining())
+	require.Empty(t, cache.commands[svc.NodeID()])
+	node, ok := cache.nodes[svc.NodeID()]
+	require.True(t, ok)
+	require.True(t, node.Draining)
+	require.Equal(t, "test", node.Version)
+}
+
+func TestClusterService_ListNodesMarksSelf(t *testing.T) {
+	cache := newClusterNodeCacheStub()
+	svc, _ := newTestClusterService(cache)
+	now := time.Now()
+	cache.nodes["older"] = ClusterNode{NodeID: "older", StartedAt: now.Add(-time.Hour)}
+	cache.nodes[svc.NodeID()] = ClusterNode{NodeID: svc.NodeID(), StartedAt: now}
+
+	nodes, err := svc.ListNodes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	require.Equal(t, "older", nodes[0].NodeID)
+	require.False(t, nodes[0].Self)
+	require.True(t, nodes[1].Self)
+}
+
+func TestClusterService_RollingRestartRejectsConcurrentRun(t *testing.T) {
+	cache := newClusterNodeCacheStub()
+	cache.locked = true
+	svc, _ := newTestClusterService(cache)
+
+	_, err := svc.StartRollingRestart(context.Background())
+	require.ErrorIs(t, err, ErrClusterRollingRestartRunning)
+}
+
+func TestClusterService_RollingRestartSingleNodeRestartsSelfLast(t *testing.T) {
+	cache := newClusterNodeCacheStub()
+	svc, restarts := newTestClusterService(cache)
+	cache.nodes[svc.NodeID()] = ClusterNode{NodeID: svc.NodeID()}
+
+	status, err := svc.StartRollingRestart(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{svc.NodeID()}, status.NodeIDs)
+
+	svc.wg.Wait()
+	require.Equal(t, 1, *restarts)
+	saved, err := cache.GetRollingRestart(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ClusterRollingRestartCompleted, saved.State)
+	require.False(t, cache.locked)
+}
+
+func TestTrackLeaderLock(t *testing.T) {
+	released := false
+	release := trackLeaderLock("test:cluster:lock", time.Minute, func() { released = true })
+	require.NotNil(t, release)
+
+	lock := findLeaderLock(t, "test:cluster:lock")
+	require.True(t, lock.Held)
+
+	release()
+	require.True(t, released)
+	lock = findLeaderLock(t, "test:cluster:lock")
+	require.False(t, lock.Held)
+	require.NotNil(t, lock.ReleasedAt)
+
+	require.Nil(t, trackLeaderLock("test:cluster:ttl", time.Minute, nil))
+	require.True(t, findLeaderLock(t, "test:cluster:ttl").Held)
+}
+
+func findLeaderLock(t *testing.T, key string) ClusterLeaderLock {
+	t.Helper()
+	for _, lock := range nodeLeaderLocks.snapshot(time.Now()) {
+		if lock.Key == key {
+			return lock
+		}
+	}
+	t.Fatalf("leader lock %s not tracked", key)
+	return ClusterLeaderLock{}
+}
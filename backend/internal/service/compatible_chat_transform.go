@@ -0,0 +1,594 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Anthropic Messages <-> OpenAI Chat Completions 协议转换（供 compatible 平台使用）。
+// 只处理通用字段：文本、图片、工具调用/结果、推理内容（reasoning_content）与用量。
+
+// convertClaudeMessagesToChatCompletions 将 Claude Messages 请求体转换为 Chat Completions 请求体。
+// upstreamModel 为映射后的上游模型名。
+func convertClaudeMessagesToChatCompletions(body []byte, upstreamModel string) ([]byte, error) {
+	var req map[string]any
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("parse request: %w", err)
+	}
+
+	messages := make([]any, 0, 8)
+	if systemText := extractClaudeSystemText(req["system"]); systemText != "" {
+		messages = append(messages, map[string]any{"role": "system", "content": systemText})
+	}
+
+	rawMessages, _ := req["messages"].([]any)
+	for i, raw := range rawMessages {
+		msg, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("messages[%d] must be an object", i)
+		}
+		role, _ := msg["role"].(string)
+		switch role {
+		case "user":
+			messages = append(messages, convertClaudeUserContentToChat(msg["content"])...)
+		case "assistant":
+			if converted := convertClaudeAssistantContentToChat(msg["content"]); converted != nil {
+				messages = append(messages, converted)
+			}
+		default:
+			return nil, fmt.Errorf("messages[%d] has unsupported role: %s", i, role)
+		}
+	}
+
+	out := map[string]any{
+		"model":    upstreamModel,
+		"messages": messages,
+	}
+	if v, ok := req["max_tokens"]; ok {
+		out["max_tokens"] = v
+	}
+	for _, key := range []string{"temperature", "top_p"} {
+		if v, ok := req[key]; ok {
+			out[key] = v
+		}
+	}
+	if stops, ok := req["stop_sequences"].([]any); ok && len(stops) > 0 {
+		out["stop"] = stops
+	}
+	if stream, _ := req["stream"].(bool); stream {
+		out["stream"] = true
+		out["stream_options"] = map[string]any{"include_usage": true}
+	}
+	if tools := convertClaudeToolsToChatTools(req["tools"]); len(tools) > 0 {
+		out["tools"] = tools
+		if choice := convertClaudeToolChoiceToChat(req["tool_choice"]); choice != nil {
+			out["tool_choice"] = choice
+		}
+	}
+	if metadata, ok := req["metadata"].(map[string]any); ok {
+		if userID, _ := metadata["user_id"].(string); userID != "" {
+			out["user"] = userID
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// convertClaudeUserContentToChat 转换 user 消息。
+// tool_result 块会被拆分为独立的 tool 消息，并排在同一轮 user 内容之前。
+func convertClaudeUserContentToChat(content any) []any {
+	if text, ok := content.(string); ok {
+		return []any{map[string]any{"role": "user", "content": text}}
+	}
+	blocks, ok := content.([]any)
+	if !ok {
+		return nil
+	}
+
+	out := make([]any, 0, 2)
+	parts := make([]any, 0, len(blocks))
+	hasImage := false
+	var texts []string
+
+	for _, raw := range blocks {
+		block, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch block["type"] {
+		case "text":
+			text, _ := block["text"].(string)
+			texts = append(texts, text)
+			parts = append(parts, map[string]any{"type": "text", "text": text})
+		case "image":
+			if url := claudeImageSourceToURL(block["source"]); url != "" {
+				hasImage = true
+				parts = append(parts, map[string]any{
+					"type":      "image_url",
+					"image_url": map[string]any{"url": url},
+				})
+			}
+		case "tool_result":
+			toolUseID, _ := block["tool_use_id"].(string)
+			resultText := extractClaudeContentText(block["content"])
+			if isErr, _ := block["is_error"].(bool); isErr && resultText != "" {
+				resultText = "Error: " + resultText
+			}
+			out = append(out, map[string]any{
+				"role":         "tool",
+				"tool_call_id": toolUseID,
+				"content":      resultText,
+			})
+		}
+	}
+
+	if len(parts) == 0 {
+		return out
+	}
+	if hasImage {
+		return append(out, map[string]any{"role": "user", "content": parts})
+	}
+	// 纯文本时使用字符串，兼容不支持数组 content 的上游
+	return append(out, map[string]any{"role": "user", "content": strings.Join(texts, "\n")})
+}
+
+func claudeImageSourceToURL(source any) string {
+	src, ok := source.(map[string]any)
+	if !ok {
+		return ""
+	}
+	switch src["type"] {
+	case "base64":
+		mediaType, _ := src["media_type"].(string)
+		data, _ := src["data"].(string)
+		if data == "" {
+			return ""
+		}
+		if mediaType == "" {
+			mediaType = "image/png"
+		}
+		return "data:" + mediaType + ";base64," + data
+	case "url":
+		url, _ := src["url"].(string)
+		return url
+	}
+	return ""
+}
+
+// convertClaudeAssistantContentToChat 转换 assistant 消息；thinking 块不回传上游。
+func convertClaudeAssistantContentToChat(content any) map[string]any {
+	if text, ok := content.(string); ok {
+		return map[string]any{"role": "assistant", "content": text}
+	}
+	blocks, ok := content.([]any)
+	if !ok {
+		return nil
+	}
+
+	var sb strings.Builder
+	toolCalls := make([]any, 0)
+	for _, raw := range blocks {
+		block, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch block["type"] {
+		case "text":
+			text, _ := block["text"].(string)
+			_, _ = sb.WriteString(text)
+		case "tool_use":
+			id, _ := block["id"].(string)
+			name, _ := block["name"].(string)
+			args := "{}"
+			if input, ok := block["input"]; ok && input != nil {
+				if b, err := json.Marshal(input); err == nil {
+					args = string(b)
+				}
+			}
+			toolCalls = append(toolCalls, map[string]any{
+				"id":   id,
+				"type": "function",
+				"function": map[string]any{
+					"name":      name,
+					"arguments": args,
+				},
+			})
+		}
+	}
+
+	msg := map[string]any{"role": "assistant"}
+	if sb.Len() > 0 {
+		msg["content"] = sb.String()
+	} else {
+		msg["content"] = nil
+	}
+	if len(toolCalls) > 0 {
+		msg["tool_calls"] = toolCalls
+	} else if sb.Len() == 0 {
+		return nil
+	}
+	return msg
+}
+
+// convertClaudeToolsToChatTools 转换自定义工具；服务端工具（web_search 等）上游无法执行，直接忽略。
+func convertClaudeToolsToChatTools(tools any) []any {
+	arr, ok := tools.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]any, 0, len(arr))
+	for _, raw := range arr {
+		tool, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if t, _ := tool["type"].(string); t != "" && t != "custom" {
+			continue
+		}
+		name, _ := tool["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		params := tool["input_schema"]
+		if params == nil {
+			params = map[string]any{"type": "object", "properties": map[string]any{}}
+		}
+		fn := map[string]any{
+			"name":       name,
+			"parameters": params,
+		}
+		if desc, _ := tool["description"].(string); desc != "" {
+			fn["description"] = desc
+		}
+		out = append(out, map[string]any{"type": "function", "function": fn})
+	}
+	return out
+}
+
+func convertClaudeToolChoiceToChat(choice any) any {
+	m, ok := choice.(map[string]any)
+	if !ok {
+		return nil
+	}
+	switch m["type"] {
+	case "auto":
+		return "auto"
+	case "any":
+		return "required"
+	case "none":
+		return "none"
+	case "tool":
+		name, _ := m["name"].(string)
+		return map[string]any{"type": "function", "function": map[string]any{"name": name}}
+	}
+	return nil
+}
+
+// convertChatCompletionToClaudeMessage 将 Chat Completions 非流式响应转换为 Claude Message。
+func convertChatCompletionToClaudeMessage(resp map[string]any, originalModel string) (map[string]any, *ClaudeUsage) {
+	content := make([]any, 0, 2)
+	finishReason := ""
+
+	if choices, ok := resp["choices"].([]any); ok && len(choices) > 0 {
+		choice, _ := choices[0].(map[string]any)
+		finishReason, _ = choice["finish_reason"].(string)
+		message, _ := choice["message"].(map[string]any)
+		if reasoning, _ := message["reasoning_content"].(string); reasoning != "" {
+			content = append(content, map[string]any{"type": "thinking", "thinking": reasoning, "signature": ""})
+		}
+		if text, _ := message["content"].(string); text != "" {
+			content = append(content, map[string]any{"type": "text", "text": text})
+		}
+		if toolCalls, ok := message["tool_calls"].([]any); ok {
+			for _, raw := range toolCalls {
+				call, ok := raw.(map[string]any)
+				if !ok {
+					continue
+				}
+				fn, _ := call["function"].(map[string]any)
+				name, _ := fn["name"].(string)
+				args, _ := fn["arguments"].(string)
+				id, _ := call["id"].(string)
+				if id == "" {
+					id = "toolu_" + randomHex(8)
+				}
+				content = append(content, map[string]any{
+					"type":  "tool_use",
+					"id":    id,
+					"name":  name,
+					"input": parseToolArguments(args),
+				})
+			}
+		}
+	}
+
+	usage := extractChatCompletionUsage(resp["usage"])
+	if usage == nil {
+		usage = &ClaudeUsage{}
+	}
+
+	stopReason := mapChatFinishReasonToClaudeStopReason(finishReason)
+	for _, block := range content {
+		if b, _ := block.(map[string]any); b["type"] == "tool_use" {
+			stopReason = "tool_use"
+			break
+		}
+	}
+
+	return map[string]any{
+		"id":            "msg_" + randomHex(12),
+		"type":          "message",
+		"role":          "assistant",
+		"model":         originalModel,
+		"content":       content,
+		"stop_reason":   stopReason,
+		"stop_sequence": nil,
+		"usage": map[string]any{
+			"input_tokens":                usage.InputTokens,
+			"output_tokens":               usage.OutputTokens,
+			"cache_creation_input_tokens": usage.CacheCreationInputTokens,
+			"cache_read_input_tokens":     usage.CacheReadInputTokens,
+		},
+	}, usage
+}
+
+func parseToolArguments(args string) map[string]any {
+	input := map[string]any{}
+	if strings.TrimSpace(args) == "" {
+		return input
+	}
+	if err := json.Unmarshal([]byte(args), &input); err != nil {
+		return map[string]any{}
+	}
+	return input
+}
+
+// extractChatCompletionUsage 解析 Chat Completions usage。
+// prompt_tokens 包含缓存命中部分（OpenAI: prompt_tokens_details.cached_tokens，DeepSeek: prompt_cache_hit_tokens），
+// 转换为 Claude 语义时需从 input_tokens 中扣除。
+func extractChatCompletionUsage(raw any) *ClaudeUsage {
+	usage, ok := raw.(map[string]any)
+	if !ok {
+		return nil
+	}
+	prompt, _ := asInt(usage["prompt_tokens"])
+	completion, _ := asInt(usage["completion_tokens"])
+	cached := 0
+	if details, ok := usage["prompt_tokens_details"].(map[string]any); ok {
+		cached, _ = asInt(details["cached_tokens"])
+	}
+	if cached == 0 {
+		cached, _ = asInt(usage["prompt_cache_hit_tokens"])
+	}
+	if cached > prompt {
+		cached = prompt
+	}
+	return &ClaudeUsage{
+		InputTokens:          prompt - cached,
+		OutputTokens:         completion,
+		CacheReadInputTokens: cached,
+	}
+}
+
+func mapChatFinishReasonToClaudeStopReason(reason string) string {
+	switch reason {
+	case "length":
+		return "max_tokens"
+	case "tool_calls", "function_call":
+		return "tool_use"
+	default:
+		return "end_turn"
+	}
+}
+
+// compatibleSSEEvent 一条待写出的 Claude SSE 事件
+type compatibleSSEEvent struct {
+	Event string
+	Data  map[string]any
+}
+
+// compatibleStreamConverter 将 Chat Completions 流式 chunk 逐条转换为 Claude SSE 事件。
+// Claude 要求内容块顺序输出，因此同一时刻只保持一个打开的块。
+type compatibleStreamConverter struct {
+	model        string
+	messageID    string
+	started      bool
+	nextIndex    int
+	openIndex    int
+	openType     string // text / thinking / tool_use
+	openToolSlot int    // 当前打开的 tool_calls[].index
+	sawToolUse   bool
+	finishReason string
+	usage        ClaudeUsage
+	hasContent   bool
+}
+
+func newCompatibleStreamConverter(model string) *compatibleStreamConverter {
+	return &compatibleStreamConverter{
+		model:        model,
+		messageID:    "msg_" + randomHex(12),
+		openIndex:    -1,
+		openToolSlot: -1,
+	}
+}
+
+func (s *compatibleStreamConverter) start() []compatibleSSEEvent {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	return []compatibleSSEEvent{{
+		Event: "message_start",
+		Data: map[string]any{
+			"type": "message_start",
+			"message": map[string]any{
+				"id":            s.messageID,
+				"type":          "message",
+				"role":          "assistant",
+				"model":         s.model,
+				"content":       []any{},
+				"stop_reason":   nil,
+				"stop_sequence": nil,
+				"usage": map[string]any{
+					"input_tokens":  0,
+					"output_tokens": 0,
+				},
+			},
+		},
+	}}
+}
+
+func (s *compatibleStreamConverter) closeOpenBlock() []compatibleSSEEvent {
+	if s.openIndex < 0 {
+		return nil
+	}
+	ev := compatibleSSEEvent{
+		Event: "content_block_stop",
+		Data:  map[string]any{"type": "content_block_stop", "index": s.openIndex},
+	}
+	s.openIndex = -1
+	s.openType = ""
+	s.openToolSlot = -1
+	return []compatibleSSEEvent{ev}
+}
+
+func (s *compatibleStreamConverter) openBlock(blockType string, block map[string]any) []compatibleSSEEvent {
+	events := s.closeOpenBlock()
+	s.openIndex = s.nextIndex
+	s.openType = blockType
+	s.nextIndex++
+	return append(events, compatibleSSEEvent{
+		Event: "content_block_start",
+		Data: map[string]any{
+			"type":          "content_block_start",
+			"index":         s.openIndex,
+			"content_block": block,
+		},
+	})
+}
+
+func (s *compatibleStreamConverter) delta(delta map[string]any) compatibleSSEEvent {
+	return compatibleSSEEvent{
+		Event: "content_block_delta",
+		Data: map[string]any{
+			"type":  "content_block_delta",
+			"index": s.openIndex,
+			"delta": delta,
+		},
+	}
+}
+
+// HandleChunk 处理一个 chunk，返回需要写出的事件
+func (s *compatibleStreamConverter) HandleChunk(chunk map[string]any) []compatibleSSEEvent {
+	events := s.start()
+
+	if u := extractChatCompletionUsage(chunk["usage"]); u != nil {
+		s.usage = *u
+	}
+
+	choices, _ := chunk["choices"].([]any)
+	if len(choices) == 0 {
+		return events
+	}
+	choice, _ := choices[0].(map[string]any)
+	if fr, _ := choice["finish_reason"].(string); fr != "" {
+		s.finishReason = fr
+	}
+	delta, _ := choice["delta"].(map[string]any)
+	if delta == nil {
+		return events
+	}
+
+	if reasoning, _ := delta["reasoning_content"].(string); reasoning != "" {
+		if s.openType != "thinking" {
+			events = append(events, s.openBlock("thinking", map[string]any{"type": "thinking", "thinking": ""})...)
+		}
+		s.hasContent = true
+		events = append(events, s.delta(map[string]any{"type": "thinking_delta", "thinking": reasoning}))
+	}
+
+	if text, _ := delta["content"].(string); text != "" {
+		if s.openType != "text" {
+			events = append(events, s.openBlock("text", map[string]any{"type": "text", "text": ""})...)
+		}
+		s.hasContent = true
+		events = append(events, s.delta(map[string]any{"type": "text_delta", "text": text}))
+	}
+
+	toolCalls, _ := delta["tool_calls"].([]any)
+	for _, raw := range toolCalls {
+		call, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		slot, ok := asInt(call["index"])
+		if !ok {
+			slot = 0
+		}
+		fn, _ := call["function"].(map[string]any)
+		if s.openType != "tool_use" || s.openToolSlot != slot {
+			id, _ := call["id"].(string)
+			if id == "" {
+				id = "toolu_" + randomHex(8)
+			}
+			name, _ := fn["name"].(string)
+			events = append(events, s.openBlock("tool_use", map[string]any{
+				"type":  "tool_use",
+				"id":    id,
+				"name":  name,
+				"input": map[string]any{},
+			})...)
+			s.openToolSlot = slot
+			s.sawToolUse = true
+			s.hasContent = true
+		}
+		if args, _ := fn["arguments"].(string); args != "" {
+			events = append(events, s.delta(map[string]any{"type": "input_json_delta", "partial_json": args}))
+		}
+	}
+
+	return events
+}
+
+// Finish 结束流：关闭打开的块并输出 message_delta / message_stop
+func (s *compatibleStreamConverter) Finish() []compatibleSSEEvent {
+	events := s.start()
+	events = append(events, s.closeOpenBlock()...)
+
+	stopReason := mapChatFinishReasonToClaudeStopReason(s.finishReason)
+	if s.sawToolUse {
+		stopReason = "tool_use"
+	}
+	usageObj := map[string]any{
+		"output_tokens": s.usage.OutputTokens,
+	}
+	if s.usage.InputTokens > 0 {
+		usageObj["input_tokens"] = s.usage.InputTokens
+	}
+	if s.usage.CacheReadInputTokens > 0 {
+		usageObj["cache_read_input_tokens"] = s.usage.CacheReadInputTokens
+	}
+	return append(events,
+		compatibleSSEEvent{
+			Event: "message_delta",
+			Data: map[string]any{
+				"type": "message_delta",
+				"delta": map[string]any{
+					"stop_reason":   stopReason,
+					"stop_sequence": nil,
+				},
+				"usage": usageObj,
+			},
+		},
+		compatibleSSEEvent{
+			Event: "message_stop",
+			Data:  map[string]any{"type": "message_stop"},
+		},
+	)
+}
+
+// Usage 返回流中累计的用量
+func (s *compatibleStreamConverter) Usage() ClaudeUsage {
+	return s.usage
+}
@@ -0,0 +1,157 @@
+//go:build unit
+
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertClaudeMessagesToChatCompletions(t *testing.T) {
+	body := []byte(`{
+		"model": "claude-sonnet-4",
+		"max_tokens": 1024,
+		"stream": true,
+		"stop_sequences": ["END"],
+		"system": [{"type":"text","text":"You are helpful."}],
+		"tools": [
+			{"name":"get_weather","description":"Get weather","input_schema":{"type":"object","properties":{"city":{"type":"string"}}}},
+			{"type":"web_search_20250305","name":"web_search"}
+		],
+		"tool_choice": {"type":"any"},
+		"messages": [
+			{"role":"user","content":[
+				{"type":"text","text":"What's in this image?"},
+				{"type":"image","source":{"type":"base64","media_type":"image/jpeg","data":"AAAA"}}
+			]},
+			{"role":"assistant","content":[
+				{"type":"thinking","thinking":"hmm","signature":"x"},
+				{"type":"tool_use","id":"toolu_1","name":"get_weather","input":{"city":"Paris"}}
+			]},
+			{"role":"user","content":[
+				{"type":"tool_result","tool_use_id":"toolu_1","content":[{"type":"text","text":"sunny"}]},
+				{"type":"text","text":"thanks"}
+			]}
+		]
+	}`)
+
+	out, err := convertClaudeMessagesToChatCompletions(body, "deepseek-chat")
+	require.NoError(t, err)
+
+	var req map[string]any
+	require.NoError(t, json.Unmarshal(out, &req))
+	require.Equal(t, "deepseek-chat", req["model"])
+	require.Equal(t, float64(1024), req["max_tokens"])
+	require.Equal(t, true, req["stream"])
+	require.Equal(t, map[string]any{"include_usage": true}, req["stream_options"])
+	require.Equal(t, []any{"END"}, req["stop"])
+	require.Equal(t, "required", req["tool_choice"])
+
+	tools := req["tools"].([]any)
+	require.Len(t, tools, 1, "服务端工具应被忽略")
+	fn := tools[0].(map[string]any)["function"].(map[string]any)
+	require.Equal(t, "get_weather", fn["name"])
+
+	messages := req["messages"].([]any)
+	require.Len(t, messages, 5)
+	require.Equal(t, map[string]any{"role": "system", "content": "You are helpful."}, messages[0])
+
+	userParts := messages[1].(map[string]any)["content"].([]any)
+	require.Len(t, userParts, 2)
+	require.Equal(t, "data:image/jpeg;base64,AAAA", userParts[1].(map[string]any)["image_url"].(map[string]any)["url"])
+
+	assistant := messages[2].(map[string]any)
+	require.Nil(t, assistant["content"])
+	call := assistant["tool_calls"].([]any)[0].(map[string]any)
+	require.Equal(t, "toolu_1", call["id"])
+	require.JSONEq(t, `{"city":"Paris"}`, call["function"].(map[string]any)["arguments"].(string))
+
+	require.Equal(t, map[string]any{"role": "tool", "tool_call_id": "toolu_1", "content": "sunny"}, messages[3])
+	require.Equal(t, map[string]any{"role": "user", "content": "thanks"}, messages[4])
+}
+
+func TestConvertClaudeMessagesToChatCompletions_InvalidRole(t *testing.T) {
+	_, err := convertClaudeMessagesToChatCompletions([]byte(`{"messages":[{"role":"system","content":"x"}]}`), "m")
+	require.Error(t, err)
+}
+
+func TestConvertChatCompletionToClaudeMessage(t *testing.T) {
+	resp := map[string]any{
+		"choices": []any{map[string]any{
+			"finish_reason": "tool_calls",
+			"message": map[string]any{
+				"reasoning_content": "thinking...",
+				"content":           "Let me check.",
+				"tool_calls": []any{map[string]any{
+					"id":       "call_1",
+					"type":     "function",
+					"function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`},
+				}},
+			},
+		}},
+		"usage": map[string]any{
+			"prompt_tokens":         float64(100),
+			"completion_tokens":     float64(20),
+			"prompt_tokens_details": map[string]any{"cached_tokens": float64(60)},
+		},
+	}
+
+	msg, usage := convertChatCompletionToClaudeMessage(resp, "claude-sonnet-4")
+	require.Equal(t, "claude-sonnet-4", msg["model"])
+	require.Equal(t, "tool_use", msg["stop_reason"])
+
+	content := msg["content"].([]any)
+	require.Len(t, content, 3)
+	require.Equal(t, "thinking", content[0].(map[string]any)["type"])
+	require.Equal(t, "Let me check.", content[1].(map[string]any)["text"])
+	toolUse := content[2].(map[string]any)
+	require.Equal(t, "call_1", toolUse["id"])
+	require.Equal(t, map[string]any{"city": "Paris"}, toolUse["input"])
+
+	require.Equal(t, &ClaudeUsage{InputTokens: 40, OutputTokens: 20, CacheReadInputTokens: 60}, usage)
+}
+
+func TestExtractChatCompletionUsage_DeepSeekCacheHit(t *testing.T) {
+	usage := extractChatCompletionUsage(map[string]any{
+		"prompt_tokens":           float64(50),
+		"completion_tokens":       float64(5),
+		"prompt_cache_hit_tokens": float64(30),
+	})
+	require.Equal(t, &ClaudeUsage{InputTokens: 20, OutputTokens: 5, CacheReadInputTokens: 30}, usage)
+	require.Nil(t, extractChatCompletionUsage(nil))
+}
+
+func TestCompatibleStreamConverter(t *testing.T) {
+	conv := newCompatibleStreamConverter("claude-sonnet-4")
+
+	var events []compatibleSSEEvent
+	events = append(events, conv.HandleChunk(map[string]any{"choices": []any{map[string]any{"delta": map[string]any{"reasoning_content": "hm"}}}})...)
+	events = append(events, conv.HandleChunk(map[string]any{"choices": []any{map[string]any{"delta": map[string]any{"content": "Hi"}}}})...)
+	events = append(events, conv.HandleChunk(map[string]any{"choices": []any{map[string]any{"delta": map[string]any{"tool_calls": []any{
+		map[string]any{"index": float64(0), "id": "call_1", "function": map[string]any{"name": "f", "arguments": `{"a":`}},
+	}}}}})...)
+	events = append(events, conv.HandleChunk(map[string]any{"choices": []any{map[string]any{"delta": map[string]any{"tool_calls": []any{
+		map[string]any{"index": float64(0), "function": map[string]any{"arguments": `1}`}},
+	}}, "finish_reason": "tool_calls"}}})...)
+	events = append(events, conv.HandleChunk(map[string]any{"choices": []any{}, "usage": map[string]any{"prompt_tokens": float64(10), "completion_tokens": float64(3)}})...)
+	events = append(events, conv.Finish()...)
+
+	names := make([]string, 0, len(events))
+	for _, ev := range events {
+		names = append(names, ev.Event)
+	}
+	require.Equal(t, []string{
+		"message_start",
+		"content_block_start", "content_block_delta",
+		"content_block_stop", "content_block_start", "content_block_delta",
+		"content_block_stop", "content_block_start", "content_block_delta",
+		"content_block_delta",
+		"content_block_stop", "message_delta", "message_stop",
+	}, names)
+
+	delta := events[len(events)-2].Data["delta"].(map[string]any)
+	require.Equal(t, "tool_use", delta["stop_reason"])
+	require.Equal(t, ClaudeUsage{InputTokens: 10, OutputTokens: 3}, conv.Usage())
+}
@@ -0,0 +1,532 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
+	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// compatibleErrorWriter 按入口协议写出错误（Claude / OpenAI 格式）
+type compatibleErrorWriter func(c *gin.Context, status int, errType, message string) error
+
+// CompatibleGatewayService 通用 OpenAI 兼容上游（/chat/completions）转发服务
+type CompatibleGatewayService struct {
+	rateLimitService *RateLimitService
+	httpUpstream     HTTPUpstream
+	cfg              *config.Config
+	hotConfig        // 可热更新的配置项通过 runtimeConfig 读取
+}
+
+// NewCompatibleGatewayService creates a new CompatibleGatewayService
+func NewCompatibleGatewayService(
+	rateLimitService *RateLimitService,
+	httpUpstream HTTPUpstream,
+	cfg *config.Config,
+) *CompatibleGatewayService {
+	return &CompatibleGatewayService{
+		rateLimitService: rateLimitService,
+		httpUpstream:     httpUpstream,
+		cfg:              cfg,
+	}
+}
+
+// runtimeConfig 返回当前配置快照（热更新后为最新发布的快照）
+func (s *CompatibleGatewayService) runtimeConfig() *config.Config {
+	return s.load(s.cfg)
+}
+
+// Forward 处理 /v1/messages 请求：Claude Messages → Chat Completions → Claude Messages
+func (s *CompatibleGatewayService) Forward(ctx context.Context, c *gin.Context, account *Account, body []byte) (*ForwardResult, error) {
+	startTime := time.Now()
+
+	var req struct {
+		Model  string `json:"model"`
+		Stream bool   `json:"stream"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("parse request: %w", err)
+	}
+	if strings.TrimSpace(req.Model) == "" {
+		return nil, fmt.Errorf("missing model")
+	}
+
+	originalModel := req.Model
+	mappedModel := account.GetMappedModel(req.Model)
+
+	chatBody, err := convertClaudeMessagesToChatCompletions(body, mappedModel)
+	if err != nil {
+		return nil, writeCompatibleClaudeError(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+	}
+
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.runtimeConfig(), req.Stream)
+	defer firstByte.release()
+
+	resp, err := s.doRequest(upstreamCtx, c, account, chatBody, firstByte, writeCompatibleClaudeError)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	requestID := resp.Header.Get("x-request-id")
+	if requestID != "" {
+		c.Header("x-request-id", requestID)
+	}
+
+	var usage ClaudeUsage
+	var firstTokenMs *int
+	clientDisconnect := false
+	if req.Stream {
+		streamRes, err := s.handleClaudeStreamingResponse(c, resp, account, startTime, originalModel, firstByte)
+		if err != nil {
+			return nil, err
+		}
+		usage = *streamRes.usage
+		firstTokenMs = streamRes.firstTokenMs
+		clientDisconnect = streamRes.clientDisconnect
+	} else {
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 8<<20))
+		if err != nil {
+			return nil, writeCompatibleClaudeError(c, http.StatusBadGateway, "upstream_error", "Failed to read upstream response")
+		}
+		var chatResp map[string]any
+		if err := json.Unmarshal(respBody, &chatResp); err != nil {
+			return nil, writeCompatibleClaudeError(c, http.StatusBadGateway, "upstream_error", "Failed to parse upstream response")
+		}
+		claudeResp, usageObj := convertChatCompletionToClaudeMessage(chatResp, originalModel)
+		c.JSON(http.StatusOK, claudeResp)
+		usage = *usageObj
+	}
+
+	return &ForwardResult{
+		RequestID:        requestID,
+		Usage:            usage,
+		Model:            originalModel,
+		Stream:           req.Stream,
+		Duration:         time.Since(startTime),
+		FirstTokenMs:     firstTokenMs,
+		ClientDisconnect: clientDisconnect,
+	}, nil
+}
+
+// ForwardChatCompletions 处理 /v1/chat/completions 请求：仅替换模型名后透传。
+// 流式请求会注入 stream_options.include_usage 以便计费。
+func (s *CompatibleGatewayService) ForwardChatCompletions(ctx context.Context, c *gin.Context, account *Account, body []byte) (*ForwardResult, error) {
+	startTime := time.Now()
+
+	originalModel := strings.TrimSpace(gjson.GetBytes(body, "model").String())
+	if originalModel == "" {
+		return nil, writeCompatibleOpenAIError(c, http.StatusBadRequest, "invalid_request_error", "model is required")
+	}
+	reqStream := gjson.GetBytes(body, "stream").Bool()
+	mappedModel := account.GetMappedModel(originalModel)
+
+	upstreamBody := body
+	var err error
+	if mappedModel != originalModel {
+		if upstreamBody, err = sjson.SetBytes(upstreamBody, "model", mappedModel); err != nil {
+			return nil, fmt.Errorf("rewrite model: %w", err)
+		}
+	}
+	if reqStream {
+		if upstreamBody, err = sjson.SetBytes(upstreamBody, "stream_options.include_usage", true); err != nil {
+			return nil, fmt.Errorf("inject stream_options: %w", err)
+		}
+	}
+
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.runtimeConfig(), reqStream)
+	defer firstByte.release()
+
+	resp, err := s.doRequest(upstreamCtx, c, account, upstreamBody, firstByte, writeCompatibleOpenAIError)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if s.cfg != nil {
+		responseheaders.WriteFilteredHeaders(c.Writer.Header(), resp.Header, s.cfg.Security.ResponseHeaders)
+	}
+	requestID := resp.Header.Get("x-request-id")
+
+	var usage ClaudeUsage
+	var firstTokenMs *int
+	clientDisconnect := false
+	if reqStream {
+		streamRes, err := s.handleChatStreamingResponse(c, resp, account, startTime, originalModel, mappedModel, firstByte)
+		if err != nil {
+			return nil, err
+		}
+		usage = *streamRes.usage
+		firstTokenMs = streamRes.firstTokenMs
+		clientDisconnect = streamRes.clientDisconnect
+	} else {
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 8<<20))
+		if err != nil {
+			return nil, writeCompatibleOpenAIError(c, http.StatusBadGateway, "upstream_error", "Failed to read upstream response")
+		}
+		if u := extractChatCompletionUsage(gjson.GetBytes(respBody, "usage").Value()); u != nil {
+			usage = *u
+		}
+		if mappedModel != originalModel {
+			if replaced, err := sjson.SetBytes(respBody, "model", originalModel); err == nil {
+				respBody = replaced
+			}
+		}
+		c.Data(http.StatusOK, "application/json", respBody)
+	}
+
+	return &ForwardResult{
+		RequestID:        requestID,
+		Usage:            usage,
+		Model:            originalModel,
+		Stream:           reqStream,
+		Duration:         time.Since(startTime),
+		FirstTokenMs:     firstTokenMs,
+		ClientDisconnect: clientDisconnect,
+	}, nil
+}
+
+// doRequest 发送上游请求并统一处理错误：可切换的错误返回 UpstreamFailoverError，其余错误直接写回客户端。
+func (s *CompatibleGatewayService) doRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, firstByte *firstByteGuard, writeError compatibleErrorWriter) (*http.Response, error) {
+	baseURL := account.GetCompatibleBaseURL()
+	if baseURL == "" {
+		return nil, writeError(c, http.StatusBadGateway, "upstream_error", "compatible base_url not configured")
+	}
+	normalizedBaseURL, err := s.validateUpstreamBaseURL(baseURL)
+	if err != nil {
+		return nil, writeError(c, http.StatusBadGateway, "upstream_error", err.Error())
+	}
+
+	upstreamReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(normalizedBaseURL, "/")+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	upstreamReq.Header.Set("Content-Type", "application/json")
+	if apiKey := account.GetCompatibleAPIKey(); apiKey != "" {
+		upstreamReq.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+
+	// Capture upstream request body for ops retry of this attempt.
+	if c != nil {
+		c.Set(OpsUpstreamRequestBodyKey, string(body))
+	}
+
+	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
+		safeErr := sanitizeUpstreamErrorMessage(err.Error())
+		setOpsUpstreamError(c, 0, safeErr, "")
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:           account.Platform,
+			AccountID:          account.ID,
+			AccountName:        account.Name,
+			UpstreamStatusCode: 0,
+			Kind:               "request_error",
+			Message:            safeErr,
+		})
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		return nil, &UpstreamFailoverError{StatusCode: http.StatusBadGateway}
+	}
+	if resp.StatusCode < 400 {
+		return resp, nil
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+
+	upstreamMsg := sanitizeUpstreamErrorMessage(strings.TrimSpace(extractUpstreamErrorMessage(respBody)))
+	upstreamDetail := ""
+	if s.cfg != nil && s.cfg.Gateway.LogUpstreamErrorBody {
+		maxBytes := s.cfg.Gateway.LogUpstreamErrorBodyMaxBytes
+		if maxBytes <= 0 {
+			maxBytes = 2048
+		}
+		upstreamDetail = truncateString(string(respBody), maxBytes)
+		log.Printf("Compatible upstream error %d (account=%d): %s", resp.StatusCode, account.ID, upstreamDetail)
+	}
+	upstreamReqID := resp.Header.Get("x-request-id")
+
+	if s.shouldFailoverUpstreamError(resp.StatusCode) {
+		if s.rateLimitService != nil {
+			s.rateLimitService.HandleUpstreamError(ctx, account, resp.StatusCode, resp.Header, respBody)
+		}
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:           account.Platform,
+			AccountID:          account.ID,
+			AccountName:        account.Name,
+			UpstreamStatusCode: resp.StatusCode,
+			UpstreamRequestID:  upstreamReqID,
+			Kind:               "failover",
+			Message:            upstreamMsg,
+			Detail:             upstreamDetail,
+		})
+		return nil, &UpstreamFailoverError{StatusCode: resp.StatusCode, ResponseBody: respBody}
+	}
+
+	if shouldReturnPromptTooLong(ctx, resp.StatusCode, respBody) {
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:           account.Platform,
+			AccountID:          account.ID,
+			AccountName:        account.Name,
+			UpstreamStatusCode: resp.StatusCode,
+			UpstreamRequestID:  upstreamReqID,
+			Kind:               "prompt_too_long",
+			Message:            upstreamMsg,
+			Detail:             upstreamDetail,
+		})
+		return nil, &PromptTooLongError{StatusCode: resp.StatusCode, RequestID: upstreamReqID, Body: respBody}
+	}
+
+	setOpsUpstreamError(c, resp.StatusCode, upstreamMsg, upstreamDetail)
+	appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+		Platform:           account.Platform,
+		AccountID:          account.ID,
+		AccountName:        account.Name,
+		UpstreamStatusCode: resp.StatusCode,
+		UpstreamRequestID:  upstreamReqID,
+		Kind:               "http_error",
+		Message:            upstreamMsg,
+		Detail:             upstreamDetail,
+	})
+
+	// 4xx 多为请求本身的问题（参数、上下文超长等），透传状态码与消息便于客户端修正
+	defaultStatus := http.StatusBadGateway
+	defaultType := "upstream_error"
+	defaultMsg := "Upstream request failed"
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		defaultStatus = resp.StatusCode
+		defaultType = "invalid_request_error"
+		if upstreamMsg != "" {
+			defaultMsg = upstreamMsg
+		}
+	}
+	status, errType, errMsg, _ := applyErrorPassthroughRule(c, PlatformCompatible, resp.StatusCode, respBody, defaultStatus, defaultType, defaultMsg)
+	_ = writeError(c, status, errType, errMsg)
+	if upstreamMsg == "" {
+		return nil, fmt.Errorf("upstream error: %d", resp.StatusCode)
+	}
+	return nil, fmt.Errorf("upstream error: %d message=%s", resp.StatusCode, upstreamMsg)
+}
+
+func (s *CompatibleGatewayService) shouldFailoverUpstreamError(statusCode int) bool {
+	switch statusCode {
+	case 401, 402, 403, 429, 529:
+		return true
+	default:
+		return statusCode >= 500
+	}
+}
+
+func (s *CompatibleGatewayService) validateUpstreamBaseURL(raw string) (string, error) {
+	if s.cfg == nil || !s.cfg.Security.URLAllowlist.Enabled {
+		allowInsecure := s.cfg != nil && s.cfg.Security.URLAllowlist.AllowInsecureHTTP
+		normalized, err := urlvalidator.ValidateURLFormat(raw, allowInsecure)
+		if err != nil {
+			return "", fmt.Errorf("invalid base_url: %w", err)
+		}
+		return normalized, nil
+	}
+	normalized, err := urlvalidator.ValidateHTTPSURL(raw, urlvalidator.ValidationOptions{
+		AllowedHosts:     s.cfg.Security.URLAllowlist.UpstreamHosts,
+		RequireAllowlist: true,
+		AllowPrivate:     s.cfg.Security.URLAllowlist.AllowPrivateHosts,
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid base_url: %w", err)
+	}
+	return normalized, nil
+}
+
+type compatibleStreamResult struct {
+	usage            *ClaudeUsage
+	firstTokenMs     *int
+	clientDisconnect bool
+}
+
+func (s *CompatibleGatewayService) newStreamScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	maxLineSize := defaultMaxLineSize
+	if s.cfg != nil && s.cfg.Gateway.MaxLineSize > 0 {
+		maxLineSize = s.cfg.Gateway.MaxLineSize
+	}
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	return scanner
+}
+
+func setCompatibleSSEHeaders(c *gin.Context) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+}
+
+// handleClaudeStreamingResponse 将上游 Chat Completions 流转换为 Claude SSE
+func (s *CompatibleGatewayService) handleClaudeStreamingResponse(c *gin.Context, resp *http.Response, account *Account, startTime time.Time, originalModel string, firstByte *firstByteGuard) (*compatibleStreamResult, error) {
+	setCompatibleSSEHeaders(c)
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming not supported")
+	}
+
+	converter := newCompatibleStreamConverter(originalModel)
+	var firstTokenMs *int
+	clientDisconnect := false
+	emit := func(events []compatibleSSEEvent) {
+		if clientDisconnect || len(events) == 0 {
+			return
+		}
+		for _, ev := range events {
+			writeSSE(c.Writer, ev.Event, ev.Data)
+		}
+		flusher.Flush()
+		if c.Request != nil && c.Request.Context().Err() != nil {
+			clientDisconnect = true
+		}
+	}
+
+	// 首字节预算内不提前输出 message_start，保留切换账号的机会
+	started := false
+	startMessage := func() {
+		if !started {
+			started = true
+			emit(converter.start())
+		}
+	}
+	if !firstByte.pending() {
+		startMessage()
+	}
+
+	scanner := s.newStreamScanner(resp.Body)
+	for scanner.Scan() {
+		firstByte.markReceived()
+		startMessage()
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if payload == "" || payload == "[DONE]" {
+			continue
+		}
+		var chunk map[string]any
+		if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+			continue
+		}
+		if firstTokenMs == nil {
+			ms := int(time.Since(startTime).Milliseconds())
+			firstTokenMs = &ms
+		}
+		// 客户端断开后继续读取上游，保证用量统计完整
+		emit(converter.HandleChunk(chunk))
+	}
+	if err := scanner.Err(); err != nil && !clientDisconnect {
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
+		return nil, fmt.Errorf("stream read error: %w", err)
+	}
+
+	startMessage()
+	emit(converter.Finish())
+
+	usage := converter.Usage()
+	return &compatibleStreamResult{usage: &usage, firstTokenMs: firstTokenMs, clientDisconnect: clientDisconnect}, nil
+}
+
+// handleChatStreamingResponse 透传 Chat Completions 流，仅还原模型名并统计用量
+func (s *CompatibleGatewayService) handleChatStreamingResponse(c *gin.Context, resp *http.Response, account *Account, startTime time.Time, originalModel, mappedModel string, firstByte *firstByteGuard) (*compatibleStreamResult, error) {
+	setCompatibleSSEHeaders(c)
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming not supported")
+	}
+
+	var usage ClaudeUsage
+	var firstTokenMs *int
+	clientDisconnect := false
+
+	scanner := s.newStreamScanner(resp.Body)
+	for scanner.Scan() {
+		firstByte.markReceived()
+		line := scanner.Text()
+		if strings.HasPrefix(line, "data:") {
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload != "" && payload != "[DONE]" {
+				if firstTokenMs == nil {
+					ms := int(time.Since(startTime).Milliseconds())
+					firstTokenMs = &ms
+				}
+				if u := extractChatCompletionUsage(gjson.Get(payload, "usage").Value()); u != nil {
+					usage = *u
+				}
+				if mappedModel != originalModel && gjson.Get(payload, "model").Exists() {
+					if replaced, err := sjson.Set(payload, "model", originalModel); err == nil {
+						line = "data: " + replaced
+					}
+				}
+			}
+		}
+		if clientDisconnect {
+			continue
+		}
+		if _, err := fmt.Fprintf(c.Writer, "%s\n", line); err != nil {
+			clientDisconnect = true
+			continue
+		}
+		if line == "" {
+			flusher.Flush()
+		}
+	}
+	if err := scanner.Err(); err != nil && !clientDisconnect {
+		if firstByte.expired() {
+			return nil, firstByte.failover(c, account)
+		}
+		return nil, fmt.Errorf("stream read error: %w", err)
+	}
+	if !clientDisconnect {
+		flusher.Flush()
+	}
+
+	return &compatibleStreamResult{usage: &usage, firstTokenMs: firstTokenMs, clientDisconnect: clientDisconnect}, nil
+}
+
+func writeCompatibleClaudeError(c *gin.Context, status int, errType, message string) error {
+	c.JSON(status, gin.H{
+		"type":  "error",
+		"error": gin.H{"type": errType, "message": message},
+	})
+	return fmt.Errorf("%s", message)
+}
+
+func writeCompatibleOpenAIError(c *gin.Context, status int, errType, message string) error {
+	c.JSON(status, gin.H{
+		"error": gin.H{"type": errType, "message": message},
+	})
+	return fmt.Errorf("%s", message)
+}
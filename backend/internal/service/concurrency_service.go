@@ -59,7 +59,8 @@ const (
 
 // ConcurrencyService manages concurrent request limiting for accounts and users
 type ConcurrencyService struct {
-	cache ConcurrencyCache
+	cache     ConcurrencyCache
+	fairQueue *FairQueueService
 }
 
 // NewConcurrencyService creates a new ConcurrencyService
@@ -67,6 +68,11 @@ func NewConcurrencyService(cache ConcurrencyCache) *ConcurrencyService {
 	return &ConcurrencyService{cache: cache}
 }
 
+// SetFairQueueService 设置分组公平队列，用于新请求让位于正在排队等待同一账号的请求
+func (s *ConcurrencyService) SetFairQueueService(fairQueue *FairQueueService) {
+	s.fairQueue = fairQueue
+}
+
 // AcquireResult represents the result of acquiring a concurrency slot
 type AcquireResult struct {
 	Acquired    bool
@@ -136,6 +142,15 @@ func (s *ConcurrencyService) AcquireAccountSlot(ctx context.Context, accountID i
 	}, nil
 }
 
+// AcquireAccountSlotUnlessQueued 仅当没有请求在公平队列中等待该账号时尝试获取槽位
+// 账号有排队者时直接返回未获取，空出的槽位留给队列中的请求，新请求随后自行入队。
+func (s *ConcurrencyService) AcquireAccountSlotUnlessQueued(ctx context.Context, accountID int64, maxConcurrency int) (*AcquireResult, error) {
+	if maxConcurrency > 0 && s.fairQueue.HasAccountWaiters(ctx, accountID) {
+		return &AcquireResult{Acquired: false}, nil
+	}
+	return s.AcquireAccountSlot(ctx, accountID, maxConcurrency)
+}
+
 // AcquireUserSlot attempts to acquire a concurrency slot for a user.
 // If the user is at max concurrency, it waits until a slot is available or timeout.
 // Returns a release function that MUST be called when the request completes.
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 配置重新加载触发方式
+const (
+	ConfigReloadTriggerManual = "manual"
+	ConfigReloadTriggerWatch  = "watch"
+)
+
+var ErrConfigFileNotFound = infraerrors.BadRequest("CONFIG_FILE_NOT_FOUND", "server was started without a config file")
+
+// ConfigReloadResult 一次配置重新加载的结果
+// 只报告配置项路径，不返回配置值（部分配置项为密钥）。
+type ConfigReloadResult struct {
+	Trigger    string    `json:"trigger"`
+	ReloadedAt time.Time `json:"reloaded_at"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+	// Applied 本次已热更新生效的配置项
+	Applied []string `json:"applied"`
+	// RestartRequired 与运行中配置不同、需要重启才能生效的配置项（包含之前重新加载遗留的未生效项）
+	RestartRequired []string `json:"restart_required"`
+}
+
+// ConfigReloadStatus 配置热更新状态
+type ConfigReloadStatus struct {
+	Path                 string              `json:"path"`
+	WatchEnabled         bool                `json:"watch_enabled"`
+	WatchIntervalSeconds int                 `json:"watch_interval_seconds"`
+	LastReload           *ConfigReloadResult `json:"last_reload"`
+}
+
+// ConfigReloadService 配置文件热更新
+//
+// 由文件监听或管理员手动触发：重新读取并校验配置文件，以当前快照为基础生成写入了可热更新配置项的新快照，
+// 再通过钩子发布给各组件；其余变更只报告为需要重启。启动时的 *config.Config 与已发布的快照均不会被原地修改。
+type ConfigReloadService struct {
+	cfg     *config.Config
+	current atomic.Pointer[config.Config]
+	path    string
+	loader  func(path string, current *config.Config) (*config.Config, error)
+
+	mu       sync.Mutex
+	hooks    []func(*config.Config)
+	last     *ConfigReloadResult
+	lastHash [sha256.Size]byte
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewConfigReloadService 创建配置热更新服务，path 为启动时读取的配置文件（为空表示未使用配置文件）
+func NewConfigReloadService(cfg *config.Config, path string) *ConfigReloadService {
+	s := &ConfigReloadService{
+		cfg:    cfg,
+		path:   path,
+		loader: config.Reload,
+		stopCh: make(chan struct{}),
+	}
+	s.current.Store(cfg)
+	s.lastHash, _ = s.fileHash()
+	return s
+}
+
+// OnReload 注册热更新钩子，配置项生效后以新发布的配置快照调用
+func (s *ConfigReloadService) OnReload(hook func(*config.Config)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks = append(s.hooks, hook)
+}
+
+// Start 启动配置文件监听
+func (s *ConfigReloadService) Start() {
+	if s == nil || s.path == "" || s.cfg == nil || !s.cfg.ConfigReload.WatchEnabled {
+		return
+	}
+	interval := time.Duration(s.cfg.ConfigReload.WatchIntervalSeconds) * time.Second
+	s.wg.Add(1)
+	go s.watchLoop(interval)
+	log.Printf("[ConfigReload] Watching %s (every %s)", s.path, interval)
+}
+
+// Stop 停止配置文件监听
+func (s *ConfigReloadService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+// watchLoop 按内容摘要轮询配置文件（兼容编辑器原子替换与 ConfigMap 符号链接切换）
+func (s *ConfigReloadService) watchLoop(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			hash, err := s.fileHash()
+			if err != nil {
+				continue
+			}
+			s.mu.Lock()
+			changed := hash != s.lastHash
+			s.mu.Unlock()
+			if changed {
+				_, _ = s.Reload(context.Background(), ConfigReloadTriggerWatch)
+			}
+		}
+	}
+}
+
+func (s *ConfigReloadService) fileHash() ([sha256.Size]byte, error) {
+	if s.path == "" {
+		return [sha256.Size]byte{}, ErrConfigFileNotFound
+	}
+	raw, err := os.ReadFile(s.path)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(raw), nil
+}
+
+// Reload 重新读取并校验配置文件，应用可热更新的配置项。
+// 校验失败时不发布新快照，返回的结果与错误同时记录到状态中。
+func (s *ConfigReloadService) Reload(ctx context.Context, trigger string) (*ConfigReloadResult, error) {
+	if s.path == "" {
+		return nil, ErrConfigFileNotFound
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &ConfigReloadResult{
+		Trigger:         trigger,
+		ReloadedAt:      time.Now(),
+		Applied:         []string{},
+		RestartRequired: []string{},
+	}
+	s.last = result
+	// 无论成功与否都记录本次内容，避免监听对同一份无效配置反复报错
+	if hash, err := s.fileHash(); err == nil {
+		s.lastHash = hash
+	}
+
+	current := s.Current()
+	next, err := s.loader(s.path, current)
+	if err != nil {
+		result.Error = err.Error()
+		log.Printf("[ConfigReload] Reload (%s) rejected: %v", trigger, err)
+		return result, infraerrors.Newf(http.StatusBadRequest, "CONFIG_INVALID", "invalid config: %v", err)
+	}
+
+	changed := config.Diff(current, next)
+	snapshot, applied := config.ApplyHotReload(current, next)
+	appliedSet := make(map[string]struct{}, len(applied))
+	for _, key := range applied {
+		appliedSet[key] = struct{}{}
+	}
+	for _, key := range changed {
+		if _, ok := appliedSet[key]; !ok {
+			result.RestartRequired = append(result.RestartRequired, key)
+		}
+	}
+	result.Applied = append(result.Applied, applied...)
+	result.Success = true
+
+	if len(applied) > 0 {
+		s.current.Store(snapshot)
+		for _, hook := range s.hooks {
+			hook(snapshot)
+		}
+	}
+	log.Printf("[ConfigReload] Reload (%s) applied %d change(s), %d change(s) require restart: applied=%v restart_required=%v",
+		trigger, len(applied), len(result.RestartRequired), applied, result.RestartRequired)
+	return result, nil
+}
+
+// Current 返回最新发布的配置快照，未热更新过时为启动配置
+func (s *ConfigReloadService) Current() *config.Config {
+	return s.current.Load()
+}
+
+// Status 返回配置热更新状态
+func (s *ConfigReloadService) Status(ctx context.Context) *ConfigReloadStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := &ConfigReloadStatus{Path: s.path, LastReload: s.last}
+	if s.cfg != nil {
+		status.WatchEnabled = s.path != "" && s.cfg.ConfigReload.WatchEnabled
+		status.WatchIntervalSeconds = s.cfg.ConfigReload.WatchIntervalSeconds
+	}
+	return status
+}
+
+// hotConfig 组件持有的热更新配置快照，嵌入到读取可热更新配置项的服务中，
+// 通过 ApplyConfig 注册为 ConfigReloadService 钩子；未发布过快照时回退到启动配置
+type hotConfig struct {
+	snapshot atomic.Pointer[config.Config]
+}
+
+// ApplyConfig 发布新的配置快照（快照发布后只读）
+func (h *hotConfig) ApplyConfig(cfg *config.Config) {
+	if cfg != nil {
+		h.snapshot.Store(cfg)
+	}
+}
+
+func (h *hotConfig) load(fallback *config.Config) *config.Config {
+	if cfg := h.snapshot.Load(); cfg != nil {
+		return cfg
+	}
+	return fallback
+}
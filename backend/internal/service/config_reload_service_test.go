@@ -0,0 +1,156 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newConfigReloadTestService(t *testing.T, content string) (*ConfigReloadService, *config.Config, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	cfg := &config.Config{}
+	cfg.Server.Port = 8080
+	cfg.Gateway.Scheduling.FallbackMaxWaiting = 10
+	cfg.Gateway.Scheduling.Strategy.Default = SchedulingStrategyDefault
+	cfg.ConfigReload.WatchEnabled = true
+	cfg.ConfigReload.WatchIntervalSeconds = 10
+
+	svc := NewConfigReloadService(cfg, path)
+	svc.loader = func(string, *config.Config) (*config.Config, error) {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		next := *cfg
+		switch string(raw) {
+		case "invalid":
+			return nil, os.ErrInvalid
+		case "v2":
+			next.Server.Port = 9090
+			next.Gateway.Scheduling.FallbackMaxWaiting = 20
+			next.Gateway.Scheduling.Strategy.Default = SchedulingStrategyFillFirst
+		}
+		return &next, nil
+	}
+	return svc, cfg, path
+}
+
+func TestConfigReloadServiceAppliesHotKeys(t *testing.T) {
+	svc, cfg, path := newConfigReloadTestService(t, "v1")
+	scheduler := NewAccountScheduler(cfg, nil)
+	svc.OnReload(scheduler.ApplyConfig)
+
+	require.NoError(t, os.WriteFile(path, []byte("v2"), 0o600))
+	result, err := svc.Reload(context.Background(), ConfigReloadTriggerManual)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Equal(t, []string{"gateway.scheduling.fallback_max_waiting", "gateway.scheduling.strategy.default"}, result.Applied)
+	require.Equal(t, []string{"server.port"}, result.RestartRequired)
+
+	require.Equal(t, 20, svc.Current().Gateway.Scheduling.FallbackMaxWaiting)
+	require.Equal(t, 8080, svc.Current().Server.Port)
+	require.Equal(t, 10, cfg.Gateway.Scheduling.FallbackMaxWaiting, "启动配置不被原地修改")
+	require.Equal(t, SchedulingStrategyFillFirst, scheduler.StrategyForGroup(nil).Name())
+
+	status := svc.Status(context.Background())
+	require.Equal(t, path, status.Path)
+	require.True(t, status.WatchEnabled)
+	require.Same(t, result, status.LastReload)
+}
+
+func TestConfigReloadServiceRejectsInvalidConfig(t *testing.T) {
+	svc, cfg, path := newConfigReloadTestService(t, "v1")
+	hookCalled := false
+	svc.OnReload(func(*config.Config) { hookCalled = true })
+
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	result, err := svc.Reload(context.Background(), ConfigReloadTriggerManual)
+	require.Error(t, err)
+	require.False(t, result.Success)
+	require.NotEmpty(t, result.Error)
+	require.False(t, hookCalled)
+	require.Same(t, cfg, svc.Current())
+
+	_, err = NewConfigReloadService(cfg, "").Reload(context.Background(), ConfigReloadTriggerManual)
+	require.ErrorIs(t, err, ErrConfigFileNotFound)
+}
+
+func TestConfigReloadServiceWatchesFile(t *testing.T) {
+	svc, _, path := newConfigReloadTestService(t, "v1")
+	reloaded := make(chan struct{}, 1)
+	svc.OnReload(func(*config.Config) { reloaded <- struct{}{} })
+
+	svc.wg.Add(1)
+	go svc.watchLoop(10 * time.Millisecond)
+	defer svc.Stop()
+
+	require.NoError(t, os.WriteFile(path, []byte("v2"), 0o600))
+	select {
+	case <-reloaded:
+	case <-time.After(2 * time.Second):
+		t.Fatal("config change was not picked up by the watcher")
+	}
+	status := svc.Status(context.Background())
+	require.Equal(t, ConfigReloadTriggerWatch, status.LastReload.Trigger)
+	require.Contains(t, status.LastReload.Applied, "gateway.scheduling.fallback_max_waiting")
+	require.Equal(t, 20, svc.Current().Gateway.Scheduling.FallbackMaxWaiting)
+}
+
+// TestConfigReloadServiceConcurrentReaders 热更新与请求路径上的无锁读取并发执行，需配合 -race 运行
+func TestConfigReloadServiceConcurrentReaders(t *testing.T) {
+	svc, cfg, path := newConfigReloadTestService(t, "v1")
+	gateway := &GatewayService{cfg: cfg}
+	openai := &OpenAIGatewayService{cfg: cfg}
+	antigravity := &AntigravityGatewayService{settingService: &SettingService{cfg: cfg}}
+	svc.OnReload(gateway.ApplyConfig)
+	svc.OnReload(openai.ApplyConfig)
+	svc.OnReload(antigravity.ApplyConfig)
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				assert.Contains(t, []int{10, 20}, gateway.schedulingConfig().FallbackMaxWaiting)
+				_ = openai.schedulingConfig().FallbackMaxWaiting
+				_ = antigravity.getConfig().Gateway.StreamDataIntervalTimeout
+				_ = svc.Current().Gateway.Scheduling.Strategy.Default
+			}
+		}()
+	}
+
+	for i := 0; i < 50; i++ {
+		content := "v1"
+		if i%2 == 0 {
+			content = "v2"
+		}
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		_, err := svc.Reload(context.Background(), ConfigReloadTriggerManual)
+		require.NoError(t, err)
+	}
+	close(stop)
+	wg.Wait()
+
+	require.Equal(t, 10, cfg.Gateway.Scheduling.FallbackMaxWaiting)
+	require.Same(t, svc.Current(), gateway.runtimeConfig())
+	require.Same(t, svc.Current(), antigravity.getConfig())
+}
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigSyncDocumentVersion 当前支持的声明式配置文件版本
+const ConfigSyncDocumentVersion = 1
+
+// 声明式配置变更的资源类型
+const (
+	ConfigSyncKindProxy                = "proxy"
+	ConfigSyncKindGroup                = "group"
+	ConfigSyncKindAccount              = "account"
+	ConfigSyncKindRoutingRule          = "routing_rule"
+	ConfigSyncKindErrorPassthroughRule = "error_passthrough_rule"
+	ConfigSyncKindModelPrice           = "model_price"
+	ConfigSyncKindSetting              = "setting"
+)
+
+// 声明式配置变更动作
+const (
+	ConfigSyncActionCreate = "create"
+	ConfigSyncActionUpdate = "update"
+	ConfigSyncActionDelete = "delete"
+)
+
+var (
+	ErrConfigSyncInvalidDocument = infraerrors.BadRequest("CONFIG_SYNC_INVALID_DOCUMENT", "invalid config sync document")
+	ErrConfigSyncPlanInvalid     = infraerrors.BadRequest("CONFIG_SYNC_PLAN_INVALID", "config sync document has validation errors")
+)
+
+// ConfigSyncDocument 声明式配置文件（期望状态）
+//
+// 同步语义：
+//   - 分组、账号、代理按名称匹配，只创建或更新文件中声明的对象，未声明的对象保持不变（不会被删除）
+//   - 对象上未填写的可选字段保持数据库中的当前值；账号 credentials / extra 按键合并，
+//     未声明的键（如 OAuth 刷新得到的 access_token）保持不变
+//   - 路由规则、错误透传规则、价格覆盖视为完整声明，开启 prune 时删除文件中未声明的同类对象
+//   - settings 只更新声明的键
+type ConfigSyncDocument struct {
+	Version               int                              `json:"version" yaml:"version"`
+	Proxies               []ConfigSyncProxy                `json:"proxies,omitempty" yaml:"proxies,omitempty"`
+	Groups                []ConfigSyncGroup                `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Accounts              []ConfigSyncAccount              `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	RoutingRules          []ConfigSyncRoutingRule          `json:"routing_rules,omitempty" yaml:"routing_rules,omitempty"`
+	ErrorPassthroughRules []ConfigSyncErrorPassthroughRule `json:"error_passthrough_rules,omitempty" yaml:"error_passthrough_rules,omitempty"`
+	Pricing               []ConfigSyncModelPrice           `json:"pricing,omitempty" yaml:"pricing,omitempty"`
+	Settings              map[string]any                   `json:"settings,omitempty" yaml:"settings,omitempty"`
+}
+
+// ConfigSyncProxy 代理声明（按 name 匹配）
+type ConfigSyncProxy struct {
+	Name     string  `json:"name" yaml:"name"`
+	Protocol string  `json:"protocol" yaml:"protocol"`
+	Host     string  `json:"host" yaml:"host"`
+	Port     int     `json:"port" yaml:"port"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Status   string  `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// ConfigSyncGroup 分组声明（按 name 匹配）
+type ConfigSyncGroup struct {
+	Name             string   `json:"name" yaml:"name"`
+	Platform         string   `json:"platform" yaml:"platform"`
+	Description      *string  `json:"description,omitempty" yaml:"description,omitempty"`
+	RateMultiplier   *float64 `json:"rate_multiplier,omitempty" yaml:"rate_multiplier,omitempty"`
+	IsExclusive      *bool    `json:"is_exclusive,omitempty" yaml:"is_exclusive,omitempty"`
+	Status           string   `json:"status,omitempty" yaml:"status,omitempty"`
+	SubscriptionType string   `json:"subscription_type,omitempty" yaml:"subscription_type,omitempty"`
+	// 限额（USD），0 表示不限
+	DailyLimitUSD   *float64 `json:"daily_limit_usd,omitempty" yaml:"daily_limit_usd,omitempty"`
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd,omitempty" yaml:"weekly_limit_usd,omitempty"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd,omitempty" yaml:"monthly_limit_usd,omitempty"`
+	ClaudeCodeOnly  *bool    `json:"claude_code_only,omitempty" yaml:"claude_code_only,omitempty"`
+	// FallbackGroup 降级分组名称，空字符串表示清除
+	FallbackGroup *string `json:"fallback_group,omitempty" yaml:"fallback_group,omitempty"`
+	// ModelRouting 模型匹配模式 -> 优先账号名称列表
+	ModelRouting         map[string][]string        `json:"model_routing,omitempty" yaml:"model_routing,omitempty"`
+	ModelRoutingEnabled  *bool                      `json:"model_routing_enabled,omitempty" yaml:"model_routing_enabled,omitempty"`
+	ModelFallbacks       []domain.ModelFallbackRule `json:"model_fallbacks,omitempty" yaml:"model_fallbacks,omitempty"`
+	ModelRateMultipliers map[string]float64         `json:"model_rate_multipliers,omitempty" yaml:"model_rate_multipliers,omitempty"`
+	SchedulingStrategy   *string                    `json:"scheduling_strategy,omitempty" yaml:"scheduling_strategy,omitempty"`
+	FirstByteTimeout     *int                       `json:"first_byte_timeout_seconds,omitempty" yaml:"first_byte_timeout_seconds,omitempty"`
+}
+
+// ConfigSyncAccount 账号声明（按 name 匹配，名称需唯一）
+type ConfigSyncAccount struct {
+	Name     string  `json:"name" yaml:"name"`
+	Platform string  `json:"platform" yaml:"platform"`
+	Type     string  `json:"type" yaml:"type"`
+	Notes    *string `json:"notes,omitempty" yaml:"notes,omitempty"`
+	// Credentials 凭证，值中可使用 ${ENV_NAME} 引用环境变量（由 CLI 在提交前解析）
+	Credentials map[string]any `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	Extra       map[string]any `json:"extra,omitempty" yaml:"extra,omitempty"`
+	// Proxy 代理名称，空字符串表示不使用代理
+	Proxy          *string  `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+	Concurrency    *int     `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+	Priority       *int     `json:"priority,omitempty" yaml:"priority,omitempty"`
+	RateMultiplier *float64 `json:"rate_multiplier,omitempty" yaml:"rate_multiplier,omitempty"`
+	// Groups 绑定的分组名称（按顺序决定优先级），nil 表示不管理分组绑定
+	Groups      []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Status      string   `json:"status,omitempty" yaml:"status,omitempty"`
+	Schedulable *bool    `json:"schedulable,omitempty" yaml:"schedulable,omitempty"`
+}
+
+// ConfigSyncRoutingRule 分组路由规则声明（按 name 匹配）
+type ConfigSyncRoutingRule struct {
+	Name             string   `json:"name" yaml:"name"`
+	Enabled          *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Priority         int      `json:"priority" yaml:"priority"`
+	Groups           []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	ModelPatterns    []string `json:"model_patterns,omitempty" yaml:"model_patterns,omitempty"`
+	MaxTokensBelow   *int     `json:"max_tokens_below,omitempty" yaml:"max_tokens_below,omitempty"`
+	RequireNoTools   bool     `json:"require_no_tools,omitempty" yaml:"require_no_tools,omitempty"`
+	InputTokensBelow *int     `json:"input_tokens_below,omitempty" yaml:"input_tokens_below,omitempty"`
+	TargetGroup      string   `json:"target_group,omitempty" yaml:"target_group,omitempty"`
+	TargetAccounts   []string `json:"target_accounts,omitempty" yaml:"target_accounts,omitempty"`
+	Description      *string  `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ConfigSyncErrorPassthroughRule 错误透传规则声明（按 name 匹配）
+type ConfigSyncErrorPassthroughRule struct {
+	Name            string   `json:"name" yaml:"name"`
+	Enabled         *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Priority        int      `json:"priority" yaml:"priority"`
+	ErrorCodes      []int    `json:"error_codes,omitempty" yaml:"error_codes,omitempty"`
+	Keywords        []string `json:"keywords,omitempty" yaml:"keywords,omitempty"`
+	MatchMode       string   `json:"match_mode,omitempty" yaml:"match_mode,omitempty"`
+	Platforms       []string `json:"platforms,omitempty" yaml:"platforms,omitempty"`
+	PassthroughCode bool     `json:"passthrough_code" yaml:"passthrough_code"`
+	ResponseCode    *int     `json:"response_code,omitempty" yaml:"response_code,omitempty"`
+	PassthroughBody bool     `json:"passthrough_body" yaml:"passthrough_body"`
+	CustomMessage   *string  `json:"custom_message,omitempty" yaml:"custom_message,omitempty"`
+	SkipMonitoring  bool     `json:"skip_monitoring,omitempty" yaml:"skip_monitoring,omitempty"`
+	Description     *string  `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ConfigSyncModelPrice 价格覆盖声明（按 model + platform + group + effective_from 匹配）
+type ConfigSyncModelPrice struct {
+	Model           string   `json:"model" yaml:"model"`
+	Platform        string   `json:"platform,omitempty" yaml:"platform,omitempty"`
+	Group           string   `json:"group,omitempty" yaml:"group,omitempty"`
+	InputPrice      float64  `json:"input_price" yaml:"input_price"`
+	OutputPrice     float64  `json:"output_price" yaml:"output_price"`
+	CacheWritePrice float64  `json:"cache_write_price" yaml:"cache_write_price"`
+	CacheReadPrice  float64  `json:"cache_read_price" yaml:"cache_read_price"`
+	ImagePrice      *float64 `json:"image_price,omitempty" yaml:"image_price,omitempty"`
+	// EffectiveFrom 生效时间，留空时匹配同范围内最新的一条（新建时取当前时间）
+	EffectiveFrom *time.Time `json:"effective_from,omitempty" yaml:"effective_from,omitempty"`
+	Enabled       *bool      `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Description   *string    `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ConfigSyncChange 单项变更；出于安全考虑只列出字段名，不包含字段值
+type ConfigSyncChange struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Action string   `json:"action"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+// ConfigSyncPlan 声明式配置与数据库当前状态的差异
+type ConfigSyncPlan struct {
+	Changes []ConfigSyncChange `json:"changes"`
+	Errors  []string           `json:"errors,omitempty"`
+	Created int                `json:"created"`
+	Updated int                `json:"updated"`
+	Deleted int                `json:"deleted"`
+	Applied bool               `json:"applied"`
+}
+
+// HasChanges 计划中是否存在待执行的变更
+func (p *ConfigSyncPlan) HasChanges() bool {
+	return p != nil && len(p.Changes) > 0
+}
+
+// ParseConfigSyncDocument 解析 YAML 或 JSON 格式的声明式配置（JSON 是 YAML 的子集）
+func ParseConfigSyncDocument(data []byte) (*ConfigSyncDocument, error) {
+	doc := &ConfigSyncDocument{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(doc); err != nil {
+		return nil, ErrConfigSyncInvalidDocument.WithCause(err)
+	}
+	if doc.Version == 0 {
+		doc.Version = ConfigSyncDocumentVersion
+	}
+	if doc.Version != ConfigSyncDocumentVersion {
+		return nil, infraerrors.BadRequest("CONFIG_SYNC_UNSUPPORTED_VERSION", fmt.Sprintf("unsupported config sync document version %d", doc.Version))
+	}
+	doc.Settings = normalizeYAMLValue(doc.Settings).(map[string]any)
+	for i := range doc.Accounts {
+		if doc.Accounts[i].Credentials != nil {
+			doc.Accounts[i].Credentials = normalizeYAMLValue(doc.Accounts[i].Credentials).(map[string]any)
+		}
+		if doc.Accounts[i].Extra != nil {
+			doc.Accounts[i].Extra = normalizeYAMLValue(doc.Accounts[i].Extra).(map[string]any)
+		}
+	}
+	return doc, nil
+}
+
+// normalizeYAMLValue 将 YAML 解码出的数值统一为 JSON 解码的形式（float64），保证与数据库中的 JSON 值可比较
+func normalizeYAMLValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		if val == nil {
+			return map[string]any(nil)
+		}
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = normalizeYAMLValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = normalizeYAMLValue(item)
+		}
+		return out
+	case int:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case uint64:
+		return float64(val)
+	default:
+		return v
+	}
+}
+
+var configSyncEnvRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ExpandEnv 解析敏感字段（账号 credentials、代理用户名/密码、settings 字符串值）中的 ${NAME} 环境变量引用。
+// lookup 为 nil 时使用 os.LookupEnv；存在未定义的变量时返回错误并列出变量名。
+func (d *ConfigSyncDocument) ExpandEnv(lookup func(string) (string, bool)) error {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	missing := map[string]struct{}{}
+	expand := func(s string) string {
+		return configSyncEnvRef.ReplaceAllStringFunc(s, func(ref string) string {
+			name := configSyncEnvRef.FindStringSubmatch(ref)[1]
+			value, ok := lookup(name)
+			if !ok {
+				missing[name] = struct{}{}
+				return ref
+			}
+			return value
+		})
+	}
+
+	for i := range d.Accounts {
+		d.Accounts[i].Credentials = expandEnvValue(d.Accounts[i].Credentials, expand).(map[string]any)
+	}
+	for i := range d.Proxies {
+		if d.Proxies[i].Username != nil {
+			v := expand(*d.Proxies[i].Username)
+			d.Proxies[i].Username = &v
+		}
+		if d.Proxies[i].Password != nil {
+			v := expand(*d.Proxies[i].Password)
+			d.Proxies[i].Password = &v
+		}
+	}
+	d.Settings = expandEnvValue(d.Settings, expand).(map[string]any)
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return infraerrors.BadRequest("CONFIG_SYNC_MISSING_ENV", "undefined environment variables: "+strings.Join(names, ", "))
+	}
+	return nil
+}
+
+func expandEnvValue(v any, expand func(string) string) any {
+	switch val := v.(type) {
+	case string:
+		return expand(val)
+	case map[string]any:
+		if val == nil {
+			return map[string]any(nil)
+		}
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = expandEnvValue(item, expand)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = expandEnvValue(item, expand)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// configSyncSettingKeys 允许通过声明式配置管理的系统设置（管理员 API Key 等凭证类设置除外）
+var configSyncSettingKeys = map[string]struct{}{
+	SettingKeyRegistrationEnabled:          {},
+	SettingKeyEmailVerifyEnabled:           {},
+	SettingKeyPromoCodeEnabled:             {},
+	SettingKeyPasswordResetEnabled:         {},
+	SettingKeyInvitationCodeEnabled:        {},
+	SettingKeySMTPHost:                     {},
+	SettingKeySMTPPort:                     {},
+	SettingKeySMTPUsername:                 {},
+	SettingKeySMTPPassword:                 {},
+	SettingKeySMTPFrom:                     {},
+	SettingKeySMTPFromName:                 {},
+	SettingKeySMTPUseTLS:                   {},
+	SettingKeyTurnstileEnabled:             {},
+	SettingKeyTurnstileSiteKey:             {},
+	SettingKeyTurnstileSecretKey:           {},
+	SettingKeyTotpEnabled:                  {},
+	SettingKeyLinuxDoConnectEnabled:        {},
+	SettingKeyLinuxDoConnectClientID:       {},
+	SettingKeyLinuxDoConnectClientSecret:   {},
+	SettingKeyLinuxDoConnectRedirectURL:    {},
+	SettingKeySiteName:                     {},
+	SettingKeySiteLogo:                     {},
+	SettingKeySiteSubtitle:                 {},
+	SettingKeyAPIBaseURL:                   {},
+	SettingKeyContactInfo:                  {},
+	SettingKeyDocURL:                       {},
+	SettingKeyHomeContent:                  {},
+	SettingKeyHideCcsImportButton:          {},
+	SettingKeyPurchaseSubscriptionEnabled:  {},
+	SettingKeyPurchaseSubscriptionURL:      {},
+	SettingKeyDefaultConcurrency:           {},
+	SettingKeyDefaultBalance:               {},
+	SettingKeyGeminiQuotaPolicy:            {},
+	SettingKeyEnableModelFallback:          {},
+	SettingKeyFallbackModelAnthropic:       {},
+	SettingKeyFallbackModelOpenAI:          {},
+	SettingKeyFallbackModelGemini:          {},
+	SettingKeyFallbackModelAntigravity:     {},
+	SettingKeyEnableIdentityPatch:          {},
+	SettingKeyIdentityPatchPrompt:          {},
+	SettingKeyOpsMonitoringEnabled:         {},
+	SettingKeyOpsRealtimeMonitoringEnabled: {},
+	SettingKeyOpsQueryModeDefault:          {},
+	SettingKeyOpsEmailNotificationConfig:   {},
+	SettingKeyOpsAlertRuntimeSettings:      {},
+	SettingKeyOpsMetricsIntervalSeconds:    {},
+	SettingKeyOpsAdvancedSettings:          {},
+	SettingKeyOpsMetricThresholds:          {},
+	SettingKeyStreamTimeoutSettings:        {},
+}
+
+// configSyncSettingValue 将声明的设置值转换为数据库存储的字符串形式（对象与数组编码为 JSON）
+func configSyncSettingValue(v any) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case map[string]any, []any:
+		encoded, err := json.Marshal(val)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	default:
+		return fmt.Sprint(val), nil
+	}
+}
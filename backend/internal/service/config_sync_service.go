@@ -0,0 +1,1830 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// configSyncPlaceholderIDBase 计划阶段为尚未创建的对象分配的占位 ID 起点（仅用于校验，不会写入数据库）
+const configSyncPlaceholderIDBase int64 = 1 << 40
+
+// configSyncStatusInactive 分组、账号、代理的停用状态（与管理后台接口一致）
+const configSyncStatusInactive = "inactive"
+
+// configSyncSecretSettingKeys 导出时不包含的凭证类设置
+var configSyncSecretSettingKeys = map[string]struct{}{
+	SettingKeySMTPPassword:               {},
+	SettingKeyTurnstileSecretKey:         {},
+	SettingKeyLinuxDoConnectClientSecret: {},
+}
+
+// ConfigSyncOptions 声明式配置同步选项
+type ConfigSyncOptions struct {
+	// Prune 删除文件中未声明的路由规则、错误透传规则与价格覆盖
+	Prune bool
+}
+
+// ConfigSyncService 声明式配置同步服务：对比期望状态与数据库当前状态，生成计划并在单个事务中执行
+type ConfigSyncService struct {
+	entClient            *dbent.Client
+	groupRepo            GroupRepository
+	accountRepo          AccountRepository
+	proxyRepo            ProxyRepository
+	settingRepo          SettingRepository
+	errorPassthroughRepo ErrorPassthroughRepository
+	routingRuleRepo      RoutingRuleRepository
+	modelPriceRepo       ModelPriceRepository
+
+	settingService          *SettingService
+	errorPassthroughService *ErrorPassthroughService
+	routingRuleService      *RoutingRuleService
+	modelPriceService       *ModelPriceService
+	authCacheInvalidator    APIKeyAuthCacheInvalidator
+
+	// applyMu 串行化 Apply，避免并发同步基于过期快照互相覆盖
+	applyMu sync.Mutex
+}
+
+// NewConfigSyncService 创建声明式配置同步服务
+func NewConfigSyncService(
+	entClient *dbent.Client,
+	groupRepo GroupRepository,
+	accountRepo AccountRepository,
+	proxyRepo ProxyRepository,
+	settingRepo SettingRepository,
+	errorPassthroughRepo ErrorPassthroughRepository,
+	routingRuleRepo RoutingRuleRepository,
+	modelPriceRepo ModelPriceRepository,
+	settingService *SettingService,
+	errorPassthroughService *ErrorPassthroughService,
+	routingRuleService *RoutingRuleService,
+	modelPriceService *ModelPriceService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+) *ConfigSyncService {
+	return &ConfigSyncService{
+		entClient:               entClient,
+		groupRepo:               groupRepo,
+		accountRepo:             accountRepo,
+		proxyRepo:               proxyRepo,
+		settingRepo:             settingRepo,
+		errorPassthroughRepo:    errorPassthroughRepo,
+		routingRuleRepo:         routingRuleRepo,
+		modelPriceRepo:          modelPriceRepo,
+		settingService:          settingService,
+		errorPassthroughService: errorPassthroughService,
+		routingRuleService:      routingRuleService,
+		modelPriceService:       modelPriceService,
+		authCacheInvalidator:    authCacheInvalidator,
+	}
+}
+
+// Plan 计算期望状态与数据库当前状态的差异，不修改任何数据
+func (s *ConfigSyncService) Plan(ctx context.Context, doc *ConfigSyncDocument, opts ConfigSyncOptions) (*ConfigSyncPlan, error) {
+	planner, err := s.newPlanner(ctx, doc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return planner.plan, nil
+}
+
+// Apply 计算差异并在单个事务中执行；计划存在校验错误时不做任何修改并返回 ErrConfigSyncPlanInvalid
+func (s *ConfigSyncService) Apply(ctx context.Context, doc *ConfigSyncDocument, opts ConfigSyncOptions) (*ConfigSyncPlan, error) {
+	s.applyMu.Lock()
+	defer s.applyMu.Unlock()
+
+	var planner *configSyncPlanner
+	state := newConfigSyncApplyState()
+	err := s.runInTx(ctx, func(txCtx context.Context) error {
+		var err error
+		// 在事务内读取快照，保证计划与执行基于同一份数据
+		planner, err = s.newPlanner(txCtx, doc, opts)
+		if err != nil {
+			return err
+		}
+		if len(planner.plan.Errors) > 0 {
+			return ErrConfigSyncPlanInvalid
+		}
+		state.seed(planner)
+		for _, op := range planner.ops {
+			if err := op(txCtx, state); err != nil {
+				return err
+			}
+		}
+		for _, op := range state.deferred {
+			if err := op(txCtx, state); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if planner != nil && errors.Is(err, ErrConfigSyncPlanInvalid) {
+			return planner.plan, err
+		}
+		return nil, err
+	}
+
+	s.refreshCaches(state)
+	planner.plan.Applied = true
+	return planner.plan, nil
+}
+
+// runInTx 在事务中执行 fn；未配置 entClient（单元测试）时直接执行
+func (s *ConfigSyncService) runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.entClient == nil {
+		return fn(ctx)
+	}
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(dbent.NewTxContext(ctx, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// refreshCaches 事务提交后刷新受影响的缓存（分组与账号变更已通过调度 outbox 通知）
+func (s *ConfigSyncService) refreshCaches(state *configSyncApplyState) {
+	if state.settingsChanged && s.settingService != nil && s.settingService.onUpdate != nil {
+		s.settingService.onUpdate()
+	}
+	if state.passthroughChanged && s.errorPassthroughService != nil {
+		refreshCtx, cancel := s.errorPassthroughService.newCacheRefreshContext()
+		s.errorPassthroughService.invalidateAndNotify(refreshCtx)
+		cancel()
+	}
+	if state.routingRulesChanged && s.routingRuleService != nil {
+		s.routingRuleService.rules.invalidateAndNotify()
+	}
+	if state.pricesChanged && s.modelPriceService != nil {
+		s.modelPriceService.prices.invalidateAndNotify()
+	}
+	if s.authCacheInvalidator != nil && len(state.updatedGroups) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for _, groupID := range state.updatedGroups {
+			s.authCacheInvalidator.InvalidateAuthCacheByGroupID(ctx, groupID)
+		}
+	}
+}
+
+// Export 导出当前配置为声明式文档（不包含账号凭证、代理密码与凭证类设置）
+func (s *ConfigSyncService) Export(ctx context.Context) (*ConfigSyncDocument, error) {
+	snap, err := s.loadSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx := newConfigSyncIndex(snap)
+	doc := &ConfigSyncDocument{Version: ConfigSyncDocumentVersion}
+
+	for i := range snap.proxies {
+		p := &snap.proxies[i]
+		item := ConfigSyncProxy{Name: p.Name, Protocol: p.Protocol, Host: p.Host, Port: p.Port, Status: p.Status}
+		if p.Username != "" {
+			item.Username = configSyncPtr(p.Username)
+		}
+		doc.Proxies = append(doc.Proxies, item)
+	}
+
+	for i := range snap.groups {
+		g := &snap.groups[i]
+		item := ConfigSyncGroup{
+			Name:                 g.Name,
+			Platform:             g.Platform,
+			Description:          configSyncPtr(g.Description),
+			RateMultiplier:       configSyncPtr(g.RateMultiplier),
+			IsExclusive:          configSyncPtr(g.IsExclusive),
+			Status:               g.Status,
+			SubscriptionType:     g.SubscriptionType,
+			DailyLimitUSD:        configSyncLimitValue(g.DailyLimitUSD),
+			WeeklyLimitUSD:       configSyncLimitValue(g.WeeklyLimitUSD),
+			MonthlyLimitUSD:      configSyncLimitValue(g.MonthlyLimitUSD),
+			ClaudeCodeOnly:       configSyncPtr(g.ClaudeCodeOnly),
+			FallbackGroup:        configSyncPtr(idx.groupRefName(g.FallbackGroupID)),
+			ModelRoutingEnabled:  configSyncPtr(g.ModelRoutingEnabled),
+			ModelFallbacks:       g.ModelFallbacks,
+			ModelRateMultipliers: g.ModelRateMultipliers,
+			SchedulingStrategy:   configSyncPtr(g.SchedulingStrategy),
+			FirstByteTimeout:     configSyncPtr(g.FirstByteTimeoutSeconds),
+		}
+		if len(g.ModelRouting) > 0 {
+			item.ModelRouting = make(map[string][]string, len(g.ModelRouting))
+			for pattern, ids := range g.ModelRouting {
+				item.ModelRouting[pattern] = idx.accountNames(ids)
+			}
+		}
+		doc.Groups = append(doc.Groups, item)
+	}
+
+	for i := range snap.accounts {
+		a := &snap.accounts[i]
+		rate := 1.0
+		if a.RateMultiplier != nil {
+			rate = *a.RateMultiplier
+		}
+		item := ConfigSyncAccount{
+			Name:           a.Name,
+			Platform:       a.Platform,
+			Type:           a.Type,
+			Notes:          a.Notes,
+			Extra:          a.Extra,
+			Proxy:          configSyncPtr(idx.proxyRefName(a.ProxyID)),
+			Concurrency:    configSyncPtr(a.Concurrency),
+			Priority:       configSyncPtr(a.Priority),
+			RateMultiplier: configSyncPtr(rate),
+			Groups:         idx.groupNames(a.GroupIDs),
+			Schedulable:    configSyncPtr(a.Schedulable),
+		}
+		if configSyncValidStatus(a.Status) {
+			item.Status = a.Status
+		}
+		doc.Accounts = append(doc.Accounts, item)
+	}
+
+	for _, r := range snap.routingRules {
+		doc.RoutingRules = append(doc.RoutingRules, ConfigSyncRoutingRule{
+			Name:             r.Name,
+			Enabled:          configSyncPtr(r.Enabled),
+			Priority:         r.Priority,
+			Groups:           idx.groupNames(r.GroupIDs),
+			ModelPatterns:    r.ModelPatterns,
+			MaxTokensBelow:   r.MaxTokensBelow,
+			RequireNoTools:   r.RequireNoTools,
+			InputTokensBelow: r.InputTokensBelow,
+			TargetGroup:      idx.groupRefName(r.TargetGroupID),
+			TargetAccounts:   idx.accountNames(r.TargetAccountIDs),
+			Description:      r.Description,
+		})
+	}
+
+	for _, r := range snap.passthroughRules {
+		doc.ErrorPassthroughRules = append(doc.ErrorPassthroughRules, ConfigSyncErrorPassthroughRule{
+			Name:            r.Name,
+			Enabled:         configSyncPtr(r.Enabled),
+			Priority:        r.Priority,
+			ErrorCodes:      r.ErrorCodes,
+			Keywords:        r.Keywords,
+			MatchMode:       r.MatchMode,
+			Platforms:       r.Platforms,
+			PassthroughCode: r.PassthroughCode,
+			ResponseCode:    r.ResponseCode,
+			PassthroughBody: r.PassthroughBody,
+			CustomMessage:   r.CustomMessage,
+			SkipMonitoring:  r.SkipMonitoring,
+			Description:     r.Description,
+		})
+	}
+
+	for _, p := range snap.prices {
+		effectiveFrom := p.EffectiveFrom.UTC()
+		doc.Pricing = append(doc.Pricing, ConfigSyncModelPrice{
+			Model:           p.Model,
+			Platform:        p.Platform,
+			Group:           idx.groupRefName(p.GroupID),
+			InputPrice:      p.InputPrice,
+			OutputPrice:     p.OutputPrice,
+			CacheWritePrice: p.CacheWritePrice,
+			CacheReadPrice:  p.CacheReadPrice,
+			ImagePrice:      p.ImagePrice,
+			EffectiveFrom:   &effectiveFrom,
+			Enabled:         configSyncPtr(p.Enabled),
+			Description:     p.Description,
+		})
+	}
+
+	for key, value := range snap.settings {
+		if _, secret := configSyncSecretSettingKeys[key]; secret {
+			continue
+		}
+		if doc.Settings == nil {
+			doc.Settings = map[string]any{}
+		}
+		doc.Settings[key] = value
+	}
+
+	return doc, nil
+}
+
+// ---------------------------------------------------------------------------
+// 快照与名称索引
+// ---------------------------------------------------------------------------
+
+// configSyncSnapshot 数据库当前状态
+type configSyncSnapshot struct {
+	proxies          []Proxy
+	groups           []Group
+	accounts         []Account
+	routingRules     []*model.RoutingRule
+	passthroughRules []*model.ErrorPassthroughRule
+	prices           []*model.ModelPrice
+	settings         map[string]string
+}
+
+func (s *ConfigSyncService) loadSnapshot(ctx context.Context) (*configSyncSnapshot, error) {
+	snap := &configSyncSnapshot{}
+	var err error
+
+	if snap.proxies, err = configSyncListAll(func(params pagination.PaginationParams) ([]Proxy, *pagination.PaginationResult, error) {
+		return s.proxyRepo.ListWithFilters(ctx, params, "", "", "")
+	}); err != nil {
+		return nil, fmt.Errorf("list proxies: %w", err)
+	}
+	if snap.groups, err = configSyncListAll(func(params pagination.PaginationParams) ([]Group, *pagination.PaginationResult, error) {
+		return s.groupRepo.ListWithFilters(ctx, params, "", "", "", nil)
+	}); err != nil {
+		return nil, fmt.Errorf("list groups: %w", err)
+	}
+	if snap.accounts, err = configSyncListAll(func(params pagination.PaginationParams) ([]Account, *pagination.PaginationResult, error) {
+		return s.accountRepo.ListWithFilters(ctx, params, "", "", "", "")
+	}); err != nil {
+		return nil, fmt.Errorf("list accounts: %w", err)
+	}
+	if snap.routingRules, err = s.routingRuleRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("list routing rules: %w", err)
+	}
+	if snap.passthroughRules, err = s.errorPassthroughRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("list error passthrough rules: %w", err)
+	}
+	if snap.prices, err = s.modelPriceRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("list model prices: %w", err)
+	}
+
+	keys := make([]string, 0, len(configSyncSettingKeys))
+	for key := range configSyncSettingKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if snap.settings, err = s.settingRepo.GetMultiple(ctx, keys); err != nil {
+		return nil, fmt.Errorf("get settings: %w", err)
+	}
+	return snap, nil
+}
+
+// configSyncListAll 逐页读取全部记录
+func configSyncListAll[T any](list func(params pagination.PaginationParams) ([]T, *pagination.PaginationResult, error)) ([]T, error) {
+	params := pagination.PaginationParams{Page: 1, PageSize: 100}
+	var out []T
+	for {
+		batch, page, err := list(params)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, batch...)
+		if page == nil || params.Page >= page.Pages || len(batch) == 0 {
+			return out, nil
+		}
+		params.Page++
+	}
+}
+
+// configSyncIndex 名称与 ID 的双向索引；账号与代理名称允许重复，重复的名称不能被引用
+type configSyncIndex struct {
+	groupByName   map[string]*Group
+	accountByName map[string]*Account
+	proxyByName   map[string]*Proxy
+
+	duplicateAccounts map[string]struct{}
+	duplicateProxies  map[string]struct{}
+
+	groupNameByID   map[int64]string
+	accountNameByID map[int64]string
+	proxyNameByID   map[int64]string
+}
+
+func newConfigSyncIndex(snap *configSyncSnapshot) *configSyncIndex {
+	idx := &configSyncIndex{
+		groupByName:       map[string]*Group{},
+		accountByName:     map[string]*Account{},
+		proxyByName:       map[string]*Proxy{},
+		duplicateAccounts: map[string]struct{}{},
+		duplicateProxies:  map[string]struct{}{},
+		groupNameByID:     map[int64]string{},
+		accountNameByID:   map[int64]string{},
+		proxyNameByID:     map[int64]string{},
+	}
+	for i := range snap.groups {
+		g := &snap.groups[i]
+		idx.groupByName[g.Name] = g
+		idx.groupNameByID[g.ID] = g.Name
+	}
+	for i := range snap.accounts {
+		a := &snap.accounts[i]
+		if _, ok := idx.accountByName[a.Name]; ok {
+			idx.duplicateAccounts[a.Name] = struct{}{}
+		}
+		idx.accountByName[a.Name] = a
+		idx.accountNameByID[a.ID] = a.Name
+	}
+	for i := range snap.proxies {
+		p := &snap.proxies[i]
+		if _, ok := idx.proxyByName[p.Name]; ok {
+			idx.duplicateProxies[p.Name] = struct{}{}
+		}
+		idx.proxyByName[p.Name] = p
+		idx.proxyNameByID[p.ID] = p.Name
+	}
+	return idx
+}
+
+// groupRefName 分组 ID 对应的名称，nil 返回空字符串
+func (idx *configSyncIndex) groupRefName(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return configSyncNameOrID(idx.groupNameByID, *id)
+}
+
+func (idx *configSyncIndex) proxyRefName(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return configSyncNameOrID(idx.proxyNameByID, *id)
+}
+
+func (idx *configSyncIndex) groupNames(ids []int64) []string {
+	return configSyncNames(idx.groupNameByID, ids)
+}
+
+func (idx *configSyncIndex) accountNames(ids []int64) []string {
+	return configSyncNames(idx.accountNameByID, ids)
+}
+
+func configSyncNames(names map[int64]string, ids []int64) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, configSyncNameOrID(names, id))
+	}
+	return out
+}
+
+// configSyncNameOrID 已不存在的对象以 #ID 表示，保证差异对比可见
+func configSyncNameOrID(names map[int64]string, id int64) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("#%d", id)
+}
+
+// ---------------------------------------------------------------------------
+// 执行状态
+// ---------------------------------------------------------------------------
+
+// configSyncOp 计划中的单个写操作
+type configSyncOp func(ctx context.Context, st *configSyncApplyState) error
+
+// configSyncApplyState 执行过程中的名称解析与缓存刷新标记
+type configSyncApplyState struct {
+	groupIDs   map[string]int64
+	accountIDs map[string]int64
+	proxyIDs   map[string]int64
+
+	// deferred 引用了后续才创建的对象的分组字段（降级分组、模型路由），在其余写操作完成后回填
+	deferred []configSyncOp
+
+	updatedGroups       []int64
+	settingsChanged     bool
+	passthroughChanged  bool
+	routingRulesChanged bool
+	pricesChanged       bool
+}
+
+func newConfigSyncApplyState() *configSyncApplyState {
+	return &configSyncApplyState{
+		groupIDs:   map[string]int64{},
+		accountIDs: map[string]int64{},
+		proxyIDs:   map[string]int64{},
+	}
+}
+
+// seed 以数据库中已有且名称唯一的对象初始化名称解析表
+func (st *configSyncApplyState) seed(p *configSyncPlanner) {
+	for name, g := range p.idx.groupByName {
+		st.groupIDs[name] = g.ID
+	}
+	for name, a := range p.idx.accountByName {
+		if _, dup := p.idx.duplicateAccounts[name]; !dup {
+			st.accountIDs[name] = a.ID
+		}
+	}
+	for name, proxy := range p.idx.proxyByName {
+		if _, dup := p.idx.duplicateProxies[name]; !dup {
+			st.proxyIDs[name] = proxy.ID
+		}
+	}
+}
+
+// resolveIDs 按名称解析 ID 列表，存在未解析的名称时返回 false
+func resolveConfigSyncIDs(ids map[string]int64, names []string) ([]int64, bool) {
+	if len(names) == 0 {
+		return nil, true
+	}
+	out := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, ok := ids[name]
+		if !ok {
+			return nil, false
+		}
+		out = append(out, id)
+	}
+	return out, true
+}
+
+// ---------------------------------------------------------------------------
+// 计划
+// ---------------------------------------------------------------------------
+
+// configSyncPlanner 计算差异并生成写操作
+type configSyncPlanner struct {
+	doc  *ConfigSyncDocument
+	opts ConfigSyncOptions
+	snap *configSyncSnapshot
+	idx  *configSyncIndex
+	svc  *ConfigSyncService
+
+	plan *ConfigSyncPlan
+	ops  []configSyncOp
+
+	// 文件中声明的对象名称
+	declaredGroups   map[string]*ConfigSyncGroup
+	declaredAccounts map[string]struct{}
+	declaredProxies  map[string]struct{}
+
+	nextPlaceholder int64
+	placeholders    map[string]int64
+}
+
+func (s *ConfigSyncService) newPlanner(ctx context.Context, doc *ConfigSyncDocument, opts ConfigSyncOptions) (*configSyncPlanner, error) {
+	if doc == nil {
+		return nil, ErrConfigSyncInvalidDocument
+	}
+	snap, err := s.loadSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p := &configSyncPlanner{
+		doc:              doc,
+		opts:             opts,
+		snap:             snap,
+		idx:              newConfigSyncIndex(snap),
+		svc:              s,
+		plan:             &ConfigSyncPlan{Changes: []ConfigSyncChange{}},
+		declaredGroups:   map[string]*ConfigSyncGroup{},
+		declaredAccounts: map[string]struct{}{},
+		declaredProxies:  map[string]struct{}{},
+		nextPlaceholder:  configSyncPlaceholderIDBase,
+		placeholders:     map[string]int64{},
+	}
+	p.collectDeclared()
+	p.planProxies()
+	p.planGroups()
+	p.planAccounts()
+	p.planRoutingRules()
+	p.planErrorPassthroughRules()
+	p.planPricing()
+	p.planSettings()
+	return p, nil
+}
+
+func (p *configSyncPlanner) errorf(kind, name, format string, args ...any) {
+	p.plan.Errors = append(p.plan.Errors, fmt.Sprintf("%s %q: %s", kind, name, fmt.Sprintf(format, args...)))
+}
+
+// configSyncErrorText 业务错误只保留可读信息，避免计划输出中出现 code/metadata 等内部细节
+func configSyncErrorText(err error) string {
+	var appErr *infraerrors.ApplicationError
+	if errors.As(err, &appErr) && appErr.Message != "" {
+		return appErr.Message
+	}
+	return err.Error()
+}
+
+func (p *configSyncPlanner) addChange(kind, name, action string, fields []string, op configSyncOp) {
+	p.plan.Changes = append(p.plan.Changes, ConfigSyncChange{Kind: kind, Name: name, Action: action, Fields: fields})
+	switch action {
+	case ConfigSyncActionCreate:
+		p.plan.Created++
+	case ConfigSyncActionUpdate:
+		p.plan.Updated++
+	case ConfigSyncActionDelete:
+		p.plan.Deleted++
+	}
+	p.ops = append(p.ops, func(ctx context.Context, st *configSyncApplyState) error {
+		if err := op(ctx, st); err != nil {
+			return fmt.Errorf("%s %s %q: %w", action, kind, name, err)
+		}
+		return nil
+	})
+}
+
+// collectDeclared 记录文件中声明的名称，并检查重复声明
+func (p *configSyncPlanner) collectDeclared() {
+	for i := range p.doc.Proxies {
+		name := strings.TrimSpace(p.doc.Proxies[i].Name)
+		if _, dup := p.declaredProxies[name]; dup && name != "" {
+			p.errorf(ConfigSyncKindProxy, name, "declared more than once")
+		}
+		p.declaredProxies[name] = struct{}{}
+	}
+	for i := range p.doc.Groups {
+		name := strings.TrimSpace(p.doc.Groups[i].Name)
+		if _, dup := p.declaredGroups[name]; dup && name != "" {
+			p.errorf(ConfigSyncKindGroup, name, "declared more than once")
+		}
+		p.declaredGroups[name] = &p.doc.Groups[i]
+	}
+	for i := range p.doc.Accounts {
+		name := strings.TrimSpace(p.doc.Accounts[i].Name)
+		if _, dup := p.declaredAccounts[name]; dup && name != "" {
+			p.errorf(ConfigSyncKindAccount, name, "declared more than once")
+		}
+		p.declaredAccounts[name] = struct{}{}
+	}
+}
+
+// placeholderID 为待创建对象分配占位 ID，用于计划阶段的规则校验
+func (p *configSyncPlanner) placeholderID(kind, name string) int64 {
+	key := kind + "\x00" + name
+	if id, ok := p.placeholders[key]; ok {
+		return id
+	}
+	p.nextPlaceholder++
+	p.placeholders[key] = p.nextPlaceholder
+	return p.nextPlaceholder
+}
+
+// groupRef 校验分组引用，返回已有 ID 或占位 ID
+func (p *configSyncPlanner) groupRef(name string) (int64, error) {
+	if g, ok := p.idx.groupByName[name]; ok {
+		return g.ID, nil
+	}
+	if _, ok := p.declaredGroups[name]; ok {
+		return p.placeholderID(ConfigSyncKindGroup, name), nil
+	}
+	return 0, fmt.Errorf("group %q not found", name)
+}
+
+func (p *configSyncPlanner) accountRef(name string) (int64, error) {
+	if _, dup := p.idx.duplicateAccounts[name]; dup {
+		return 0, fmt.Errorf("account name %q is ambiguous (multiple accounts share it)", name)
+	}
+	if a, ok := p.idx.accountByName[name]; ok {
+		return a.ID, nil
+	}
+	if _, ok := p.declaredAccounts[name]; ok {
+		return p.placeholderID(ConfigSyncKindAccount, name), nil
+	}
+	return 0, fmt.Errorf("account %q not found", name)
+}
+
+func (p *configSyncPlanner) proxyRef(name string) (int64, error) {
+	if _, dup := p.idx.duplicateProxies[name]; dup {
+		return 0, fmt.Errorf("proxy name %q is ambiguous (multiple proxies share it)", name)
+	}
+	if proxy, ok := p.idx.proxyByName[name]; ok {
+		return proxy.ID, nil
+	}
+	if _, ok := p.declaredProxies[name]; ok {
+		return p.placeholderID(ConfigSyncKindProxy, name), nil
+	}
+	return 0, fmt.Errorf("proxy %q not found", name)
+}
+
+func (p *configSyncPlanner) groupRefs(names []string) ([]int64, error) {
+	out := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, err := p.groupRef(name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+func (p *configSyncPlanner) accountRefs(names []string) ([]int64, error) {
+	out := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, err := p.accountRef(name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// configSyncDiff 收集有变化的字段名，并把声明值写入目标对象
+type configSyncDiff struct {
+	fields []string
+}
+
+func (d *configSyncDiff) mark(field string, changed bool) bool {
+	if changed {
+		d.fields = append(d.fields, field)
+	}
+	return changed
+}
+
+// text 空字符串表示不管理该字段
+func (d *configSyncDiff) text(field string, dst *string, v string) {
+	if v != "" && d.mark(field, *dst != v) {
+		*dst = v
+	}
+}
+
+func (d *configSyncDiff) str(field string, dst *string, v *string) {
+	if v != nil && d.mark(field, *dst != *v) {
+		*dst = *v
+	}
+}
+
+func (d *configSyncDiff) boolean(field string, dst *bool, v *bool) {
+	if v != nil && d.mark(field, *dst != *v) {
+		*dst = *v
+	}
+}
+
+func (d *configSyncDiff) integer(field string, dst *int, v *int) {
+	if v != nil && d.mark(field, *dst != *v) {
+		*dst = *v
+	}
+}
+
+func (d *configSyncDiff) float(field string, dst *float64, v *float64) {
+	if v != nil && d.mark(field, *dst != *v) {
+		*dst = *v
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 代理
+// ---------------------------------------------------------------------------
+
+var configSyncProxyProtocols = map[string]struct{}{"http": {}, "https": {}, "socks5": {}, "socks5h": {}}
+
+func (p *configSyncPlanner) planProxies() {
+	for i := range p.doc.Proxies {
+		decl := p.doc.Proxies[i]
+		name := strings.TrimSpace(decl.Name)
+		if name == "" {
+			p.errorf(ConfigSyncKindProxy, name, "name is required")
+			continue
+		}
+		if _, dup := p.idx.duplicateProxies[name]; dup {
+			p.errorf(ConfigSyncKindProxy, name, "multiple existing proxies share this name")
+			continue
+		}
+		if _, ok := configSyncProxyProtocols[decl.Protocol]; !ok {
+			p.errorf(ConfigSyncKindProxy, name, "protocol must be one of http, https, socks5, socks5h")
+			continue
+		}
+		if strings.TrimSpace(decl.Host) == "" || decl.Port < 1 || decl.Port > 65535 {
+			p.errorf(ConfigSyncKindProxy, name, "host and a port between 1 and 65535 are required")
+			continue
+		}
+		if !configSyncValidStatus(decl.Status) {
+			p.errorf(ConfigSyncKindProxy, name, "status must be active or inactive")
+			continue
+		}
+
+		existing := p.idx.proxyByName[name]
+		target := Proxy{Name: name, Status: StatusActive}
+		if existing != nil {
+			target = *existing
+		}
+		diff := &configSyncDiff{}
+		diff.text("protocol", &target.Protocol, decl.Protocol)
+		diff.text("host", &target.Host, strings.TrimSpace(decl.Host))
+		diff.integer("port", &target.Port, &decl.Port)
+		diff.str("username", &target.Username, decl.Username)
+		diff.str("password", &target.Password, decl.Password)
+		diff.text("status", &target.Status, decl.Status)
+
+		if existing == nil {
+			p.addChange(ConfigSyncKindProxy, name, ConfigSyncActionCreate, nil, func(ctx context.Context, st *configSyncApplyState) error {
+				proxy := target
+				if err := p.svc.proxyRepo.Create(ctx, &proxy); err != nil {
+					return err
+				}
+				st.proxyIDs[name] = proxy.ID
+				return nil
+			})
+			continue
+		}
+		if len(diff.fields) == 0 {
+			continue
+		}
+		p.addChange(ConfigSyncKindProxy, name, ConfigSyncActionUpdate, diff.fields, func(ctx context.Context, st *configSyncApplyState) error {
+			proxy := target
+			return p.svc.proxyRepo.Update(ctx, &proxy)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 分组
+// ---------------------------------------------------------------------------
+
+// configSyncGroupRefs 分组上引用其他对象的字段（nil 表示不管理）
+type configSyncGroupRefs struct {
+	fallback     *string
+	modelRouting map[string][]string
+}
+
+// resolve 按名称解析引用；存在尚未创建的对象时返回 false，且不修改 g
+func (r configSyncGroupRefs) resolve(st *configSyncApplyState, g *Group) bool {
+	var fallbackID *int64
+	if r.fallback != nil && *r.fallback != "" {
+		id, ok := st.groupIDs[*r.fallback]
+		if !ok {
+			return false
+		}
+		fallbackID = &id
+	}
+	var routing map[string][]int64
+	if r.modelRouting != nil {
+		routing = make(map[string][]int64, len(r.modelRouting))
+		for pattern, names := range r.modelRouting {
+			ids, ok := resolveConfigSyncIDs(st.accountIDs, names)
+			if !ok {
+				return false
+			}
+			routing[pattern] = ids
+		}
+	}
+	if r.fallback != nil {
+		g.FallbackGroupID = fallbackID
+	}
+	if r.modelRouting != nil {
+		g.ModelRouting = routing
+	}
+	return true
+}
+
+func (p *configSyncPlanner) planGroups() {
+	for i := range p.doc.Groups {
+		decl := p.doc.Groups[i]
+		name := strings.TrimSpace(decl.Name)
+		if name == "" {
+			p.errorf(ConfigSyncKindGroup, name, "name is required")
+			continue
+		}
+		existing := p.idx.groupByName[name]
+		target := Group{
+			Name:             name,
+			Platform:         PlatformAnthropic,
+			RateMultiplier:   1,
+			Status:           StatusActive,
+			SubscriptionType: SubscriptionTypeStandard,
+			MCPXMLInject:     true,
+		}
+		if existing != nil {
+			target = *existing
+		}
+
+		diff, refs, err := p.diffGroup(&target, decl)
+		if err != nil {
+			p.errorf(ConfigSyncKindGroup, name, "%s", configSyncErrorText(err))
+			continue
+		}
+
+		if existing == nil {
+			p.addChange(ConfigSyncKindGroup, name, ConfigSyncActionCreate, nil, func(ctx context.Context, st *configSyncApplyState) error {
+				group := target
+				resolved := refs.resolve(st, &group)
+				if !resolved {
+					group.FallbackGroupID, group.ModelRouting = nil, nil
+				}
+				if err := p.svc.groupRepo.Create(ctx, &group); err != nil {
+					return err
+				}
+				st.groupIDs[name] = group.ID
+				if !resolved {
+					st.deferred = append(st.deferred, p.deferGroupRefs(name, group, refs))
+				}
+				return nil
+			})
+			continue
+		}
+		if len(diff.fields) == 0 {
+			continue
+		}
+		p.addChange(ConfigSyncKindGroup, name, ConfigSyncActionUpdate, diff.fields, func(ctx context.Context, st *configSyncApplyState) error {
+			group := target
+			resolved := refs.resolve(st, &group)
+			if !resolved {
+				group.FallbackGroupID, group.ModelRouting = existing.FallbackGroupID, existing.ModelRouting
+			}
+			if err := p.svc.groupRepo.Update(ctx, &group); err != nil {
+				return err
+			}
+			st.updatedGroups = append(st.updatedGroups, group.ID)
+			if !resolved {
+				st.deferred = append(st.deferred, p.deferGroupRefs(name, group, refs))
+			}
+			return nil
+		})
+	}
+}
+
+// deferGroupRefs 在所有对象创建完成后回填分组引用
+func (p *configSyncPlanner) deferGroupRefs(name string, group Group, refs configSyncGroupRefs) configSyncOp {
+	return func(ctx context.Context, st *configSyncApplyState) error {
+		if !refs.resolve(st, &group) {
+			return fmt.Errorf("group %q: unresolved fallback group or model routing account", name)
+		}
+		if err := p.svc.groupRepo.Update(ctx, &group); err != nil {
+			return fmt.Errorf("group %q: %w", name, err)
+		}
+		return nil
+	}
+}
+
+// diffGroup 将声明合并到 target，返回变更字段与引用字段
+func (p *configSyncPlanner) diffGroup(target *Group, decl ConfigSyncGroup) (*configSyncDiff, configSyncGroupRefs, error) {
+	diff := &configSyncDiff{}
+	refs := configSyncGroupRefs{}
+
+	if decl.Platform != "" && !configSyncValidPlatform(decl.Platform) {
+		return nil, refs, fmt.Errorf("unsupported platform %q", decl.Platform)
+	}
+	if !configSyncValidStatus(decl.Status) {
+		return nil, refs, fmt.Errorf("status must be active or inactive")
+	}
+	if decl.SubscriptionType != "" && decl.SubscriptionType != SubscriptionTypeStandard && decl.SubscriptionType != SubscriptionTypeSubscription {
+		return nil, refs, fmt.Errorf("subscription_type must be standard or subscription")
+	}
+	if decl.RateMultiplier != nil && *decl.RateMultiplier < 0 {
+		return nil, refs, fmt.Errorf("rate_multiplier must not be negative")
+	}
+
+	diff.text("platform", &target.Platform, decl.Platform)
+	diff.str("description", &target.Description, decl.Description)
+	diff.float("rate_multiplier", &target.RateMultiplier, decl.RateMultiplier)
+	diff.boolean("is_exclusive", &target.IsExclusive, decl.IsExclusive)
+	diff.text("status", &target.Status, decl.Status)
+	diff.text("subscription_type", &target.SubscriptionType, decl.SubscriptionType)
+	configSyncDiffLimit(diff, "daily_limit_usd", &target.DailyLimitUSD, decl.DailyLimitUSD)
+	configSyncDiffLimit(diff, "weekly_limit_usd", &target.WeeklyLimitUSD, decl.WeeklyLimitUSD)
+	configSyncDiffLimit(diff, "monthly_limit_usd", &target.MonthlyLimitUSD, decl.MonthlyLimitUSD)
+	diff.boolean("claude_code_only", &target.ClaudeCodeOnly, decl.ClaudeCodeOnly)
+	diff.boolean("model_routing_enabled", &target.ModelRoutingEnabled, decl.ModelRoutingEnabled)
+
+	if decl.ModelFallbacks != nil {
+		rules, err := domain.NormalizeModelFallbackRules(decl.ModelFallbacks)
+		if err != nil {
+			return nil, refs, err
+		}
+		if diff.mark("model_fallbacks", !configSyncEmptyOrEqual(target.ModelFallbacks, rules)) {
+			target.ModelFallbacks = rules
+		}
+	}
+	if decl.ModelRateMultipliers != nil {
+		multipliers, err := domain.NormalizeModelRateMultipliers(decl.ModelRateMultipliers)
+		if err != nil {
+			return nil, refs, err
+		}
+		if diff.mark("model_rate_multipliers", !configSyncEmptyOrEqual(target.ModelRateMultipliers, multipliers)) {
+			target.ModelRateMultipliers = multipliers
+		}
+	}
+	if decl.SchedulingStrategy != nil {
+		strategy, err := domain.NormalizeSchedulingStrategy(*decl.SchedulingStrategy)
+		if err != nil {
+			return nil, refs, err
+		}
+		diff.str("scheduling_strategy", &target.SchedulingStrategy, &strategy)
+	}
+	if decl.FirstByteTimeout != nil {
+		if err := validateFirstByteTimeout(*decl.FirstByteTimeout); err != nil {
+			return nil, refs, err
+		}
+		diff.integer("first_byte_timeout_seconds", &target.FirstByteTimeoutSeconds, decl.FirstByteTimeout)
+	}
+
+	if decl.FallbackGroup != nil {
+		fallback := strings.TrimSpace(*decl.FallbackGroup)
+		if fallback != "" {
+			if err := p.validateFallbackGroup(target.Name, fallback); err != nil {
+				return nil, refs, err
+			}
+		}
+		refs.fallback = &fallback
+		diff.mark("fallback_group", p.idx.groupRefName(target.FallbackGroupID) != fallback)
+	}
+	if decl.ModelRouting != nil {
+		current := make(map[string][]string, len(target.ModelRouting))
+		for pattern, ids := range target.ModelRouting {
+			current[pattern] = p.idx.accountNames(ids)
+		}
+		for pattern, names := range decl.ModelRouting {
+			if _, err := p.accountRefs(names); err != nil {
+				return nil, refs, fmt.Errorf("model_routing %q: %w", pattern, err)
+			}
+		}
+		refs.modelRouting = decl.ModelRouting
+		diff.mark("model_routing", !configSyncEmptyOrEqual(current, decl.ModelRouting))
+	}
+	return diff, refs, nil
+}
+
+// validateFallbackGroup 与管理后台一致：不能指向自身、不能成环、降级分组不能启用 claude_code_only
+func (p *configSyncPlanner) validateFallbackGroup(name, fallback string) error {
+	if name == fallback {
+		return fmt.Errorf("cannot set self as fallback group")
+	}
+	if _, err := p.groupRef(fallback); err != nil {
+		return fmt.Errorf("fallback group: %w", err)
+	}
+	if p.effectiveClaudeCodeOnly(fallback) {
+		return fmt.Errorf("fallback group cannot have claude_code_only enabled")
+	}
+
+	visited := map[string]struct{}{name: {}}
+	next := fallback
+	for next != "" {
+		if _, seen := visited[next]; seen {
+			return fmt.Errorf("fallback group cycle detected")
+		}
+		visited[next] = struct{}{}
+		next = p.effectiveFallback(next)
+	}
+	return nil
+}
+
+// effectiveFallback 同步后分组的降级分组名称（声明优先，其次数据库当前值）
+func (p *configSyncPlanner) effectiveFallback(name string) string {
+	if decl, ok := p.declaredGroups[name]; ok && decl.FallbackGroup != nil {
+		return strings.TrimSpace(*decl.FallbackGroup)
+	}
+	if g, ok := p.idx.groupByName[name]; ok {
+		return p.idx.groupRefName(g.FallbackGroupID)
+	}
+	return ""
+}
+
+func (p *configSyncPlanner) effectiveClaudeCodeOnly(name string) bool {
+	if decl, ok := p.declaredGroups[name]; ok && decl.ClaudeCodeOnly != nil {
+		return *decl.ClaudeCodeOnly
+	}
+	if g, ok := p.idx.groupByName[name]; ok {
+		return g.ClaudeCodeOnly
+	}
+	return false
+}
+
+// configSyncDiffLimit 限额字段：0 与 nil 都表示不限
+func configSyncDiffLimit(diff *configSyncDiff, field string, dst **float64, v *float64) {
+	if v == nil {
+		return
+	}
+	want := normalizeLimit(v)
+	current := *dst
+	changed := (current == nil) != (want == nil) || (current != nil && *current != *want)
+	if diff.mark(field, changed) {
+		*dst = want
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 账号
+// ---------------------------------------------------------------------------
+
+var configSyncAccountTypes = map[string]struct{}{
+	AccountTypeOAuth: {}, AccountTypeSetupToken: {}, AccountTypeAPIKey: {}, AccountTypeUpstream: {},
+}
+
+func (p *configSyncPlanner) planAccounts() {
+	for i := range p.doc.Accounts {
+		decl := p.doc.Accounts[i]
+		name := strings.TrimSpace(decl.Name)
+		if name == "" {
+			p.errorf(ConfigSyncKindAccount, name, "name is required")
+			continue
+		}
+		if _, dup := p.idx.duplicateAccounts[name]; dup {
+			p.errorf(ConfigSyncKindAccount, name, "multiple existing accounts share this name")
+			continue
+		}
+		existing := p.idx.accountByName[name]
+		if err := p.validateAccount(decl, existing); err != nil {
+			p.errorf(ConfigSyncKindAccount, name, "%s", configSyncErrorText(err))
+			continue
+		}
+
+		target := Account{
+			Name:               name,
+			Platform:           decl.Platform,
+			Status:             StatusActive,
+			Schedulable:        true,
+			AutoPauseOnExpired: true,
+		}
+		if existing != nil {
+			target = *existing
+		}
+
+		diff := &configSyncDiff{}
+		diff.text("type", &target.Type, decl.Type)
+		if decl.Notes != nil {
+			notes := normalizeAccountNotes(decl.Notes)
+			if diff.mark("notes", !reflect.DeepEqual(target.Notes, notes)) {
+				target.Notes = notes
+			}
+		}
+		if merged, changed := configSyncMergeMap(target.Credentials, decl.Credentials); diff.mark("credentials", changed) {
+			target.Credentials = merged
+		}
+		if merged, changed := configSyncMergeMap(target.Extra, decl.Extra); diff.mark("extra", changed) {
+			target.Extra = merged
+		}
+		diff.integer("concurrency", &target.Concurrency, decl.Concurrency)
+		diff.integer("priority", &target.Priority, decl.Priority)
+		if decl.RateMultiplier != nil {
+			current := 1.0
+			if target.RateMultiplier != nil {
+				current = *target.RateMultiplier
+			}
+			if diff.mark("rate_multiplier", current != *decl.RateMultiplier) {
+				target.RateMultiplier = configSyncPtr(*decl.RateMultiplier)
+			}
+		}
+		diff.text("status", &target.Status, decl.Status)
+		diff.boolean("schedulable", &target.Schedulable, decl.Schedulable)
+
+		var proxyName *string
+		if decl.Proxy != nil {
+			name := strings.TrimSpace(*decl.Proxy)
+			proxyName = &name
+			diff.mark("proxy", p.idx.proxyRefName(target.ProxyID) != name)
+		}
+		groupsChanged := decl.Groups != nil && diff.mark("groups", !configSyncEmptyOrEqual(p.idx.groupNames(target.GroupIDs), decl.Groups))
+		groups := decl.Groups
+
+		resolveProxy := func(st *configSyncApplyState, account *Account) error {
+			if proxyName == nil {
+				return nil
+			}
+			if *proxyName == "" {
+				account.ProxyID = nil
+				return nil
+			}
+			id, ok := st.proxyIDs[*proxyName]
+			if !ok {
+				return fmt.Errorf("proxy %q not found", *proxyName)
+			}
+			account.ProxyID = &id
+			return nil
+		}
+		bindGroups := func(ctx context.Context, st *configSyncApplyState, accountID int64) error {
+			ids, ok := resolveConfigSyncIDs(st.groupIDs, groups)
+			if !ok {
+				return fmt.Errorf("unresolved group in %v", groups)
+			}
+			return p.svc.accountRepo.BindGroups(ctx, accountID, ids)
+		}
+
+		if existing == nil {
+			p.addChange(ConfigSyncKindAccount, name, ConfigSyncActionCreate, nil, func(ctx context.Context, st *configSyncApplyState) error {
+				account := target
+				account.GroupIDs = nil
+				if err := resolveProxy(st, &account); err != nil {
+					return err
+				}
+				if err := p.svc.accountRepo.Create(ctx, &account); err != nil {
+					return err
+				}
+				st.accountIDs[name] = account.ID
+				if len(groups) > 0 {
+					return bindGroups(ctx, st, account.ID)
+				}
+				return nil
+			})
+			continue
+		}
+		if len(diff.fields) == 0 {
+			continue
+		}
+		fieldsOnlyGroups := groupsChanged && len(diff.fields) == 1
+		p.addChange(ConfigSyncKindAccount, name, ConfigSyncActionUpdate, diff.fields, func(ctx context.Context, st *configSyncApplyState) error {
+			account := target
+			if !fieldsOnlyGroups {
+				if err := resolveProxy(st, &account); err != nil {
+					return err
+				}
+				if err := p.svc.accountRepo.Update(ctx, &account); err != nil {
+					return err
+				}
+			}
+			if groupsChanged {
+				return bindGroups(ctx, st, account.ID)
+			}
+			return nil
+		})
+	}
+}
+
+func (p *configSyncPlanner) validateAccount(decl ConfigSyncAccount, existing *Account) error {
+	if existing == nil {
+		if decl.Platform == "" || decl.Type == "" {
+			return fmt.Errorf("platform and type are required for new accounts")
+		}
+		if len(decl.Credentials) == 0 {
+			return fmt.Errorf("credentials are required for new accounts")
+		}
+	} else if decl.Platform != "" && decl.Platform != existing.Platform {
+		return fmt.Errorf("platform cannot be changed (current %s)", existing.Platform)
+	}
+	if decl.Platform != "" && !configSyncValidPlatform(decl.Platform) {
+		return fmt.Errorf("unsupported platform %q", decl.Platform)
+	}
+	if _, ok := configSyncAccountTypes[decl.Type]; decl.Type != "" && !ok {
+		return fmt.Errorf("type must be one of oauth, setup-token, apikey, upstream")
+	}
+	if !configSyncValidStatus(decl.Status) {
+		return fmt.Errorf("status must be active or inactive")
+	}
+	if decl.Concurrency != nil && *decl.Concurrency < 0 {
+		return fmt.Errorf("concurrency must not be negative")
+	}
+	if decl.RateMultiplier != nil && *decl.RateMultiplier < 0 {
+		return fmt.Errorf("rate_multiplier must not be negative")
+	}
+	if decl.Proxy != nil && strings.TrimSpace(*decl.Proxy) != "" {
+		if _, err := p.proxyRef(strings.TrimSpace(*decl.Proxy)); err != nil {
+			return err
+		}
+	}
+	if _, err := p.groupRefs(decl.Groups); err != nil {
+		return err
+	}
+	return nil
+}
+
+// configSyncMergeMap 按键合并声明值，未声明的键保持不变
+func configSyncMergeMap(current, declared map[string]any) (map[string]any, bool) {
+	if len(declared) == 0 {
+		return current, false
+	}
+	merged := make(map[string]any, len(current)+len(declared))
+	for k, v := range current {
+		merged[k] = v
+	}
+	changed := false
+	for k, v := range declared {
+		if old, ok := current[k]; !ok || !configSyncJSONEqual(old, v) {
+			changed = true
+		}
+		merged[k] = v
+	}
+	return merged, changed
+}
+
+// ---------------------------------------------------------------------------
+// 路由规则
+// ---------------------------------------------------------------------------
+
+func (p *configSyncPlanner) planRoutingRules() {
+	existingByName := make(map[string]*model.RoutingRule, len(p.snap.routingRules))
+	for _, r := range p.snap.routingRules {
+		existingByName[r.Name] = r
+	}
+	declared := make(map[string]struct{}, len(p.doc.RoutingRules))
+
+	for i := range p.doc.RoutingRules {
+		decl := p.doc.RoutingRules[i]
+		name := strings.TrimSpace(decl.Name)
+		if _, dup := declared[name]; dup && name != "" {
+			p.errorf(ConfigSyncKindRoutingRule, name, "declared more than once")
+			continue
+		}
+		declared[name] = struct{}{}
+
+		enabled := true
+		if decl.Enabled != nil {
+			enabled = *decl.Enabled
+		}
+		desired := model.RoutingRule{
+			Name:             name,
+			Enabled:          enabled,
+			Priority:         decl.Priority,
+			ModelPatterns:    decl.ModelPatterns,
+			MaxTokensBelow:   decl.MaxTokensBelow,
+			RequireNoTools:   decl.RequireNoTools,
+			InputTokensBelow: decl.InputTokensBelow,
+			Description:      decl.Description,
+		}
+
+		// 以占位 ID 校验规则，执行时再按名称解析真实 ID
+		groupIDs, err := p.groupRefs(decl.Groups)
+		if err != nil {
+			p.errorf(ConfigSyncKindRoutingRule, name, "%s", configSyncErrorText(err))
+			continue
+		}
+		accountIDs, err := p.accountRefs(decl.TargetAccounts)
+		if err != nil {
+			p.errorf(ConfigSyncKindRoutingRule, name, "%s", configSyncErrorText(err))
+			continue
+		}
+		check := desired
+		check.GroupIDs, check.TargetAccountIDs = groupIDs, accountIDs
+		if decl.TargetGroup != "" {
+			id, err := p.groupRef(decl.TargetGroup)
+			if err != nil {
+				p.errorf(ConfigSyncKindRoutingRule, name, "%s", configSyncErrorText(err))
+				continue
+			}
+			check.TargetGroupID = &id
+		}
+		if err := check.Validate(); err != nil {
+			p.errorf(ConfigSyncKindRoutingRule, name, "%s", configSyncErrorText(err))
+			continue
+		}
+
+		build := func(st *configSyncApplyState, rule *model.RoutingRule) error {
+			var ok bool
+			if rule.GroupIDs, ok = resolveConfigSyncIDs(st.groupIDs, decl.Groups); !ok {
+				return fmt.Errorf("unresolved group in %v", decl.Groups)
+			}
+			if rule.TargetAccountIDs, ok = resolveConfigSyncIDs(st.accountIDs, decl.TargetAccounts); !ok {
+				return fmt.Errorf("unresolved account in %v", decl.TargetAccounts)
+			}
+			rule.TargetGroupID = nil
+			if decl.TargetGroup != "" {
+				id, ok := st.groupIDs[decl.TargetGroup]
+				if !ok {
+					return fmt.Errorf("group %q not found", decl.TargetGroup)
+				}
+				rule.TargetGroupID = &id
+			}
+			return nil
+		}
+
+		existing := existingByName[name]
+		if existing == nil {
+			p.addChange(ConfigSyncKindRoutingRule, name, ConfigSyncActionCreate, nil, func(ctx context.Context, st *configSyncApplyState) error {
+				rule := desired
+				if err := build(st, &rule); err != nil {
+					return err
+				}
+				st.routingRulesChanged = true
+				_, err := p.svc.routingRuleRepo.Create(ctx, &rule)
+				return err
+			})
+			continue
+		}
+
+		diff := &configSyncDiff{}
+		diff.mark("enabled", existing.Enabled != desired.Enabled)
+		diff.mark("priority", existing.Priority != desired.Priority)
+		diff.mark("groups", !configSyncEmptyOrEqual(p.idx.groupNames(existing.GroupIDs), decl.Groups))
+		diff.mark("model_patterns", !configSyncEmptyOrEqual(existing.ModelPatterns, desired.ModelPatterns))
+		diff.mark("max_tokens_below", !reflect.DeepEqual(existing.MaxTokensBelow, desired.MaxTokensBelow))
+		diff.mark("require_no_tools", existing.RequireNoTools != desired.RequireNoTools)
+		diff.mark("input_tokens_below", !reflect.DeepEqual(existing.InputTokensBelow, desired.InputTokensBelow))
+		diff.mark("target_group", p.idx.groupRefName(existing.TargetGroupID) != decl.TargetGroup)
+		diff.mark("target_accounts", !configSyncEmptyOrEqual(p.idx.accountNames(existing.TargetAccountIDs), decl.TargetAccounts))
+		diff.mark("description", !configSyncEqualStringPtr(existing.Description, desired.Description))
+		if len(diff.fields) == 0 {
+			continue
+		}
+		id := existing.ID
+		p.addChange(ConfigSyncKindRoutingRule, name, ConfigSyncActionUpdate, diff.fields, func(ctx context.Context, st *configSyncApplyState) error {
+			rule := desired
+			rule.ID = id
+			if err := build(st, &rule); err != nil {
+				return err
+			}
+			st.routingRulesChanged = true
+			_, err := p.svc.routingRuleRepo.Update(ctx, &rule)
+			return err
+		})
+	}
+
+	if !p.opts.Prune {
+		return
+	}
+	for _, r := range p.snap.routingRules {
+		if _, ok := declared[r.Name]; ok {
+			continue
+		}
+		id := r.ID
+		p.addChange(ConfigSyncKindRoutingRule, r.Name, ConfigSyncActionDelete, nil, func(ctx context.Context, st *configSyncApplyState) error {
+			st.routingRulesChanged = true
+			return p.svc.routingRuleRepo.Delete(ctx, id)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 错误透传规则
+// ---------------------------------------------------------------------------
+
+func (p *configSyncPlanner) planErrorPassthroughRules() {
+	existingByName := make(map[string]*model.ErrorPassthroughRule, len(p.snap.passthroughRules))
+	for _, r := range p.snap.passthroughRules {
+		existingByName[r.Name] = r
+	}
+	declared := make(map[string]struct{}, len(p.doc.ErrorPassthroughRules))
+
+	for i := range p.doc.ErrorPassthroughRules {
+		decl := p.doc.ErrorPassthroughRules[i]
+		name := strings.TrimSpace(decl.Name)
+		if _, dup := declared[name]; dup && name != "" {
+			p.errorf(ConfigSyncKindErrorPassthroughRule, name, "declared more than once")
+			continue
+		}
+		declared[name] = struct{}{}
+
+		enabled := true
+		if decl.Enabled != nil {
+			enabled = *decl.Enabled
+		}
+		matchMode := decl.MatchMode
+		if matchMode == "" {
+			matchMode = model.MatchModeAny
+		}
+		desired := model.ErrorPassthroughRule{
+			Name:            name,
+			Enabled:         enabled,
+			Priority:        decl.Priority,
+			ErrorCodes:      decl.ErrorCodes,
+			Keywords:        decl.Keywords,
+			MatchMode:       matchMode,
+			Platforms:       decl.Platforms,
+			PassthroughCode: decl.PassthroughCode,
+			ResponseCode:    decl.ResponseCode,
+			PassthroughBody: decl.PassthroughBody,
+			CustomMessage:   decl.CustomMessage,
+			SkipMonitoring:  decl.SkipMonitoring,
+			Description:     decl.Description,
+		}
+		if err := desired.Validate(); err != nil {
+			p.errorf(ConfigSyncKindErrorPassthroughRule, name, "%s", configSyncErrorText(err))
+			continue
+		}
+
+		existing := existingByName[name]
+		if existing == nil {
+			p.addChange(ConfigSyncKindErrorPassthroughRule, name, ConfigSyncActionCreate, nil, func(ctx context.Context, st *configSyncApplyState) error {
+				rule := desired
+				st.passthroughChanged = true
+				_, err := p.svc.errorPassthroughRepo.Create(ctx, &rule)
+				return err
+			})
+			continue
+		}
+
+		diff := &configSyncDiff{}
+		diff.mark("enabled", existing.Enabled != desired.Enabled)
+		diff.mark("priority", existing.Priority != desired.Priority)
+		diff.mark("error_codes", !configSyncEmptyOrEqual(existing.ErrorCodes, desired.ErrorCodes))
+		diff.mark("keywords", !configSyncEmptyOrEqual(existing.Keywords, desired.Keywords))
+		diff.mark("match_mode", existing.MatchMode != desired.MatchMode)
+		diff.mark("platforms", !configSyncEmptyOrEqual(existing.Platforms, desired.Platforms))
+		diff.mark("passthrough_code", existing.PassthroughCode != desired.PassthroughCode)
+		diff.mark("response_code", !reflect.DeepEqual(existing.ResponseCode, desired.ResponseCode))
+		diff.mark("passthrough_body", existing.PassthroughBody != desired.PassthroughBody)
+		diff.mark("custom_message", !configSyncEqualStringPtr(existing.CustomMessage, desired.CustomMessage))
+		diff.mark("skip_monitoring", existing.SkipMonitoring != desired.SkipMonitoring)
+		diff.mark("description", !configSyncEqualStringPtr(existing.Description, desired.Description))
+		if len(diff.fields) == 0 {
+			continue
+		}
+		id := existing.ID
+		p.addChange(ConfigSyncKindErrorPassthroughRule, name, ConfigSyncActionUpdate, diff.fields, func(ctx context.Context, st *configSyncApplyState) error {
+			rule := desired
+			rule.ID = id
+			st.passthroughChanged = true
+			_, err := p.svc.errorPassthroughRepo.Update(ctx, &rule)
+			return err
+		})
+	}
+
+	if !p.opts.Prune {
+		return
+	}
+	for _, r := range p.snap.passthroughRules {
+		if _, ok := declared[r.Name]; ok {
+			continue
+		}
+		id := r.ID
+		p.addChange(ConfigSyncKindErrorPassthroughRule, r.Name, ConfigSyncActionDelete, nil, func(ctx context.Context, st *configSyncApplyState) error {
+			st.passthroughChanged = true
+			return p.svc.errorPassthroughRepo.Delete(ctx, id)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 价格覆盖
+// ---------------------------------------------------------------------------
+
+// configSyncPriceName 价格覆盖在计划中的显示名称：model[platform][@group]@effective_from
+func configSyncPriceName(modelName, platform, group string, effectiveFrom *time.Time) string {
+	var b strings.Builder
+	b.WriteString(modelName)
+	if platform != "" {
+		b.WriteString(" platform=" + platform)
+	}
+	if group != "" {
+		b.WriteString(" group=" + group)
+	}
+	if effectiveFrom != nil {
+		b.WriteString(" from=" + effectiveFrom.UTC().Format(time.RFC3339))
+	}
+	return b.String()
+}
+
+func (p *configSyncPlanner) planPricing() {
+	matched := make(map[int64]struct{}, len(p.doc.Pricing))
+	declared := make(map[string]struct{}, len(p.doc.Pricing))
+
+	for i := range p.doc.Pricing {
+		decl := p.doc.Pricing[i]
+		modelName := strings.TrimSpace(decl.Model)
+		group := strings.TrimSpace(decl.Group)
+		name := configSyncPriceName(modelName, decl.Platform, group, decl.EffectiveFrom)
+		if _, dup := declared[name]; dup {
+			p.errorf(ConfigSyncKindModelPrice, name, "declared more than once")
+			continue
+		}
+		declared[name] = struct{}{}
+
+		enabled := true
+		if decl.Enabled != nil {
+			enabled = *decl.Enabled
+		}
+		desired := model.ModelPrice{
+			Model:           modelName,
+			Platform:        decl.Platform,
+			InputPrice:      decl.InputPrice,
+			OutputPrice:     decl.OutputPrice,
+			CacheWritePrice: decl.CacheWritePrice,
+			CacheReadPrice:  decl.CacheReadPrice,
+			ImagePrice:      decl.ImagePrice,
+			Enabled:         enabled,
+			Description:     decl.Description,
+		}
+		check := desired
+		check.EffectiveFrom = time.Now()
+		if decl.EffectiveFrom != nil {
+			check.EffectiveFrom = *decl.EffectiveFrom
+		}
+		if group != "" {
+			id, err := p.groupRef(group)
+			if err != nil {
+				p.errorf(ConfigSyncKindModelPrice, name, "%s", configSyncErrorText(err))
+				continue
+			}
+			check.GroupID = &id
+		}
+		if err := check.Validate(); err != nil {
+			p.errorf(ConfigSyncKindModelPrice, name, "%s", configSyncErrorText(err))
+			continue
+		}
+
+		build := func(st *configSyncApplyState, price *model.ModelPrice) error {
+			price.GroupID = nil
+			if group != "" {
+				id, ok := st.groupIDs[group]
+				if !ok {
+					return fmt.Errorf("group %q not found", group)
+				}
+				price.GroupID = &id
+			}
+			return nil
+		}
+
+		existing := p.findPrice(modelName, decl.Platform, group, decl.EffectiveFrom)
+		if existing == nil {
+			p.addChange(ConfigSyncKindModelPrice, name, ConfigSyncActionCreate, nil, func(ctx context.Context, st *configSyncApplyState) error {
+				price := desired
+				price.EffectiveFrom = time.Now()
+				if decl.EffectiveFrom != nil {
+					price.EffectiveFrom = *decl.EffectiveFrom
+				}
+				if err := build(st, &price); err != nil {
+					return err
+				}
+				st.pricesChanged = true
+				_, err := p.svc.modelPriceRepo.Create(ctx, &price)
+				return err
+			})
+			continue
+		}
+		matched[existing.ID] = struct{}{}
+
+		diff := &configSyncDiff{}
+		diff.mark("input_price", existing.InputPrice != desired.InputPrice)
+		diff.mark("output_price", existing.OutputPrice != desired.OutputPrice)
+		diff.mark("cache_write_price", existing.CacheWritePrice != desired.CacheWritePrice)
+		diff.mark("cache_read_price", existing.CacheReadPrice != desired.CacheReadPrice)
+		diff.mark("image_price", !reflect.DeepEqual(existing.ImagePrice, desired.ImagePrice))
+		diff.mark("enabled", existing.Enabled != desired.Enabled)
+		diff.mark("description", !configSyncEqualStringPtr(existing.Description, desired.Description))
+		if len(diff.fields) == 0 {
+			continue
+		}
+		id, effectiveFrom := existing.ID, existing.EffectiveFrom
+		p.addChange(ConfigSyncKindModelPrice, name, ConfigSyncActionUpdate, diff.fields, func(ctx context.Context, st *configSyncApplyState) error {
+			price := desired
+			price.ID, price.EffectiveFrom = id, effectiveFrom
+			if err := build(st, &price); err != nil {
+				return err
+			}
+			st.pricesChanged = true
+			_, err := p.svc.modelPriceRepo.Update(ctx, &price)
+			return err
+		})
+	}
+
+	if !p.opts.Prune {
+		return
+	}
+	for _, price := range p.snap.prices {
+		if _, ok := matched[price.ID]; ok {
+			continue
+		}
+		id, effectiveFrom := price.ID, price.EffectiveFrom
+		name := configSyncPriceName(price.Model, price.Platform, p.idx.groupRefName(price.GroupID), &effectiveFrom)
+		p.addChange(ConfigSyncKindModelPrice, name, ConfigSyncActionDelete, nil, func(ctx context.Context, st *configSyncApplyState) error {
+			st.pricesChanged = true
+			return p.svc.modelPriceRepo.Delete(ctx, id)
+		})
+	}
+}
+
+// findPrice 查找同一范围（模型、平台、分组）的价格；未指定生效时间时取最新的一条
+func (p *configSyncPlanner) findPrice(modelName, platform, group string, effectiveFrom *time.Time) *model.ModelPrice {
+	var found *model.ModelPrice
+	for _, price := range p.snap.prices {
+		if price.Model != modelName || price.Platform != platform || p.idx.groupRefName(price.GroupID) != group {
+			continue
+		}
+		if effectiveFrom != nil {
+			if price.EffectiveFrom.Equal(*effectiveFrom) {
+				return price
+			}
+			continue
+		}
+		if found == nil || price.EffectiveFrom.After(found.EffectiveFrom) {
+			found = price
+		}
+	}
+	return found
+}
+
+// ---------------------------------------------------------------------------
+// 系统设置
+// ---------------------------------------------------------------------------
+
+func (p *configSyncPlanner) planSettings() {
+	keys := make([]string, 0, len(p.doc.Settings))
+	for key := range p.doc.Settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	updates := map[string]string{}
+	for _, key := range keys {
+		if _, ok := configSyncSettingKeys[key]; !ok {
+			p.errorf(ConfigSyncKindSetting, key, "setting is not managed by config sync")
+			continue
+		}
+		value, err := configSyncSettingValue(p.doc.Settings[key])
+		if err != nil {
+			p.errorf(ConfigSyncKindSetting, key, "%s", configSyncErrorText(err))
+			continue
+		}
+		current, exists := p.snap.settings[key]
+		if exists && configSyncSettingEqual(current, value) {
+			continue
+		}
+		updates[key] = value
+		action := ConfigSyncActionUpdate
+		if !exists {
+			action = ConfigSyncActionCreate
+		}
+		p.plan.Changes = append(p.plan.Changes, ConfigSyncChange{Kind: ConfigSyncKindSetting, Name: key, Action: action})
+		if action == ConfigSyncActionCreate {
+			p.plan.Created++
+		} else {
+			p.plan.Updated++
+		}
+	}
+	if len(updates) == 0 {
+		return
+	}
+	p.ops = append(p.ops, func(ctx context.Context, st *configSyncApplyState) error {
+		st.settingsChanged = true
+		if err := p.svc.settingRepo.SetMultiple(ctx, updates); err != nil {
+			return fmt.Errorf("update settings: %w", err)
+		}
+		return nil
+	})
+}
+
+// configSyncSettingEqual JSON 设置按语义比较，避免键顺序或空白差异产生无意义的变更
+func configSyncSettingEqual(current, want string) bool {
+	if current == want {
+		return true
+	}
+	var a, b any
+	if json.Unmarshal([]byte(current), &a) != nil || json.Unmarshal([]byte(want), &b) != nil {
+		return false
+	}
+	switch a.(type) {
+	case map[string]any, []any:
+		return reflect.DeepEqual(a, b)
+	}
+	return false
+}
+
+// ---------------------------------------------------------------------------
+// 工具函数
+// ---------------------------------------------------------------------------
+
+func configSyncPtr[T any](v T) *T {
+	return &v
+}
+
+// configSyncLimitValue 导出限额，nil（不限）导出为 0
+func configSyncLimitValue(v *float64) *float64 {
+	if v == nil {
+		return configSyncPtr(0.0)
+	}
+	return configSyncPtr(*v)
+}
+
+// configSyncValidStatus 空字符串表示不管理状态
+func configSyncValidStatus(status string) bool {
+	return status == "" || status == StatusActive || status == configSyncStatusInactive
+}
+
+func configSyncValidPlatform(platform string) bool {
+	for _, p := range model.AllPlatforms() {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
+
+func configSyncEqualStringPtr(a, b *string) bool {
+	av, bv := "", ""
+	if a != nil {
+		av = *a
+	}
+	if b != nil {
+		bv = *b
+	}
+	return av == bv
+}
+
+// configSyncEmptyOrEqual 比较切片或映射，nil 与空值视为相等
+func configSyncEmptyOrEqual(a, b any) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// configSyncJSONEqual 按 JSON 语义比较（数据库中的数值解码为 float64，声明中的数值已统一为 float64）
+func configSyncJSONEqual(a, b any) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	var na, nb any
+	if json.Unmarshal(ja, &na) != nil || json.Unmarshal(jb, &nb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(na, nb)
+}
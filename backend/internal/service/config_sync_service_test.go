@@ -0,0 +1,456 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type configSyncGroupRepoStub struct {
+	GroupRepository
+	groups []*Group
+}
+
+func (r *configSyncGroupRepoStub) Create(_ context.Context, group *Group) error {
+	group.ID = int64(len(r.groups) + 1)
+	cloned := *group
+	r.groups = append(r.groups, &cloned)
+	return nil
+}
+
+func (r *configSyncGroupRepoStub) Update(_ context.Context, group *Group) error {
+	for i, g := range r.groups {
+		if g.ID == group.ID {
+			cloned := *group
+			r.groups[i] = &cloned
+		}
+	}
+	return nil
+}
+
+func (r *configSyncGroupRepoStub) ListWithFilters(_ context.Context, _ pagination.PaginationParams, _, _, _ string, _ *bool) ([]Group, *pagination.PaginationResult, error) {
+	out := make([]Group, 0, len(r.groups))
+	for _, g := range r.groups {
+		out = append(out, *g)
+	}
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, Pages: 1}, nil
+}
+
+func (r *configSyncGroupRepoStub) byName(name string) *Group {
+	for _, g := range r.groups {
+		if g.Name == name {
+			return g
+		}
+	}
+	return nil
+}
+
+type configSyncAccountRepoStub struct {
+	AccountRepository
+	accounts []*Account
+}
+
+func (r *configSyncAccountRepoStub) Create(_ context.Context, account *Account) error {
+	account.ID = int64(len(r.accounts) + 1)
+	cloned := *account
+	r.accounts = append(r.accounts, &cloned)
+	return nil
+}
+
+func (r *configSyncAccountRepoStub) Update(_ context.Context, account *Account) error {
+	for i, a := range r.accounts {
+		if a.ID == account.ID {
+			cloned := *account
+			cloned.GroupIDs = a.GroupIDs
+			r.accounts[i] = &cloned
+		}
+	}
+	return nil
+}
+
+func (r *configSyncAccountRepoStub) BindGroups(_ context.Context, accountID int64, groupIDs []int64) error {
+	for _, a := range r.accounts {
+		if a.ID == accountID {
+			a.GroupIDs = append([]int64(nil), groupIDs...)
+		}
+	}
+	return nil
+}
+
+func (r *configSyncAccountRepoStub) ListWithFilters(_ context.Context, _ pagination.PaginationParams, _, _, _, _ string) ([]Account, *pagination.PaginationResult, error) {
+	out := make([]Account, 0, len(r.accounts))
+	for _, a := range r.accounts {
+		out = append(out, *a)
+	}
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, Pages: 1}, nil
+}
+
+func (r *configSyncAccountRepoStub) byName(name string) *Account {
+	for _, a := range r.accounts {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+type configSyncProxyRepoStub struct {
+	ProxyRepository
+	proxies []*Proxy
+}
+
+func (r *configSyncProxyRepoStub) Create(_ context.Context, proxy *Proxy) error {
+	proxy.ID = int64(len(r.proxies) + 1)
+	cloned := *proxy
+	r.proxies = append(r.proxies, &cloned)
+	return nil
+}
+
+func (r *configSyncProxyRepoStub) Update(_ context.Context, proxy *Proxy) error {
+	for i, p := range r.proxies {
+		if p.ID == proxy.ID {
+			cloned := *proxy
+			r.proxies[i] = &cloned
+		}
+	}
+	return nil
+}
+
+func (r *configSyncProxyRepoStub) ListWithFilters(_ context.Context, _ pagination.PaginationParams, _, _, _ string) ([]Proxy, *pagination.PaginationResult, error) {
+	out := make([]Proxy, 0, len(r.proxies))
+	for _, p := range r.proxies {
+		out = append(out, *p)
+	}
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, Pages: 1}, nil
+}
+
+type configSyncSettingRepoStub struct {
+	SettingRepository
+	values map[string]string
+}
+
+func (r *configSyncSettingRepoStub) GetMultiple(_ context.Context, keys []string) (map[string]string, error) {
+	out := map[string]string{}
+	for _, key := range keys {
+		if v, ok := r.values[key]; ok {
+			out[key] = v
+		}
+	}
+	return out, nil
+}
+
+func (r *configSyncSettingRepoStub) SetMultiple(_ context.Context, settings map[string]string) error {
+	for k, v := range settings {
+		r.values[k] = v
+	}
+	return nil
+}
+
+type configSyncRuleRepoStub[T any] struct {
+	items  []*T
+	nextID int64
+	id     func(*T) *int64
+}
+
+func (r *configSyncRuleRepoStub[T]) List(context.Context) ([]*T, error) {
+	out := make([]*T, 0, len(r.items))
+	for _, item := range r.items {
+		cloned := *item
+		out = append(out, &cloned)
+	}
+	return out, nil
+}
+
+func (r *configSyncRuleRepoStub[T]) GetByID(_ context.Context, id int64) (*T, error) {
+	for _, item := range r.items {
+		if *r.id(item) == id {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *configSyncRuleRepoStub[T]) Create(_ context.Context, item *T) (*T, error) {
+	r.nextID++
+	*r.id(item) = r.nextID
+	cloned := *item
+	r.items = append(r.items, &cloned)
+	return item, nil
+}
+
+func (r *configSyncRuleRepoStub[T]) Update(_ context.Context, item *T) (*T, error) {
+	for i, existing := range r.items {
+		if *r.id(existing) == *r.id(item) {
+			cloned := *item
+			r.items[i] = &cloned
+		}
+	}
+	return item, nil
+}
+
+func (r *configSyncRuleRepoStub[T]) Delete(_ context.Context, id int64) error {
+	for i, item := range r.items {
+		if *r.id(item) == id {
+			r.items = append(r.items[:i], r.items[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+type configSyncModelPriceRepoStub struct {
+	*configSyncRuleRepoStub[model.ModelPrice]
+}
+
+func (r configSyncModelPriceRepoStub) Import(context.Context, []*model.ModelPrice, bool) (int, error) {
+	return 0, nil
+}
+
+type configSyncFixture struct {
+	svc      *ConfigSyncService
+	groups   *configSyncGroupRepoStub
+	accounts *configSyncAccountRepoStub
+	proxies  *configSyncProxyRepoStub
+	settings *configSyncSettingRepoStub
+	routing  *configSyncRuleRepoStub[model.RoutingRule]
+	errors   *configSyncRuleRepoStub[model.ErrorPassthroughRule]
+	prices   *configSyncRuleRepoStub[model.ModelPrice]
+}
+
+func newConfigSyncFixture() *configSyncFixture {
+	f := &configSyncFixture{
+		groups:   &configSyncGroupRepoStub{},
+		accounts: &configSyncAccountRepoStub{},
+		proxies:  &configSyncProxyRepoStub{},
+		settings: &configSyncSettingRepoStub{values: map[string]string{}},
+		routing:  &configSyncRuleRepoStub[model.RoutingRule]{id: func(r *model.RoutingRule) *int64 { return &r.ID }},
+		errors:   &configSyncRuleRepoStub[model.ErrorPassthroughRule]{id: func(r *model.ErrorPassthroughRule) *int64 { return &r.ID }},
+		prices:   &configSyncRuleRepoStub[model.ModelPrice]{id: func(p *model.ModelPrice) *int64 { return &p.ID }},
+	}
+	f.svc = NewConfigSyncService(nil, f.groups, f.accounts, f.proxies, f.settings, f.errors, f.routing,
+		configSyncModelPriceRepoStub{f.prices}, nil, nil, nil, nil, nil)
+	return f
+}
+
+const configSyncTestDocument = `
+version: 1
+proxies:
+  - name: hk
+    protocol: socks5
+    host: 10.0.0.1
+    port: 1080
+    password: ${PROXY_PASSWORD}
+groups:
+  - name: main
+    platform: anthropic
+    rate_multiplier: 1.5
+    fallback_group: backup
+    model_routing:
+      "claude-opus-*": [opus-key]
+  - name: backup
+    platform: anthropic
+accounts:
+  - name: opus-key
+    platform: anthropic
+    type: apikey
+    proxy: hk
+    concurrency: 5
+    credentials:
+      api_key: ${OPUS_KEY}
+      base_url: https://api.example.com
+    groups: [main, backup]
+routing_rules:
+  - name: small
+    groups: [main]
+    max_tokens_below: 512
+    target_group: backup
+error_passthrough_rules:
+  - name: overloaded
+    error_codes: [529]
+    passthrough_code: true
+    passthrough_body: true
+pricing:
+  - model: claude-opus-4
+    group: main
+    input_price: 0.000015
+    output_price: 0.000075
+    cache_write_price: 0
+    cache_read_price: 0
+    effective_from: "2026-01-01T00:00:00Z"
+settings:
+  site_name: Example
+  default_concurrency: 3
+  registration_enabled: false
+`
+
+func parseConfigSyncTestDocument(t *testing.T, data string) *ConfigSyncDocument {
+	t.Helper()
+	doc, err := ParseConfigSyncDocument([]byte(data))
+	require.NoError(t, err)
+	env := map[string]string{"PROXY_PASSWORD": "proxy-secret", "OPUS_KEY": "sk-opus"}
+	require.NoError(t, doc.ExpandEnv(func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}))
+	return doc
+}
+
+func TestConfigSync_ApplyCreatesAndIsIdempotent(t *testing.T) {
+	f := newConfigSyncFixture()
+	doc := parseConfigSyncTestDocument(t, configSyncTestDocument)
+	ctx := context.Background()
+
+	plan, err := f.svc.Plan(ctx, doc, ConfigSyncOptions{})
+	require.NoError(t, err)
+	require.Empty(t, plan.Errors)
+	require.Equal(t, 10, plan.Created)
+	require.Empty(t, f.groups.groups, "plan must not write")
+
+	plan, err = f.svc.Apply(ctx, doc, ConfigSyncOptions{})
+	require.NoError(t, err)
+	require.True(t, plan.Applied)
+
+	main, backup := f.groups.byName("main"), f.groups.byName("backup")
+	account := f.accounts.byName("opus-key")
+	require.NotNil(t, main)
+	require.NotNil(t, backup)
+	require.NotNil(t, account)
+	require.Equal(t, 1.5, main.RateMultiplier)
+	require.Equal(t, &backup.ID, main.FallbackGroupID)
+	require.Equal(t, map[string][]int64{"claude-opus-*": {account.ID}}, main.ModelRouting)
+	require.Equal(t, []int64{main.ID, backup.ID}, account.GroupIDs)
+	require.Equal(t, "sk-opus", account.Credentials["api_key"])
+	require.Equal(t, &f.proxies.proxies[0].ID, account.ProxyID)
+	require.Equal(t, "proxy-secret", f.proxies.proxies[0].Password)
+	require.Equal(t, []int64{main.ID}, f.routing.items[0].GroupIDs)
+	require.Equal(t, &backup.ID, f.routing.items[0].TargetGroupID)
+	require.Equal(t, &main.ID, f.prices.items[0].GroupID)
+	require.Equal(t, "3", f.settings.values[SettingKeyDefaultConcurrency])
+	require.Equal(t, "false", f.settings.values[SettingKeyRegistrationEnabled])
+
+	plan, err = f.svc.Plan(ctx, doc, ConfigSyncOptions{Prune: true})
+	require.NoError(t, err)
+	require.Empty(t, plan.Errors)
+	require.False(t, plan.HasChanges(), "unexpected changes: %+v", plan.Changes)
+}
+
+func TestConfigSync_AccountCredentialsMergeByKey(t *testing.T) {
+	f := newConfigSyncFixture()
+	f.accounts.accounts = []*Account{{
+		ID: 1, Name: "oauth-1", Platform: PlatformAnthropic, Type: AccountTypeOAuth, Status: StatusActive, Schedulable: true,
+		Credentials: map[string]any{"access_token": "refreshed", "expires_in": float64(3600)},
+		Concurrency: 3,
+	}}
+	doc := parseConfigSyncTestDocument(t, `
+accounts:
+  - name: oauth-1
+    platform: anthropic
+    type: oauth
+    concurrency: 8
+    credentials:
+      expires_in: 3600
+      refresh_token: rt-new
+`)
+
+	plan, err := f.svc.Apply(context.Background(), doc, ConfigSyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []ConfigSyncChange{{
+		Kind: ConfigSyncKindAccount, Name: "oauth-1", Action: ConfigSyncActionUpdate, Fields: []string{"credentials", "concurrency"},
+	}}, plan.Changes)
+
+	account := f.accounts.byName("oauth-1")
+	require.Equal(t, 8, account.Concurrency)
+	require.Equal(t, map[string]any{"access_token": "refreshed", "expires_in": float64(3600), "refresh_token": "rt-new"}, account.Credentials)
+}
+
+func TestConfigSync_InvalidPlanDoesNotWrite(t *testing.T) {
+	f := newConfigSyncFixture()
+	f.groups.groups = []*Group{{ID: 1, Name: "a", Platform: PlatformAnthropic, Status: StatusActive}}
+	doc := parseConfigSyncTestDocument(t, `
+groups:
+  - name: b
+    fallback_group: a
+  - name: a
+    fallback_group: b
+  - name: c
+    fallback_group: missing
+accounts:
+  - name: dup
+    platform: anthropic
+    type: apikey
+    credentials: {api_key: x}
+    groups: [nowhere]
+settings:
+  admin_api_key: leaked
+`)
+
+	plan, err := f.svc.Apply(context.Background(), doc, ConfigSyncOptions{})
+	require.ErrorIs(t, err, ErrConfigSyncPlanInvalid)
+	require.False(t, plan.Applied)
+	require.Len(t, plan.Errors, 5)
+	require.Len(t, f.groups.groups, 1)
+	require.Empty(t, f.accounts.accounts)
+	require.Empty(t, f.settings.values)
+}
+
+func TestConfigSync_PruneOnlyDeletesRulesAndPrices(t *testing.T) {
+	f := newConfigSyncFixture()
+	f.groups.groups = []*Group{{ID: 1, Name: "keep", Platform: PlatformAnthropic, Status: StatusActive}}
+	f.errors.items = []*model.ErrorPassthroughRule{{ID: 1, Name: "old", Enabled: true, ErrorCodes: []int{500}, MatchMode: model.MatchModeAny}}
+	f.errors.nextID = 1
+	f.prices.items = []*model.ModelPrice{{ID: 1, Model: "gpt-4o", EffectiveFrom: time.Now(), Enabled: true}}
+	f.prices.nextID = 1
+	doc := parseConfigSyncTestDocument(t, `settings: {site_name: x}`)
+	ctx := context.Background()
+
+	plan, err := f.svc.Plan(ctx, doc, ConfigSyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 0, plan.Deleted)
+
+	plan, err = f.svc.Apply(ctx, doc, ConfigSyncOptions{Prune: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, plan.Deleted)
+	require.Empty(t, f.errors.items)
+	require.Empty(t, f.prices.items)
+	require.Len(t, f.groups.groups, 1)
+}
+
+func TestConfigSync_ExportRoundTrip(t *testing.T) {
+	f := newConfigSyncFixture()
+	ctx := context.Background()
+	_, err := f.svc.Apply(ctx, parseConfigSyncTestDocument(t, configSyncTestDocument), ConfigSyncOptions{})
+	require.NoError(t, err)
+	f.settings.values[SettingKeySMTPPassword] = "smtp-secret"
+
+	exported, err := f.svc.Export(ctx)
+	require.NoError(t, err)
+	require.Empty(t, exported.Accounts[0].Credentials)
+	require.Nil(t, exported.Proxies[0].Password)
+	require.NotContains(t, exported.Settings, SettingKeySMTPPassword)
+
+	plan, err := f.svc.Plan(ctx, exported, ConfigSyncOptions{Prune: true})
+	require.NoError(t, err)
+	require.Empty(t, plan.Errors)
+	require.False(t, plan.HasChanges(), "unexpected changes: %+v", plan.Changes)
+}
+
+func TestParseConfigSyncDocument(t *testing.T) {
+	_, err := ParseConfigSyncDocument([]byte("groups:\n  - name: a\n    unknown_field: 1\n"))
+	require.ErrorIs(t, err, ErrConfigSyncInvalidDocument)
+
+	_, err = ParseConfigSyncDocument([]byte("version: 2\n"))
+	require.Error(t, err)
+
+	doc, err := ParseConfigSyncDocument([]byte(`{"accounts":[{"name":"a","credentials":{"api_key":"${MISSING_KEY}","n":1}}]}`))
+	require.NoError(t, err)
+	require.Equal(t, float64(1), doc.Accounts[0].Credentials["n"])
+	err = doc.ExpandEnv(func(string) (string, bool) { return "", false })
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "MISSING_KEY")
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+)
+
+// StandardContextWindowTokens Anthropic 标准上下文窗口，超出需使用开通 1M 上下文 beta 的账号
+const StandardContextWindowTokens = 200000
+
+// ContextTooLongError 分组内没有上下文容量足以承载当前请求的账号
+// 属于请求侧问题，Handler 应返回 400 而不是 503
+type ContextTooLongError struct {
+	EstimatedTokens  int
+	MaxContextTokens int // 分组内可调度账号的最大上下文容量
+}
+
+func (e *ContextTooLongError) Error() string {
+	return fmt.Sprintf("request is too long for this group: estimated %d input tokens exceeds the largest available context window (%d tokens)",
+		e.EstimatedTokens, e.MaxContextTokens)
+}
+
+// WithEstimatedInputTokens 估算请求输入 token 数并写入 context，供调度层过滤上下文容量不足的账号
+func WithEstimatedInputTokens(ctx context.Context, body []byte) context.Context {
+	tokens := estimateRequestInputTokens(body)
+	if tokens <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxkey.EstimatedInputTokens, tokens)
+}
+
+func estimatedInputTokensFromContext(ctx context.Context) int {
+	if ctx == nil {
+		return 0
+	}
+	tokens, _ := ctx.Value(ctxkey.EstimatedInputTokens).(int)
+	return tokens
+}
+
+// contextTokensWithTolerance 按容差折算估算的输入 token 数，用于与账号上下文容量比较
+func contextTokensWithTolerance(ctx context.Context, tolerancePercent int) int {
+	tokens := estimatedInputTokensFromContext(ctx)
+	if tokens <= 0 || tolerancePercent <= 0 {
+		return tokens
+	}
+	return tokens * 100 / (100 + tolerancePercent)
+}
+
+// fitsEstimatedContext 粘性会话等直接取号路径检查账号上下文容量是否足以承载请求
+// 不满足时回到候选列表，由 filterContextCapableAccounts 过滤或拒绝
+func fitsEstimatedContext(ctx context.Context, account *Account, tolerancePercent int) bool {
+	return account.SupportsContextTokens(contextTokensWithTolerance(ctx, tolerancePercent))
+}
+
+// filterContextCapableAccounts 只保留上下文容量足以承载请求的账号
+// 全部账号容量不足时返回 ContextTooLongError，以便与“无可用账号”区分
+func filterContextCapableAccounts(ctx context.Context, accounts []Account, tolerancePercent int) ([]Account, error) {
+	tokens := contextTokensWithTolerance(ctx, tolerancePercent)
+	if tokens <= 0 || len(accounts) == 0 {
+		return accounts, nil
+	}
+
+	filtered := make([]Account, 0, len(accounts))
+	largest := 0
+	for i := range accounts {
+		if accounts[i].SupportsContextTokens(tokens) {
+			filtered = append(filtered, accounts[i])
+			continue
+		}
+		if limit := accounts[i].GetMaxContextTokens(); limit > largest {
+			largest = limit
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, &ContextTooLongError{EstimatedTokens: estimatedInputTokensFromContext(ctx), MaxContextTokens: largest}
+	}
+	return filtered, nil
+}
+
+// estimateContextTokensForText 估算文本 token 数：ASCII 字符约 4 个 1 token，其余字符（中日韩等）按 1 字 1 token 计
+// 与 estimateTokensForText 不同，混合文本分别计数，避免以中文为主的代码/日志被整体按 1 字 1 token 高估
+func estimateContextTokensForText(s string) int {
+	ascii, other := 0, 0
+	for _, r := range strings.TrimSpace(s) {
+		if r <= 0x7f {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return (ascii+3)/4 + other
+}
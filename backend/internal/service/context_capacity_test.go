@@ -0,0 +1,101 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount_GetMaxContextTokens(t *testing.T) {
+	require.Equal(t, StandardContextWindowTokens, (&Account{Platform: PlatformAnthropic}).GetMaxContextTokens())
+	require.Equal(t, 1000000, (&Account{Platform: PlatformAnthropic, Extra: map[string]any{"max_context_tokens": float64(1000000)}}).GetMaxContextTokens())
+	require.Equal(t, StandardContextWindowTokens, (&Account{Platform: PlatformAnthropic, Extra: map[string]any{"max_context_tokens": "0"}}).GetMaxContextTokens())
+	require.Equal(t, 0, (&Account{Platform: PlatformGemini}).GetMaxContextTokens())
+
+	require.True(t, (&Account{Platform: PlatformGemini}).SupportsContextTokens(5000000))
+	require.False(t, (&Account{Platform: PlatformAnthropic}).SupportsContextTokens(StandardContextWindowTokens+1))
+}
+
+func TestFilterContextCapableAccounts(t *testing.T) {
+	standard := Account{ID: 1, Platform: PlatformAnthropic}
+	long := Account{ID: 2, Platform: PlatformAnthropic, Extra: map[string]any{"max_context_tokens": 1000000}}
+
+	// 未估算时不过滤
+	accounts, err := filterContextCapableAccounts(context.Background(), []Account{standard, long}, 0)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	// 约 25 万 token：只保留容量足够的账号
+	body := []byte(`{"messages":[{"role":"user","content":"` + strings.Repeat("abcd", 250000) + `"}]}`)
+	ctx := WithEstimatedInputTokens(context.Background(), body)
+	accounts, err = filterContextCapableAccounts(ctx, []Account{standard, long}, 0)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, int64(2), accounts[0].ID)
+
+	// 没有容量足够的账号时直接返回 ContextTooLongError
+	_, err = filterContextCapableAccounts(ctx, []Account{standard}, 10)
+	var contextErr *ContextTooLongError
+	require.True(t, errors.As(err, &contextErr))
+	require.Equal(t, StandardContextWindowTokens, contextErr.MaxContextTokens)
+	require.Equal(t, 250000, contextErr.EstimatedTokens)
+
+	// 空列表保持“无可用账号”语义
+	accounts, err = filterContextCapableAccounts(ctx, nil, 0)
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
+
+// TestFilterContextCapableAccounts_Tolerance 容差只吸收小幅估算误差
+func TestFilterContextCapableAccounts_Tolerance(t *testing.T) {
+	standard := Account{ID: 1, Platform: PlatformAnthropic}
+
+	// 约 21 万 token：10% 容差内仍交由标准账号处理
+	body := []byte(`{"messages":[{"role":"user","content":"` + strings.Repeat("abcd", 210000) + `"}]}`)
+	ctx := WithEstimatedInputTokens(context.Background(), body)
+	_, err := filterContextCapableAccounts(ctx, []Account{standard}, 0)
+	require.Error(t, err)
+	accounts, err := filterContextCapableAccounts(ctx, []Account{standard}, 10)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.True(t, fitsEstimatedContext(ctx, &standard, 10))
+
+	// 约 35 万 token：超出容差直接拒绝
+	body = []byte(`{"messages":[{"role":"user","content":"` + strings.Repeat("abcd", 350000) + `"}]}`)
+	_, err = filterContextCapableAccounts(WithEstimatedInputTokens(context.Background(), body), []Account{standard}, 10)
+	var contextErr *ContextTooLongError
+	require.True(t, errors.As(err, &contextErr))
+}
+
+// TestEstimateContextTokensForText 混合文本中 ASCII 与中日韩字符分别计数
+func TestEstimateContextTokensForText(t *testing.T) {
+	require.Equal(t, 0, estimateContextTokensForText("  "))
+	require.Equal(t, 25, estimateContextTokensForText(strings.Repeat("abcd", 25)))
+	require.Equal(t, 10, estimateContextTokensForText(strings.Repeat("中文", 5)))
+	// 以中文注释为主的代码：ASCII 部分不按 1 字 1 token 计
+	require.Equal(t, 25+10, estimateContextTokensForText(strings.Repeat("abcd", 25)+strings.Repeat("中文", 5)))
+
+	body := []byte(`{"system":"你是一个助手","messages":[{"role":"user","content":"` + strings.Repeat("中文上下文", 50000) + `"}]}`)
+	ctx := WithEstimatedInputTokens(context.Background(), body)
+	standard := Account{ID: 1, Platform: PlatformAnthropic}
+	long := Account{ID: 2, Platform: PlatformAnthropic, Extra: map[string]any{"max_context_tokens": 1000000}}
+	accounts, err := filterContextCapableAccounts(ctx, []Account{standard, long}, 10)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, int64(2), accounts[0].ID)
+}
+
+func TestFitsEstimatedContext(t *testing.T) {
+	account := &Account{Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true}
+	body := []byte(`{"messages":[{"role":"user","content":"` + strings.Repeat("abcd", 250000) + `"}]}`)
+
+	require.True(t, fitsEstimatedContext(context.Background(), account, 0))
+	require.False(t, fitsEstimatedContext(WithEstimatedInputTokens(context.Background(), body), account, 10))
+	// 容量检查由候选列表负责，模型级可调度判断不再包含上下文容量
+	require.True(t, account.IsSchedulableForModelWithContext(WithEstimatedInputTokens(context.Background(), body), "claude-sonnet-4-5"))
+}
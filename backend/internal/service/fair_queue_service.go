@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+)
+
+const (
+	// 用户排队权重取值范围，0 或未设置视为默认权重 1
+	DefaultUserQueueWeight = 1
+	MaxUserQueueWeight     = 100
+
+	fairQueueOpTimeout = 2 * time.Second
+)
+
+// FairQueueCache 分组公平队列缓存接口
+//
+// 采用起始时间公平排队（SFQ）：每个等待请求的起始标签为 max(分组虚拟时钟, 该用户上一个请求的结束标签)，
+// 结束标签 = 起始标签 + 1/权重，队列按起始标签排序。高并发用户的请求标签逐个递增，
+// 不会挤占其他用户的排队位置；请求获得槽位时虚拟时钟推进到其起始标签。
+//
+// 队列按分组排序，但槽位属于账号：票据同时记录所等待的账号，
+// 准入窗口按账号计算，等待不同账号的请求不会互相阻塞。
+// 票据格式为 {纳秒时间戳}:{accountID}:{随机串}。
+type FairQueueCache interface {
+	// Enqueue 将等待指定账号的票据加入分组队列
+	Enqueue(ctx context.Context, groupID, accountID, userID int64, weight int, ticket string, ttl time.Duration) error
+	// Position 刷新票据心跳并返回其在队列中的位置；票据不在队列中时 Rank 为 -1
+	Position(ctx context.Context, groupID, accountID int64, ticket string, ttl time.Duration) (*FairQueuePosition, error)
+	// Admit 票据已获得槽位：出队并推进虚拟时钟与出队间隔统计
+	Admit(ctx context.Context, groupID, accountID int64, ticket string) error
+	// Leave 票据放弃等待（超时/客户端断开）：仅出队
+	Leave(ctx context.Context, groupID, accountID int64, ticket string) error
+	// AccountWaiting 返回正在等待指定账号的票据数（跨分组）
+	AccountWaiting(ctx context.Context, accountID int64, ttl time.Duration) (int64, error)
+	// Stats 返回所有非空分组队列的状态
+	Stats(ctx context.Context, ttl time.Duration) ([]FairQueueGroupStats, error)
+}
+
+// FairQueuePosition 票据在分组队列中的位置
+type FairQueuePosition struct {
+	Rank  int64 // 从 0 开始，-1 表示不在队列中
+	Depth int64
+	// AvgAdmitIntervalMs 分组最近的平均出队间隔（EWMA），0 表示暂无数据
+	AvgAdmitIntervalMs int64
+	// AheadSameAccount 排在前面、等待同一账号的票据数
+	AheadSameAccount int64
+}
+
+// FairQueueGroupStats 单个分组的排队状态
+type FairQueueGroupStats struct {
+	GroupID              int64 `json:"group_id"`
+	Depth                int64 `json:"depth"`
+	AvgAdmitIntervalMs   int64 `json:"avg_admit_interval_ms"`
+	EstimatedWaitSeconds int64 `json:"estimated_wait_seconds"` // 新请求入队后的预计等待时间
+}
+
+// FairQueueStats 运维面板展示的公平队列状态
+type FairQueueStats struct {
+	Enabled bool                  `json:"enabled"`
+	Groups  []FairQueueGroupStats `json:"groups"`
+}
+
+// FairQueueStatus 等待中请求的排队状态
+type FairQueueStatus struct {
+	Position      int64 // 从 1 开始
+	Depth         int64
+	EstimatedWait time.Duration // 0 表示暂无估计
+	// CanAcquire 位于队首准入窗口内，或排在前面等待同一账号的请求少于窗口大小，可以尝试获取账号槽位
+	CanAcquire bool
+}
+
+// FairQueueService 账号饱和时的分组加权公平队列
+type FairQueueService struct {
+	cache FairQueueCache
+	cfg   atomic.Pointer[config.FairQueueConfig]
+}
+
+// NewFairQueueService 创建分组公平队列服务
+func NewFairQueueService(cache FairQueueCache, cfg *config.Config) *FairQueueService {
+	s := &FairQueueService{cache: cache}
+	s.ApplyConfig(cfg)
+	return s
+}
+
+// ApplyConfig 应用（热更新后的）公平队列配置，已在排队的请求按新配置继续排队
+func (s *FairQueueService) ApplyConfig(cfg *config.Config) {
+	var fq config.FairQueueConfig
+	if cfg != nil {
+		fq = cfg.Gateway.Scheduling.FairQueue
+	}
+	s.cfg.Store(&fq)
+}
+
+func (s *FairQueueService) currentConfig() *config.FairQueueConfig {
+	if cfg := s.cfg.Load(); cfg != nil {
+		return cfg
+	}
+	return &config.FairQueueConfig{}
+}
+
+// Enabled 是否启用分组公平队列
+func (s *FairQueueService) Enabled() bool {
+	return s != nil && s.cache != nil && s.currentConfig().Enabled
+}
+
+func (s *FairQueueService) ticketTTL() time.Duration {
+	return time.Duration(s.currentConfig().TicketTTLSeconds) * time.Second
+}
+
+// NormalizeQueueWeight 将用户排队权重限制在 [1, MaxUserQueueWeight]
+func NormalizeQueueWeight(weight int) int {
+	if weight < DefaultUserQueueWeight {
+		return DefaultUserQueueWeight
+	}
+	if weight > MaxUserQueueWeight {
+		return MaxUserQueueWeight
+	}
+	return weight
+}
+
+// FairQueueTicket 等待中请求在分组队列中的票据
+type FairQueueTicket struct {
+	svc       *FairQueueService
+	groupID   int64
+	accountID int64
+	id        string
+}
+
+// Join 加入分组队列，等待指定账号的槽位
+func (s *FairQueueService) Join(ctx context.Context, groupID, accountID, userID int64, weight int) (*FairQueueTicket, error) {
+	if !s.Enabled() {
+		return nil, fmt.Errorf("fair queue disabled")
+	}
+	// 票据以纳秒时间戳开头：起始标签相同时按加入顺序排序
+	id := fmt.Sprintf("%019d:%d:%s", time.Now().UnixNano(), accountID, generateRequestID())
+	if err := s.cache.Enqueue(ctx, groupID, accountID, userID, NormalizeQueueWeight(weight), id, s.ticketTTL()); err != nil {
+		return nil, err
+	}
+	return &FairQueueTicket{svc: s, groupID: groupID, accountID: accountID, id: id}, nil
+}
+
+// HasAccountWaiters 是否有请求正在排队等待该账号
+// 有等待者时新请求不应直接抢占该账号空出的槽位；查询失败时不阻塞调度。
+func (s *FairQueueService) HasAccountWaiters(ctx context.Context, accountID int64) bool {
+	if !s.Enabled() {
+		return false
+	}
+	count, err := s.cache.AccountWaiting(ctx, accountID, s.ticketTTL())
+	if err != nil {
+		log.Printf("Warning: fair queue waiting check failed for account %d: %v", accountID, err)
+		return false
+	}
+	return count > 0
+}
+
+// Poll 刷新心跳并返回当前排队状态
+// 票据已被清理（如心跳超时）时视为可直接尝试获取槽位，避免请求永久等待。
+func (t *FairQueueTicket) Poll(ctx context.Context) (*FairQueueStatus, error) {
+	pos, err := t.svc.cache.Position(ctx, t.groupID, t.accountID, t.id, t.svc.ticketTTL())
+	if err != nil {
+		return nil, err
+	}
+	return t.svc.status(pos), nil
+}
+
+func (s *FairQueueService) status(pos *FairQueuePosition) *FairQueueStatus {
+	if pos == nil || pos.Rank < 0 {
+		return &FairQueueStatus{CanAcquire: true}
+	}
+	window := int64(s.currentConfig().AdmitWindow)
+	status := &FairQueueStatus{
+		Position:   pos.Rank + 1,
+		Depth:      pos.Depth,
+		CanAcquire: pos.Rank < window || pos.AheadSameAccount < window,
+	}
+	if pos.AvgAdmitIntervalMs > 0 {
+		status.EstimatedWait = time.Duration(status.Position*pos.AvgAdmitIntervalMs) * time.Millisecond
+	}
+	return status
+}
+
+// Admit 票据已获得槽位
+func (t *FairQueueTicket) Admit() {
+	ctx, cancel := context.WithTimeout(context.Background(), fairQueueOpTimeout)
+	defer cancel()
+	if err := t.svc.cache.Admit(ctx, t.groupID, t.accountID, t.id); err != nil {
+		log.Printf("Warning: fair queue admit failed for group %d: %v", t.groupID, err)
+	}
+}
+
+// Leave 放弃等待
+// 使用独立 context，确保客户端断开时票据也能及时出队。
+func (t *FairQueueTicket) Leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), fairQueueOpTimeout)
+	defer cancel()
+	if err := t.svc.cache.Leave(ctx, t.groupID, t.accountID, t.id); err != nil {
+		log.Printf("Warning: fair queue leave failed for group %d: %v", t.groupID, err)
+	}
+}
+
+// Stats 返回各分组的队列深度与预计等待时间
+func (s *FairQueueService) Stats(ctx context.Context) (*FairQueueStats, error) {
+	stats := &FairQueueStats{Enabled: s.Enabled(), Groups: []FairQueueGroupStats{}}
+	if !stats.Enabled {
+		return stats, nil
+	}
+	groups, err := s.cache.Stats(ctx, s.ticketTTL())
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		groups[i].EstimatedWaitSeconds = (groups[i].Depth + 1) * groups[i].AvgAdmitIntervalMs / 1000
+	}
+	stats.Groups = groups
+	return stats, nil
+}
@@ -0,0 +1,161 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type fairQueueCacheStub struct {
+	enqueued  []int // weights
+	position  *FairQueuePosition
+	admitted  []string
+	left      []string
+	groups    []FairQueueGroupStats
+	waiting   map[int64]int64
+	enqueueFn func() error
+}
+
+func (s *fairQueueCacheStub) Enqueue(_ context.Context, _, _, _ int64, weight int, _ string, _ time.Duration) error {
+	if s.enqueueFn != nil {
+		if err := s.enqueueFn(); err != nil {
+			return err
+		}
+	}
+	s.enqueued = append(s.enqueued, weight)
+	return nil
+}
+
+func (s *fairQueueCacheStub) Position(context.Context, int64, int64, string, time.Duration) (*FairQueuePosition, error) {
+	return s.position, nil
+}
+
+func (s *fairQueueCacheStub) Admit(_ context.Context, _, _ int64, ticket string) error {
+	s.admitted = append(s.admitted, ticket)
+	return nil
+}
+
+func (s *fairQueueCacheStub) Leave(_ context.Context, _, _ int64, ticket string) error {
+	s.left = append(s.left, ticket)
+	return nil
+}
+
+func (s *fairQueueCacheStub) AccountWaiting(_ context.Context, accountID int64, _ time.Duration) (int64, error) {
+	return s.waiting[accountID], nil
+}
+
+func (s *fairQueueCacheStub) Stats(context.Context, time.Duration) ([]FairQueueGroupStats, error) {
+	return s.groups, nil
+}
+
+func newFairQueueServiceForTest(cache FairQueueCache) *FairQueueService {
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.FairQueue = config.FairQueueConfig{Enabled: true, AdmitWindow: 2, TicketTTLSeconds: 30}
+	return NewFairQueueService(cache, cfg)
+}
+
+func TestNormalizeQueueWeight(t *testing.T) {
+	require.Equal(t, 1, NormalizeQueueWeight(0))
+	require.Equal(t, 1, NormalizeQueueWeight(-3))
+	require.Equal(t, 7, NormalizeQueueWeight(7))
+	require.Equal(t, MaxUserQueueWeight, NormalizeQueueWeight(1000))
+}
+
+func TestFairQueueService_Disabled(t *testing.T) {
+	svc := NewFairQueueService(&fairQueueCacheStub{}, &config.Config{})
+	require.False(t, svc.Enabled())
+	_, err := svc.Join(context.Background(), 1, 1, 1, 1)
+	require.Error(t, err)
+	require.False(t, svc.HasAccountWaiters(context.Background(), 1))
+
+	var nilSvc *FairQueueService
+	require.False(t, nilSvc.Enabled())
+	stats, err := nilSvc.Stats(context.Background())
+	require.NoError(t, err)
+	require.False(t, stats.Enabled)
+	require.Empty(t, stats.Groups)
+}
+
+func TestFairQueueTicket_Poll(t *testing.T) {
+	cache := &fairQueueCacheStub{}
+	svc := newFairQueueServiceForTest(cache)
+
+	ticket, err := svc.Join(context.Background(), 1, 9, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, cache.enqueued, "未设置权重时使用默认权重")
+	require.Contains(t, ticket.id, ":9:", "票据中记录所等待的账号")
+
+	cache.position = &FairQueuePosition{Rank: 1, Depth: 5, AvgAdmitIntervalMs: 1500}
+	status, err := ticket.Poll(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), status.Position)
+	require.Equal(t, int64(5), status.Depth)
+	require.Equal(t, 3*time.Second, status.EstimatedWait)
+	require.True(t, status.CanAcquire, "位于准入窗口内")
+
+	cache.position = &FairQueuePosition{Rank: 2, Depth: 5, AheadSameAccount: 2}
+	status, err = ticket.Poll(context.Background())
+	require.NoError(t, err)
+	require.False(t, status.CanAcquire)
+	require.Zero(t, status.EstimatedWait, "无出队数据时不估计等待时间")
+
+	cache.position = &FairQueuePosition{Rank: 4, Depth: 5, AheadSameAccount: 1}
+	status, err = ticket.Poll(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.CanAcquire, "前面等待的多为其他账号时不被阻塞")
+
+	cache.position = &FairQueuePosition{Rank: -1}
+	status, err = ticket.Poll(context.Background())
+	require.NoError(t, err)
+	require.True(t, status.CanAcquire, "票据已被清理时直接竞争")
+
+	ticket.Admit()
+	ticket.Leave()
+	require.Equal(t, []string{ticket.id}, cache.admitted)
+	require.Equal(t, []string{ticket.id}, cache.left)
+}
+
+func TestFairQueueService_JoinError(t *testing.T) {
+	cache := &fairQueueCacheStub{enqueueFn: func() error { return errors.New("redis down") }}
+	_, err := newFairQueueServiceForTest(cache).Join(context.Background(), 1, 1, 2, 3)
+	require.Error(t, err)
+}
+
+func TestFairQueueService_HasAccountWaiters(t *testing.T) {
+	cache := &fairQueueCacheStub{waiting: map[int64]int64{7: 2}}
+	svc := newFairQueueServiceForTest(cache)
+	require.True(t, svc.HasAccountWaiters(context.Background(), 7))
+	require.False(t, svc.HasAccountWaiters(context.Background(), 8))
+}
+
+func TestFairQueueService_Stats(t *testing.T) {
+	cache := &fairQueueCacheStub{groups: []FairQueueGroupStats{{GroupID: 3, Depth: 4, AvgAdmitIntervalMs: 2000}}}
+	stats, err := newFairQueueServiceForTest(cache).Stats(context.Background())
+	require.NoError(t, err)
+	require.True(t, stats.Enabled)
+	require.Len(t, stats.Groups, 1)
+	require.Equal(t, int64(10), stats.Groups[0].EstimatedWaitSeconds)
+}
+
+func TestConcurrencyService_AcquireAccountSlotUnlessQueued(t *testing.T) {
+	svc := NewConcurrencyService(stubConcurrencyCache{})
+	svc.SetFairQueueService(newFairQueueServiceForTest(&fairQueueCacheStub{waiting: map[int64]int64{7: 1}}))
+
+	result, err := svc.AcquireAccountSlotUnlessQueued(context.Background(), 7, 2)
+	require.NoError(t, err)
+	require.False(t, result.Acquired, "账号有排队者时新请求不抢占槽位")
+
+	result, err = svc.AcquireAccountSlotUnlessQueued(context.Background(), 8, 2)
+	require.NoError(t, err)
+	require.True(t, result.Acquired)
+
+	result, err = svc.AcquireAccountSlotUnlessQueued(context.Background(), 7, 0)
+	require.NoError(t, err)
+	require.True(t, result.Acquired, "不限并发的账号不受队列影响")
+}
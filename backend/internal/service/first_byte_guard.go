@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/gin-gonic/gin"
+)
+
+// maxFirstByteTimeoutSeconds 分组首字节预算上限（秒）
+const maxFirstByteTimeoutSeconds = 600
+
+func validateFirstByteTimeout(seconds int) error {
+	if seconds < 0 || seconds > maxFirstByteTimeoutSeconds {
+		return ErrFirstByteTimeoutInvalid
+	}
+	return nil
+}
+
+// firstByteBudget 返回流式请求的首字节预算：分组配置优先，未配置时使用全局配置，0 表示禁用
+func firstByteBudget(ctx context.Context, cfg *config.Config) time.Duration {
+	if group, ok := ctx.Value(ctxkey.Group).(*Group); ok && IsGroupContextValid(group) && group.FirstByteTimeoutSeconds > 0 {
+		return time.Duration(group.FirstByteTimeoutSeconds) * time.Second
+	}
+	if cfg != nil && cfg.Gateway.FirstByteTimeout > 0 {
+		return time.Duration(cfg.Gateway.FirstByteTimeout) * time.Second
+	}
+	return 0
+}
+
+const (
+	firstBytePending int32 = iota
+	firstByteReceived
+	firstByteExpired
+)
+
+// firstByteGuard 首字节预算守卫
+//
+// 预算内未收到上游任何数据时取消上游请求，由调用方转换为 failover 切换账号。
+// 仅在尚未向客户端写入任何内容时启用（等待槽位期间已发送 ping 的请求无法再切换账号）。
+//
+// 每个账号一个守卫：预算从在该账号上首次发起请求开始计算，覆盖同账号的全部重试及其退避等待，
+// 衡量的是客户端在当前账号上等待首字节的总时长；超出后不再原地重试，直接切换账号。
+type firstByteGuard struct {
+	budget time.Duration
+	state  atomic.Int32
+	timer  *time.Timer
+	cancel context.CancelFunc
+}
+
+// newFirstByteGuard 返回用于上游请求的 context 与守卫；未启用时返回原 context 与 nil
+func newFirstByteGuard(ctx context.Context, c *gin.Context, cfg *config.Config, reqStream bool) (context.Context, *firstByteGuard) {
+	if !reqStream || c == nil || c.Writer.Written() {
+		return ctx, nil
+	}
+	budget := firstByteBudget(ctx, cfg)
+	if budget <= 0 {
+		return ctx, nil
+	}
+	return startFirstByteGuard(ctx, budget)
+}
+
+func startFirstByteGuard(ctx context.Context, budget time.Duration) (context.Context, *firstByteGuard) {
+	upstreamCtx, cancel := context.WithCancel(ctx)
+	g := &firstByteGuard{budget: budget, cancel: cancel}
+	g.timer = time.AfterFunc(budget, func() {
+		if g.state.CompareAndSwap(firstBytePending, firstByteExpired) {
+			cancel()
+		}
+	})
+	return upstreamCtx, g
+}
+
+// markReceived 收到上游首个数据后解除预算
+func (g *firstByteGuard) markReceived() {
+	if g != nil && g.state.CompareAndSwap(firstBytePending, firstByteReceived) {
+		g.timer.Stop()
+	}
+}
+
+// pending 是否仍在等待上游首个数据
+func (g *firstByteGuard) pending() bool {
+	return g != nil && g.state.Load() == firstBytePending
+}
+
+// expired 是否因超出首字节预算而取消了上游请求
+func (g *firstByteGuard) expired() bool {
+	return g != nil && g.state.Load() == firstByteExpired
+}
+
+// release 释放守卫，需在上游响应读取完毕后调用
+func (g *firstByteGuard) release() {
+	if g == nil {
+		return
+	}
+	g.timer.Stop()
+	g.cancel()
+}
+
+// failover 记录被放弃的尝试并返回 failover 错误，由 handler 切换到其他账号
+// 被放弃的尝试未产生输出，不会计费。
+func (g *firstByteGuard) failover(c *gin.Context, account *Account) error {
+	message := fmt.Sprintf("no upstream data within %s", g.budget)
+	appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+		Platform:           account.Platform,
+		AccountID:          account.ID,
+		AccountName:        account.Name,
+		UpstreamStatusCode: 0,
+		Kind:               "first_byte_timeout",
+		Message:            message,
+	})
+	log.Printf("First byte timeout: account=%d budget=%s, failing over", account.ID, g.budget)
+	return &UpstreamFailoverError{StatusCode: http.StatusGatewayTimeout}
+}
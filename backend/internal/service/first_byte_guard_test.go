@@ -0,0 +1,228 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// ctxBlockingReadCloser 阻塞读取直到 context 取消，模拟迟迟不返回首字节的上游
+type ctxBlockingReadCloser struct {
+	ctx context.Context
+}
+
+func (r ctxBlockingReadCloser) Read([]byte) (int, error) {
+	<-r.ctx.Done()
+	return 0, r.ctx.Err()
+}
+
+func (r ctxBlockingReadCloser) Close() error { return nil }
+
+func TestValidateFirstByteTimeout(t *testing.T) {
+	require.NoError(t, validateFirstByteTimeout(0))
+	require.NoError(t, validateFirstByteTimeout(maxFirstByteTimeoutSeconds))
+	require.ErrorIs(t, validateFirstByteTimeout(-1), ErrFirstByteTimeoutInvalid)
+	require.ErrorIs(t, validateFirstByteTimeout(maxFirstByteTimeoutSeconds+1), ErrFirstByteTimeoutInvalid)
+}
+
+func TestFirstByteBudget(t *testing.T) {
+	cfg := &config.Config{Gateway: config.GatewayConfig{FirstByteTimeout: 20}}
+
+	require.Equal(t, 20*time.Second, firstByteBudget(context.Background(), cfg))
+	require.Zero(t, firstByteBudget(context.Background(), &config.Config{}))
+	require.Zero(t, firstByteBudget(context.Background(), nil))
+
+	group := &Group{ID: 1, Platform: PlatformAnthropic, Status: StatusActive, Hydrated: true, FirstByteTimeoutSeconds: 5}
+	ctx := context.WithValue(context.Background(), ctxkey.Group, group)
+	require.Equal(t, 5*time.Second, firstByteBudget(ctx, cfg), "分组配置优先")
+
+	group.FirstByteTimeoutSeconds = 0
+	require.Equal(t, 20*time.Second, firstByteBudget(ctx, cfg), "分组未配置时使用全局配置")
+}
+
+func TestNewFirstByteGuard_Disabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Gateway: config.GatewayConfig{FirstByteTimeout: 10}}
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	ctx := context.Background()
+
+	gotCtx, guard := newFirstByteGuard(ctx, c, cfg, false)
+	require.Nil(t, guard, "非流式请求不启用")
+	require.Equal(t, ctx, gotCtx)
+
+	_, guard = newFirstByteGuard(ctx, c, &config.Config{}, true)
+	require.Nil(t, guard, "未配置预算时不启用")
+
+	c.Writer.WriteHeaderNow()
+	_, guard = newFirstByteGuard(ctx, c, cfg, true)
+	require.Nil(t, guard, "已向客户端输出时无法切换账号")
+
+	// nil 守卫的方法均为空操作
+	guard.markReceived()
+	guard.release()
+	require.False(t, guard.pending())
+	require.False(t, guard.expired())
+}
+
+func TestFirstByteGuard_ExpiresAndCancels(t *testing.T) {
+	ctx, guard := startFirstByteGuard(context.Background(), 20*time.Millisecond)
+	defer guard.release()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected upstream context to be canceled")
+	}
+	require.True(t, guard.expired())
+	require.False(t, guard.pending())
+}
+
+func TestFirstByteGuard_MarkReceivedStopsBudget(t *testing.T) {
+	ctx, guard := startFirstByteGuard(context.Background(), 20*time.Millisecond)
+	require.True(t, guard.pending())
+	guard.markReceived()
+
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, ctx.Err())
+	require.False(t, guard.expired())
+
+	guard.release()
+	require.Error(t, ctx.Err(), "release 后取消上游 context")
+}
+
+func TestOpenAIStreamingFirstByteTimeoutFailsOver(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Gateway: config.GatewayConfig{MaxLineSize: defaultMaxLineSize}}
+	svc := &OpenAIGatewayService{cfg: cfg}
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+
+	upstreamCtx, guard := startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ctxBlockingReadCloser{ctx: upstreamCtx},
+		Header:     http.Header{},
+	}
+
+	_, err := svc.handleStreamingResponse(c.Request.Context(), resp, c, &Account{ID: 1, Name: "a", Platform: PlatformOpenAI}, time.Now(), "model", "model", guard)
+	var failoverErr *UpstreamFailoverError
+	require.True(t, errors.As(err, &failoverErr), "expected failover error, got %v", err)
+	require.Equal(t, http.StatusGatewayTimeout, failoverErr.StatusCode)
+	require.Empty(t, rec.Body.String(), "切换账号前不应向客户端输出")
+
+	raw, ok := c.Get(OpsUpstreamErrorsKey)
+	require.True(t, ok)
+	events, ok := raw.([]*OpsUpstreamErrorEvent)
+	require.True(t, ok)
+	require.Len(t, events, 1)
+	require.Equal(t, "first_byte_timeout", events[0].Kind)
+}
+
+// requireFirstByteFailover 断言切换账号且未向客户端输出任何内容
+func requireFirstByteFailover(t *testing.T, c *gin.Context, rec *httptest.ResponseRecorder, err error) {
+	t.Helper()
+	var failoverErr *UpstreamFailoverError
+	require.True(t, errors.As(err, &failoverErr), "expected failover error, got %v", err)
+	require.Equal(t, http.StatusGatewayTimeout, failoverErr.StatusCode)
+	require.Empty(t, rec.Body.String(), "切换账号前不应向客户端输出")
+	require.False(t, c.Writer.Written())
+}
+
+func newFirstByteTestContext() (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	return c, rec
+}
+
+func newFirstByteBlockingResponse(ctx context.Context) *http.Response {
+	return &http.Response{StatusCode: http.StatusOK, Body: ctxBlockingReadCloser{ctx: ctx}, Header: http.Header{}}
+}
+
+func TestCompatibleStreamingFirstByteTimeoutFailsOver(t *testing.T) {
+	svc := &CompatibleGatewayService{cfg: &config.Config{Gateway: config.GatewayConfig{MaxLineSize: defaultMaxLineSize}}}
+	account := &Account{ID: 1, Name: "a", Platform: PlatformCompatible}
+
+	c, rec := newFirstByteTestContext()
+	upstreamCtx, guard := startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	_, err := svc.handleClaudeStreamingResponse(c, newFirstByteBlockingResponse(upstreamCtx), account, time.Now(), "model", guard)
+	requireFirstByteFailover(t, c, rec, err)
+
+	c, rec = newFirstByteTestContext()
+	upstreamCtx, guard = startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	_, err = svc.handleChatStreamingResponse(c, newFirstByteBlockingResponse(upstreamCtx), account, time.Now(), "model", "model", guard)
+	requireFirstByteFailover(t, c, rec, err)
+}
+
+func TestGeminiCompatStreamingFirstByteTimeoutFailsOver(t *testing.T) {
+	svc := &GeminiMessagesCompatService{cfg: &config.Config{}}
+	account := &Account{ID: 1, Name: "a", Platform: PlatformGemini}
+
+	c, rec := newFirstByteTestContext()
+	upstreamCtx, guard := startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	_, err := svc.handleStreamingResponse(c, newFirstByteBlockingResponse(upstreamCtx), account, time.Now(), "model", guard)
+	requireFirstByteFailover(t, c, rec, err)
+
+	c, rec = newFirstByteTestContext()
+	upstreamCtx, guard = startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	_, err = svc.handleNativeStreamingResponse(c, newFirstByteBlockingResponse(upstreamCtx), account, time.Now(), false, guard)
+	requireFirstByteFailover(t, c, rec, err)
+}
+
+func TestAntigravityStreamingFirstByteTimeoutFailsOver(t *testing.T) {
+	svc := newAntigravityTestService(&config.Config{Gateway: config.GatewayConfig{MaxLineSize: defaultMaxLineSize}})
+	account := &Account{ID: 1, Name: "a", Platform: PlatformAntigravity}
+
+	c, rec := newFirstByteTestContext()
+	upstreamCtx, guard := startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	_, err := svc.handleClaudeStreamingResponse(c, newFirstByteBlockingResponse(upstreamCtx), account, time.Now(), "model", guard)
+	requireFirstByteFailover(t, c, rec, err)
+
+	c, rec = newFirstByteTestContext()
+	upstreamCtx, guard = startFirstByteGuard(c.Request.Context(), 20*time.Millisecond)
+	defer guard.release()
+	_, err = svc.handleGeminiStreamingResponse(c, newFirstByteBlockingResponse(upstreamCtx), account, time.Now(), guard)
+	requireFirstByteFailover(t, c, rec, err)
+}
+
+func TestCompatibleStreamingDefersMessageStartUntilFirstByte(t *testing.T) {
+	svc := &CompatibleGatewayService{cfg: &config.Config{Gateway: config.GatewayConfig{MaxLineSize: defaultMaxLineSize}}}
+	c, rec := newFirstByteTestContext()
+	_, guard := startFirstByteGuard(context.Background(), time.Minute)
+	defer guard.release()
+
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("data: {\"choices\":[{\"delta\":{\"content\":\"hi\"}}]}\n\ndata: [DONE]\n\n")),
+	}
+	result, err := svc.handleClaudeStreamingResponse(c, resp, &Account{ID: 1}, time.Now(), "model", guard)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	body := rec.Body.String()
+	require.Contains(t, body, "event: message_start")
+	require.Less(t, strings.Index(body, "message_start"), strings.Index(body, "hi"))
+	require.False(t, guard.pending())
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/geminicli"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/googleapi"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
 
@@ -51,6 +52,8 @@ type GeminiMessagesCompatService struct {
 	httpUpstream              HTTPUpstream
 	antigravityGatewayService *AntigravityGatewayService
 	cfg                       *config.Config
+	hotConfig                 // 可热更新的配置项通过 runtimeConfig 读取
+	scheduler                 *AccountScheduler
 }
 
 func NewGeminiMessagesCompatService(
@@ -63,6 +66,7 @@ func NewGeminiMessagesCompatService(
 	httpUpstream HTTPUpstream,
 	antigravityGatewayService *AntigravityGatewayService,
 	cfg *config.Config,
+	scheduler *AccountScheduler,
 ) *GeminiMessagesCompatService {
 	return &GeminiMessagesCompatService{
 		accountRepo:               accountRepo,
@@ -74,9 +78,15 @@ func NewGeminiMessagesCompatService(
 		httpUpstream:              httpUpstream,
 		antigravityGatewayService: antigravityGatewayService,
 		cfg:                       cfg,
+		scheduler:                 scheduler,
 	}
 }
 
+// runtimeConfig 返回当前配置快照（热更新后为最新发布的快照）
+func (s *GeminiMessagesCompatService) runtimeConfig() *config.Config {
+	return s.load(s.cfg)
+}
+
 // GetTokenProvider returns the token provider for OAuth accounts
 func (s *GeminiMessagesCompatService) GetTokenProvider() *GeminiTokenProvider {
 	return s.tokenProvider
@@ -116,9 +126,9 @@ func (s *GeminiMessagesCompatService) SelectAccountForModelWithExclusions(ctx co
 		}
 	}
 
-	// 4. 按优先级 + LRU 选择最佳账号
-	// Select best account by priority + LRU
-	selected := s.selectBestGeminiAccount(ctx, accounts, requestedModel, excludedIDs, platform, useMixedScheduling)
+	// 4. 按分组调度策略选择账号
+	// Select by the group's scheduling strategy
+	selected := s.selectBestGeminiAccount(ctx, groupID, accounts, requestedModel, excludedIDs, platform, useMixedScheduling)
 
 	if selected == nil {
 		if requestedModel != "" {
@@ -286,20 +296,21 @@ func (s *GeminiMessagesCompatService) passesRateLimitPreCheck(ctx context.Contex
 	return ok
 }
 
-// selectBestGeminiAccount 从候选账号中选择最佳账号（优先级 + LRU + OAuth 优先）。
+// selectBestGeminiAccount 过滤可用账号后按分组调度策略选择账号（OAuth 优先）。
 // 返回 nil 表示无可用账号。
 //
-// selectBestGeminiAccount selects best account from candidates (priority + LRU + OAuth preferred).
+// selectBestGeminiAccount filters usable candidates and picks one with the group's scheduling strategy (OAuth preferred).
 // Returns nil if no available account.
 func (s *GeminiMessagesCompatService) selectBestGeminiAccount(
 	ctx context.Context,
+	groupID *int64,
 	accounts []Account,
 	requestedModel string,
 	excludedIDs map[int64]struct{},
 	platform string,
 	useMixedScheduling bool,
 ) *Account {
-	var selected *Account
+	candidates := make([]*Account, 0, len(accounts))
 
 	for i := range accounts {
 		acc := &accounts[i]
@@ -314,49 +325,11 @@ func (s *GeminiMessagesCompatService) selectBestGeminiAccount(
 			continue
 		}
 
-		// 选择最佳账号
-		if selected == nil {
-			selected = acc
-			continue
-		}
-
-		if s.isBetterGeminiAccount(acc, selected) {
-			selected = acc
-		}
-	}
-
-	return selected
-}
-
-// isBetterGeminiAccount 判断 candidate 是否比 current 更优。
-// 规则：优先级更高（数值更小）优先；同优先级时，未使用过的优先（OAuth > 非 OAuth），其次是最久未使用的。
-//
-// isBetterGeminiAccount checks if candidate is better than current.
-// Rules: higher priority (lower value) wins; same priority: never used (OAuth > non-OAuth) > least recently used.
-func (s *GeminiMessagesCompatService) isBetterGeminiAccount(candidate, current *Account) bool {
-	// 优先级更高（数值更小）
-	if candidate.Priority < current.Priority {
-		return true
-	}
-	if candidate.Priority > current.Priority {
-		return false
+		candidates = append(candidates, acc)
 	}
 
-	// 同优先级，比较最后使用时间
-	switch {
-	case candidate.LastUsedAt == nil && current.LastUsedAt != nil:
-		// candidate 从未使用，优先
-		return true
-	case candidate.LastUsedAt != nil && current.LastUsedAt == nil:
-		// current 从未使用，保持
-		return false
-	case candidate.LastUsedAt == nil && current.LastUsedAt == nil:
-		// 都未使用，优先选择 OAuth 账号（更兼容 Code Assist 流程）
-		return candidate.Type == AccountTypeOAuth && current.Type != AccountTypeOAuth
-	default:
-		// 都使用过，选择最久未使用的
-		return candidate.LastUsedAt.Before(*current.LastUsedAt)
-	}
+	// 未使用过的账号中优先 OAuth（更兼容 Code Assist 流程）
+	return s.scheduler.SelectAccount(ctx, groupID, candidates, requestedModel, true)
 }
 
 // isModelSupportedByAccount 根据账户平台检查模型支持
@@ -376,13 +349,26 @@ func (s *GeminiMessagesCompatService) GetAntigravityGatewayService() *Antigravit
 }
 
 func (s *GeminiMessagesCompatService) getSchedulableAccount(ctx context.Context, accountID int64) (*Account, error) {
+	// 粘性会话绑定的账号不在路由规则限定的子集内时视为不可用
+	if !isRoutedAccountAllowed(ctx, accountID) {
+		return nil, ErrAccountNotFound
+	}
 	if s.schedulerSnapshot != nil {
 		return s.schedulerSnapshot.GetAccount(ctx, accountID)
 	}
 	return s.accountRepo.GetByID(ctx, accountID)
 }
 
+// listSchedulableAccountsOnce 查询可调度账号并限定到路由规则的账号子集
 func (s *GeminiMessagesCompatService) listSchedulableAccountsOnce(ctx context.Context, groupID *int64, platform string, hasForcePlatform bool) ([]Account, error) {
+	accounts, err := s.listAllSchedulableAccounts(ctx, groupID, platform, hasForcePlatform)
+	if err != nil {
+		return nil, err
+	}
+	return filterRoutedAccounts(ctx, groupID, accounts), nil
+}
+
+func (s *GeminiMessagesCompatService) listAllSchedulableAccounts(ctx context.Context, groupID *int64, platform string, hasForcePlatform bool) ([]Account, error) {
 	if s.schedulerSnapshot != nil {
 		accounts, _, err := s.schedulerSnapshot.ListSchedulableAccounts(ctx, groupID, platform, hasForcePlatform)
 		return accounts, err
@@ -513,6 +499,9 @@ func (s *GeminiMessagesCompatService) SelectAccountForAIStudioEndpoints(ctx cont
 }
 
 func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Context, account *Account, body []byte) (*ForwardResult, error) {
+	ctx, span := tracing.Start(ctx, "gateway.forward", tracing.AttrAccountID.Int64(account.ID), tracing.AttrPlatform.String(account.Platform))
+	defer span.End()
+
 	startTime := time.Now()
 
 	var req struct {
@@ -663,11 +652,18 @@ func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Contex
 		return nil, fmt.Errorf("unsupported account type: %s", account.Type)
 	}
 
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.runtimeConfig(), req.Stream)
+	defer firstByte.release()
+
 	var resp *http.Response
 	signatureRetryStage := 0
 	for attempt := 1; attempt <= geminiMaxRetries; attempt++ {
-		upstreamReq, idHeader, err := buildReq(ctx)
+		upstreamReq, idHeader, err := buildReq(upstreamCtx)
 		if err != nil {
+			if firstByte.expired() {
+				return nil, firstByte.failover(c, account)
+			}
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return nil, err
 			}
@@ -687,6 +683,9 @@ func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Contex
 
 		resp, err = s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
 		if err != nil {
+			if firstByte.expired() {
+				return nil, firstByte.failover(c, account)
+			}
 			safeErr := sanitizeUpstreamErrorMessage(err.Error())
 			appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
 				Platform:           account.Platform,
@@ -956,7 +955,7 @@ func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Contex
 	var usage *ClaudeUsage
 	var firstTokenMs *int
 	if req.Stream {
-		streamRes, err := s.handleStreamingResponse(c, resp, startTime, originalModel)
+		streamRes, err := s.handleStreamingResponse(c, resp, account, startTime, originalModel, firstByte)
 		if err != nil {
 			return nil, err
 		}
@@ -1010,6 +1009,9 @@ func isGeminiSignatureRelatedError(respBody []byte) bool {
 }
 
 func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.Context, account *Account, originalModel string, action string, stream bool, body []byte) (*ForwardResult, error) {
+	ctx, span := tracing.Start(ctx, "gateway.forward", tracing.AttrAccountID.Int64(account.ID), tracing.AttrPlatform.String(account.Platform))
+	defer span.End()
+
 	startTime := time.Now()
 
 	if strings.TrimSpace(originalModel) == "" {
@@ -1162,10 +1164,17 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 		return nil, s.writeGoogleError(c, http.StatusBadGateway, "Unsupported account type: "+account.Type)
 	}
 
+	// 流式请求首字节预算：预算内未收到上游数据时取消本次尝试并切换账号
+	upstreamCtx, firstByte := newFirstByteGuard(ctx, c, s.runtimeConfig(), stream)
+	defer firstByte.release()
+
 	var resp *http.Response
 	for attempt := 1; attempt <= geminiMaxRetries; attempt++ {
-		upstreamReq, idHeader, err := buildReq(ctx)
+		upstreamReq, idHeader, err := buildReq(upstreamCtx)
 		if err != nil {
+			if firstByte.expired() {
+				return nil, firstByte.failover(c, account)
+			}
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return nil, err
 			}
@@ -1185,6 +1194,9 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 
 		resp, err = s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
 		if err != nil {
+			if firstByte.expired() {
+				return nil, firstByte.failover(c, account)
+			}
 			safeErr := sanitizeUpstreamErrorMessage(err.Error())
 			appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
 				Platform:           account.Platform,
@@ -1453,7 +1465,7 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 	var firstTokenMs *int
 
 	if stream {
-		streamRes, err := s.handleNativeStreamingResponse(c, resp, startTime, isOAuth)
+		streamRes, err := s.handleNativeStreamingResponse(c, resp, account, startTime, isOAuth, firstByte)
 		if err != nil {
 			return nil, err
 		}
@@ -1832,7 +1844,7 @@ func (s *GeminiMessagesCompatService) handleNonStreamingResponse(c *gin.Context,
 	return usage, nil
 }
 
-func (s *GeminiMessagesCompatService) handleStreamingResponse(c *gin.Context, resp *http.Response, startTime time.Time, originalModel string) (*geminiStreamResult, error) {
+func (s *GeminiMessagesCompatService) handleStreamingResponse(c *gin.Context, resp *http.Response, account *Account, startTime time.Time, originalModel string, firstByte *firstByteGuard) (*geminiStreamResult, error) {
 	c.Header("Content-Type", "text/event-stream")
 	c.Header("Cache-Control", "no-cache")
 	c.Header("Connection", "keep-alive")
@@ -1861,8 +1873,18 @@ func (s *GeminiMessagesCompatService) handleStreamingResponse(c *gin.Context, re
 			},
 		},
 	}
-	writeSSE(c.Writer, "message_start", messageStart)
-	flusher.Flush()
+	// 首字节预算内不提前输出 message_start，保留切换账号的机会
+	started := false
+	startMessage := func() {
+		if !started {
+			started = true
+			writeSSE(c.Writer, "message_start", messageStart)
+			flusher.Flush()
+		}
+	}
+	if !firstByte.pending() {
+		startMessage()
+	}
 
 	var firstTokenMs *int
 	var usage ClaudeUsage
@@ -1882,8 +1904,15 @@ func (s *GeminiMessagesCompatService) handleStreamingResponse(c *gin.Context, re
 	for {
 		line, err := reader.ReadString('\n')
 		if err != nil && !errors.Is(err, io.EOF) {
+			if firstByte.expired() {
+				return nil, firstByte.failover(c, account)
+			}
 			return nil, fmt.Errorf("stream read error: %w", err)
 		}
+		if line != "" {
+			firstByte.markReceived()
+			startMessage()
+		}
 
 		if !strings.HasPrefix(line, "data:") {
 			if errors.Is(err, io.EOF) {
@@ -2040,6 +2069,7 @@ func (s *GeminiMessagesCompatService) handleStreamingResponse(c *gin.Context, re
 		}
 	}
 
+	startMessage()
 	if openBlockIndex >= 0 {
 		writeSSE(c.Writer, "content_block_stop", map[string]any{
 			"type":  "content_block_stop",
@@ -2412,7 +2442,7 @@ func (s *GeminiMessagesCompatService) handleNativeNonStreamingResponse(c *gin.Co
 	return &ClaudeUsage{}, nil
 }
 
-func (s *GeminiMessagesCompatService) handleNativeStreamingResponse(c *gin.Context, resp *http.Response, startTime time.Time, isOAuth bool) (*geminiNativeStreamResult, error) {
+func (s *GeminiMessagesCompatService) handleNativeStreamingResponse(c *gin.Context, resp *http.Response, account *Account, startTime time.Time, isOAuth bool, firstByte *firstByteGuard) (*geminiNativeStreamResult, error) {
 	// Log response headers for debugging
 	log.Printf("[GeminiAPI] ========== Streaming Response Headers ==========")
 	for key, values := range resp.Header {
@@ -2449,6 +2479,7 @@ func (s *GeminiMessagesCompatService) handleNativeStreamingResponse(c *gin.Conte
 	for {
 		line, err := reader.ReadString('\n')
 		if len(line) > 0 {
+			firstByte.markReceived()
 			trimmed := strings.TrimRight(line, "\r\n")
 			if strings.HasPrefix(trimmed, "data:") {
 				payload := strings.TrimSpace(strings.TrimPrefix(trimmed, "data:"))
@@ -2503,6 +2534,9 @@ func (s *GeminiMessagesCompatService) handleNativeStreamingResponse(c *gin.Conte
 			break
 		}
 		if err != nil {
+			if firstByte.expired() {
+				return nil, firstByte.failover(c, account)
+			}
 			return nil, err
 		}
 	}
@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 )
 
 const (
@@ -37,6 +39,10 @@ func (p *GeminiTokenProvider) GetAccessToken(ctx context.Context, account *Accou
 	if account == nil {
 		return "", errors.New("account is nil")
 	}
+
+	ctx, span := tracing.Start(ctx, "token_provider.get_access_token", tracing.AttrAccountID.Int64(account.ID), tracing.AttrPlatform.String(account.Platform))
+	defer span.End()
+
 	if account.Platform != PlatformGemini || account.Type != AccountTypeOAuth {
 		return "", errors.New("not a gemini oauth account")
 	}
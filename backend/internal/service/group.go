@@ -38,6 +38,9 @@ type Group struct {
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled bool
 
+	// 请求路由规则限定的账号子集，仅存在于请求上下文中的分组副本，不持久化
+	RoutedAccountIDs []int64
+
 	// MCP XML 协议注入开关（仅 antigravity 平台使用）
 	MCPXMLInject bool
 
@@ -45,6 +48,18 @@ type Group struct {
 	// 可选值: claude, gemini_text, gemini_image
 	SupportedModelScopes []string
 
+	// 模型降级链：请求模型无法被服务时按顺序切换到备选模型
+	ModelFallbacks []ModelFallbackRule
+
+	// 模型倍率：模型匹配模式 -> 倍率，在分组/用户倍率之上叠加
+	ModelRateMultipliers map[string]float64
+
+	// 账号调度策略，空表示使用全局默认策略
+	SchedulingStrategy string
+
+	// 流式请求首字节预算（秒），0 表示使用全局配置
+	FirstByteTimeoutSeconds int
+
 	// 分组排序
 	SortOrder int
 
@@ -134,6 +149,27 @@ func (g *Group) GetRoutingAccountIDs(requestedModel string) []int64 {
 	return nil
 }
 
+// GetModelRateMultiplier 获取模型在分组内的额外倍率
+// 精确匹配优先，其次选择前缀最长的通配规则；未配置时返回 1
+func (g *Group) GetModelRateMultiplier(model string) float64 {
+	if g == nil || len(g.ModelRateMultipliers) == 0 || model == "" {
+		return 1.0
+	}
+	if rate, ok := g.ModelRateMultipliers[model]; ok {
+		return rate
+	}
+	rate, bestLen := 1.0, -1
+	for pattern, r := range g.ModelRateMultipliers {
+		if !strings.HasSuffix(pattern, "*") || !matchModelPattern(pattern, model) {
+			continue
+		}
+		if len(pattern) > bestLen {
+			rate, bestLen = r, len(pattern)
+		}
+	}
+	return rate
+}
+
 // matchModelPattern 检查模型是否匹配模式
 // 支持 * 通配符，如 "claude-opus-*" 匹配 "claude-opus-4-20250514"
 func matchModelPattern(pattern, model string) bool {
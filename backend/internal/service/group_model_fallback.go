@@ -0,0 +1,93 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+)
+
+type ModelFallbackRule = domain.ModelFallbackRule
+
+const (
+	ModelFallbackTriggerNoAccount      = domain.ModelFallbackTriggerNoAccount
+	ModelFallbackTriggerRateLimited    = domain.ModelFallbackTriggerRateLimited
+	ModelFallbackTriggerOverloaded     = domain.ModelFallbackTriggerOverloaded
+	ModelFallbackTriggerContextTooLong = domain.ModelFallbackTriggerContextTooLong
+)
+
+// GetModelFallbackRule 根据请求模型查找降级链规则
+// 精确匹配优先，其次选择前缀最长的通配规则；未命中返回 nil
+func (g *Group) GetModelFallbackRule(requestedModel string) *ModelFallbackRule {
+	if g == nil || len(g.ModelFallbacks) == 0 || requestedModel == "" {
+		return nil
+	}
+	var best *ModelFallbackRule
+	bestLen := -1
+	for i := range g.ModelFallbacks {
+		rule := &g.ModelFallbacks[i]
+		if len(rule.Fallbacks) == 0 {
+			continue
+		}
+		if rule.Model == requestedModel {
+			return rule
+		}
+		if !strings.HasSuffix(rule.Model, "*") || !matchModelPattern(rule.Model, requestedModel) {
+			continue
+		}
+		if l := len(rule.Model); l > bestLen {
+			best, bestLen = rule, l
+		}
+	}
+	return best
+}
+
+// ModelFallbackChain 单次请求的模型降级状态
+// 记录原始请求模型与已尝试的备选位置，保证每个备选模型最多尝试一次
+type ModelFallbackChain struct {
+	rule           *ModelFallbackRule
+	requestedModel string
+	next           int
+}
+
+// NewModelFallbackChain 为请求模型创建降级状态；分组未配置对应规则时返回 nil
+func NewModelFallbackChain(group *Group, requestedModel string) *ModelFallbackChain {
+	rule := group.GetModelFallbackRule(requestedModel)
+	if rule == nil {
+		return nil
+	}
+	return &ModelFallbackChain{rule: rule, requestedModel: requestedModel}
+}
+
+// RequestedModel 返回客户端原始请求的模型
+func (c *ModelFallbackChain) RequestedModel() string {
+	if c == nil {
+		return ""
+	}
+	return c.requestedModel
+}
+
+// CanFallback 判断指定触发条件下是否还有可用的备选模型
+func (c *ModelFallbackChain) CanFallback(trigger string) bool {
+	return c != nil && trigger != "" && c.next < len(c.rule.Fallbacks) && c.rule.HasTrigger(trigger)
+}
+
+// Next 按触发条件取出下一个备选模型；无可用备选时返回 false
+func (c *ModelFallbackChain) Next(trigger string) (string, bool) {
+	if !c.CanFallback(trigger) {
+		return "", false
+	}
+	model := c.rule.Fallbacks[c.next]
+	c.next++
+	return model, true
+}
+
+// ModelFallbackTriggerForStatus 将上游故障转移状态码映射为降级触发条件
+func ModelFallbackTriggerForStatus(statusCode int) string {
+	switch statusCode {
+	case 429:
+		return ModelFallbackTriggerRateLimited
+	case 529:
+		return ModelFallbackTriggerOverloaded
+	}
+	return ""
+}
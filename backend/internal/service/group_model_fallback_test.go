@@ -0,0 +1,93 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupGetModelFallbackRule(t *testing.T) {
+	group := &Group{ModelFallbacks: []ModelFallbackRule{
+		{Model: "claude-*", Fallbacks: []string{"claude-haiku-4-5"}},
+		{Model: "claude-opus-*", Fallbacks: []string{"claude-sonnet-4-5"}},
+		{Model: "claude-opus-4-5", Fallbacks: []string{"claude-opus-4-1"}},
+	}}
+
+	require.Equal(t, []string{"claude-opus-4-1"}, group.GetModelFallbackRule("claude-opus-4-5").Fallbacks, "精确匹配优先")
+	require.Equal(t, []string{"claude-sonnet-4-5"}, group.GetModelFallbackRule("claude-opus-4-1").Fallbacks, "最长通配优先")
+	require.Equal(t, []string{"claude-haiku-4-5"}, group.GetModelFallbackRule("claude-sonnet-4-5").Fallbacks)
+	require.Nil(t, group.GetModelFallbackRule("gpt-4o"))
+
+	var nilGroup *Group
+	require.Nil(t, nilGroup.GetModelFallbackRule("claude-opus-4-5"))
+}
+
+func TestModelFallbackChain(t *testing.T) {
+	group := &Group{ModelFallbacks: []ModelFallbackRule{{
+		Model:     "claude-opus-4-5",
+		Fallbacks: []string{"claude-sonnet-4-5", "claude-haiku-4-5"},
+		Triggers:  []string{ModelFallbackTriggerRateLimited, ModelFallbackTriggerNoAccount},
+	}}}
+
+	chain := NewModelFallbackChain(group, "claude-opus-4-5")
+	require.NotNil(t, chain)
+	require.Equal(t, "claude-opus-4-5", chain.RequestedModel())
+
+	_, ok := chain.Next(ModelFallbackTriggerOverloaded)
+	require.False(t, ok, "未配置的触发条件不降级")
+	_, ok = chain.Next("")
+	require.False(t, ok)
+
+	model, ok := chain.Next(ModelFallbackTriggerRateLimited)
+	require.True(t, ok)
+	require.Equal(t, "claude-sonnet-4-5", model)
+	model, ok = chain.Next(ModelFallbackTriggerNoAccount)
+	require.True(t, ok)
+	require.Equal(t, "claude-haiku-4-5", model)
+	_, ok = chain.Next(ModelFallbackTriggerNoAccount)
+	require.False(t, ok, "降级链用尽")
+
+	require.Nil(t, NewModelFallbackChain(group, "claude-sonnet-4-5"))
+	require.False(t, (*ModelFallbackChain)(nil).CanFallback(ModelFallbackTriggerNoAccount))
+}
+
+func TestModelFallbackTriggerForStatus(t *testing.T) {
+	require.Equal(t, ModelFallbackTriggerRateLimited, ModelFallbackTriggerForStatus(429))
+	require.Equal(t, ModelFallbackTriggerOverloaded, ModelFallbackTriggerForStatus(529))
+	require.Empty(t, ModelFallbackTriggerForStatus(500))
+}
+
+func TestNormalizeModelFallbackRules(t *testing.T) {
+	rules, err := domain.NormalizeModelFallbackRules([]ModelFallbackRule{{
+		Model:     " claude-opus-* ",
+		Fallbacks: []string{" claude-sonnet-4-5", ""},
+		Triggers:  []string{" rate_limited "},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []ModelFallbackRule{{
+		Model:     "claude-opus-*",
+		Fallbacks: []string{"claude-sonnet-4-5"},
+		Triggers:  []string{ModelFallbackTriggerRateLimited},
+	}}, rules)
+
+	rules, err = domain.NormalizeModelFallbackRules(nil)
+	require.NoError(t, err)
+	require.NotNil(t, rules)
+
+	invalid := [][]ModelFallbackRule{
+		{{Model: "", Fallbacks: []string{"a"}}},
+		{{Model: "a", Fallbacks: []string{"b"}}, {Model: "a", Fallbacks: []string{"c"}}},
+		{{Model: "a", Fallbacks: nil}},
+		{{Model: "a", Fallbacks: []string{"a"}}},
+		{{Model: "a", Fallbacks: []string{"b-*"}}},
+		{{Model: "a", Fallbacks: []string{"b", "c", "d", "e", "f", "g"}}},
+		{{Model: "a", Fallbacks: []string{"b"}, Triggers: []string{"timeout"}}},
+	}
+	for _, in := range invalid {
+		_, err := domain.NormalizeModelFallbackRules(in)
+		require.Error(t, err)
+	}
+}
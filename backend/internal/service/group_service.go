@@ -11,6 +11,8 @@ import (
 var (
 	ErrGroupNotFound = infraerrors.NotFound("GROUP_NOT_FOUND", "group not found")
 	ErrGroupExists   = infraerrors.Conflict("GROUP_EXISTS", "group name already exists")
+
+	ErrFirstByteTimeoutInvalid = infraerrors.BadRequest("FIRST_BYTE_TIMEOUT_INVALID", "first byte timeout must be between 0 and 600 seconds")
 )
 
 type GroupRepository interface {
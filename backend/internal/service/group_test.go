@@ -5,6 +5,7 @@ package service
 import (
 	"testing"
 
+	"github.com/Wei-Shaw/sub2api/internal/domain"
 	"github.com/stretchr/testify/require"
 )
 
@@ -90,3 +91,44 @@ func TestGroup_GetImagePrice_PartialConfig(t *testing.T) {
 	require.Nil(t, group.GetImagePrice("2K"))
 	require.Nil(t, group.GetImagePrice("4K"))
 }
+
+// TestGroup_GetModelRateMultiplier 测试分组内模型倍率匹配
+func TestGroup_GetModelRateMultiplier(t *testing.T) {
+	group := &Group{ModelRateMultipliers: map[string]float64{
+		"claude-*":         1.5,
+		"claude-opus-*":    3,
+		"claude-haiku-4-5": 0.5,
+		"gemini-*":         0,
+	}}
+
+	require.InDelta(t, 0.5, group.GetModelRateMultiplier("claude-haiku-4-5"), 1e-9, "精确匹配优先")
+	require.InDelta(t, 3.0, group.GetModelRateMultiplier("claude-opus-4-5"), 1e-9, "最长通配优先")
+	require.InDelta(t, 1.5, group.GetModelRateMultiplier("claude-sonnet-4-5"), 1e-9)
+	require.InDelta(t, 0.0, group.GetModelRateMultiplier("gemini-2.5-pro"), 1e-9, "允许配置为免费")
+	require.InDelta(t, 1.0, group.GetModelRateMultiplier("gpt-4o"), 1e-9, "未命中返回 1")
+
+	var nilGroup *Group
+	require.InDelta(t, 1.0, nilGroup.GetModelRateMultiplier("claude-opus-4-5"), 1e-9)
+}
+
+// TestNormalizeModelRateMultipliers 测试模型倍率配置校验
+func TestNormalizeModelRateMultipliers(t *testing.T) {
+	out, err := domain.NormalizeModelRateMultipliers(map[string]float64{" claude-opus-* ": 2, "claude-haiku-4-5": 0})
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{"claude-opus-*": 2, "claude-haiku-4-5": 0}, out)
+
+	out, err = domain.NormalizeModelRateMultipliers(nil)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+
+	invalid := []map[string]float64{
+		{"": 1},
+		{"claude-*-opus": 1},
+		{"claude-opus-*": -1},
+		{"claude-opus-*": 1, " claude-opus-* ": 2},
+	}
+	for _, in := range invalid {
+		_, err := domain.NormalizeModelRateMultipliers(in)
+		require.Error(t, err)
+	}
+}
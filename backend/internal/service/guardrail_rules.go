@@ -0,0 +1,250 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// compiledGuardrailRule 预编译后的护栏规则
+type compiledGuardrailRule struct {
+	rule     *model.GuardrailRule
+	matchers []guardrailMatcher
+}
+
+// guardrailMatcher 单个匹配项：label 用于命中记录，replacement 为脱敏占位符
+type guardrailMatcher struct {
+	label       string
+	re          *regexp.Regexp
+	validate    func(match string) bool // 可选的二次校验（如银行卡 Luhn 校验）
+	replacement string
+}
+
+// guardrailSpan 文本中需要脱敏的区间
+type guardrailSpan struct {
+	start, end  int
+	replacement string
+}
+
+// guardrailText 请求体中的一段待扫描文本
+type guardrailText struct {
+	path  string
+	value string
+}
+
+const guardrailRedactedPlaceholder = "[REDACTED]"
+
+// piiDetectors 内置 PII 检测器
+var piiDetectors = map[string]guardrailMatcher{
+	model.PIIEmail: {
+		re: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+	},
+	model.PIIPhone: {
+		// 中国大陆手机号 / 北美号码（要求分隔符，降低误报）
+		re: regexp.MustCompile(`(?:\+86[- ]?|\b)1[3-9]\d{9}\b|(?:\+1[-. ]?)?\(?\b[2-9]\d{2}\)?[-. ]\d{3}[-. ]\d{4}\b`),
+	},
+	model.PIICard: {
+		re:       regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		validate: luhnValid,
+	},
+	model.PIIAPIKey: {
+		// OpenAI/Anthropic、AWS、GitHub、Google、Slack 等常见密钥格式
+		re: regexp.MustCompile(`\b(?:sk-[A-Za-z0-9_\-]{20,}|AKIA[0-9A-Z]{16}|ghp_[A-Za-z0-9]{36}|AIza[0-9A-Za-z_\-]{35}|xox[abpr]-[A-Za-z0-9\-]{10,})`),
+	},
+}
+
+// guardrailTextKeys 扫描时下钻的字段：覆盖 system/instructions、messages/input、
+// Gemini 的 systemInstruction/contents/parts、内容块文本以及工具结果
+// （Anthropic tool_result.content、OpenAI function_call_output.output、Gemini functionResponse.response）
+var guardrailTextKeys = map[string]struct{}{
+	"system":            {},
+	"instructions":      {},
+	"messages":          {},
+	"input":             {},
+	"prompt":            {},
+	"content":           {},
+	"text":              {},
+	"output":            {},
+	"systemInstruction": {},
+	"contents":          {},
+	"parts":             {},
+	"functionResponse":  {},
+	"response":          {},
+}
+
+func compileGuardrailRule(rule *model.GuardrailRule) (*compiledGuardrailRule, error) {
+	compiled := &compiledGuardrailRule{rule: rule}
+	for _, pattern := range rule.Patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		switch rule.MatchType {
+		case model.GuardrailMatchKeyword:
+			compiled.matchers = append(compiled.matchers, guardrailMatcher{
+				label:       pattern,
+				re:          regexp.MustCompile(`(?i)` + regexp.QuoteMeta(pattern)),
+				replacement: guardrailRedactedPlaceholder,
+			})
+		case model.GuardrailMatchRegex:
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex %q: %w", pattern, err)
+			}
+			compiled.matchers = append(compiled.matchers, guardrailMatcher{
+				label:       pattern,
+				re:          re,
+				replacement: guardrailRedactedPlaceholder,
+			})
+		case model.GuardrailMatchPII:
+			detector, ok := piiDetectors[pattern]
+			if !ok {
+				return nil, fmt.Errorf("unsupported pii type %q", pattern)
+			}
+			detector.label = pattern
+			detector.replacement = "[REDACTED_" + strings.ToUpper(pattern) + "]"
+			compiled.matchers = append(compiled.matchers, detector)
+		default:
+			return nil, fmt.Errorf("unsupported match type %q", rule.MatchType)
+		}
+	}
+	return compiled, nil
+}
+
+func (c *compiledGuardrailRule) appliesToGroup(groupID int64) bool {
+	if len(c.rule.GroupIDs) == 0 {
+		return true
+	}
+	for _, id := range c.rule.GroupIDs {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m guardrailMatcher) find(text string) []guardrailSpan {
+	locs := m.re.FindAllStringIndex(text, -1)
+	if len(locs) == 0 {
+		return nil
+	}
+	spans := make([]guardrailSpan, 0, len(locs))
+	for _, loc := range locs {
+		if loc[0] == loc[1] {
+			continue
+		}
+		if m.validate != nil && !m.validate(text[loc[0]:loc[1]]) {
+			continue
+		}
+		spans = append(spans, guardrailSpan{start: loc[0], end: loc[1], replacement: m.replacement})
+	}
+	return spans
+}
+
+// luhnValid 校验银行卡号（13-19 位数字，允许空格/短横线分隔）
+func luhnValid(value string) bool {
+	digits := make([]int, 0, len(value))
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// collectGuardrailTexts 收集请求体中需要扫描的文本及其 JSON 路径
+func collectGuardrailTexts(body []byte) []guardrailText {
+	root := gjson.ParseBytes(body)
+	if !root.IsObject() {
+		return nil
+	}
+	var out []guardrailText
+	walkGuardrailTexts(root, "", &out)
+	return out
+}
+
+func walkGuardrailTexts(value gjson.Result, path string, out *[]guardrailText) {
+	switch {
+	case value.Type == gjson.String:
+		if path != "" && value.Str != "" {
+			*out = append(*out, guardrailText{path: path, value: value.Str})
+		}
+	case value.IsArray():
+		for i, item := range value.Array() {
+			walkGuardrailTexts(item, joinGuardrailPath(path, fmt.Sprint(i)), out)
+		}
+	case value.IsObject():
+		value.ForEach(func(key, item gjson.Result) bool {
+			if _, ok := guardrailTextKeys[key.String()]; ok {
+				walkGuardrailTexts(item, joinGuardrailPath(path, key.String()), out)
+			}
+			return true
+		})
+	}
+}
+
+func joinGuardrailPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// applyGuardrailSpans 按区间替换文本并写回请求体；没有需要替换的区间或写回失败时返回 nil
+func applyGuardrailSpans(body []byte, texts []guardrailText, spans [][]guardrailSpan) []byte {
+	out := body
+	changed := false
+	for i, text := range texts {
+		if len(spans[i]) == 0 {
+			continue
+		}
+		var err error
+		if out, err = sjson.SetBytes(out, text.path, redactGuardrailSpans(text.value, spans[i])); err != nil {
+			return nil
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return out
+}
+
+// redactGuardrailSpans 替换文本中的命中区间，重叠区间以先出现者为准
+func redactGuardrailSpans(text string, spans []guardrailSpan) string {
+	sorted := append([]guardrailSpan(nil), spans...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].start < sorted[j].start
+	})
+	var b strings.Builder
+	pos := 0
+	for _, span := range sorted {
+		if span.start < pos {
+			continue
+		}
+		b.WriteString(text[pos:span.start])
+		b.WriteString(span.replacement)
+		pos = span.end
+	}
+	b.WriteString(text[pos:])
+	return b.String()
+}
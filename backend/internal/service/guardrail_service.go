@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// GuardrailRuleRepository 定义内容护栏规则的数据访问接口
+type GuardrailRuleRepository interface {
+	// List 获取所有规则
+	List(ctx context.Context) ([]*model.GuardrailRule, error)
+	// GetByID 根据 ID 获取规则
+	GetByID(ctx context.Context, id int64) (*model.GuardrailRule, error)
+	// Create 创建规则
+	Create(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error)
+	// Update 更新规则
+	Update(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error)
+	// Delete 删除规则
+	Delete(ctx context.Context, id int64) error
+}
+
+// GuardrailRuleCache 定义内容护栏规则的缓存接口
+type GuardrailRuleCache interface {
+	// Get 从缓存获取规则列表
+	Get(ctx context.Context) ([]*model.GuardrailRule, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, rules []*model.GuardrailRule) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// GuardrailService 分组内容护栏服务
+type GuardrailService struct {
+	repo GuardrailRuleRepository
+
+	// 本地快照（预编译匹配器，按优先级排序）
+	rules *ruleSnapshot[model.GuardrailRule, compiledGuardrailRule]
+}
+
+// GuardrailBlockedError 请求命中 block 规则
+type GuardrailBlockedError struct {
+	RuleID   int64
+	RuleName string
+	Message  string
+}
+
+func (e *GuardrailBlockedError) Error() string {
+	return e.Message
+}
+
+// GuardrailHit 单条规则单个匹配项的命中记录（不包含命中的原文，避免敏感信息外泄）
+type GuardrailHit struct {
+	RuleID    int64
+	Name      string
+	MatchType string
+	Action    string
+	Label     string // 关键词、正则或 PII 类型
+	Count     int    // 命中次数
+}
+
+// GuardrailResult 内容护栏扫描结果
+type GuardrailResult struct {
+	Body     []byte // 执行 mask 后的请求体（未改写时为原始请求体）
+	Modified bool   // 请求体是否被脱敏改写
+	Hits     []GuardrailHit
+	// RedactedBody 将所有命中内容脱敏后的请求体，用于写入运维日志；无命中时为 nil
+	RedactedBody []byte
+}
+
+// NewGuardrailService 创建内容护栏规则服务
+func NewGuardrailService(
+	repo GuardrailRuleRepository,
+	cache GuardrailRuleCache,
+) *GuardrailService {
+	return &GuardrailService{
+		repo:  repo,
+		rules: newRuleSnapshot("GuardrailService", ruleLister[model.GuardrailRule](repo), ruleListCache[model.GuardrailRule](cache), compileGuardrailRules),
+	}
+}
+
+// List 获取所有规则
+func (s *GuardrailService) List(ctx context.Context) ([]*model.GuardrailRule, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取规则
+func (s *GuardrailService) GetByID(ctx context.Context, id int64) (*model.GuardrailRule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建规则
+func (s *GuardrailService) Create(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return created, nil
+}
+
+// Update 更新规则
+func (s *GuardrailService) Update(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return updated, nil
+}
+
+// Delete 删除规则
+func (s *GuardrailService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return nil
+}
+
+// Scan 在转发前扫描请求文本并执行分组内容护栏规则
+//
+// 扫描 system、messages/input 以及工具结果中的文本：mask 规则将命中内容替换为占位符，
+// flag 规则仅记录命中；任一 block 规则命中时返回 *GuardrailBlockedError。
+// 命中记录只包含规则与匹配项，RedactedBody 供运维日志使用，避免原文落库。
+func (s *GuardrailService) Scan(groupID int64, body []byte) (*GuardrailResult, error) {
+	result := &GuardrailResult{Body: body}
+	if s == nil {
+		return result, nil
+	}
+	rules := s.rules.items()
+	if len(rules) == 0 {
+		return result, nil
+	}
+	texts := collectGuardrailTexts(body)
+	if len(texts) == 0 {
+		return result, nil
+	}
+
+	maskSpans := make([][]guardrailSpan, len(texts))
+	allSpans := make([][]guardrailSpan, len(texts))
+	var blocked *compiledGuardrailRule
+	for _, compiled := range rules {
+		if !compiled.appliesToGroup(groupID) {
+			continue
+		}
+		for _, m := range compiled.matchers {
+			count := 0
+			for i, text := range texts {
+				spans := m.find(text.value)
+				if len(spans) == 0 {
+					continue
+				}
+				count += len(spans)
+				allSpans[i] = append(allSpans[i], spans...)
+				if compiled.rule.Action == model.GuardrailActionMask {
+					maskSpans[i] = append(maskSpans[i], spans...)
+				}
+			}
+			if count == 0 {
+				continue
+			}
+			result.Hits = append(result.Hits, GuardrailHit{
+				RuleID:    compiled.rule.ID,
+				Name:      compiled.rule.Name,
+				MatchType: compiled.rule.MatchType,
+				Action:    compiled.rule.Action,
+				Label:     m.label,
+				Count:     count,
+			})
+			if compiled.rule.Action == model.GuardrailActionBlock && blocked == nil {
+				blocked = compiled
+			}
+		}
+	}
+	if len(result.Hits) == 0 {
+		return result, nil
+	}
+
+	result.RedactedBody = applyGuardrailSpans(body, texts, allSpans)
+	if blocked != nil {
+		log.Printf("[Guardrail] blocked: group=%d rule=%d(%s)", groupID, blocked.rule.ID, blocked.rule.Name)
+		return result, &GuardrailBlockedError{
+			RuleID:   blocked.rule.ID,
+			RuleName: blocked.rule.Name,
+			Message:  guardrailBlockMessage(blocked.rule),
+		}
+	}
+
+	if masked := applyGuardrailSpans(body, texts, maskSpans); masked != nil {
+		result.Body = masked
+		result.Modified = true
+	}
+	for _, hit := range result.Hits {
+		log.Printf("[Guardrail] %s: group=%d rule=%d(%s) match=%s count=%d", hit.Action, groupID, hit.RuleID, hit.Name, hit.Label, hit.Count)
+	}
+	return result, nil
+}
+
+func guardrailBlockMessage(rule *model.GuardrailRule) string {
+	if rule.BlockMessage != nil && strings.TrimSpace(*rule.BlockMessage) != "" {
+		return *rule.BlockMessage
+	}
+	return fmt.Sprintf("Request blocked by content guardrail: %s", rule.Name)
+}
+
+// compileGuardrailRules 只保留启用的规则，预编译匹配器并按优先级排序
+func compileGuardrailRules(rules []*model.GuardrailRule) []*compiledGuardrailRule {
+	cached := make([]*compiledGuardrailRule, 0, len(rules))
+	for _, r := range rules {
+		if r == nil || !r.Enabled {
+			continue
+		}
+		compiled, err := compileGuardrailRule(r)
+		if err != nil {
+			log.Printf("[GuardrailService] Skip rule %d(%s): %v", r.ID, r.Name, err)
+			continue
+		}
+		cached = append(cached, compiled)
+	}
+	sort.SliceStable(cached, func(i, j int) bool {
+		return cached[i].rule.Priority < cached[j].rule.Priority
+	})
+	return cached
+}
@@ -0,0 +1,136 @@
+//go:build unit
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func guardrailRuleID(r *model.GuardrailRule) *int64 { return &r.ID }
+
+func newGuardrailServiceForTest(rules ...*model.GuardrailRule) *GuardrailService {
+	for i, rule := range rules {
+		rule.ID = int64(i + 1)
+		rule.Enabled = true
+	}
+	return NewGuardrailService(newRuleRepoStub(guardrailRuleID, rules...), nil)
+}
+
+func TestGuardrailRule_Validate(t *testing.T) {
+	valid := &model.GuardrailRule{Name: "pii", MatchType: model.GuardrailMatchPII, Action: model.GuardrailActionMask, Patterns: []string{model.PIIEmail}}
+	require.NoError(t, valid.Validate())
+
+	cases := []*model.GuardrailRule{
+		{Name: "", MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionFlag, Patterns: []string{"x"}},
+		{Name: "a", MatchType: model.GuardrailMatchKeyword, Action: "drop", Patterns: []string{"x"}},
+		{Name: "a", MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionFlag, Patterns: []string{" "}},
+		{Name: "a", MatchType: model.GuardrailMatchRegex, Action: model.GuardrailActionFlag, Patterns: []string{"("}},
+		{Name: "a", MatchType: model.GuardrailMatchPII, Action: model.GuardrailActionFlag, Patterns: []string{"ssn"}},
+		{Name: "a", MatchType: "semantic", Action: model.GuardrailActionFlag, Patterns: []string{"x"}},
+	}
+	requireInvalidRules(t, cases...)
+}
+
+func TestGuardrail_BlockKeywordInSystemPrompt(t *testing.T) {
+	svc := newGuardrailServiceForTest(&model.GuardrailRule{
+		Name: "blocklist", MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionBlock, Patterns: []string{"forbidden topic"},
+	})
+	body := []byte(`{"model":"claude","system":[{"type":"text","text":"Talk about the FORBIDDEN Topic"}],"messages":[{"role":"user","content":"hi"}]}`)
+
+	result, err := svc.Scan(1, body)
+	var blocked *GuardrailBlockedError
+	require.True(t, errors.As(err, &blocked))
+	require.Equal(t, "blocklist", blocked.RuleName)
+	require.Len(t, result.Hits, 1)
+	require.Equal(t, "Talk about the [REDACTED]", gjson.GetBytes(result.RedactedBody, "system.0.text").String())
+}
+
+func TestGuardrail_MaskPIIInMessagesAndToolResults(t *testing.T) {
+	svc := newGuardrailServiceForTest(&model.GuardrailRule{
+		Name: "pii", MatchType: model.GuardrailMatchPII, Action: model.GuardrailActionMask, Patterns: model.AllPIITypes(),
+	})
+	body := []byte(`{"model":"claude","messages":[
+		{"role":"user","content":[{"type":"text","text":"mail me at alice@example.com or call 13800138000"}]},
+		{"role":"user","content":[{"type":"tool_result","tool_use_id":"t1","content":"card 4111 1111 1111 1111, key sk-abcdefghijklmnopqrstuvwx"}]}
+	]}`)
+
+	result, err := svc.Scan(1, body)
+	require.NoError(t, err)
+	require.True(t, result.Modified)
+	require.Equal(t, "mail me at [REDACTED_EMAIL] or call [REDACTED_PHONE]", gjson.GetBytes(result.Body, "messages.0.content.0.text").String())
+	require.Equal(t, "card [REDACTED_CARD], key [REDACTED_API_KEY]", gjson.GetBytes(result.Body, "messages.1.content.0.content").String())
+	require.Equal(t, "t1", gjson.GetBytes(result.Body, "messages.1.content.0.tool_use_id").String())
+	require.Len(t, result.Hits, 4)
+}
+
+func TestGuardrail_MaskPIIInGeminiNativeRequest(t *testing.T) {
+	svc := newGuardrailServiceForTest(&model.GuardrailRule{
+		Name: "pii", MatchType: model.GuardrailMatchPII, Action: model.GuardrailActionMask, Patterns: []string{model.PIIEmail},
+	})
+	body := []byte(`{
+		"systemInstruction":{"parts":[{"text":"reply to ops@example.com"}]},
+		"contents":[
+			{"role":"user","parts":[{"text":"mail alice@example.com"},{"inlineData":{"mimeType":"text/plain","data":"bob@example.com"}}]},
+			{"role":"user","parts":[{"functionResponse":{"name":"lookup","response":{"output":"carol@example.com"}}}]}
+		],
+		"generationConfig":{"stopSequences":["dave@example.com"]}
+	}`)
+
+	result, err := svc.Scan(1, body)
+	require.NoError(t, err)
+	require.True(t, result.Modified)
+	require.Equal(t, "reply to [REDACTED_EMAIL]", gjson.GetBytes(result.Body, "systemInstruction.parts.0.text").String())
+	require.Equal(t, "mail [REDACTED_EMAIL]", gjson.GetBytes(result.Body, "contents.0.parts.0.text").String())
+	require.Equal(t, "[REDACTED_EMAIL]", gjson.GetBytes(result.Body, "contents.1.parts.0.functionResponse.response.output").String())
+	require.Equal(t, "bob@example.com", gjson.GetBytes(result.Body, "contents.0.parts.1.inlineData.data").String(), "不扫描二进制附件")
+	require.Equal(t, "dave@example.com", gjson.GetBytes(result.Body, "generationConfig.stopSequences.0").String(), "不扫描生成参数")
+	require.Len(t, result.Hits, 1)
+	require.Equal(t, 3, result.Hits[0].Count)
+}
+
+func TestGuardrail_CardRequiresLuhn(t *testing.T) {
+	svc := newGuardrailServiceForTest(&model.GuardrailRule{
+		Name: "card", MatchType: model.GuardrailMatchPII, Action: model.GuardrailActionMask, Patterns: []string{model.PIICard},
+	})
+	result, err := svc.Scan(1, []byte(`{"messages":[{"role":"user","content":"order 1234567890123456"}]}`))
+	require.NoError(t, err)
+	require.False(t, result.Modified)
+	require.Empty(t, result.Hits)
+}
+
+func TestGuardrail_FlagKeepsBodyAndRedactsForOps(t *testing.T) {
+	svc := newGuardrailServiceForTest(&model.GuardrailRule{
+		Name: "secrets", MatchType: model.GuardrailMatchRegex, Action: model.GuardrailActionFlag, Patterns: []string{`password=\S+`},
+	})
+	body := []byte(`{"model":"gpt","input":[{"role":"user","content":[{"type":"input_text","text":"login password=hunter2"}]}],"instructions":"be nice"}`)
+
+	result, err := svc.Scan(1, body)
+	require.NoError(t, err)
+	require.False(t, result.Modified)
+	require.Equal(t, body, result.Body)
+	require.Len(t, result.Hits, 1)
+	require.Equal(t, model.GuardrailActionFlag, result.Hits[0].Action)
+	require.Equal(t, "login [REDACTED]", gjson.GetBytes(result.RedactedBody, "input.0.content.0.text").String())
+}
+
+func TestGuardrail_GroupScopeAndNilService(t *testing.T) {
+	svc := newGuardrailServiceForTest(&model.GuardrailRule{
+		Name: "group", MatchType: model.GuardrailMatchKeyword, Action: model.GuardrailActionBlock, Patterns: []string{"secret"}, GroupIDs: []int64{7},
+	})
+	body := []byte(`{"messages":[{"role":"user","content":"secret"}]}`)
+
+	_, err := svc.Scan(1, body)
+	require.NoError(t, err)
+	_, err = svc.Scan(7, body)
+	require.Error(t, err)
+
+	var nilSvc *GuardrailService
+	result, err := nilSvc.Scan(7, body)
+	require.NoError(t, err)
+	require.Equal(t, body, result.Body)
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	healthCheckTimeout = 2 * time.Second
+	drainPollInterval  = 100 * time.Millisecond
+)
+
+// 就绪检查项名称
+const (
+	HealthCheckPostgres          = "postgres"
+	HealthCheckRedis             = "redis"
+	HealthCheckSchedulerSnapshot = "scheduler_snapshot"
+	HealthCheckPricing           = "pricing"
+)
+
+// 就绪状态
+const (
+	ReadinessReady    = "ready"
+	ReadinessNotReady = "not_ready"
+	ReadinessDraining = "draining"
+)
+
+// HealthCheckResult 单项依赖检查结果
+type HealthCheckResult struct {
+	OK        bool   `json:"ok"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ReadinessReport 就绪检查报告
+type ReadinessReport struct {
+	Status           string                       `json:"status"`
+	Checks           map[string]HealthCheckResult `json:"checks"`
+	InFlightRequests int64                        `json:"in_flight_requests"`
+}
+
+// Ready 返回实例是否可以接收新流量
+func (r *ReadinessReport) Ready() bool {
+	return r != nil && r.Status == ReadinessReady
+}
+
+// HealthService 提供存活/就绪检查与优雅停机的 draining 状态
+//
+// draining 期间 /readyz 失败，网关中间件拒绝新请求，进行中的请求继续执行直到完成或停机超时。
+type HealthService struct {
+	db                *sql.DB
+	redisClient       *redis.Client
+	schedulerSnapshot *SchedulerSnapshotService
+	pricingService    *PricingService
+	cfg               *config.Config
+
+	draining atomic.Bool
+	inFlight atomic.Int64
+}
+
+// NewHealthService 创建健康检查服务
+func NewHealthService(
+	db *sql.DB,
+	redisClient *redis.Client,
+	schedulerSnapshot *SchedulerSnapshotService,
+	pricingService *PricingService,
+	cfg *config.Config,
+) *HealthService {
+	return &HealthService{
+		db:                db,
+		redisClient:       redisClient,
+		schedulerSnapshot: schedulerSnapshot,
+		pricingService:    pricingService,
+		cfg:               cfg,
+	}
+}
+
+// StartDraining 进入 draining 状态（幂等）
+func (s *HealthService) StartDraining() {
+	if s == nil {
+		return
+	}
+	s.draining.Store(true)
+}
+
+// StopDraining 退出 draining 状态（管理员撤销摘流）
+func (s *HealthService) StopDraining() {
+	if s == nil {
+		return
+	}
+	s.draining.Store(false)
+}
+
+// IsDraining 返回是否处于 draining 状态
+func (s *HealthService) IsDraining() bool {
+	return s != nil && s.draining.Load()
+}
+
+// InFlight 返回进行中的网关请求数
+func (s *HealthService) InFlight() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.inFlight.Load()
+}
+
+// TrackRequest 登记一个进行中的网关请求，返回的函数需在请求结束时调用
+func (s *HealthService) TrackRequest() func() {
+	if s == nil {
+		return func() {}
+	}
+	s.inFlight.Add(1)
+	var once sync.Once
+	return func() {
+		once.Do(func() { s.inFlight.Add(-1) })
+	}
+}
+
+// WaitDrained 等待进行中的网关请求全部结束，ctx 结束时返回 ctx 错误
+//
+// h2c 连接被 Hijack 后不受 http.Server.Shutdown 跟踪，因此停机时需要额外等待该计数归零。
+func (s *HealthService) WaitDrained(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for s.inFlight.Load() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// CheckReadiness 检查 Postgres、Redis、调度快照新鲜度与价格数据
+func (s *HealthService) CheckReadiness(ctx context.Context) *ReadinessReport {
+	report := &ReadinessReport{
+		Status:           ReadinessReady,
+		Checks:           make(map[string]HealthCheckResult, 4),
+		InFlightRequests: s.InFlight(),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	run := func(name string, fn func(context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+			start := time.Now()
+			err := fn(checkCtx)
+			result := HealthCheckResult{OK: err == nil, LatencyMs: time.Since(start).Milliseconds()}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			mu.Lock()
+			report.Checks[name] = result
+			mu.Unlock()
+		}()
+	}
+	run(HealthCheckPostgres, s.checkPostgres)
+	run(HealthCheckRedis, s.checkRedis)
+	run(HealthCheckSchedulerSnapshot, s.checkSchedulerSnapshot)
+	run(HealthCheckPricing, s.checkPricing)
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if !result.OK {
+			report.Status = ReadinessNotReady
+			break
+		}
+	}
+	if s.IsDraining() {
+		report.Status = ReadinessDraining
+	}
+	return report
+}
+
+func (s *HealthService) checkPostgres(ctx context.Context) error {
+	if s.db == nil {
+		return errors.New("database not configured")
+	}
+	return s.db.PingContext(ctx)
+}
+
+func (s *HealthService) checkRedis(ctx context.Context) error {
+	if s.redisClient == nil {
+		return errors.New("redis not configured")
+	}
+	return s.redisClient.Ping(ctx).Err()
+}
+
+func (s *HealthService) checkSchedulerSnapshot(context.Context) error {
+	if s.schedulerSnapshot == nil {
+		return errors.New("scheduler snapshot not configured")
+	}
+	initialized, lastSyncAt := s.schedulerSnapshot.SnapshotStatus()
+	if !initialized {
+		return errors.New("initial snapshot build not finished")
+	}
+	maxAge := 0
+	if s.cfg != nil {
+		maxAge = s.cfg.Server.ReadinessSnapshotMaxAge
+	}
+	if maxAge > 0 {
+		if age := time.Since(lastSyncAt); age > time.Duration(maxAge)*time.Second {
+			return fmt.Errorf("snapshot stale: last sync %s ago", age.Truncate(time.Second))
+		}
+	}
+	return nil
+}
+
+func (s *HealthService) checkPricing(context.Context) error {
+	if s.pricingService == nil {
+		return errors.New("pricing service not configured")
+	}
+	if s.pricingService.ModelCount() == 0 {
+		return errors.New("pricing data not loaded")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthService_WaitDrained(t *testing.T) {
+	svc := NewHealthService(nil, nil, nil, nil, nil)
+	done := svc.TrackRequest()
+	svc.StartDraining()
+	require.True(t, svc.IsDraining())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, svc.WaitDrained(ctx), context.DeadlineExceeded)
+
+	done()
+	done() // 重复调用不应使计数为负
+	require.Equal(t, int64(0), svc.InFlight())
+	require.NoError(t, svc.WaitDrained(context.Background()))
+}
+
+func TestHealthService_CheckSchedulerSnapshot(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Server.ReadinessSnapshotMaxAge = 60
+	snapshot := &SchedulerSnapshotService{}
+	svc := NewHealthService(nil, nil, snapshot, nil, cfg)
+
+	require.ErrorContains(t, svc.checkSchedulerSnapshot(context.Background()), "not finished")
+
+	snapshot.markSynced(true)
+	require.NoError(t, svc.checkSchedulerSnapshot(context.Background()))
+
+	snapshot.lastSyncAt.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	require.ErrorContains(t, svc.checkSchedulerSnapshot(context.Background()), "stale")
+
+	cfg.Server.ReadinessSnapshotMaxAge = 0
+	require.NoError(t, svc.checkSchedulerSnapshot(context.Background()))
+}
+
+func TestHealthService_CheckReadinessDraining(t *testing.T) {
+	svc := NewHealthService(nil, nil, nil, nil, nil)
+	report := svc.CheckReadiness(context.Background())
+	require.Equal(t, ReadinessNotReady, report.Status)
+	require.False(t, report.Checks[HealthCheckPostgres].OK)
+
+	svc.StartDraining()
+	report = svc.CheckReadiness(context.Background())
+	require.Equal(t, ReadinessDraining, report.Status)
+	require.False(t, report.Ready())
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrModelPriceNotFound = infraerrors.NotFound("MODEL_PRICE_NOT_FOUND", "model price not found")
+	ErrModelPriceExists   = infraerrors.Conflict("MODEL_PRICE_EXISTS", "model price with the same model, scope and effective_from already exists")
+)
+
+// ModelPriceRepository 定义模型价格的数据访问接口
+type ModelPriceRepository interface {
+	// List 获取所有价格
+	List(ctx context.Context) ([]*model.ModelPrice, error)
+	// GetByID 根据 ID 获取价格
+	GetByID(ctx context.Context, id int64) (*model.ModelPrice, error)
+	// Create 创建价格
+	Create(ctx context.Context, price *model.ModelPrice) (*model.ModelPrice, error)
+	// Update 更新价格
+	Update(ctx context.Context, price *model.ModelPrice) (*model.ModelPrice, error)
+	// Delete 删除价格
+	Delete(ctx context.Context, id int64) error
+	// Import 批量导入价格，replace 为 true 时先清空现有价格
+	Import(ctx context.Context, prices []*model.ModelPrice, replace bool) (int, error)
+}
+
+// ModelPriceCache 定义模型价格的缓存接口
+type ModelPriceCache interface {
+	// Get 从缓存获取价格列表
+	Get(ctx context.Context) ([]*model.ModelPrice, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, prices []*model.ModelPrice) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// PricingScope 计费时用于匹配价格覆盖的请求范围
+type PricingScope struct {
+	GroupID  *int64
+	Platform string
+}
+
+// ModelPriceService 管理员维护的模型价格服务
+// 价格全量加载到本地内存，计费路径只做内存匹配
+type ModelPriceService struct {
+	repo   ModelPriceRepository
+	prices *ruleSnapshot[model.ModelPrice, model.ModelPrice]
+}
+
+// NewModelPriceService 创建模型价格服务
+func NewModelPriceService(repo ModelPriceRepository, cache ModelPriceCache) *ModelPriceService {
+	return &ModelPriceService{
+		repo:   repo,
+		prices: newRuleSnapshot("ModelPriceService", ruleLister[model.ModelPrice](repo), ruleListCache[model.ModelPrice](cache), identityRules[model.ModelPrice]),
+	}
+}
+
+// List 获取所有价格
+func (s *ModelPriceService) List(ctx context.Context) ([]*model.ModelPrice, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取价格
+func (s *ModelPriceService) GetByID(ctx context.Context, id int64) (*model.ModelPrice, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建价格
+func (s *ModelPriceService) Create(ctx context.Context, price *model.ModelPrice) (*model.ModelPrice, error) {
+	if err := price.Validate(); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, price)
+	if err != nil {
+		return nil, err
+	}
+	s.prices.invalidateAndNotify()
+	return created, nil
+}
+
+// Update 更新价格
+func (s *ModelPriceService) Update(ctx context.Context, price *model.ModelPrice) (*model.ModelPrice, error) {
+	if err := price.Validate(); err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.Update(ctx, price)
+	if err != nil {
+		return nil, err
+	}
+	s.prices.invalidateAndNotify()
+	return updated, nil
+}
+
+// Delete 删除价格
+func (s *ModelPriceService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.prices.invalidateAndNotify()
+	return nil
+}
+
+// Import 批量导入价格（全部校验通过后在同一事务内写入）
+func (s *ModelPriceService) Import(ctx context.Context, prices []*model.ModelPrice, replace bool) (int, error) {
+	now := time.Now()
+	for _, p := range prices {
+		if p.EffectiveFrom.IsZero() {
+			p.EffectiveFrom = now
+		}
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+	count, err := s.repo.Import(ctx, prices, replace)
+	if err != nil {
+		return 0, err
+	}
+	s.prices.invalidateAndNotify()
+	return count, nil
+}
+
+// Lookup 查找在 at 时刻对指定模型生效的价格覆盖，未命中返回 nil
+//
+// 匹配优先级：分组 > 平台 > 全局；同一范围内精确模型 > 最长前缀通配；
+// 最后取 effective_from 不晚于 at 的最新一条。
+func (s *ModelPriceService) Lookup(modelName string, scope PricingScope, at time.Time) *model.ModelPrice {
+	if s == nil || strings.TrimSpace(modelName) == "" {
+		return nil
+	}
+	return matchModelPrice(s.prices.items(), modelName, scope, at)
+}
+
+// GetModelPricing 将命中的价格覆盖转换为计费使用的 per-token 价格
+func (s *ModelPriceService) GetModelPricing(modelName string, scope PricingScope, at time.Time) *ModelPricing {
+	price := s.Lookup(modelName, scope, at)
+	if price == nil {
+		return nil
+	}
+	const perMillion = 1e-6
+	return &ModelPricing{
+		InputPricePerToken:         price.InputPrice * perMillion,
+		OutputPricePerToken:        price.OutputPrice * perMillion,
+		CacheCreationPricePerToken: price.CacheWritePrice * perMillion,
+		CacheReadPricePerToken:     price.CacheReadPrice * perMillion,
+	}
+}
+
+// GetImagePrice 返回价格覆盖中的单张图片价格，未配置返回 nil
+func (s *ModelPriceService) GetImagePrice(modelName string, scope PricingScope, at time.Time) *float64 {
+	price := s.Lookup(modelName, scope, at)
+	if price == nil {
+		return nil
+	}
+	return price.ImagePrice
+}
+
+// matchModelPrice 在价格列表中按优先级选出最匹配的一条
+func matchModelPrice(prices []*model.ModelPrice, modelName string, scope PricingScope, at time.Time) *model.ModelPrice {
+	var best *model.ModelPrice
+	bestScope, bestModel := -1, -1
+	for _, p := range prices {
+		if !p.Enabled || p.EffectiveFrom.After(at) {
+			continue
+		}
+		scopeRank := 0
+		if p.GroupID != nil {
+			if scope.GroupID == nil || *scope.GroupID != *p.GroupID {
+				continue
+			}
+			scopeRank += 2
+		}
+		if p.Platform != "" {
+			if p.Platform != scope.Platform {
+				continue
+			}
+			scopeRank++
+		}
+		matched, exact := p.MatchesModel(modelName)
+		if !matched {
+			continue
+		}
+		// 精确匹配视为比任意通配都长
+		modelRank := len(p.Model)
+		if exact {
+			modelRank = 1 << 20
+		}
+		switch {
+		case scopeRank > bestScope,
+			scopeRank == bestScope && modelRank > bestModel,
+			scopeRank == bestScope && modelRank == bestModel && p.EffectiveFrom.After(best.EffectiveFrom):
+			best, bestScope, bestModel = p, scopeRank, modelRank
+		}
+	}
+	return best
+}
@@ -0,0 +1,108 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+// stubModelPriceRepo 在通用规则仓储桩上补充批量导入
+type stubModelPriceRepo struct {
+	*ruleRepoStub[model.ModelPrice]
+}
+
+func newStubModelPriceRepo(prices ...*model.ModelPrice) *stubModelPriceRepo {
+	return &stubModelPriceRepo{ruleRepoStub: newRuleRepoStub(modelPriceID, prices...)}
+}
+
+func (r *stubModelPriceRepo) Import(ctx context.Context, prices []*model.ModelPrice, replace bool) (int, error) {
+	if replace {
+		r.items = nil
+	}
+	r.items = append(r.items, prices...)
+	return len(prices), nil
+}
+
+func TestModelPriceServiceLookup(t *testing.T) {
+	now := time.Now()
+	groupID := int64(7)
+	repo := newStubModelPriceRepo(
+		&model.ModelPrice{ID: 1, Model: "claude-opus-*", InputPrice: 10, EffectiveFrom: now.Add(-48 * time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 2, Model: "claude-opus-*", InputPrice: 12, EffectiveFrom: now.Add(-time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 3, Model: "claude-opus-*", InputPrice: 99, EffectiveFrom: now.Add(time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 4, Model: "claude-opus-4-5", InputPrice: 20, EffectiveFrom: now.Add(-48 * time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 5, Model: "claude-*", InputPrice: 30, Platform: PlatformAnthropic, EffectiveFrom: now.Add(-48 * time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 6, Model: "claude-*", InputPrice: 40, GroupID: &groupID, EffectiveFrom: now.Add(-48 * time.Hour), Enabled: true},
+		&model.ModelPrice{ID: 7, Model: "gpt-4o", InputPrice: 50, EffectiveFrom: now.Add(-48 * time.Hour), Enabled: false},
+	)
+	svc := NewModelPriceService(repo, nil)
+
+	require.Equal(t, int64(2), svc.Lookup("claude-opus-4-1", PricingScope{}, now).ID, "取已生效的最新价格")
+	require.Equal(t, int64(1), svc.Lookup("claude-opus-4-1", PricingScope{}, now.Add(-24*time.Hour)).ID, "历史时间点使用当时的价格")
+	require.Equal(t, int64(4), svc.Lookup("Claude-Opus-4-5", PricingScope{}, now).ID, "精确匹配优先，不区分大小写")
+	require.Equal(t, int64(5), svc.Lookup("claude-opus-4-5", PricingScope{Platform: PlatformAnthropic}, now).ID, "平台范围优先于全局")
+	require.Equal(t, int64(6), svc.Lookup("claude-opus-4-5", PricingScope{GroupID: &groupID, Platform: PlatformAnthropic}, now).ID, "分组范围优先")
+	require.Nil(t, svc.Lookup("gpt-4o", PricingScope{}, now), "禁用的价格不生效")
+
+	pricing := svc.GetModelPricing("claude-opus-4-5", PricingScope{}, now)
+	require.InDelta(t, 20e-6, pricing.InputPricePerToken, 1e-15)
+}
+
+func TestModelPriceServiceCreateRefreshesCache(t *testing.T) {
+	svc := NewModelPriceService(newStubModelPriceRepo(), nil)
+	require.Nil(t, svc.Lookup("deepseek-chat", PricingScope{}, time.Now()))
+
+	_, err := svc.Create(context.Background(), &model.ModelPrice{Model: "deepseek-chat", InputPrice: 1, EffectiveFrom: time.Now().Add(-time.Minute), Enabled: true})
+	require.NoError(t, err)
+	require.NotNil(t, svc.Lookup("deepseek-chat", PricingScope{}, time.Now()))
+
+	_, err = svc.Create(context.Background(), &model.ModelPrice{Model: "a*b", EffectiveFrom: time.Now()})
+	require.Error(t, err)
+}
+
+func TestBillingServiceUsesModelPriceOverride(t *testing.T) {
+	repo := newStubModelPriceRepo(
+		&model.ModelPrice{ID: 1, Model: "claude-sonnet-4", InputPrice: 1, OutputPrice: 2, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+	)
+	svc := NewBillingService(nil, nil, NewModelPriceService(repo, nil))
+
+	cost, err := svc.CalculateCost("claude-sonnet-4", UsageTokens{InputTokens: 1_000_000, OutputTokens: 1_000_000}, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 3.0, cost.TotalCost, 1e-9)
+
+	account := &Account{Platform: PlatformAnthropic}
+	cost, err = svc.CalculateCostForAccount(account, nil, "claude-sonnet-4", UsageTokens{InputTokens: 1_000_000}, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 2.0, cost.ActualCost, 1e-9)
+}
+
+func TestBillingServiceLongContextUsesScopedOverride(t *testing.T) {
+	groupID := int64(3)
+	repo := newStubModelPriceRepo(
+		&model.ModelPrice{ID: 1, Model: "gemini-2.5-pro", GroupID: &groupID, Platform: PlatformGemini, InputPrice: 1, OutputPrice: 2, EffectiveFrom: time.Now().Add(-time.Hour), Enabled: true},
+	)
+	svc := NewBillingService(nil, nil, NewModelPriceService(repo, nil))
+	scope := PricingScope{GroupID: &groupID, Platform: PlatformGemini}
+
+	// 未超过阈值：按分组价格覆盖正常计费
+	cost, err := svc.CalculateCostWithLongContext("gemini-2.5-pro", scope, UsageTokens{InputTokens: 100_000, OutputTokens: 1_000_000}, 1, 200_000, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 2.1, cost.ActualCost, 1e-9)
+
+	// 超过阈值：超出部分 × 2
+	cost, err = svc.CalculateCostWithLongContext("gemini-2.5-pro", scope, UsageTokens{InputTokens: 1_000_000}, 1, 200_000, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 0.2+0.8*2, cost.ActualCost, 1e-9)
+
+	// 其他分组不匹配价格覆盖
+	otherGroup := int64(4)
+	cost, err = svc.CalculateCostWithLongContext("gemini-2.5-pro", PricingScope{GroupID: &otherGroup, Platform: PlatformGemini}, UsageTokens{InputTokens: 100_000}, 1, 200_000, 2)
+	require.NoError(t, err)
+	require.Greater(t, math.Abs(cost.ActualCost-0.1), 1e-9)
+}
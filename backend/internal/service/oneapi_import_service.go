@@ -0,0 +1,1033 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/oneapi"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// one-api / new-api 导入映射：
+//   - 渠道 -> 账号：按渠道类型识别平台，多 Key 渠道按行拆分为多个账号
+//   - 渠道分组 -> 分组：同名分组被多个平台使用时按 "分组-平台" 命名，倍率取 GroupRatio
+//   - 用户 -> 用户：额度按 QuotaPerUnit 换算为 USD 余额，bcrypt 密码哈希原样保留
+//   - 令牌 -> API Key：保留原 Key（补 sk- 前缀）、额度、过期时间与状态
+//   - 未使用的兑换码 -> 余额兑换码
+//
+// 导入不会覆盖已有数据：账号按 extra.oneapi_channel、用户按邮箱、API Key / 兑换码按 Key 判重，
+// 重复执行时已存在的项报告为 exists，因此可在失败修正后重新执行。
+
+var (
+	ErrOneAPISourceRequired    = infraerrors.BadRequest("ONEAPI_SOURCE_REQUIRED", "either export data or database dsn is required")
+	ErrOneAPISourceUnavailable = infraerrors.BadRequest("ONEAPI_SOURCE_UNAVAILABLE", "failed to read one-api data")
+	ErrOneAPIInvalidFlavor     = infraerrors.BadRequest("ONEAPI_INVALID_FLAVOR", "flavor must be one-api or new-api")
+	ErrOneAPIInvalidPlatform   = infraerrors.BadRequest("ONEAPI_INVALID_PLATFORM", "platform must be one of openai, anthropic, gemini, compatible")
+)
+
+// 导入项类型
+const (
+	OneAPIImportKindGroup      = "group"
+	OneAPIImportKindAccount    = "account"
+	OneAPIImportKindUser       = "user"
+	OneAPIImportKindAPIKey     = "api_key"
+	OneAPIImportKindRedeemCode = "redeem_code"
+)
+
+// 导入项结果；预览模式下 created 表示将被创建
+const (
+	OneAPIImportActionCreated = "created"
+	OneAPIImportActionExists  = "exists"
+	OneAPIImportActionSkipped = "skipped"
+	OneAPIImportActionFailed  = "failed"
+)
+
+const (
+	oneAPIDefaultEmailDomain = "oneapi.local"
+	oneAPIDefaultGroup       = "default"
+	oneAPIAccountConcurrency = 3
+	oneAPIKeyPrefix          = "sk-"
+)
+
+// oneAPICompatibleChannels 按 OpenAI 协议转发的渠道类型 -> 默认上游地址（空表示必须配置 base_url）。
+// 两个分支的类型编号在 OpenRouter 之后出现分歧，需分别维护。
+var oneAPICompatibleChannels = map[string]map[int]string{
+	oneapi.FlavorOneAPI: {
+		8:  "",
+		20: "https://openrouter.ai/api/v1",
+		25: "https://api.moonshot.cn/v1",
+		28: "https://api.mistral.ai/v1",
+		29: "https://api.groq.com/openai/v1",
+		30: "",
+		31: "https://api.lingyiwanwu.com/v1",
+		36: "https://api.deepseek.com/v1",
+		39: "https://api.together.xyz/v1",
+		44: "https://api.siliconflow.cn/v1",
+		45: "https://api.x.ai/v1",
+		50: "",
+	},
+	oneapi.FlavorNewAPI: {
+		4:  "",
+		8:  "",
+		20: "https://openrouter.ai/api/v1",
+		25: "https://api.moonshot.cn/v1",
+		31: "https://api.lingyiwanwu.com/v1",
+		40: "https://api.siliconflow.cn/v1",
+		42: "https://api.mistral.ai/v1",
+		43: "https://api.deepseek.com/v1",
+		48: "https://api.x.ai/v1",
+	},
+}
+
+// 两个分支共用的原生渠道类型
+const (
+	oneAPIChannelOpenAI    = 1
+	oneAPIChannelAnthropic = 14
+	oneAPIChannelGemini    = 24
+)
+
+var oneAPIVersionSuffix = regexp.MustCompile(`/v\d+[a-z]*$`)
+
+// OneAPISource 导入数据来源：JSON 导出或数据库连接，二选一
+type OneAPISource struct {
+	Export *oneapi.Dump
+	// Driver postgres / sqlite
+	Driver string
+	DSN    string
+}
+
+// OneAPIImportInput 导入参数
+type OneAPIImportInput struct {
+	Source OneAPISource
+	// Flavor one-api（默认）或 new-api，决定渠道类型编号的含义
+	Flavor string
+	// QuotaPerUnit 每 USD 对应的额度，<=0 时使用源实例的 QuotaPerUnit 设置（默认 500000）
+	QuotaPerUnit float64
+	// DefaultPlatform 令牌分组对应多个平台时绑定的平台，默认 openai
+	DefaultPlatform string
+	// EmailDomain 源用户没有邮箱时使用 用户名@EmailDomain，默认 oneapi.local
+	EmailDomain string
+	// TypeOverrides 渠道类型 -> 平台，覆盖内置识别规则，也可用于导入未内置的渠道类型
+	TypeOverrides map[int]string
+
+	SkipChannels    bool
+	SkipUsers       bool
+	SkipTokens      bool
+	SkipRedemptions bool
+}
+
+// OneAPIImportItem 单个导入项的结果
+type OneAPIImportItem struct {
+	Kind     string `json:"kind"`
+	SourceID string `json:"source_id"`
+	Name     string `json:"name"`
+	Action   string `json:"action"`
+	Platform string `json:"platform,omitempty"`
+	Type     string `json:"type,omitempty"`
+	TargetID int64  `json:"target_id,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// OneAPIImportResult 导入（或预览）报告
+type OneAPIImportResult struct {
+	DryRun       bool               `json:"dry_run"`
+	Flavor       string             `json:"flavor"`
+	QuotaPerUnit float64            `json:"quota_per_unit"`
+	Created      int                `json:"created"`
+	Existing     int                `json:"existing"`
+	Skipped      int                `json:"skipped"`
+	Failed       int                `json:"failed"`
+	Items        []OneAPIImportItem `json:"items"`
+}
+
+// OneAPIImportService 从 one-api / new-api 实例迁移数据
+type OneAPIImportService struct {
+	accountRepo    AccountRepository
+	groupRepo      GroupRepository
+	userRepo       UserRepository
+	apiKeyRepo     APIKeyRepository
+	redeemRepo     RedeemCodeRepository
+	settingService *SettingService
+}
+
+// NewOneAPIImportService 创建导入服务
+func NewOneAPIImportService(
+	accountRepo AccountRepository,
+	groupRepo GroupRepository,
+	userRepo UserRepository,
+	apiKeyRepo APIKeyRepository,
+	redeemRepo RedeemCodeRepository,
+	settingService *SettingService,
+) *OneAPIImportService {
+	return &OneAPIImportService{
+		accountRepo:    accountRepo,
+		groupRepo:      groupRepo,
+		userRepo:       userRepo,
+		apiKeyRepo:     apiKeyRepo,
+		redeemRepo:     redeemRepo,
+		settingService: settingService,
+	}
+}
+
+// Preview 按导入逻辑生成报告但不写入任何数据
+func (s *OneAPIImportService) Preview(ctx context.Context, input OneAPIImportInput) (*OneAPIImportResult, error) {
+	return s.run(ctx, input, true)
+}
+
+// Import 执行导入。各项独立写入，单项失败不影响其它项，失败原因记录在报告中。
+func (s *OneAPIImportService) Import(ctx context.Context, input OneAPIImportInput) (*OneAPIImportResult, error) {
+	return s.run(ctx, input, false)
+}
+
+func (s *OneAPIImportService) run(ctx context.Context, input OneAPIImportInput, dryRun bool) (*OneAPIImportResult, error) {
+	flavor := strings.ToLower(strings.TrimSpace(input.Flavor))
+	if flavor == "" {
+		flavor = oneapi.FlavorOneAPI
+	}
+	if flavor != oneapi.FlavorOneAPI && flavor != oneapi.FlavorNewAPI {
+		return nil, ErrOneAPIInvalidFlavor
+	}
+	defaultPlatform := strings.TrimSpace(input.DefaultPlatform)
+	if defaultPlatform == "" {
+		defaultPlatform = PlatformOpenAI
+	}
+	if !isOneAPIImportPlatform(defaultPlatform) {
+		return nil, ErrOneAPIInvalidPlatform
+	}
+	for _, platform := range input.TypeOverrides {
+		if !isOneAPIImportPlatform(platform) {
+			return nil, ErrOneAPIInvalidPlatform.WithMetadata(map[string]string{"platform": platform})
+		}
+	}
+
+	dump, err := loadOneAPISource(ctx, input.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	perUnit := input.QuotaPerUnit
+	if perUnit <= 0 {
+		perUnit = dump.QuotaPerUnit()
+	}
+	emailDomain := strings.TrimPrefix(strings.TrimSpace(input.EmailDomain), "@")
+	if emailDomain == "" {
+		emailDomain = oneAPIDefaultEmailDomain
+	}
+
+	imp := &oneAPIImporter{
+		svc:             s,
+		input:           input,
+		dump:            dump,
+		dryRun:          dryRun,
+		flavor:          flavor,
+		perUnit:         perUnit,
+		defaultPlatform: defaultPlatform,
+		emailDomain:     emailDomain,
+		now:             time.Now(),
+		result: &OneAPIImportResult{
+			DryRun:       dryRun,
+			Flavor:       flavor,
+			QuotaPerUnit: perUnit,
+			Items:        []OneAPIImportItem{},
+		},
+		groupIDs:     map[string]map[string]int64{},
+		userIDs:      map[int64]int64{},
+		userByEmail:  map[string]int64{},
+		seenKeys:     map[string]struct{}{},
+		seenCodes:    map[string]struct{}{},
+		nextDryRunID: -1,
+	}
+	if err := imp.loadExisting(ctx); err != nil {
+		return nil, err
+	}
+
+	channels := imp.planChannels()
+	if !input.SkipChannels || !input.SkipTokens {
+		imp.importGroups(ctx, channels)
+	}
+	if !input.SkipChannels {
+		imp.importChannels(ctx, channels)
+	}
+	imp.importUsers(ctx)
+	if !input.SkipTokens {
+		imp.importTokens(ctx)
+	}
+	if !input.SkipRedemptions {
+		imp.importRedemptions(ctx)
+	}
+	return imp.result, nil
+}
+
+func loadOneAPISource(ctx context.Context, src OneAPISource) (*oneapi.Dump, error) {
+	if src.Export != nil {
+		return src.Export, nil
+	}
+	if strings.TrimSpace(src.DSN) == "" {
+		return nil, ErrOneAPISourceRequired
+	}
+	dump, err := oneapi.Load(ctx, strings.ToLower(strings.TrimSpace(src.Driver)), strings.TrimSpace(src.DSN))
+	if err != nil {
+		return nil, ErrOneAPISourceUnavailable.WithCause(err).WithMetadata(map[string]string{"detail": err.Error()})
+	}
+	return dump, nil
+}
+
+func isOneAPIImportPlatform(platform string) bool {
+	switch platform {
+	case PlatformOpenAI, PlatformAnthropic, PlatformGemini, PlatformCompatible:
+		return true
+	}
+	return false
+}
+
+// oneAPIImporter 单次导入的状态
+type oneAPIImporter struct {
+	svc             *OneAPIImportService
+	input           OneAPIImportInput
+	dump            *oneapi.Dump
+	dryRun          bool
+	flavor          string
+	perUnit         float64
+	defaultPlatform string
+	emailDomain     string
+	now             time.Time
+	result          *OneAPIImportResult
+
+	existingGroups   map[string]*Group
+	existingChannels map[string]int64
+
+	// groupIDs 源分组 -> 平台 -> 目标分组 ID
+	groupIDs map[string]map[string]int64
+	// userIDs 源用户 ID -> 目标用户 ID
+	userIDs     map[int64]int64
+	userByEmail map[string]int64
+	seenKeys    map[string]struct{}
+	seenCodes   map[string]struct{}
+
+	// nextDryRunID 预览模式下为“将创建”的分组与用户分配的占位 ID（负数）
+	nextDryRunID int64
+}
+
+// oneAPIChannelPlan 渠道的识别结果
+type oneAPIChannelPlan struct {
+	src      *oneapi.Channel
+	platform string
+	baseURL  string
+	groups   []string
+	skip     string
+}
+
+func (imp *oneAPIImporter) loadExisting(ctx context.Context) error {
+	groups, err := configSyncListAll(func(params pagination.PaginationParams) ([]Group, *pagination.PaginationResult, error) {
+		return imp.svc.groupRepo.ListWithFilters(ctx, params, "", "", "", nil)
+	})
+	if err != nil {
+		return fmt.Errorf("list groups: %w", err)
+	}
+	imp.existingGroups = make(map[string]*Group, len(groups))
+	for i := range groups {
+		imp.existingGroups[groups[i].Name] = &groups[i]
+	}
+
+	imp.existingChannels = map[string]int64{}
+	if imp.input.SkipChannels {
+		return nil
+	}
+	accounts, err := configSyncListAll(func(params pagination.PaginationParams) ([]Account, *pagination.PaginationResult, error) {
+		return imp.svc.accountRepo.ListWithFilters(ctx, params, "", "", "", "")
+	})
+	if err != nil {
+		return fmt.Errorf("list accounts: %w", err)
+	}
+	for i := range accounts {
+		if ref, ok := accounts[i].Extra["oneapi_channel"].(string); ok && ref != "" {
+			imp.existingChannels[ref] = accounts[i].ID
+		}
+	}
+	return nil
+}
+
+func (imp *oneAPIImporter) add(item OneAPIImportItem) {
+	switch item.Action {
+	case OneAPIImportActionCreated:
+		imp.result.Created++
+	case OneAPIImportActionExists:
+		imp.result.Existing++
+	case OneAPIImportActionSkipped:
+		imp.result.Skipped++
+	case OneAPIImportActionFailed:
+		imp.result.Failed++
+	}
+	imp.result.Items = append(imp.result.Items, item)
+}
+
+func (imp *oneAPIImporter) dryRunID() int64 {
+	id := imp.nextDryRunID
+	imp.nextDryRunID--
+	return id
+}
+
+func (imp *oneAPIImporter) planChannels() []oneAPIChannelPlan {
+	plans := make([]oneAPIChannelPlan, 0, len(imp.dump.Channels))
+	for i := range imp.dump.Channels {
+		ch := &imp.dump.Channels[i]
+		plan := oneAPIChannelPlan{src: ch, groups: oneapi.SplitList(ch.Group)}
+		if len(plan.groups) == 0 {
+			plan.groups = []string{oneAPIDefaultGroup}
+		}
+		platform, baseURL, err := imp.detectChannel(ch)
+		if err != nil {
+			plan.skip = err.Error()
+		}
+		plan.platform, plan.baseURL = platform, baseURL
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// detectChannel 识别渠道对应的平台与上游地址
+func (imp *oneAPIImporter) detectChannel(ch *oneapi.Channel) (string, string, error) {
+	base := ""
+	if ch.BaseURL != nil {
+		base = strings.TrimRight(strings.TrimSpace(*ch.BaseURL), "/")
+	}
+	defaults := oneAPICompatibleChannels[imp.flavor]
+
+	platform, overridden := imp.input.TypeOverrides[ch.Type]
+	if !overridden {
+		switch ch.Type {
+		case oneAPIChannelOpenAI:
+			platform = PlatformOpenAI
+			if base != "" && !strings.Contains(base, "api.openai.com") {
+				platform = PlatformCompatible
+			}
+		case oneAPIChannelAnthropic:
+			platform = PlatformAnthropic
+		case oneAPIChannelGemini:
+			platform = PlatformGemini
+		default:
+			if _, ok := defaults[ch.Type]; !ok {
+				return "", "", fmt.Errorf("unsupported channel type %d", ch.Type)
+			}
+			platform = PlatformCompatible
+		}
+	}
+
+	switch platform {
+	case PlatformAnthropic:
+		// 网关会追加 /v1/messages
+		base = strings.TrimSuffix(base, "/v1")
+	case PlatformOpenAI:
+		if strings.Contains(base, "api.openai.com") {
+			base = ""
+		} else if base != "" && !oneAPIVersionSuffix.MatchString(base) {
+			base += "/v1"
+		}
+	case PlatformCompatible:
+		if base == "" {
+			base = defaults[ch.Type]
+		} else if !oneAPIVersionSuffix.MatchString(base) {
+			// one-api 在 base_url 后追加 /v1/chat/completions
+			base += "/v1"
+		}
+		if base == "" {
+			return platform, "", errors.New("base_url is required for this channel type")
+		}
+	}
+	return platform, base, nil
+}
+
+// oneAPIGroupName 源分组被多个平台使用时按平台拆分
+func oneAPIGroupName(group, platform string, platformCount int) string {
+	if platformCount <= 1 {
+		return group
+	}
+	return group + "-" + platform
+}
+
+func (imp *oneAPIImporter) importGroups(ctx context.Context, plans []oneAPIChannelPlan) {
+	platformsByGroup := map[string]map[string]struct{}{}
+	for _, plan := range plans {
+		if plan.skip != "" {
+			continue
+		}
+		for _, g := range plan.groups {
+			if platformsByGroup[g] == nil {
+				platformsByGroup[g] = map[string]struct{}{}
+			}
+			platformsByGroup[g][plan.platform] = struct{}{}
+		}
+	}
+	ratios := imp.dump.GroupRatios()
+
+	for _, source := range sortedKeys(platformsByGroup) {
+		platforms := sortedKeys(platformsByGroup[source])
+		for _, platform := range platforms {
+			name := oneAPIGroupName(source, platform, len(platforms))
+			item := OneAPIImportItem{
+				Kind:     OneAPIImportKindGroup,
+				SourceID: source,
+				Name:     name,
+				Platform: platform,
+			}
+			if existing := imp.existingGroups[name]; existing != nil {
+				if existing.Platform != platform {
+					item.Action = OneAPIImportActionFailed
+					item.Error = fmt.Sprintf("group %q already exists on platform %s", name, existing.Platform)
+					imp.add(item)
+					continue
+				}
+				item.Action = OneAPIImportActionExists
+				item.TargetID = existing.ID
+				imp.bindGroup(source, platform, existing.ID)
+				imp.add(item)
+				continue
+			}
+
+			ratio, ok := ratios[source]
+			if !ok || ratio < 0 {
+				ratio = 1
+			}
+			group := &Group{
+				Name:             name,
+				Description:      fmt.Sprintf("Imported from %s group %s", imp.flavor, source),
+				Platform:         platform,
+				RateMultiplier:   ratio,
+				Status:           StatusActive,
+				SubscriptionType: SubscriptionTypeStandard,
+				MCPXMLInject:     true,
+			}
+			if imp.dryRun {
+				group.ID = imp.dryRunID()
+			} else if err := imp.svc.groupRepo.Create(ctx, group); err != nil {
+				item.Action = OneAPIImportActionFailed
+				item.Error = err.Error()
+				imp.add(item)
+				continue
+			}
+			item.Action = OneAPIImportActionCreated
+			item.TargetID = max(group.ID, 0)
+			imp.bindGroup(source, platform, group.ID)
+			imp.add(item)
+		}
+	}
+}
+
+func (imp *oneAPIImporter) bindGroup(source, platform string, id int64) {
+	if imp.groupIDs[source] == nil {
+		imp.groupIDs[source] = map[string]int64{}
+	}
+	imp.groupIDs[source][platform] = id
+}
+
+func (imp *oneAPIImporter) importChannels(ctx context.Context, plans []oneAPIChannelPlan) {
+	for _, plan := range plans {
+		ch := plan.src
+		name := strings.TrimSpace(ch.Name)
+		if name == "" {
+			name = fmt.Sprintf("%s channel %d", imp.flavor, ch.ID)
+		}
+		base := OneAPIImportItem{
+			Kind:     OneAPIImportKindAccount,
+			SourceID: strconv.FormatInt(ch.ID, 10),
+			Name:     name,
+			Platform: plan.platform,
+			Type:     AccountTypeAPIKey,
+		}
+		if plan.skip != "" {
+			base.Action = OneAPIImportActionSkipped
+			base.Message = plan.skip
+			imp.add(base)
+			continue
+		}
+
+		var keys []string
+		for _, key := range strings.Split(ch.Key, "\n") {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			base.Action = OneAPIImportActionFailed
+			base.Error = "channel has no key"
+			imp.add(base)
+			continue
+		}
+
+		mapping, err := oneAPIModelRedirects(ch)
+		if err != nil {
+			base.Action = OneAPIImportActionFailed
+			base.Error = err.Error()
+			imp.add(base)
+			continue
+		}
+		var groupIDs []int64
+		var missing []string
+		for _, g := range plan.groups {
+			if id, ok := imp.groupIDs[g][plan.platform]; ok {
+				groupIDs = append(groupIDs, id)
+			} else {
+				missing = append(missing, g)
+			}
+		}
+
+		for i, key := range keys {
+			item := base
+			ref := fmt.Sprintf("%s:%d", imp.flavor, ch.ID)
+			if len(keys) > 1 {
+				suffix := fmt.Sprintf("#%d", i+1)
+				ref += suffix
+				item.SourceID += suffix
+				item.Name = fmt.Sprintf("%s %s", name, suffix)
+			}
+			if len(missing) > 0 {
+				item.Message = "not bound to groups: " + strings.Join(missing, ", ")
+			}
+			if id, ok := imp.existingChannels[ref]; ok {
+				item.Action = OneAPIImportActionExists
+				item.TargetID = id
+				item.Message = ""
+				imp.add(item)
+				continue
+			}
+
+			account := imp.buildAccount(plan, item.Name, ref, key, mapping)
+			if !imp.dryRun {
+				if err := imp.svc.accountRepo.Create(ctx, account); err != nil {
+					item.Action = OneAPIImportActionFailed
+					item.Error = err.Error()
+					imp.add(item)
+					continue
+				}
+				if len(groupIDs) > 0 {
+					if err := imp.svc.accountRepo.BindGroups(ctx, account.ID, groupIDs); err != nil {
+						item.Action = OneAPIImportActionFailed
+						item.TargetID = account.ID
+						item.Error = "bind groups: " + err.Error()
+						imp.add(item)
+						continue
+					}
+				}
+			}
+			item.Action = OneAPIImportActionCreated
+			item.TargetID = account.ID
+			imp.add(item)
+		}
+	}
+}
+
+// oneAPIModelRedirects 解析渠道的模型重定向（请求模型 -> 上游模型）
+func oneAPIModelRedirects(ch *oneapi.Channel) (map[string]any, error) {
+	redirects := map[string]string{}
+	if ch.ModelMapping != nil {
+		if raw := strings.TrimSpace(*ch.ModelMapping); raw != "" && raw != "null" {
+			if err := json.Unmarshal([]byte(raw), &redirects); err != nil {
+				return nil, fmt.Errorf("invalid model_mapping: %w", err)
+			}
+		}
+	}
+	mapping := make(map[string]any, len(redirects))
+	for from, to := range redirects {
+		if from = strings.TrimSpace(from); from != "" && strings.TrimSpace(to) != "" {
+			mapping[from] = strings.TrimSpace(to)
+		}
+	}
+	return mapping, nil
+}
+
+// buildAccount 构造账号。原生平台以 model_mapping 的键作为模型白名单，列表中未重定向的模型映射到自身；
+// compatible 平台分别写入 models 与 model_mapping。
+func (imp *oneAPIImporter) buildAccount(plan oneAPIChannelPlan, name, ref, key string, redirects map[string]any) *Account {
+	ch := plan.src
+	models := oneapi.SplitList(ch.Models)
+	credentials := map[string]any{"api_key": key}
+	if plan.baseURL != "" {
+		credentials["base_url"] = plan.baseURL
+	}
+
+	if plan.platform == PlatformCompatible {
+		if len(models) > 0 {
+			list := make([]any, 0, len(models))
+			for _, m := range models {
+				list = append(list, m)
+			}
+			credentials["models"] = list
+		}
+		if len(redirects) > 0 {
+			credentials["model_mapping"] = redirects
+		}
+	} else if len(models) > 0 || len(redirects) > 0 {
+		mapping := make(map[string]any, len(models)+len(redirects))
+		for _, m := range models {
+			mapping[m] = m
+		}
+		for from, to := range redirects {
+			mapping[from] = to
+		}
+		credentials["model_mapping"] = mapping
+	}
+
+	priority := 50
+	if ch.Priority != nil {
+		// one-api 数值越大越优先，sub2api 越小越优先
+		priority = 50 - int(*ch.Priority)
+	}
+	priority = min(max(priority, 1), 100)
+
+	status := StatusActive
+	if ch.Status != oneapi.StatusEnabled {
+		status = "inactive"
+	}
+	notes := fmt.Sprintf("Imported from %s channel #%d", imp.flavor, ch.ID)
+	return &Account{
+		Name:        name,
+		Notes:       &notes,
+		Platform:    plan.platform,
+		Type:        AccountTypeAPIKey,
+		Credentials: credentials,
+		Extra: map[string]any{
+			"oneapi_channel":      ref,
+			"oneapi_channel_type": ch.Type,
+			"oneapi_imported_at":  imp.now.UTC().Format(time.RFC3339),
+		},
+		Concurrency: oneAPIAccountConcurrency,
+		Priority:    priority,
+		Status:      status,
+		Schedulable: true,
+	}
+}
+
+func (imp *oneAPIImporter) importUsers(ctx context.Context) {
+	concurrency := 0
+	if !imp.input.SkipUsers && imp.svc.settingService != nil {
+		concurrency = imp.svc.settingService.GetDefaultConcurrency(ctx)
+	}
+	for i := range imp.dump.Users {
+		u := &imp.dump.Users[i]
+		item := OneAPIImportItem{
+			Kind:     OneAPIImportKindUser,
+			SourceID: strconv.FormatInt(u.ID, 10),
+			Name:     u.Username,
+		}
+		if u.DeletedAt != nil && *u.DeletedAt != "" {
+			if !imp.input.SkipUsers {
+				item.Action = OneAPIImportActionSkipped
+				item.Message = "user is deleted"
+				imp.add(item)
+			}
+			continue
+		}
+
+		email := strings.ToLower(strings.TrimSpace(u.Email))
+		if email == "" {
+			local := strings.ToLower(strings.TrimSpace(u.Username))
+			if local == "" {
+				local = fmt.Sprintf("user%d", u.ID)
+			}
+			email = local + "@" + imp.emailDomain
+		}
+
+		existingID, err := imp.findUser(ctx, email)
+		if err != nil {
+			if !imp.input.SkipUsers {
+				item.Action = OneAPIImportActionFailed
+				item.Error = err.Error()
+				imp.add(item)
+			}
+			continue
+		}
+		if existingID != 0 {
+			// 已存在（或跳过用户导入）时仍建立映射，令牌可归属到已有用户
+			imp.userIDs[u.ID] = existingID
+			if !imp.input.SkipUsers {
+				item.Action = OneAPIImportActionExists
+				item.TargetID = max(existingID, 0)
+				item.Message = email
+				imp.add(item)
+			}
+			continue
+		}
+		if imp.input.SkipUsers {
+			continue
+		}
+
+		user := &User{
+			Email:       email,
+			Username:    u.Username,
+			Notes:       fmt.Sprintf("Imported from %s user #%d (%s)", imp.flavor, u.ID, u.Username),
+			Role:        RoleUser,
+			Balance:     float64(u.Quota) / imp.perUnit,
+			Concurrency: concurrency,
+			Status:      StatusActive,
+		}
+		if u.Status != oneapi.StatusEnabled {
+			user.Status = StatusDisabled
+		}
+		item.Message = email
+		if strings.HasPrefix(u.Password, "$2") {
+			user.PasswordHash = u.Password
+		} else {
+			if err := user.SetPassword(randomOneAPIPassword()); err != nil {
+				item.Action = OneAPIImportActionFailed
+				item.Error = err.Error()
+				imp.add(item)
+				continue
+			}
+			item.Message += "; password must be reset"
+		}
+
+		if imp.dryRun {
+			user.ID = imp.dryRunID()
+		} else if err := imp.svc.userRepo.Create(ctx, user); err != nil {
+			item.Action = OneAPIImportActionFailed
+			item.Error = err.Error()
+			imp.add(item)
+			continue
+		}
+		imp.userIDs[u.ID] = user.ID
+		imp.userByEmail[email] = user.ID
+		item.Action = OneAPIImportActionCreated
+		item.TargetID = max(user.ID, 0)
+		imp.add(item)
+	}
+}
+
+// findUser 返回邮箱对应的用户 ID（含本次导入中已处理的用户），不存在时返回 0
+func (imp *oneAPIImporter) findUser(ctx context.Context, email string) (int64, error) {
+	if id, ok := imp.userByEmail[email]; ok {
+		return id, nil
+	}
+	user, err := imp.svc.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, ErrUserNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	imp.userByEmail[email] = user.ID
+	return user.ID, nil
+}
+
+func randomOneAPIPassword() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (imp *oneAPIImporter) importTokens(ctx context.Context) {
+	userGroups := make(map[int64]string, len(imp.dump.Users))
+	for _, u := range imp.dump.Users {
+		userGroups[u.ID] = strings.TrimSpace(u.Group)
+	}
+
+	for i := range imp.dump.Tokens {
+		t := &imp.dump.Tokens[i]
+		name := strings.TrimSpace(t.Name)
+		if name == "" {
+			name = fmt.Sprintf("%s token %d", imp.flavor, t.ID)
+		}
+		item := OneAPIImportItem{
+			Kind:     OneAPIImportKindAPIKey,
+			SourceID: strconv.FormatInt(t.ID, 10),
+			Name:     name,
+		}
+		if t.DeletedAt != nil && *t.DeletedAt != "" {
+			item.Action = OneAPIImportActionSkipped
+			item.Message = "token is deleted"
+			imp.add(item)
+			continue
+		}
+		userID, ok := imp.userIDs[t.UserID]
+		if !ok {
+			item.Action = OneAPIImportActionSkipped
+			item.Message = fmt.Sprintf("owner user #%d was not imported", t.UserID)
+			imp.add(item)
+			continue
+		}
+		key := strings.TrimSpace(t.Key)
+		if key == "" {
+			item.Action = OneAPIImportActionFailed
+			item.Error = "token has no key"
+			imp.add(item)
+			continue
+		}
+		if !strings.HasPrefix(key, oneAPIKeyPrefix) {
+			key = oneAPIKeyPrefix + key
+		}
+		if _, seen := imp.seenKeys[key]; seen {
+			item.Action = OneAPIImportActionExists
+			imp.add(item)
+			continue
+		}
+		imp.seenKeys[key] = struct{}{}
+		exists, err := imp.svc.apiKeyRepo.ExistsByKey(ctx, key)
+		if err != nil {
+			item.Action = OneAPIImportActionFailed
+			item.Error = err.Error()
+			imp.add(item)
+			continue
+		}
+		if exists {
+			item.Action = OneAPIImportActionExists
+			imp.add(item)
+			continue
+		}
+
+		apiKey := &APIKey{
+			UserID: userID,
+			Key:    key,
+			Name:   name,
+			Status: StatusAPIKeyActive,
+		}
+		group := strings.TrimSpace(t.Group)
+		if group == "" {
+			group = userGroups[t.UserID]
+		}
+		if group == "" {
+			group = oneAPIDefaultGroup
+		}
+		if platform, id, ok := imp.tokenGroup(group); ok {
+			apiKey.GroupID = &id
+			item.Platform = platform
+		} else {
+			item.Message = fmt.Sprintf("group %q has no imported channels; key is not bound to a group", group)
+		}
+
+		if !bool(t.UnlimitedQuota) {
+			apiKey.Quota = float64(t.RemainQuota+t.UsedQuota) / imp.perUnit
+			apiKey.QuotaUsed = float64(t.UsedQuota) / imp.perUnit
+		}
+		if t.ExpiredTime > 0 {
+			expiresAt := time.Unix(t.ExpiredTime, 0)
+			apiKey.ExpiresAt = &expiresAt
+		}
+		switch t.Status {
+		case oneapi.StatusEnabled:
+			switch {
+			case apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(imp.now):
+				apiKey.Status = StatusAPIKeyExpired
+			case !bool(t.UnlimitedQuota) && t.RemainQuota <= 0:
+				// 额度为 0 在 sub2api 中表示不限额，需显式标记为耗尽
+				apiKey.Status = StatusAPIKeyQuotaExhausted
+			}
+		case oneapi.TokenStatusExpired:
+			apiKey.Status = StatusAPIKeyExpired
+		case oneapi.TokenStatusExhausted:
+			apiKey.Status = StatusAPIKeyQuotaExhausted
+		default:
+			apiKey.Status = StatusAPIKeyDisabled
+		}
+		item.Type = apiKey.Status
+
+		if !imp.dryRun {
+			if err := imp.svc.apiKeyRepo.Create(ctx, apiKey); err != nil {
+				item.Action = OneAPIImportActionFailed
+				item.Error = err.Error()
+				imp.add(item)
+				continue
+			}
+		}
+		item.Action = OneAPIImportActionCreated
+		item.TargetID = apiKey.ID
+		imp.add(item)
+	}
+}
+
+// tokenGroup 选择令牌绑定的目标分组：源分组仅对应一个平台时直接使用，否则优先默认平台
+func (imp *oneAPIImporter) tokenGroup(source string) (string, int64, bool) {
+	ids := imp.groupIDs[source]
+	if len(ids) == 0 {
+		return "", 0, false
+	}
+	if id, ok := ids[imp.defaultPlatform]; ok {
+		return imp.defaultPlatform, id, true
+	}
+	platform := sortedKeys(ids)[0]
+	return platform, ids[platform], true
+}
+
+func (imp *oneAPIImporter) importRedemptions(ctx context.Context) {
+	for i := range imp.dump.Redemptions {
+		r := &imp.dump.Redemptions[i]
+		item := OneAPIImportItem{
+			Kind:     OneAPIImportKindRedeemCode,
+			SourceID: strconv.FormatInt(r.ID, 10),
+			Name:     r.Name,
+			Type:     RedeemTypeBalance,
+		}
+		if r.Status != oneapi.StatusEnabled {
+			item.Action = OneAPIImportActionSkipped
+			item.Message = "redemption code is used or disabled"
+			if r.Status == oneapi.RedemptionStatusUsed {
+				item.Message = "redemption code is already used"
+			}
+			imp.add(item)
+			continue
+		}
+		code := strings.TrimSpace(r.Key)
+		if code == "" {
+			item.Action = OneAPIImportActionFailed
+			item.Error = "redemption code has no key"
+			imp.add(item)
+			continue
+		}
+		if _, seen := imp.seenCodes[code]; seen {
+			item.Action = OneAPIImportActionExists
+			imp.add(item)
+			continue
+		}
+		imp.seenCodes[code] = struct{}{}
+		existing, err := imp.svc.redeemRepo.GetByCode(ctx, code)
+		if err != nil && !errors.Is(err, ErrRedeemCodeNotFound) {
+			item.Action = OneAPIImportActionFailed
+			item.Error = err.Error()
+			imp.add(item)
+			continue
+		}
+		if existing != nil {
+			item.Action = OneAPIImportActionExists
+			item.TargetID = existing.ID
+			imp.add(item)
+			continue
+		}
+
+		redeem := &RedeemCode{
+			Code:   code,
+			Type:   RedeemTypeBalance,
+			Value:  float64(r.Quota) / imp.perUnit,
+			Status: StatusUnused,
+			Notes:  fmt.Sprintf("Imported from %s redemption #%d (%s)", imp.flavor, r.ID, r.Name),
+		}
+		if !imp.dryRun {
+			if err := imp.svc.redeemRepo.Create(ctx, redeem); err != nil {
+				item.Action = OneAPIImportActionFailed
+				item.Error = err.Error()
+				imp.add(item)
+				continue
+			}
+		}
+		item.Action = OneAPIImportActionCreated
+		item.TargetID = redeem.ID
+		imp.add(item)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
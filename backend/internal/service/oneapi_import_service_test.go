@@ -0,0 +1,277 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/oneapi"
+	"github.com/stretchr/testify/require"
+)
+
+type oneAPIUserRepoStub struct {
+	UserRepository
+	users []*User
+}
+
+func (r *oneAPIUserRepoStub) Create(_ context.Context, user *User) error {
+	user.ID = int64(len(r.users) + 100)
+	cloned := *user
+	r.users = append(r.users, &cloned)
+	return nil
+}
+
+func (r *oneAPIUserRepoStub) GetByEmail(_ context.Context, email string) (*User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+type oneAPIKeyRepoStub struct {
+	APIKeyRepository
+	keys []*APIKey
+}
+
+func (r *oneAPIKeyRepoStub) Create(_ context.Context, key *APIKey) error {
+	key.ID = int64(len(r.keys) + 1)
+	cloned := *key
+	r.keys = append(r.keys, &cloned)
+	return nil
+}
+
+func (r *oneAPIKeyRepoStub) ExistsByKey(_ context.Context, key string) (bool, error) {
+	for _, k := range r.keys {
+		if k.Key == key {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type oneAPIRedeemRepoStub struct {
+	RedeemCodeRepository
+	codes []*RedeemCode
+}
+
+func (r *oneAPIRedeemRepoStub) Create(_ context.Context, code *RedeemCode) error {
+	code.ID = int64(len(r.codes) + 1)
+	cloned := *code
+	r.codes = append(r.codes, &cloned)
+	return nil
+}
+
+func (r *oneAPIRedeemRepoStub) GetByCode(_ context.Context, code string) (*RedeemCode, error) {
+	for _, c := range r.codes {
+		if c.Code == code {
+			return c, nil
+		}
+	}
+	return nil, ErrRedeemCodeNotFound
+}
+
+type oneAPISettingRepoStub struct {
+	SettingRepository
+}
+
+func (oneAPISettingRepoStub) GetValue(context.Context, string) (string, error) {
+	return "", errors.New("not set")
+}
+
+type oneAPIImportFixture struct {
+	svc      *OneAPIImportService
+	groups   *configSyncGroupRepoStub
+	accounts *configSyncAccountRepoStub
+	users    *oneAPIUserRepoStub
+	keys     *oneAPIKeyRepoStub
+	codes    *oneAPIRedeemRepoStub
+}
+
+func newOneAPIImportFixture() *oneAPIImportFixture {
+	f := &oneAPIImportFixture{
+		groups:   &configSyncGroupRepoStub{},
+		accounts: &configSyncAccountRepoStub{},
+		users:    &oneAPIUserRepoStub{},
+		keys:     &oneAPIKeyRepoStub{},
+		codes:    &oneAPIRedeemRepoStub{},
+	}
+	cfg := &config.Config{}
+	cfg.Default.UserConcurrency = 5
+	f.svc = NewOneAPIImportService(f.accounts, f.groups, f.users, f.keys, f.codes, NewSettingService(oneAPISettingRepoStub{}, cfg))
+	return f
+}
+
+const oneAPITestExport = `{
+	"channels": [
+		{"id": 1, "type": 1, "key": "sk-openai-1\nsk-openai-2", "status": 1, "name": "openai", "models": "gpt-4o,gpt-4o-mini", "group": "default,vip", "model_mapping": "{\"gpt-4\": \"gpt-4o\"}", "priority": 10},
+		{"id": 2, "type": 14, "key": "sk-ant", "status": 2, "name": "claude", "base_url": "https://relay.example.com/v1", "models": "claude-sonnet-4-5", "group": "vip"},
+		{"id": 3, "type": 36, "key": "sk-ds", "status": 1, "name": "deepseek", "models": "deepseek-chat", "group": "default"},
+		{"id": 4, "type": 1, "key": "sk-proxy", "status": 1, "name": "proxy", "base_url": "https://proxy.example.com", "group": "default"},
+		{"id": 5, "type": 33, "key": "aws", "status": 1, "name": "bedrock"},
+		{"id": 6, "type": 8, "key": "sk-custom", "status": 1, "name": "custom"}
+	],
+	"users": [
+		{"id": 1, "username": "alice", "password": "$2a$10$abcdefghijklmnopqrstuv", "status": 1, "email": "Alice@Example.com", "group": "vip", "quota": 1000000},
+		{"id": 2, "username": "bob", "password": "plain", "status": 2, "group": "default", "quota": 250000},
+		{"id": 3, "username": "gone", "status": 1, "deleted_at": "2024-01-01T00:00:00Z"}
+	],
+	"tokens": [
+		{"id": 1, "user_id": 1, "key": "alicekey", "status": 1, "name": "main", "expired_time": -1, "remain_quota": 400000, "used_quota": 100000},
+		{"id": 2, "user_id": 2, "key": "bobkey", "status": 1, "name": "", "expired_time": -1, "unlimited_quota": true},
+		{"id": 3, "user_id": 3, "key": "gonekey", "status": 1},
+		{"id": 4, "user_id": 1, "key": "spent", "status": 1, "expired_time": -1, "remain_quota": 0, "used_quota": 500000}
+	],
+	"redemptions": [
+		{"id": 1, "key": "code-unused", "status": 1, "name": "promo", "quota": 5000000},
+		{"id": 2, "key": "code-used", "status": 3, "name": "promo", "quota": 5000000}
+	],
+	"options": [
+		{"key": "QuotaPerUnit", "value": "500000"},
+		{"key": "GroupRatio", "value": "{\"default\": 1, \"vip\": 0.8}"}
+	]
+}`
+
+func decodeOneAPITestExport(t *testing.T) *oneapi.Dump {
+	t.Helper()
+	dump, err := oneapi.Decode(strings.NewReader(oneAPITestExport))
+	require.NoError(t, err)
+	return dump
+}
+
+// oneAPIResultItem 按来源 ID（分组按目标名称）查找导入项
+func oneAPIResultItem(t *testing.T, result *OneAPIImportResult, kind, sourceID string) OneAPIImportItem {
+	t.Helper()
+	for _, item := range result.Items {
+		if item.Kind == kind && (item.SourceID == sourceID || item.Name == sourceID) {
+			return item
+		}
+	}
+	t.Fatalf("item %s/%s not found", kind, sourceID)
+	return OneAPIImportItem{}
+}
+
+func TestOneAPIImportService_Import(t *testing.T) {
+	f := newOneAPIImportFixture()
+	ctx := context.Background()
+	input := OneAPIImportInput{Source: OneAPISource{Export: decodeOneAPITestExport(t)}}
+
+	preview, err := f.svc.Preview(ctx, input)
+	require.NoError(t, err)
+	require.True(t, preview.DryRun)
+	require.Empty(t, f.groups.groups)
+	require.Empty(t, f.accounts.accounts)
+	require.Empty(t, f.users.users)
+	require.Empty(t, f.keys.keys)
+
+	result, err := f.svc.Import(ctx, input)
+	require.NoError(t, err)
+	require.Zero(t, result.Failed, "%+v", result.Items)
+	require.Equal(t, preview.Created, result.Created)
+	require.Equal(t, preview.Skipped, result.Skipped)
+
+	// 分组：default 被 openai 与 compatible 使用，按平台拆分；vip 被 openai 与 anthropic 使用
+	vipOpenAI := f.groups.byName("vip-openai")
+	require.NotNil(t, vipOpenAI)
+	require.Equal(t, 0.8, vipOpenAI.RateMultiplier)
+	require.NotNil(t, f.groups.byName("vip-anthropic"))
+	require.NotNil(t, f.groups.byName("default-compatible"))
+	require.Nil(t, f.groups.byName("vip"))
+
+	// 多 Key 渠道拆分为多个账号，模型列表与重定向合并为白名单
+	openai1 := f.accounts.byName("openai #1")
+	require.NotNil(t, openai1)
+	require.Equal(t, PlatformOpenAI, openai1.Platform)
+	require.Equal(t, "sk-openai-1", openai1.Credentials["api_key"])
+	require.Equal(t, map[string]any{"gpt-4o": "gpt-4o", "gpt-4o-mini": "gpt-4o-mini", "gpt-4": "gpt-4o"}, openai1.Credentials["model_mapping"])
+	require.Equal(t, 40, openai1.Priority)
+	require.Len(t, openai1.GroupIDs, 2)
+	require.NotNil(t, f.accounts.byName("openai #2"))
+
+	claude := f.accounts.byName("claude")
+	require.Equal(t, PlatformAnthropic, claude.Platform)
+	require.Equal(t, "https://relay.example.com", claude.Credentials["base_url"])
+	require.Equal(t, "inactive", claude.Status)
+
+	deepseek := f.accounts.byName("deepseek")
+	require.Equal(t, PlatformCompatible, deepseek.Platform)
+	require.Equal(t, "https://api.deepseek.com/v1", deepseek.Credentials["base_url"])
+	require.Equal(t, []any{"deepseek-chat"}, deepseek.Credentials["models"])
+
+	proxy := f.accounts.byName("proxy")
+	require.Equal(t, PlatformCompatible, proxy.Platform)
+	require.Equal(t, "https://proxy.example.com/v1", proxy.Credentials["base_url"])
+
+	require.Equal(t, OneAPIImportActionSkipped, oneAPIResultItem(t, result, OneAPIImportKindAccount, "5").Action)
+	require.Equal(t, OneAPIImportActionSkipped, oneAPIResultItem(t, result, OneAPIImportKindAccount, "6").Action)
+
+	// 用户：额度换算为余额，bcrypt 哈希原样保留，缺失邮箱时使用默认域名
+	require.Len(t, f.users.users, 2)
+	alice, err := f.users.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	require.Equal(t, 2.0, alice.Balance)
+	require.Equal(t, "$2a$10$abcdefghijklmnopqrstuv", alice.PasswordHash)
+	require.Equal(t, 5, alice.Concurrency)
+	bob, err := f.users.GetByEmail(ctx, "bob@oneapi.local")
+	require.NoError(t, err)
+	require.Equal(t, StatusDisabled, bob.Status)
+	require.True(t, strings.HasPrefix(bob.PasswordHash, "$2"))
+	require.Contains(t, oneAPIResultItem(t, result, OneAPIImportKindUser, "2").Message, "password must be reset")
+
+	// 令牌：保留 Key 并补前缀，额度换算为 USD；分组对应多个平台时绑定默认平台
+	require.Len(t, f.keys.keys, 3)
+	aliceKey := f.keys.keys[0]
+	require.Equal(t, "sk-alicekey", aliceKey.Key)
+	require.Equal(t, alice.ID, aliceKey.UserID)
+	require.Equal(t, 1.0, aliceKey.Quota)
+	require.Equal(t, 0.2, aliceKey.QuotaUsed)
+	require.Equal(t, vipOpenAI.ID, *aliceKey.GroupID)
+	require.Zero(t, f.keys.keys[1].Quota)
+	require.Equal(t, StatusAPIKeyQuotaExhausted, f.keys.keys[2].Status)
+	require.Equal(t, OneAPIImportActionSkipped, oneAPIResultItem(t, result, OneAPIImportKindAPIKey, "3").Action)
+
+	require.Len(t, f.codes.codes, 1)
+	require.Equal(t, 10.0, f.codes.codes[0].Value)
+	require.Equal(t, RedeemTypeBalance, f.codes.codes[0].Type)
+
+	// 重复执行不会重复创建
+	again, err := f.svc.Import(ctx, input)
+	require.NoError(t, err)
+	require.Zero(t, again.Created, "%+v", again.Items)
+	require.Zero(t, again.Failed)
+	require.Equal(t, result.Created, again.Existing)
+	require.Len(t, f.accounts.accounts, 5)
+}
+
+func TestOneAPIImportService_Options(t *testing.T) {
+	ctx := context.Background()
+
+	f := newOneAPIImportFixture()
+	f.groups.groups = append(f.groups.groups, &Group{ID: 1, Name: "vip-openai", Platform: PlatformGemini})
+	result, err := f.svc.Import(ctx, OneAPIImportInput{
+		Source:          OneAPISource{Export: decodeOneAPITestExport(t)},
+		QuotaPerUnit:    1000000,
+		TypeOverrides:   map[int]string{8: PlatformOpenAI},
+		SkipUsers:       true,
+		SkipRedemptions: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, OneAPIImportActionFailed, oneAPIResultItem(t, result, OneAPIImportKindGroup, "vip-openai").Action)
+	require.Equal(t, OneAPIImportActionCreated, oneAPIResultItem(t, result, OneAPIImportKindAccount, "6").Action)
+	require.Empty(t, f.users.users)
+	require.Empty(t, f.codes.codes)
+	// 未导入用户时令牌无法归属
+	require.Empty(t, f.keys.keys)
+
+	_, err = f.svc.Import(ctx, OneAPIImportInput{})
+	require.ErrorIs(t, err, ErrOneAPISourceRequired)
+	_, err = f.svc.Preview(ctx, OneAPIImportInput{Source: OneAPISource{Export: &oneapi.Dump{}}, Flavor: "other"})
+	require.ErrorIs(t, err, ErrOneAPIInvalidFlavor)
+	_, err = f.svc.Preview(ctx, OneAPIImportInput{Source: OneAPISource{Export: &oneapi.Dump{}}, TypeOverrides: map[int]string{8: "antigravity"}})
+	require.ErrorIs(t, err, ErrOneAPIInvalidPlatform)
+}
@@ -5,8 +5,18 @@ import (
 	"database/sql"
 	"hash/fnv"
 	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
+// OpsLeaderLockReleaseScript 释放运维后台任务的 Redis leader 锁：仅当锁仍由本实例持有时删除
+var OpsLeaderLockReleaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+  return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 func hashAdvisoryLockID(key string) int64 {
 	h := fnv.New64a()
 	_, _ = h.Write([]byte(key))
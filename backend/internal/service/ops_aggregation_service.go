@@ -373,13 +373,6 @@ func (s *OpsAggregationService) isMonitoringEnabled(ctx context.Context) bool {
 	}
 }
 
-var opsAggReleaseScript = redis.NewScript(`
-if redis.call("GET", KEYS[1]) == ARGV[1] then
-  return redis.call("DEL", KEYS[1])
-end
-return 0
-`)
-
 func (s *OpsAggregationService) tryAcquireLeaderLock(ctx context.Context, key string, ttl time.Duration, logPrefix string) (func(), bool) {
 	if s == nil {
 		return nil, false
@@ -400,9 +393,9 @@ func (s *OpsAggregationService) tryAcquireLeaderLock(ctx context.Context, key st
 			release := func() {
 				ctx2, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 				defer cancel()
-				_, _ = opsAggReleaseScript.Run(ctx2, s.redisClient, []string{key}, s.instanceID).Result()
+				_, _ = OpsLeaderLockReleaseScript.Run(ctx2, s.redisClient, []string{key}, s.instanceID).Result()
 			}
-			return release, true
+			return trackLeaderLock(key, ttl, release), true
 		}
 		// Redis error: fall through to DB advisory lock.
 	}
@@ -412,7 +405,7 @@ func (s *OpsAggregationService) tryAcquireLeaderLock(ctx context.Context, key st
 		s.maybeLogSkip(logPrefix)
 		return nil, false
 	}
-	return release, true
+	return trackLeaderLock(key, ttl, release), true
 }
 
 func (s *OpsAggregationService) maybeLogSkip(prefix string) {
@@ -24,13 +24,6 @@ const (
 	opsAlertEvaluatorSkipLogInterval = 1 * time.Minute
 )
 
-var opsAlertEvaluatorReleaseScript = redis.NewScript(`
-if redis.call("GET", KEYS[1]) == ARGV[1] then
-  return redis.call("DEL", KEYS[1])
-end
-return 0
-`)
-
 type OpsAlertEvaluatorService struct {
 	opsService   *OpsService
 	opsRepo      OpsRepository
@@ -805,9 +798,9 @@ func (s *OpsAlertEvaluatorService) tryAcquireLeaderLock(ctx context.Context, loc
 		s.maybeLogSkip(key)
 		return nil, false
 	}
-	return func() {
-		_, _ = opsAlertEvaluatorReleaseScript.Run(ctx, s.redisClient, []string{key}, s.instanceID).Result()
-	}, true
+	return trackLeaderLock(key, ttl, func() {
+		_, _ = OpsLeaderLockReleaseScript.Run(ctx, s.redisClient, []string{key}, s.instanceID).Result()
+	}), true
 }
 
 func (s *OpsAlertEvaluatorService) maybeLogSkip(key string) {
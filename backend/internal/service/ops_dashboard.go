@@ -61,6 +61,22 @@ func (s *OpsService) GetDashboardOverview(ctx context.Context, filter *OpsDashbo
 		log.Printf("[Ops] ListJobHeartbeats failed: %v", err)
 	}
 
+	if s.usageBilling != nil {
+		if stats, err := s.usageBilling.Stats(ctx); err == nil {
+			overview.UsageOutbox = stats
+		} else {
+			log.Printf("[Ops] Get usage outbox stats failed: %v", err)
+		}
+	}
+
+	if s.fairQueue.Enabled() {
+		if stats, err := s.fairQueue.Stats(ctx); err == nil {
+			overview.FairQueue = stats
+		} else {
+			log.Printf("[Ops] Get fair queue stats failed: %v", err)
+		}
+	}
+
 	overview.HealthScore = computeDashboardHealthScore(time.Now().UTC(), overview)
 
 	return overview, nil
@@ -45,6 +45,12 @@ type OpsDashboardOverview struct {
 	// Background jobs health (heartbeats).
 	JobHeartbeats []*OpsJobHeartbeat `json:"job_heartbeats"`
 
+	// Usage billing outbox lag (best-effort, omitted when unavailable).
+	UsageOutbox *UsageOutboxStats `json:"usage_outbox,omitempty"`
+
+	// Per-group fair queue depth when accounts are saturated (omitted when disabled).
+	FairQueue *FairQueueStats `json:"fair_queue,omitempty"`
+
 	SuccessCount         int64 `json:"success_count"`
 	ErrorCountTotal      int64 `json:"error_count_total"`
 	BusinessLimitedCount int64 `json:"business_limited_count"`
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+)
+
+// GetFairQueueStats returns per-group fair queue depth and estimated wait.
+// 未启用公平队列时返回 enabled=false 与空列表。
+func (s *OpsService) GetFairQueueStats(ctx context.Context) (*FairQueueStats, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	return s.fairQueue.Stats(ctx)
+}
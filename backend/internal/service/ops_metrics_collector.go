@@ -854,13 +854,6 @@ func (c *OpsMetricsCollector) dbPoolStats() (active int, idle int) {
 	return stats.InUse, stats.Idle
 }
 
-var opsMetricsCollectorReleaseScript = redis.NewScript(`
-if redis.call("GET", KEYS[1]) == ARGV[1] then
-  return redis.call("DEL", KEYS[1])
-end
-return 0
-`)
-
 func (c *OpsMetricsCollector) tryAcquireLeaderLock(ctx context.Context) (func(), bool) {
 	if c == nil || c.redisClient == nil {
 		return nil, true
@@ -878,7 +871,7 @@ func (c *OpsMetricsCollector) tryAcquireLeaderLock(ctx context.Context) (func(),
 			c.maybeLogSkip()
 			return nil, false
 		}
-		return release, true
+		return trackLeaderLock(opsMetricsCollectorLeaderLockKey, opsMetricsCollectorLeaderLockTTL, release), true
 	}
 	if !ok {
 		c.maybeLogSkip()
@@ -888,9 +881,9 @@ func (c *OpsMetricsCollector) tryAcquireLeaderLock(ctx context.Context) (func(),
 	release := func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		_, _ = opsMetricsCollectorReleaseScript.Run(ctx, c.redisClient, []string{opsMetricsCollectorLeaderLockKey}, c.instanceID).Result()
+		_, _ = OpsLeaderLockReleaseScript.Run(ctx, c.redisClient, []string{opsMetricsCollectorLeaderLockKey}, c.instanceID).Result()
 	}
-	return release, true
+	return trackLeaderLock(opsMetricsCollectorLeaderLockKey, opsMetricsCollectorLeaderLockTTL, release), true
 }
 
 func (c *OpsMetricsCollector) maybeLogSkip() {
@@ -76,6 +76,9 @@ type OpsErrorLogDetail struct {
 
 	// vNext metric semantics
 	IsBusinessLimited bool `json:"is_business_limited"`
+
+	// Tracing (optional)
+	TraceID string `json:"trace_id,omitempty"`
 }
 
 type OpsErrorLogFilter struct {
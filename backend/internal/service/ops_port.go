@@ -62,6 +62,7 @@ type OpsRepository interface {
 type OpsInsertErrorLogInput struct {
 	RequestID       string
 	ClientRequestID string
+	TraceID         string // OpenTelemetry trace ID，仅在请求被采样时非空
 
 	UserID    *int64
 	APIKeyID  *int64
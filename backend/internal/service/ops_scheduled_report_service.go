@@ -28,13 +28,6 @@ const (
 
 var opsScheduledReportCronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 
-var opsScheduledReportReleaseScript = redis.NewScript(`
-if redis.call("GET", KEYS[1]) == ARGV[1] then
-  return redis.call("DEL", KEYS[1])
-end
-return 0
-`)
-
 type OpsScheduledReportService struct {
 	opsService   *OpsService
 	userService  *UserService
@@ -612,9 +605,9 @@ func (s *OpsScheduledReportService) tryAcquireLeaderLock(ctx context.Context) (f
 	if !ok {
 		return nil, false
 	}
-	return func() {
-		_, _ = opsScheduledReportReleaseScript.Run(ctx, s.redisClient, []string{key}, s.instanceID).Result()
-	}, true
+	return trackLeaderLock(key, ttl, func() {
+		_, _ = OpsLeaderLockReleaseScript.Run(ctx, s.redisClient, []string{key}, s.instanceID).Result()
+	}), true
 }
 
 func (s *OpsScheduledReportService) getLastRunAt(ctx context.Context, reportType string) time.Time {
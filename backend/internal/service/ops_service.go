@@ -37,6 +37,8 @@ type OpsService struct {
 	openAIGatewayService      *OpenAIGatewayService
 	geminiCompatService       *GeminiMessagesCompatService
 	antigravityGatewayService *AntigravityGatewayService
+	usageBilling              *UsageBillingService
+	fairQueue                 *FairQueueService
 }
 
 func NewOpsService(
@@ -50,6 +52,8 @@ func NewOpsService(
 	openAIGatewayService *OpenAIGatewayService,
 	geminiCompatService *GeminiMessagesCompatService,
 	antigravityGatewayService *AntigravityGatewayService,
+	usageBilling *UsageBillingService,
+	fairQueue *FairQueueService,
 ) *OpsService {
 	return &OpsService{
 		opsRepo:     opsRepo,
@@ -64,6 +68,8 @@ func NewOpsService(
 		openAIGatewayService:      openAIGatewayService,
 		geminiCompatService:       geminiCompatService,
 		antigravityGatewayService: antigravityGatewayService,
+		usageBilling:              usageBilling,
+		fairQueue:                 fairQueue,
 	}
 }
 
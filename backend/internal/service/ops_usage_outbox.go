@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// GetUsageOutboxStats returns the usage billing outbox backlog and consumer lag.
+func (s *OpsService) GetUsageOutboxStats(ctx context.Context) (*UsageOutboxStats, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.usageBilling == nil {
+		return nil, infraerrors.ServiceUnavailable("USAGE_OUTBOX_UNAVAILABLE", "Usage billing outbox not available")
+	}
+	return s.usageBilling.Stats(ctx)
+}
@@ -58,6 +58,7 @@ type LiteLLMRawEntry struct {
 // PricingService 动态价格服务
 type PricingService struct {
 	cfg          *config.Config
+	hotConfig    // 远程地址可热更新，通过 runtimeConfig 读取
 	remoteClient PricingRemoteClient
 	mu           sync.RWMutex
 	pricingData  map[string]*LiteLLMModelPricing
@@ -180,7 +181,7 @@ func (s *PricingService) syncWithRemote() error {
 	}
 
 	// 如果配置了哈希URL，从远程获取哈希进行比对
-	if s.cfg.Pricing.HashURL != "" {
+	if s.runtimeConfig().Pricing.HashURL != "" {
 		remoteHash, err := s.fetchRemoteHash()
 		if err != nil {
 			log.Printf("[Pricing] Failed to fetch remote hash: %v", err)
@@ -214,7 +215,7 @@ func (s *PricingService) syncWithRemote() error {
 
 // downloadPricingData 从远程下载价格数据
 func (s *PricingService) downloadPricingData() error {
-	remoteURL, err := s.validatePricingURL(s.cfg.Pricing.RemoteURL)
+	remoteURL, err := s.validatePricingURL(s.runtimeConfig().Pricing.RemoteURL)
 	if err != nil {
 		return err
 	}
@@ -224,7 +225,7 @@ func (s *PricingService) downloadPricingData() error {
 	defer cancel()
 
 	var expectedHash string
-	if strings.TrimSpace(s.cfg.Pricing.HashURL) != "" {
+	if strings.TrimSpace(s.runtimeConfig().Pricing.HashURL) != "" {
 		expectedHash, err = s.fetchRemoteHash()
 		if err != nil {
 			return fmt.Errorf("fetch remote hash: %w", err)
@@ -396,9 +397,14 @@ func (s *PricingService) useFallbackPricing() error {
 	return s.loadPricingData(fallbackFile)
 }
 
+// runtimeConfig 返回当前配置快照（热更新后为最新发布的快照）
+func (s *PricingService) runtimeConfig() *config.Config {
+	return s.load(s.cfg)
+}
+
 // fetchRemoteHash 从远程获取哈希值
 func (s *PricingService) fetchRemoteHash() (string, error) {
-	hashURL, err := s.validatePricingURL(s.cfg.Pricing.HashURL)
+	hashURL, err := s.validatePricingURL(s.runtimeConfig().Pricing.HashURL)
 	if err != nil {
 		return "", err
 	}
@@ -728,6 +734,13 @@ func (s *PricingService) GetStatus() map[string]any {
 	}
 }
 
+// ModelCount 返回已加载的模型价格条数，0 表示价格数据不可用
+func (s *PricingService) ModelCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.pricingData)
+}
+
 // ForceUpdate 强制更新
 func (s *PricingService) ForceUpdate() error {
 	return s.downloadPricingData()
@@ -19,6 +19,7 @@ type RateLimitService struct {
 	accountRepo             AccountRepository
 	usageRepo               UsageLogRepository
 	cfg                     *config.Config
+	hotConfig               // 过载冷却时间可热更新，通过 runtimeConfig 读取
 	geminiQuotaService      *GeminiQuotaService
 	tempUnschedCache        TempUnschedCache
 	timeoutCounterCache     TimeoutCounterCache
@@ -852,10 +853,15 @@ func parseOpenAIRateLimitResetTime(body []byte) *int64 {
 	return nil
 }
 
+// runtimeConfig 返回当前配置快照（热更新后为最新发布的快照）
+func (s *RateLimitService) runtimeConfig() *config.Config {
+	return s.load(s.cfg)
+}
+
 // handle529 处理529过载错误
 // 根据配置设置过载冷却时间
 func (s *RateLimitService) handle529(ctx context.Context, account *Account) {
-	cooldownMinutes := s.cfg.RateLimit.OverloadCooldownMinutes
+	cooldownMinutes := s.runtimeConfig().RateLimit.OverloadCooldownMinutes
 	if cooldownMinutes <= 0 {
 		cooldownMinutes = 10 // 默认10分钟
 	}
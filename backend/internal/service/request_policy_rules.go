@@ -0,0 +1,302 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// requestPolicyEval 单条策略的评估结果：detail 描述违反情况，apply 执行 modify 改写
+type requestPolicyEval struct {
+	detail string
+	apply  func() ([]byte, error)
+}
+
+// evaluateRequestPolicy 评估请求是否违反策略，未违反时返回 nil
+func evaluateRequestPolicy(policy *model.RequestPolicy, protocol string, body []byte, header http.Header) *requestPolicyEval {
+	switch policy.Kind {
+	case model.PolicyKindMaxTokens:
+		return evalMaxTokensPolicy(policy, protocol, body)
+	case model.PolicyKindThinkingBudget:
+		return evalThinkingBudgetPolicy(policy, protocol, body)
+	case model.PolicyKindAnthropicBetaDeny:
+		return evalBetaDenyPolicy(policy, protocol, body, header)
+	case model.PolicyKindAnthropicBetaForce:
+		return evalBetaForcePolicy(policy, protocol, body, header)
+	case model.PolicyKindServerTools:
+		return evalServerToolsPolicy(policy, body)
+	case model.PolicyKindReasoningEffort:
+		return evalReasoningEffortPolicy(policy, protocol, body)
+	case model.PolicyKindImageInput:
+		return evalImageInputPolicy(protocol, body)
+	}
+	return nil
+}
+
+// maxTokensFields 各协议的最大输出 token 字段
+func maxTokensFields(protocol string) []string {
+	if protocol == model.RequestProtocolOpenAI {
+		// Responses API / Chat Completions（新旧字段）
+		return []string{"max_output_tokens", "max_completion_tokens", "max_tokens"}
+	}
+	return []string{"max_tokens"}
+}
+
+func evalMaxTokensPolicy(policy *model.RequestPolicy, protocol string, body []byte) *requestPolicyEval {
+	limit := int64(*policy.LimitValue)
+	var over []string
+	for _, field := range maxTokensFields(protocol) {
+		if v := gjson.GetBytes(body, field); v.Exists() && v.Int() > limit {
+			over = append(over, field)
+		}
+	}
+	if len(over) == 0 {
+		return nil
+	}
+	return &requestPolicyEval{
+		detail: fmt.Sprintf("%s exceeds %d", strings.Join(over, ", "), limit),
+		apply: func() ([]byte, error) {
+			out := body
+			for _, field := range over {
+				var err error
+				if out, err = sjson.SetBytes(out, field, limit); err != nil {
+					return nil, err
+				}
+			}
+			return out, nil
+		},
+	}
+}
+
+func evalThinkingBudgetPolicy(policy *model.RequestPolicy, protocol string, body []byte) *requestPolicyEval {
+	if protocol != model.RequestProtocolAnthropic {
+		return nil
+	}
+	limit := int64(*policy.LimitValue)
+	v := gjson.GetBytes(body, "thinking.budget_tokens")
+	if !v.Exists() || v.Int() <= limit {
+		return nil
+	}
+	return &requestPolicyEval{
+		detail: fmt.Sprintf("thinking.budget_tokens %d exceeds %d", v.Int(), limit),
+		apply: func() ([]byte, error) {
+			return sjson.SetBytes(body, "thinking.budget_tokens", limit)
+		},
+	}
+}
+
+func splitBetaHeader(header http.Header) []string {
+	if header == nil {
+		return nil
+	}
+	var out []string
+	for _, value := range header.Values("anthropic-beta") {
+		for _, token := range strings.Split(value, ",") {
+			if token = strings.TrimSpace(token); token != "" {
+				out = append(out, token)
+			}
+		}
+	}
+	return out
+}
+
+func evalBetaDenyPolicy(policy *model.RequestPolicy, protocol string, body []byte, header http.Header) *requestPolicyEval {
+	if protocol != model.RequestProtocolAnthropic {
+		return nil
+	}
+	deny := make(map[string]struct{}, len(policy.Params))
+	for _, p := range policy.Params {
+		deny[strings.ToLower(strings.TrimSpace(p))] = struct{}{}
+	}
+	var kept, denied []string
+	for _, token := range splitBetaHeader(header) {
+		if _, ok := deny[strings.ToLower(token)]; ok {
+			denied = append(denied, token)
+			continue
+		}
+		kept = append(kept, token)
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+	return &requestPolicyEval{
+		detail: "anthropic-beta contains " + strings.Join(denied, ", "),
+		apply: func() ([]byte, error) {
+			if len(kept) == 0 {
+				header.Del("anthropic-beta")
+			} else {
+				header.Set("anthropic-beta", strings.Join(kept, ","))
+			}
+			return body, nil
+		},
+	}
+}
+
+func evalBetaForcePolicy(policy *model.RequestPolicy, protocol string, body []byte, header http.Header) *requestPolicyEval {
+	if protocol != model.RequestProtocolAnthropic || header == nil {
+		return nil
+	}
+	current := splitBetaHeader(header)
+	present := make(map[string]struct{}, len(current))
+	for _, token := range current {
+		present[strings.ToLower(token)] = struct{}{}
+	}
+	var missing []string
+	for _, p := range policy.Params {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, ok := present[strings.ToLower(p)]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return &requestPolicyEval{
+		detail: "anthropic-beta missing " + strings.Join(missing, ", "),
+		apply: func() ([]byte, error) {
+			header.Set("anthropic-beta", strings.Join(append(current, missing...), ","))
+			return body, nil
+		},
+	}
+}
+
+// serverToolMatches 按类型前缀匹配服务端工具（如 web_search 匹配 web_search_20250305 / web_search_preview）
+func serverToolMatches(toolType string, prefixes []string) bool {
+	toolType = strings.ToLower(toolType)
+	if toolType == "" {
+		return false
+	}
+	for _, p := range prefixes {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if p != "" && strings.HasPrefix(toolType, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func evalServerToolsPolicy(policy *model.RequestPolicy, body []byte) *requestPolicyEval {
+	tools := gjson.GetBytes(body, "tools")
+	if !tools.IsArray() {
+		return nil
+	}
+	var indexes []int
+	var types []string
+	names := make(map[string]struct{})
+	for i, tool := range tools.Array() {
+		toolType := tool.Get("type").String()
+		if serverToolMatches(toolType, policy.Params) {
+			indexes = append(indexes, i)
+			types = append(types, toolType)
+			if name := tool.Get("name").String(); name != "" {
+				names[name] = struct{}{}
+			}
+		}
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+	remaining := len(tools.Array()) - len(indexes)
+	return &requestPolicyEval{
+		detail: "server tools not allowed: " + strings.Join(types, ", "),
+		apply: func() ([]byte, error) {
+			out := body
+			var err error
+			if remaining == 0 {
+				if out, err = sjson.DeleteBytes(out, "tools"); err != nil {
+					return nil, err
+				}
+			} else {
+				// 从后往前删除，避免下标偏移
+				sort.Sort(sort.Reverse(sort.IntSlice(indexes)))
+				for _, idx := range indexes {
+					if out, err = sjson.DeleteBytes(out, fmt.Sprintf("tools.%d", idx)); err != nil {
+						return nil, err
+					}
+				}
+			}
+			// tool_choice 指向被移除的工具时一并移除，避免上游报错
+			choice := gjson.GetBytes(out, "tool_choice")
+			if choice.IsObject() {
+				_, byName := names[choice.Get("name").String()]
+				if byName || serverToolMatches(choice.Get("type").String(), policy.Params) || remaining == 0 {
+					if out, err = sjson.DeleteBytes(out, "tool_choice"); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return out, nil
+		},
+	}
+}
+
+func evalReasoningEffortPolicy(policy *model.RequestPolicy, protocol string, body []byte) *requestPolicyEval {
+	if protocol != model.RequestProtocolOpenAI {
+		return nil
+	}
+	effort := strings.TrimSpace(policy.Params[0])
+	// Chat Completions 使用 reasoning_effort，Responses API 使用 reasoning.effort
+	field := "reasoning.effort"
+	if gjson.GetBytes(body, "reasoning_effort").Exists() || gjson.GetBytes(body, "messages").Exists() {
+		field = "reasoning_effort"
+	}
+	current := gjson.GetBytes(body, field).String()
+	if strings.EqualFold(current, effort) {
+		return nil
+	}
+	if current == "" {
+		current = "unset"
+	}
+	return &requestPolicyEval{
+		detail: fmt.Sprintf("%s is %s, policy requires %s", field, current, effort),
+		apply: func() ([]byte, error) {
+			return sjson.SetBytes(body, field, effort)
+		},
+	}
+}
+
+// imageContentTypes 各协议中表示图片输入的内容块类型
+var imageContentTypes = map[string]struct{}{
+	"image":       {}, // Anthropic
+	"input_image": {}, // OpenAI Responses
+	"image_url":   {}, // OpenAI Chat Completions
+}
+
+func evalImageInputPolicy(protocol string, body []byte) *requestPolicyEval {
+	listPath := "messages"
+	if protocol == model.RequestProtocolOpenAI && !gjson.GetBytes(body, "messages").Exists() {
+		listPath = "input"
+	}
+	if !containsImageContent(gjson.GetBytes(body, listPath)) {
+		return nil
+	}
+	return &requestPolicyEval{detail: "image input is not allowed"}
+}
+
+func containsImageContent(items gjson.Result) bool {
+	if !items.IsArray() {
+		return false
+	}
+	found := false
+	items.ForEach(func(_, item gjson.Result) bool {
+		if _, ok := imageContentTypes[item.Get("type").String()]; ok {
+			found = true
+			return false
+		}
+		content := item.Get("content")
+		if content.IsArray() && containsImageContent(content) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// RequestPolicyRepository 定义请求参数策略的数据访问接口
+type RequestPolicyRepository interface {
+	// List 获取所有策略
+	List(ctx context.Context) ([]*model.RequestPolicy, error)
+	// GetByID 根据 ID 获取策略
+	GetByID(ctx context.Context, id int64) (*model.RequestPolicy, error)
+	// Create 创建策略
+	Create(ctx context.Context, policy *model.RequestPolicy) (*model.RequestPolicy, error)
+	// Update 更新策略
+	Update(ctx context.Context, policy *model.RequestPolicy) (*model.RequestPolicy, error)
+	// Delete 删除策略
+	Delete(ctx context.Context, id int64) error
+}
+
+// RequestPolicyCache 定义请求参数策略的缓存接口
+type RequestPolicyCache interface {
+	// Get 从缓存获取策略列表
+	Get(ctx context.Context) ([]*model.RequestPolicy, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, policies []*model.RequestPolicy) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// RequestPolicyService 分组请求参数策略服务
+type RequestPolicyService struct {
+	repo RequestPolicyRepository
+
+	// 本地快照（仅启用的策略，按优先级排序）
+	policies *ruleSnapshot[model.RequestPolicy, model.RequestPolicy]
+}
+
+// RequestPolicyViolationError 请求违反 reject 策略
+type RequestPolicyViolationError struct {
+	PolicyID   int64
+	PolicyName string
+	Message    string
+}
+
+func (e *RequestPolicyViolationError) Error() string {
+	return e.Message
+}
+
+// RequestPolicyHit 单条策略的命中记录
+type RequestPolicyHit struct {
+	PolicyID int64
+	Name     string
+	Kind     string
+	Action   string
+	DryRun   bool
+	Detail   string // 违反情况描述
+}
+
+// RequestPolicyResult 策略执行结果
+type RequestPolicyResult struct {
+	Body     []byte // 执行策略后的请求体（未改写时为原始请求体）
+	Modified bool   // 请求体或请求头是否被改写
+	Hits     []RequestPolicyHit
+}
+
+// NewRequestPolicyService 创建请求参数策略服务
+func NewRequestPolicyService(
+	repo RequestPolicyRepository,
+	cache RequestPolicyCache,
+) *RequestPolicyService {
+	return &RequestPolicyService{
+		repo:     repo,
+		policies: newRuleSnapshot("RequestPolicyService", ruleLister[model.RequestPolicy](repo), ruleListCache[model.RequestPolicy](cache), compileRequestPolicies),
+	}
+}
+
+// List 获取所有策略
+func (s *RequestPolicyService) List(ctx context.Context) ([]*model.RequestPolicy, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取策略
+func (s *RequestPolicyService) GetByID(ctx context.Context, id int64) (*model.RequestPolicy, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建策略
+func (s *RequestPolicyService) Create(ctx context.Context, policy *model.RequestPolicy) (*model.RequestPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.policies.invalidateAndNotify()
+
+	return created, nil
+}
+
+// Update 更新策略
+func (s *RequestPolicyService) Update(ctx context.Context, policy *model.RequestPolicy) (*model.RequestPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.policies.invalidateAndNotify()
+
+	return updated, nil
+}
+
+// Delete 删除策略
+func (s *RequestPolicyService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.policies.invalidateAndNotify()
+
+	return nil
+}
+
+// Apply 在转发前对请求执行分组策略
+//
+// 按优先级依次执行匹配的策略：modify 改写请求体/请求头，warn 仅记录日志，
+// reject 返回 *RequestPolicyViolationError；试运行策略只记录将要执行的动作。
+// header 为客户端请求头，anthropic-beta 策略会直接改写它。
+func (s *RequestPolicyService) Apply(groupID int64, protocol string, body []byte, header http.Header) (*RequestPolicyResult, error) {
+	result := &RequestPolicyResult{Body: body}
+	if s == nil {
+		return result, nil
+	}
+	for _, policy := range s.policies.items() {
+		if !policyMatches(policy, groupID, protocol) {
+			continue
+		}
+		eval := evaluateRequestPolicy(policy, protocol, result.Body, header)
+		if eval == nil {
+			continue
+		}
+
+		hit := RequestPolicyHit{
+			PolicyID: policy.ID,
+			Name:     policy.Name,
+			Kind:     policy.Kind,
+			Action:   policy.Action,
+			DryRun:   policy.DryRun,
+			Detail:   eval.detail,
+		}
+		result.Hits = append(result.Hits, hit)
+
+		if policy.DryRun {
+			log.Printf("[RequestPolicy] dry-run: group=%d policy=%d(%s) would %s: %s", groupID, policy.ID, policy.Name, policy.Action, eval.detail)
+			continue
+		}
+		switch policy.Action {
+		case model.PolicyActionReject:
+			log.Printf("[RequestPolicy] rejected: group=%d policy=%d(%s): %s", groupID, policy.ID, policy.Name, eval.detail)
+			return result, &RequestPolicyViolationError{
+				PolicyID:   policy.ID,
+				PolicyName: policy.Name,
+				Message:    policyRejectMessage(policy, eval.detail),
+			}
+		case model.PolicyActionModify:
+			if eval.apply == nil {
+				continue
+			}
+			modified, err := eval.apply()
+			if err != nil {
+				log.Printf("[RequestPolicy] modify failed: group=%d policy=%d(%s): %v", groupID, policy.ID, policy.Name, err)
+				continue
+			}
+			result.Body = modified
+			result.Modified = true
+			log.Printf("[RequestPolicy] modified: group=%d policy=%d(%s): %s", groupID, policy.ID, policy.Name, eval.detail)
+		default:
+			log.Printf("[RequestPolicy] warning: group=%d policy=%d(%s): %s", groupID, policy.ID, policy.Name, eval.detail)
+		}
+	}
+	return result, nil
+}
+
+func policyMatches(policy *model.RequestPolicy, groupID int64, protocol string) bool {
+	if !policy.Enabled {
+		return false
+	}
+	if len(policy.GroupIDs) > 0 {
+		found := false
+		for _, id := range policy.GroupIDs {
+			if id == groupID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(policy.Protocols) > 0 {
+		found := false
+		for _, p := range policy.Protocols {
+			if strings.EqualFold(p, protocol) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func policyRejectMessage(policy *model.RequestPolicy, detail string) string {
+	if policy.RejectMessage != nil && strings.TrimSpace(*policy.RejectMessage) != "" {
+		return *policy.RejectMessage
+	}
+	return fmt.Sprintf("Request rejected by policy: %s", detail)
+}
+
+// compileRequestPolicies 只保留启用的策略并按优先级排序
+func compileRequestPolicies(policies []*model.RequestPolicy) []*model.RequestPolicy {
+	cached := make([]*model.RequestPolicy, 0, len(policies))
+	for _, p := range policies {
+		if p != nil && p.Enabled {
+			cached = append(cached, p)
+		}
+	}
+	sort.SliceStable(cached, func(i, j int) bool {
+		return cached[i].Priority < cached[j].Priority
+	})
+	return cached
+}
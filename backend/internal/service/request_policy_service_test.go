@@ -0,0 +1,171 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func requestPolicyID(p *model.RequestPolicy) *int64 { return &p.ID }
+
+func newRequestPolicyServiceForTest(policies ...*model.RequestPolicy) *RequestPolicyService {
+	for i, p := range policies {
+		p.ID = int64(i + 1)
+		p.Enabled = true
+	}
+	return NewRequestPolicyService(newRuleRepoStub(requestPolicyID, policies...), nil)
+}
+
+func TestRequestPolicy_Validate(t *testing.T) {
+	valid := &model.RequestPolicy{Name: "cap", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionModify, LimitValue: intPtr(1024)}
+	require.NoError(t, valid.Validate())
+
+	cases := []*model.RequestPolicy{
+		{Name: "", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionModify, LimitValue: intPtr(1)},
+		{Name: "x", Kind: model.PolicyKindMaxTokens, Action: "drop", LimitValue: intPtr(1)},
+		{Name: "x", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionModify},
+		{Name: "x", Kind: model.PolicyKindServerTools, Action: model.PolicyActionModify},
+		{Name: "x", Kind: model.PolicyKindReasoningEffort, Action: model.PolicyActionModify, Params: []string{"low", "high"}},
+		{Name: "x", Kind: model.PolicyKindImageInput, Action: model.PolicyActionModify},
+		{Name: "x", Kind: "unknown", Action: model.PolicyActionWarn},
+		{Name: "x", Kind: model.PolicyKindImageInput, Action: model.PolicyActionReject, Protocols: []string{"gemini"}},
+	}
+	requireInvalidRules(t, cases...)
+}
+
+func TestRequestPolicy_ClampMaxTokensAndThinking(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(
+		&model.RequestPolicy{Name: "max", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionModify, LimitValue: intPtr(4096)},
+		&model.RequestPolicy{Name: "thinking", Kind: model.PolicyKindThinkingBudget, Action: model.PolicyActionModify, LimitValue: intPtr(2048)},
+	)
+	body := []byte(`{"model":"claude","max_tokens":32000,"thinking":{"type":"enabled","budget_tokens":16000}}`)
+
+	result, err := svc.Apply(1, model.RequestProtocolAnthropic, body, http.Header{})
+	require.NoError(t, err)
+	require.True(t, result.Modified)
+	require.Len(t, result.Hits, 2)
+	require.Equal(t, int64(4096), gjson.GetBytes(result.Body, "max_tokens").Int())
+	require.Equal(t, int64(2048), gjson.GetBytes(result.Body, "thinking.budget_tokens").Int())
+
+	result, err = svc.Apply(1, model.RequestProtocolOpenAI, []byte(`{"max_output_tokens":100}`), nil)
+	require.NoError(t, err)
+	require.False(t, result.Modified, "未超出上限时不改写")
+}
+
+func TestRequestPolicy_GroupAndProtocolScope(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(&model.RequestPolicy{
+		Name: "scoped", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionReject, LimitValue: intPtr(10),
+		GroupIDs: []int64{7}, Protocols: []string{model.RequestProtocolOpenAI},
+	})
+	body := []byte(`{"max_tokens":100}`)
+
+	_, err := svc.Apply(8, model.RequestProtocolOpenAI, body, nil)
+	require.NoError(t, err, "其他分组不受影响")
+	_, err = svc.Apply(7, model.RequestProtocolAnthropic, body, nil)
+	require.NoError(t, err, "其他协议不受影响")
+
+	_, err = svc.Apply(7, model.RequestProtocolOpenAI, body, nil)
+	var violation *RequestPolicyViolationError
+	require.True(t, errors.As(err, &violation))
+	require.Equal(t, "scoped", violation.PolicyName)
+	require.Contains(t, violation.Message, "max_tokens exceeds 10")
+}
+
+func TestRequestPolicy_AnthropicBeta(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(
+		&model.RequestPolicy{Name: "deny", Kind: model.PolicyKindAnthropicBetaDeny, Action: model.PolicyActionModify, Params: []string{"context-1m-2025-08-07"}},
+		&model.RequestPolicy{Name: "force", Kind: model.PolicyKindAnthropicBetaForce, Action: model.PolicyActionModify, Params: []string{"prompt-caching-2024-07-31"}},
+	)
+	header := http.Header{}
+	header.Set("anthropic-beta", "context-1m-2025-08-07, interleaved-thinking-2025-05-14")
+
+	result, err := svc.Apply(1, model.RequestProtocolAnthropic, []byte(`{}`), header)
+	require.NoError(t, err)
+	require.True(t, result.Modified)
+	require.Equal(t, "interleaved-thinking-2025-05-14,prompt-caching-2024-07-31", header.Get("anthropic-beta"))
+}
+
+func TestRequestPolicy_StripServerTools(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(&model.RequestPolicy{
+		Name: "no-search", Kind: model.PolicyKindServerTools, Action: model.PolicyActionModify, Params: []string{"web_search"},
+	})
+	body := []byte(`{"tools":[{"name":"get_weather","input_schema":{}},{"type":"web_search_20250305","name":"web_search"}],"tool_choice":{"type":"tool","name":"web_search"}}`)
+
+	result, err := svc.Apply(1, model.RequestProtocolAnthropic, body, nil)
+	require.NoError(t, err)
+	tools := gjson.GetBytes(result.Body, "tools").Array()
+	require.Len(t, tools, 1)
+	require.Equal(t, "get_weather", tools[0].Get("name").String())
+	require.False(t, gjson.GetBytes(result.Body, "tool_choice").Exists(), "指向被移除工具的 tool_choice 一并移除")
+
+	result, err = svc.Apply(1, model.RequestProtocolOpenAI, []byte(`{"tools":[{"type":"web_search_preview"}]}`), nil)
+	require.NoError(t, err)
+	require.False(t, gjson.GetBytes(result.Body, "tools").Exists())
+}
+
+func TestRequestPolicy_ForceReasoningEffort(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(&model.RequestPolicy{
+		Name: "effort", Kind: model.PolicyKindReasoningEffort, Action: model.PolicyActionModify, Params: []string{"low"},
+	})
+
+	result, err := svc.Apply(1, model.RequestProtocolOpenAI, []byte(`{"input":"hi","reasoning":{"effort":"high"}}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, "low", gjson.GetBytes(result.Body, "reasoning.effort").String())
+
+	result, err = svc.Apply(1, model.RequestProtocolOpenAI, []byte(`{"messages":[]}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, "low", gjson.GetBytes(result.Body, "reasoning_effort").String(), "Chat Completions 使用 reasoning_effort")
+}
+
+func TestRequestPolicy_RejectImageInput(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(&model.RequestPolicy{
+		Name: "no-image", Kind: model.PolicyKindImageInput, Action: model.PolicyActionReject,
+		RejectMessage: func() *string { s := "images are disabled"; return &s }(),
+	})
+
+	_, err := svc.Apply(1, model.RequestProtocolAnthropic, []byte(`{"messages":[{"role":"user","content":[{"type":"tool_result","content":[{"type":"image","source":{}}]}]}]}`), nil)
+	require.EqualError(t, err, "images are disabled")
+
+	_, err = svc.Apply(1, model.RequestProtocolOpenAI, []byte(`{"input":[{"role":"user","content":[{"type":"input_image","image_url":"x"}]}]}`), nil)
+	require.Error(t, err)
+
+	_, err = svc.Apply(1, model.RequestProtocolAnthropic, []byte(`{"messages":[{"role":"user","content":"hi"}]}`), nil)
+	require.NoError(t, err)
+}
+
+func TestRequestPolicy_DryRunAndWarnDoNotChangeRequest(t *testing.T) {
+	svc := newRequestPolicyServiceForTest(
+		&model.RequestPolicy{Name: "dry", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionReject, DryRun: true, LimitValue: intPtr(10)},
+		&model.RequestPolicy{Name: "warn", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionWarn, LimitValue: intPtr(10)},
+	)
+	body := []byte(`{"max_tokens":100}`)
+
+	result, err := svc.Apply(1, model.RequestProtocolAnthropic, body, nil)
+	require.NoError(t, err)
+	require.False(t, result.Modified)
+	require.Equal(t, body, result.Body)
+	require.Len(t, result.Hits, 2)
+	require.True(t, result.Hits[0].DryRun)
+}
+
+func TestRequestPolicy_NilServiceAndDisabledPolicies(t *testing.T) {
+	var nilSvc *RequestPolicyService
+	result, err := nilSvc.Apply(1, model.RequestProtocolAnthropic, []byte(`{}`), nil)
+	require.NoError(t, err)
+	require.False(t, result.Modified)
+
+	policy := &model.RequestPolicy{Name: "max", Kind: model.PolicyKindMaxTokens, Action: model.PolicyActionReject, LimitValue: intPtr(1)}
+	svc := newRequestPolicyServiceForTest(policy)
+	policy.Enabled = false
+	_, err = svc.Update(context.Background(), policy)
+	require.NoError(t, err)
+	_, err = svc.Apply(1, model.RequestProtocolAnthropic, []byte(`{"max_tokens":100}`), nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+)
+
+// RoutingRuleRepository 定义请求路由规则的数据访问接口
+type RoutingRuleRepository interface {
+	// List 获取所有规则
+	List(ctx context.Context) ([]*model.RoutingRule, error)
+	// GetByID 根据 ID 获取规则
+	GetByID(ctx context.Context, id int64) (*model.RoutingRule, error)
+	// Create 创建规则
+	Create(ctx context.Context, rule *model.RoutingRule) (*model.RoutingRule, error)
+	// Update 更新规则
+	Update(ctx context.Context, rule *model.RoutingRule) (*model.RoutingRule, error)
+	// Delete 删除规则
+	Delete(ctx context.Context, id int64) error
+}
+
+// RoutingRuleCache 定义请求路由规则的缓存接口
+type RoutingRuleCache interface {
+	// Get 从缓存获取规则列表
+	Get(ctx context.Context) ([]*model.RoutingRule, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, rules []*model.RoutingRule) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// RoutingRuleService 分组请求路由规则服务
+type RoutingRuleService struct {
+	repo RoutingRuleRepository
+
+	// 本地快照（预编译规则与目标分组，按优先级排序）
+	rules *ruleSnapshot[model.RoutingRule, compiledRoutingRule]
+}
+
+// RoutingDecision 路由规则命中结果
+type RoutingDecision struct {
+	RuleID   int64
+	RuleName string
+	// Group 路由后的分组；配置了目标账号时为带账号子集的分组副本
+	Group *Group
+	// GroupChanged 是否转入了其他分组（调用方需按目标分组计费）
+	GroupChanged bool
+}
+
+// NewRoutingRuleService 创建请求路由规则服务
+func NewRoutingRuleService(
+	repo RoutingRuleRepository,
+	cache RoutingRuleCache,
+	groupRepo GroupRepository,
+) *RoutingRuleService {
+	compile := withRedirectTargets(groupRepo, compileRoutingRules, func(c *compiledRoutingRule) (*int64, **redirectTarget) {
+		return c.rule.TargetGroupID, &c.target
+	})
+	return &RoutingRuleService{
+		repo:  repo,
+		rules: newRuleSnapshot("RoutingRuleService", ruleLister[model.RoutingRule](repo), ruleListCache[model.RoutingRule](cache), compile),
+	}
+}
+
+// List 获取所有规则
+func (s *RoutingRuleService) List(ctx context.Context) ([]*model.RoutingRule, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取规则
+func (s *RoutingRuleService) GetByID(ctx context.Context, id int64) (*model.RoutingRule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建规则
+func (s *RoutingRuleService) Create(ctx context.Context, rule *model.RoutingRule) (*model.RoutingRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return created, nil
+}
+
+// Update 更新规则
+func (s *RoutingRuleService) Update(ctx context.Context, rule *model.RoutingRule) (*model.RoutingRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return updated, nil
+}
+
+// Delete 删除规则
+func (s *RoutingRuleService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.rules.invalidateAndNotify()
+
+	return nil
+}
+
+// Route 按优先级匹配分组路由规则
+//
+// 返回第一条条件全部满足且目标可用的规则；目标分组不可用（停用、跨平台或订阅分组）时跳过该规则。
+// 订阅分组的请求按订阅额度计费，不允许转入其他分组，只能限定本分组内的账号子集。
+// 未命中时返回 nil，请求按原分组调度。路由只跳转一次，不再匹配目标分组自身的路由规则。
+func (s *RoutingRuleService) Route(ctx context.Context, group *Group, body []byte) *RoutingDecision {
+	if s == nil || group == nil || len(body) == 0 {
+		return nil
+	}
+	var req *routingRequest
+	for _, compiled := range s.rules.items() {
+		if !compiled.appliesToGroup(group.ID) {
+			continue
+		}
+		if req == nil {
+			req = newRoutingRequest(body)
+		}
+		if !compiled.matches(req) {
+			continue
+		}
+
+		rule := compiled.rule
+		target := group
+		if rule.TargetGroupID != nil && *rule.TargetGroupID != group.ID {
+			if group.IsSubscriptionType() {
+				log.Printf("[RoutingRule] skip cross-group routing for subscription group: group=%d rule=%d(%s) target=%d",
+					group.ID, rule.ID, rule.Name, *rule.TargetGroupID)
+				continue
+			}
+			resolved, err := compiled.target.forGroup(group)
+			if err != nil {
+				log.Printf("[RoutingRule] target unavailable: group=%d rule=%d(%s) target=%d err=%v",
+					group.ID, rule.ID, rule.Name, *rule.TargetGroupID, err)
+				continue
+			}
+			target = resolved
+		}
+		routed := withRoutedAccounts(target, rule.TargetAccountIDs)
+		log.Printf("[RoutingRule] routed: group=%d rule=%d(%s) -> group=%d accounts=%v model=%s max_tokens=%d",
+			group.ID, rule.ID, rule.Name, routed.ID, rule.TargetAccountIDs, req.model, req.maxTokens)
+		return &RoutingDecision{
+			RuleID:       rule.ID,
+			RuleName:     rule.Name,
+			Group:        routed,
+			GroupChanged: routed.ID != group.ID,
+		}
+	}
+	return nil
+}
+
+// withRoutedAccounts 返回限定账号子集的分组副本
+//
+// 子集通过请求上下文中的分组传递给调度器，候选账号严格限定在子集内（子集账号均不可用时不回退到分组其他账号），
+// 分组自身的模型路由在子集内继续生效；对所有平台的调度路径一致。
+func withRoutedAccounts(group *Group, accountIDs []int64) *Group {
+	if len(accountIDs) == 0 {
+		return group
+	}
+	cloned := *group
+	cloned.RoutedAccountIDs = append([]int64(nil), accountIDs...)
+	return &cloned
+}
+
+// routedAccountIDs 返回请求上下文中路由规则限定的账号子集，未限定时返回 nil
+// groupID 不为空时要求与上下文分组一致，避免调度器切换到降级分组后仍沿用原分组的子集
+func routedAccountIDs(ctx context.Context, groupID *int64) []int64 {
+	group, ok := ctx.Value(ctxkey.Group).(*Group)
+	if !ok || !IsGroupContextValid(group) || len(group.RoutedAccountIDs) == 0 {
+		return nil
+	}
+	if groupID != nil && *groupID != group.ID {
+		return nil
+	}
+	return group.RoutedAccountIDs
+}
+
+// filterRoutedAccounts 只保留路由规则限定的账号，未限定时原样返回
+func filterRoutedAccounts(ctx context.Context, groupID *int64, accounts []Account) []Account {
+	ids := routedAccountIDs(ctx, groupID)
+	if ids == nil {
+		return accounts
+	}
+	filtered := make([]Account, 0, len(ids))
+	for _, acc := range accounts {
+		if containsInt64(ids, acc.ID) {
+			filtered = append(filtered, acc)
+		}
+	}
+	return filtered
+}
+
+// isRoutedAccountAllowed 粘性会话等按 ID 取账号的路径使用：账号不在上下文分组的路由子集内时返回 false
+func isRoutedAccountAllowed(ctx context.Context, accountID int64) bool {
+	ids := routedAccountIDs(ctx, nil)
+	return ids == nil || containsInt64(ids, accountID)
+}
+
+// InvalidateGroup 分组变更后刷新引用该分组作为目标的规则快照
+func (s *RoutingRuleService) InvalidateGroup(groupID int64) {
+	if s == nil {
+		return
+	}
+	for _, compiled := range s.rules.items() {
+		if compiled.target.references(groupID) {
+			s.rules.invalidateAndNotify()
+			return
+		}
+	}
+}
+
+// redirectTargetLoadTimeout 构建规则快照时加载目标分组的超时
+const redirectTargetLoadTimeout = 3 * time.Second
+
+// redirectTarget 规则快照构建时预解析的目标分组（或其不可用原因），请求路径只读内存
+type redirectTarget struct {
+	id    int64
+	group *Group
+	err   error
+}
+
+// withRedirectTargets 包装规则预处理函数：预处理后解析规则引用的目标分组并写回
+// slot 返回规则配置的目标分组 ID（未配置为 nil）及保存解析结果的字段
+func withRedirectTargets[T any, C any](groupRepo GroupRepository, compile func([]*T) []*C, slot func(*C) (*int64, **redirectTarget)) func([]*T) []*C {
+	return func(items []*T) []*C {
+		compiled := compile(items)
+		ids := make([]int64, 0, len(compiled))
+		for _, c := range compiled {
+			if id, _ := slot(c); id != nil {
+				ids = append(ids, *id)
+			}
+		}
+		targets := resolveRedirectTargets(groupRepo, ids)
+		for _, c := range compiled {
+			if id, target := slot(c); id != nil {
+				*target = targets[*id]
+			}
+		}
+		return compiled
+	}
+}
+
+// resolveRedirectTargets 加载并校验规则引用的目标分组：需启用且非订阅分组
+// 在规则快照构建时调用（规则或分组变更触发刷新），每个分组只查询一次
+func resolveRedirectTargets(groupRepo GroupRepository, ids []int64) map[int64]*redirectTarget {
+	if len(ids) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), redirectTargetLoadTimeout)
+	defer cancel()
+
+	targets := make(map[int64]*redirectTarget, len(ids))
+	for _, id := range ids {
+		if _, ok := targets[id]; ok {
+			continue
+		}
+		target := &redirectTarget{id: id}
+		targets[id] = target
+		if groupRepo == nil {
+			target.err = fmt.Errorf("group repository not configured")
+			continue
+		}
+		group, err := groupRepo.GetByIDLite(ctx, id)
+		switch {
+		case err != nil:
+			target.err = err
+		case !group.IsActive():
+			target.err = fmt.Errorf("target group is not active")
+		case group.IsSubscriptionType():
+			target.err = fmt.Errorf("target group cannot be a subscription group")
+		default:
+			target.group = group
+		}
+	}
+	return targets
+}
+
+// forGroup 校验目标分组可供 group 的请求转入（同平台），返回分组副本
+func (t *redirectTarget) forGroup(group *Group) (*Group, error) {
+	if t == nil {
+		return nil, fmt.Errorf("target group not resolved")
+	}
+	if t.err != nil {
+		return nil, t.err
+	}
+	if t.group.Platform != group.Platform {
+		return nil, fmt.Errorf("target group platform %s differs from %s", t.group.Platform, group.Platform)
+	}
+	cloned := *t.group
+	return &cloned, nil
+}
+
+func (t *redirectTarget) references(groupID int64) bool {
+	return t != nil && t.id == groupID
+}
+
+// compileRoutingRules 只保留启用的规则，预编译匹配条件并按优先级排序
+func compileRoutingRules(rules []*model.RoutingRule) []*compiledRoutingRule {
+	cached := make([]*compiledRoutingRule, 0, len(rules))
+	for _, r := range rules {
+		if r == nil || !r.Enabled {
+			continue
+		}
+		compiled, err := compileRoutingRule(r)
+		if err != nil {
+			log.Printf("[RoutingRuleService] Skip rule %d(%s): %v", r.ID, r.Name, err)
+			continue
+		}
+		cached = append(cached, compiled)
+	}
+	sort.SliceStable(cached, func(i, j int) bool {
+		return cached[i].rule.Priority < cached[j].rule.Priority
+	})
+	return cached
+}
@@ -0,0 +1,271 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/stretchr/testify/require"
+)
+
+func routingRuleID(r *model.RoutingRule) *int64 { return &r.ID }
+
+func newRoutingRuleServiceForTest(groups map[int64]*Group, rules ...*model.RoutingRule) *RoutingRuleService {
+	for i, rule := range rules {
+		rule.ID = int64(i + 1)
+		rule.Enabled = true
+	}
+	return NewRoutingRuleService(newRuleRepoStub(routingRuleID, rules...), nil, &clientRuleGroupRepoStub{groups: groups})
+}
+
+func TestRoutingRule_Validate(t *testing.T) {
+	target := int64(2)
+	valid := &model.RoutingRule{Name: "haiku", ModelPatterns: []string{"claude-3-5-haiku*"}, TargetGroupID: &target}
+	require.NoError(t, valid.Validate())
+
+	zero := int64(0)
+	cases := []*model.RoutingRule{
+		{Name: "", ModelPatterns: []string{"x"}, TargetGroupID: &target},
+		{Name: "a", TargetGroupID: &target},
+		{Name: "a", ModelPatterns: []string{"x"}},
+		{Name: "a", MaxTokensBelow: intPtr(0), TargetGroupID: &target},
+		{Name: "a", RequireNoTools: true, TargetGroupID: &zero},
+		{Name: "a", RequireNoTools: true, GroupIDs: []int64{2}, TargetGroupID: &target},
+		{Name: "a", RequireNoTools: true, TargetAccountIDs: []int64{-1}},
+	}
+	requireInvalidRules(t, cases...)
+}
+
+func TestRoutingRule_ConditionsMustAllMatch(t *testing.T) {
+	target := int64(9)
+	groups := map[int64]*Group{
+		9: {ID: 9, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+	}
+	svc := newRoutingRuleServiceForTest(groups, &model.RoutingRule{
+		Name:             "cheap",
+		GroupIDs:         []int64{1},
+		ModelPatterns:    []string{"claude-3-5-haiku*", "claude-haiku-4-5*"},
+		MaxTokensBelow:   intPtr(1024),
+		RequireNoTools:   true,
+		InputTokensBelow: intPtr(100),
+		TargetGroupID:    &target,
+	})
+	group := &Group{ID: 1, Platform: PlatformAnthropic}
+
+	decision := svc.Route(context.Background(), group, []byte(`{"model":"claude-haiku-4-5-20251001","max_tokens":32,"messages":[{"role":"user","content":"Write a title"}]}`))
+	require.NotNil(t, decision)
+	require.True(t, decision.GroupChanged)
+	require.Equal(t, int64(9), decision.Group.ID)
+
+	misses := []string{
+		`{"model":"claude-sonnet-4-5","max_tokens":32,"messages":[{"role":"user","content":"hi"}]}`,
+		`{"model":"claude-haiku-4-5","max_tokens":4096,"messages":[{"role":"user","content":"hi"}]}`,
+		`{"model":"claude-haiku-4-5","messages":[{"role":"user","content":"hi"}]}`,
+		`{"model":"claude-haiku-4-5","max_tokens":32,"tools":[{"name":"bash"}],"messages":[{"role":"user","content":"hi"}]}`,
+		`{"model":"claude-haiku-4-5","max_tokens":32,"messages":[{"role":"user","content":"` + strings.Repeat("long context ", 200) + `"}]}`,
+	}
+	for _, body := range misses {
+		require.Nil(t, svc.Route(context.Background(), group, []byte(body)), body)
+	}
+
+	// 其他分组不受影响
+	require.Nil(t, svc.Route(context.Background(), &Group{ID: 2, Platform: PlatformAnthropic}, []byte(`{"model":"claude-haiku-4-5","max_tokens":1}`)))
+}
+
+func TestRoutingRule_AccountSubsetKeepsGroupRouting(t *testing.T) {
+	svc := newRoutingRuleServiceForTest(nil, &model.RoutingRule{
+		Name: "probe", MaxTokensBelow: intPtr(2), TargetAccountIDs: []int64{11, 12},
+	})
+	group := &Group{ID: 1, Platform: PlatformAnthropic, ModelRouting: map[string][]int64{"claude-opus*": {1}}, ModelRoutingEnabled: true}
+
+	decision := svc.Route(context.Background(), group, []byte(`{"model":"claude-opus-4-5","max_tokens":1}`))
+	require.NotNil(t, decision)
+	require.False(t, decision.GroupChanged)
+	require.Equal(t, []int64{11, 12}, decision.Group.RoutedAccountIDs)
+	require.Equal(t, []int64{1}, decision.Group.GetRoutingAccountIDs("claude-opus-4-5"), "分组自身的模型路由保持不变")
+	// 原分组不被修改
+	require.Nil(t, group.RoutedAccountIDs)
+}
+
+func TestRoutingRule_SubscriptionGroupStaysInGroup(t *testing.T) {
+	target := int64(9)
+	groups := map[int64]*Group{
+		9: {ID: 9, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+	}
+	svc := newRoutingRuleServiceForTest(groups,
+		&model.RoutingRule{Name: "cheap", RequireNoTools: true, TargetGroupID: &target},
+		&model.RoutingRule{Name: "probe", RequireNoTools: true, TargetAccountIDs: []int64{11}},
+	)
+	subscription := &Group{ID: 1, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeSubscription}
+
+	// 订阅分组跳过跨分组规则，只命中本分组内的账号子集规则，订阅计费不受影响
+	decision := svc.Route(context.Background(), subscription, []byte(`{"model":"claude-haiku-4-5"}`))
+	require.NotNil(t, decision)
+	require.Equal(t, "probe", decision.RuleName)
+	require.False(t, decision.GroupChanged)
+	require.Equal(t, int64(1), decision.Group.ID)
+	require.True(t, decision.Group.IsSubscriptionType())
+
+	standard := &Group{ID: 1, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard}
+	decision = svc.Route(context.Background(), standard, []byte(`{"model":"claude-haiku-4-5"}`))
+	require.NotNil(t, decision)
+	require.Equal(t, "cheap", decision.RuleName)
+	require.True(t, decision.GroupChanged)
+}
+
+func routedGroupContext(group *Group, accountIDs ...int64) context.Context {
+	return context.WithValue(context.Background(), ctxkey.Group, withRoutedAccounts(group, accountIDs))
+}
+
+func TestRoutingRule_FilterRoutedAccounts(t *testing.T) {
+	group := &Group{ID: 1, Platform: PlatformOpenAI, Status: StatusActive, Hydrated: true}
+	accounts := []Account{{ID: 1}, {ID: 2}, {ID: 3}}
+	groupID, otherGroupID := int64(1), int64(2)
+
+	ctx := routedGroupContext(group, 2, 3)
+	filtered := filterRoutedAccounts(ctx, &groupID, accounts)
+	require.Len(t, filtered, 2)
+	require.Equal(t, int64(2), filtered[0].ID)
+	require.False(t, isRoutedAccountAllowed(ctx, 1))
+	require.True(t, isRoutedAccountAllowed(ctx, 3))
+
+	// 调度器切换到其他分组（如 Claude Code 降级）时不沿用原分组的子集
+	require.Len(t, filterRoutedAccounts(ctx, &otherGroupID, accounts), 3)
+	// 未限定子集时原样返回
+	require.Len(t, filterRoutedAccounts(context.Background(), &groupID, accounts), 3)
+	require.True(t, isRoutedAccountAllowed(context.Background(), 1))
+}
+
+func TestRoutingRule_AnthropicSchedulerStaysInSubset(t *testing.T) {
+	groupID := int64(1)
+	sessionHash := "sticky"
+	group := &Group{
+		ID: groupID, Platform: PlatformAnthropic, Status: StatusActive, Hydrated: true,
+		ModelRoutingEnabled: true, ModelRouting: map[string][]int64{"claude-b": {3}},
+	}
+	repo := &mockAccountRepoForPlatform{
+		accounts: []Account{
+			{ID: 1, Platform: PlatformAnthropic, Priority: 1, Status: StatusActive, Schedulable: true, Concurrency: 5, AccountGroups: []AccountGroup{{GroupID: groupID}}},
+			{ID: 2, Platform: PlatformAnthropic, Priority: 2, Status: StatusActive, Schedulable: true, Concurrency: 5, AccountGroups: []AccountGroup{{GroupID: groupID}}},
+			{ID: 3, Platform: PlatformAnthropic, Priority: 3, Status: StatusActive, Schedulable: true, Concurrency: 5, AccountGroups: []AccountGroup{{GroupID: groupID}}},
+		},
+		accountsByID: map[int64]*Account{},
+	}
+	for i := range repo.accounts {
+		repo.accountsByID[repo.accounts[i].ID] = &repo.accounts[i]
+	}
+	cfg := testConfig()
+	cfg.Gateway.Scheduling.LoadBatchEnabled = true
+	svc := &GatewayService{
+		accountRepo: repo,
+		groupRepo:   &mockGroupRepoForGateway{groups: map[int64]*Group{groupID: group}},
+		cache:       &mockGatewayCacheForPlatform{sessionBindings: map[string]int64{sessionHash: 1}},
+		cfg:         cfg,
+	}
+
+	// 粘性绑定的账号 1 不在子集内；子集内分组模型路由继续生效
+	result, err := svc.SelectAccountWithLoadAwareness(routedGroupContext(group, 2, 3), &groupID, sessionHash, "claude-b", nil, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Account.ID)
+
+	result, err = svc.SelectAccountWithLoadAwareness(routedGroupContext(group, 2, 3), &groupID, "", "claude-a", nil, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), result.Account.ID)
+
+	// 子集账号全部不可用时不回退到分组其他账号
+	_, err = svc.SelectAccountWithLoadAwareness(routedGroupContext(group, 2), &groupID, "", "claude-a", map[int64]struct{}{2: {}}, "")
+	require.Error(t, err)
+}
+
+func TestRoutingRule_OpenAISchedulerStaysInSubset(t *testing.T) {
+	groupID := int64(1)
+	sessionHash := "sticky"
+	group := &Group{ID: groupID, Platform: PlatformOpenAI, Status: StatusActive, Hydrated: true}
+	svc := &OpenAIGatewayService{
+		accountRepo: stubOpenAIAccountRepo{accounts: []Account{
+			{ID: 1, Platform: PlatformOpenAI, Type: AccountTypeAPIKey, Status: StatusActive, Schedulable: true, Concurrency: 1, Priority: 0},
+			{ID: 2, Platform: PlatformOpenAI, Type: AccountTypeAPIKey, Status: StatusActive, Schedulable: true, Concurrency: 1, Priority: 1},
+		}},
+		cache:              &stubGatewayCache{sessionBindings: map[string]int64{"openai:" + sessionHash: 1}},
+		concurrencyService: NewConcurrencyService(stubConcurrencyCache{}),
+	}
+
+	selection, err := svc.SelectAccountWithLoadAwareness(routedGroupContext(group, 2), &groupID, sessionHash, "gpt-5.2", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), selection.Account.ID)
+	if selection.ReleaseFunc != nil {
+		selection.ReleaseFunc()
+	}
+
+	_, err = svc.SelectAccountWithLoadAwareness(routedGroupContext(group, 99), &groupID, "", "gpt-5.2", nil)
+	require.Error(t, err)
+}
+
+func TestRoutingRule_UnavailableTargetFallsThrough(t *testing.T) {
+	subscriptionTarget, activeTarget := int64(8), int64(9)
+	groups := map[int64]*Group{
+		8: {ID: 8, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeSubscription},
+		9: {ID: 9, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+	}
+	svc := newRoutingRuleServiceForTest(groups,
+		&model.RoutingRule{Name: "subscription", RequireNoTools: true, TargetGroupID: &subscriptionTarget},
+		&model.RoutingRule{Name: "standard", RequireNoTools: true, TargetGroupID: &activeTarget},
+	)
+
+	decision := svc.Route(context.Background(), &Group{ID: 1, Platform: PlatformAnthropic}, []byte(`{"model":"claude-haiku-4-5"}`))
+	require.NotNil(t, decision)
+	require.Equal(t, "standard", decision.RuleName)
+	require.Equal(t, int64(9), decision.Group.ID)
+}
+
+func TestRoutingRule_TargetsResolvedWithSnapshot(t *testing.T) {
+	target := int64(9)
+	groupRepo := &clientRuleGroupRepoStub{groups: map[int64]*Group{
+		9: {ID: 9, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+	}}
+	rules := []*model.RoutingRule{
+		{ID: 1, Name: "a", Enabled: true, RequireNoTools: true, TargetGroupID: &target},
+		{ID: 2, Name: "b", Enabled: true, Priority: 1, RequireNoTools: true, TargetGroupID: &target},
+	}
+	svc := NewRoutingRuleService(newRuleRepoStub(routingRuleID, rules...), nil, groupRepo)
+	require.Equal(t, 1, groupRepo.calls, "每个目标分组在构建快照时只加载一次")
+
+	group := &Group{ID: 1, Platform: PlatformAnthropic}
+	body := []byte(`{"model":"claude-haiku-4-5"}`)
+	for i := 0; i < 3; i++ {
+		decision := svc.Route(context.Background(), group, body)
+		require.NotNil(t, decision)
+		require.Equal(t, int64(9), decision.Group.ID)
+	}
+	require.Equal(t, 1, groupRepo.calls, "命中请求不再查询分组")
+
+	// 无关分组变更不刷新快照
+	svc.InvalidateGroup(5)
+	require.Equal(t, 1, groupRepo.calls)
+
+	// 目标分组停用后刷新快照，规则不再命中
+	groupRepo.groups[9] = &Group{ID: 9, Platform: PlatformAnthropic, Status: StatusDisabled, SubscriptionType: SubscriptionTypeStandard}
+	svc.InvalidateGroup(9)
+	require.Equal(t, 2, groupRepo.calls)
+	require.Nil(t, svc.Route(context.Background(), group, body))
+}
+
+func TestRoutingRule_OpenAIFieldsAndNilService(t *testing.T) {
+	target := int64(9)
+	groups := map[int64]*Group{
+		9: {ID: 9, Platform: PlatformOpenAI, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+	}
+	svc := newRoutingRuleServiceForTest(groups, &model.RoutingRule{
+		Name: "mini", ModelPatterns: []string{"gpt-5-mini"}, MaxTokensBelow: intPtr(512), TargetGroupID: &target,
+	})
+	group := &Group{ID: 1, Platform: PlatformOpenAI}
+	require.NotNil(t, svc.Route(context.Background(), group, []byte(`{"model":"gpt-5-mini","max_output_tokens":256,"input":"hi"}`)))
+	require.NotNil(t, svc.Route(context.Background(), group, []byte(`{"model":"gpt-5-mini","max_completion_tokens":256,"messages":[]}`)))
+
+	var nilSvc *RoutingRuleService
+	require.Nil(t, nilSvc.Route(context.Background(), group, []byte(`{"model":"gpt-5-mini","max_output_tokens":256}`)))
+}
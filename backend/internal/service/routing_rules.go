@@ -0,0 +1,105 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/tidwall/gjson"
+)
+
+// compiledRoutingRule 预处理后的路由规则
+type compiledRoutingRule struct {
+	rule          *model.RoutingRule
+	modelPatterns []string
+	target        *redirectTarget // 构建快照时解析的目标分组，未配置时为 nil
+}
+
+// routingRequest 规则匹配所需的请求特征（输入 token 按需估算一次）
+type routingRequest struct {
+	body        []byte
+	model       string
+	maxTokens   int // 0 表示未设置
+	hasTools    bool
+	inputTokens int
+	tokensOnce  bool
+}
+
+func newRoutingRequest(body []byte) *routingRequest {
+	fields := gjson.GetManyBytes(body, "model", "max_tokens", "max_output_tokens", "max_completion_tokens", "tools", "functions")
+	req := &routingRequest{body: body, model: fields[0].String()}
+	// Anthropic 使用 max_tokens，OpenAI Responses 使用 max_output_tokens，Chat Completions 使用 max_completion_tokens
+	for _, f := range fields[1:4] {
+		if f.Exists() && f.Int() > 0 {
+			req.maxTokens = int(f.Int())
+			break
+		}
+	}
+	req.hasTools = len(fields[4].Array()) > 0 || len(fields[5].Array()) > 0
+	return req
+}
+
+func (req *routingRequest) estimatedInputTokens() int {
+	if !req.tokensOnce {
+		req.tokensOnce = true
+		req.inputTokens = estimateRequestInputTokens(req.body)
+	}
+	return req.inputTokens
+}
+
+// estimateRequestInputTokens 粗略估算请求输入 token 数
+// 覆盖 system/instructions、messages/input 文本及工具结果，不含工具定义与图片
+func estimateRequestInputTokens(body []byte) int {
+	total := 0
+	for _, text := range collectGuardrailTexts(body) {
+		total += estimateContextTokensForText(text.value)
+	}
+	return total
+}
+
+func compileRoutingRule(rule *model.RoutingRule) (*compiledRoutingRule, error) {
+	compiled := &compiledRoutingRule{rule: rule}
+	for _, pattern := range rule.ModelPatterns {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			compiled.modelPatterns = append(compiled.modelPatterns, pattern)
+		}
+	}
+	return compiled, nil
+}
+
+func (c *compiledRoutingRule) appliesToGroup(groupID int64) bool {
+	if len(c.rule.GroupIDs) == 0 {
+		return true
+	}
+	for _, id := range c.rule.GroupIDs {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// matches 检查请求是否满足规则的全部条件
+func (c *compiledRoutingRule) matches(req *routingRequest) bool {
+	if len(c.modelPatterns) > 0 {
+		matched := false
+		for _, pattern := range c.modelPatterns {
+			if matchModelPattern(pattern, req.model) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if c.rule.MaxTokensBelow != nil && (req.maxTokens <= 0 || req.maxTokens >= *c.rule.MaxTokensBelow) {
+		return false
+	}
+	if c.rule.RequireNoTools && req.hasTools {
+		return false
+	}
+	if c.rule.InputTokensBelow != nil && req.estimatedInputTokens() >= *c.rule.InputTokensBelow {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// ruleLister 规则仓储的全量读取接口
+type ruleLister[T any] interface {
+	List(ctx context.Context) ([]*T, error)
+}
+
+// ruleListCache 规则列表的 Redis 缓存接口，各规则的 XxxCache 接口均满足
+type ruleListCache[T any] interface {
+	Get(ctx context.Context) ([]*T, bool)
+	Set(ctx context.Context, items []*T) error
+	Invalidate(ctx context.Context) error
+	NotifyUpdate(ctx context.Context) error
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// ruleSnapshot 管理后台维护的规则在本进程内的快照
+//
+// 启动时从数据库加载（失败时退回 Redis），订阅 Pub/Sub 通知刷新；
+// 写操作后调用 invalidateAndNotify 失效 Redis、重载本地快照并通知其他实例。
+// T 为持久化的规则，C 为请求路径使用的预处理结果（过滤、排序、预编译正则等）。
+type ruleSnapshot[T any, C any] struct {
+	name    string // 日志前缀
+	repo    ruleLister[T]
+	cache   ruleListCache[T]
+	compile func(items []*T) []*C
+
+	mu     sync.RWMutex
+	local  []*C
+	loaded bool
+}
+
+// newRuleSnapshot 创建规则快照并完成首次加载与更新订阅
+func newRuleSnapshot[T any, C any](name string, repo ruleLister[T], cache ruleListCache[T], compile func(items []*T) []*C) *ruleSnapshot[T, C] {
+	r := &ruleSnapshot[T, C]{name: name, repo: repo, cache: cache, compile: compile}
+
+	ctx := context.Background()
+	if err := r.reloadFromDB(ctx); err != nil {
+		log.Printf("[%s] Failed to load from DB on startup: %v", name, err)
+		if fallbackErr := r.refresh(ctx); fallbackErr != nil {
+			log.Printf("[%s] Failed to load from cache fallback on startup: %v", name, fallbackErr)
+		}
+	}
+
+	if cache != nil {
+		cache.SubscribeUpdates(ctx, func() {
+			if err := r.refresh(context.Background()); err != nil {
+				log.Printf("[%s] Failed to refresh cache on notification: %v", name, err)
+			}
+		})
+	}
+	return r
+}
+
+// items 返回当前快照，尚未加载时先尝试刷新
+func (r *ruleSnapshot[T, C]) items() []*C {
+	r.mu.RLock()
+	local, loaded := r.local, r.loaded
+	r.mu.RUnlock()
+	if loaded {
+		return local
+	}
+
+	if err := r.refresh(context.Background()); err != nil {
+		log.Printf("[%s] Failed to refresh cache: %v", r.name, err)
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.local
+}
+
+// refresh 刷新本地快照，优先读取 Redis
+func (r *ruleSnapshot[T, C]) refresh(ctx context.Context) error {
+	if r.cache != nil {
+		if items, ok := r.cache.Get(ctx); ok {
+			r.set(items)
+			return nil
+		}
+	}
+	return r.reloadFromDB(ctx)
+}
+
+// reloadFromDB 绕过 Redis 从数据库加载，确保拿到最新值
+// 未配置仓储时视为空规则集
+func (r *ruleSnapshot[T, C]) reloadFromDB(ctx context.Context) error {
+	if r.repo == nil {
+		r.set(nil)
+		return nil
+	}
+	items, err := r.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	if r.cache != nil {
+		if err := r.cache.Set(ctx, items); err != nil {
+			log.Printf("[%s] Failed to set cache: %v", r.name, err)
+		}
+	}
+	r.set(items)
+	return nil
+}
+
+// set 用持久化规则重建本地快照
+func (r *ruleSnapshot[T, C]) set(items []*T) {
+	compiled := r.compile(items)
+	r.mu.Lock()
+	r.local = compiled
+	r.loaded = true
+	r.mu.Unlock()
+}
+
+// clear 清空本地快照，避免刷新失败时继续命中陈旧规则
+func (r *ruleSnapshot[T, C]) clear() {
+	r.mu.Lock()
+	r.local = nil
+	r.loaded = false
+	r.mu.Unlock()
+}
+
+// invalidateAndNotify 写操作后失效缓存、重载本地快照并通知其他实例
+// 使用独立上下文，避免受请求取消影响
+func (r *ruleSnapshot[T, C]) invalidateAndNotify() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if r.cache != nil {
+		if err := r.cache.Invalidate(ctx); err != nil {
+			log.Printf("[%s] Failed to invalidate cache: %v", r.name, err)
+		}
+	}
+	if err := r.reloadFromDB(ctx); err != nil {
+		log.Printf("[%s] Failed to refresh local cache: %v", r.name, err)
+		r.clear()
+	}
+	if r.cache != nil {
+		if err := r.cache.NotifyUpdate(ctx); err != nil {
+			log.Printf("[%s] Failed to notify cache update: %v", r.name, err)
+		}
+	}
+}
+
+// identityRules 直接使用持久化规则，不做预处理
+func identityRules[T any](items []*T) []*T {
+	return items
+}
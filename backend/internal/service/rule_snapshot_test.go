@@ -0,0 +1,182 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+// ruleRepoStub 各类后台规则仓储共用的内存实现，idOf 返回规则的 ID 字段
+type ruleRepoStub[T any] struct {
+	items     []*T
+	idOf      func(*T) *int64
+	listErr   error
+	listCalls int
+}
+
+func newRuleRepoStub[T any](idOf func(*T) *int64, items ...*T) *ruleRepoStub[T] {
+	return &ruleRepoStub[T]{items: items, idOf: idOf}
+}
+
+func (r *ruleRepoStub[T]) List(context.Context) ([]*T, error) {
+	r.listCalls++
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	return r.items, nil
+}
+
+func (r *ruleRepoStub[T]) GetByID(_ context.Context, id int64) (*T, error) {
+	for _, item := range r.items {
+		if *r.idOf(item) == id {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *ruleRepoStub[T]) Create(_ context.Context, item *T) (*T, error) {
+	*r.idOf(item) = int64(len(r.items) + 1)
+	r.items = append(r.items, item)
+	return item, nil
+}
+
+func (r *ruleRepoStub[T]) Update(_ context.Context, item *T) (*T, error) {
+	return item, nil
+}
+
+func (r *ruleRepoStub[T]) Delete(_ context.Context, id int64) error {
+	for i, item := range r.items {
+		if *r.idOf(item) == id {
+			r.items = append(r.items[:i], r.items[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// requireInvalidRules 断言每条规则都返回 *model.ValidationError
+func requireInvalidRules[T interface{ Validate() error }](t *testing.T, rules ...T) {
+	t.Helper()
+	for _, rule := range rules {
+		var validationErr *model.ValidationError
+		require.ErrorAs(t, rule.Validate(), &validationErr, "rule %+v", rule)
+	}
+}
+
+// ruleListCacheStub 内存版规则缓存，记录失效与通知次数
+type ruleListCacheStub[T any] struct {
+	items       []*T
+	ok          bool
+	invalidated int
+	notified    int
+	subscriber  func()
+}
+
+func (c *ruleListCacheStub[T]) Get(context.Context) ([]*T, bool) { return c.items, c.ok }
+
+func (c *ruleListCacheStub[T]) Set(_ context.Context, items []*T) error {
+	c.items, c.ok = items, true
+	return nil
+}
+
+func (c *ruleListCacheStub[T]) Invalidate(context.Context) error {
+	c.items, c.ok = nil, false
+	c.invalidated++
+	return nil
+}
+
+func (c *ruleListCacheStub[T]) NotifyUpdate(context.Context) error {
+	c.notified++
+	return nil
+}
+
+func (c *ruleListCacheStub[T]) SubscribeUpdates(_ context.Context, handler func()) {
+	c.subscriber = handler
+}
+
+func modelPriceID(p *model.ModelPrice) *int64 { return &p.ID }
+
+func TestRuleSnapshot_LoadsAndCompilesOnStartup(t *testing.T) {
+	repo := newRuleRepoStub(modelPriceID,
+		&model.ModelPrice{ID: 1, Model: "a", Enabled: true},
+		&model.ModelPrice{ID: 2, Model: "b"},
+	)
+	cache := &ruleListCacheStub[model.ModelPrice]{}
+	enabledOnly := func(items []*model.ModelPrice) []*string {
+		out := make([]*string, 0, len(items))
+		for _, item := range items {
+			if item.Enabled {
+				out = append(out, &item.Model)
+			}
+		}
+		return out
+	}
+
+	snap := newRuleSnapshot("test", ruleLister[model.ModelPrice](repo), ruleListCache[model.ModelPrice](cache), enabledOnly)
+	items := snap.items()
+	require.Len(t, items, 1)
+	require.Equal(t, "a", *items[0])
+	require.Len(t, cache.items, 2, "启动时把数据库结果写回 Redis")
+	require.NotNil(t, cache.subscriber)
+}
+
+func TestRuleSnapshot_EmptyLoadedSetDoesNotRequery(t *testing.T) {
+	repo := newRuleRepoStub(modelPriceID)
+	snap := newRuleSnapshot("test", ruleLister[model.ModelPrice](repo), nil, identityRules[model.ModelPrice])
+
+	require.Empty(t, snap.items())
+	require.Empty(t, snap.items())
+	require.Equal(t, 1, repo.listCalls, "空规则集也算已加载")
+}
+
+func TestRuleSnapshot_FallsBackToCacheWhenDBFails(t *testing.T) {
+	repo := newRuleRepoStub(modelPriceID)
+	repo.listErr = errors.New("db down")
+	cache := &ruleListCacheStub[model.ModelPrice]{items: []*model.ModelPrice{{ID: 9}}, ok: true}
+
+	snap := newRuleSnapshot("test", ruleLister[model.ModelPrice](repo), ruleListCache[model.ModelPrice](cache), identityRules[model.ModelPrice])
+	require.Len(t, snap.items(), 1)
+	require.Equal(t, int64(9), snap.items()[0].ID)
+}
+
+func TestRuleSnapshot_InvalidateAndNotifyReloadsFromDB(t *testing.T) {
+	repo := newRuleRepoStub(modelPriceID)
+	cache := &ruleListCacheStub[model.ModelPrice]{}
+	snap := newRuleSnapshot("test", ruleLister[model.ModelPrice](repo), ruleListCache[model.ModelPrice](cache), identityRules[model.ModelPrice])
+	require.Empty(t, snap.items())
+
+	// 写入后即使 Redis 里还有旧值，也必须以数据库为准
+	_, _ = repo.Create(context.Background(), &model.ModelPrice{Model: "new"})
+	cache.items, cache.ok = nil, true
+	snap.invalidateAndNotify()
+	require.Len(t, snap.items(), 1)
+	require.Equal(t, 1, cache.invalidated)
+	require.Equal(t, 1, cache.notified)
+
+	// 重载失败时清空本地快照，而不是继续使用旧规则
+	repo.listErr = errors.New("db down")
+	snap.invalidateAndNotify()
+	require.Empty(t, snap.items())
+}
+
+func TestRuleSnapshot_SubscriptionRefreshesFromCache(t *testing.T) {
+	repo := newRuleRepoStub(modelPriceID)
+	cache := &ruleListCacheStub[model.ModelPrice]{}
+	snap := newRuleSnapshot("test", ruleLister[model.ModelPrice](repo), ruleListCache[model.ModelPrice](cache), identityRules[model.ModelPrice])
+	require.Empty(t, snap.items())
+
+	cache.items, cache.ok = []*model.ModelPrice{{ID: 3}}, true
+	cache.subscriber()
+	require.Len(t, snap.items(), 1)
+}
+
+func TestRuleSnapshot_NilRepoIsEmpty(t *testing.T) {
+	snap := newRuleSnapshot[model.ModelPrice]("test", nil, nil, identityRules[model.ModelPrice])
+	require.Empty(t, snap.items())
+}
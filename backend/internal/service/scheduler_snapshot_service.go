@@ -7,6 +7,7 @@ import (
 	"log"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
@@ -17,7 +18,11 @@ var (
 	ErrSchedulerFallbackLimited = errors.New("scheduler db fallback limited")
 )
 
-const outboxEventTimeout = 2 * time.Minute
+const (
+	outboxEventTimeout = 2 * time.Minute
+	// initialRebuildRetryInterval 启动构建失败后的重试间隔，构建成功前就绪检查保持失败
+	initialRebuildRetryInterval = 10 * time.Second
+)
 
 type SchedulerSnapshotService struct {
 	cache         SchedulerCache
@@ -25,12 +30,17 @@ type SchedulerSnapshotService struct {
 	accountRepo   AccountRepository
 	groupRepo     GroupRepository
 	cfg           *config.Config
+	hotConfig     // 积压阈值与回源开关可热更新，通过 runtimeConfig 读取
 	stopCh        chan struct{}
 	stopOnce      sync.Once
 	wg            sync.WaitGroup
 	fallbackLimit *fallbackLimiter
 	lagMu         sync.Mutex
 	lagFailures   int
+
+	// 快照新鲜度（供就绪检查使用）
+	initialized atomic.Bool
+	lastSyncAt  atomic.Int64 // unix nano
 }
 
 func NewSchedulerSnapshotService(
@@ -159,10 +169,42 @@ func (s *SchedulerSnapshotService) UpdateAccountInCache(ctx context.Context, acc
 	return s.cache.SetAccount(ctx, account)
 }
 
+// SnapshotStatus 返回快照是否已完成初始构建，以及最近一次成功同步（构建或 outbox 轮询）的时间
+func (s *SchedulerSnapshotService) SnapshotStatus() (initialized bool, lastSyncAt time.Time) {
+	if s == nil {
+		return false, time.Time{}
+	}
+	if nano := s.lastSyncAt.Load(); nano > 0 {
+		lastSyncAt = time.Unix(0, nano)
+	}
+	return s.initialized.Load(), lastSyncAt
+}
+
+func (s *SchedulerSnapshotService) markSynced(fullRebuild bool) {
+	if fullRebuild {
+		s.initialized.Store(true)
+	}
+	s.lastSyncAt.Store(time.Now().UnixNano())
+}
+
 func (s *SchedulerSnapshotService) runInitialRebuild() {
 	if s.cache == nil {
 		return
 	}
+	for {
+		if err := s.rebuildStartup(); err == nil {
+			s.markSynced(true)
+			return
+		}
+		select {
+		case <-time.After(initialRebuildRetryInterval):
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+func (s *SchedulerSnapshotService) rebuildStartup() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 	defer cancel()
 	buckets, err := s.cache.ListBuckets(ctx)
@@ -173,12 +215,14 @@ func (s *SchedulerSnapshotService) runInitialRebuild() {
 		buckets, err = s.defaultBuckets(ctx)
 		if err != nil {
 			log.Printf("[Scheduler] default buckets failed: %v", err)
-			return
+			return err
 		}
 	}
 	if err := s.rebuildBuckets(ctx, buckets, "startup"); err != nil {
 		log.Printf("[Scheduler] rebuild startup failed: %v", err)
+		return err
 	}
+	return nil
 }
 
 func (s *SchedulerSnapshotService) runOutboxWorker(interval time.Duration) {
@@ -231,6 +275,7 @@ func (s *SchedulerSnapshotService) pollOutbox() {
 		return
 	}
 	if len(events) == 0 {
+		s.markSynced(false)
 		return
 	}
 
@@ -250,6 +295,7 @@ func (s *SchedulerSnapshotService) pollOutbox() {
 		log.Printf("[Scheduler] outbox watermark write failed: %v", err)
 	} else {
 		watermarkForCheck = lastID
+		s.markSynced(false)
 	}
 
 	s.checkOutboxLag(ctx, events[0], watermarkForCheck)
@@ -386,7 +432,7 @@ func (s *SchedulerSnapshotService) rebuildByGroupIDs(ctx context.Context, groupI
 	if len(groupIDs) == 0 {
 		return nil
 	}
-	platforms := []string{PlatformAnthropic, PlatformGemini, PlatformOpenAI, PlatformAntigravity}
+	platforms := []string{PlatformAnthropic, PlatformGemini, PlatformOpenAI, PlatformAntigravity, PlatformCompatible}
 	var firstErr error
 	for _, platform := range platforms {
 		if err := s.rebuildBucketsForPlatform(ctx, platform, groupIDs, reason); err != nil && firstErr == nil {
@@ -474,26 +520,36 @@ func (s *SchedulerSnapshotService) triggerFullRebuild(reason string) error {
 			return err
 		}
 	}
-	return s.rebuildBuckets(ctx, buckets, reason)
+	if err := s.rebuildBuckets(ctx, buckets, reason); err != nil {
+		return err
+	}
+	s.markSynced(true)
+	return nil
+}
+
+// runtimeConfig 返回当前配置快照（热更新后为最新发布的快照）
+func (s *SchedulerSnapshotService) runtimeConfig() *config.Config {
+	return s.load(s.cfg)
 }
 
 func (s *SchedulerSnapshotService) checkOutboxLag(ctx context.Context, oldest SchedulerOutboxEvent, watermark int64) {
-	if oldest.CreatedAt.IsZero() || s.cfg == nil {
+	cfg := s.runtimeConfig()
+	if oldest.CreatedAt.IsZero() || cfg == nil {
 		return
 	}
 
 	lag := time.Since(oldest.CreatedAt)
-	if lagSeconds := int(lag.Seconds()); lagSeconds >= s.cfg.Gateway.Scheduling.OutboxLagWarnSeconds && s.cfg.Gateway.Scheduling.OutboxLagWarnSeconds > 0 {
+	if lagSeconds := int(lag.Seconds()); lagSeconds >= cfg.Gateway.Scheduling.OutboxLagWarnSeconds && cfg.Gateway.Scheduling.OutboxLagWarnSeconds > 0 {
 		log.Printf("[Scheduler] outbox lag warning: %ds", lagSeconds)
 	}
 
-	if s.cfg.Gateway.Scheduling.OutboxLagRebuildSeconds > 0 && int(lag.Seconds()) >= s.cfg.Gateway.Scheduling.OutboxLagRebuildSeconds {
+	if cfg.Gateway.Scheduling.OutboxLagRebuildSeconds > 0 && int(lag.Seconds()) >= cfg.Gateway.Scheduling.OutboxLagRebuildSeconds {
 		s.lagMu.Lock()
 		s.lagFailures++
 		failures := s.lagFailures
 		s.lagMu.Unlock()
 
-		if failures >= s.cfg.Gateway.Scheduling.OutboxLagRebuildFailures {
+		if failures >= cfg.Gateway.Scheduling.OutboxLagRebuildFailures {
 			log.Printf("[Scheduler] outbox lag rebuild triggered: lag=%s failures=%d", lag, failures)
 			s.lagMu.Lock()
 			s.lagFailures = 0
@@ -508,7 +564,7 @@ func (s *SchedulerSnapshotService) checkOutboxLag(ctx context.Context, oldest Sc
 		s.lagMu.Unlock()
 	}
 
-	threshold := s.cfg.Gateway.Scheduling.OutboxBacklogRebuildRows
+	threshold := cfg.Gateway.Scheduling.OutboxBacklogRebuildRows
 	if threshold <= 0 || s.outboxRepo == nil {
 		return
 	}
@@ -615,7 +671,7 @@ func (s *SchedulerSnapshotService) resolveMode(platform string, hasForcePlatform
 }
 
 func (s *SchedulerSnapshotService) guardFallback(ctx context.Context) error {
-	if s.cfg == nil || s.cfg.Gateway.Scheduling.DbFallbackEnabled {
+	if cfg := s.runtimeConfig(); cfg == nil || cfg.Gateway.Scheduling.DbFallbackEnabled {
 		if s.fallbackLimit == nil || s.fallbackLimit.Allow() {
 			return nil
 		}
@@ -625,10 +681,11 @@ func (s *SchedulerSnapshotService) guardFallback(ctx context.Context) error {
 }
 
 func (s *SchedulerSnapshotService) withFallbackTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
-	if s.cfg == nil || s.cfg.Gateway.Scheduling.DbFallbackTimeoutSeconds <= 0 {
+	cfg := s.runtimeConfig()
+	if cfg == nil || cfg.Gateway.Scheduling.DbFallbackTimeoutSeconds <= 0 {
 		return context.WithCancel(ctx)
 	}
-	timeout := time.Duration(s.cfg.Gateway.Scheduling.DbFallbackTimeoutSeconds) * time.Second
+	timeout := time.Duration(cfg.Gateway.Scheduling.DbFallbackTimeoutSeconds) * time.Second
 	if deadline, ok := ctx.Deadline(); ok {
 		remaining := time.Until(deadline)
 		if remaining <= 0 {
@@ -669,7 +726,7 @@ func (s *SchedulerSnapshotService) fullRebuildInterval() time.Duration {
 
 func (s *SchedulerSnapshotService) defaultBuckets(ctx context.Context) ([]SchedulerBucket, error) {
 	buckets := make([]SchedulerBucket, 0)
-	platforms := []string{PlatformAnthropic, PlatformGemini, PlatformOpenAI, PlatformAntigravity}
+	platforms := []string{PlatformAnthropic, PlatformGemini, PlatformOpenAI, PlatformAntigravity, PlatformCompatible}
 	for _, platform := range platforms {
 		buckets = append(buckets, SchedulerBucket{GroupID: 0, Platform: platform, Mode: SchedulerModeSingle})
 		buckets = append(buckets, SchedulerBucket{GroupID: 0, Platform: platform, Mode: SchedulerModeForced})
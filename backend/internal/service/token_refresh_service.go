@@ -29,6 +29,9 @@ const (
 	tokenRefreshTransientCooldownMax  = 15 * time.Minute
 )
 
+// tokenRefreshLeaderLockName 节点注册表中展示的锁名称
+const tokenRefreshLeaderLockName = "token_refresh:leader"
+
 var tokenRefreshLeaderBucket = SchedulerBucket{
 	GroupID:  -1,
 	Platform: "system",
@@ -225,6 +228,7 @@ func (s *TokenRefreshService) shouldRunRefreshCycle(ctx context.Context) bool {
 		return false
 	}
 
+	trackLeaderLock(tokenRefreshLeaderLockName, ttl, nil)
 	return true
 }
 
@@ -0,0 +1,702 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/google/uuid"
+)
+
+const (
+	usageOutboxBlockTimeout   = 2 * time.Second
+	usageOutboxClaimTimeout   = 10 * time.Second
+	usageOutboxApplyTimeout   = 30 * time.Second
+	usageOutboxErrorBackoff   = time.Second
+	usageOutboxRetryBaseDelay = 200 * time.Millisecond
+	usageOutboxBatchAttempts  = 3
+	usageOutboxAppendAttempts = 3
+	usageSpoolReplayInterval  = 10 * time.Second
+)
+
+// UsageBillingEvent 一次请求的用量事件：使用日志及其对应的扣费指令
+// 以 (request_id, api_key_id) 作为幂等键，重复投递只会入库和扣费一次。
+type UsageBillingEvent struct {
+	Log *UsageLog `json:"log"`
+	// BalanceCost 余额模式扣除的金额（已含倍率）
+	BalanceCost float64 `json:"balance_cost,omitempty"`
+	// SubscriptionCost 订阅模式累加的用量（原始费用，不含倍率）
+	SubscriptionCost float64 `json:"subscription_cost,omitempty"`
+	// APIKeyQuotaCost API Key 独立配额累加的金额
+	APIKeyQuotaCost float64 `json:"api_key_quota_cost,omitempty"`
+	// Reservation 转发前持有的费用预留，扣费提交并同步缓存后释放，避免扣费生效前额度被其他请求占用
+	Reservation *CostReservationRef `json:"reservation,omitempty"`
+}
+
+// UsageBillingRepository 用量批量入库接口
+type UsageBillingRepository interface {
+	// BatchCreateUsageLogs 批量写入使用日志，返回每条日志是否为新插入（重复的 request_id 返回 false）
+	BatchCreateUsageLogs(ctx context.Context, logs []*UsageLog) ([]bool, error)
+}
+
+// UsageOutboxMessage 从用量事件流中取出的一条消息
+type UsageOutboxMessage struct {
+	ID         string
+	Event      *UsageBillingEvent
+	Deliveries int64
+	// Payload 原始消息体，转入死信流时原样保留
+	Payload string
+	// DecodeErr 消息体无法解析时的错误，此类消息直接转入死信流
+	DecodeErr error
+}
+
+// UsageOutbox 持久化的用量事件流（Redis Stream 消费组）
+type UsageOutbox interface {
+	// EnsureGroup 确保事件流与消费组存在
+	EnsureGroup(ctx context.Context) error
+	// Append 追加一条用量事件
+	Append(ctx context.Context, event *UsageBillingEvent) error
+	// Claim 优先接管空闲超过 minIdle 的未确认消息，否则阻塞读取新消息
+	Claim(ctx context.Context, consumer string, count int, minIdle, block time.Duration) ([]UsageOutboxMessage, error)
+	// Ack 确认并删除已处理的消息
+	Ack(ctx context.Context, ids ...string) error
+	// DeadLetter 将无法处理的消息转入死信流并从主流移除
+	DeadLetter(ctx context.Context, msg UsageOutboxMessage, reason string) error
+	// Stats 返回积压、未确认与死信数量
+	Stats(ctx context.Context) (*UsageOutboxStats, error)
+}
+
+// UsageOutboxStats 用量计费流水线状态（运维监控展示）
+type UsageOutboxStats struct {
+	Enabled bool `json:"enabled"`
+	// Backlog 尚未确认的事件总数（含已投递未确认的）
+	Backlog int64 `json:"backlog"`
+	// Pending 已投递给消费者但尚未确认的事件数
+	Pending int64 `json:"pending"`
+	// OldestEventAgeSeconds 最早一条未确认事件的等待时长，即消费延迟
+	OldestEventAgeSeconds float64 `json:"oldest_event_age_seconds"`
+	DeadLetters           int64   `json:"dead_letters"`
+	// Spooled 暂存在本地文件、等待重放的事件数
+	Spooled int64 `json:"spooled"`
+
+	// 以下为本实例自启动以来的计数
+	Published     int64      `json:"published"`
+	Fallbacks     int64      `json:"fallbacks"`
+	Applied       int64      `json:"applied"`
+	Duplicates    int64      `json:"duplicates"`
+	Retries       int64      `json:"retries"`
+	DeadLettered  int64      `json:"dead_lettered"`
+	LastAppliedAt *time.Time `json:"last_applied_at,omitempty"`
+}
+
+// UsageBillingService 用量计费流水线
+//
+// 网关只负责把用量事件写入持久化事件流，工作池批量入库使用日志，
+// 并在同一事务内对新插入的日志执行扣费，保证重复投递不会重复扣费。
+// 事件流不可用时回退为同步入库；同步入库也失败时事件暂存到本地文件，由后台协程经同一幂等入库路径重放，
+// 扣费只在入库事务内发生，保证用量不丢失也不重复扣费。
+type UsageBillingService struct {
+	entClient           *dbent.Client
+	repo                UsageBillingRepository
+	userRepo            UserRepository
+	userSubRepo         UserSubscriptionRepository
+	apiKeyService       *APIKeyService
+	billingCacheService *BillingCacheService
+	outbox              UsageOutbox
+	spool               *usageSpool
+	cfg                 *config.Config
+
+	consumerPrefix string
+	stopCh         chan struct{}
+	stopOnce       sync.Once
+	wg             sync.WaitGroup
+
+	published     atomic.Int64
+	fallbacks     atomic.Int64
+	applied       atomic.Int64
+	duplicates    atomic.Int64
+	retries       atomic.Int64
+	deadLettered  atomic.Int64
+	lastAppliedAt atomic.Int64
+}
+
+// NewUsageBillingService 创建用量计费流水线
+func NewUsageBillingService(
+	entClient *dbent.Client,
+	repo UsageBillingRepository,
+	userRepo UserRepository,
+	userSubRepo UserSubscriptionRepository,
+	apiKeyService *APIKeyService,
+	billingCacheService *BillingCacheService,
+	outbox UsageOutbox,
+	cfg *config.Config,
+) *UsageBillingService {
+	var spool *usageSpool
+	if cfg != nil {
+		spool = newUsageSpool(cfg.Billing.UsageOutbox.SpoolFile)
+	}
+	return &UsageBillingService{
+		entClient:           entClient,
+		repo:                repo,
+		userRepo:            userRepo,
+		userSubRepo:         userSubRepo,
+		apiKeyService:       apiKeyService,
+		billingCacheService: billingCacheService,
+		outbox:              outbox,
+		spool:               spool,
+		cfg:                 cfg,
+		consumerPrefix:      resolveUsageOutboxConsumerPrefix(),
+		stopCh:              make(chan struct{}),
+	}
+}
+
+func resolveUsageOutboxConsumerPrefix() string {
+	hostname, err := os.Hostname()
+	if err != nil || strings.TrimSpace(hostname) == "" {
+		hostname = "usage-billing"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+func (s *UsageBillingService) outboxEnabled() bool {
+	return s != nil && s.outbox != nil && s.cfg != nil && s.cfg.Billing.UsageOutbox.Enabled
+}
+
+// Submit 提交一条用量事件
+// 未携带 request_id 的日志会生成一个，保证重试与重复投递可以去重。
+// 同步入库失败时不在事务外扣费，事件转存后返回错误，由重放完成入库与扣费。
+func (s *UsageBillingService) Submit(ctx context.Context, event *UsageBillingEvent) error {
+	if event == nil || event.Log == nil {
+		return nil
+	}
+	event.Log.RequestID = strings.TrimSpace(event.Log.RequestID)
+	if event.Log.RequestID == "" {
+		event.Log.RequestID = "usage-" + uuid.NewString()
+	}
+
+	if s.outboxEnabled() {
+		err := s.appendWithRetry(ctx, event)
+		if err == nil {
+			s.published.Add(1)
+			return nil
+		}
+		log.Printf("[UsageBilling] Append to outbox failed, applying synchronously: request_id=%s err=%v", event.Log.RequestID, err)
+		s.fallbacks.Add(1)
+	}
+	err := s.applyBatch(ctx, []*UsageBillingEvent{event})
+	if err == nil {
+		return nil
+	}
+	// 事务可能已提交（如提交结果未知），只能交给按 request_id 去重的入库路径重放
+	if spoolErr := s.spoolEvent(ctx, event); spoolErr != nil {
+		log.Printf("[UsageBilling] Usage event lost: request_id=%s user=%d apply_err=%v spool_err=%v", event.Log.RequestID, event.Log.UserID, err, spoolErr)
+		return fmt.Errorf("%w (spool failed: %v)", err, spoolErr)
+	}
+	log.Printf("[UsageBilling] Apply failed, event kept for replay: request_id=%s err=%v", event.Log.RequestID, err)
+	return err
+}
+
+// appendWithRetry 追加到事件流，短暂失败时按退避重试
+func (s *UsageBillingService) appendWithRetry(ctx context.Context, event *UsageBillingEvent) error {
+	delay := usageOutboxRetryBaseDelay
+	var err error
+	for attempt := 1; attempt <= usageOutboxAppendAttempts; attempt++ {
+		if err = s.outbox.Append(ctx, event); err == nil || attempt == usageOutboxAppendAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return err
+}
+
+// spoolEvent 同步入库失败后保留事件：优先再次写入事件流，否则写入本地暂存文件
+func (s *UsageBillingService) spoolEvent(ctx context.Context, event *UsageBillingEvent) error {
+	if s.outboxEnabled() {
+		if err := s.outbox.Append(ctx, event); err == nil {
+			s.published.Add(1)
+			return nil
+		}
+	}
+	if s.spool == nil {
+		return errors.New("usage spool is not configured")
+	}
+	return s.spool.Append(event)
+}
+
+// replaySpool 经幂等入库路径重放暂存的事件，成功后从暂存文件移除；返回重放的事件数
+func (s *UsageBillingService) replaySpool() (int, error) {
+	if s.spool == nil {
+		return 0, nil
+	}
+	batchSize := 100
+	if s.cfg != nil && s.cfg.Billing.UsageOutbox.BatchSize > 0 {
+		batchSize = s.cfg.Billing.UsageOutbox.BatchSize
+	}
+	replayed := 0
+	for {
+		events, lines, err := s.spool.Read(batchSize)
+		if err != nil || lines == 0 {
+			return replayed, err
+		}
+		if len(events) > 0 {
+			ctx, cancel := context.WithTimeout(context.Background(), usageOutboxApplyTimeout)
+			err = s.applyBatch(ctx, events)
+			cancel()
+			if err != nil {
+				return replayed, err
+			}
+		}
+		if err := s.spool.Remove(lines); err != nil {
+			return replayed, err
+		}
+		replayed += len(events)
+	}
+}
+
+func (s *UsageBillingService) runSpoolReplay() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(usageSpoolReplayInterval)
+	defer ticker.Stop()
+	for {
+		if n, err := s.replaySpool(); err != nil {
+			log.Printf("[UsageBilling] Replay spooled events failed, will retry: replayed=%d err=%v", n, err)
+		} else if n > 0 {
+			log.Printf("[UsageBilling] Replayed %d spooled usage event(s)", n)
+		}
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// usageSubscriptionDeduction 单个订阅的用量累加
+type usageSubscriptionDeduction struct {
+	userID  int64
+	groupID int64
+	amount  float64
+}
+
+// usageDeductions 一批新插入日志聚合后的扣费
+type usageDeductions struct {
+	balances      map[int64]float64
+	subscriptions map[int64]*usageSubscriptionDeduction
+	apiKeyQuotas  map[int64]float64
+}
+
+// aggregateUsageDeductions 仅对新插入的日志聚合扣费，重复日志跳过
+func aggregateUsageDeductions(events []*UsageBillingEvent, inserted []bool) usageDeductions {
+	d := usageDeductions{
+		balances:      make(map[int64]float64),
+		subscriptions: make(map[int64]*usageSubscriptionDeduction),
+		apiKeyQuotas:  make(map[int64]float64),
+	}
+	for i, event := range events {
+		if i >= len(inserted) || !inserted[i] {
+			continue
+		}
+		usageLog := event.Log
+		if event.BalanceCost > 0 {
+			d.balances[usageLog.UserID] += event.BalanceCost
+		}
+		if event.SubscriptionCost > 0 && usageLog.SubscriptionID != nil && usageLog.GroupID != nil {
+			sub := d.subscriptions[*usageLog.SubscriptionID]
+			if sub == nil {
+				sub = &usageSubscriptionDeduction{userID: usageLog.UserID, groupID: *usageLog.GroupID}
+				d.subscriptions[*usageLog.SubscriptionID] = sub
+			}
+			sub.amount += event.SubscriptionCost
+		}
+		if event.APIKeyQuotaCost > 0 {
+			d.apiKeyQuotas[usageLog.APIKeyID] += event.APIKeyQuotaCost
+		}
+	}
+	return d
+}
+
+// dedupeUsageEvents 去除批内重复的事件（相同 request_id 与 api_key_id）
+func dedupeUsageEvents(events []*UsageBillingEvent) (unique []*UsageBillingEvent, duplicates int) {
+	seen := make(map[string]struct{}, len(events))
+	unique = make([]*UsageBillingEvent, 0, len(events))
+	for _, event := range events {
+		if event == nil || event.Log == nil {
+			continue
+		}
+		if event.Log.RequestID != "" {
+			key := fmt.Sprintf("%d:%s", event.Log.APIKeyID, event.Log.RequestID)
+			if _, ok := seen[key]; ok {
+				duplicates++
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		unique = append(unique, event)
+	}
+	return unique, duplicates
+}
+
+// applyBatch 在同一事务内批量写入使用日志并对新插入的日志扣费（含 API Key 配额），
+// 提交后同步余额/订阅缓存，再释放事件携带的费用预留
+func (s *UsageBillingService) applyBatch(ctx context.Context, events []*UsageBillingEvent) error {
+	reservations := collectUsageReservations(events)
+	events, duplicates := dedupeUsageEvents(events)
+	if len(events) == 0 {
+		s.duplicates.Add(int64(duplicates))
+		s.releaseReservations(ctx, reservations)
+		return nil
+	}
+
+	txCtx := ctx
+	var tx *dbent.Tx
+	if s.entClient != nil {
+		var err error
+		tx, err = s.entClient.Tx(ctx)
+		if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+			return fmt.Errorf("begin transaction: %w", err)
+		}
+		if tx != nil {
+			txCtx = dbent.NewTxContext(ctx, tx)
+			defer func() { _ = tx.Rollback() }()
+		}
+	}
+
+	logs := make([]*UsageLog, len(events))
+	for i, event := range events {
+		logs[i] = event.Log
+	}
+	inserted, err := s.repo.BatchCreateUsageLogs(txCtx, logs)
+	if err != nil {
+		return fmt.Errorf("batch create usage logs: %w", err)
+	}
+
+	deductions := aggregateUsageDeductions(events, inserted)
+	for userID, amount := range deductions.balances {
+		if err := s.userRepo.DeductBalance(txCtx, userID, amount); err != nil {
+			if errors.Is(err, ErrUserNotFound) {
+				log.Printf("[UsageBilling] Skip balance deduction for missing user: user=%d amount=%.6f", userID, amount)
+				continue
+			}
+			return fmt.Errorf("deduct balance: %w", err)
+		}
+	}
+	for subscriptionID, sub := range deductions.subscriptions {
+		if err := s.userSubRepo.IncrementUsage(txCtx, subscriptionID, sub.amount); err != nil {
+			if errors.Is(err, ErrSubscriptionNotFound) {
+				log.Printf("[UsageBilling] Skip usage increment for missing subscription: subscription=%d amount=%.6f", subscriptionID, sub.amount)
+				continue
+			}
+			return fmt.Errorf("increment subscription usage: %w", err)
+		}
+	}
+	quotaUsed := make(map[int64]float64, len(deductions.apiKeyQuotas))
+	if s.apiKeyService != nil {
+		for apiKeyID, amount := range deductions.apiKeyQuotas {
+			used, err := s.apiKeyService.IncrementQuotaUsed(txCtx, apiKeyID, amount)
+			if err != nil {
+				if errors.Is(err, ErrAPIKeyNotFound) {
+					log.Printf("[UsageBilling] Skip quota increment for missing API key: api_key=%d amount=%.6f", apiKeyID, amount)
+					continue
+				}
+				return fmt.Errorf("increment api key quota: %w", err)
+			}
+			quotaUsed[apiKeyID] = used
+		}
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("commit transaction: %w", err)
+		}
+	}
+
+	newCount := 0
+	for _, ok := range inserted {
+		if ok {
+			newCount++
+		}
+	}
+	s.applied.Add(int64(newCount))
+	s.duplicates.Add(int64(duplicates + len(events) - newCount))
+	s.lastAppliedAt.Store(time.Now().Unix())
+
+	// 提交后同步缓存：持有预留的用户/订阅同步写入，确保释放预留时缓存已反映扣费；其余走异步队列
+	if s.billingCacheService != nil {
+		for userID, amount := range deductions.balances {
+			if _, ok := reservations[usageReservationKey{userID: userID}]; ok {
+				if err := s.billingCacheService.DeductBalanceCache(ctx, userID, amount); err != nil {
+					log.Printf("[UsageBilling] Deduct balance cache failed: user=%d err=%v", userID, err)
+				}
+				continue
+			}
+			s.billingCacheService.QueueDeductBalance(userID, amount)
+		}
+		for _, sub := range deductions.subscriptions {
+			if _, ok := reservations[usageReservationKey{userID: sub.userID, groupID: sub.groupID}]; ok {
+				if err := s.billingCacheService.UpdateSubscriptionUsage(ctx, sub.userID, sub.groupID, sub.amount); err != nil {
+					log.Printf("[UsageBilling] Update subscription cache failed: user=%d group=%d err=%v", sub.userID, sub.groupID, err)
+				}
+				continue
+			}
+			s.billingCacheService.QueueUpdateSubscriptionUsage(sub.userID, sub.groupID, sub.amount)
+		}
+	}
+	for apiKeyID, used := range quotaUsed {
+		s.apiKeyService.RefreshQuotaStatus(ctx, apiKeyID, used)
+	}
+	s.releaseReservations(ctx, reservations)
+	return nil
+}
+
+// usageReservationKey 预留所属的用户与分组（余额模式分组为 0）
+type usageReservationKey struct {
+	userID  int64
+	groupID int64
+}
+
+// collectUsageReservations 按用户与分组收集事件携带的费用预留（含批内重复事件）
+func collectUsageReservations(events []*UsageBillingEvent) map[usageReservationKey][]*CostReservationRef {
+	reservations := make(map[usageReservationKey][]*CostReservationRef)
+	for _, event := range events {
+		if event == nil || event.Reservation == nil {
+			continue
+		}
+		key := usageReservationKey{userID: event.Reservation.UserID, groupID: event.Reservation.GroupID}
+		reservations[key] = append(reservations[key], event.Reservation)
+	}
+	return reservations
+}
+
+// releaseReservations 释放扣费已提交的事件持有的费用预留
+// 入库失败的事件保留预留等待重新投递，转入死信流的事件由 TTL 过期释放
+func (s *UsageBillingService) releaseReservations(ctx context.Context, reservations map[usageReservationKey][]*CostReservationRef) {
+	if s.billingCacheService == nil {
+		return
+	}
+	for _, refs := range reservations {
+		for _, ref := range refs {
+			s.billingCacheService.ReleaseReservation(ctx, ref)
+		}
+	}
+}
+
+// Start 启动消费工作池与暂存事件重放
+func (s *UsageBillingService) Start() {
+	if s.spool != nil {
+		s.wg.Add(1)
+		go s.runSpoolReplay()
+	}
+	if !s.outboxEnabled() {
+		log.Println("[UsageBilling] Outbox disabled, usage is applied synchronously")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), usageOutboxClaimTimeout)
+	if err := s.outbox.EnsureGroup(ctx); err != nil {
+		log.Printf("[UsageBilling] Ensure consumer group failed: %v", err)
+	}
+	cancel()
+
+	workers := s.cfg.Billing.UsageOutbox.Workers
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.runWorker(fmt.Sprintf("%s-%d", s.consumerPrefix, i))
+	}
+	log.Printf("[UsageBilling] Started (workers=%d, batch_size=%d)", workers, s.cfg.Billing.UsageOutbox.BatchSize)
+}
+
+// Stop 停止消费工作池，未确认的事件留在事件流中由下次启动或其他实例接管
+func (s *UsageBillingService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *UsageBillingService) runWorker(consumer string) {
+	defer s.wg.Done()
+
+	cfg := s.cfg.Billing.UsageOutbox
+	minIdle := time.Duration(cfg.ClaimIdleSeconds) * time.Second
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), usageOutboxClaimTimeout)
+		msgs, err := s.outbox.Claim(ctx, consumer, cfg.BatchSize, minIdle, usageOutboxBlockTimeout)
+		cancel()
+		if err != nil {
+			log.Printf("[UsageBilling] Claim events failed: consumer=%s err=%v", consumer, err)
+			if !s.sleep(usageOutboxErrorBackoff) {
+				return
+			}
+			continue
+		}
+		if len(msgs) > 0 {
+			s.processMessages(msgs)
+		}
+	}
+}
+
+// processMessages 处理一批消息：整批重试失败后逐条处理，失败的消息保持未确认等待重新投递
+func (s *UsageBillingService) processMessages(msgs []UsageOutboxMessage) {
+	maxDeliveries := int64(s.cfg.Billing.UsageOutbox.MaxDeliveries)
+	events := make([]*UsageBillingEvent, 0, len(msgs))
+	ids := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		switch {
+		case msg.DecodeErr != nil || msg.Event == nil || msg.Event.Log == nil:
+			s.deadLetter(msg, fmt.Sprintf("decode failed: %v", msg.DecodeErr))
+		case maxDeliveries > 0 && msg.Deliveries > maxDeliveries:
+			s.deadLetter(msg, fmt.Sprintf("exceeded max deliveries (%d)", maxDeliveries))
+		default:
+			events = append(events, msg.Event)
+			ids = append(ids, msg.ID)
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	err := s.applyWithRetry(events)
+	if err == nil {
+		s.ack(ids...)
+		return
+	}
+	log.Printf("[UsageBilling] Batch apply failed, falling back to per-event apply: size=%d err=%v", len(events), err)
+
+	for i, event := range events {
+		ctx, cancel := context.WithTimeout(context.Background(), usageOutboxApplyTimeout)
+		err := s.applyBatch(ctx, []*UsageBillingEvent{event})
+		cancel()
+		if err != nil {
+			log.Printf("[UsageBilling] Apply event failed, will retry on redelivery: id=%s request_id=%s err=%v", ids[i], event.Log.RequestID, err)
+			continue
+		}
+		s.ack(ids[i])
+	}
+}
+
+// applyWithRetry 带指数退避重试整批入库
+func (s *UsageBillingService) applyWithRetry(events []*UsageBillingEvent) error {
+	maxBackoff := time.Duration(s.cfg.Billing.UsageOutbox.RetryBackoffMaxSeconds) * time.Second
+	delay := usageOutboxRetryBaseDelay
+	var err error
+	for attempt := 1; attempt <= usageOutboxBatchAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), usageOutboxApplyTimeout)
+		err = s.applyBatch(ctx, events)
+		cancel()
+		if err == nil || attempt == usageOutboxBatchAttempts {
+			return err
+		}
+		s.retries.Add(1)
+		if !s.sleep(delay) {
+			return err
+		}
+		delay *= 2
+		if maxBackoff > 0 && delay > maxBackoff {
+			delay = maxBackoff
+		}
+	}
+	return err
+}
+
+func (s *UsageBillingService) ack(ids ...string) {
+	ctx, cancel := context.WithTimeout(context.Background(), usageOutboxClaimTimeout)
+	defer cancel()
+	// 确认失败时事件会被重新投递，依赖 request_id 幂等，不会重复扣费
+	if err := s.outbox.Ack(ctx, ids...); err != nil {
+		log.Printf("[UsageBilling] Ack events failed: count=%d err=%v", len(ids), err)
+	}
+}
+
+func (s *UsageBillingService) deadLetter(msg UsageOutboxMessage, reason string) {
+	ctx, cancel := context.WithTimeout(context.Background(), usageOutboxClaimTimeout)
+	defer cancel()
+	if err := s.outbox.DeadLetter(ctx, msg, reason); err != nil {
+		log.Printf("[UsageBilling] Move event to dead-letter stream failed: id=%s err=%v", msg.ID, err)
+		return
+	}
+	s.deadLettered.Add(1)
+	log.Printf("[UsageBilling] Event moved to dead-letter stream: id=%s reason=%s", msg.ID, reason)
+}
+
+// sleep 等待指定时间，服务停止时返回 false
+func (s *UsageBillingService) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-s.stopCh:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Stats 返回流水线状态，积压数据来自事件流，计数为本实例统计
+func (s *UsageBillingService) Stats(ctx context.Context) (*UsageOutboxStats, error) {
+	stats := &UsageOutboxStats{}
+	if s.outboxEnabled() {
+		streamStats, err := s.outbox.Stats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if streamStats != nil {
+			stats = streamStats
+		}
+		stats.Enabled = true
+	}
+	stats.Published = s.published.Load()
+	stats.Fallbacks = s.fallbacks.Load()
+	stats.Applied = s.applied.Load()
+	stats.Duplicates = s.duplicates.Load()
+	stats.Retries = s.retries.Load()
+	stats.DeadLettered = s.deadLettered.Load()
+	if s.spool != nil {
+		stats.Spooled = s.spool.Pending()
+	}
+	if ts := s.lastAppliedAt.Load(); ts > 0 {
+		at := time.Unix(ts, 0).UTC()
+		stats.LastAppliedAt = &at
+	}
+	return stats, nil
+}
+
+// newUsageBillingEvent 根据计费方式构建用量事件
+// 订阅模式累加原始费用，余额模式扣除含倍率的费用；简易模式只记录用量不扣费。
+func newUsageBillingEvent(cfg *config.Config, usageLog *UsageLog, cost *CostBreakdown, subscriptionBilling, chargeAPIKeyQuota bool) *UsageBillingEvent {
+	event := &UsageBillingEvent{Log: usageLog}
+	if cfg != nil && cfg.RunMode == config.RunModeSimple {
+		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
+		return event
+	}
+	if subscriptionBilling {
+		event.SubscriptionCost = cost.TotalCost
+	} else {
+		event.BalanceCost = cost.ActualCost
+	}
+	if chargeAPIKeyQuota {
+		event.APIKeyQuotaCost = cost.ActualCost
+	}
+	return event
+}
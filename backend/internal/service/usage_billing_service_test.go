@@ -0,0 +1,267 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type usageBillingRepoStub struct {
+	seen map[string]bool
+	err  error
+}
+
+func (r *usageBillingRepoStub) BatchCreateUsageLogs(ctx context.Context, logs []*UsageLog) ([]bool, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.seen == nil {
+		r.seen = make(map[string]bool)
+	}
+	inserted := make([]bool, len(logs))
+	for i, l := range logs {
+		if !r.seen[l.RequestID] {
+			r.seen[l.RequestID] = true
+			inserted[i] = true
+		}
+	}
+	return inserted, nil
+}
+
+type usageBillingUserRepoStub struct {
+	*userRepoStub
+	deducted map[int64]float64
+}
+
+func (s *usageBillingUserRepoStub) DeductBalance(ctx context.Context, id int64, amount float64) error {
+	s.deducted[id] += amount
+	return nil
+}
+
+type usageOutboxStub struct {
+	appendErr error
+	appended  []*UsageBillingEvent
+	acked     []string
+	dead      []string
+}
+
+func (o *usageOutboxStub) EnsureGroup(ctx context.Context) error { return nil }
+
+func (o *usageOutboxStub) Append(ctx context.Context, event *UsageBillingEvent) error {
+	if o.appendErr != nil {
+		return o.appendErr
+	}
+	o.appended = append(o.appended, event)
+	return nil
+}
+
+func (o *usageOutboxStub) Claim(ctx context.Context, consumer string, count int, minIdle, block time.Duration) ([]UsageOutboxMessage, error) {
+	return nil, nil
+}
+
+func (o *usageOutboxStub) Ack(ctx context.Context, ids ...string) error {
+	o.acked = append(o.acked, ids...)
+	return nil
+}
+
+func (o *usageOutboxStub) DeadLetter(ctx context.Context, msg UsageOutboxMessage, reason string) error {
+	o.dead = append(o.dead, msg.ID)
+	return nil
+}
+
+func (o *usageOutboxStub) Stats(ctx context.Context) (*UsageOutboxStats, error) {
+	return &UsageOutboxStats{Backlog: int64(len(o.appended) - len(o.acked) - len(o.dead))}, nil
+}
+
+func newUsageBillingServiceForTest(outbox UsageOutbox, enabled bool) (*UsageBillingService, *usageBillingUserRepoStub) {
+	cfg := &config.Config{}
+	cfg.Billing.UsageOutbox = config.UsageOutboxConfig{Enabled: enabled, Workers: 1, BatchSize: 10, ClaimIdleSeconds: 60, MaxDeliveries: 3, RetryBackoffMaxSeconds: 1}
+	userRepo := &usageBillingUserRepoStub{userRepoStub: &userRepoStub{}, deducted: map[int64]float64{}}
+	svc := NewUsageBillingService(nil, &usageBillingRepoStub{}, userRepo, nil, nil, nil, outbox, cfg)
+	return svc, userRepo
+}
+
+func TestUsageBillingService_SubmitIsIdempotent(t *testing.T) {
+	svc, userRepo := newUsageBillingServiceForTest(nil, false)
+
+	event := func() *UsageBillingEvent {
+		return &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-1"}, BalanceCost: 1.5}
+	}
+	require.NoError(t, svc.Submit(context.Background(), event()))
+	require.NoError(t, svc.Submit(context.Background(), event()))
+	require.InDelta(t, 1.5, userRepo.deducted[1], 1e-9, "重复 request_id 只扣费一次")
+
+	stats, err := svc.Stats(context.Background())
+	require.NoError(t, err)
+	require.False(t, stats.Enabled)
+	require.Equal(t, int64(1), stats.Applied)
+	require.Equal(t, int64(1), stats.Duplicates)
+}
+
+func TestUsageBillingService_SubmitFallsBackWhenOutboxFails(t *testing.T) {
+	outbox := &usageOutboxStub{}
+	svc, userRepo := newUsageBillingServiceForTest(outbox, true)
+
+	e := &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2}, BalanceCost: 1}
+	require.NoError(t, svc.Submit(context.Background(), e))
+	require.Len(t, outbox.appended, 1)
+	require.NotEmpty(t, e.Log.RequestID, "缺失的 request_id 自动生成")
+	require.Zero(t, userRepo.deducted[1], "写入事件流后由工作池扣费")
+
+	outbox.appendErr = errors.New("redis down")
+	require.NoError(t, svc.Submit(context.Background(), &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-2"}, BalanceCost: 2}))
+	require.InDelta(t, 2.0, userRepo.deducted[1], 1e-9, "事件流不可用时同步扣费")
+
+	stats, err := svc.Stats(context.Background())
+	require.NoError(t, err)
+	require.True(t, stats.Enabled)
+	require.Equal(t, int64(1), stats.Published)
+	require.Equal(t, int64(1), stats.Fallbacks)
+}
+
+func TestUsageBillingService_ProcessMessages(t *testing.T) {
+	outbox := &usageOutboxStub{}
+	svc, userRepo := newUsageBillingServiceForTest(outbox, true)
+
+	msgs := []UsageOutboxMessage{
+		{ID: "1-0", Deliveries: 1, Event: &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "a"}, BalanceCost: 1}},
+		{ID: "1-1", Deliveries: 2, Event: &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "a"}, BalanceCost: 1}},
+		{ID: "1-2", Deliveries: 1, Event: &UsageBillingEvent{Log: &UsageLog{UserID: 3, APIKeyID: 4, RequestID: "b"}, BalanceCost: 2}},
+		{ID: "1-3", Deliveries: 4, Event: &UsageBillingEvent{Log: &UsageLog{UserID: 3, APIKeyID: 4, RequestID: "c"}, BalanceCost: 5}},
+		{ID: "1-4", Deliveries: 1, DecodeErr: errors.New("bad json")},
+	}
+	svc.processMessages(msgs)
+
+	require.Equal(t, []string{"1-0", "1-1", "1-2"}, outbox.acked)
+	require.ElementsMatch(t, []string{"1-3", "1-4"}, outbox.dead)
+	require.InDelta(t, 1.0, userRepo.deducted[1], 1e-9, "批内重复事件只扣费一次")
+	require.InDelta(t, 2.0, userRepo.deducted[3], 1e-9, "超过最大投递次数的事件不扣费")
+}
+
+func TestUsageBillingService_ReleasesReservationAfterCharge(t *testing.T) {
+	outbox := &usageOutboxStub{}
+	svc, userRepo := newUsageBillingServiceForTest(outbox, true)
+	cache := &reservationCacheStub{balance: 10, holds: map[string]float64{"r1": 3}}
+	svc.billingCacheService = NewBillingCacheService(cache, nil, nil, nil, svc.cfg)
+	t.Cleanup(svc.billingCacheService.Stop)
+
+	event := &UsageBillingEvent{
+		Log:         &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-1"},
+		BalanceCost: 1,
+		Reservation: &CostReservationRef{UserID: 1, ID: "r1"},
+	}
+	require.NoError(t, svc.Submit(context.Background(), event))
+	require.Contains(t, cache.holds, "r1", "写入事件流后扣费尚未生效，预留继续持有")
+
+	svc.processMessages([]UsageOutboxMessage{{ID: "1-0", Deliveries: 1, Event: outbox.appended[0]}})
+	require.InDelta(t, 1.0, userRepo.deducted[1], 1e-9)
+	require.NotContains(t, cache.holds, "r1", "扣费提交后释放预留")
+}
+
+func TestUsageBillingService_SyncFailureSpoolsWithoutCharging(t *testing.T) {
+	svc, userRepo := newUsageBillingServiceForTest(nil, false)
+	repo := &usageBillingRepoStub{err: errors.New("db down")}
+	svc.repo = repo
+	svc.spool = newUsageSpool(filepath.Join(t.TempDir(), "spool", "usage.jsonl"))
+
+	event := &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-1"}, BalanceCost: 2}
+	require.Error(t, svc.Submit(context.Background(), event))
+	require.Zero(t, userRepo.deducted[1], "入库失败时不在事务外扣费")
+	stats, err := svc.Stats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Spooled)
+
+	// 数据库恢复前重放失败，事件保留
+	_, err = svc.replaySpool()
+	require.Error(t, err)
+	require.Equal(t, int64(1), svc.spool.Pending())
+
+	// 恢复后经幂等入库路径重放；事务实际已提交的事件重复重放也只扣费一次
+	repo.err = nil
+	require.NoError(t, svc.spool.Append(event))
+	replayed, err := svc.replaySpool()
+	require.NoError(t, err)
+	require.Equal(t, 2, replayed)
+	require.InDelta(t, 2.0, userRepo.deducted[1], 1e-9)
+	require.Zero(t, svc.spool.Pending())
+}
+
+func TestUsageBillingService_SyncFailureRequeuesToOutbox(t *testing.T) {
+	outbox := &usageOutboxStub{appendErr: errors.New("redis blip")}
+	svc, userRepo := newUsageBillingServiceForTest(outbox, true)
+	svc.repo = &usageBillingRepoStub{err: errors.New("db down")}
+
+	event := &UsageBillingEvent{Log: &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-1"}, BalanceCost: 2}
+	// 同步入库失败且事件流与暂存文件都不可用时返回错误
+	require.Error(t, svc.Submit(context.Background(), event))
+	require.Zero(t, userRepo.deducted[1])
+
+	// 事件流恢复后，同步入库失败的事件重新写入事件流交给工作池
+	outbox.appendErr = nil
+	require.NoError(t, svc.spoolEvent(context.Background(), event))
+	require.Len(t, outbox.appended, 1)
+}
+
+func TestUsageSpool_RemoveKeepsNewLines(t *testing.T) {
+	spool := newUsageSpool(filepath.Join(t.TempDir(), "usage.jsonl"))
+	require.Nil(t, newUsageSpool(""))
+	for _, id := range []string{"a", "b"} {
+		require.NoError(t, spool.Append(&UsageBillingEvent{Log: &UsageLog{RequestID: id}}))
+	}
+	events, lines, err := spool.Read(0)
+	require.NoError(t, err)
+	require.Equal(t, 2, lines)
+	require.Equal(t, "a", events[0].Log.RequestID)
+
+	// 读取后追加的行在移除已重放的行后保留
+	require.NoError(t, spool.Append(&UsageBillingEvent{Log: &UsageLog{RequestID: "c"}}))
+	require.NoError(t, spool.Remove(lines))
+	events, lines, err = spool.Read(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, lines)
+	require.Equal(t, "c", events[0].Log.RequestID)
+	require.NoError(t, spool.Remove(lines))
+	require.Zero(t, spool.Pending())
+}
+
+func TestUsageBillingService_OutboxFailureKeepsReservation(t *testing.T) {
+	outbox := &usageOutboxStub{}
+	svc, userRepo := newUsageBillingServiceForTest(outbox, true)
+	svc.repo = &usageBillingRepoStub{err: errors.New("db down")}
+	cache := &reservationCacheStub{balance: 10, holds: map[string]float64{"r1": 3}}
+	svc.billingCacheService = NewBillingCacheService(cache, nil, nil, nil, svc.cfg)
+	t.Cleanup(svc.billingCacheService.Stop)
+
+	event := &UsageBillingEvent{
+		Log:         &UsageLog{UserID: 1, APIKeyID: 2, RequestID: "req-1"},
+		BalanceCost: 1,
+		Reservation: &CostReservationRef{UserID: 1, ID: "r1"},
+	}
+	svc.processMessages([]UsageOutboxMessage{{ID: "1-0", Deliveries: 1, Event: event}})
+	require.Empty(t, outbox.acked, "入库失败的事件等待重新投递")
+	require.Zero(t, userRepo.deducted[1])
+	require.Contains(t, cache.holds, "r1", "扣费未提交时不释放预留")
+}
+
+func TestAggregateUsageDeductions(t *testing.T) {
+	groupID, subID := int64(10), int64(20)
+	events := []*UsageBillingEvent{
+		{Log: &UsageLog{UserID: 1, APIKeyID: 5}, BalanceCost: 1, APIKeyQuotaCost: 1},
+		{Log: &UsageLog{UserID: 1, APIKeyID: 5}, BalanceCost: 2, APIKeyQuotaCost: 2},
+		{Log: &UsageLog{UserID: 2, APIKeyID: 6, GroupID: &groupID, SubscriptionID: &subID}, SubscriptionCost: 3},
+		{Log: &UsageLog{UserID: 2, APIKeyID: 6, GroupID: &groupID, SubscriptionID: &subID}, SubscriptionCost: 4},
+	}
+	d := aggregateUsageDeductions(events, []bool{true, true, true, false})
+
+	require.InDelta(t, 3.0, d.balances[1], 1e-9)
+	require.InDelta(t, 3.0, d.apiKeyQuotas[5], 1e-9)
+	require.Len(t, d.subscriptions, 1)
+	require.Equal(t, &usageSubscriptionDeduction{userID: 2, groupID: groupID, amount: 3}, d.subscriptions[subID])
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// usageSpoolMaxLineSize 单条暂存事件的最大长度
+const usageSpoolMaxLineSize = 1 << 20
+
+// usageSpool 本地暂存文件（每行一条 JSON 编码的用量事件）
+//
+// 事件流与同步入库都失败时，事件追加写入并落盘，由后台协程按 request_id 幂等重放；
+// 重放成功的行从文件头部移除，期间新追加的行保留。
+type usageSpool struct {
+	path string
+	mu   sync.Mutex
+}
+
+func newUsageSpool(path string) *usageSpool {
+	if path == "" {
+		return nil
+	}
+	return &usageSpool{path: path}
+}
+
+// Append 追加一条事件并同步落盘
+func (s *usageSpool) Append(event *UsageBillingEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode usage event: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("create spool dir: %w", err)
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open spool: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write spool: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("sync spool: %w", err)
+	}
+	return f.Close()
+}
+
+// Read 读取当前暂存的事件，返回事件与读取的行数（无法解析的行计入行数但跳过）
+func (s *usageSpool) Read(limit int) ([]*UsageBillingEvent, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("open spool: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var events []*UsageBillingEvent
+	lines := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), usageSpoolMaxLineSize)
+	for scanner.Scan() && (limit <= 0 || lines < limit) {
+		lines++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var event UsageBillingEvent
+		if err := json.Unmarshal(raw, &event); err != nil || event.Log == nil {
+			continue
+		}
+		events = append(events, &event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("read spool: %w", err)
+	}
+	return events, lines, nil
+}
+
+// Remove 移除文件头部已重放的 n 行
+func (s *usageSpool) Remove(n int) error {
+	if n <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("read spool: %w", err)
+	}
+	rest := raw
+	for i := 0; i < n && len(rest) > 0; i++ {
+		idx := bytes.IndexByte(rest, '\n')
+		if idx < 0 {
+			rest = nil
+			break
+		}
+		rest = rest[idx+1:]
+	}
+	if len(rest) == 0 {
+		return os.Remove(s.path)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, rest, 0o600); err != nil {
+		return fmt.Errorf("write spool: %w", err)
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// Pending 返回暂存文件中的事件行数
+func (s *usageSpool) Pending() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := os.ReadFile(s.path)
+	if err != nil {
+		return 0
+	}
+	return int64(bytes.Count(raw, []byte{'\n'}))
+}
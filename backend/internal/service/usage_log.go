@@ -17,6 +17,9 @@ type UsageLog struct {
 	// ReasoningEffort is the request's reasoning effort level (OpenAI Responses API),
 	// e.g. "low" / "medium" / "high" / "xhigh". Nil means not provided / not applicable.
 	ReasoningEffort *string
+	// RequestedModel 客户端原始请求的模型，仅在分组模型降级链生效时记录；
+	// 此时 Model 为实际服务（并据此计费）的模型。
+	RequestedModel *string
 
 	GroupID        *int64
 	SubscriptionID *int64
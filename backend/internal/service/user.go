@@ -15,6 +15,7 @@ type User struct {
 	Role          string
 	Balance       float64
 	Concurrency   int
+	QueueWeight   int // 账号饱和时分组公平队列中的权重
 	Status        string
 	AllowedGroups []int64
 	TokenVersion  int64 // Incremented on password change to invalidate existing tokens
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"time"
 
+	dbent "github.com/Wei-Shaw/sub2api/ent"
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tlsfingerprint"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
 )
@@ -66,6 +68,64 @@ func ProvideUsageCleanupService(repo UsageCleanupRepository, timingWheel *Timing
 	return svc
 }
 
+// ProvideUsageBillingService 创建并启动用量计费流水线
+func ProvideUsageBillingService(
+	entClient *dbent.Client,
+	repo UsageBillingRepository,
+	userRepo UserRepository,
+	userSubRepo UserSubscriptionRepository,
+	apiKeyService *APIKeyService,
+	billingCacheService *BillingCacheService,
+	outbox UsageOutbox,
+	cfg *config.Config,
+) *UsageBillingService {
+	svc := NewUsageBillingService(entClient, repo, userRepo, userSubRepo, apiKeyService, billingCacheService, outbox, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideAccountQuotaService 创建并启动额度感知调度服务
+func ProvideAccountQuotaService(accountRepo AccountRepository, usageService *AccountUsageService, schedulerCache SchedulerCache, cfg *config.Config) *AccountQuotaService {
+	svc := NewAccountQuotaService(accountRepo, usageService, schedulerCache, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideConfigReloadService 创建配置热更新服务，注册启动时复制了配置的组件并启动文件监听
+func ProvideConfigReloadService(
+	cfg *config.Config,
+	fairQueue *FairQueueService,
+	accountQuota *AccountQuotaService,
+	scheduler *AccountScheduler,
+	gatewayService *GatewayService,
+	openAIGatewayService *OpenAIGatewayService,
+	antigravityGatewayService *AntigravityGatewayService,
+	geminiCompatService *GeminiMessagesCompatService,
+	compatibleGatewayService *CompatibleGatewayService,
+	schedulerSnapshot *SchedulerSnapshotService,
+	rateLimitService *RateLimitService,
+	pricingService *PricingService,
+) *ConfigReloadService {
+	svc := NewConfigReloadService(cfg, config.ConfigFileUsed())
+	svc.OnReload(fairQueue.ApplyConfig)
+	svc.OnReload(accountQuota.ApplyConfig)
+	svc.OnReload(scheduler.ApplyConfig)
+	// 以下组件在请求路径上无锁读取可热更新的配置项，各自持有新发布的快照
+	svc.OnReload(gatewayService.ApplyConfig)
+	svc.OnReload(openAIGatewayService.ApplyConfig)
+	svc.OnReload(antigravityGatewayService.ApplyConfig)
+	svc.OnReload(geminiCompatService.ApplyConfig)
+	svc.OnReload(compatibleGatewayService.ApplyConfig)
+	svc.OnReload(schedulerSnapshot.ApplyConfig)
+	svc.OnReload(rateLimitService.ApplyConfig)
+	svc.OnReload(pricingService.ApplyConfig)
+	svc.OnReload(func(cfg *config.Config) {
+		tlsfingerprint.InitGlobalRegistry(&cfg.Gateway.TLSFingerprint)
+	})
+	svc.Start()
+	return svc
+}
+
 // ProvideAccountExpiryService creates and starts AccountExpiryService.
 func ProvideAccountExpiryService(accountRepo AccountRepository) *AccountExpiryService {
 	svc := NewAccountExpiryService(accountRepo, time.Minute)
@@ -98,8 +158,9 @@ func ProvideDeferredService(accountRepo AccountRepository, timingWheel *TimingWh
 }
 
 // ProvideConcurrencyService creates ConcurrencyService and starts slot cleanup worker.
-func ProvideConcurrencyService(cache ConcurrencyCache, accountRepo AccountRepository, cfg *config.Config) *ConcurrencyService {
+func ProvideConcurrencyService(cache ConcurrencyCache, accountRepo AccountRepository, fairQueue *FairQueueService, cfg *config.Config) *ConcurrencyService {
 	svc := NewConcurrencyService(cache)
+	svc.SetFairQueueService(fairQueue)
 	if cfg != nil {
 		svc.StartSlotCleanupWorker(accountRepo, cfg.Gateway.Scheduling.SlotCleanupInterval)
 	}
@@ -119,6 +180,13 @@ func ProvideSchedulerSnapshotService(
 	return svc
 }
 
+// ProvideClusterService creates ClusterService and starts the node heartbeat.
+func ProvideClusterService(cache ClusterNodeCache, health *HealthService, cfg *config.Config, buildInfo BuildInfo) *ClusterService {
+	svc := NewClusterService(cache, health, cfg, buildInfo)
+	svc.Start()
+	return svc
+}
+
 // ProvideRateLimitService creates RateLimitService with optional dependencies.
 func ProvideRateLimitService(
 	accountRepo AccountRepository,
@@ -267,6 +335,7 @@ var ProviderSet = wire.NewSet(
 	NewAntigravityOAuthService,
 	NewGeminiTokenProvider,
 	NewGeminiMessagesCompatService,
+	NewCompatibleGatewayService,
 	NewAntigravityTokenProvider,
 	NewOpenAITokenProvider,
 	NewClaudeTokenProvider,
@@ -287,6 +356,8 @@ var ProviderSet = wire.NewSet(
 	NewSubscriptionService,
 	ProvideConcurrencyService,
 	ProvideSchedulerSnapshotService,
+	NewHealthService,
+	ProvideClusterService,
 	NewIdentityService,
 	NewCRSSyncService,
 	ProvideUpdateService,
@@ -296,11 +367,24 @@ var ProviderSet = wire.NewSet(
 	ProvideTimingWheelService,
 	ProvideDashboardAggregationService,
 	ProvideUsageCleanupService,
+	ProvideUsageBillingService,
+	ProvideAccountQuotaService,
+	NewAccountScheduler,
+	NewFairQueueService,
+	ProvideConfigReloadService,
 	ProvideDeferredService,
 	NewAntigravityQuotaFetcher,
 	NewUserAttributeService,
 	NewUsageCache,
 	NewTotpService,
 	NewErrorPassthroughService,
+	NewModelPriceService,
+	NewRequestPolicyService,
+	NewGuardrailService,
+	NewClientRuleService,
+	NewRoutingRuleService,
+	NewConfigSyncService,
+	NewBackupService,
+	NewOneAPIImportService,
 	NewDigestSessionStore,
 )
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Wei-Shaw/sub2api/internal/config"
 	"golang.org/x/term"
 )
 
@@ -123,41 +124,11 @@ func RunCLI() error {
 	fmt.Println()
 	fmt.Println("── Redis Configuration ──")
 
-	for {
-		cfg.Redis.Host = promptString(reader, "Redis Host", "localhost")
-		if cliValidateHostname(cfg.Redis.Host) {
-			break
-		}
-		fmt.Println("  Invalid hostname format. Use alphanumeric, dots, hyphens only.")
-	}
-
-	for {
-		cfg.Redis.Port = promptInt(reader, "Redis Port", 6379)
-		if cliValidatePort(cfg.Redis.Port) {
-			break
-		}
-		fmt.Println("  Invalid port. Must be between 1 and 65535.")
-	}
-
-	cfg.Redis.Password = promptPassword("Redis Password (optional)")
-
-	for {
-		cfg.Redis.DB = promptInt(reader, "Redis DB", 0)
-		if cfg.Redis.DB >= 0 && cfg.Redis.DB <= 15 {
-			break
-		}
-		fmt.Println("  Invalid Redis DB. Must be between 0 and 15.")
-	}
-
-	cfg.Redis.EnableTLS = promptConfirm(reader, "Enable Redis TLS?")
-
-	fmt.Println()
-	fmt.Print("Testing Redis connection... ")
-	if err := TestRedisConnection(&cfg.Redis); err != nil {
-		fmt.Println("FAILED")
-		return fmt.Errorf("redis connection failed: %w", err)
+	if promptConfirm(reader, "Use embedded cache instead of Redis? (single-node only, cache is lost on restart)") {
+		cfg.Redis.Mode = config.RedisModeEmbedded
+	} else if err := promptRedisConnection(reader, cfg); err != nil {
+		return err
 	}
-	fmt.Println("OK")
 
 	// Admin configuration with validation
 	fmt.Println()
@@ -206,8 +177,12 @@ func RunCLI() error {
 	fmt.Println()
 	fmt.Println("── Configuration Summary ──")
 	fmt.Printf("Database: %s@%s:%d/%s\n", cfg.Database.User, cfg.Database.Host, cfg.Database.Port, cfg.Database.DBName)
-	fmt.Printf("Redis: %s:%d\n", cfg.Redis.Host, cfg.Redis.Port)
-	fmt.Printf("Redis TLS: %s\n", map[bool]string{true: "enabled", false: "disabled"}[cfg.Redis.EnableTLS])
+	if cfg.Redis.Embedded() {
+		fmt.Println("Redis: embedded (single-node)")
+	} else {
+		fmt.Printf("Redis: %s:%d\n", cfg.Redis.Host, cfg.Redis.Port)
+		fmt.Printf("Redis TLS: %s\n", map[bool]string{true: "enabled", false: "disabled"}[cfg.Redis.EnableTLS])
+	}
 	fmt.Printf("Admin: %s\n", cfg.Admin.Email)
 	fmt.Printf("Server: :%d\n", cfg.Server.Port)
 	fmt.Println()
@@ -296,3 +271,43 @@ func promptConfirm(reader *bufio.Reader, prompt string) bool {
 	input = strings.TrimSpace(strings.ToLower(input))
 	return input == "y" || input == "yes"
 }
+
+// promptRedisConnection 交互式配置外部 Redis 连接并测试
+func promptRedisConnection(reader *bufio.Reader, cfg *SetupConfig) error {
+	for {
+		cfg.Redis.Host = promptString(reader, "Redis Host", "localhost")
+		if cliValidateHostname(cfg.Redis.Host) {
+			break
+		}
+		fmt.Println("  Invalid hostname format. Use alphanumeric, dots, hyphens only.")
+	}
+
+	for {
+		cfg.Redis.Port = promptInt(reader, "Redis Port", 6379)
+		if cliValidatePort(cfg.Redis.Port) {
+			break
+		}
+		fmt.Println("  Invalid port. Must be between 1 and 65535.")
+	}
+
+	cfg.Redis.Password = promptPassword("Redis Password (optional)")
+
+	for {
+		cfg.Redis.DB = promptInt(reader, "Redis DB", 0)
+		if cfg.Redis.DB >= 0 && cfg.Redis.DB <= 15 {
+			break
+		}
+		fmt.Println("  Invalid Redis DB. Must be between 0 and 15.")
+	}
+
+	cfg.Redis.EnableTLS = promptConfirm(reader, "Enable Redis TLS?")
+
+	fmt.Println()
+	fmt.Print("Testing Redis connection... ")
+	if err := TestRedisConnection(&cfg.Redis); err != nil {
+		fmt.Println("FAILED")
+		return fmt.Errorf("redis connection failed: %w", err)
+	}
+	fmt.Println("OK")
+	return nil
+}
@@ -266,18 +266,25 @@ func install(c *gin.Context) {
 		return
 	}
 
-	// Redis validation
-	if !validateHostname(req.Redis.Host) {
-		response.Error(c, http.StatusBadRequest, "Invalid Redis hostname")
+	// Redis validation（内置缓存模式下无需外部 Redis 连接参数）
+	req.Redis.Mode = strings.ToLower(strings.TrimSpace(req.Redis.Mode))
+	if !validRedisMode(req.Redis.Mode) {
+		response.Error(c, http.StatusBadRequest, "Invalid Redis mode")
 		return
 	}
-	if !validatePort(req.Redis.Port) {
-		response.Error(c, http.StatusBadRequest, "Invalid Redis port")
-		return
-	}
-	if req.Redis.DB < 0 || req.Redis.DB > 15 {
-		response.Error(c, http.StatusBadRequest, "Invalid Redis database number")
-		return
+	if !req.Redis.Embedded() {
+		if !validateHostname(req.Redis.Host) {
+			response.Error(c, http.StatusBadRequest, "Invalid Redis hostname")
+			return
+		}
+		if !validatePort(req.Redis.Port) {
+			response.Error(c, http.StatusBadRequest, "Invalid Redis port")
+			return
+		}
+		if req.Redis.DB < 0 || req.Redis.DB > 15 {
+			response.Error(c, http.StatusBadRequest, "Invalid Redis database number")
+			return
+		}
 	}
 
 	// Admin validation
@@ -10,8 +10,10 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/repository"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -80,6 +82,8 @@ type DatabaseConfig struct {
 }
 
 type RedisConfig struct {
+	// Mode 为 embedded 时使用进程内缓存，无需外部 Redis（仅适用于单节点部署）
+	Mode      string `json:"mode,omitempty" yaml:"mode,omitempty"`
 	Host      string `json:"host" yaml:"host"`
 	Port      int    `json:"port" yaml:"port"`
 	Password  string `json:"password" yaml:"password"`
@@ -199,8 +203,22 @@ func TestDatabaseConnection(cfg *DatabaseConfig) error {
 	return nil
 }
 
+// Embedded 是否使用进程内缓存后端
+func (r *RedisConfig) Embedded() bool {
+	return r.Mode == config.RedisModeEmbedded
+}
+
+// validRedisMode 校验缓存后端模式（留空等同 external）
+func validRedisMode(mode string) bool {
+	return mode == "" || mode == config.RedisModeExternal || mode == config.RedisModeEmbedded
+}
+
 // TestRedisConnection tests the Redis connection
 func TestRedisConnection(cfg *RedisConfig) error {
+	if cfg.Embedded() {
+		// 内置缓存随服务进程启动，无需连接测试
+		return nil
+	}
 	opts := &redis.Options{
 		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
 		Password: cfg.Password,
@@ -496,6 +514,7 @@ func AutoSetupFromEnv() error {
 			SSLMode:  getEnvOrDefault("DATABASE_SSLMODE", "disable"),
 		},
 		Redis: RedisConfig{
+			Mode:      strings.ToLower(strings.TrimSpace(getEnvOrDefault("REDIS_MODE", ""))),
 			Host:      getEnvOrDefault("REDIS_HOST", "localhost"),
 			Port:      getEnvIntOrDefault("REDIS_PORT", 6379),
 			Password:  getEnvOrDefault("REDIS_PASSWORD", ""),
@@ -547,11 +566,18 @@ func AutoSetupFromEnv() error {
 	log.Println("Database connection successful")
 
 	// Test Redis connection
-	log.Println("Testing Redis connection...")
-	if err := TestRedisConnection(&cfg.Redis); err != nil {
-		return fmt.Errorf("redis connection failed: %w", err)
+	if !validRedisMode(cfg.Redis.Mode) {
+		return fmt.Errorf("invalid REDIS_MODE %q (expected %s or %s)", cfg.Redis.Mode, config.RedisModeExternal, config.RedisModeEmbedded)
+	}
+	if cfg.Redis.Embedded() {
+		log.Println("Using embedded cache backend (REDIS_MODE=embedded); skipping Redis connection test")
+	} else {
+		log.Println("Testing Redis connection...")
+		if err := TestRedisConnection(&cfg.Redis); err != nil {
+			return fmt.Errorf("redis connection failed: %w", err)
+		}
+		log.Println("Redis connection successful")
 	}
-	log.Println("Redis connection successful")
 
 	// Initialize database
 	log.Println("Initializing database...")
@@ -0,0 +1,6 @@
+-- Add per-group ordered model fallback chains
+-- Format: [{"model": "claude-opus-*", "fallbacks": ["claude-sonnet-4-5", "claude-haiku-4-5"], "triggers": ["no_account", "rate_limited"]}]
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS model_fallbacks JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+-- Record the originally requested model when a fallback model served the request
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS requested_model VARCHAR(100);
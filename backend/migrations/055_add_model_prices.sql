@@ -0,0 +1,26 @@
+-- Admin-managed model price overrides
+-- Prices are USD per 1M tokens (image_price is USD per image).
+-- Resolution: group > platform > global scope, then exact model > longest wildcard,
+-- then the latest effective_from that is not in the future.
+
+CREATE TABLE IF NOT EXISTS model_prices (
+    id BIGSERIAL PRIMARY KEY,
+    model VARCHAR(100) NOT NULL,
+    group_id BIGINT,
+    platform VARCHAR(50) NOT NULL DEFAULT '',
+    input_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    output_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    cache_write_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    cache_read_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    image_price DECIMAL(20,8),
+    effective_from TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_model_prices_model ON model_prices (model);
+CREATE INDEX IF NOT EXISTS idx_model_prices_group_id ON model_prices (group_id);
+CREATE UNIQUE INDEX IF NOT EXISTS modelprice_model_group_id_platform_effective_from
+    ON model_prices (model, group_id, platform, effective_from);
@@ -0,0 +1,3 @@
+-- Add per-group model rate multipliers, applied on top of group/user multipliers
+-- Format: {"claude-opus-*": 2.0, "claude-haiku-4-5": 0.5}
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS model_rate_multipliers JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,3 @@
+-- Add per-group account scheduling strategy; empty means the global default
+-- Values: default, weighted_round_robin, least_cost, least_latency, fill_first
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS scheduling_strategy VARCHAR(32) NOT NULL DEFAULT '';
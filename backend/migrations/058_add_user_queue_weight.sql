@@ -0,0 +1,2 @@
+-- Add per-user weight for the group fair queue used when all accounts are saturated
+ALTER TABLE users ADD COLUMN IF NOT EXISTS queue_weight INT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+-- Add per-group time-to-first-byte budget for streaming requests; 0 means the global default
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS first_byte_timeout_seconds INT NOT NULL DEFAULT 0;
@@ -0,0 +1,23 @@
+-- Request parameter policies
+-- Declarative per-group policies that clamp, strip or reject client request parameters before forwarding
+
+CREATE TABLE IF NOT EXISTS request_policies (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    group_ids JSONB DEFAULT '[]',
+    protocols JSONB DEFAULT '[]',
+    kind VARCHAR(32) NOT NULL,
+    action VARCHAR(10) NOT NULL DEFAULT 'modify',
+    dry_run BOOLEAN NOT NULL DEFAULT false,
+    limit_value INTEGER,
+    params JSONB DEFAULT '[]',
+    reject_message TEXT,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_policies_enabled ON request_policies (enabled);
+CREATE INDEX IF NOT EXISTS idx_request_policies_priority ON request_policies (priority);
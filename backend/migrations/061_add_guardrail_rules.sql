@@ -0,0 +1,20 @@
+-- Content guardrail rules
+-- Admin-managed keyword/regex blocklists and PII detectors that block, mask or flag request text before forwarding
+
+CREATE TABLE IF NOT EXISTS guardrail_rules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    group_ids JSONB DEFAULT '[]',
+    match_type VARCHAR(16) NOT NULL,
+    patterns JSONB DEFAULT '[]',
+    action VARCHAR(10) NOT NULL DEFAULT 'flag',
+    block_message TEXT,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_guardrail_rules_enabled ON guardrail_rules (enabled);
+CREATE INDEX IF NOT EXISTS idx_guardrail_rules_priority ON guardrail_rules (priority);
@@ -0,0 +1,22 @@
+-- Client allowlist rules
+-- Generalizes groups.claude_code_only: per-group client matching with presets, fallback group and rejection counters (Redis)
+
+CREATE TABLE IF NOT EXISTS client_rules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    group_ids JSONB DEFAULT '[]',
+    preset VARCHAR(32) NOT NULL DEFAULT '',
+    user_agent_pattern VARCHAR(500),
+    required_headers JSONB DEFAULT '[]',
+    metadata_user_id_pattern VARCHAR(500),
+    body_fields JSONB DEFAULT '[]',
+    fallback_group_id BIGINT,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_rules_enabled ON client_rules (enabled);
+CREATE INDEX IF NOT EXISTS idx_client_rules_priority ON client_rules (priority);
@@ -0,0 +1,22 @@
+-- Request routing rules
+-- Route cheap/background requests (by model, max_tokens, tools, estimated input tokens) to another group or account subset
+
+CREATE TABLE IF NOT EXISTS routing_rules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    group_ids JSONB DEFAULT '[]',
+    model_patterns JSONB DEFAULT '[]',
+    max_tokens_below INTEGER,
+    require_no_tools BOOLEAN NOT NULL DEFAULT false,
+    input_tokens_below INTEGER,
+    target_group_id BIGINT,
+    target_account_ids JSONB DEFAULT '[]',
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_routing_rules_enabled ON routing_rules (enabled);
+CREATE INDEX IF NOT EXISTS idx_routing_rules_priority ON routing_rules (priority);
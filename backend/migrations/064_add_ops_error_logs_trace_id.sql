@@ -0,0 +1,8 @@
+-- Link ops error logs to OpenTelemetry traces.
+--
+-- trace_id is only populated when tracing is enabled and the request was sampled.
+
+ALTER TABLE ops_error_logs
+  ADD COLUMN IF NOT EXISTS trace_id VARCHAR(32);
+
+CREATE INDEX IF NOT EXISTS idx_ops_error_logs_trace_id ON ops_error_logs (trace_id) WHERE trace_id IS NOT NULL;
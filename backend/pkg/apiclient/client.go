@@ -0,0 +1,263 @@
+// Package apiclient 是 sub2api HTTP API 的类型化 Go 客户端。
+//
+// 请求/响应结构与各操作方法（client_gen.go）由 cmd/openapigen 根据 OpenAPI 文档生成，
+// 本文件是生成代码依赖的手写运行时：连接配置、认证、统一响应包装的解码与错误处理。
+// 网关接口（/v1/messages 等）不在客户端范围内，请直接使用对应上游的 SDK。
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client 调用 sub2api HTTP API
+type Client struct {
+	baseURL string
+	http    *http.Client
+	header  http.Header
+}
+
+// ClientOption 客户端配置项
+type ClientOption func(*Client)
+
+// WithHTTPClient 使用自定义的 http.Client
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) { c.http = hc }
+}
+
+// WithBearerToken 使用登录获得的 JWT 访问令牌认证（用户接口与管理接口）
+func WithBearerToken(token string) ClientOption {
+	return func(c *Client) { c.header.Set("Authorization", "Bearer "+token) }
+}
+
+// WithAdminAPIKey 使用管理员 API Key 认证（仅管理接口）
+func WithAdminAPIKey(key string) ClientOption {
+	return func(c *Client) { c.header.Set("x-api-key", key) }
+}
+
+// WithHeader 为每个请求附加请求头
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) { c.header.Set(key, value) }
+}
+
+// New 创建客户端，baseURL 为服务地址（如 https://example.com），不含 /api/v1
+func New(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http:    &http.Client{Timeout: 2 * time.Minute},
+		header:  http.Header{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// APIError 服务端返回的错误响应
+type APIError struct {
+	StatusCode int
+	Code       int               `json:"code"`
+	Message    string            `json:"message"`
+	Reason     string            `json:"reason,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("sub2api: HTTP %d", e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Reason != "" {
+		msg += " (" + e.Reason + ")"
+	}
+	return msg
+}
+
+// Page 分页数据
+type Page[T any] struct {
+	Items    []T   `json:"items"`
+	Total    int64 `json:"total"`
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+	Pages    int   `json:"pages"`
+}
+
+// envelope 统一响应包装
+type envelope struct {
+	Code     int               `json:"code"`
+	Message  string            `json:"message"`
+	Reason   string            `json:"reason"`
+	Metadata map[string]string `json:"metadata"`
+	Data     json.RawMessage   `json:"data"`
+}
+
+// request 生成方法构造的请求
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	header      http.Header
+	body        any       // JSON 编码后发送
+	raw         io.Reader // 原样发送，配合 contentType
+	contentType string
+}
+
+// setQuery 设置查询参数，v 为 nil 指针或空切片时忽略
+func (r *request) setQuery(name string, v any) {
+	values, ok := formatParam(v)
+	if !ok {
+		return
+	}
+	if r.query == nil {
+		r.query = url.Values{}
+	}
+	r.query[name] = values
+}
+
+// setHeader 设置请求头，v 为 nil 指针时忽略
+func (r *request) setHeader(name string, v any) {
+	values, ok := formatParam(v)
+	if !ok {
+		return
+	}
+	if r.header == nil {
+		r.header = http.Header{}
+	}
+	r.header.Set(name, values[0])
+}
+
+// call 发送请求并解码响应：envelope 为 true 时检查 code 并将 data 解码到 out，否则解码整个响应体
+func (c *Client) call(ctx context.Context, req *request, wrapped bool, out any) error {
+	resp, err := c.stream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if !wrapped {
+		if out == nil || len(bytes.TrimSpace(raw)) == 0 {
+			return nil
+		}
+		return json.Unmarshal(raw, out)
+	}
+	var env envelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return fmt.Errorf("sub2api: %s %s: invalid response: %w", req.method, req.path, err)
+	}
+	if env.Code != 0 {
+		return &APIError{StatusCode: resp.StatusCode, Code: env.Code, Message: env.Message, Reason: env.Reason, Metadata: env.Metadata}
+	}
+	if out == nil || len(env.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(env.Data, out)
+}
+
+// stream 发送请求并返回原始响应（调用方负责关闭 Body），非 2xx/3xx 状态返回 *APIError
+func (c *Client) stream(ctx context.Context, req *request) (*http.Response, error) {
+	body := req.raw
+	contentType := req.contentType
+	if req.body != nil {
+		raw, err := json.Marshal(req.body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(raw)
+		contentType = "application/json"
+	}
+	target := c.baseURL + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range c.header {
+		httpReq.Header[key] = values
+	}
+	for key, values := range req.header {
+		httpReq.Header[key] = values
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer func() { _ = resp.Body.Close() }()
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		if json.Unmarshal(raw, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = strings.TrimSpace(string(raw))
+		}
+		return nil, apiErr
+	}
+	return resp, nil
+}
+
+// pathParam 转义路径参数
+func pathParam(v any) string {
+	return url.PathEscape(fmt.Sprint(v))
+}
+
+// formatParam 将参数值格式化为字符串，nil 指针或空切片返回 false
+func formatParam(v any) ([]string, bool) {
+	switch p := v.(type) {
+	case *string:
+		if p != nil {
+			return []string{*p}, true
+		}
+	case *int64:
+		if p != nil {
+			return []string{strconv.FormatInt(*p, 10)}, true
+		}
+	case *int32:
+		if p != nil {
+			return []string{strconv.FormatInt(int64(*p), 10)}, true
+		}
+	case *float64:
+		if p != nil {
+			return []string{strconv.FormatFloat(*p, 'f', -1, 64)}, true
+		}
+	case *bool:
+		if p != nil {
+			return []string{strconv.FormatBool(*p)}, true
+		}
+	case *time.Time:
+		if p != nil {
+			return []string{p.Format(time.RFC3339)}, true
+		}
+	case []string:
+		if len(p) > 0 {
+			return p, true
+		}
+	case []int64:
+		out := make([]string, 0, len(p))
+		for _, n := range p {
+			out = append(out, strconv.FormatInt(n, 10))
+		}
+		return out, len(out) > 0
+	}
+	return nil, false
+}
+
+// Ptr 返回 v 的指针，便于填写可选字段与参数
+func Ptr[T any](v T) *T {
+	return &v
+}
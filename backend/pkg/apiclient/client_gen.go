@@ -0,0 +1,7196 @@
+// Code generated by cmd/openapigen from the OpenAPI document. DO NOT EDIT.
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+type APIKey struct {
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Group       *Group     `json:"group,omitempty"`
+	GroupID     *int64     `json:"group_id,omitempty"`
+	ID          int64      `json:"id,omitempty"`
+	IPBlacklist []string   `json:"ip_blacklist,omitempty"`
+	IPWhitelist []string   `json:"ip_whitelist,omitempty"`
+	Key         string     `json:"key,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Quota       float64    `json:"quota,omitempty"`
+	QuotaUsed   float64    `json:"quota_used,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+	User        *DtoUser   `json:"user,omitempty"`
+	UserID      int64      `json:"user_id,omitempty"`
+}
+
+type APIKeyUsageTrendPoint struct {
+	APIKeyID int64  `json:"api_key_id,omitempty"`
+	Date     string `json:"date,omitempty"`
+	KeyName  string `json:"key_name,omitempty"`
+	Requests int64  `json:"requests,omitempty"`
+	Tokens   int64  `json:"tokens,omitempty"`
+}
+
+type Account struct {
+	AccountGroups             []AccountGroup             `json:"account_groups,omitempty"`
+	AutoPauseOnExpired        bool                       `json:"auto_pause_on_expired,omitempty"`
+	Concurrency               int64                      `json:"concurrency,omitempty"`
+	CreatedAt                 time.Time                  `json:"created_at,omitempty"`
+	Credentials               map[string]json.RawMessage `json:"credentials,omitempty"`
+	EnableTLSFingerprint      *bool                      `json:"enable_tls_fingerprint,omitempty"`
+	ErrorMessage              string                     `json:"error_message,omitempty"`
+	ExpiresAt                 *int64                     `json:"expires_at,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"extra,omitempty"`
+	GroupIDs                  []int64                    `json:"group_ids,omitempty"`
+	Groups                    []*Group                   `json:"groups,omitempty"`
+	ID                        int64                      `json:"id,omitempty"`
+	LastUsedAt                *time.Time                 `json:"last_used_at,omitempty"`
+	MaxSessions               *int64                     `json:"max_sessions,omitempty"`
+	Name                      string                     `json:"name,omitempty"`
+	Notes                     *string                    `json:"notes,omitempty"`
+	OverloadUntil             *time.Time                 `json:"overload_until,omitempty"`
+	Platform                  string                     `json:"platform,omitempty"`
+	Priority                  int64                      `json:"priority,omitempty"`
+	Proxy                     *Proxy                     `json:"proxy,omitempty"`
+	ProxyID                   *int64                     `json:"proxy_id,omitempty"`
+	RateLimitResetAt          *time.Time                 `json:"rate_limit_reset_at,omitempty"`
+	RateLimitedAt             *time.Time                 `json:"rate_limited_at,omitempty"`
+	RateMultiplier            float64                    `json:"rate_multiplier,omitempty"`
+	Schedulable               bool                       `json:"schedulable,omitempty"`
+	SessionIDMaskingEnabled   *bool                      `json:"session_id_masking_enabled,omitempty"`
+	SessionIdleTimeoutMinutes *int64                     `json:"session_idle_timeout_minutes,omitempty"`
+	SessionWindowEnd          *time.Time                 `json:"session_window_end,omitempty"`
+	SessionWindowStart        *time.Time                 `json:"session_window_start,omitempty"`
+	SessionWindowStatus       string                     `json:"session_window_status,omitempty"`
+	Status                    string                     `json:"status,omitempty"`
+	TempUnschedulableReason   string                     `json:"temp_unschedulable_reason,omitempty"`
+	TempUnschedulableUntil    *time.Time                 `json:"temp_unschedulable_until,omitempty"`
+	Type                      string                     `json:"type,omitempty"`
+	UpdatedAt                 time.Time                  `json:"updated_at,omitempty"`
+	WindowCostLimit           *float64                   `json:"window_cost_limit,omitempty"`
+	WindowCostStickyReserve   *float64                   `json:"window_cost_sticky_reserve,omitempty"`
+}
+
+type AccountAvailability struct {
+	AccountID              int64      `json:"account_id,omitempty"`
+	AccountName            string     `json:"account_name,omitempty"`
+	ErrorMessage           string     `json:"error_message,omitempty"`
+	GroupID                int64      `json:"group_id,omitempty"`
+	GroupName              string     `json:"group_name,omitempty"`
+	HasError               bool       `json:"has_error,omitempty"`
+	IsAvailable            bool       `json:"is_available,omitempty"`
+	IsOverloaded           bool       `json:"is_overloaded,omitempty"`
+	IsRateLimited          bool       `json:"is_rate_limited,omitempty"`
+	OverloadRemainingSec   *int64     `json:"overload_remaining_sec,omitempty"`
+	OverloadUntil          *time.Time `json:"overload_until,omitempty"`
+	Platform               string     `json:"platform,omitempty"`
+	RateLimitRemainingSec  *int64     `json:"rate_limit_remaining_sec,omitempty"`
+	RateLimitResetAt       *time.Time `json:"rate_limit_reset_at,omitempty"`
+	Status                 string     `json:"status,omitempty"`
+	TempUnschedulableUntil *time.Time `json:"temp_unschedulable_until,omitempty"`
+}
+
+type AccountConcurrencyInfo struct {
+	AccountID      int64   `json:"account_id,omitempty"`
+	AccountName    string  `json:"account_name,omitempty"`
+	CurrentInUse   int64   `json:"current_in_use,omitempty"`
+	GroupID        int64   `json:"group_id,omitempty"`
+	GroupName      string  `json:"group_name,omitempty"`
+	LoadPercentage float64 `json:"load_percentage,omitempty"`
+	MaxCapacity    int64   `json:"max_capacity,omitempty"`
+	Platform       string  `json:"platform,omitempty"`
+	WaitingInQueue int64   `json:"waiting_in_queue,omitempty"`
+}
+
+type AccountGroup struct {
+	Account   *Account  `json:"account,omitempty"`
+	AccountID int64     `json:"account_id,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	Group     *Group    `json:"group,omitempty"`
+	GroupID   int64     `json:"group_id,omitempty"`
+	Priority  int64     `json:"priority,omitempty"`
+}
+
+type AccountSummary struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type AccountUsageHistory struct {
+	ActualCost float64 `json:"actual_cost,omitempty"`
+	Cost       float64 `json:"cost,omitempty"`
+	Date       string  `json:"date,omitempty"`
+	Label      string  `json:"label,omitempty"`
+	Requests   int64   `json:"requests,omitempty"`
+	Tokens     int64   `json:"tokens,omitempty"`
+	UserCost   float64 `json:"user_cost,omitempty"`
+}
+
+type AccountUsageStatsResponse struct {
+	History []AccountUsageHistory `json:"history,omitempty"`
+	Models  []ModelStat           `json:"models,omitempty"`
+	Summary AccountUsageSummary   `json:"summary,omitempty"`
+}
+
+type AccountUsageSummary struct {
+	ActualDaysUsed   int64   `json:"actual_days_used,omitempty"`
+	AvgDailyCost     float64 `json:"avg_daily_cost,omitempty"`
+	AvgDailyRequests float64 `json:"avg_daily_requests,omitempty"`
+	AvgDailyTokens   float64 `json:"avg_daily_tokens,omitempty"`
+	AvgDailyUserCost float64 `json:"avg_daily_user_cost,omitempty"`
+	AvgDurationMs    float64 `json:"avg_duration_ms,omitempty"`
+	Days             int64   `json:"days,omitempty"`
+	HighestCostDay   *struct {
+		Cost     float64 `json:"cost,omitempty"`
+		Date     string  `json:"date,omitempty"`
+		Label    string  `json:"label,omitempty"`
+		Requests int64   `json:"requests,omitempty"`
+		UserCost float64 `json:"user_cost,omitempty"`
+	} `json:"highest_cost_day,omitempty"`
+	HighestRequestDay *struct {
+		Cost     float64 `json:"cost,omitempty"`
+		Date     string  `json:"date,omitempty"`
+		Label    string  `json:"label,omitempty"`
+		Requests int64   `json:"requests,omitempty"`
+		UserCost float64 `json:"user_cost,omitempty"`
+	} `json:"highest_request_day,omitempty"`
+	Today *struct {
+		Cost     float64 `json:"cost,omitempty"`
+		Date     string  `json:"date,omitempty"`
+		Requests int64   `json:"requests,omitempty"`
+		Tokens   int64   `json:"tokens,omitempty"`
+		UserCost float64 `json:"user_cost,omitempty"`
+	} `json:"today,omitempty"`
+	TotalCost         float64 `json:"total_cost,omitempty"`
+	TotalRequests     int64   `json:"total_requests,omitempty"`
+	TotalStandardCost float64 `json:"total_standard_cost,omitempty"`
+	TotalTokens       int64   `json:"total_tokens,omitempty"`
+	TotalUserCost     float64 `json:"total_user_cost,omitempty"`
+}
+
+type AccountWithConcurrency struct {
+	AccountGroups             []AccountGroup             `json:"account_groups,omitempty"`
+	ActiveSessions            *int64                     `json:"active_sessions,omitempty"`
+	AutoPauseOnExpired        bool                       `json:"auto_pause_on_expired,omitempty"`
+	Concurrency               int64                      `json:"concurrency,omitempty"`
+	CreatedAt                 time.Time                  `json:"created_at,omitempty"`
+	Credentials               map[string]json.RawMessage `json:"credentials,omitempty"`
+	CurrentConcurrency        int64                      `json:"current_concurrency,omitempty"`
+	CurrentWindowCost         *float64                   `json:"current_window_cost,omitempty"`
+	EnableTLSFingerprint      *bool                      `json:"enable_tls_fingerprint,omitempty"`
+	ErrorMessage              string                     `json:"error_message,omitempty"`
+	ExpiresAt                 *int64                     `json:"expires_at,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"extra,omitempty"`
+	GroupIDs                  []int64                    `json:"group_ids,omitempty"`
+	Groups                    []*Group                   `json:"groups,omitempty"`
+	ID                        int64                      `json:"id,omitempty"`
+	LastUsedAt                *time.Time                 `json:"last_used_at,omitempty"`
+	MaxSessions               *int64                     `json:"max_sessions,omitempty"`
+	Name                      string                     `json:"name,omitempty"`
+	Notes                     *string                    `json:"notes,omitempty"`
+	OverloadUntil             *time.Time                 `json:"overload_until,omitempty"`
+	Platform                  string                     `json:"platform,omitempty"`
+	Priority                  int64                      `json:"priority,omitempty"`
+	Proxy                     *Proxy                     `json:"proxy,omitempty"`
+	ProxyID                   *int64                     `json:"proxy_id,omitempty"`
+	RateLimitResetAt          *time.Time                 `json:"rate_limit_reset_at,omitempty"`
+	RateLimitedAt             *time.Time                 `json:"rate_limited_at,omitempty"`
+	RateMultiplier            float64                    `json:"rate_multiplier,omitempty"`
+	Schedulable               bool                       `json:"schedulable,omitempty"`
+	SessionIDMaskingEnabled   *bool                      `json:"session_id_masking_enabled,omitempty"`
+	SessionIdleTimeoutMinutes *int64                     `json:"session_idle_timeout_minutes,omitempty"`
+	SessionWindowEnd          *time.Time                 `json:"session_window_end,omitempty"`
+	SessionWindowStart        *time.Time                 `json:"session_window_start,omitempty"`
+	SessionWindowStatus       string                     `json:"session_window_status,omitempty"`
+	Status                    string                     `json:"status,omitempty"`
+	TempUnschedulableReason   string                     `json:"temp_unschedulable_reason,omitempty"`
+	TempUnschedulableUntil    *time.Time                 `json:"temp_unschedulable_until,omitempty"`
+	Type                      string                     `json:"type,omitempty"`
+	UpdatedAt                 time.Time                  `json:"updated_at,omitempty"`
+	WindowCostLimit           *float64                   `json:"window_cost_limit,omitempty"`
+	WindowCostStickyReserve   *float64                   `json:"window_cost_sticky_reserve,omitempty"`
+}
+
+type AdjustSubscriptionRequest struct {
+	Days int64 `json:"days"`
+}
+
+type AdminAccountBatchCreateRequest struct {
+	Accounts []CreateAccountRequest `json:"accounts,omitempty"`
+}
+
+type AdminAccountBatchCreateResponse struct {
+	Failed  int64                        `json:"failed"`
+	Results []map[string]json.RawMessage `json:"results,omitempty"`
+	Success int64                        `json:"success"`
+}
+
+type AdminAccountBatchUpdateCredentialsResponse struct {
+	Failed  int64                        `json:"failed"`
+	Results []map[string]json.RawMessage `json:"results,omitempty"`
+	Success int64                        `json:"success"`
+}
+
+type AdminAccountBulkResetStatusResponse struct {
+	Failed     int64                        `json:"failed"`
+	FailedIDs  []int64                      `json:"failed_ids,omitempty"`
+	Results    []map[string]json.RawMessage `json:"results,omitempty"`
+	Success    int64                        `json:"success"`
+	SuccessIDs []int64                      `json:"success_ids,omitempty"`
+}
+
+type AdminAccountClearRateLimitResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminAccountClearTempUnschedulableResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminAccountDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminAccountGetTempUnschedulableResponse struct {
+	Active bool              `json:"active"`
+	State  *TempUnschedState `json:"state,omitempty"`
+}
+
+type AdminAccountRefreshTierResponse struct {
+	DriveStorageLimit json.RawMessage `json:"drive_storage_limit"`
+	DriveStorageUsage json.RawMessage `json:"drive_storage_usage"`
+	StorageInfo       json.RawMessage `json:"storage_info,omitempty"`
+	TierID            string          `json:"tier_id"`
+	UpdatedAt         json.RawMessage `json:"updated_at"`
+}
+
+type AdminAnnouncementDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminBatchAPIKeysUsageRequest struct {
+	APIKeyIDs []int64 `json:"api_key_ids,omitempty"`
+}
+
+type AdminClientRuleDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminClientRuleResetRejectedCountResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminClusterListNodesResponse struct {
+	Nodes      []ClusterNode `json:"nodes,omitempty"`
+	SelfNodeID string        `json:"self_node_id"`
+}
+
+type AdminClusterSendCommandResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminDashboardBackfillAggregationResponse struct {
+	Status string `json:"status"`
+}
+
+type AdminDashboardGetAPIKeyUsageTrendResponse struct {
+	EndDate     string                  `json:"end_date"`
+	Granularity string                  `json:"granularity"`
+	StartDate   string                  `json:"start_date"`
+	Trend       []APIKeyUsageTrendPoint `json:"trend,omitempty"`
+}
+
+type AdminDashboardGetBatchAPIKeysUsageResponse struct {
+	Stats map[string]*BatchAPIKeyUsageStats `json:"stats,omitempty"`
+}
+
+type AdminDashboardGetBatchUsersUsageResponse struct {
+	Stats map[string]*BatchUserUsageStats `json:"stats,omitempty"`
+}
+
+type AdminDashboardGetModelStatsResponse struct {
+	EndDate   string      `json:"end_date"`
+	Models    []ModelStat `json:"models,omitempty"`
+	StartDate string      `json:"start_date"`
+}
+
+type AdminDashboardGetRealtimeMetricsResponse struct {
+	ActiveRequests      int64   `json:"active_requests"`
+	AverageResponseTime int64   `json:"average_response_time"`
+	ErrorRate           float64 `json:"error_rate"`
+	RequestsPerMinute   int64   `json:"requests_per_minute"`
+}
+
+type AdminDashboardGetStatsResponse struct {
+	ActiveAPIKeys            int64   `json:"active_api_keys"`
+	ActiveUsers              int64   `json:"active_users"`
+	AverageDurationMs        float64 `json:"average_duration_ms"`
+	ErrorAccounts            int64   `json:"error_accounts"`
+	HourlyActiveUsers        int64   `json:"hourly_active_users"`
+	NormalAccounts           int64   `json:"normal_accounts"`
+	OverloadAccounts         int64   `json:"overload_accounts"`
+	RatelimitAccounts        int64   `json:"ratelimit_accounts"`
+	RPM                      int64   `json:"rpm"`
+	StatsStale               bool    `json:"stats_stale"`
+	StatsUpdatedAt           string  `json:"stats_updated_at"`
+	TodayActualCost          float64 `json:"today_actual_cost"`
+	TodayCacheCreationTokens int64   `json:"today_cache_creation_tokens"`
+	TodayCacheReadTokens     int64   `json:"today_cache_read_tokens"`
+	TodayCost                float64 `json:"today_cost"`
+	TodayInputTokens         int64   `json:"today_input_tokens"`
+	TodayNewUsers            int64   `json:"today_new_users"`
+	TodayOutputTokens        int64   `json:"today_output_tokens"`
+	TodayRequests            int64   `json:"today_requests"`
+	TodayTokens              int64   `json:"today_tokens"`
+	TotalAccounts            int64   `json:"total_accounts"`
+	TotalActualCost          float64 `json:"total_actual_cost"`
+	TotalAPIKeys             int64   `json:"total_api_keys"`
+	TotalCacheCreationTokens int64   `json:"total_cache_creation_tokens"`
+	TotalCacheReadTokens     int64   `json:"total_cache_read_tokens"`
+	TotalCost                float64 `json:"total_cost"`
+	TotalInputTokens         int64   `json:"total_input_tokens"`
+	TotalOutputTokens        int64   `json:"total_output_tokens"`
+	TotalRequests            int64   `json:"total_requests"`
+	TotalTokens              int64   `json:"total_tokens"`
+	TotalUsers               int64   `json:"total_users"`
+	TPM                      int64   `json:"tpm"`
+	Uptime                   int64   `json:"uptime"`
+}
+
+type AdminDashboardGetUsageTrendResponse struct {
+	EndDate     string           `json:"end_date"`
+	Granularity string           `json:"granularity"`
+	StartDate   string           `json:"start_date"`
+	Trend       []TrendDataPoint `json:"trend,omitempty"`
+}
+
+type AdminDashboardGetUserUsageTrendResponse struct {
+	EndDate     string                `json:"end_date"`
+	Granularity string                `json:"granularity"`
+	StartDate   string                `json:"start_date"`
+	Trend       []UserUsageTrendPoint `json:"trend,omitempty"`
+}
+
+type AdminErrorPassthroughDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminGroup struct {
+	AccountCount                    int64               `json:"account_count,omitempty"`
+	AccountGroups                   []AccountGroup      `json:"account_groups,omitempty"`
+	ClaudeCodeOnly                  bool                `json:"claude_code_only,omitempty"`
+	CreatedAt                       time.Time           `json:"created_at,omitempty"`
+	DailyLimitUsd                   *float64            `json:"daily_limit_usd,omitempty"`
+	Description                     string              `json:"description,omitempty"`
+	FallbackGroupID                 *int64              `json:"fallback_group_id,omitempty"`
+	FallbackGroupIDOnInvalidRequest *int64              `json:"fallback_group_id_on_invalid_request,omitempty"`
+	FirstByteTimeoutSeconds         int64               `json:"first_byte_timeout_seconds,omitempty"`
+	ID                              int64               `json:"id,omitempty"`
+	ImagePrice1k                    *float64            `json:"image_price_1k,omitempty"`
+	ImagePrice2k                    *float64            `json:"image_price_2k,omitempty"`
+	ImagePrice4k                    *float64            `json:"image_price_4k,omitempty"`
+	IsExclusive                     bool                `json:"is_exclusive,omitempty"`
+	McpXmlInject                    bool                `json:"mcp_xml_inject,omitempty"`
+	ModelFallbacks                  []ModelFallbackRule `json:"model_fallbacks,omitempty"`
+	ModelRateMultipliers            map[string]float64  `json:"model_rate_multipliers,omitempty"`
+	ModelRouting                    map[string][]int64  `json:"model_routing,omitempty"`
+	ModelRoutingEnabled             bool                `json:"model_routing_enabled,omitempty"`
+	MonthlyLimitUsd                 *float64            `json:"monthly_limit_usd,omitempty"`
+	Name                            string              `json:"name,omitempty"`
+	Platform                        string              `json:"platform,omitempty"`
+	RateMultiplier                  float64             `json:"rate_multiplier,omitempty"`
+	SchedulingStrategy              string              `json:"scheduling_strategy,omitempty"`
+	SortOrder                       int64               `json:"sort_order,omitempty"`
+	Status                          string              `json:"status,omitempty"`
+	SubscriptionType                string              `json:"subscription_type,omitempty"`
+	SupportedModelScopes            []string            `json:"supported_model_scopes,omitempty"`
+	UpdatedAt                       time.Time           `json:"updated_at,omitempty"`
+	WeeklyLimitUsd                  *float64            `json:"weekly_limit_usd,omitempty"`
+}
+
+type AdminGroupDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminGroupGetStatsResponse struct {
+	ActiveAPIKeys int64   `json:"active_api_keys"`
+	TotalAPIKeys  int64   `json:"total_api_keys"`
+	TotalCost     float64 `json:"total_cost"`
+	TotalRequests int64   `json:"total_requests"`
+}
+
+type AdminGroupUpdateSortOrderResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminGuardrailDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminModelPriceDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminModelPriceExportResponse struct {
+	Items []ModelPriceRequest `json:"items,omitempty"`
+}
+
+type AdminModelPriceImportResponse struct {
+	Imported int64 `json:"imported"`
+}
+
+type AdminOpenAIOAuthCreateAccountFromOAuthRequest struct {
+	Code        string  `json:"code"`
+	Concurrency int64   `json:"concurrency,omitempty"`
+	GroupIDs    []int64 `json:"group_ids,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Priority    int64   `json:"priority,omitempty"`
+	ProxyID     *int64  `json:"proxy_id,omitempty"`
+	RedirectURI string  `json:"redirect_uri,omitempty"`
+	SessionID   string  `json:"session_id"`
+}
+
+type AdminOpsCreateAlertSilenceRequest struct {
+	GroupID  *int64  `json:"group_id,omitempty"`
+	Platform string  `json:"platform,omitempty"`
+	Reason   string  `json:"reason,omitempty"`
+	Region   *string `json:"region,omitempty"`
+	RuleID   int64   `json:"rule_id,omitempty"`
+	Until    string  `json:"until,omitempty"`
+}
+
+type AdminOpsDeleteAlertRuleResponse struct {
+	Deleted bool `json:"deleted"`
+}
+
+type AdminOpsGetAccountAvailabilityResponse struct {
+	Account   map[string]*AccountAvailability  `json:"account,omitempty"`
+	Enabled   bool                             `json:"enabled"`
+	Group     map[string]*GroupAvailability    `json:"group,omitempty"`
+	Platform  map[string]*PlatformAvailability `json:"platform,omitempty"`
+	Timestamp time.Time                        `json:"timestamp"`
+}
+
+type AdminOpsGetConcurrencyStatsResponse struct {
+	Account   map[string]*AccountConcurrencyInfo  `json:"account,omitempty"`
+	Enabled   bool                                `json:"enabled"`
+	Group     map[string]*GroupConcurrencyInfo    `json:"group,omitempty"`
+	Platform  map[string]*PlatformConcurrencyInfo `json:"platform,omitempty"`
+	Timestamp time.Time                           `json:"timestamp"`
+}
+
+type AdminOpsGetRealtimeTrafficSummaryResponse struct {
+	Enabled   bool                       `json:"enabled"`
+	Summary   *OpsRealtimeTrafficSummary `json:"summary,omitempty"`
+	Timestamp time.Time                  `json:"timestamp"`
+}
+
+type AdminOpsGetUserConcurrencyStatsResponse struct {
+	Enabled   bool                            `json:"enabled"`
+	Timestamp time.Time                       `json:"timestamp"`
+	User      map[string]*UserConcurrencyInfo `json:"user,omitempty"`
+}
+
+type AdminOpsListRequestErrorUpstreamErrorsResponse struct {
+	Items    json.RawMessage `json:"items"`
+	Page     int64           `json:"page"`
+	PageSize int64           `json:"page_size"`
+	Pages    int64           `json:"pages"`
+	Total    int64           `json:"total"`
+}
+
+type AdminOpsResolveRequestErrorResponse struct {
+	Ok bool `json:"ok"`
+}
+
+type AdminOpsResolveUpstreamErrorResponse struct {
+	Ok bool `json:"ok"`
+}
+
+type AdminOpsUpdateAlertEventStatusRequest struct {
+	Status string `json:"status,omitempty"`
+}
+
+type AdminOpsUpdateAlertEventStatusResponse struct {
+	Updated bool `json:"updated"`
+}
+
+type AdminOpsUpdateErrorResolutionResponse struct {
+	Ok bool `json:"ok"`
+}
+
+type AdminPromoDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminProxyBatchCreateResponse struct {
+	Created int64 `json:"created"`
+	Skipped int64 `json:"skipped"`
+}
+
+type AdminProxyDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminProxyGetStatsResponse struct {
+	ActiveAccounts int64   `json:"active_accounts"`
+	AverageLatency int64   `json:"average_latency"`
+	SuccessRate    float64 `json:"success_rate"`
+	TotalAccounts  int64   `json:"total_accounts"`
+	TotalRequests  int64   `json:"total_requests"`
+}
+
+type AdminRedeemBatchDeleteRequest struct {
+	IDs []int64 `json:"ids,omitempty"`
+}
+
+type AdminRedeemBatchDeleteResponse struct {
+	Deleted int64  `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type AdminRedeemCode struct {
+	Code         string     `json:"code,omitempty"`
+	CreatedAt    time.Time  `json:"created_at,omitempty"`
+	Group        *Group     `json:"group,omitempty"`
+	GroupID      *int64     `json:"group_id,omitempty"`
+	ID           int64      `json:"id,omitempty"`
+	Notes        string     `json:"notes,omitempty"`
+	Status       string     `json:"status,omitempty"`
+	Type         string     `json:"type,omitempty"`
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+	UsedBy       *int64     `json:"used_by,omitempty"`
+	User         *DtoUser   `json:"user,omitempty"`
+	ValidityDays int64      `json:"validity_days,omitempty"`
+	Value        float64    `json:"value,omitempty"`
+}
+
+type AdminRedeemDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminRedeemGetStatsResponse struct {
+	ActiveCodes int64 `json:"active_codes"`
+	ByType      struct {
+		Balance     int64 `json:"balance"`
+		Concurrency int64 `json:"concurrency"`
+		Trial       int64 `json:"trial"`
+	} `json:"by_type"`
+	ExpiredCodes          int64   `json:"expired_codes"`
+	TotalCodes            int64   `json:"total_codes"`
+	TotalValueDistributed float64 `json:"total_value_distributed"`
+	UsedCodes             int64   `json:"used_codes"`
+}
+
+type AdminRequestPolicyDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminRoutingRuleDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminSettingDeleteAdminAPIKeyResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminSettingGetAdminAPIKeyResponse struct {
+	Exists    bool   `json:"exists"`
+	MaskedKey string `json:"masked_key"`
+}
+
+type AdminSettingRegenerateAdminAPIKeyResponse struct {
+	Key string `json:"key"`
+}
+
+type AdminSettingSendTestEmailResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminSettingTestSMTPConnectionResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminSubscriptionRevokeResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminSystemGetVersionResponse struct {
+	Version string `json:"version"`
+}
+
+type AdminSystemPerformUpdateResponse struct {
+	Message     string `json:"message"`
+	NeedRestart bool   `json:"need_restart"`
+}
+
+type AdminSystemRestartServiceResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminSystemRollbackResponse struct {
+	Message     string `json:"message"`
+	NeedRestart bool   `json:"need_restart"`
+}
+
+type AdminUsageCancelCleanupTaskResponse struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+type AdminUsageLog struct {
+	Account               *AccountSummary   `json:"account,omitempty"`
+	AccountID             int64             `json:"account_id,omitempty"`
+	AccountRateMultiplier *float64          `json:"account_rate_multiplier,omitempty"`
+	ActualCost            float64           `json:"actual_cost,omitempty"`
+	APIKey                *APIKey           `json:"api_key,omitempty"`
+	APIKeyID              int64             `json:"api_key_id,omitempty"`
+	BillingType           int32             `json:"billing_type,omitempty"`
+	CacheCreation1hTokens int64             `json:"cache_creation_1h_tokens,omitempty"`
+	CacheCreation5mTokens int64             `json:"cache_creation_5m_tokens,omitempty"`
+	CacheCreationCost     float64           `json:"cache_creation_cost,omitempty"`
+	CacheCreationTokens   int64             `json:"cache_creation_tokens,omitempty"`
+	CacheReadCost         float64           `json:"cache_read_cost,omitempty"`
+	CacheReadTokens       int64             `json:"cache_read_tokens,omitempty"`
+	CreatedAt             time.Time         `json:"created_at,omitempty"`
+	DurationMs            *int64            `json:"duration_ms,omitempty"`
+	FirstTokenMs          *int64            `json:"first_token_ms,omitempty"`
+	Group                 *Group            `json:"group,omitempty"`
+	GroupID               *int64            `json:"group_id,omitempty"`
+	ID                    int64             `json:"id,omitempty"`
+	ImageCount            int64             `json:"image_count,omitempty"`
+	ImageSize             *string           `json:"image_size,omitempty"`
+	InputCost             float64           `json:"input_cost,omitempty"`
+	InputTokens           int64             `json:"input_tokens,omitempty"`
+	IPAddress             *string           `json:"ip_address,omitempty"`
+	Model                 string            `json:"model,omitempty"`
+	OutputCost            float64           `json:"output_cost,omitempty"`
+	OutputTokens          int64             `json:"output_tokens,omitempty"`
+	RateMultiplier        float64           `json:"rate_multiplier,omitempty"`
+	ReasoningEffort       *string           `json:"reasoning_effort,omitempty"`
+	RequestID             string            `json:"request_id,omitempty"`
+	RequestedModel        *string           `json:"requested_model,omitempty"`
+	Stream                bool              `json:"stream,omitempty"`
+	Subscription          *UserSubscription `json:"subscription,omitempty"`
+	SubscriptionID        *int64            `json:"subscription_id,omitempty"`
+	TotalCost             float64           `json:"total_cost,omitempty"`
+	User                  *DtoUser          `json:"user,omitempty"`
+	UserAgent             *string           `json:"user_agent,omitempty"`
+	UserID                int64             `json:"user_id,omitempty"`
+}
+
+type AdminUser struct {
+	AllowedGroups []int64            `json:"allowed_groups,omitempty"`
+	APIKeys       []APIKey           `json:"api_keys,omitempty"`
+	Balance       float64            `json:"balance,omitempty"`
+	Concurrency   int64              `json:"concurrency,omitempty"`
+	CreatedAt     time.Time          `json:"created_at,omitempty"`
+	Email         string             `json:"email,omitempty"`
+	GroupRates    map[string]float64 `json:"group_rates,omitempty"`
+	ID            int64              `json:"id,omitempty"`
+	Notes         string             `json:"notes,omitempty"`
+	QueueWeight   int64              `json:"queue_weight,omitempty"`
+	Role          string             `json:"role,omitempty"`
+	Status        string             `json:"status,omitempty"`
+	Subscriptions []UserSubscription `json:"subscriptions,omitempty"`
+	UpdatedAt     time.Time          `json:"updated_at,omitempty"`
+	Username      string             `json:"username,omitempty"`
+}
+
+type AdminUserAttributeDeleteDefinitionResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminUserAttributeReorderDefinitionsResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminUserDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type AdminUserGetBalanceHistoryResponse struct {
+	Items          []AdminRedeemCode `json:"items,omitempty"`
+	Page           int64             `json:"page"`
+	PageSize       int64             `json:"page_size"`
+	Pages          int64             `json:"pages"`
+	Total          int64             `json:"total"`
+	TotalRecharged float64           `json:"total_recharged"`
+}
+
+type AdminUserSubscription struct {
+	AssignedAt         time.Time  `json:"assigned_at,omitempty"`
+	AssignedBy         *int64     `json:"assigned_by,omitempty"`
+	AssignedByUser     *DtoUser   `json:"assigned_by_user,omitempty"`
+	CreatedAt          time.Time  `json:"created_at,omitempty"`
+	DailyUsageUsd      float64    `json:"daily_usage_usd,omitempty"`
+	DailyWindowStart   *time.Time `json:"daily_window_start,omitempty"`
+	ExpiresAt          time.Time  `json:"expires_at,omitempty"`
+	Group              *Group     `json:"group,omitempty"`
+	GroupID            int64      `json:"group_id,omitempty"`
+	ID                 int64      `json:"id,omitempty"`
+	MonthlyUsageUsd    float64    `json:"monthly_usage_usd,omitempty"`
+	MonthlyWindowStart *time.Time `json:"monthly_window_start,omitempty"`
+	Notes              string     `json:"notes,omitempty"`
+	StartsAt           time.Time  `json:"starts_at,omitempty"`
+	Status             string     `json:"status,omitempty"`
+	UpdatedAt          time.Time  `json:"updated_at,omitempty"`
+	User               *DtoUser   `json:"user,omitempty"`
+	UserID             int64      `json:"user_id,omitempty"`
+	WeeklyUsageUsd     float64    `json:"weekly_usage_usd,omitempty"`
+	WeeklyWindowStart  *time.Time `json:"weekly_window_start,omitempty"`
+}
+
+type Announcement struct {
+	Content   string                `json:"content,omitempty"`
+	CreatedAt time.Time             `json:"created_at,omitempty"`
+	CreatedBy *int64                `json:"created_by,omitempty"`
+	EndsAt    *time.Time            `json:"ends_at,omitempty"`
+	ID        int64                 `json:"id,omitempty"`
+	StartsAt  *time.Time            `json:"starts_at,omitempty"`
+	Status    string                `json:"status,omitempty"`
+	Targeting AnnouncementTargeting `json:"targeting,omitempty"`
+	Title     string                `json:"title,omitempty"`
+	UpdatedAt time.Time             `json:"updated_at,omitempty"`
+	UpdatedBy *int64                `json:"updated_by,omitempty"`
+}
+
+type AnnouncementCondition struct {
+	GroupIDs []int64 `json:"group_ids,omitempty"`
+	Operator string  `json:"operator,omitempty"`
+	Type     string  `json:"type,omitempty"`
+	Value    float64 `json:"value,omitempty"`
+}
+
+type AnnouncementConditionGroup struct {
+	AllOf []AnnouncementCondition `json:"all_of,omitempty"`
+}
+
+type AnnouncementMarkReadResponse struct {
+	Message string `json:"message"`
+}
+
+type AnnouncementTargeting struct {
+	AnyOf []AnnouncementConditionGroup `json:"any_of,omitempty"`
+}
+
+type AnnouncementUserReadStatus struct {
+	Balance  float64    `json:"balance,omitempty"`
+	Eligible bool       `json:"eligible,omitempty"`
+	Email    string     `json:"email,omitempty"`
+	ReadAt   *time.Time `json:"read_at,omitempty"`
+	UserID   int64      `json:"user_id,omitempty"`
+	Username string     `json:"username,omitempty"`
+}
+
+type AntigravityAuthURLResult struct {
+	AuthURL   string `json:"auth_url,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+	State     string `json:"state,omitempty"`
+}
+
+type AntigravityExchangeCodeRequest struct {
+	Code      string `json:"code"`
+	ProxyID   *int64 `json:"proxy_id,omitempty"`
+	SessionID string `json:"session_id"`
+	State     string `json:"state"`
+}
+
+type AntigravityGenerateAuthURLRequest struct {
+	ProxyID *int64 `json:"proxy_id,omitempty"`
+}
+
+type AntigravityModelQuota struct {
+	ResetTime   string `json:"reset_time,omitempty"`
+	Utilization int64  `json:"utilization,omitempty"`
+}
+
+type AntigravityRefreshTokenRequest struct {
+	ProxyID      *int64 `json:"proxy_id,omitempty"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type AntigravityTokenInfo struct {
+	AccessToken  string `json:"access_token,omitempty"`
+	Email        string `json:"email,omitempty"`
+	ExpiresAt    int64  `json:"expires_at,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+	ProjectID    string `json:"project_id,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+}
+
+type ApiKeyDeleteResponse struct {
+	Message string `json:"message"`
+}
+
+type Asset struct {
+	DownloadURL string `json:"download_url,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+}
+
+type AssignSubscriptionRequest struct {
+	GroupID      int64  `json:"group_id"`
+	Notes        string `json:"notes,omitempty"`
+	UserID       int64  `json:"user_id"`
+	ValidityDays int64  `json:"validity_days,omitempty"`
+}
+
+type AttributeDefinitionResponse struct {
+	CreatedAt    string                  `json:"created_at,omitempty"`
+	Description  string                  `json:"description,omitempty"`
+	DisplayOrder int64                   `json:"display_order,omitempty"`
+	Enabled      bool                    `json:"enabled,omitempty"`
+	ID           int64                   `json:"id,omitempty"`
+	Key          string                  `json:"key,omitempty"`
+	Name         string                  `json:"name,omitempty"`
+	Options      []UserAttributeOption   `json:"options,omitempty"`
+	Placeholder  string                  `json:"placeholder,omitempty"`
+	Required     bool                    `json:"required,omitempty"`
+	Type         string                  `json:"type,omitempty"`
+	UpdatedAt    string                  `json:"updated_at,omitempty"`
+	Validation   UserAttributeValidation `json:"validation,omitempty"`
+}
+
+type AttributeValueResponse struct {
+	AttributeID int64  `json:"attribute_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	ID          int64  `json:"id,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	UserID      int64  `json:"user_id,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
+
+type AuthResponse struct {
+	AccessToken  string   `json:"access_token,omitempty"`
+	ExpiresIn    int64    `json:"expires_in,omitempty"`
+	RefreshToken string   `json:"refresh_token,omitempty"`
+	TokenType    string   `json:"token_type,omitempty"`
+	User         *DtoUser `json:"user,omitempty"`
+}
+
+type BackupTableResult struct {
+	Merged   int64  `json:"merged,omitempty"`
+	Restored int64  `json:"restored,omitempty"`
+	Table    string `json:"table,omitempty"`
+}
+
+type BatchAPIKeyUsageStats struct {
+	APIKeyID        int64   `json:"api_key_id,omitempty"`
+	TodayActualCost float64 `json:"today_actual_cost,omitempty"`
+	TotalActualCost float64 `json:"total_actual_cost,omitempty"`
+}
+
+type BatchCreateProxyItem struct {
+	Host     string `json:"host"`
+	Password string `json:"password,omitempty"`
+	Port     int64  `json:"port"`
+	Protocol string `json:"protocol"`
+	Username string `json:"username,omitempty"`
+}
+
+type BatchCreateRequest struct {
+	Proxies []BatchCreateProxyItem `json:"proxies,omitempty"`
+}
+
+type BatchDeleteRequest struct {
+	IDs []int64 `json:"ids,omitempty"`
+}
+
+type BatchGetUserAttributesRequest struct {
+	UserIDs []int64 `json:"user_ids,omitempty"`
+}
+
+type BatchRefreshTierRequest struct {
+	AccountIDs []int64 `json:"account_ids,omitempty"`
+}
+
+type BatchUpdateCredentialsRequest struct {
+	AccountIDs []int64         `json:"account_ids,omitempty"`
+	Field      string          `json:"field"`
+	Value      json.RawMessage `json:"value,omitempty"`
+}
+
+type BatchUserAttributesResponse struct {
+	Attributes map[string]map[string]string `json:"attributes,omitempty"`
+}
+
+type BatchUserUsageStats struct {
+	TodayActualCost float64 `json:"today_actual_cost,omitempty"`
+	TotalActualCost float64 `json:"total_actual_cost,omitempty"`
+	UserID          int64   `json:"user_id,omitempty"`
+}
+
+type BatchUsersUsageRequest struct {
+	UserIDs []int64 `json:"user_ids,omitempty"`
+}
+
+type BulkAssignResult struct {
+	Errors        []string                `json:"errors,omitempty"`
+	FailedCount   int64                   `json:"failed_count,omitempty"`
+	Subscriptions []AdminUserSubscription `json:"subscriptions,omitempty"`
+	SuccessCount  int64                   `json:"success_count,omitempty"`
+}
+
+type BulkAssignSubscriptionRequest struct {
+	GroupID      int64   `json:"group_id"`
+	Notes        string  `json:"notes,omitempty"`
+	UserIDs      []int64 `json:"user_ids,omitempty"`
+	ValidityDays int64   `json:"validity_days,omitempty"`
+}
+
+type BulkResetStatusRequest struct {
+	AccountIDs []int64 `json:"account_ids,omitempty"`
+}
+
+type BulkUpdateAccountResult struct {
+	AccountID int64  `json:"account_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Success   bool   `json:"success,omitempty"`
+}
+
+type BulkUpdateAccountsRequest struct {
+	AccountIDs              []int64                    `json:"account_ids,omitempty"`
+	Concurrency             *int64                     `json:"concurrency,omitempty"`
+	ConfirmMixedChannelRisk *bool                      `json:"confirm_mixed_channel_risk,omitempty"`
+	Credentials             map[string]json.RawMessage `json:"credentials,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"extra,omitempty"`
+	GroupIDs                []int64                    `json:"group_ids,omitempty"`
+	Name                    string                     `json:"name,omitempty"`
+	Priority                *int64                     `json:"priority,omitempty"`
+	ProxyID                 *int64                     `json:"proxy_id,omitempty"`
+	RateMultiplier          *float64                   `json:"rate_multiplier,omitempty"`
+	Schedulable             *bool                      `json:"schedulable,omitempty"`
+	Status                  string                     `json:"status,omitempty"`
+}
+
+type BulkUpdateAccountsResult struct {
+	Failed     int64                     `json:"failed,omitempty"`
+	FailedIDs  []int64                   `json:"failed_ids,omitempty"`
+	Results    []BulkUpdateAccountResult `json:"results,omitempty"`
+	Success    int64                     `json:"success,omitempty"`
+	SuccessIDs []int64                   `json:"success_ids,omitempty"`
+}
+
+type CRSPreviewAccount struct {
+	CRSAccountID string `json:"crs_account_id,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Platform     string `json:"platform,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+type ChangePasswordRequest struct {
+	NewPassword string `json:"new_password"`
+	OldPassword string `json:"old_password"`
+}
+
+type Channel struct {
+	BaseURL      *string `json:"base_url,omitempty"`
+	Group        string  `json:"group,omitempty"`
+	ID           int64   `json:"id,omitempty"`
+	Key          string  `json:"key,omitempty"`
+	ModelMapping *string `json:"model_mapping,omitempty"`
+	Models       string  `json:"models,omitempty"`
+	Name         string  `json:"name,omitempty"`
+	Priority     *int64  `json:"priority,omitempty"`
+	Status       int64   `json:"status,omitempty"`
+	Type         int64   `json:"type,omitempty"`
+	Weight       *int64  `json:"weight,omitempty"`
+}
+
+type ClaudeModel struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type ClientRule struct {
+	BodyFields            []string  `json:"body_fields,omitempty"`
+	CreatedAt             time.Time `json:"created_at,omitempty"`
+	Description           *string   `json:"description,omitempty"`
+	Enabled               bool      `json:"enabled,omitempty"`
+	FallbackGroupID       *int64    `json:"fallback_group_id,omitempty"`
+	GroupIDs              []int64   `json:"group_ids,omitempty"`
+	ID                    int64     `json:"id,omitempty"`
+	MetadataUserIDPattern *string   `json:"metadata_user_id_pattern,omitempty"`
+	Name                  string    `json:"name,omitempty"`
+	Preset                string    `json:"preset,omitempty"`
+	Priority              int64     `json:"priority,omitempty"`
+	RejectedCount         int64     `json:"rejected_count,omitempty"`
+	RequiredHeaders       []string  `json:"required_headers,omitempty"`
+	UpdatedAt             time.Time `json:"updated_at,omitempty"`
+	UserAgentPattern      *string   `json:"user_agent_pattern,omitempty"`
+}
+
+type ClusterLeaderLock struct {
+	ExpiresAt      time.Time  `json:"expires_at,omitempty"`
+	Held           bool       `json:"held,omitempty"`
+	Key            string     `json:"key,omitempty"`
+	LastAcquiredAt time.Time  `json:"last_acquired_at,omitempty"`
+	ReleasedAt     *time.Time `json:"released_at,omitempty"`
+}
+
+type ClusterNode struct {
+	Address          string              `json:"address,omitempty"`
+	BuildType        string              `json:"build_type,omitempty"`
+	Draining         bool                `json:"draining,omitempty"`
+	GoVersion        string              `json:"go_version,omitempty"`
+	Goroutines       int64               `json:"goroutines,omitempty"`
+	Host             string              `json:"host,omitempty"`
+	InFlightRequests int64               `json:"in_flight_requests,omitempty"`
+	LastHeartbeatAt  time.Time           `json:"last_heartbeat_at,omitempty"`
+	Locks            []ClusterLeaderLock `json:"locks,omitempty"`
+	NodeID           string              `json:"node_id,omitempty"`
+	Pid              int64               `json:"pid,omitempty"`
+	Restarting       bool                `json:"restarting,omitempty"`
+	Self             bool                `json:"self,omitempty"`
+	StartedAt        time.Time           `json:"started_at,omitempty"`
+	Version          string              `json:"version,omitempty"`
+}
+
+type ClusterRollingRestartStatus struct {
+	Completed   int64      `json:"completed,omitempty"`
+	CurrentNode string     `json:"current_node,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	ID          string     `json:"id,omitempty"`
+	InitiatedBy string     `json:"initiated_by,omitempty"`
+	NodeIDs     []string   `json:"node_ids,omitempty"`
+	StartedAt   time.Time  `json:"started_at,omitempty"`
+	State       string     `json:"state,omitempty"`
+}
+
+type ConfigReloadResult struct {
+	Applied         []string  `json:"applied,omitempty"`
+	Error           string    `json:"error,omitempty"`
+	ReloadedAt      time.Time `json:"reloaded_at,omitempty"`
+	RestartRequired []string  `json:"restart_required,omitempty"`
+	Success         bool      `json:"success,omitempty"`
+	Trigger         string    `json:"trigger,omitempty"`
+}
+
+type ConfigReloadStatus struct {
+	LastReload           *ConfigReloadResult `json:"last_reload,omitempty"`
+	Path                 string              `json:"path,omitempty"`
+	WatchEnabled         bool                `json:"watch_enabled,omitempty"`
+	WatchIntervalSeconds int64               `json:"watch_interval_seconds,omitempty"`
+}
+
+type ConfigSyncAccount struct {
+	Concurrency    *int64                     `json:"concurrency,omitempty"`
+	Credentials    map[string]json.RawMessage `json:"credentials,omitempty"`
+	Extra          map[string]json.RawMessage `json:"extra,omitempty"`
+	Groups         []string                   `json:"groups,omitempty"`
+	Name           string                     `json:"name,omitempty"`
+	Notes          *string                    `json:"notes,omitempty"`
+	Platform       string                     `json:"platform,omitempty"`
+	Priority       *int64                     `json:"priority,omitempty"`
+	Proxy          *string                    `json:"proxy,omitempty"`
+	RateMultiplier *float64                   `json:"rate_multiplier,omitempty"`
+	Schedulable    *bool                      `json:"schedulable,omitempty"`
+	Status         string                     `json:"status,omitempty"`
+	Type           string                     `json:"type,omitempty"`
+}
+
+type ConfigSyncChange struct {
+	Action string   `json:"action,omitempty"`
+	Fields []string `json:"fields,omitempty"`
+	Kind   string   `json:"kind,omitempty"`
+	Name   string   `json:"name,omitempty"`
+}
+
+type ConfigSyncDocument struct {
+	Accounts              []ConfigSyncAccount              `json:"accounts,omitempty"`
+	ErrorPassthroughRules []ConfigSyncErrorPassthroughRule `json:"error_passthrough_rules,omitempty"`
+	Groups                []ConfigSyncGroup                `json:"groups,omitempty"`
+	Pricing               []ConfigSyncModelPrice           `json:"pricing,omitempty"`
+	Proxies               []ConfigSyncProxy                `json:"proxies,omitempty"`
+	RoutingRules          []ConfigSyncRoutingRule          `json:"routing_rules,omitempty"`
+	Settings              map[string]json.RawMessage       `json:"settings,omitempty"`
+	Version               int64                            `json:"version,omitempty"`
+}
+
+type ConfigSyncErrorPassthroughRule struct {
+	CustomMessage   *string  `json:"custom_message,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+	ErrorCodes      []int64  `json:"error_codes,omitempty"`
+	Keywords        []string `json:"keywords,omitempty"`
+	MatchMode       string   `json:"match_mode,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	PassthroughBody bool     `json:"passthrough_body,omitempty"`
+	PassthroughCode bool     `json:"passthrough_code,omitempty"`
+	Platforms       []string `json:"platforms,omitempty"`
+	Priority        int64    `json:"priority,omitempty"`
+	ResponseCode    *int64   `json:"response_code,omitempty"`
+	SkipMonitoring  bool     `json:"skip_monitoring,omitempty"`
+}
+
+type ConfigSyncGroup struct {
+	ClaudeCodeOnly          *bool               `json:"claude_code_only,omitempty"`
+	DailyLimitUsd           *float64            `json:"daily_limit_usd,omitempty"`
+	Description             *string             `json:"description,omitempty"`
+	FallbackGroup           *string             `json:"fallback_group,omitempty"`
+	FirstByteTimeoutSeconds *int64              `json:"first_byte_timeout_seconds,omitempty"`
+	IsExclusive             *bool               `json:"is_exclusive,omitempty"`
+	ModelFallbacks          []ModelFallbackRule `json:"model_fallbacks,omitempty"`
+	ModelRateMultipliers    map[string]float64  `json:"model_rate_multipliers,omitempty"`
+	ModelRouting            map[string][]string `json:"model_routing,omitempty"`
+	ModelRoutingEnabled     *bool               `json:"model_routing_enabled,omitempty"`
+	MonthlyLimitUsd         *float64            `json:"monthly_limit_usd,omitempty"`
+	Name                    string              `json:"name,omitempty"`
+	Platform                string              `json:"platform,omitempty"`
+	RateMultiplier          *float64            `json:"rate_multiplier,omitempty"`
+	SchedulingStrategy      *string             `json:"scheduling_strategy,omitempty"`
+	Status                  string              `json:"status,omitempty"`
+	SubscriptionType        string              `json:"subscription_type,omitempty"`
+	WeeklyLimitUsd          *float64            `json:"weekly_limit_usd,omitempty"`
+}
+
+type ConfigSyncModelPrice struct {
+	CacheReadPrice  float64    `json:"cache_read_price,omitempty"`
+	CacheWritePrice float64    `json:"cache_write_price,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	EffectiveFrom   *time.Time `json:"effective_from,omitempty"`
+	Enabled         *bool      `json:"enabled,omitempty"`
+	Group           string     `json:"group,omitempty"`
+	ImagePrice      *float64   `json:"image_price,omitempty"`
+	InputPrice      float64    `json:"input_price,omitempty"`
+	Model           string     `json:"model,omitempty"`
+	OutputPrice     float64    `json:"output_price,omitempty"`
+	Platform        string     `json:"platform,omitempty"`
+}
+
+type ConfigSyncPlan struct {
+	Applied bool               `json:"applied,omitempty"`
+	Changes []ConfigSyncChange `json:"changes,omitempty"`
+	Created int64              `json:"created,omitempty"`
+	Deleted int64              `json:"deleted,omitempty"`
+	Errors  []string           `json:"errors,omitempty"`
+	Updated int64              `json:"updated,omitempty"`
+}
+
+type ConfigSyncProxy struct {
+	Host     string  `json:"host,omitempty"`
+	Name     string  `json:"name,omitempty"`
+	Password *string `json:"password,omitempty"`
+	Port     int64   `json:"port,omitempty"`
+	Protocol string  `json:"protocol,omitempty"`
+	Status   string  `json:"status,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+type ConfigSyncRoutingRule struct {
+	Description      *string  `json:"description,omitempty"`
+	Enabled          *bool    `json:"enabled,omitempty"`
+	Groups           []string `json:"groups,omitempty"`
+	InputTokensBelow *int64   `json:"input_tokens_below,omitempty"`
+	MaxTokensBelow   *int64   `json:"max_tokens_below,omitempty"`
+	ModelPatterns    []string `json:"model_patterns,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	Priority         int64    `json:"priority,omitempty"`
+	RequireNoTools   bool     `json:"require_no_tools,omitempty"`
+	TargetAccounts   []string `json:"target_accounts,omitempty"`
+	TargetGroup      string   `json:"target_group,omitempty"`
+}
+
+type CookieAuthRequest struct {
+	Code    string `json:"code"`
+	ProxyID *int64 `json:"proxy_id,omitempty"`
+}
+
+type CreateAPIKeyRequest struct {
+	CustomKey     *string  `json:"custom_key,omitempty"`
+	ExpiresInDays *int64   `json:"expires_in_days,omitempty"`
+	GroupID       *int64   `json:"group_id,omitempty"`
+	IPBlacklist   []string `json:"ip_blacklist,omitempty"`
+	IPWhitelist   []string `json:"ip_whitelist,omitempty"`
+	Name          string   `json:"name"`
+	Quota         *float64 `json:"quota,omitempty"`
+}
+
+type CreateAccountRequest struct {
+	AutoPauseOnExpired      *bool                      `json:"auto_pause_on_expired,omitempty"`
+	Concurrency             int64                      `json:"concurrency,omitempty"`
+	ConfirmMixedChannelRisk *bool                      `json:"confirm_mixed_channel_risk,omitempty"`
+	Credentials             map[string]json.RawMessage `json:"credentials,omitempty"`
+	ExpiresAt               *int64                     `json:"expires_at,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"extra,omitempty"`
+	GroupIDs                []int64                    `json:"group_ids,omitempty"`
+	Name                    string                     `json:"name"`
+	Notes                   *string                    `json:"notes,omitempty"`
+	Platform                string                     `json:"platform"`
+	Priority                int64                      `json:"priority,omitempty"`
+	ProxyID                 *int64                     `json:"proxy_id,omitempty"`
+	RateMultiplier          *float64                   `json:"rate_multiplier,omitempty"`
+	Type                    string                     `json:"type"`
+}
+
+type CreateAnnouncementRequest struct {
+	Content   string                `json:"content"`
+	EndsAt    *int64                `json:"ends_at,omitempty"`
+	StartsAt  *int64                `json:"starts_at,omitempty"`
+	Status    string                `json:"status,omitempty"`
+	Targeting AnnouncementTargeting `json:"targeting,omitempty"`
+	Title     string                `json:"title"`
+}
+
+type CreateAttributeDefinitionRequest struct {
+	Description string                  `json:"description,omitempty"`
+	Enabled     bool                    `json:"enabled,omitempty"`
+	Key         string                  `json:"key"`
+	Name        string                  `json:"name"`
+	Options     []UserAttributeOption   `json:"options,omitempty"`
+	Placeholder string                  `json:"placeholder,omitempty"`
+	Required    bool                    `json:"required,omitempty"`
+	Type        string                  `json:"type"`
+	Validation  UserAttributeValidation `json:"validation,omitempty"`
+}
+
+type CreateClientRuleRequest struct {
+	BodyFields            []string `json:"body_fields,omitempty"`
+	Description           *string  `json:"description,omitempty"`
+	Enabled               *bool    `json:"enabled,omitempty"`
+	FallbackGroupID       *int64   `json:"fallback_group_id,omitempty"`
+	GroupIDs              []int64  `json:"group_ids,omitempty"`
+	MetadataUserIDPattern *string  `json:"metadata_user_id_pattern,omitempty"`
+	Name                  string   `json:"name"`
+	Preset                string   `json:"preset,omitempty"`
+	Priority              int64    `json:"priority,omitempty"`
+	RequiredHeaders       []string `json:"required_headers,omitempty"`
+	UserAgentPattern      *string  `json:"user_agent_pattern,omitempty"`
+}
+
+type CreateErrorPassthroughRuleRequest struct {
+	CustomMessage   *string  `json:"custom_message,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+	ErrorCodes      []int64  `json:"error_codes,omitempty"`
+	Keywords        []string `json:"keywords,omitempty"`
+	MatchMode       string   `json:"match_mode,omitempty"`
+	Name            string   `json:"name"`
+	PassthroughBody *bool    `json:"passthrough_body,omitempty"`
+	PassthroughCode *bool    `json:"passthrough_code,omitempty"`
+	Platforms       []string `json:"platforms,omitempty"`
+	Priority        int64    `json:"priority,omitempty"`
+	ResponseCode    *int64   `json:"response_code,omitempty"`
+	SkipMonitoring  *bool    `json:"skip_monitoring,omitempty"`
+}
+
+type CreateGroupRequest struct {
+	ClaudeCodeOnly                  bool                `json:"claude_code_only,omitempty"`
+	CopyAccountsFromGroupIDs        []int64             `json:"copy_accounts_from_group_ids,omitempty"`
+	DailyLimitUsd                   *float64            `json:"daily_limit_usd,omitempty"`
+	Description                     string              `json:"description,omitempty"`
+	FallbackGroupID                 *int64              `json:"fallback_group_id,omitempty"`
+	FallbackGroupIDOnInvalidRequest *int64              `json:"fallback_group_id_on_invalid_request,omitempty"`
+	FirstByteTimeoutSeconds         int64               `json:"first_byte_timeout_seconds,omitempty"`
+	ImagePrice1k                    *float64            `json:"image_price_1k,omitempty"`
+	ImagePrice2k                    *float64            `json:"image_price_2k,omitempty"`
+	ImagePrice4k                    *float64            `json:"image_price_4k,omitempty"`
+	IsExclusive                     bool                `json:"is_exclusive,omitempty"`
+	McpXmlInject                    *bool               `json:"mcp_xml_inject,omitempty"`
+	ModelFallbacks                  []ModelFallbackRule `json:"model_fallbacks,omitempty"`
+	ModelRateMultipliers            map[string]float64  `json:"model_rate_multipliers,omitempty"`
+	ModelRouting                    map[string][]int64  `json:"model_routing,omitempty"`
+	ModelRoutingEnabled             bool                `json:"model_routing_enabled,omitempty"`
+	MonthlyLimitUsd                 *float64            `json:"monthly_limit_usd,omitempty"`
+	Name                            string              `json:"name"`
+	Platform                        string              `json:"platform,omitempty"`
+	RateMultiplier                  float64             `json:"rate_multiplier,omitempty"`
+	SchedulingStrategy              string              `json:"scheduling_strategy,omitempty"`
+	SubscriptionType                string              `json:"subscription_type,omitempty"`
+	SupportedModelScopes            []string            `json:"supported_model_scopes,omitempty"`
+	WeeklyLimitUsd                  *float64            `json:"weekly_limit_usd,omitempty"`
+}
+
+type CreateGuardrailRuleRequest struct {
+	Action       string   `json:"action,omitempty"`
+	BlockMessage *string  `json:"block_message,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	Enabled      *bool    `json:"enabled,omitempty"`
+	GroupIDs     []int64  `json:"group_ids,omitempty"`
+	MatchType    string   `json:"match_type"`
+	Name         string   `json:"name"`
+	Patterns     []string `json:"patterns,omitempty"`
+	Priority     int64    `json:"priority,omitempty"`
+}
+
+type CreatePromoCodeRequest struct {
+	BonusAmount float64 `json:"bonus_amount"`
+	Code        string  `json:"code,omitempty"`
+	ExpiresAt   *int64  `json:"expires_at,omitempty"`
+	MaxUses     int64   `json:"max_uses,omitempty"`
+	Notes       string  `json:"notes,omitempty"`
+}
+
+type CreateProxyRequest struct {
+	Host     string `json:"host"`
+	Name     string `json:"name"`
+	Password string `json:"password,omitempty"`
+	Port     int64  `json:"port"`
+	Protocol string `json:"protocol"`
+	Username string `json:"username,omitempty"`
+}
+
+type CreateRequestPolicyRequest struct {
+	Action        string   `json:"action,omitempty"`
+	Description   *string  `json:"description,omitempty"`
+	DryRun        bool     `json:"dry_run,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+	GroupIDs      []int64  `json:"group_ids,omitempty"`
+	Kind          string   `json:"kind"`
+	LimitValue    *int64   `json:"limit_value,omitempty"`
+	Name          string   `json:"name"`
+	Params        []string `json:"params,omitempty"`
+	Priority      int64    `json:"priority,omitempty"`
+	Protocols     []string `json:"protocols,omitempty"`
+	RejectMessage *string  `json:"reject_message,omitempty"`
+}
+
+type CreateRoutingRuleRequest struct {
+	Description      *string  `json:"description,omitempty"`
+	Enabled          *bool    `json:"enabled,omitempty"`
+	GroupIDs         []int64  `json:"group_ids,omitempty"`
+	InputTokensBelow *int64   `json:"input_tokens_below,omitempty"`
+	MaxTokensBelow   *int64   `json:"max_tokens_below,omitempty"`
+	ModelPatterns    []string `json:"model_patterns,omitempty"`
+	Name             string   `json:"name"`
+	Priority         int64    `json:"priority,omitempty"`
+	RequireNoTools   bool     `json:"require_no_tools,omitempty"`
+	TargetAccountIDs []int64  `json:"target_account_ids,omitempty"`
+	TargetGroupID    *int64   `json:"target_group_id,omitempty"`
+}
+
+type CreateUsageCleanupTaskRequest struct {
+	AccountID   *int64  `json:"account_id,omitempty"`
+	APIKeyID    *int64  `json:"api_key_id,omitempty"`
+	BillingType *int32  `json:"billing_type,omitempty"`
+	EndDate     string  `json:"end_date,omitempty"`
+	GroupID     *int64  `json:"group_id,omitempty"`
+	Model       *string `json:"model,omitempty"`
+	StartDate   string  `json:"start_date,omitempty"`
+	Stream      *bool   `json:"stream,omitempty"`
+	Timezone    string  `json:"timezone,omitempty"`
+	UserID      *int64  `json:"user_id,omitempty"`
+}
+
+type CreateUserRequest struct {
+	AllowedGroups []int64 `json:"allowed_groups,omitempty"`
+	Balance       float64 `json:"balance,omitempty"`
+	Concurrency   int64   `json:"concurrency,omitempty"`
+	Email         string  `json:"email"`
+	Notes         string  `json:"notes,omitempty"`
+	Password      string  `json:"password"`
+	QueueWeight   int64   `json:"queue_weight,omitempty"`
+	Username      string  `json:"username,omitempty"`
+}
+
+type DashboardAggregationBackfillRequest struct {
+	End   string `json:"end,omitempty"`
+	Start string `json:"start,omitempty"`
+}
+
+type DataAccount struct {
+	AutoPauseOnExpired *bool                      `json:"auto_pause_on_expired,omitempty"`
+	Concurrency        int64                      `json:"concurrency,omitempty"`
+	Credentials        map[string]json.RawMessage `json:"credentials,omitempty"`
+	ExpiresAt          *int64                     `json:"expires_at,omitempty"`
+	Extra              map[string]json.RawMessage `json:"extra,omitempty"`
+	Name               string                     `json:"name,omitempty"`
+	Notes              *string                    `json:"notes,omitempty"`
+	Platform           string                     `json:"platform,omitempty"`
+	Priority           int64                      `json:"priority,omitempty"`
+	ProxyKey           *string                    `json:"proxy_key,omitempty"`
+	RateMultiplier     *float64                   `json:"rate_multiplier,omitempty"`
+	Type               string                     `json:"type,omitempty"`
+}
+
+type DataImportError struct {
+	Kind     string `json:"kind,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Name     string `json:"name,omitempty"`
+	ProxyKey string `json:"proxy_key,omitempty"`
+}
+
+type DataImportRequest struct {
+	Data                 DataPayload `json:"data,omitempty"`
+	SkipDefaultGroupBind *bool       `json:"skip_default_group_bind,omitempty"`
+}
+
+type DataImportResult struct {
+	AccountCreated int64             `json:"account_created,omitempty"`
+	AccountFailed  int64             `json:"account_failed,omitempty"`
+	Errors         []DataImportError `json:"errors,omitempty"`
+	ProxyCreated   int64             `json:"proxy_created,omitempty"`
+	ProxyFailed    int64             `json:"proxy_failed,omitempty"`
+	ProxyReused    int64             `json:"proxy_reused,omitempty"`
+}
+
+type DataPayload struct {
+	Accounts   []DataAccount `json:"accounts,omitempty"`
+	ExportedAt string        `json:"exported_at,omitempty"`
+	Proxies    []DataProxy   `json:"proxies,omitempty"`
+	Type       string        `json:"type,omitempty"`
+	Version    int64         `json:"version,omitempty"`
+}
+
+type DataProxy struct {
+	Host     string `json:"host,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Password string `json:"password,omitempty"`
+	Port     int64  `json:"port,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	ProxyKey string `json:"proxy_key,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+type DtoUser struct {
+	AllowedGroups []int64            `json:"allowed_groups,omitempty"`
+	APIKeys       []APIKey           `json:"api_keys,omitempty"`
+	Balance       float64            `json:"balance,omitempty"`
+	Concurrency   int64              `json:"concurrency,omitempty"`
+	CreatedAt     time.Time          `json:"created_at,omitempty"`
+	Email         string             `json:"email,omitempty"`
+	ID            int64              `json:"id,omitempty"`
+	Role          string             `json:"role,omitempty"`
+	Status        string             `json:"status,omitempty"`
+	Subscriptions []UserSubscription `json:"subscriptions,omitempty"`
+	UpdatedAt     time.Time          `json:"updated_at,omitempty"`
+	Username      string             `json:"username,omitempty"`
+}
+
+type Dump struct {
+	Channels    []Channel    `json:"channels,omitempty"`
+	Options     []Option     `json:"options,omitempty"`
+	Redemptions []Redemption `json:"redemptions,omitempty"`
+	Tokens      []Token      `json:"tokens,omitempty"`
+	Users       []OneapiUser `json:"users,omitempty"`
+}
+
+type ErrorPassthroughRule struct {
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	CustomMessage   *string   `json:"custom_message,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Enabled         bool      `json:"enabled,omitempty"`
+	ErrorCodes      []int64   `json:"error_codes,omitempty"`
+	ID              int64     `json:"id,omitempty"`
+	Keywords        []string  `json:"keywords,omitempty"`
+	MatchMode       string    `json:"match_mode,omitempty"`
+	Name            string    `json:"name,omitempty"`
+	PassthroughBody bool      `json:"passthrough_body,omitempty"`
+	PassthroughCode bool      `json:"passthrough_code,omitempty"`
+	Platforms       []string  `json:"platforms,omitempty"`
+	Priority        int64     `json:"priority,omitempty"`
+	ResponseCode    *int64    `json:"response_code,omitempty"`
+	SkipMonitoring  bool      `json:"skip_monitoring,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty"`
+}
+
+type ErrorResponse struct {
+	Code     int64             `json:"code"`
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Reason   string            `json:"reason,omitempty"`
+}
+
+type ExchangeCodeRequest struct {
+	Code      string `json:"code"`
+	ProxyID   *int64 `json:"proxy_id,omitempty"`
+	SessionID string `json:"session_id"`
+}
+
+type FairQueueGroupStats struct {
+	AvgAdmitIntervalMs   int64 `json:"avg_admit_interval_ms,omitempty"`
+	Depth                int64 `json:"depth,omitempty"`
+	EstimatedWaitSeconds int64 `json:"estimated_wait_seconds,omitempty"`
+	GroupID              int64 `json:"group_id,omitempty"`
+}
+
+type FairQueueStats struct {
+	Enabled bool                  `json:"enabled,omitempty"`
+	Groups  []FairQueueGroupStats `json:"groups,omitempty"`
+}
+
+type ForgotPasswordRequest struct {
+	Email          string `json:"email"`
+	TurnstileToken string `json:"turnstile_token,omitempty"`
+}
+
+type ForgotPasswordResponse struct {
+	Message string `json:"message,omitempty"`
+}
+
+type GeminiAuthURLResult struct {
+	AuthURL   string `json:"auth_url,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+	State     string `json:"state,omitempty"`
+}
+
+type GeminiExchangeCodeRequest struct {
+	Code      string `json:"code"`
+	OauthType string `json:"oauth_type,omitempty"`
+	ProxyID   *int64 `json:"proxy_id,omitempty"`
+	SessionID string `json:"session_id"`
+	State     string `json:"state"`
+	TierID    string `json:"tier_id,omitempty"`
+}
+
+type GeminiGenerateAuthURLRequest struct {
+	OauthType string `json:"oauth_type,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+	ProxyID   *int64 `json:"proxy_id,omitempty"`
+	TierID    string `json:"tier_id,omitempty"`
+}
+
+type GeminiOAuthCapabilities struct {
+	AiStudioOauthEnabled bool     `json:"ai_studio_oauth_enabled,omitempty"`
+	RequiredRedirectUris []string `json:"required_redirect_uris,omitempty"`
+}
+
+type GeminiTokenInfo struct {
+	AccessToken  string                     `json:"access_token,omitempty"`
+	ExpiresAt    int64                      `json:"expires_at,omitempty"`
+	ExpiresIn    int64                      `json:"expires_in,omitempty"`
+	Extra        map[string]json.RawMessage `json:"extra,omitempty"`
+	OauthType    string                     `json:"oauth_type,omitempty"`
+	ProjectID    string                     `json:"project_id,omitempty"`
+	RefreshToken string                     `json:"refresh_token,omitempty"`
+	Scope        string                     `json:"scope,omitempty"`
+	TierID       string                     `json:"tier_id,omitempty"`
+	TokenType    string                     `json:"token_type,omitempty"`
+}
+
+type GeminicliModel struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type GenerateAuthURLRequest struct {
+	ProxyID *int64 `json:"proxy_id,omitempty"`
+}
+
+type GenerateAuthURLResult struct {
+	AuthURL   string `json:"auth_url,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+}
+
+type GenerateRedeemCodesRequest struct {
+	Count        int64   `json:"count"`
+	GroupID      *int64  `json:"group_id,omitempty"`
+	Type         string  `json:"type"`
+	ValidityDays int64   `json:"validity_days,omitempty"`
+	Value        float64 `json:"value,omitempty"`
+}
+
+type GetHealthResponse struct {
+	Status string `json:"status"`
+}
+
+type GetSetupStatusResponse struct {
+	Code int64 `json:"code"`
+	Data struct {
+		NeedsSetup bool   `json:"needs_setup"`
+		Step       string `json:"step"`
+	} `json:"data"`
+}
+
+type Group struct {
+	ClaudeCodeOnly                  bool               `json:"claude_code_only,omitempty"`
+	CreatedAt                       time.Time          `json:"created_at,omitempty"`
+	DailyLimitUsd                   *float64           `json:"daily_limit_usd,omitempty"`
+	Description                     string             `json:"description,omitempty"`
+	FallbackGroupID                 *int64             `json:"fallback_group_id,omitempty"`
+	FallbackGroupIDOnInvalidRequest *int64             `json:"fallback_group_id_on_invalid_request,omitempty"`
+	ID                              int64              `json:"id,omitempty"`
+	ImagePrice1k                    *float64           `json:"image_price_1k,omitempty"`
+	ImagePrice2k                    *float64           `json:"image_price_2k,omitempty"`
+	ImagePrice4k                    *float64           `json:"image_price_4k,omitempty"`
+	IsExclusive                     bool               `json:"is_exclusive,omitempty"`
+	ModelRateMultipliers            map[string]float64 `json:"model_rate_multipliers,omitempty"`
+	MonthlyLimitUsd                 *float64           `json:"monthly_limit_usd,omitempty"`
+	Name                            string             `json:"name,omitempty"`
+	Platform                        string             `json:"platform,omitempty"`
+	RateMultiplier                  float64            `json:"rate_multiplier,omitempty"`
+	Status                          string             `json:"status,omitempty"`
+	SubscriptionType                string             `json:"subscription_type,omitempty"`
+	UpdatedAt                       time.Time          `json:"updated_at,omitempty"`
+	WeeklyLimitUsd                  *float64           `json:"weekly_limit_usd,omitempty"`
+}
+
+type GroupAvailability struct {
+	AvailableCount int64  `json:"available_count,omitempty"`
+	ErrorCount     int64  `json:"error_count,omitempty"`
+	GroupID        int64  `json:"group_id,omitempty"`
+	GroupName      string `json:"group_name,omitempty"`
+	Platform       string `json:"platform,omitempty"`
+	RateLimitCount int64  `json:"rate_limit_count,omitempty"`
+	TotalAccounts  int64  `json:"total_accounts,omitempty"`
+}
+
+type GroupConcurrencyInfo struct {
+	CurrentInUse   int64   `json:"current_in_use,omitempty"`
+	GroupID        int64   `json:"group_id,omitempty"`
+	GroupName      string  `json:"group_name,omitempty"`
+	LoadPercentage float64 `json:"load_percentage,omitempty"`
+	MaxCapacity    int64   `json:"max_capacity,omitempty"`
+	Platform       string  `json:"platform,omitempty"`
+	WaitingInQueue int64   `json:"waiting_in_queue,omitempty"`
+}
+
+type GuardrailRule struct {
+	Action       string    `json:"action,omitempty"`
+	BlockMessage *string   `json:"block_message,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	Enabled      bool      `json:"enabled,omitempty"`
+	GroupIDs     []int64   `json:"group_ids,omitempty"`
+	ID           int64     `json:"id,omitempty"`
+	MatchType    string    `json:"match_type,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Patterns     []string  `json:"patterns,omitempty"`
+	Priority     int64     `json:"priority,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+}
+
+type HandlerBatchAPIKeysUsageRequest struct {
+	APIKeyIDs []int64 `json:"api_key_ids,omitempty"`
+}
+
+type HealthCheckResult struct {
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms,omitempty"`
+	Ok        bool   `json:"ok,omitempty"`
+}
+
+type HealthLivezResponse struct {
+	Status string `json:"status"`
+}
+
+type ImportModelPricesRequest struct {
+	Items   []ModelPriceRequest `json:"items,omitempty"`
+	Replace bool                `json:"replace,omitempty"`
+}
+
+type Login2FARequest struct {
+	TempToken string `json:"temp_token"`
+	TotpCode  string `json:"totp_code"`
+}
+
+type LoginRequest struct {
+	Email          string `json:"email"`
+	Password       string `json:"password"`
+	TurnstileToken string `json:"turnstile_token,omitempty"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+type LogoutResponse struct {
+	Message string `json:"message,omitempty"`
+}
+
+type Manifest struct {
+	AppVersion    string      `json:"app_version,omitempty"`
+	CreatedAt     time.Time   `json:"created_at,omitempty"`
+	Encrypted     bool        `json:"encrypted,omitempty"`
+	FormatVersion int64       `json:"format_version,omitempty"`
+	SchemaVersion string      `json:"schema_version,omitempty"`
+	Tables        []string    `json:"tables,omitempty"`
+	UsageLogs     bool        `json:"usage_logs,omitempty"`
+	UsageRange    *UsageRange `json:"usage_range,omitempty"`
+}
+
+type ModelFallbackRule struct {
+	Fallbacks []string `json:"fallbacks,omitempty"`
+	Model     string   `json:"model,omitempty"`
+	Triggers  []string `json:"triggers,omitempty"`
+}
+
+type ModelPrice struct {
+	CacheReadPrice  float64   `json:"cache_read_price,omitempty"`
+	CacheWritePrice float64   `json:"cache_write_price,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	EffectiveFrom   time.Time `json:"effective_from,omitempty"`
+	Enabled         bool      `json:"enabled,omitempty"`
+	GroupID         *int64    `json:"group_id,omitempty"`
+	ID              int64     `json:"id,omitempty"`
+	ImagePrice      *float64  `json:"image_price,omitempty"`
+	InputPrice      float64   `json:"input_price,omitempty"`
+	Model           string    `json:"model,omitempty"`
+	OutputPrice     float64   `json:"output_price,omitempty"`
+	Platform        string    `json:"platform,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty"`
+}
+
+type ModelPriceRequest struct {
+	CacheReadPrice  float64    `json:"cache_read_price,omitempty"`
+	CacheWritePrice float64    `json:"cache_write_price,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	EffectiveFrom   *time.Time `json:"effective_from,omitempty"`
+	Enabled         *bool      `json:"enabled,omitempty"`
+	GroupID         *int64     `json:"group_id,omitempty"`
+	ImagePrice      *float64   `json:"image_price,omitempty"`
+	InputPrice      float64    `json:"input_price,omitempty"`
+	Model           string     `json:"model"`
+	OutputPrice     float64    `json:"output_price,omitempty"`
+	Platform        string     `json:"platform,omitempty"`
+}
+
+type ModelStat struct {
+	ActualCost   float64 `json:"actual_cost,omitempty"`
+	Cost         float64 `json:"cost,omitempty"`
+	InputTokens  int64   `json:"input_tokens,omitempty"`
+	Model        string  `json:"model,omitempty"`
+	OutputTokens int64   `json:"output_tokens,omitempty"`
+	Requests     int64   `json:"requests,omitempty"`
+	TotalTokens  int64   `json:"total_tokens,omitempty"`
+}
+
+type NodeCommandRequest struct {
+	Action string `json:"action"`
+}
+
+type OneAPIImportItem struct {
+	Action   string `json:"action,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Kind     string `json:"kind,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Platform string `json:"platform,omitempty"`
+	SourceID string `json:"source_id,omitempty"`
+	TargetID int64  `json:"target_id,omitempty"`
+	Type     string `json:"type,omitempty"`
+}
+
+type OneAPIImportRequest struct {
+	Database *struct {
+		Driver string `json:"driver"`
+		Dsn    string `json:"dsn"`
+	} `json:"database,omitempty"`
+	DefaultPlatform string            `json:"default_platform,omitempty"`
+	EmailDomain     string            `json:"email_domain,omitempty"`
+	Export          *Dump             `json:"export,omitempty"`
+	Flavor          string            `json:"flavor,omitempty"`
+	QuotaPerUnit    float64           `json:"quota_per_unit,omitempty"`
+	SkipChannels    bool              `json:"skip_channels,omitempty"`
+	SkipRedemptions bool              `json:"skip_redemptions,omitempty"`
+	SkipTokens      bool              `json:"skip_tokens,omitempty"`
+	SkipUsers       bool              `json:"skip_users,omitempty"`
+	TypeOverrides   map[string]string `json:"type_overrides,omitempty"`
+}
+
+type OneAPIImportResult struct {
+	Created      int64              `json:"created,omitempty"`
+	DryRun       bool               `json:"dry_run,omitempty"`
+	Existing     int64              `json:"existing,omitempty"`
+	Failed       int64              `json:"failed,omitempty"`
+	Flavor       string             `json:"flavor,omitempty"`
+	Items        []OneAPIImportItem `json:"items,omitempty"`
+	QuotaPerUnit float64            `json:"quota_per_unit,omitempty"`
+	Skipped      int64              `json:"skipped,omitempty"`
+}
+
+type OneapiUser struct {
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+	DisplayName string  `json:"display_name,omitempty"`
+	Email       string  `json:"email,omitempty"`
+	Group       string  `json:"group,omitempty"`
+	ID          int64   `json:"id,omitempty"`
+	Password    string  `json:"password,omitempty"`
+	Quota       int64   `json:"quota,omitempty"`
+	Role        int64   `json:"role,omitempty"`
+	Status      int64   `json:"status,omitempty"`
+	UsedQuota   int64   `json:"used_quota,omitempty"`
+	Username    string  `json:"username,omitempty"`
+}
+
+type OpenAIAuthURLResult struct {
+	AuthURL   string `json:"auth_url,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+}
+
+type OpenAIExchangeCodeRequest struct {
+	Code        string `json:"code"`
+	ProxyID     *int64 `json:"proxy_id,omitempty"`
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	SessionID   string `json:"session_id"`
+}
+
+type OpenAIGenerateAuthURLRequest struct {
+	ProxyID     *int64 `json:"proxy_id,omitempty"`
+	RedirectURI string `json:"redirect_uri,omitempty"`
+}
+
+type OpenAIRefreshTokenRequest struct {
+	ProxyID      *int64 `json:"proxy_id,omitempty"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type OpenAITokenInfo struct {
+	AccessToken      string `json:"access_token,omitempty"`
+	ChatgptAccountID string `json:"chatgpt_account_id,omitempty"`
+	ChatgptUserID    string `json:"chatgpt_user_id,omitempty"`
+	Email            string `json:"email,omitempty"`
+	ExpiresAt        int64  `json:"expires_at,omitempty"`
+	ExpiresIn        int64  `json:"expires_in,omitempty"`
+	IDToken          string `json:"id_token,omitempty"`
+	OrganizationID   string `json:"organization_id,omitempty"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+}
+
+type OpenaiModel struct {
+	Created     int64  `json:"created,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Object      string `json:"object,omitempty"`
+	OwnedBy     string `json:"owned_by,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type OpsAdvancedSettings struct {
+	Aggregation                OpsAggregationSettings   `json:"aggregation,omitempty"`
+	AutoRefreshEnabled         bool                     `json:"auto_refresh_enabled,omitempty"`
+	AutoRefreshIntervalSeconds int64                    `json:"auto_refresh_interval_seconds,omitempty"`
+	DataRetention              OpsDataRetentionSettings `json:"data_retention,omitempty"`
+	IgnoreContextCanceled      bool                     `json:"ignore_context_canceled,omitempty"`
+	IgnoreCountTokensErrors    bool                     `json:"ignore_count_tokens_errors,omitempty"`
+	IgnoreInvalidAPIKeyErrors  bool                     `json:"ignore_invalid_api_key_errors,omitempty"`
+	IgnoreNoAvailableAccounts  bool                     `json:"ignore_no_available_accounts,omitempty"`
+}
+
+type OpsAggregationSettings struct {
+	AggregationEnabled bool `json:"aggregation_enabled,omitempty"`
+}
+
+type OpsAlertEvent struct {
+	CreatedAt      time.Time                  `json:"created_at,omitempty"`
+	Description    string                     `json:"description,omitempty"`
+	Dimensions     map[string]json.RawMessage `json:"dimensions,omitempty"`
+	EmailSent      bool                       `json:"email_sent,omitempty"`
+	FiredAt        time.Time                  `json:"fired_at,omitempty"`
+	ID             int64                      `json:"id,omitempty"`
+	MetricValue    *float64                   `json:"metric_value,omitempty"`
+	ResolvedAt     *time.Time                 `json:"resolved_at,omitempty"`
+	RuleID         int64                      `json:"rule_id,omitempty"`
+	Severity       string                     `json:"severity,omitempty"`
+	Status         string                     `json:"status,omitempty"`
+	ThresholdValue *float64                   `json:"threshold_value,omitempty"`
+	Title          string                     `json:"title,omitempty"`
+}
+
+type OpsAlertRule struct {
+	CooldownMinutes  int64                      `json:"cooldown_minutes,omitempty"`
+	CreatedAt        time.Time                  `json:"created_at,omitempty"`
+	Description      string                     `json:"description,omitempty"`
+	Enabled          bool                       `json:"enabled,omitempty"`
+	Filters          map[string]json.RawMessage `json:"filters,omitempty"`
+	ID               int64                      `json:"id,omitempty"`
+	LastTriggeredAt  *time.Time                 `json:"last_triggered_at,omitempty"`
+	MetricType       string                     `json:"metric_type,omitempty"`
+	Name             string                     `json:"name,omitempty"`
+	NotifyEmail      bool                       `json:"notify_email,omitempty"`
+	Operator         string                     `json:"operator,omitempty"`
+	Severity         string                     `json:"severity,omitempty"`
+	SustainedMinutes int64                      `json:"sustained_minutes,omitempty"`
+	Threshold        float64                    `json:"threshold,omitempty"`
+	UpdatedAt        time.Time                  `json:"updated_at,omitempty"`
+	WindowMinutes    int64                      `json:"window_minutes,omitempty"`
+}
+
+type OpsAlertRuntimeSettings struct {
+	DistributedLock           OpsDistributedLockSettings `json:"distributed_lock,omitempty"`
+	EvaluationIntervalSeconds int64                      `json:"evaluation_interval_seconds,omitempty"`
+	Silencing                 OpsAlertSilencingSettings  `json:"silencing,omitempty"`
+	Thresholds                OpsMetricThresholds        `json:"thresholds,omitempty"`
+}
+
+type OpsAlertSilence struct {
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedBy *int64    `json:"created_by,omitempty"`
+	GroupID   *int64    `json:"group_id,omitempty"`
+	ID        int64     `json:"id,omitempty"`
+	Platform  string    `json:"platform,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Region    *string   `json:"region,omitempty"`
+	RuleID    int64     `json:"rule_id,omitempty"`
+	Until     time.Time `json:"until,omitempty"`
+}
+
+type OpsAlertSilenceEntry struct {
+	Reason       string   `json:"reason,omitempty"`
+	RuleID       *int64   `json:"rule_id,omitempty"`
+	Severities   []string `json:"severities,omitempty"`
+	UntilRfc3339 string   `json:"until_rfc3339,omitempty"`
+}
+
+type OpsAlertSilencingSettings struct {
+	Enabled            bool                   `json:"enabled,omitempty"`
+	Entries            []OpsAlertSilenceEntry `json:"entries,omitempty"`
+	GlobalReason       string                 `json:"global_reason,omitempty"`
+	GlobalUntilRfc3339 string                 `json:"global_until_rfc3339,omitempty"`
+}
+
+type OpsDashboardOverview struct {
+	BusinessLimitedCount         int64                     `json:"business_limited_count,omitempty"`
+	Duration                     OpsPercentiles            `json:"duration,omitempty"`
+	EndTime                      time.Time                 `json:"end_time,omitempty"`
+	ErrorCountSla                int64                     `json:"error_count_sla,omitempty"`
+	ErrorCountTotal              int64                     `json:"error_count_total,omitempty"`
+	ErrorRate                    float64                   `json:"error_rate,omitempty"`
+	FairQueue                    *FairQueueStats           `json:"fair_queue,omitempty"`
+	GroupID                      *int64                    `json:"group_id,omitempty"`
+	HealthScore                  int64                     `json:"health_score,omitempty"`
+	JobHeartbeats                []*OpsJobHeartbeat        `json:"job_heartbeats,omitempty"`
+	Platform                     string                    `json:"platform,omitempty"`
+	QPS                          OpsRateSummary            `json:"qps,omitempty"`
+	RequestCountSla              int64                     `json:"request_count_sla,omitempty"`
+	RequestCountTotal            int64                     `json:"request_count_total,omitempty"`
+	Sla                          float64                   `json:"sla,omitempty"`
+	StartTime                    time.Time                 `json:"start_time,omitempty"`
+	SuccessCount                 int64                     `json:"success_count,omitempty"`
+	SystemMetrics                *OpsSystemMetricsSnapshot `json:"system_metrics,omitempty"`
+	TokenConsumed                int64                     `json:"token_consumed,omitempty"`
+	TPS                          OpsRateSummary            `json:"tps,omitempty"`
+	Ttft                         OpsPercentiles            `json:"ttft,omitempty"`
+	Upstream429Count             int64                     `json:"upstream_429_count,omitempty"`
+	Upstream529Count             int64                     `json:"upstream_529_count,omitempty"`
+	UpstreamErrorCountExcl429529 int64                     `json:"upstream_error_count_excl_429_529,omitempty"`
+	UpstreamErrorRate            float64                   `json:"upstream_error_rate,omitempty"`
+	UsageOutbox                  *UsageOutboxStats         `json:"usage_outbox,omitempty"`
+}
+
+type OpsDataRetentionSettings struct {
+	CleanupEnabled             bool   `json:"cleanup_enabled,omitempty"`
+	CleanupSchedule            string `json:"cleanup_schedule,omitempty"`
+	ErrorLogRetentionDays      int64  `json:"error_log_retention_days,omitempty"`
+	HourlyMetricsRetentionDays int64  `json:"hourly_metrics_retention_days,omitempty"`
+	MinuteMetricsRetentionDays int64  `json:"minute_metrics_retention_days,omitempty"`
+}
+
+type OpsDistributedLockSettings struct {
+	Enabled    bool   `json:"enabled,omitempty"`
+	Key        string `json:"key,omitempty"`
+	TTLSeconds int64  `json:"ttl_seconds,omitempty"`
+}
+
+type OpsEmailAlertConfig struct {
+	BatchingWindowSeconds int64    `json:"batching_window_seconds,omitempty"`
+	Enabled               bool     `json:"enabled,omitempty"`
+	IncludeResolvedAlerts bool     `json:"include_resolved_alerts,omitempty"`
+	MinSeverity           string   `json:"min_severity,omitempty"`
+	RateLimitPerHour      int64    `json:"rate_limit_per_hour,omitempty"`
+	Recipients            []string `json:"recipients,omitempty"`
+}
+
+type OpsEmailNotificationConfig struct {
+	Alert  OpsEmailAlertConfig  `json:"alert,omitempty"`
+	Report OpsEmailReportConfig `json:"report,omitempty"`
+}
+
+type OpsEmailNotificationConfigUpdateRequest struct {
+	Alert  *OpsEmailAlertConfig  `json:"alert,omitempty"`
+	Report *OpsEmailReportConfig `json:"report,omitempty"`
+}
+
+type OpsEmailReportConfig struct {
+	AccountHealthEnabled            bool     `json:"account_health_enabled,omitempty"`
+	AccountHealthErrorRateThreshold float64  `json:"account_health_error_rate_threshold,omitempty"`
+	AccountHealthSchedule           string   `json:"account_health_schedule,omitempty"`
+	DailySummaryEnabled             bool     `json:"daily_summary_enabled,omitempty"`
+	DailySummarySchedule            string   `json:"daily_summary_schedule,omitempty"`
+	Enabled                         bool     `json:"enabled,omitempty"`
+	ErrorDigestEnabled              bool     `json:"error_digest_enabled,omitempty"`
+	ErrorDigestMinCount             int64    `json:"error_digest_min_count,omitempty"`
+	ErrorDigestSchedule             string   `json:"error_digest_schedule,omitempty"`
+	Recipients                      []string `json:"recipients,omitempty"`
+	WeeklySummaryEnabled            bool     `json:"weekly_summary_enabled,omitempty"`
+	WeeklySummarySchedule           string   `json:"weekly_summary_schedule,omitempty"`
+}
+
+type OpsErrorDistributionItem struct {
+	BusinessLimited int64 `json:"business_limited,omitempty"`
+	Sla             int64 `json:"sla,omitempty"`
+	StatusCode      int64 `json:"status_code,omitempty"`
+	Total           int64 `json:"total,omitempty"`
+}
+
+type OpsErrorDistributionResponse struct {
+	Items []*OpsErrorDistributionItem `json:"items,omitempty"`
+	Total int64                       `json:"total,omitempty"`
+}
+
+type OpsErrorLog struct {
+	AccountID          *int64     `json:"account_id,omitempty"`
+	AccountName        string     `json:"account_name,omitempty"`
+	APIKeyID           *int64     `json:"api_key_id,omitempty"`
+	ClientIP           *string    `json:"client_ip,omitempty"`
+	ClientRequestID    string     `json:"client_request_id,omitempty"`
+	CreatedAt          time.Time  `json:"created_at,omitempty"`
+	ErrorOwner         string     `json:"error_owner,omitempty"`
+	ErrorSource        string     `json:"error_source,omitempty"`
+	GroupID            *int64     `json:"group_id,omitempty"`
+	GroupName          string     `json:"group_name,omitempty"`
+	ID                 int64      `json:"id,omitempty"`
+	IsRetryable        bool       `json:"is_retryable,omitempty"`
+	Message            string     `json:"message,omitempty"`
+	Model              string     `json:"model,omitempty"`
+	Phase              string     `json:"phase,omitempty"`
+	Platform           string     `json:"platform,omitempty"`
+	RequestID          string     `json:"request_id,omitempty"`
+	RequestPath        string     `json:"request_path,omitempty"`
+	Resolved           bool       `json:"resolved,omitempty"`
+	ResolvedAt         *time.Time `json:"resolved_at,omitempty"`
+	ResolvedByUserID   *int64     `json:"resolved_by_user_id,omitempty"`
+	ResolvedByUserName string     `json:"resolved_by_user_name,omitempty"`
+	ResolvedRetryID    *int64     `json:"resolved_retry_id,omitempty"`
+	RetryCount         int64      `json:"retry_count,omitempty"`
+	Severity           string     `json:"severity,omitempty"`
+	StatusCode         int64      `json:"status_code,omitempty"`
+	Stream             bool       `json:"stream,omitempty"`
+	Type               string     `json:"type,omitempty"`
+	UserEmail          string     `json:"user_email,omitempty"`
+	UserID             *int64     `json:"user_id,omitempty"`
+}
+
+type OpsErrorLogDetail struct {
+	AccountID            *int64     `json:"account_id,omitempty"`
+	AccountName          string     `json:"account_name,omitempty"`
+	APIKeyID             *int64     `json:"api_key_id,omitempty"`
+	AuthLatencyMs        *int64     `json:"auth_latency_ms,omitempty"`
+	ClientIP             *string    `json:"client_ip,omitempty"`
+	ClientRequestID      string     `json:"client_request_id,omitempty"`
+	CreatedAt            time.Time  `json:"created_at,omitempty"`
+	ErrorBody            string     `json:"error_body,omitempty"`
+	ErrorOwner           string     `json:"error_owner,omitempty"`
+	ErrorSource          string     `json:"error_source,omitempty"`
+	GroupID              *int64     `json:"group_id,omitempty"`
+	GroupName            string     `json:"group_name,omitempty"`
+	ID                   int64      `json:"id,omitempty"`
+	IsBusinessLimited    bool       `json:"is_business_limited,omitempty"`
+	IsRetryable          bool       `json:"is_retryable,omitempty"`
+	Message              string     `json:"message,omitempty"`
+	Model                string     `json:"model,omitempty"`
+	Phase                string     `json:"phase,omitempty"`
+	Platform             string     `json:"platform,omitempty"`
+	RequestBody          string     `json:"request_body,omitempty"`
+	RequestBodyBytes     *int64     `json:"request_body_bytes,omitempty"`
+	RequestBodyTruncated bool       `json:"request_body_truncated,omitempty"`
+	RequestHeaders       string     `json:"request_headers,omitempty"`
+	RequestID            string     `json:"request_id,omitempty"`
+	RequestPath          string     `json:"request_path,omitempty"`
+	Resolved             bool       `json:"resolved,omitempty"`
+	ResolvedAt           *time.Time `json:"resolved_at,omitempty"`
+	ResolvedByUserID     *int64     `json:"resolved_by_user_id,omitempty"`
+	ResolvedByUserName   string     `json:"resolved_by_user_name,omitempty"`
+	ResolvedRetryID      *int64     `json:"resolved_retry_id,omitempty"`
+	ResponseLatencyMs    *int64     `json:"response_latency_ms,omitempty"`
+	RetryCount           int64      `json:"retry_count,omitempty"`
+	RoutingLatencyMs     *int64     `json:"routing_latency_ms,omitempty"`
+	Severity             string     `json:"severity,omitempty"`
+	StatusCode           int64      `json:"status_code,omitempty"`
+	Stream               bool       `json:"stream,omitempty"`
+	TimeToFirstTokenMs   *int64     `json:"time_to_first_token_ms,omitempty"`
+	TraceID              string     `json:"trace_id,omitempty"`
+	Type                 string     `json:"type,omitempty"`
+	UpstreamErrorDetail  string     `json:"upstream_error_detail,omitempty"`
+	UpstreamErrorMessage string     `json:"upstream_error_message,omitempty"`
+	UpstreamErrors       string     `json:"upstream_errors,omitempty"`
+	UpstreamLatencyMs    *int64     `json:"upstream_latency_ms,omitempty"`
+	UpstreamStatusCode   *int64     `json:"upstream_status_code,omitempty"`
+	UserAgent            string     `json:"user_agent,omitempty"`
+	UserEmail            string     `json:"user_email,omitempty"`
+	UserID               *int64     `json:"user_id,omitempty"`
+}
+
+type OpsErrorTrendPoint struct {
+	BucketStart                  time.Time `json:"bucket_start,omitempty"`
+	BusinessLimitedCount         int64     `json:"business_limited_count,omitempty"`
+	ErrorCountSla                int64     `json:"error_count_sla,omitempty"`
+	ErrorCountTotal              int64     `json:"error_count_total,omitempty"`
+	Upstream429Count             int64     `json:"upstream_429_count,omitempty"`
+	Upstream529Count             int64     `json:"upstream_529_count,omitempty"`
+	UpstreamErrorCountExcl429529 int64     `json:"upstream_error_count_excl_429_529,omitempty"`
+}
+
+type OpsErrorTrendResponse struct {
+	Bucket string                `json:"bucket,omitempty"`
+	Points []*OpsErrorTrendPoint `json:"points,omitempty"`
+}
+
+type OpsJobHeartbeat struct {
+	JobName        string     `json:"job_name,omitempty"`
+	LastDurationMs *int64     `json:"last_duration_ms,omitempty"`
+	LastError      *string    `json:"last_error,omitempty"`
+	LastErrorAt    *time.Time `json:"last_error_at,omitempty"`
+	LastResult     *string    `json:"last_result,omitempty"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	LastSuccessAt  *time.Time `json:"last_success_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at,omitempty"`
+}
+
+type OpsLatencyHistogramBucket struct {
+	Count int64  `json:"count,omitempty"`
+	Range string `json:"range,omitempty"`
+}
+
+type OpsLatencyHistogramResponse struct {
+	Buckets       []*OpsLatencyHistogramBucket `json:"buckets,omitempty"`
+	EndTime       time.Time                    `json:"end_time,omitempty"`
+	GroupID       *int64                       `json:"group_id,omitempty"`
+	Platform      string                       `json:"platform,omitempty"`
+	StartTime     time.Time                    `json:"start_time,omitempty"`
+	TotalRequests int64                        `json:"total_requests,omitempty"`
+}
+
+type OpsMetricThresholds struct {
+	RequestErrorRatePercentMax  *float64 `json:"request_error_rate_percent_max,omitempty"`
+	SlaPercentMin               *float64 `json:"sla_percent_min,omitempty"`
+	TtftP99MsMax                *float64 `json:"ttft_p99_ms_max,omitempty"`
+	UpstreamErrorRatePercentMax *float64 `json:"upstream_error_rate_percent_max,omitempty"`
+}
+
+type OpsPercentiles struct {
+	AvgMs *int64 `json:"avg_ms,omitempty"`
+	MaxMs *int64 `json:"max_ms,omitempty"`
+	P50Ms *int64 `json:"p50_ms,omitempty"`
+	P90Ms *int64 `json:"p90_ms,omitempty"`
+	P95Ms *int64 `json:"p95_ms,omitempty"`
+	P99Ms *int64 `json:"p99_ms,omitempty"`
+}
+
+type OpsRateSummary struct {
+	Avg     float64 `json:"avg,omitempty"`
+	Current float64 `json:"current,omitempty"`
+	Peak    float64 `json:"peak,omitempty"`
+}
+
+type OpsRealtimeTrafficSummary struct {
+	EndTime   time.Time      `json:"end_time,omitempty"`
+	GroupID   *int64         `json:"group_id,omitempty"`
+	Platform  string         `json:"platform,omitempty"`
+	QPS       OpsRateSummary `json:"qps,omitempty"`
+	StartTime time.Time      `json:"start_time,omitempty"`
+	TPS       OpsRateSummary `json:"tps,omitempty"`
+	Window    string         `json:"window,omitempty"`
+}
+
+type OpsRequestDetail struct {
+	AccountID  *int64    `json:"account_id,omitempty"`
+	APIKeyID   *int64    `json:"api_key_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	DurationMs *int64    `json:"duration_ms,omitempty"`
+	ErrorID    *int64    `json:"error_id,omitempty"`
+	GroupID    *int64    `json:"group_id,omitempty"`
+	Kind       string    `json:"kind,omitempty"`
+	Message    string    `json:"message,omitempty"`
+	Model      string    `json:"model,omitempty"`
+	Phase      string    `json:"phase,omitempty"`
+	Platform   string    `json:"platform,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Severity   string    `json:"severity,omitempty"`
+	StatusCode *int64    `json:"status_code,omitempty"`
+	Stream     bool      `json:"stream,omitempty"`
+	UserID     *int64    `json:"user_id,omitempty"`
+}
+
+type OpsResolveRequest struct {
+	Resolved bool `json:"resolved,omitempty"`
+}
+
+type OpsRetryAttempt struct {
+	CreatedAt         time.Time  `json:"created_at,omitempty"`
+	DurationMs        *int64     `json:"duration_ms,omitempty"`
+	ErrorMessage      *string    `json:"error_message,omitempty"`
+	FinishedAt        *time.Time `json:"finished_at,omitempty"`
+	HTTPStatusCode    *int64     `json:"http_status_code,omitempty"`
+	ID                int64      `json:"id,omitempty"`
+	Mode              string     `json:"mode,omitempty"`
+	PinnedAccountID   *int64     `json:"pinned_account_id,omitempty"`
+	PinnedAccountName string     `json:"pinned_account_name,omitempty"`
+	RequestedByUserID int64      `json:"requested_by_user_id,omitempty"`
+	ResponsePreview   *string    `json:"response_preview,omitempty"`
+	ResponseTruncated *bool      `json:"response_truncated,omitempty"`
+	ResultErrorID     *int64     `json:"result_error_id,omitempty"`
+	ResultRequestID   *string    `json:"result_request_id,omitempty"`
+	SourceErrorID     int64      `json:"source_error_id,omitempty"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	Status            string     `json:"status,omitempty"`
+	Success           *bool      `json:"success,omitempty"`
+	UpstreamRequestID *string    `json:"upstream_request_id,omitempty"`
+	UsedAccountID     *int64     `json:"used_account_id,omitempty"`
+	UsedAccountName   string     `json:"used_account_name,omitempty"`
+}
+
+type OpsRetryRequest struct {
+	Force           bool   `json:"force,omitempty"`
+	Mode            string `json:"mode,omitempty"`
+	PinnedAccountID *int64 `json:"pinned_account_id,omitempty"`
+}
+
+type OpsRetryResult struct {
+	AttemptID         int64     `json:"attempt_id,omitempty"`
+	DurationMs        int64     `json:"duration_ms,omitempty"`
+	ErrorMessage      string    `json:"error_message,omitempty"`
+	FinishedAt        time.Time `json:"finished_at,omitempty"`
+	HTTPStatusCode    int64     `json:"http_status_code,omitempty"`
+	Mode              string    `json:"mode,omitempty"`
+	PinnedAccountID   *int64    `json:"pinned_account_id,omitempty"`
+	ResponsePreview   string    `json:"response_preview,omitempty"`
+	ResponseTruncated bool      `json:"response_truncated,omitempty"`
+	StartedAt         time.Time `json:"started_at,omitempty"`
+	Status            string    `json:"status,omitempty"`
+	UpstreamRequestID string    `json:"upstream_request_id,omitempty"`
+	UsedAccountID     *int64    `json:"used_account_id,omitempty"`
+}
+
+type OpsSystemMetricsSnapshot struct {
+	AccountSwitchCount    *int64    `json:"account_switch_count,omitempty"`
+	ConcurrencyQueueDepth *int64    `json:"concurrency_queue_depth,omitempty"`
+	CPUUsagePercent       *float64  `json:"cpu_usage_percent,omitempty"`
+	CreatedAt             time.Time `json:"created_at,omitempty"`
+	DBConnActive          *int64    `json:"db_conn_active,omitempty"`
+	DBConnIdle            *int64    `json:"db_conn_idle,omitempty"`
+	DBConnWaiting         *int64    `json:"db_conn_waiting,omitempty"`
+	DBMaxOpenConns        *int64    `json:"db_max_open_conns,omitempty"`
+	DBOk                  *bool     `json:"db_ok,omitempty"`
+	GoroutineCount        *int64    `json:"goroutine_count,omitempty"`
+	ID                    int64     `json:"id,omitempty"`
+	MemoryTotalMb         *int64    `json:"memory_total_mb,omitempty"`
+	MemoryUsagePercent    *float64  `json:"memory_usage_percent,omitempty"`
+	MemoryUsedMb          *int64    `json:"memory_used_mb,omitempty"`
+	RedisConnIdle         *int64    `json:"redis_conn_idle,omitempty"`
+	RedisConnTotal        *int64    `json:"redis_conn_total,omitempty"`
+	RedisOk               *bool     `json:"redis_ok,omitempty"`
+	RedisPoolSize         *int64    `json:"redis_pool_size,omitempty"`
+	WindowMinutes         int64     `json:"window_minutes,omitempty"`
+}
+
+type OpsThroughputGroupBreakdownItem struct {
+	GroupID       int64  `json:"group_id,omitempty"`
+	GroupName     string `json:"group_name,omitempty"`
+	RequestCount  int64  `json:"request_count,omitempty"`
+	TokenConsumed int64  `json:"token_consumed,omitempty"`
+}
+
+type OpsThroughputPlatformBreakdownItem struct {
+	Platform      string `json:"platform,omitempty"`
+	RequestCount  int64  `json:"request_count,omitempty"`
+	TokenConsumed int64  `json:"token_consumed,omitempty"`
+}
+
+type OpsThroughputTrendPoint struct {
+	BucketStart   time.Time `json:"bucket_start,omitempty"`
+	QPS           float64   `json:"qps,omitempty"`
+	RequestCount  int64     `json:"request_count,omitempty"`
+	SwitchCount   int64     `json:"switch_count,omitempty"`
+	TokenConsumed int64     `json:"token_consumed,omitempty"`
+	TPS           float64   `json:"tps,omitempty"`
+}
+
+type OpsThroughputTrendResponse struct {
+	Bucket     string                                `json:"bucket,omitempty"`
+	ByPlatform []*OpsThroughputPlatformBreakdownItem `json:"by_platform,omitempty"`
+	Points     []*OpsThroughputTrendPoint            `json:"points,omitempty"`
+	TopGroups  []*OpsThroughputGroupBreakdownItem    `json:"top_groups,omitempty"`
+}
+
+type Option struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type PlatformAvailability struct {
+	AvailableCount int64  `json:"available_count,omitempty"`
+	ErrorCount     int64  `json:"error_count,omitempty"`
+	Platform       string `json:"platform,omitempty"`
+	RateLimitCount int64  `json:"rate_limit_count,omitempty"`
+	TotalAccounts  int64  `json:"total_accounts,omitempty"`
+}
+
+type PlatformConcurrencyInfo struct {
+	CurrentInUse   int64   `json:"current_in_use,omitempty"`
+	LoadPercentage float64 `json:"load_percentage,omitempty"`
+	MaxCapacity    int64   `json:"max_capacity,omitempty"`
+	Platform       string  `json:"platform,omitempty"`
+	WaitingInQueue int64   `json:"waiting_in_queue,omitempty"`
+}
+
+type PreviewFromCRSRequest struct {
+	BaseURL  string `json:"base_url"`
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+type PreviewFromCRSResult struct {
+	ExistingAccounts []CRSPreviewAccount `json:"existing_accounts,omitempty"`
+	NewAccounts      []CRSPreviewAccount `json:"new_accounts,omitempty"`
+}
+
+type PromoCode struct {
+	BonusAmount float64    `json:"bonus_amount,omitempty"`
+	Code        string     `json:"code,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	ID          int64      `json:"id,omitempty"`
+	MaxUses     int64      `json:"max_uses,omitempty"`
+	Notes       string     `json:"notes,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+	UsedCount   int64      `json:"used_count,omitempty"`
+}
+
+type PromoCodeUsage struct {
+	BonusAmount float64   `json:"bonus_amount,omitempty"`
+	ID          int64     `json:"id,omitempty"`
+	PromoCodeID int64     `json:"promo_code_id,omitempty"`
+	UsedAt      time.Time `json:"used_at,omitempty"`
+	User        *DtoUser  `json:"user,omitempty"`
+	UserID      int64     `json:"user_id,omitempty"`
+}
+
+type Proxy struct {
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	Host      string    `json:"host,omitempty"`
+	ID        int64     `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Port      int64     `json:"port,omitempty"`
+	Protocol  string    `json:"protocol,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	Username  string    `json:"username,omitempty"`
+}
+
+type ProxyAccountSummary struct {
+	ID       int64   `json:"id,omitempty"`
+	Name     string  `json:"name,omitempty"`
+	Notes    *string `json:"notes,omitempty"`
+	Platform string  `json:"platform,omitempty"`
+	Type     string  `json:"type,omitempty"`
+}
+
+type ProxyBatchDeleteResult struct {
+	DeletedIDs []int64                   `json:"deleted_ids,omitempty"`
+	Skipped    []ProxyBatchDeleteSkipped `json:"skipped,omitempty"`
+}
+
+type ProxyBatchDeleteSkipped struct {
+	ID     int64  `json:"id,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type ProxyImportRequest struct {
+	Data DataPayload `json:"data,omitempty"`
+}
+
+type ProxyTestResult struct {
+	City        string `json:"city,omitempty"`
+	Country     string `json:"country,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+	IPAddress   string `json:"ip_address,omitempty"`
+	LatencyMs   int64  `json:"latency_ms,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Region      string `json:"region,omitempty"`
+	Success     bool   `json:"success,omitempty"`
+}
+
+type ProxyWithAccountCount struct {
+	AccountCount   int64     `json:"account_count,omitempty"`
+	City           string    `json:"city,omitempty"`
+	Country        string    `json:"country,omitempty"`
+	CountryCode    string    `json:"country_code,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	Host           string    `json:"host,omitempty"`
+	ID             int64     `json:"id,omitempty"`
+	IPAddress      string    `json:"ip_address,omitempty"`
+	LatencyMessage string    `json:"latency_message,omitempty"`
+	LatencyMs      *int64    `json:"latency_ms,omitempty"`
+	LatencyStatus  string    `json:"latency_status,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Port           int64     `json:"port,omitempty"`
+	Protocol       string    `json:"protocol,omitempty"`
+	Region         string    `json:"region,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	Username       string    `json:"username,omitempty"`
+}
+
+type PublicSettings struct {
+	APIBaseURL                  string `json:"api_base_url,omitempty"`
+	ContactInfo                 string `json:"contact_info,omitempty"`
+	DocURL                      string `json:"doc_url,omitempty"`
+	EmailVerifyEnabled          bool   `json:"email_verify_enabled,omitempty"`
+	HideCcsImportButton         bool   `json:"hide_ccs_import_button,omitempty"`
+	HomeContent                 string `json:"home_content,omitempty"`
+	InvitationCodeEnabled       bool   `json:"invitation_code_enabled,omitempty"`
+	LinuxdoOauthEnabled         bool   `json:"linuxdo_oauth_enabled,omitempty"`
+	PasswordResetEnabled        bool   `json:"password_reset_enabled,omitempty"`
+	PromoCodeEnabled            bool   `json:"promo_code_enabled,omitempty"`
+	PurchaseSubscriptionEnabled bool   `json:"purchase_subscription_enabled,omitempty"`
+	PurchaseSubscriptionURL     string `json:"purchase_subscription_url,omitempty"`
+	RegistrationEnabled         bool   `json:"registration_enabled,omitempty"`
+	SiteLogo                    string `json:"site_logo,omitempty"`
+	SiteName                    string `json:"site_name,omitempty"`
+	SiteSubtitle                string `json:"site_subtitle,omitempty"`
+	TotpEnabled                 bool   `json:"totp_enabled,omitempty"`
+	TurnstileEnabled            bool   `json:"turnstile_enabled,omitempty"`
+	TurnstileSiteKey            string `json:"turnstile_site_key,omitempty"`
+	Version                     string `json:"version,omitempty"`
+}
+
+type ReadinessReport struct {
+	Checks           map[string]HealthCheckResult `json:"checks,omitempty"`
+	InFlightRequests int64                        `json:"in_flight_requests,omitempty"`
+	Status           string                       `json:"status,omitempty"`
+}
+
+type RedeemCode struct {
+	Code         string     `json:"code,omitempty"`
+	CreatedAt    time.Time  `json:"created_at,omitempty"`
+	Group        *Group     `json:"group,omitempty"`
+	GroupID      *int64     `json:"group_id,omitempty"`
+	ID           int64      `json:"id,omitempty"`
+	Notes        *string    `json:"notes,omitempty"`
+	Status       string     `json:"status,omitempty"`
+	Type         string     `json:"type,omitempty"`
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+	UsedBy       *int64     `json:"used_by,omitempty"`
+	User         *DtoUser   `json:"user,omitempty"`
+	ValidityDays int64      `json:"validity_days,omitempty"`
+	Value        float64    `json:"value,omitempty"`
+}
+
+type RedeemRequest struct {
+	Code string `json:"code"`
+}
+
+type Redemption struct {
+	ID     int64  `json:"id,omitempty"`
+	Key    string `json:"key,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Quota  int64  `json:"quota,omitempty"`
+	Status int64  `json:"status,omitempty"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshTokenResponse struct {
+	AccessToken  string `json:"access_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+}
+
+type RegisterRequest struct {
+	Email          string `json:"email"`
+	InvitationCode string `json:"invitation_code,omitempty"`
+	Password       string `json:"password"`
+	PromoCode      string `json:"promo_code,omitempty"`
+	TurnstileToken string `json:"turnstile_token,omitempty"`
+	VerifyCode     string `json:"verify_code,omitempty"`
+}
+
+type ReleaseInfo struct {
+	Assets      []Asset `json:"assets,omitempty"`
+	Body        string  `json:"body,omitempty"`
+	HTMLURL     string  `json:"html_url,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	PublishedAt string  `json:"published_at,omitempty"`
+}
+
+type ReorderRequest struct {
+	IDs []int64 `json:"ids,omitempty"`
+}
+
+type RequestPolicy struct {
+	Action        string    `json:"action,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	DryRun        bool      `json:"dry_run,omitempty"`
+	Enabled       bool      `json:"enabled,omitempty"`
+	GroupIDs      []int64   `json:"group_ids,omitempty"`
+	ID            int64     `json:"id,omitempty"`
+	Kind          string    `json:"kind,omitempty"`
+	LimitValue    *int64    `json:"limit_value,omitempty"`
+	Name          string    `json:"name,omitempty"`
+	Params        []string  `json:"params,omitempty"`
+	Priority      int64     `json:"priority,omitempty"`
+	Protocols     []string  `json:"protocols,omitempty"`
+	RejectMessage *string   `json:"reject_message,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+type ResetPasswordRequest struct {
+	Email       string `json:"email"`
+	NewPassword string `json:"new_password"`
+	Token       string `json:"token"`
+}
+
+type ResetPasswordResponse struct {
+	Message string `json:"message,omitempty"`
+}
+
+type RestoreResult struct {
+	Manifest        Manifest            `json:"manifest,omitempty"`
+	RestartRequired bool                `json:"restart_required,omitempty"`
+	Tables          []BackupTableResult `json:"tables,omitempty"`
+}
+
+type RevokeAllSessionsResponse struct {
+	Message string `json:"message,omitempty"`
+}
+
+type RoutingRule struct {
+	CreatedAt        time.Time `json:"created_at,omitempty"`
+	Description      *string   `json:"description,omitempty"`
+	Enabled          bool      `json:"enabled,omitempty"`
+	GroupIDs         []int64   `json:"group_ids,omitempty"`
+	ID               int64     `json:"id,omitempty"`
+	InputTokensBelow *int64    `json:"input_tokens_below,omitempty"`
+	MaxTokensBelow   *int64    `json:"max_tokens_below,omitempty"`
+	ModelPatterns    []string  `json:"model_patterns,omitempty"`
+	Name             string    `json:"name,omitempty"`
+	Priority         int64     `json:"priority,omitempty"`
+	RequireNoTools   bool      `json:"require_no_tools,omitempty"`
+	TargetAccountIDs []int64   `json:"target_account_ids,omitempty"`
+	TargetGroupID    *int64    `json:"target_group_id,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+}
+
+type SendTestEmailRequest struct {
+	Email         string `json:"email"`
+	SMTPFromEmail string `json:"smtp_from_email,omitempty"`
+	SMTPFromName  string `json:"smtp_from_name,omitempty"`
+	SMTPHost      string `json:"smtp_host"`
+	SMTPPassword  string `json:"smtp_password,omitempty"`
+	SMTPPort      int64  `json:"smtp_port,omitempty"`
+	SMTPUseTLS    bool   `json:"smtp_use_tls,omitempty"`
+	SMTPUsername  string `json:"smtp_username,omitempty"`
+}
+
+type SendVerifyCodeRequest struct {
+	Email          string `json:"email"`
+	TurnstileToken string `json:"turnstile_token,omitempty"`
+}
+
+type SendVerifyCodeResponse struct {
+	Countdown int64  `json:"countdown,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+type ServiceUsageStats struct {
+	AverageDurationMs float64 `json:"average_duration_ms,omitempty"`
+	TotalActualCost   float64 `json:"total_actual_cost,omitempty"`
+	TotalCacheTokens  int64   `json:"total_cache_tokens,omitempty"`
+	TotalCost         float64 `json:"total_cost,omitempty"`
+	TotalInputTokens  int64   `json:"total_input_tokens,omitempty"`
+	TotalOutputTokens int64   `json:"total_output_tokens,omitempty"`
+	TotalRequests     int64   `json:"total_requests,omitempty"`
+	TotalTokens       int64   `json:"total_tokens,omitempty"`
+}
+
+type SetSchedulableRequest struct {
+	Schedulable bool `json:"schedulable,omitempty"`
+}
+
+type SimpleAPIKey struct {
+	ID     int64  `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	UserID int64  `json:"user_id,omitempty"`
+}
+
+type SimpleUser struct {
+	Email string `json:"email,omitempty"`
+	ID    int64  `json:"id,omitempty"`
+}
+
+type StreamTimeoutSettings struct {
+	Action                 string `json:"action,omitempty"`
+	Enabled                bool   `json:"enabled,omitempty"`
+	TempUnschedMinutes     int64  `json:"temp_unsched_minutes,omitempty"`
+	ThresholdCount         int64  `json:"threshold_count,omitempty"`
+	ThresholdWindowMinutes int64  `json:"threshold_window_minutes,omitempty"`
+}
+
+type SubscriptionGetSummaryResponse struct {
+	ActiveCount   int64                     `json:"active_count,omitempty"`
+	Subscriptions []SubscriptionSummaryItem `json:"subscriptions,omitempty"`
+	TotalUsedUsd  float64                   `json:"total_used_usd,omitempty"`
+}
+
+type SubscriptionProgress struct {
+	Daily         *UsageWindowProgress `json:"daily,omitempty"`
+	ExpiresAt     time.Time            `json:"expires_at,omitempty"`
+	ExpiresInDays int64                `json:"expires_in_days,omitempty"`
+	GroupName     string               `json:"group_name,omitempty"`
+	ID            int64                `json:"id,omitempty"`
+	Monthly       *UsageWindowProgress `json:"monthly,omitempty"`
+	Weekly        *UsageWindowProgress `json:"weekly,omitempty"`
+}
+
+type SubscriptionProgressInfo struct {
+	Progress     *SubscriptionProgress `json:"progress,omitempty"`
+	Subscription *UserSubscription     `json:"subscription,omitempty"`
+}
+
+type SubscriptionSummaryItem struct {
+	DailyLimitUsd   float64 `json:"daily_limit_usd,omitempty"`
+	DailyUsedUsd    float64 `json:"daily_used_usd,omitempty"`
+	ExpiresAt       *string `json:"expires_at,omitempty"`
+	GroupID         int64   `json:"group_id,omitempty"`
+	GroupName       string  `json:"group_name,omitempty"`
+	ID              int64   `json:"id,omitempty"`
+	MonthlyLimitUsd float64 `json:"monthly_limit_usd,omitempty"`
+	MonthlyUsedUsd  float64 `json:"monthly_used_usd,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	WeeklyLimitUsd  float64 `json:"weekly_limit_usd,omitempty"`
+	WeeklyUsedUsd   float64 `json:"weekly_used_usd,omitempty"`
+}
+
+type SyncFromCRSItemResult struct {
+	Action       string `json:"action,omitempty"`
+	CRSAccountID string `json:"crs_account_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+type SyncFromCRSRequest struct {
+	BaseURL            string   `json:"base_url"`
+	Password           string   `json:"password"`
+	SelectedAccountIDs []string `json:"selected_account_ids,omitempty"`
+	SyncProxies        *bool    `json:"sync_proxies,omitempty"`
+	Username           string   `json:"username"`
+}
+
+type SyncFromCRSResult struct {
+	Created int64                   `json:"created,omitempty"`
+	Failed  int64                   `json:"failed,omitempty"`
+	Items   []SyncFromCRSItemResult `json:"items,omitempty"`
+	Skipped int64                   `json:"skipped,omitempty"`
+	Updated int64                   `json:"updated,omitempty"`
+}
+
+type SystemSettings struct {
+	APIBaseURL                           string  `json:"api_base_url,omitempty"`
+	ContactInfo                          string  `json:"contact_info,omitempty"`
+	DefaultBalance                       float64 `json:"default_balance,omitempty"`
+	DefaultConcurrency                   int64   `json:"default_concurrency,omitempty"`
+	DocURL                               string  `json:"doc_url,omitempty"`
+	EmailVerifyEnabled                   bool    `json:"email_verify_enabled,omitempty"`
+	EnableIdentityPatch                  bool    `json:"enable_identity_patch,omitempty"`
+	EnableModelFallback                  bool    `json:"enable_model_fallback,omitempty"`
+	FallbackModelAnthropic               string  `json:"fallback_model_anthropic,omitempty"`
+	FallbackModelAntigravity             string  `json:"fallback_model_antigravity,omitempty"`
+	FallbackModelGemini                  string  `json:"fallback_model_gemini,omitempty"`
+	FallbackModelOpenai                  string  `json:"fallback_model_openai,omitempty"`
+	HideCcsImportButton                  bool    `json:"hide_ccs_import_button,omitempty"`
+	HomeContent                          string  `json:"home_content,omitempty"`
+	IdentityPatchPrompt                  string  `json:"identity_patch_prompt,omitempty"`
+	InvitationCodeEnabled                bool    `json:"invitation_code_enabled,omitempty"`
+	LinuxdoConnectClientID               string  `json:"linuxdo_connect_client_id,omitempty"`
+	LinuxdoConnectClientSecretConfigured bool    `json:"linuxdo_connect_client_secret_configured,omitempty"`
+	LinuxdoConnectEnabled                bool    `json:"linuxdo_connect_enabled,omitempty"`
+	LinuxdoConnectRedirectURL            string  `json:"linuxdo_connect_redirect_url,omitempty"`
+	OpsMetricsIntervalSeconds            int64   `json:"ops_metrics_interval_seconds,omitempty"`
+	OpsMonitoringEnabled                 bool    `json:"ops_monitoring_enabled,omitempty"`
+	OpsQueryModeDefault                  string  `json:"ops_query_mode_default,omitempty"`
+	OpsRealtimeMonitoringEnabled         bool    `json:"ops_realtime_monitoring_enabled,omitempty"`
+	PasswordResetEnabled                 bool    `json:"password_reset_enabled,omitempty"`
+	PromoCodeEnabled                     bool    `json:"promo_code_enabled,omitempty"`
+	PurchaseSubscriptionEnabled          bool    `json:"purchase_subscription_enabled,omitempty"`
+	PurchaseSubscriptionURL              string  `json:"purchase_subscription_url,omitempty"`
+	RegistrationEnabled                  bool    `json:"registration_enabled,omitempty"`
+	SiteLogo                             string  `json:"site_logo,omitempty"`
+	SiteName                             string  `json:"site_name,omitempty"`
+	SiteSubtitle                         string  `json:"site_subtitle,omitempty"`
+	SMTPFromEmail                        string  `json:"smtp_from_email,omitempty"`
+	SMTPFromName                         string  `json:"smtp_from_name,omitempty"`
+	SMTPHost                             string  `json:"smtp_host,omitempty"`
+	SMTPPasswordConfigured               bool    `json:"smtp_password_configured,omitempty"`
+	SMTPPort                             int64   `json:"smtp_port,omitempty"`
+	SMTPUseTLS                           bool    `json:"smtp_use_tls,omitempty"`
+	SMTPUsername                         string  `json:"smtp_username,omitempty"`
+	TotpEnabled                          bool    `json:"totp_enabled,omitempty"`
+	TotpEncryptionKeyConfigured          bool    `json:"totp_encryption_key_configured,omitempty"`
+	TurnstileEnabled                     bool    `json:"turnstile_enabled,omitempty"`
+	TurnstileSecretKeyConfigured         bool    `json:"turnstile_secret_key_configured,omitempty"`
+	TurnstileSiteKey                     string  `json:"turnstile_site_key,omitempty"`
+}
+
+type TempUnschedState struct {
+	ErrorMessage    string `json:"error_message,omitempty"`
+	MatchedKeyword  string `json:"matched_keyword,omitempty"`
+	RuleIndex       int64  `json:"rule_index,omitempty"`
+	StatusCode      int64  `json:"status_code,omitempty"`
+	TriggeredAtUnix int64  `json:"triggered_at_unix,omitempty"`
+	UntilUnix       int64  `json:"until_unix,omitempty"`
+}
+
+type TestAccountRequest struct {
+	ModelID string `json:"model_id,omitempty"`
+}
+
+type TestSMTPRequest struct {
+	SMTPHost     string `json:"smtp_host"`
+	SMTPPassword string `json:"smtp_password,omitempty"`
+	SMTPPort     int64  `json:"smtp_port,omitempty"`
+	SMTPUseTLS   bool   `json:"smtp_use_tls,omitempty"`
+	SMTPUsername string `json:"smtp_username,omitempty"`
+}
+
+type Token struct {
+	DeletedAt      *string `json:"deleted_at,omitempty"`
+	ExpiredTime    int64   `json:"expired_time,omitempty"`
+	Group          string  `json:"group,omitempty"`
+	ID             int64   `json:"id,omitempty"`
+	Key            string  `json:"key,omitempty"`
+	Name           string  `json:"name,omitempty"`
+	RemainQuota    int64   `json:"remain_quota,omitempty"`
+	Status         int64   `json:"status,omitempty"`
+	UnlimitedQuota bool    `json:"unlimited_quota,omitempty"`
+	UsedQuota      int64   `json:"used_quota,omitempty"`
+	UserID         int64   `json:"user_id,omitempty"`
+}
+
+type TokenInfo struct {
+	AccessToken  string `json:"access_token,omitempty"`
+	AccountUUID  string `json:"account_uuid,omitempty"`
+	EmailAddress string `json:"email_address,omitempty"`
+	ExpiresAt    int64  `json:"expires_at,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+	OrgUUID      string `json:"org_uuid,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+}
+
+type TotpDisableRequest struct {
+	EmailCode string `json:"email_code,omitempty"`
+	Password  string `json:"password,omitempty"`
+}
+
+type TotpDisableResponse struct {
+	Success bool `json:"success"`
+}
+
+type TotpEnableRequest struct {
+	SetupToken string `json:"setup_token"`
+	TotpCode   string `json:"totp_code"`
+}
+
+type TotpEnableResponse struct {
+	Success bool `json:"success"`
+}
+
+type TotpLoginResponse struct {
+	Requires2fa     bool   `json:"requires_2fa,omitempty"`
+	TempToken       string `json:"temp_token,omitempty"`
+	UserEmailMasked string `json:"user_email_masked,omitempty"`
+}
+
+type TotpSendVerifyCodeResponse struct {
+	Success bool `json:"success"`
+}
+
+type TotpSetupRequest struct {
+	EmailCode string `json:"email_code,omitempty"`
+	Password  string `json:"password,omitempty"`
+}
+
+type TotpSetupResponse struct {
+	Countdown  int64  `json:"countdown,omitempty"`
+	QrCodeURL  string `json:"qr_code_url,omitempty"`
+	Secret     string `json:"secret,omitempty"`
+	SetupToken string `json:"setup_token,omitempty"`
+}
+
+type TotpStatusResponse struct {
+	Enabled        bool   `json:"enabled,omitempty"`
+	EnabledAt      *int64 `json:"enabled_at,omitempty"`
+	FeatureEnabled bool   `json:"feature_enabled,omitempty"`
+}
+
+type TrendDataPoint struct {
+	ActualCost   float64 `json:"actual_cost,omitempty"`
+	CacheTokens  int64   `json:"cache_tokens,omitempty"`
+	Cost         float64 `json:"cost,omitempty"`
+	Date         string  `json:"date,omitempty"`
+	InputTokens  int64   `json:"input_tokens,omitempty"`
+	OutputTokens int64   `json:"output_tokens,omitempty"`
+	Requests     int64   `json:"requests,omitempty"`
+	TotalTokens  int64   `json:"total_tokens,omitempty"`
+}
+
+type UnifiedModel struct {
+	DisplayName string `json:"display_name,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type UpdateAPIKeyRequest struct {
+	ExpiresAt   *string  `json:"expires_at,omitempty"`
+	GroupID     *int64   `json:"group_id,omitempty"`
+	IPBlacklist []string `json:"ip_blacklist,omitempty"`
+	IPWhitelist []string `json:"ip_whitelist,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Quota       *float64 `json:"quota,omitempty"`
+	ResetQuota  *bool    `json:"reset_quota,omitempty"`
+	Status      string   `json:"status,omitempty"`
+}
+
+type UpdateAccountRequest struct {
+	AutoPauseOnExpired      *bool                      `json:"auto_pause_on_expired,omitempty"`
+	Concurrency             *int64                     `json:"concurrency,omitempty"`
+	ConfirmMixedChannelRisk *bool                      `json:"confirm_mixed_channel_risk,omitempty"`
+	Credentials             map[string]json.RawMessage `json:"credentials,omitempty"`
+	ExpiresAt               *int64                     `json:"expires_at,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"extra,omitempty"`
+	GroupIDs                []int64                    `json:"group_ids,omitempty"`
+	Name                    string                     `json:"name,omitempty"`
+	Notes                   *string                    `json:"notes,omitempty"`
+	Priority                *int64                     `json:"priority,omitempty"`
+	ProxyID                 *int64                     `json:"proxy_id,omitempty"`
+	RateMultiplier          *float64                   `json:"rate_multiplier,omitempty"`
+	Status                  string                     `json:"status,omitempty"`
+	Type                    string                     `json:"type,omitempty"`
+}
+
+type UpdateAnnouncementRequest struct {
+	Content   *string                `json:"content,omitempty"`
+	EndsAt    *int64                 `json:"ends_at,omitempty"`
+	StartsAt  *int64                 `json:"starts_at,omitempty"`
+	Status    *string                `json:"status,omitempty"`
+	Targeting *AnnouncementTargeting `json:"targeting,omitempty"`
+	Title     *string                `json:"title,omitempty"`
+}
+
+type UpdateAttributeDefinitionRequest struct {
+	Description *string                  `json:"description,omitempty"`
+	Enabled     *bool                    `json:"enabled,omitempty"`
+	Name        *string                  `json:"name,omitempty"`
+	Options     []UserAttributeOption    `json:"options,omitempty"`
+	Placeholder *string                  `json:"placeholder,omitempty"`
+	Required    *bool                    `json:"required,omitempty"`
+	Type        *string                  `json:"type,omitempty"`
+	Validation  *UserAttributeValidation `json:"validation,omitempty"`
+}
+
+type UpdateBalanceRequest struct {
+	Balance   float64 `json:"balance"`
+	Notes     string  `json:"notes,omitempty"`
+	Operation string  `json:"operation"`
+}
+
+type UpdateClientRuleRequest struct {
+	BodyFields            []string `json:"body_fields,omitempty"`
+	Description           *string  `json:"description,omitempty"`
+	Enabled               *bool    `json:"enabled,omitempty"`
+	FallbackGroupID       *int64   `json:"fallback_group_id,omitempty"`
+	GroupIDs              []int64  `json:"group_ids,omitempty"`
+	MetadataUserIDPattern *string  `json:"metadata_user_id_pattern,omitempty"`
+	Name                  *string  `json:"name,omitempty"`
+	Preset                *string  `json:"preset,omitempty"`
+	Priority              *int64   `json:"priority,omitempty"`
+	RequiredHeaders       []string `json:"required_headers,omitempty"`
+	UserAgentPattern      *string  `json:"user_agent_pattern,omitempty"`
+}
+
+type UpdateErrorPassthroughRuleRequest struct {
+	CustomMessage   *string  `json:"custom_message,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+	ErrorCodes      []int64  `json:"error_codes,omitempty"`
+	Keywords        []string `json:"keywords,omitempty"`
+	MatchMode       *string  `json:"match_mode,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	PassthroughBody *bool    `json:"passthrough_body,omitempty"`
+	PassthroughCode *bool    `json:"passthrough_code,omitempty"`
+	Platforms       []string `json:"platforms,omitempty"`
+	Priority        *int64   `json:"priority,omitempty"`
+	ResponseCode    *int64   `json:"response_code,omitempty"`
+	SkipMonitoring  *bool    `json:"skip_monitoring,omitempty"`
+}
+
+type UpdateGroupRequest struct {
+	ClaudeCodeOnly                  *bool               `json:"claude_code_only,omitempty"`
+	CopyAccountsFromGroupIDs        []int64             `json:"copy_accounts_from_group_ids,omitempty"`
+	DailyLimitUsd                   *float64            `json:"daily_limit_usd,omitempty"`
+	Description                     string              `json:"description,omitempty"`
+	FallbackGroupID                 *int64              `json:"fallback_group_id,omitempty"`
+	FallbackGroupIDOnInvalidRequest *int64              `json:"fallback_group_id_on_invalid_request,omitempty"`
+	FirstByteTimeoutSeconds         *int64              `json:"first_byte_timeout_seconds,omitempty"`
+	ImagePrice1k                    *float64            `json:"image_price_1k,omitempty"`
+	ImagePrice2k                    *float64            `json:"image_price_2k,omitempty"`
+	ImagePrice4k                    *float64            `json:"image_price_4k,omitempty"`
+	IsExclusive                     *bool               `json:"is_exclusive,omitempty"`
+	McpXmlInject                    *bool               `json:"mcp_xml_inject,omitempty"`
+	ModelFallbacks                  []ModelFallbackRule `json:"model_fallbacks,omitempty"`
+	ModelRateMultipliers            map[string]float64  `json:"model_rate_multipliers,omitempty"`
+	ModelRouting                    map[string][]int64  `json:"model_routing,omitempty"`
+	ModelRoutingEnabled             *bool               `json:"model_routing_enabled,omitempty"`
+	MonthlyLimitUsd                 *float64            `json:"monthly_limit_usd,omitempty"`
+	Name                            string              `json:"name,omitempty"`
+	Platform                        string              `json:"platform,omitempty"`
+	RateMultiplier                  *float64            `json:"rate_multiplier,omitempty"`
+	SchedulingStrategy              *string             `json:"scheduling_strategy,omitempty"`
+	Status                          string              `json:"status,omitempty"`
+	SubscriptionType                string              `json:"subscription_type,omitempty"`
+	SupportedModelScopes            []string            `json:"supported_model_scopes,omitempty"`
+	WeeklyLimitUsd                  *float64            `json:"weekly_limit_usd,omitempty"`
+}
+
+type UpdateGuardrailRuleRequest struct {
+	Action       *string  `json:"action,omitempty"`
+	BlockMessage *string  `json:"block_message,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	Enabled      *bool    `json:"enabled,omitempty"`
+	GroupIDs     []int64  `json:"group_ids,omitempty"`
+	MatchType    *string  `json:"match_type,omitempty"`
+	Name         *string  `json:"name,omitempty"`
+	Patterns     []string `json:"patterns,omitempty"`
+	Priority     *int64   `json:"priority,omitempty"`
+}
+
+type UpdateInfo struct {
+	BuildType      string       `json:"build_type,omitempty"`
+	Cached         bool         `json:"cached,omitempty"`
+	CurrentVersion string       `json:"current_version,omitempty"`
+	HasUpdate      bool         `json:"has_update,omitempty"`
+	LatestVersion  string       `json:"latest_version,omitempty"`
+	ReleaseInfo    *ReleaseInfo `json:"release_info,omitempty"`
+	Warning        string       `json:"warning,omitempty"`
+}
+
+type UpdateModelPriceRequest struct {
+	CacheReadPrice  *float64   `json:"cache_read_price,omitempty"`
+	CacheWritePrice *float64   `json:"cache_write_price,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	EffectiveFrom   *time.Time `json:"effective_from,omitempty"`
+	Enabled         *bool      `json:"enabled,omitempty"`
+	GroupID         *int64     `json:"group_id,omitempty"`
+	ImagePrice      *float64   `json:"image_price,omitempty"`
+	InputPrice      *float64   `json:"input_price,omitempty"`
+	Model           *string    `json:"model,omitempty"`
+	OutputPrice     *float64   `json:"output_price,omitempty"`
+	Platform        *string    `json:"platform,omitempty"`
+}
+
+type UpdateProfileRequest struct {
+	Username *string `json:"username,omitempty"`
+}
+
+type UpdatePromoCodeRequest struct {
+	BonusAmount *float64 `json:"bonus_amount,omitempty"`
+	Code        *string  `json:"code,omitempty"`
+	ExpiresAt   *int64   `json:"expires_at,omitempty"`
+	MaxUses     *int64   `json:"max_uses,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+	Status      *string  `json:"status,omitempty"`
+}
+
+type UpdateProxyRequest struct {
+	Host     string `json:"host,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Password string `json:"password,omitempty"`
+	Port     int64  `json:"port,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+type UpdateRequestPolicyRequest struct {
+	Action        *string  `json:"action,omitempty"`
+	Description   *string  `json:"description,omitempty"`
+	DryRun        *bool    `json:"dry_run,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+	GroupIDs      []int64  `json:"group_ids,omitempty"`
+	Kind          *string  `json:"kind,omitempty"`
+	LimitValue    *int64   `json:"limit_value,omitempty"`
+	Name          *string  `json:"name,omitempty"`
+	Params        []string `json:"params,omitempty"`
+	Priority      *int64   `json:"priority,omitempty"`
+	Protocols     []string `json:"protocols,omitempty"`
+	RejectMessage *string  `json:"reject_message,omitempty"`
+}
+
+type UpdateRoutingRuleRequest struct {
+	Description      *string  `json:"description,omitempty"`
+	Enabled          *bool    `json:"enabled,omitempty"`
+	GroupIDs         []int64  `json:"group_ids,omitempty"`
+	InputTokensBelow *int64   `json:"input_tokens_below,omitempty"`
+	MaxTokensBelow   *int64   `json:"max_tokens_below,omitempty"`
+	ModelPatterns    []string `json:"model_patterns,omitempty"`
+	Name             *string  `json:"name,omitempty"`
+	Priority         *int64   `json:"priority,omitempty"`
+	RequireNoTools   *bool    `json:"require_no_tools,omitempty"`
+	TargetAccountIDs []int64  `json:"target_account_ids,omitempty"`
+	TargetGroupID    *int64   `json:"target_group_id,omitempty"`
+}
+
+type UpdateSettingsRequest struct {
+	APIBaseURL                   string  `json:"api_base_url,omitempty"`
+	ContactInfo                  string  `json:"contact_info,omitempty"`
+	DefaultBalance               float64 `json:"default_balance,omitempty"`
+	DefaultConcurrency           int64   `json:"default_concurrency,omitempty"`
+	DocURL                       string  `json:"doc_url,omitempty"`
+	EmailVerifyEnabled           bool    `json:"email_verify_enabled,omitempty"`
+	EnableIdentityPatch          bool    `json:"enable_identity_patch,omitempty"`
+	EnableModelFallback          bool    `json:"enable_model_fallback,omitempty"`
+	FallbackModelAnthropic       string  `json:"fallback_model_anthropic,omitempty"`
+	FallbackModelAntigravity     string  `json:"fallback_model_antigravity,omitempty"`
+	FallbackModelGemini          string  `json:"fallback_model_gemini,omitempty"`
+	FallbackModelOpenai          string  `json:"fallback_model_openai,omitempty"`
+	HideCcsImportButton          bool    `json:"hide_ccs_import_button,omitempty"`
+	HomeContent                  string  `json:"home_content,omitempty"`
+	IdentityPatchPrompt          string  `json:"identity_patch_prompt,omitempty"`
+	InvitationCodeEnabled        bool    `json:"invitation_code_enabled,omitempty"`
+	LinuxdoConnectClientID       string  `json:"linuxdo_connect_client_id,omitempty"`
+	LinuxdoConnectClientSecret   string  `json:"linuxdo_connect_client_secret,omitempty"`
+	LinuxdoConnectEnabled        bool    `json:"linuxdo_connect_enabled,omitempty"`
+	LinuxdoConnectRedirectURL    string  `json:"linuxdo_connect_redirect_url,omitempty"`
+	OpsMetricsIntervalSeconds    *int64  `json:"ops_metrics_interval_seconds,omitempty"`
+	OpsMonitoringEnabled         *bool   `json:"ops_monitoring_enabled,omitempty"`
+	OpsQueryModeDefault          *string `json:"ops_query_mode_default,omitempty"`
+	OpsRealtimeMonitoringEnabled *bool   `json:"ops_realtime_monitoring_enabled,omitempty"`
+	PasswordResetEnabled         bool    `json:"password_reset_enabled,omitempty"`
+	PromoCodeEnabled             bool    `json:"promo_code_enabled,omitempty"`
+	PurchaseSubscriptionEnabled  *bool   `json:"purchase_subscription_enabled,omitempty"`
+	PurchaseSubscriptionURL      *string `json:"purchase_subscription_url,omitempty"`
+	RegistrationEnabled          bool    `json:"registration_enabled,omitempty"`
+	SiteLogo                     string  `json:"site_logo,omitempty"`
+	SiteName                     string  `json:"site_name,omitempty"`
+	SiteSubtitle                 string  `json:"site_subtitle,omitempty"`
+	SMTPFromEmail                string  `json:"smtp_from_email,omitempty"`
+	SMTPFromName                 string  `json:"smtp_from_name,omitempty"`
+	SMTPHost                     string  `json:"smtp_host,omitempty"`
+	SMTPPassword                 string  `json:"smtp_password,omitempty"`
+	SMTPPort                     int64   `json:"smtp_port,omitempty"`
+	SMTPUseTLS                   bool    `json:"smtp_use_tls,omitempty"`
+	SMTPUsername                 string  `json:"smtp_username,omitempty"`
+	TotpEnabled                  bool    `json:"totp_enabled,omitempty"`
+	TurnstileEnabled             bool    `json:"turnstile_enabled,omitempty"`
+	TurnstileSecretKey           string  `json:"turnstile_secret_key,omitempty"`
+	TurnstileSiteKey             string  `json:"turnstile_site_key,omitempty"`
+}
+
+type UpdateSortOrderRequest struct {
+	Updates []struct {
+		ID        int64 `json:"id"`
+		SortOrder int64 `json:"sort_order,omitempty"`
+	} `json:"updates,omitempty"`
+}
+
+type UpdateStreamTimeoutSettingsRequest struct {
+	Action                 string `json:"action,omitempty"`
+	Enabled                bool   `json:"enabled,omitempty"`
+	TempUnschedMinutes     int64  `json:"temp_unsched_minutes,omitempty"`
+	ThresholdCount         int64  `json:"threshold_count,omitempty"`
+	ThresholdWindowMinutes int64  `json:"threshold_window_minutes,omitempty"`
+}
+
+type UpdateUserAttributesRequest struct {
+	Values map[string]string `json:"values,omitempty"`
+}
+
+type UpdateUserRequest struct {
+	AllowedGroups []int64             `json:"allowed_groups,omitempty"`
+	Balance       *float64            `json:"balance,omitempty"`
+	Concurrency   *int64              `json:"concurrency,omitempty"`
+	Email         string              `json:"email,omitempty"`
+	GroupRates    map[string]*float64 `json:"group_rates,omitempty"`
+	Notes         *string             `json:"notes,omitempty"`
+	Password      string              `json:"password,omitempty"`
+	QueueWeight   *int64              `json:"queue_weight,omitempty"`
+	Status        string              `json:"status,omitempty"`
+	Username      *string             `json:"username,omitempty"`
+}
+
+type UsageCleanupFilters struct {
+	AccountID   *int64    `json:"account_id,omitempty"`
+	APIKeyID    *int64    `json:"api_key_id,omitempty"`
+	BillingType *int32    `json:"billing_type,omitempty"`
+	EndTime     time.Time `json:"end_time,omitempty"`
+	GroupID     *int64    `json:"group_id,omitempty"`
+	Model       *string   `json:"model,omitempty"`
+	StartTime   time.Time `json:"start_time,omitempty"`
+	Stream      *bool     `json:"stream,omitempty"`
+	UserID      *int64    `json:"user_id,omitempty"`
+}
+
+type UsageCleanupTask struct {
+	CanceledAt   *time.Time          `json:"canceled_at,omitempty"`
+	CanceledBy   *int64              `json:"canceled_by,omitempty"`
+	CreatedAt    time.Time           `json:"created_at,omitempty"`
+	CreatedBy    int64               `json:"created_by,omitempty"`
+	DeletedRows  int64               `json:"deleted_rows,omitempty"`
+	ErrorMessage *string             `json:"error_message,omitempty"`
+	Filters      UsageCleanupFilters `json:"filters,omitempty"`
+	FinishedAt   *time.Time          `json:"finished_at,omitempty"`
+	ID           int64               `json:"id,omitempty"`
+	StartedAt    *time.Time          `json:"started_at,omitempty"`
+	Status       string              `json:"status,omitempty"`
+	UpdatedAt    time.Time           `json:"updated_at,omitempty"`
+}
+
+type UsageDashboardAPIKeysUsageResponse struct {
+	Stats map[string]*BatchAPIKeyUsageStats `json:"stats,omitempty"`
+}
+
+type UsageDashboardModelsResponse struct {
+	EndDate   string      `json:"end_date"`
+	Models    []ModelStat `json:"models,omitempty"`
+	StartDate string      `json:"start_date"`
+}
+
+type UsageDashboardTrendResponse struct {
+	EndDate     string           `json:"end_date"`
+	Granularity string           `json:"granularity"`
+	StartDate   string           `json:"start_date"`
+	Trend       []TrendDataPoint `json:"trend,omitempty"`
+}
+
+type UsageInfo struct {
+	AntigravityQuota   map[string]*AntigravityModelQuota `json:"antigravity_quota,omitempty"`
+	FiveHour           *UsageProgress                    `json:"five_hour,omitempty"`
+	GeminiFlashDaily   *UsageProgress                    `json:"gemini_flash_daily,omitempty"`
+	GeminiFlashMinute  *UsageProgress                    `json:"gemini_flash_minute,omitempty"`
+	GeminiProDaily     *UsageProgress                    `json:"gemini_pro_daily,omitempty"`
+	GeminiProMinute    *UsageProgress                    `json:"gemini_pro_minute,omitempty"`
+	GeminiSharedDaily  *UsageProgress                    `json:"gemini_shared_daily,omitempty"`
+	GeminiSharedMinute *UsageProgress                    `json:"gemini_shared_minute,omitempty"`
+	SevenDay           *UsageProgress                    `json:"seven_day,omitempty"`
+	SevenDayOpus       *UsageProgress                    `json:"seven_day_opus,omitempty"`
+	SevenDaySonnet     *UsageProgress                    `json:"seven_day_sonnet,omitempty"`
+	UpdatedAt          *time.Time                        `json:"updated_at,omitempty"`
+}
+
+type UsageLog struct {
+	AccountID             int64             `json:"account_id,omitempty"`
+	ActualCost            float64           `json:"actual_cost,omitempty"`
+	APIKey                *APIKey           `json:"api_key,omitempty"`
+	APIKeyID              int64             `json:"api_key_id,omitempty"`
+	BillingType           int32             `json:"billing_type,omitempty"`
+	CacheCreation1hTokens int64             `json:"cache_creation_1h_tokens,omitempty"`
+	CacheCreation5mTokens int64             `json:"cache_creation_5m_tokens,omitempty"`
+	CacheCreationCost     float64           `json:"cache_creation_cost,omitempty"`
+	CacheCreationTokens   int64             `json:"cache_creation_tokens,omitempty"`
+	CacheReadCost         float64           `json:"cache_read_cost,omitempty"`
+	CacheReadTokens       int64             `json:"cache_read_tokens,omitempty"`
+	CreatedAt             time.Time         `json:"created_at,omitempty"`
+	DurationMs            *int64            `json:"duration_ms,omitempty"`
+	FirstTokenMs          *int64            `json:"first_token_ms,omitempty"`
+	Group                 *Group            `json:"group,omitempty"`
+	GroupID               *int64            `json:"group_id,omitempty"`
+	ID                    int64             `json:"id,omitempty"`
+	ImageCount            int64             `json:"image_count,omitempty"`
+	ImageSize             *string           `json:"image_size,omitempty"`
+	InputCost             float64           `json:"input_cost,omitempty"`
+	InputTokens           int64             `json:"input_tokens,omitempty"`
+	Model                 string            `json:"model,omitempty"`
+	OutputCost            float64           `json:"output_cost,omitempty"`
+	OutputTokens          int64             `json:"output_tokens,omitempty"`
+	RateMultiplier        float64           `json:"rate_multiplier,omitempty"`
+	ReasoningEffort       *string           `json:"reasoning_effort,omitempty"`
+	RequestID             string            `json:"request_id,omitempty"`
+	RequestedModel        *string           `json:"requested_model,omitempty"`
+	Stream                bool              `json:"stream,omitempty"`
+	Subscription          *UserSubscription `json:"subscription,omitempty"`
+	SubscriptionID        *int64            `json:"subscription_id,omitempty"`
+	TotalCost             float64           `json:"total_cost,omitempty"`
+	User                  *DtoUser          `json:"user,omitempty"`
+	UserAgent             *string           `json:"user_agent,omitempty"`
+	UserID                int64             `json:"user_id,omitempty"`
+}
+
+type UsageOutboxStats struct {
+	Applied               int64      `json:"applied,omitempty"`
+	Backlog               int64      `json:"backlog,omitempty"`
+	DeadLettered          int64      `json:"dead_lettered,omitempty"`
+	DeadLetters           int64      `json:"dead_letters,omitempty"`
+	Duplicates            int64      `json:"duplicates,omitempty"`
+	Enabled               bool       `json:"enabled,omitempty"`
+	Fallbacks             int64      `json:"fallbacks,omitempty"`
+	LastAppliedAt         *time.Time `json:"last_applied_at,omitempty"`
+	OldestEventAgeSeconds float64    `json:"oldest_event_age_seconds,omitempty"`
+	Pending               int64      `json:"pending,omitempty"`
+	Published             int64      `json:"published,omitempty"`
+	Retries               int64      `json:"retries,omitempty"`
+	Spooled               int64      `json:"spooled,omitempty"`
+}
+
+type UsageProgress struct {
+	LimitRequests    int64        `json:"limit_requests,omitempty"`
+	RemainingSeconds int64        `json:"remaining_seconds,omitempty"`
+	ResetsAt         *time.Time   `json:"resets_at,omitempty"`
+	UsedRequests     int64        `json:"used_requests,omitempty"`
+	Utilization      float64      `json:"utilization,omitempty"`
+	WindowStats      *WindowStats `json:"window_stats,omitempty"`
+}
+
+type UsageRange struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+type UsageWindowProgress struct {
+	LimitUsd        float64   `json:"limit_usd,omitempty"`
+	Percentage      float64   `json:"percentage,omitempty"`
+	RemainingUsd    float64   `json:"remaining_usd,omitempty"`
+	ResetsAt        time.Time `json:"resets_at,omitempty"`
+	ResetsInSeconds int64     `json:"resets_in_seconds,omitempty"`
+	UsedUsd         float64   `json:"used_usd,omitempty"`
+	WindowStart     time.Time `json:"window_start,omitempty"`
+}
+
+type UsagestatsUsageStats struct {
+	AverageDurationMs float64  `json:"average_duration_ms,omitempty"`
+	TotalAccountCost  *float64 `json:"total_account_cost,omitempty"`
+	TotalActualCost   float64  `json:"total_actual_cost,omitempty"`
+	TotalCacheTokens  int64    `json:"total_cache_tokens,omitempty"`
+	TotalCost         float64  `json:"total_cost,omitempty"`
+	TotalInputTokens  int64    `json:"total_input_tokens,omitempty"`
+	TotalOutputTokens int64    `json:"total_output_tokens,omitempty"`
+	TotalRequests     int64    `json:"total_requests,omitempty"`
+	TotalTokens       int64    `json:"total_tokens,omitempty"`
+}
+
+type UserAnnouncement struct {
+	Content   string     `json:"content,omitempty"`
+	CreatedAt time.Time  `json:"created_at,omitempty"`
+	EndsAt    *time.Time `json:"ends_at,omitempty"`
+	ID        int64      `json:"id,omitempty"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at,omitempty"`
+}
+
+type UserAttributeOption struct {
+	Label string `json:"label,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type UserAttributeValidation struct {
+	Max       *int64  `json:"max,omitempty"`
+	MaxLength *int64  `json:"max_length,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Min       *int64  `json:"min,omitempty"`
+	MinLength *int64  `json:"min_length,omitempty"`
+	Pattern   *string `json:"pattern,omitempty"`
+}
+
+type UserChangePasswordResponse struct {
+	Message string `json:"message"`
+}
+
+type UserConcurrencyInfo struct {
+	CurrentInUse   int64   `json:"current_in_use,omitempty"`
+	LoadPercentage float64 `json:"load_percentage,omitempty"`
+	MaxCapacity    int64   `json:"max_capacity,omitempty"`
+	UserEmail      string  `json:"user_email,omitempty"`
+	UserID         int64   `json:"user_id,omitempty"`
+	Username       string  `json:"username,omitempty"`
+	WaitingInQueue int64   `json:"waiting_in_queue,omitempty"`
+}
+
+type UserDashboardStats struct {
+	ActiveAPIKeys            int64   `json:"active_api_keys,omitempty"`
+	AverageDurationMs        float64 `json:"average_duration_ms,omitempty"`
+	RPM                      int64   `json:"rpm,omitempty"`
+	TodayActualCost          float64 `json:"today_actual_cost,omitempty"`
+	TodayCacheCreationTokens int64   `json:"today_cache_creation_tokens,omitempty"`
+	TodayCacheReadTokens     int64   `json:"today_cache_read_tokens,omitempty"`
+	TodayCost                float64 `json:"today_cost,omitempty"`
+	TodayInputTokens         int64   `json:"today_input_tokens,omitempty"`
+	TodayOutputTokens        int64   `json:"today_output_tokens,omitempty"`
+	TodayRequests            int64   `json:"today_requests,omitempty"`
+	TodayTokens              int64   `json:"today_tokens,omitempty"`
+	TotalActualCost          float64 `json:"total_actual_cost,omitempty"`
+	TotalAPIKeys             int64   `json:"total_api_keys,omitempty"`
+	TotalCacheCreationTokens int64   `json:"total_cache_creation_tokens,omitempty"`
+	TotalCacheReadTokens     int64   `json:"total_cache_read_tokens,omitempty"`
+	TotalCost                float64 `json:"total_cost,omitempty"`
+	TotalInputTokens         int64   `json:"total_input_tokens,omitempty"`
+	TotalOutputTokens        int64   `json:"total_output_tokens,omitempty"`
+	TotalRequests            int64   `json:"total_requests,omitempty"`
+	TotalTokens              int64   `json:"total_tokens,omitempty"`
+	TPM                      int64   `json:"tpm,omitempty"`
+}
+
+type UserResponse struct {
+	AllowedGroups []int64            `json:"allowed_groups,omitempty"`
+	APIKeys       []APIKey           `json:"api_keys,omitempty"`
+	Balance       float64            `json:"balance,omitempty"`
+	Concurrency   int64              `json:"concurrency,omitempty"`
+	CreatedAt     time.Time          `json:"created_at,omitempty"`
+	Email         string             `json:"email,omitempty"`
+	ID            int64              `json:"id,omitempty"`
+	Role          string             `json:"role,omitempty"`
+	RunMode       string             `json:"run_mode,omitempty"`
+	Status        string             `json:"status,omitempty"`
+	Subscriptions []UserSubscription `json:"subscriptions,omitempty"`
+	UpdatedAt     time.Time          `json:"updated_at,omitempty"`
+	Username      string             `json:"username,omitempty"`
+}
+
+type UserSubscription struct {
+	CreatedAt          time.Time  `json:"created_at,omitempty"`
+	DailyUsageUsd      float64    `json:"daily_usage_usd,omitempty"`
+	DailyWindowStart   *time.Time `json:"daily_window_start,omitempty"`
+	ExpiresAt          time.Time  `json:"expires_at,omitempty"`
+	Group              *Group     `json:"group,omitempty"`
+	GroupID            int64      `json:"group_id,omitempty"`
+	ID                 int64      `json:"id,omitempty"`
+	MonthlyUsageUsd    float64    `json:"monthly_usage_usd,omitempty"`
+	MonthlyWindowStart *time.Time `json:"monthly_window_start,omitempty"`
+	StartsAt           time.Time  `json:"starts_at,omitempty"`
+	Status             string     `json:"status,omitempty"`
+	UpdatedAt          time.Time  `json:"updated_at,omitempty"`
+	User               *DtoUser   `json:"user,omitempty"`
+	UserID             int64      `json:"user_id,omitempty"`
+	WeeklyUsageUsd     float64    `json:"weekly_usage_usd,omitempty"`
+	WeeklyWindowStart  *time.Time `json:"weekly_window_start,omitempty"`
+}
+
+type UserUsageTrendPoint struct {
+	ActualCost float64 `json:"actual_cost,omitempty"`
+	Cost       float64 `json:"cost,omitempty"`
+	Date       string  `json:"date,omitempty"`
+	Email      string  `json:"email,omitempty"`
+	Requests   int64   `json:"requests,omitempty"`
+	Tokens     int64   `json:"tokens,omitempty"`
+	UserID     int64   `json:"user_id,omitempty"`
+}
+
+type UserWithConcurrency struct {
+	AllowedGroups      []int64            `json:"allowed_groups,omitempty"`
+	APIKeys            []APIKey           `json:"api_keys,omitempty"`
+	Balance            float64            `json:"balance,omitempty"`
+	Concurrency        int64              `json:"concurrency,omitempty"`
+	CreatedAt          time.Time          `json:"created_at,omitempty"`
+	CurrentConcurrency int64              `json:"current_concurrency,omitempty"`
+	Email              string             `json:"email,omitempty"`
+	GroupRates         map[string]float64 `json:"group_rates,omitempty"`
+	ID                 int64              `json:"id,omitempty"`
+	Notes              string             `json:"notes,omitempty"`
+	QueueWeight        int64              `json:"queue_weight,omitempty"`
+	Role               string             `json:"role,omitempty"`
+	Status             string             `json:"status,omitempty"`
+	Subscriptions      []UserSubscription `json:"subscriptions,omitempty"`
+	UpdatedAt          time.Time          `json:"updated_at,omitempty"`
+	Username           string             `json:"username,omitempty"`
+}
+
+type ValidateInvitationCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type ValidateInvitationCodeResponse struct {
+	ErrorCode string `json:"error_code,omitempty"`
+	Valid     bool   `json:"valid,omitempty"`
+}
+
+type ValidatePromoCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type ValidatePromoCodeResponse struct {
+	BonusAmount float64 `json:"bonus_amount,omitempty"`
+	ErrorCode   string  `json:"error_code,omitempty"`
+	Message     string  `json:"message,omitempty"`
+	Valid       bool    `json:"valid,omitempty"`
+}
+
+type VerificationMethod struct {
+	Method string `json:"method,omitempty"`
+}
+
+type WindowStats struct {
+	Cost         float64 `json:"cost,omitempty"`
+	Requests     int64   `json:"requests,omitempty"`
+	StandardCost float64 `json:"standard_cost,omitempty"`
+	Tokens       int64   `json:"tokens,omitempty"`
+	UserCost     float64 `json:"user_cost,omitempty"`
+}
+
+// PostAPIEventLoggingBatch 对应 POST /api/event_logging/batch
+//
+// Claude Code 遥测日志（忽略，直接返回200）
+func (c *Client) PostAPIEventLoggingBatch(ctx context.Context) error {
+	req := &request{method: http.MethodPost, path: "/api/event_logging/batch"}
+	return c.call(ctx, req, false, nil)
+}
+
+// AdminAccountListParams AdminAccountList 的查询与请求头参数
+type AdminAccountListParams struct {
+	Page     *int64
+	PageSize *int64
+	Platform *string
+	Type     *string
+	Status   *string
+	Search   *string
+}
+
+// AdminAccountList 对应 GET /api/v1/admin/accounts
+//
+// Listing all accounts with pagination
+func (c *Client) AdminAccountList(ctx context.Context, params *AdminAccountListParams) (*Page[AccountWithConcurrency], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("type", params.Type)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+	}
+	var out *Page[AccountWithConcurrency]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountCreate 对应 POST /api/v1/admin/accounts
+//
+// Creating a new account
+func (c *Client) AdminAccountCreate(ctx context.Context, body *CreateAccountRequest) (*Account, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts", body: body}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountGetAntigravityDefaultModelMapping 对应 GET /api/v1/admin/accounts/antigravity/default-model-mapping
+//
+// 获取 Antigravity 平台的默认模型映射
+func (c *Client) AdminAccountGetAntigravityDefaultModelMapping(ctx context.Context) (map[string]string, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/antigravity/default-model-mapping"}
+	var out map[string]string
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountBatchCreate 对应 POST /api/v1/admin/accounts/batch
+//
+// Batch creating accounts
+func (c *Client) AdminAccountBatchCreate(ctx context.Context, body *AdminAccountBatchCreateRequest) (*AdminAccountBatchCreateResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/batch", body: body}
+	var out *AdminAccountBatchCreateResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountBatchRefreshTier 对应 POST /api/v1/admin/accounts/batch-refresh-tier
+//
+// Batch refreshing Google One tier
+func (c *Client) AdminAccountBatchRefreshTier(ctx context.Context, body *BatchRefreshTierRequest) (json.RawMessage, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/batch-refresh-tier", body: body}
+	var out json.RawMessage
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountBatchUpdateCredentials 对应 POST /api/v1/admin/accounts/batch-update-credentials
+//
+// Batch updating credentials fields
+func (c *Client) AdminAccountBatchUpdateCredentials(ctx context.Context, body *BatchUpdateCredentialsRequest) (*AdminAccountBatchUpdateCredentialsResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/batch-update-credentials", body: body}
+	var out *AdminAccountBatchUpdateCredentialsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountBulkResetStatus 对应 POST /api/v1/admin/accounts/bulk-reset-status
+//
+// Resetting runtime status for multiple accounts.
+func (c *Client) AdminAccountBulkResetStatus(ctx context.Context, body *BulkResetStatusRequest) (*AdminAccountBulkResetStatusResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/bulk-reset-status", body: body}
+	var out *AdminAccountBulkResetStatusResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountBulkUpdate 对应 POST /api/v1/admin/accounts/bulk-update
+//
+// Bulk updating accounts with selected fields/credentials.
+func (c *Client) AdminAccountBulkUpdate(ctx context.Context, body *BulkUpdateAccountsRequest) (*BulkUpdateAccountsResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/bulk-update", body: body}
+	var out *BulkUpdateAccountsResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOAuthCookieAuth 对应 POST /api/v1/admin/accounts/cookie-auth
+//
+// Performs OAuth using sessionKey (cookie-based auto-auth)
+func (c *Client) AdminOAuthCookieAuth(ctx context.Context, body *CookieAuthRequest) (*TokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/cookie-auth", body: body}
+	var out *TokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountExportDataParams AdminAccountExportData 的查询与请求头参数
+type AdminAccountExportDataParams struct {
+	IDs            []string
+	Platform       *string
+	Type           *string
+	Status         *string
+	Search         *string
+	IncludeProxies *string
+}
+
+// AdminAccountExportData 对应 GET /api/v1/admin/accounts/data
+func (c *Client) AdminAccountExportData(ctx context.Context, params *AdminAccountExportDataParams) (*DataPayload, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/data"}
+	if params != nil {
+		req.setQuery("ids", params.IDs)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("type", params.Type)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+		req.setQuery("include_proxies", params.IncludeProxies)
+	}
+	var out *DataPayload
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountImportData 对应 POST /api/v1/admin/accounts/data
+func (c *Client) AdminAccountImportData(ctx context.Context, body *DataImportRequest) (*DataImportResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/data", body: body}
+	var out *DataImportResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOAuthExchangeCode 对应 POST /api/v1/admin/accounts/exchange-code
+//
+// Exchanges authorization code for tokens
+func (c *Client) AdminOAuthExchangeCode(ctx context.Context, body *ExchangeCodeRequest) (*TokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/exchange-code", body: body}
+	var out *TokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOAuthExchangeSetupTokenCode 对应 POST /api/v1/admin/accounts/exchange-setup-token-code
+//
+// Exchanges authorization code for setup token
+func (c *Client) AdminOAuthExchangeSetupTokenCode(ctx context.Context, body *ExchangeCodeRequest) (*TokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/exchange-setup-token-code", body: body}
+	var out *TokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOAuthGenerateAuthURL 对应 POST /api/v1/admin/accounts/generate-auth-url
+//
+// Generates OAuth authorization URL with full scope
+func (c *Client) AdminOAuthGenerateAuthURL(ctx context.Context, body *GenerateAuthURLRequest) (*GenerateAuthURLResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/generate-auth-url", body: body}
+	var out *GenerateAuthURLResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOAuthGenerateSetupTokenURL 对应 POST /api/v1/admin/accounts/generate-setup-token-url
+//
+// Generates OAuth authorization URL for setup token (inference only)
+func (c *Client) AdminOAuthGenerateSetupTokenURL(ctx context.Context, body *GenerateAuthURLRequest) (*GenerateAuthURLResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/generate-setup-token-url", body: body}
+	var out *GenerateAuthURLResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOAuthSetupTokenCookieAuth 对应 POST /api/v1/admin/accounts/setup-token-cookie-auth
+//
+// Performs OAuth using sessionKey for setup token (inference only)
+func (c *Client) AdminOAuthSetupTokenCookieAuth(ctx context.Context, body *CookieAuthRequest) (*TokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/setup-token-cookie-auth", body: body}
+	var out *TokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountSyncFromCRS 对应 POST /api/v1/admin/accounts/sync/crs
+//
+// Syncing accounts from claude-relay-service (CRS)
+func (c *Client) AdminAccountSyncFromCRS(ctx context.Context, body *SyncFromCRSRequest) (*SyncFromCRSResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/sync/crs", body: body}
+	var out *SyncFromCRSResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountPreviewFromCRS 对应 POST /api/v1/admin/accounts/sync/crs/preview
+//
+// Previewing accounts from CRS before sync
+func (c *Client) AdminAccountPreviewFromCRS(ctx context.Context, body *PreviewFromCRSRequest) (*PreviewFromCRSResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/sync/crs/preview", body: body}
+	var out *PreviewFromCRSResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountDelete 对应 DELETE /api/v1/admin/accounts/{id}
+//
+// Deleting an account
+func (c *Client) AdminAccountDelete(ctx context.Context, id int64) (*AdminAccountDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/accounts/" + pathParam(id)}
+	var out *AdminAccountDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountGetByID 对应 GET /api/v1/admin/accounts/{id}
+//
+// Getting an account by ID
+func (c *Client) AdminAccountGetByID(ctx context.Context, id int64) (*Account, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/" + pathParam(id)}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountUpdate 对应 PUT /api/v1/admin/accounts/{id}
+//
+// Updating an account
+func (c *Client) AdminAccountUpdate(ctx context.Context, id int64, body *UpdateAccountRequest) (*Account, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/accounts/" + pathParam(id), body: body}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountClearError 对应 POST /api/v1/admin/accounts/{id}/clear-error
+//
+// Clearing account error
+func (c *Client) AdminAccountClearError(ctx context.Context, id int64) (*Account, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/" + pathParam(id) + "/clear-error"}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountClearRateLimit 对应 POST /api/v1/admin/accounts/{id}/clear-rate-limit
+//
+// Clearing account rate limit status
+func (c *Client) AdminAccountClearRateLimit(ctx context.Context, id int64) (*AdminAccountClearRateLimitResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/" + pathParam(id) + "/clear-rate-limit"}
+	var out *AdminAccountClearRateLimitResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountGetAvailableModels 对应 GET /api/v1/admin/accounts/{id}/models
+//
+// Getting available models for an account
+func (c *Client) AdminAccountGetAvailableModels(ctx context.Context, id int64) (json.RawMessage, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/" + pathParam(id) + "/models"}
+	var out json.RawMessage
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountRefresh 对应 POST /api/v1/admin/accounts/{id}/refresh
+//
+// Refreshing account credentials
+func (c *Client) AdminAccountRefresh(ctx context.Context, id int64) (json.RawMessage, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/" + pathParam(id) + "/refresh"}
+	var out json.RawMessage
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountRefreshTier 对应 POST /api/v1/admin/accounts/{id}/refresh-tier
+//
+// Refreshing Google One tier for a single account
+func (c *Client) AdminAccountRefreshTier(ctx context.Context, id int64) (*AdminAccountRefreshTierResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/" + pathParam(id) + "/refresh-tier"}
+	var out *AdminAccountRefreshTierResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountSetSchedulable 对应 POST /api/v1/admin/accounts/{id}/schedulable
+//
+// Toggling account schedulable status
+func (c *Client) AdminAccountSetSchedulable(ctx context.Context, id int64, body *SetSchedulableRequest) (*Account, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/" + pathParam(id) + "/schedulable", body: body}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountGetStatsParams AdminAccountGetStats 的查询与请求头参数
+type AdminAccountGetStatsParams struct {
+	Days *int64
+}
+
+// AdminAccountGetStats 对应 GET /api/v1/admin/accounts/{id}/stats
+//
+// Getting account statistics
+func (c *Client) AdminAccountGetStats(ctx context.Context, id int64, params *AdminAccountGetStatsParams) (*AccountUsageStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/" + pathParam(id) + "/stats"}
+	if params != nil {
+		req.setQuery("days", params.Days)
+	}
+	var out *AccountUsageStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountClearTempUnschedulable 对应 DELETE /api/v1/admin/accounts/{id}/temp-unschedulable
+//
+// Clearing temporary unschedulable status
+func (c *Client) AdminAccountClearTempUnschedulable(ctx context.Context, id int64) (*AdminAccountClearTempUnschedulableResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/accounts/" + pathParam(id) + "/temp-unschedulable"}
+	var out *AdminAccountClearTempUnschedulableResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountGetTempUnschedulable 对应 GET /api/v1/admin/accounts/{id}/temp-unschedulable
+//
+// Getting temporary unschedulable status
+func (c *Client) AdminAccountGetTempUnschedulable(ctx context.Context, id int64) (*AdminAccountGetTempUnschedulableResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/" + pathParam(id) + "/temp-unschedulable"}
+	var out *AdminAccountGetTempUnschedulableResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountTest 对应 POST /api/v1/admin/accounts/{id}/test
+//
+// Testing account connectivity with SSE streaming
+func (c *Client) AdminAccountTest(ctx context.Context, id int64, body *TestAccountRequest) (*http.Response, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/accounts/" + pathParam(id) + "/test", body: body}
+	return c.stream(ctx, req)
+}
+
+// AdminAccountGetTodayStats 对应 GET /api/v1/admin/accounts/{id}/today-stats
+//
+// Getting account today statistics
+func (c *Client) AdminAccountGetTodayStats(ctx context.Context, id int64) (*WindowStats, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/" + pathParam(id) + "/today-stats"}
+	var out *WindowStats
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAccountGetUsage 对应 GET /api/v1/admin/accounts/{id}/usage
+//
+// Getting account usage information
+func (c *Client) AdminAccountGetUsage(ctx context.Context, id int64) (*UsageInfo, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/accounts/" + pathParam(id) + "/usage"}
+	var out *UsageInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAnnouncementListParams AdminAnnouncementList 的查询与请求头参数
+type AdminAnnouncementListParams struct {
+	Page     *int64
+	PageSize *int64
+	Status   *string
+	Search   *string
+}
+
+// AdminAnnouncementList 对应 GET /api/v1/admin/announcements
+//
+// Listing announcements with filters
+func (c *Client) AdminAnnouncementList(ctx context.Context, params *AdminAnnouncementListParams) (*Page[Announcement], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/announcements"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+	}
+	var out *Page[Announcement]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAnnouncementCreate 对应 POST /api/v1/admin/announcements
+//
+// Creating a new announcement
+func (c *Client) AdminAnnouncementCreate(ctx context.Context, body *CreateAnnouncementRequest) (*Announcement, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/announcements", body: body}
+	var out *Announcement
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAnnouncementDelete 对应 DELETE /api/v1/admin/announcements/{id}
+//
+// Deleting an announcement
+func (c *Client) AdminAnnouncementDelete(ctx context.Context, id int64) (*AdminAnnouncementDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/announcements/" + pathParam(id)}
+	var out *AdminAnnouncementDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAnnouncementGetByID 对应 GET /api/v1/admin/announcements/{id}
+//
+// Getting an announcement by ID
+func (c *Client) AdminAnnouncementGetByID(ctx context.Context, id int64) (*Announcement, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/announcements/" + pathParam(id)}
+	var out *Announcement
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAnnouncementUpdate 对应 PUT /api/v1/admin/announcements/{id}
+//
+// Updating an announcement
+func (c *Client) AdminAnnouncementUpdate(ctx context.Context, id int64, body *UpdateAnnouncementRequest) (*Announcement, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/announcements/" + pathParam(id), body: body}
+	var out *Announcement
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAnnouncementListReadStatusParams AdminAnnouncementListReadStatus 的查询与请求头参数
+type AdminAnnouncementListReadStatusParams struct {
+	Page     *int64
+	PageSize *int64
+	Search   *string
+}
+
+// AdminAnnouncementListReadStatus 对应 GET /api/v1/admin/announcements/{id}/read-status
+//
+// Listing users read status for an announcement
+func (c *Client) AdminAnnouncementListReadStatus(ctx context.Context, id int64, params *AdminAnnouncementListReadStatusParams) (*Page[AnnouncementUserReadStatus], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/announcements/" + pathParam(id) + "/read-status"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("search", params.Search)
+	}
+	var out *Page[AnnouncementUserReadStatus]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAntigravityOAuthGenerateAuthURL 对应 POST /api/v1/admin/antigravity/oauth/auth-url
+//
+// Generates Google OAuth authorization URL
+func (c *Client) AdminAntigravityOAuthGenerateAuthURL(ctx context.Context, body *AntigravityGenerateAuthURLRequest) (*AntigravityAuthURLResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/antigravity/oauth/auth-url", body: body}
+	var out *AntigravityAuthURLResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAntigravityOAuthExchangeCode 对应 POST /api/v1/admin/antigravity/oauth/exchange-code
+//
+// 用 authorization code 交换 token
+func (c *Client) AdminAntigravityOAuthExchangeCode(ctx context.Context, body *AntigravityExchangeCodeRequest) (*AntigravityTokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/antigravity/oauth/exchange-code", body: body}
+	var out *AntigravityTokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminAntigravityOAuthRefreshToken 对应 POST /api/v1/admin/antigravity/oauth/refresh-token
+//
+// Validates an Antigravity refresh token and returns full token info
+func (c *Client) AdminAntigravityOAuthRefreshToken(ctx context.Context, body *AntigravityRefreshTokenRequest) (*AntigravityTokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/antigravity/oauth/refresh-token", body: body}
+	var out *AntigravityTokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClientRuleList 对应 GET /api/v1/admin/client-rules
+//
+// 获取所有规则
+func (c *Client) AdminClientRuleList(ctx context.Context) ([]*ClientRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/client-rules"}
+	var out []*ClientRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClientRuleCreate 对应 POST /api/v1/admin/client-rules
+//
+// 创建规则
+func (c *Client) AdminClientRuleCreate(ctx context.Context, body *CreateClientRuleRequest) (*ClientRule, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/client-rules", body: body}
+	var out *ClientRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClientRuleDelete 对应 DELETE /api/v1/admin/client-rules/{id}
+//
+// 删除规则
+func (c *Client) AdminClientRuleDelete(ctx context.Context, id int64) (*AdminClientRuleDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/client-rules/" + pathParam(id)}
+	var out *AdminClientRuleDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClientRuleGetByID 对应 GET /api/v1/admin/client-rules/{id}
+//
+// 根据 ID 获取规则
+func (c *Client) AdminClientRuleGetByID(ctx context.Context, id int64) (*ClientRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/client-rules/" + pathParam(id)}
+	var out *ClientRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClientRuleUpdate 对应 PUT /api/v1/admin/client-rules/{id}
+//
+// 更新规则（支持部分更新）
+func (c *Client) AdminClientRuleUpdate(ctx context.Context, id int64, body *UpdateClientRuleRequest) (*ClientRule, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/client-rules/" + pathParam(id), body: body}
+	var out *ClientRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClientRuleResetRejectedCount 对应 POST /api/v1/admin/client-rules/{id}/reset-counter
+//
+// 清零规则拒绝计数
+func (c *Client) AdminClientRuleResetRejectedCount(ctx context.Context, id int64) (*AdminClientRuleResetRejectedCountResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/client-rules/" + pathParam(id) + "/reset-counter"}
+	var out *AdminClientRuleResetRejectedCountResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminConfigSyncApplyParams AdminConfigSyncApply 的查询与请求头参数
+type AdminConfigSyncApplyParams struct {
+	Prune *bool
+}
+
+// AdminConfigSyncApply 对应 POST /api/v1/admin/config-sync/apply
+//
+// 在单个事务中执行变更计划
+func (c *Client) AdminConfigSyncApply(ctx context.Context, params *AdminConfigSyncApplyParams, body io.Reader) (*ConfigSyncPlan, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/config-sync/apply", raw: body, contentType: "application/octet-stream"}
+	if params != nil {
+		req.setQuery("prune", params.Prune)
+	}
+	var out *ConfigSyncPlan
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminConfigSyncExport 对应 GET /api/v1/admin/config-sync/export
+//
+// 导出当前配置（不含账号凭证、代理密码与凭证类设置）
+func (c *Client) AdminConfigSyncExport(ctx context.Context) (*ConfigSyncDocument, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/config-sync/export"}
+	var out *ConfigSyncDocument
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminConfigSyncPlanParams AdminConfigSyncPlan 的查询与请求头参数
+type AdminConfigSyncPlanParams struct {
+	Prune *bool
+}
+
+// AdminConfigSyncPlan 对应 POST /api/v1/admin/config-sync/plan
+//
+// 对比声明式配置与当前状态，返回变更计划（不修改数据）
+func (c *Client) AdminConfigSyncPlan(ctx context.Context, params *AdminConfigSyncPlanParams, body io.Reader) (*ConfigSyncPlan, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/config-sync/plan", raw: body, contentType: "application/octet-stream"}
+	if params != nil {
+		req.setQuery("prune", params.Prune)
+	}
+	var out *ConfigSyncPlan
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardBackfillAggregation 对应 POST /api/v1/admin/dashboard/aggregation/backfill
+//
+// Triggering aggregation backfill
+func (c *Client) AdminDashboardBackfillAggregation(ctx context.Context, body *DashboardAggregationBackfillRequest) (*AdminDashboardBackfillAggregationResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/dashboard/aggregation/backfill", body: body}
+	var out *AdminDashboardBackfillAggregationResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetAPIKeyUsageTrendParams AdminDashboardGetAPIKeyUsageTrend 的查询与请求头参数
+type AdminDashboardGetAPIKeyUsageTrendParams struct {
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+	Granularity *string
+	Limit       *int64
+}
+
+// AdminDashboardGetAPIKeyUsageTrend 对应 GET /api/v1/admin/dashboard/api-keys-trend
+//
+// Getting API key usage trend data
+func (c *Client) AdminDashboardGetAPIKeyUsageTrend(ctx context.Context, params *AdminDashboardGetAPIKeyUsageTrendParams) (*AdminDashboardGetAPIKeyUsageTrendResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/dashboard/api-keys-trend"}
+	if params != nil {
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("granularity", params.Granularity)
+		req.setQuery("limit", params.Limit)
+	}
+	var out *AdminDashboardGetAPIKeyUsageTrendResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetBatchAPIKeysUsage 对应 POST /api/v1/admin/dashboard/api-keys-usage
+//
+// Getting usage stats for multiple API keys
+func (c *Client) AdminDashboardGetBatchAPIKeysUsage(ctx context.Context, body *AdminBatchAPIKeysUsageRequest) (*AdminDashboardGetBatchAPIKeysUsageResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/dashboard/api-keys-usage", body: body}
+	var out *AdminDashboardGetBatchAPIKeysUsageResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetModelStatsParams AdminDashboardGetModelStats 的查询与请求头参数
+type AdminDashboardGetModelStatsParams struct {
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+	UserID      *int64
+	APIKeyID    *int64
+	AccountID   *int64
+	GroupID     *int64
+	Stream      *bool
+	BillingType *int64
+}
+
+// AdminDashboardGetModelStats 对应 GET /api/v1/admin/dashboard/models
+//
+// Getting model usage statistics
+func (c *Client) AdminDashboardGetModelStats(ctx context.Context, params *AdminDashboardGetModelStatsParams) (*AdminDashboardGetModelStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/dashboard/models"}
+	if params != nil {
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("stream", params.Stream)
+		req.setQuery("billing_type", params.BillingType)
+	}
+	var out *AdminDashboardGetModelStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetRealtimeMetrics 对应 GET /api/v1/admin/dashboard/realtime
+//
+// Getting real-time system metrics
+func (c *Client) AdminDashboardGetRealtimeMetrics(ctx context.Context) (*AdminDashboardGetRealtimeMetricsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/dashboard/realtime"}
+	var out *AdminDashboardGetRealtimeMetricsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetStats 对应 GET /api/v1/admin/dashboard/stats
+//
+// Getting dashboard statistics
+func (c *Client) AdminDashboardGetStats(ctx context.Context) (*AdminDashboardGetStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/dashboard/stats"}
+	var out *AdminDashboardGetStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetUsageTrendParams AdminDashboardGetUsageTrend 的查询与请求头参数
+type AdminDashboardGetUsageTrendParams struct {
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+	Granularity *string
+	UserID      *int64
+	APIKeyID    *int64
+	AccountID   *int64
+	GroupID     *int64
+	Model       *string
+	Stream      *bool
+	BillingType *int64
+}
+
+// AdminDashboardGetUsageTrend 对应 GET /api/v1/admin/dashboard/trend
+//
+// Getting usage trend data
+func (c *Client) AdminDashboardGetUsageTrend(ctx context.Context, params *AdminDashboardGetUsageTrendParams) (*AdminDashboardGetUsageTrendResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/dashboard/trend"}
+	if params != nil {
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("granularity", params.Granularity)
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("model", params.Model)
+		req.setQuery("stream", params.Stream)
+		req.setQuery("billing_type", params.BillingType)
+	}
+	var out *AdminDashboardGetUsageTrendResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetUserUsageTrendParams AdminDashboardGetUserUsageTrend 的查询与请求头参数
+type AdminDashboardGetUserUsageTrendParams struct {
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+	Granularity *string
+	Limit       *int64
+}
+
+// AdminDashboardGetUserUsageTrend 对应 GET /api/v1/admin/dashboard/users-trend
+//
+// Getting user usage trend data
+func (c *Client) AdminDashboardGetUserUsageTrend(ctx context.Context, params *AdminDashboardGetUserUsageTrendParams) (*AdminDashboardGetUserUsageTrendResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/dashboard/users-trend"}
+	if params != nil {
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("granularity", params.Granularity)
+		req.setQuery("limit", params.Limit)
+	}
+	var out *AdminDashboardGetUserUsageTrendResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminDashboardGetBatchUsersUsage 对应 POST /api/v1/admin/dashboard/users-usage
+//
+// Getting usage stats for multiple users
+func (c *Client) AdminDashboardGetBatchUsersUsage(ctx context.Context, body *BatchUsersUsageRequest) (*AdminDashboardGetBatchUsersUsageResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/dashboard/users-usage", body: body}
+	var out *AdminDashboardGetBatchUsersUsageResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminErrorPassthroughList 对应 GET /api/v1/admin/error-passthrough-rules
+//
+// 获取所有规则
+func (c *Client) AdminErrorPassthroughList(ctx context.Context) ([]*ErrorPassthroughRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/error-passthrough-rules"}
+	var out []*ErrorPassthroughRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminErrorPassthroughCreate 对应 POST /api/v1/admin/error-passthrough-rules
+//
+// 创建规则
+func (c *Client) AdminErrorPassthroughCreate(ctx context.Context, body *CreateErrorPassthroughRuleRequest) (*ErrorPassthroughRule, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/error-passthrough-rules", body: body}
+	var out *ErrorPassthroughRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminErrorPassthroughDelete 对应 DELETE /api/v1/admin/error-passthrough-rules/{id}
+//
+// 删除规则
+func (c *Client) AdminErrorPassthroughDelete(ctx context.Context, id int64) (*AdminErrorPassthroughDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/error-passthrough-rules/" + pathParam(id)}
+	var out *AdminErrorPassthroughDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminErrorPassthroughGetByID 对应 GET /api/v1/admin/error-passthrough-rules/{id}
+//
+// 根据 ID 获取规则
+func (c *Client) AdminErrorPassthroughGetByID(ctx context.Context, id int64) (*ErrorPassthroughRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/error-passthrough-rules/" + pathParam(id)}
+	var out *ErrorPassthroughRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminErrorPassthroughUpdate 对应 PUT /api/v1/admin/error-passthrough-rules/{id}
+//
+// 更新规则（支持部分更新）
+func (c *Client) AdminErrorPassthroughUpdate(ctx context.Context, id int64, body *UpdateErrorPassthroughRuleRequest) (*ErrorPassthroughRule, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/error-passthrough-rules/" + pathParam(id), body: body}
+	var out *ErrorPassthroughRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGeminiOAuthGenerateAuthURLParams AdminGeminiOAuthGenerateAuthURL 的查询与请求头参数
+type AdminGeminiOAuthGenerateAuthURLParams struct {
+	XForwardedProto *string
+	XForwardedHost  *string
+}
+
+// AdminGeminiOAuthGenerateAuthURL 对应 POST /api/v1/admin/gemini/oauth/auth-url
+//
+// Generates Google OAuth authorization URL for Gemini.
+func (c *Client) AdminGeminiOAuthGenerateAuthURL(ctx context.Context, params *AdminGeminiOAuthGenerateAuthURLParams, body *GeminiGenerateAuthURLRequest) (*GeminiAuthURLResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/gemini/oauth/auth-url", body: body}
+	if params != nil {
+		req.setHeader("X-Forwarded-Proto", params.XForwardedProto)
+		req.setHeader("X-Forwarded-Host", params.XForwardedHost)
+	}
+	var out *GeminiAuthURLResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGeminiOAuthGetCapabilities 对应 GET /api/v1/admin/gemini/oauth/capabilities
+//
+// Returns the Gemini OAuth configuration capabilities.
+func (c *Client) AdminGeminiOAuthGetCapabilities(ctx context.Context) (*GeminiOAuthCapabilities, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/gemini/oauth/capabilities"}
+	var out *GeminiOAuthCapabilities
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGeminiOAuthExchangeCode 对应 POST /api/v1/admin/gemini/oauth/exchange-code
+//
+// Exchanges authorization code for tokens.
+func (c *Client) AdminGeminiOAuthExchangeCode(ctx context.Context, body *GeminiExchangeCodeRequest) (*GeminiTokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/gemini/oauth/exchange-code", body: body}
+	var out *GeminiTokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupListParams AdminGroupList 的查询与请求头参数
+type AdminGroupListParams struct {
+	Page        *int64
+	PageSize    *int64
+	Platform    *string
+	Status      *string
+	Search      *string
+	IsExclusive *string
+}
+
+// AdminGroupList 对应 GET /api/v1/admin/groups
+//
+// Listing all groups with pagination
+func (c *Client) AdminGroupList(ctx context.Context, params *AdminGroupListParams) (*Page[AdminGroup], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/groups"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+		req.setQuery("is_exclusive", params.IsExclusive)
+	}
+	var out *Page[AdminGroup]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupCreate 对应 POST /api/v1/admin/groups
+//
+// Creating a new group
+func (c *Client) AdminGroupCreate(ctx context.Context, body *CreateGroupRequest) (*AdminGroup, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/groups", body: body}
+	var out *AdminGroup
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupGetAllParams AdminGroupGetAll 的查询与请求头参数
+type AdminGroupGetAllParams struct {
+	Platform *string
+}
+
+// AdminGroupGetAll 对应 GET /api/v1/admin/groups/all
+//
+// Getting all active groups without pagination
+func (c *Client) AdminGroupGetAll(ctx context.Context, params *AdminGroupGetAllParams) ([]AdminGroup, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/groups/all"}
+	if params != nil {
+		req.setQuery("platform", params.Platform)
+	}
+	var out []AdminGroup
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupUpdateSortOrder 对应 PUT /api/v1/admin/groups/sort-order
+//
+// Updating group sort orders
+func (c *Client) AdminGroupUpdateSortOrder(ctx context.Context, body *UpdateSortOrderRequest) (*AdminGroupUpdateSortOrderResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/groups/sort-order", body: body}
+	var out *AdminGroupUpdateSortOrderResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupDelete 对应 DELETE /api/v1/admin/groups/{id}
+//
+// Deleting a group
+func (c *Client) AdminGroupDelete(ctx context.Context, id int64) (*AdminGroupDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/groups/" + pathParam(id)}
+	var out *AdminGroupDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupGetByID 对应 GET /api/v1/admin/groups/{id}
+//
+// Getting a group by ID
+func (c *Client) AdminGroupGetByID(ctx context.Context, id int64) (*AdminGroup, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/groups/" + pathParam(id)}
+	var out *AdminGroup
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupUpdate 对应 PUT /api/v1/admin/groups/{id}
+//
+// Updating a group
+func (c *Client) AdminGroupUpdate(ctx context.Context, id int64, body *UpdateGroupRequest) (*AdminGroup, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/groups/" + pathParam(id), body: body}
+	var out *AdminGroup
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupGetGroupAPIKeysParams AdminGroupGetGroupAPIKeys 的查询与请求头参数
+type AdminGroupGetGroupAPIKeysParams struct {
+	Page     *int64
+	PageSize *int64
+}
+
+// AdminGroupGetGroupAPIKeys 对应 GET /api/v1/admin/groups/{id}/api-keys
+//
+// Getting API keys in a group
+func (c *Client) AdminGroupGetGroupAPIKeys(ctx context.Context, id int64, params *AdminGroupGetGroupAPIKeysParams) (*Page[APIKey], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/groups/" + pathParam(id) + "/api-keys"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+	}
+	var out *Page[APIKey]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGroupGetStats 对应 GET /api/v1/admin/groups/{id}/stats
+//
+// Getting group statistics
+func (c *Client) AdminGroupGetStats(ctx context.Context, id int64) (*AdminGroupGetStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/groups/" + pathParam(id) + "/stats"}
+	var out *AdminGroupGetStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionListByGroupParams AdminSubscriptionListByGroup 的查询与请求头参数
+type AdminSubscriptionListByGroupParams struct {
+	Page     *int64
+	PageSize *int64
+}
+
+// AdminSubscriptionListByGroup 对应 GET /api/v1/admin/groups/{id}/subscriptions
+//
+// Listing subscriptions for a specific group
+func (c *Client) AdminSubscriptionListByGroup(ctx context.Context, id int64, params *AdminSubscriptionListByGroupParams) (*Page[AdminUserSubscription], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/groups/" + pathParam(id) + "/subscriptions"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+	}
+	var out *Page[AdminUserSubscription]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGuardrailList 对应 GET /api/v1/admin/guardrail-rules
+//
+// 获取所有规则
+func (c *Client) AdminGuardrailList(ctx context.Context) ([]*GuardrailRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/guardrail-rules"}
+	var out []*GuardrailRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGuardrailCreate 对应 POST /api/v1/admin/guardrail-rules
+//
+// 创建规则
+func (c *Client) AdminGuardrailCreate(ctx context.Context, body *CreateGuardrailRuleRequest) (*GuardrailRule, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/guardrail-rules", body: body}
+	var out *GuardrailRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGuardrailDelete 对应 DELETE /api/v1/admin/guardrail-rules/{id}
+//
+// 删除规则
+func (c *Client) AdminGuardrailDelete(ctx context.Context, id int64) (*AdminGuardrailDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/guardrail-rules/" + pathParam(id)}
+	var out *AdminGuardrailDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGuardrailGetByID 对应 GET /api/v1/admin/guardrail-rules/{id}
+//
+// 根据 ID 获取规则
+func (c *Client) AdminGuardrailGetByID(ctx context.Context, id int64) (*GuardrailRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/guardrail-rules/" + pathParam(id)}
+	var out *GuardrailRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminGuardrailUpdate 对应 PUT /api/v1/admin/guardrail-rules/{id}
+//
+// 更新规则（支持部分更新）
+func (c *Client) AdminGuardrailUpdate(ctx context.Context, id int64, body *UpdateGuardrailRuleRequest) (*GuardrailRule, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/guardrail-rules/" + pathParam(id), body: body}
+	var out *GuardrailRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceList 对应 GET /api/v1/admin/model-prices
+//
+// 获取所有价格
+func (c *Client) AdminModelPriceList(ctx context.Context) ([]*ModelPrice, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/model-prices"}
+	var out []*ModelPrice
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceCreate 对应 POST /api/v1/admin/model-prices
+//
+// 创建价格
+func (c *Client) AdminModelPriceCreate(ctx context.Context, body *ModelPriceRequest) (*ModelPrice, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/model-prices", body: body}
+	var out *ModelPrice
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceExport 对应 GET /api/v1/admin/model-prices/export
+//
+// 导出全部价格（格式与导入请求的 items 一致）
+func (c *Client) AdminModelPriceExport(ctx context.Context) (*AdminModelPriceExportResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/model-prices/export"}
+	var out *AdminModelPriceExportResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceImport 对应 POST /api/v1/admin/model-prices/import
+//
+// 批量导入价格
+func (c *Client) AdminModelPriceImport(ctx context.Context, body *ImportModelPricesRequest) (*AdminModelPriceImportResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/model-prices/import", body: body}
+	var out *AdminModelPriceImportResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceDelete 对应 DELETE /api/v1/admin/model-prices/{id}
+//
+// 删除价格
+func (c *Client) AdminModelPriceDelete(ctx context.Context, id int64) (*AdminModelPriceDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/model-prices/" + pathParam(id)}
+	var out *AdminModelPriceDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceGetByID 对应 GET /api/v1/admin/model-prices/{id}
+//
+// 根据 ID 获取价格
+func (c *Client) AdminModelPriceGetByID(ctx context.Context, id int64) (*ModelPrice, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/model-prices/" + pathParam(id)}
+	var out *ModelPrice
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminModelPriceUpdate 对应 PUT /api/v1/admin/model-prices/{id}
+//
+// 更新价格（支持部分更新）
+func (c *Client) AdminModelPriceUpdate(ctx context.Context, id int64, body *UpdateModelPriceRequest) (*ModelPrice, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/model-prices/" + pathParam(id), body: body}
+	var out *ModelPrice
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpenAIOAuthRefreshAccountToken 对应 POST /api/v1/admin/openai/accounts/{id}/refresh
+//
+// Refreshes token for a specific OpenAI account
+func (c *Client) AdminOpenAIOAuthRefreshAccountToken(ctx context.Context, id int64) (*Account, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/openai/accounts/" + pathParam(id) + "/refresh"}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpenAIOAuthCreateAccountFromOAuth 对应 POST /api/v1/admin/openai/create-from-oauth
+//
+// Creates a new OpenAI OAuth account from token info
+func (c *Client) AdminOpenAIOAuthCreateAccountFromOAuth(ctx context.Context, body *AdminOpenAIOAuthCreateAccountFromOAuthRequest) (*Account, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/openai/create-from-oauth", body: body}
+	var out *Account
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpenAIOAuthExchangeCode 对应 POST /api/v1/admin/openai/exchange-code
+//
+// Exchanges OpenAI authorization code for tokens
+func (c *Client) AdminOpenAIOAuthExchangeCode(ctx context.Context, body *OpenAIExchangeCodeRequest) (*OpenAITokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/openai/exchange-code", body: body}
+	var out *OpenAITokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpenAIOAuthGenerateAuthURL 对应 POST /api/v1/admin/openai/generate-auth-url
+//
+// Generates OpenAI OAuth authorization URL
+func (c *Client) AdminOpenAIOAuthGenerateAuthURL(ctx context.Context, body *OpenAIGenerateAuthURLRequest) (*OpenAIAuthURLResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/openai/generate-auth-url", body: body}
+	var out *OpenAIAuthURLResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpenAIOAuthRefreshToken 对应 POST /api/v1/admin/openai/refresh-token
+//
+// Refreshes an OpenAI OAuth token
+func (c *Client) AdminOpenAIOAuthRefreshToken(ctx context.Context, body *OpenAIRefreshTokenRequest) (*OpenAITokenInfo, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/openai/refresh-token", body: body}
+	var out *OpenAITokenInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetAccountAvailabilityParams AdminOpsGetAccountAvailability 的查询与请求头参数
+type AdminOpsGetAccountAvailabilityParams struct {
+	Platform *string
+	GroupID  *int64
+}
+
+// AdminOpsGetAccountAvailability 对应 GET /api/v1/admin/ops/account-availability
+//
+// Returns account availability statistics.
+func (c *Client) AdminOpsGetAccountAvailability(ctx context.Context, params *AdminOpsGetAccountAvailabilityParams) (*AdminOpsGetAccountAvailabilityResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/account-availability"}
+	if params != nil {
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *AdminOpsGetAccountAvailabilityResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetAdvancedSettings 对应 GET /api/v1/admin/ops/advanced-settings
+//
+// Returns Ops advanced settings (DB-backed).
+func (c *Client) AdminOpsGetAdvancedSettings(ctx context.Context) (*OpsAdvancedSettings, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/advanced-settings"}
+	var out *OpsAdvancedSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateAdvancedSettings 对应 PUT /api/v1/admin/ops/advanced-settings
+//
+// Updates Ops advanced settings (DB-backed).
+func (c *Client) AdminOpsUpdateAdvancedSettings(ctx context.Context, body *OpsAdvancedSettings) (*OpsAdvancedSettings, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/advanced-settings", body: body}
+	var out *OpsAdvancedSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListAlertEventsParams AdminOpsListAlertEvents 的查询与请求头参数
+type AdminOpsListAlertEventsParams struct {
+	Limit         *int64
+	Status        *string
+	Severity      *string
+	EmailSent     *string
+	BeforeFiredAt *string
+	BeforeID      *int64
+	Platform      *string
+	GroupID       *int64
+	StartTime     *string
+	EndTime       *string
+	TimeRange     *string
+}
+
+// AdminOpsListAlertEvents 对应 GET /api/v1/admin/ops/alert-events
+func (c *Client) AdminOpsListAlertEvents(ctx context.Context, params *AdminOpsListAlertEventsParams) ([]*OpsAlertEvent, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/alert-events"}
+	if params != nil {
+		req.setQuery("limit", params.Limit)
+		req.setQuery("status", params.Status)
+		req.setQuery("severity", params.Severity)
+		req.setQuery("email_sent", params.EmailSent)
+		req.setQuery("before_fired_at", params.BeforeFiredAt)
+		req.setQuery("before_id", params.BeforeID)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+	}
+	var out []*OpsAlertEvent
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetAlertEvent 对应 GET /api/v1/admin/ops/alert-events/{id}
+//
+// Returns a single ops alert event.
+func (c *Client) AdminOpsGetAlertEvent(ctx context.Context, id int64) (*OpsAlertEvent, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/alert-events/" + pathParam(id)}
+	var out *OpsAlertEvent
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateAlertEventStatus 对应 PUT /api/v1/admin/ops/alert-events/{id}/status
+//
+// Updates an ops alert event status.
+func (c *Client) AdminOpsUpdateAlertEventStatus(ctx context.Context, id int64, body *AdminOpsUpdateAlertEventStatusRequest) (*AdminOpsUpdateAlertEventStatusResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/alert-events/" + pathParam(id) + "/status", body: body}
+	var out *AdminOpsUpdateAlertEventStatusResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListAlertRules 对应 GET /api/v1/admin/ops/alert-rules
+//
+// Returns all ops alert rules.
+func (c *Client) AdminOpsListAlertRules(ctx context.Context) ([]*OpsAlertRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/alert-rules"}
+	var out []*OpsAlertRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsCreateAlertRule 对应 POST /api/v1/admin/ops/alert-rules
+//
+// Creates an ops alert rule.
+func (c *Client) AdminOpsCreateAlertRule(ctx context.Context, body *OpsAlertRule) (*OpsAlertRule, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/ops/alert-rules", body: body}
+	var out *OpsAlertRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsDeleteAlertRule 对应 DELETE /api/v1/admin/ops/alert-rules/{id}
+//
+// Deletes an ops alert rule.
+func (c *Client) AdminOpsDeleteAlertRule(ctx context.Context, id int64) (*AdminOpsDeleteAlertRuleResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/ops/alert-rules/" + pathParam(id)}
+	var out *AdminOpsDeleteAlertRuleResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateAlertRule 对应 PUT /api/v1/admin/ops/alert-rules/{id}
+//
+// Updates an existing ops alert rule.
+func (c *Client) AdminOpsUpdateAlertRule(ctx context.Context, id int64, body *OpsAlertRule) (*OpsAlertRule, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/alert-rules/" + pathParam(id), body: body}
+	var out *OpsAlertRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsCreateAlertSilence 对应 POST /api/v1/admin/ops/alert-silences
+//
+// ListAlertEvents lists recent ops alert events.
+func (c *Client) AdminOpsCreateAlertSilence(ctx context.Context, body *AdminOpsCreateAlertSilenceRequest) (*OpsAlertSilence, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/ops/alert-silences", body: body}
+	var out *OpsAlertSilence
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetConcurrencyStatsParams AdminOpsGetConcurrencyStats 的查询与请求头参数
+type AdminOpsGetConcurrencyStatsParams struct {
+	Platform *string
+	GroupID  *int64
+}
+
+// AdminOpsGetConcurrencyStats 对应 GET /api/v1/admin/ops/concurrency
+//
+// Returns real-time concurrency usage aggregated by platform/group/account.
+func (c *Client) AdminOpsGetConcurrencyStats(ctx context.Context, params *AdminOpsGetConcurrencyStatsParams) (*AdminOpsGetConcurrencyStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/concurrency"}
+	if params != nil {
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *AdminOpsGetConcurrencyStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetDashboardErrorDistributionParams AdminOpsGetDashboardErrorDistribution 的查询与请求头参数
+type AdminOpsGetDashboardErrorDistributionParams struct {
+	StartTime *string
+	EndTime   *string
+	TimeRange *string
+	Platform  *string
+	Mode      *string
+	GroupID   *int64
+}
+
+// AdminOpsGetDashboardErrorDistribution 对应 GET /api/v1/admin/ops/dashboard/error-distribution
+//
+// Returns error distribution by status code (raw path).
+func (c *Client) AdminOpsGetDashboardErrorDistribution(ctx context.Context, params *AdminOpsGetDashboardErrorDistributionParams) (*OpsErrorDistributionResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/dashboard/error-distribution"}
+	if params != nil {
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("mode", params.Mode)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *OpsErrorDistributionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetDashboardErrorTrendParams AdminOpsGetDashboardErrorTrend 的查询与请求头参数
+type AdminOpsGetDashboardErrorTrendParams struct {
+	StartTime *string
+	EndTime   *string
+	TimeRange *string
+	Platform  *string
+	Mode      *string
+	GroupID   *int64
+}
+
+// AdminOpsGetDashboardErrorTrend 对应 GET /api/v1/admin/ops/dashboard/error-trend
+//
+// Returns error counts time series (raw path).
+func (c *Client) AdminOpsGetDashboardErrorTrend(ctx context.Context, params *AdminOpsGetDashboardErrorTrendParams) (*OpsErrorTrendResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/dashboard/error-trend"}
+	if params != nil {
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("mode", params.Mode)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *OpsErrorTrendResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetDashboardLatencyHistogramParams AdminOpsGetDashboardLatencyHistogram 的查询与请求头参数
+type AdminOpsGetDashboardLatencyHistogramParams struct {
+	StartTime *string
+	EndTime   *string
+	TimeRange *string
+	Platform  *string
+	Mode      *string
+	GroupID   *int64
+}
+
+// AdminOpsGetDashboardLatencyHistogram 对应 GET /api/v1/admin/ops/dashboard/latency-histogram
+//
+// Returns the latency distribution histogram (success requests).
+func (c *Client) AdminOpsGetDashboardLatencyHistogram(ctx context.Context, params *AdminOpsGetDashboardLatencyHistogramParams) (*OpsLatencyHistogramResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/dashboard/latency-histogram"}
+	if params != nil {
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("mode", params.Mode)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *OpsLatencyHistogramResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetDashboardOverviewParams AdminOpsGetDashboardOverview 的查询与请求头参数
+type AdminOpsGetDashboardOverviewParams struct {
+	StartTime *string
+	EndTime   *string
+	TimeRange *string
+	Platform  *string
+	Mode      *string
+	GroupID   *int64
+}
+
+// AdminOpsGetDashboardOverview 对应 GET /api/v1/admin/ops/dashboard/overview
+//
+// Returns vNext ops dashboard overview (raw path).
+func (c *Client) AdminOpsGetDashboardOverview(ctx context.Context, params *AdminOpsGetDashboardOverviewParams) (*OpsDashboardOverview, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/dashboard/overview"}
+	if params != nil {
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("mode", params.Mode)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *OpsDashboardOverview
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetDashboardThroughputTrendParams AdminOpsGetDashboardThroughputTrend 的查询与请求头参数
+type AdminOpsGetDashboardThroughputTrendParams struct {
+	StartTime *string
+	EndTime   *string
+	TimeRange *string
+	Platform  *string
+	Mode      *string
+	GroupID   *int64
+}
+
+// AdminOpsGetDashboardThroughputTrend 对应 GET /api/v1/admin/ops/dashboard/throughput-trend
+//
+// Returns throughput time series (raw path).
+func (c *Client) AdminOpsGetDashboardThroughputTrend(ctx context.Context, params *AdminOpsGetDashboardThroughputTrendParams) (*OpsThroughputTrendResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/dashboard/throughput-trend"}
+	if params != nil {
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("mode", params.Mode)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *OpsThroughputTrendResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetEmailNotificationConfig 对应 GET /api/v1/admin/ops/email-notification/config
+//
+// Returns Ops email notification config (DB-backed).
+func (c *Client) AdminOpsGetEmailNotificationConfig(ctx context.Context) (*OpsEmailNotificationConfig, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/email-notification/config"}
+	var out *OpsEmailNotificationConfig
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateEmailNotificationConfig 对应 PUT /api/v1/admin/ops/email-notification/config
+//
+// Updates Ops email notification config (DB-backed).
+func (c *Client) AdminOpsUpdateEmailNotificationConfig(ctx context.Context, body *OpsEmailNotificationConfigUpdateRequest) (*OpsEmailNotificationConfig, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/email-notification/config", body: body}
+	var out *OpsEmailNotificationConfig
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetErrorLogsParams AdminOpsGetErrorLogs 的查询与请求头参数
+type AdminOpsGetErrorLogsParams struct {
+	Page        *int64
+	PageSize    *int64
+	StartTime   *string
+	EndTime     *string
+	TimeRange   *string
+	View        *string
+	Phase       *string
+	ErrorOwner  *string
+	ErrorSource *string
+	Q           *string
+	UserQuery   *string
+	Platform    *string
+	GroupID     *int64
+	AccountID   *int64
+	Resolved    *string
+	StatusCodes *string
+}
+
+// AdminOpsGetErrorLogs 对应 GET /api/v1/admin/ops/errors
+//
+// Lists ops error logs.
+func (c *Client) AdminOpsGetErrorLogs(ctx context.Context, params *AdminOpsGetErrorLogsParams) (*Page[*OpsErrorLog], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/errors"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("view", params.View)
+		req.setQuery("phase", params.Phase)
+		req.setQuery("error_owner", params.ErrorOwner)
+		req.setQuery("error_source", params.ErrorSource)
+		req.setQuery("q", params.Q)
+		req.setQuery("user_query", params.UserQuery)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("resolved", params.Resolved)
+		req.setQuery("status_codes", params.StatusCodes)
+	}
+	var out *Page[*OpsErrorLog]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetErrorLogByID 对应 GET /api/v1/admin/ops/errors/{id}
+//
+// Returns ops error log detail.
+func (c *Client) AdminOpsGetErrorLogByID(ctx context.Context, id int64) (*OpsErrorLogDetail, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/errors/" + pathParam(id)}
+	var out *OpsErrorLogDetail
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateErrorResolution 对应 PUT /api/v1/admin/ops/errors/{id}/resolve
+//
+// Allows manual resolve/unresolve.
+func (c *Client) AdminOpsUpdateErrorResolution(ctx context.Context, id int64, body *OpsResolveRequest) (*AdminOpsUpdateErrorResolutionResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/errors/" + pathParam(id) + "/resolve", body: body}
+	var out *AdminOpsUpdateErrorResolutionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListRetryAttemptsParams AdminOpsListRetryAttempts 的查询与请求头参数
+type AdminOpsListRetryAttemptsParams struct {
+	Limit *int64
+}
+
+// AdminOpsListRetryAttempts 对应 GET /api/v1/admin/ops/errors/{id}/retries
+//
+// Lists retry attempts for an error log.
+func (c *Client) AdminOpsListRetryAttempts(ctx context.Context, id int64, params *AdminOpsListRetryAttemptsParams) ([]*OpsRetryAttempt, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/errors/" + pathParam(id) + "/retries"}
+	if params != nil {
+		req.setQuery("limit", params.Limit)
+	}
+	var out []*OpsRetryAttempt
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsRetryErrorRequest 对应 POST /api/v1/admin/ops/errors/{id}/retry
+//
+// Retries a failed request using stored request_body.
+func (c *Client) AdminOpsRetryErrorRequest(ctx context.Context, id int64, body *OpsRetryRequest) (*OpsRetryResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/ops/errors/" + pathParam(id) + "/retry", body: body}
+	var out *OpsRetryResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetFairQueueStats 对应 GET /api/v1/admin/ops/fair-queue
+//
+// Returns per-group fair queue depth and estimated wait.
+func (c *Client) AdminOpsGetFairQueueStats(ctx context.Context) (*FairQueueStats, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/fair-queue"}
+	var out *FairQueueStats
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetRealtimeTrafficSummaryParams AdminOpsGetRealtimeTrafficSummary 的查询与请求头参数
+type AdminOpsGetRealtimeTrafficSummaryParams struct {
+	Window   *string
+	Platform *string
+	GroupID  *int64
+}
+
+// AdminOpsGetRealtimeTrafficSummary 对应 GET /api/v1/admin/ops/realtime-traffic
+//
+// Returns QPS/TPS current/peak/avg for the selected window.
+func (c *Client) AdminOpsGetRealtimeTrafficSummary(ctx context.Context, params *AdminOpsGetRealtimeTrafficSummaryParams) (*AdminOpsGetRealtimeTrafficSummaryResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/realtime-traffic"}
+	if params != nil {
+		req.setQuery("window", params.Window)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+	}
+	var out *AdminOpsGetRealtimeTrafficSummaryResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListRequestErrorsParams AdminOpsListRequestErrors 的查询与请求头参数
+type AdminOpsListRequestErrorsParams struct {
+	Page        *int64
+	PageSize    *int64
+	StartTime   *string
+	EndTime     *string
+	TimeRange   *string
+	View        *string
+	Phase       *string
+	ErrorOwner  *string
+	ErrorSource *string
+	Q           *string
+	UserQuery   *string
+	Platform    *string
+	GroupID     *int64
+	AccountID   *int64
+	Resolved    *string
+	StatusCodes *string
+}
+
+// AdminOpsListRequestErrors 对应 GET /api/v1/admin/ops/request-errors
+//
+// Lists client-visible request errors.
+func (c *Client) AdminOpsListRequestErrors(ctx context.Context, params *AdminOpsListRequestErrorsParams) (*Page[*OpsErrorLog], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/request-errors"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("view", params.View)
+		req.setQuery("phase", params.Phase)
+		req.setQuery("error_owner", params.ErrorOwner)
+		req.setQuery("error_source", params.ErrorSource)
+		req.setQuery("q", params.Q)
+		req.setQuery("user_query", params.UserQuery)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("resolved", params.Resolved)
+		req.setQuery("status_codes", params.StatusCodes)
+	}
+	var out *Page[*OpsErrorLog]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetRequestError 对应 GET /api/v1/admin/ops/request-errors/{id}
+//
+// Returns request error detail.
+func (c *Client) AdminOpsGetRequestError(ctx context.Context, id int64) (*OpsErrorLogDetail, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/request-errors/" + pathParam(id)}
+	var out *OpsErrorLogDetail
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsResolveRequestError 对应 PUT /api/v1/admin/ops/request-errors/{id}/resolve
+//
+// Toggles resolved status.
+func (c *Client) AdminOpsResolveRequestError(ctx context.Context, id int64, body *OpsResolveRequest) (*AdminOpsResolveRequestErrorResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/request-errors/" + pathParam(id) + "/resolve", body: body}
+	var out *AdminOpsResolveRequestErrorResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsRetryRequestErrorClient 对应 POST /api/v1/admin/ops/request-errors/{id}/retry-client
+//
+// Retries the client request based on stored request body.
+func (c *Client) AdminOpsRetryRequestErrorClient(ctx context.Context, id int64) (*OpsRetryResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/ops/request-errors/" + pathParam(id) + "/retry-client"}
+	var out *OpsRetryResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListRequestErrorUpstreamErrorsParams AdminOpsListRequestErrorUpstreamErrors 的查询与请求头参数
+type AdminOpsListRequestErrorUpstreamErrorsParams struct {
+	Page          *int64
+	PageSize      *int64
+	StartTime     *string
+	EndTime       *string
+	TimeRange     *string
+	ErrorSource   *string
+	Q             *string
+	Platform      *string
+	IncludeDetail *string
+}
+
+// AdminOpsListRequestErrorUpstreamErrors 对应 GET /api/v1/admin/ops/request-errors/{id}/upstream-errors
+//
+// Lists upstream error logs correlated to a request error.
+func (c *Client) AdminOpsListRequestErrorUpstreamErrors(ctx context.Context, id int64, params *AdminOpsListRequestErrorUpstreamErrorsParams) (*AdminOpsListRequestErrorUpstreamErrorsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/request-errors/" + pathParam(id) + "/upstream-errors"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("error_source", params.ErrorSource)
+		req.setQuery("q", params.Q)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("include_detail", params.IncludeDetail)
+	}
+	var out *AdminOpsListRequestErrorUpstreamErrorsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsRetryRequestErrorUpstreamEvent 对应 POST /api/v1/admin/ops/request-errors/{id}/upstream-errors/{idx}/retry
+//
+// Retries a specific upstream attempt using captured upstream_request_body.
+func (c *Client) AdminOpsRetryRequestErrorUpstreamEvent(ctx context.Context, id int64, idx int64) (*OpsRetryResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/ops/request-errors/" + pathParam(id) + "/upstream-errors/" + pathParam(idx) + "/retry"}
+	var out *OpsRetryResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListRequestDetailsParams AdminOpsListRequestDetails 的查询与请求头参数
+type AdminOpsListRequestDetailsParams struct {
+	Page          *int64
+	PageSize      *int64
+	StartTime     *string
+	EndTime       *string
+	TimeRange     *string
+	Kind          *string
+	Platform      *string
+	Model         *string
+	RequestID     *string
+	Q             *string
+	Sort          *string
+	UserID        *int64
+	APIKeyID      *int64
+	AccountID     *int64
+	GroupID       *int64
+	MinDurationMs *int64
+	MaxDurationMs *int64
+}
+
+// AdminOpsListRequestDetails 对应 GET /api/v1/admin/ops/requests
+//
+// Returns a request-level list (success + error) for drill-down.
+func (c *Client) AdminOpsListRequestDetails(ctx context.Context, params *AdminOpsListRequestDetailsParams) (*Page[*OpsRequestDetail], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/requests"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("kind", params.Kind)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("model", params.Model)
+		req.setQuery("request_id", params.RequestID)
+		req.setQuery("q", params.Q)
+		req.setQuery("sort", params.Sort)
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("min_duration_ms", params.MinDurationMs)
+		req.setQuery("max_duration_ms", params.MaxDurationMs)
+	}
+	var out *Page[*OpsRequestDetail]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetAlertRuntimeSettings 对应 GET /api/v1/admin/ops/runtime/alert
+//
+// Returns Ops alert evaluator runtime settings (DB-backed).
+func (c *Client) AdminOpsGetAlertRuntimeSettings(ctx context.Context) (*OpsAlertRuntimeSettings, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/runtime/alert"}
+	var out *OpsAlertRuntimeSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateAlertRuntimeSettings 对应 PUT /api/v1/admin/ops/runtime/alert
+//
+// Updates Ops alert evaluator runtime settings (DB-backed).
+func (c *Client) AdminOpsUpdateAlertRuntimeSettings(ctx context.Context, body *OpsAlertRuntimeSettings) (*OpsAlertRuntimeSettings, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/runtime/alert", body: body}
+	var out *OpsAlertRuntimeSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetMetricThresholds 对应 GET /api/v1/admin/ops/settings/metric-thresholds
+//
+// Returns Ops metric thresholds (DB-backed).
+func (c *Client) AdminOpsGetMetricThresholds(ctx context.Context) (*OpsMetricThresholds, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/settings/metric-thresholds"}
+	var out *OpsMetricThresholds
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsUpdateMetricThresholds 对应 PUT /api/v1/admin/ops/settings/metric-thresholds
+//
+// Updates Ops metric thresholds (DB-backed).
+func (c *Client) AdminOpsUpdateMetricThresholds(ctx context.Context, body *OpsMetricThresholds) (*OpsMetricThresholds, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/settings/metric-thresholds", body: body}
+	var out *OpsMetricThresholds
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsListUpstreamErrorsParams AdminOpsListUpstreamErrors 的查询与请求头参数
+type AdminOpsListUpstreamErrorsParams struct {
+	Page        *int64
+	PageSize    *int64
+	StartTime   *string
+	EndTime     *string
+	TimeRange   *string
+	View        *string
+	ErrorSource *string
+	Q           *string
+	Platform    *string
+	GroupID     *int64
+	AccountID   *int64
+	Resolved    *string
+	StatusCodes *string
+}
+
+// AdminOpsListUpstreamErrors 对应 GET /api/v1/admin/ops/upstream-errors
+//
+// Lists independent upstream errors.
+func (c *Client) AdminOpsListUpstreamErrors(ctx context.Context, params *AdminOpsListUpstreamErrorsParams) (*Page[*OpsErrorLog], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/upstream-errors"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("start_time", params.StartTime)
+		req.setQuery("end_time", params.EndTime)
+		req.setQuery("time_range", params.TimeRange)
+		req.setQuery("view", params.View)
+		req.setQuery("error_source", params.ErrorSource)
+		req.setQuery("q", params.Q)
+		req.setQuery("platform", params.Platform)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("resolved", params.Resolved)
+		req.setQuery("status_codes", params.StatusCodes)
+	}
+	var out *Page[*OpsErrorLog]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetUpstreamError 对应 GET /api/v1/admin/ops/upstream-errors/{id}
+//
+// Returns upstream error detail.
+func (c *Client) AdminOpsGetUpstreamError(ctx context.Context, id int64) (*OpsErrorLogDetail, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/upstream-errors/" + pathParam(id)}
+	var out *OpsErrorLogDetail
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsResolveUpstreamError 对应 PUT /api/v1/admin/ops/upstream-errors/{id}/resolve
+//
+// Toggles resolved status.
+func (c *Client) AdminOpsResolveUpstreamError(ctx context.Context, id int64, body *OpsResolveRequest) (*AdminOpsResolveUpstreamErrorResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/ops/upstream-errors/" + pathParam(id) + "/resolve", body: body}
+	var out *AdminOpsResolveUpstreamErrorResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsRetryUpstreamError 对应 POST /api/v1/admin/ops/upstream-errors/{id}/retry
+//
+// Retries upstream error using the original account_id.
+func (c *Client) AdminOpsRetryUpstreamError(ctx context.Context, id int64) (*OpsRetryResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/ops/upstream-errors/" + pathParam(id) + "/retry"}
+	var out *OpsRetryResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetUsageOutboxStats 对应 GET /api/v1/admin/ops/usage-outbox
+//
+// Returns the usage billing outbox backlog and consumer lag.
+func (c *Client) AdminOpsGetUsageOutboxStats(ctx context.Context) (*UsageOutboxStats, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/usage-outbox"}
+	var out *UsageOutboxStats
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOpsGetUserConcurrencyStats 对应 GET /api/v1/admin/ops/user-concurrency
+//
+// Returns real-time concurrency usage for all active users.
+func (c *Client) AdminOpsGetUserConcurrencyStats(ctx context.Context) (*AdminOpsGetUserConcurrencyStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/ops/user-concurrency"}
+	var out *AdminOpsGetUserConcurrencyStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminPromoListParams AdminPromoList 的查询与请求头参数
+type AdminPromoListParams struct {
+	Page     *int64
+	PageSize *int64
+	Status   *string
+	Search   *string
+}
+
+// AdminPromoList 对应 GET /api/v1/admin/promo-codes
+//
+// Listing all promo codes with pagination
+func (c *Client) AdminPromoList(ctx context.Context, params *AdminPromoListParams) (*Page[PromoCode], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/promo-codes"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+	}
+	var out *Page[PromoCode]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminPromoCreate 对应 POST /api/v1/admin/promo-codes
+//
+// Creating a new promo code
+func (c *Client) AdminPromoCreate(ctx context.Context, body *CreatePromoCodeRequest) (*PromoCode, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/promo-codes", body: body}
+	var out *PromoCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminPromoDelete 对应 DELETE /api/v1/admin/promo-codes/{id}
+//
+// Deleting a promo code
+func (c *Client) AdminPromoDelete(ctx context.Context, id int64) (*AdminPromoDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/promo-codes/" + pathParam(id)}
+	var out *AdminPromoDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminPromoGetByID 对应 GET /api/v1/admin/promo-codes/{id}
+//
+// Getting a promo code by ID
+func (c *Client) AdminPromoGetByID(ctx context.Context, id int64) (*PromoCode, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/promo-codes/" + pathParam(id)}
+	var out *PromoCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminPromoUpdate 对应 PUT /api/v1/admin/promo-codes/{id}
+//
+// Updating a promo code
+func (c *Client) AdminPromoUpdate(ctx context.Context, id int64, body *UpdatePromoCodeRequest) (*PromoCode, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/promo-codes/" + pathParam(id), body: body}
+	var out *PromoCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminPromoGetUsagesParams AdminPromoGetUsages 的查询与请求头参数
+type AdminPromoGetUsagesParams struct {
+	Page     *int64
+	PageSize *int64
+}
+
+// AdminPromoGetUsages 对应 GET /api/v1/admin/promo-codes/{id}/usages
+//
+// Getting usage records for a promo code
+func (c *Client) AdminPromoGetUsages(ctx context.Context, id int64, params *AdminPromoGetUsagesParams) (*Page[PromoCodeUsage], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/promo-codes/" + pathParam(id) + "/usages"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+	}
+	var out *Page[PromoCodeUsage]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyListParams AdminProxyList 的查询与请求头参数
+type AdminProxyListParams struct {
+	Page     *int64
+	PageSize *int64
+	Protocol *string
+	Status   *string
+	Search   *string
+}
+
+// AdminProxyList 对应 GET /api/v1/admin/proxies
+//
+// Listing all proxies with pagination
+func (c *Client) AdminProxyList(ctx context.Context, params *AdminProxyListParams) (*Page[ProxyWithAccountCount], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/proxies"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("protocol", params.Protocol)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+	}
+	var out *Page[ProxyWithAccountCount]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyCreate 对应 POST /api/v1/admin/proxies
+//
+// Creating a new proxy
+func (c *Client) AdminProxyCreate(ctx context.Context, body *CreateProxyRequest) (*Proxy, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/proxies", body: body}
+	var out *Proxy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyGetAllParams AdminProxyGetAll 的查询与请求头参数
+type AdminProxyGetAllParams struct {
+	WithCount *string
+}
+
+// AdminProxyGetAll 对应 GET /api/v1/admin/proxies/all
+//
+// Getting all active proxies without pagination
+func (c *Client) AdminProxyGetAll(ctx context.Context, params *AdminProxyGetAllParams) (json.RawMessage, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/proxies/all"}
+	if params != nil {
+		req.setQuery("with_count", params.WithCount)
+	}
+	var out json.RawMessage
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyBatchCreate 对应 POST /api/v1/admin/proxies/batch
+//
+// Batch creating proxies
+func (c *Client) AdminProxyBatchCreate(ctx context.Context, body *BatchCreateRequest) (*AdminProxyBatchCreateResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/proxies/batch", body: body}
+	var out *AdminProxyBatchCreateResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyBatchDelete 对应 POST /api/v1/admin/proxies/batch-delete
+//
+// Batch deleting proxies
+func (c *Client) AdminProxyBatchDelete(ctx context.Context, body *BatchDeleteRequest) (*ProxyBatchDeleteResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/proxies/batch-delete", body: body}
+	var out *ProxyBatchDeleteResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyExportDataParams AdminProxyExportData 的查询与请求头参数
+type AdminProxyExportDataParams struct {
+	IDs      []string
+	Protocol *string
+	Status   *string
+	Search   *string
+}
+
+// AdminProxyExportData 对应 GET /api/v1/admin/proxies/data
+//
+// Exports proxy-only data for migration.
+func (c *Client) AdminProxyExportData(ctx context.Context, params *AdminProxyExportDataParams) (*DataPayload, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/proxies/data"}
+	if params != nil {
+		req.setQuery("ids", params.IDs)
+		req.setQuery("protocol", params.Protocol)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+	}
+	var out *DataPayload
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyImportData 对应 POST /api/v1/admin/proxies/data
+//
+// Imports proxy-only data for migration.
+func (c *Client) AdminProxyImportData(ctx context.Context, body *ProxyImportRequest) (*DataImportResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/proxies/data", body: body}
+	var out *DataImportResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyDelete 对应 DELETE /api/v1/admin/proxies/{id}
+//
+// Deleting a proxy
+func (c *Client) AdminProxyDelete(ctx context.Context, id int64) (*AdminProxyDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/proxies/" + pathParam(id)}
+	var out *AdminProxyDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyGetByID 对应 GET /api/v1/admin/proxies/{id}
+//
+// Getting a proxy by ID
+func (c *Client) AdminProxyGetByID(ctx context.Context, id int64) (*Proxy, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/proxies/" + pathParam(id)}
+	var out *Proxy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyUpdate 对应 PUT /api/v1/admin/proxies/{id}
+//
+// Updating a proxy
+func (c *Client) AdminProxyUpdate(ctx context.Context, id int64, body *UpdateProxyRequest) (*Proxy, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/proxies/" + pathParam(id), body: body}
+	var out *Proxy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyGetProxyAccounts 对应 GET /api/v1/admin/proxies/{id}/accounts
+//
+// Getting accounts using a proxy
+func (c *Client) AdminProxyGetProxyAccounts(ctx context.Context, id int64) ([]ProxyAccountSummary, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/proxies/" + pathParam(id) + "/accounts"}
+	var out []ProxyAccountSummary
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyGetStats 对应 GET /api/v1/admin/proxies/{id}/stats
+//
+// Getting proxy statistics
+func (c *Client) AdminProxyGetStats(ctx context.Context, id int64) (*AdminProxyGetStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/proxies/" + pathParam(id) + "/stats"}
+	var out *AdminProxyGetStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminProxyTest 对应 POST /api/v1/admin/proxies/{id}/test
+//
+// Testing proxy connectivity
+func (c *Client) AdminProxyTest(ctx context.Context, id int64) (*ProxyTestResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/proxies/" + pathParam(id) + "/test"}
+	var out *ProxyTestResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemListParams AdminRedeemList 的查询与请求头参数
+type AdminRedeemListParams struct {
+	Page     *int64
+	PageSize *int64
+	Type     *string
+	Status   *string
+	Search   *string
+}
+
+// AdminRedeemList 对应 GET /api/v1/admin/redeem-codes
+//
+// Listing all redeem codes with pagination
+func (c *Client) AdminRedeemList(ctx context.Context, params *AdminRedeemListParams) (*Page[AdminRedeemCode], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/redeem-codes"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("type", params.Type)
+		req.setQuery("status", params.Status)
+		req.setQuery("search", params.Search)
+	}
+	var out *Page[AdminRedeemCode]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemBatchDelete 对应 POST /api/v1/admin/redeem-codes/batch-delete
+//
+// Batch deleting redeem codes
+func (c *Client) AdminRedeemBatchDelete(ctx context.Context, body *AdminRedeemBatchDeleteRequest) (*AdminRedeemBatchDeleteResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/redeem-codes/batch-delete", body: body}
+	var out *AdminRedeemBatchDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemExportParams AdminRedeemExport 的查询与请求头参数
+type AdminRedeemExportParams struct {
+	Type   *string
+	Status *string
+}
+
+// AdminRedeemExport 对应 GET /api/v1/admin/redeem-codes/export
+//
+// Exporting redeem codes to CSV
+func (c *Client) AdminRedeemExport(ctx context.Context, params *AdminRedeemExportParams) (*http.Response, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/redeem-codes/export"}
+	if params != nil {
+		req.setQuery("type", params.Type)
+		req.setQuery("status", params.Status)
+	}
+	return c.stream(ctx, req)
+}
+
+// AdminRedeemGenerate 对应 POST /api/v1/admin/redeem-codes/generate
+//
+// Generating new redeem codes
+func (c *Client) AdminRedeemGenerate(ctx context.Context, body *GenerateRedeemCodesRequest) ([]AdminRedeemCode, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/redeem-codes/generate", body: body}
+	var out []AdminRedeemCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemGetStats 对应 GET /api/v1/admin/redeem-codes/stats
+//
+// Getting redeem code statistics
+func (c *Client) AdminRedeemGetStats(ctx context.Context) (*AdminRedeemGetStatsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/redeem-codes/stats"}
+	var out *AdminRedeemGetStatsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemDelete 对应 DELETE /api/v1/admin/redeem-codes/{id}
+//
+// Deleting a redeem code
+func (c *Client) AdminRedeemDelete(ctx context.Context, id int64) (*AdminRedeemDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/redeem-codes/" + pathParam(id)}
+	var out *AdminRedeemDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemGetByID 对应 GET /api/v1/admin/redeem-codes/{id}
+//
+// Getting a redeem code by ID
+func (c *Client) AdminRedeemGetByID(ctx context.Context, id int64) (*AdminRedeemCode, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/redeem-codes/" + pathParam(id)}
+	var out *AdminRedeemCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRedeemExpire 对应 POST /api/v1/admin/redeem-codes/{id}/expire
+//
+// Expiring a redeem code
+func (c *Client) AdminRedeemExpire(ctx context.Context, id int64) (*AdminRedeemCode, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/redeem-codes/" + pathParam(id) + "/expire"}
+	var out *AdminRedeemCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRequestPolicyList 对应 GET /api/v1/admin/request-policies
+//
+// 获取所有策略
+func (c *Client) AdminRequestPolicyList(ctx context.Context) ([]*RequestPolicy, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/request-policies"}
+	var out []*RequestPolicy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRequestPolicyCreate 对应 POST /api/v1/admin/request-policies
+//
+// 创建策略
+func (c *Client) AdminRequestPolicyCreate(ctx context.Context, body *CreateRequestPolicyRequest) (*RequestPolicy, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/request-policies", body: body}
+	var out *RequestPolicy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRequestPolicyDelete 对应 DELETE /api/v1/admin/request-policies/{id}
+//
+// 删除策略
+func (c *Client) AdminRequestPolicyDelete(ctx context.Context, id int64) (*AdminRequestPolicyDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/request-policies/" + pathParam(id)}
+	var out *AdminRequestPolicyDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRequestPolicyGetByID 对应 GET /api/v1/admin/request-policies/{id}
+//
+// 根据 ID 获取策略
+func (c *Client) AdminRequestPolicyGetByID(ctx context.Context, id int64) (*RequestPolicy, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/request-policies/" + pathParam(id)}
+	var out *RequestPolicy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRequestPolicyUpdate 对应 PUT /api/v1/admin/request-policies/{id}
+//
+// 更新策略（支持部分更新）
+func (c *Client) AdminRequestPolicyUpdate(ctx context.Context, id int64, body *UpdateRequestPolicyRequest) (*RequestPolicy, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/request-policies/" + pathParam(id), body: body}
+	var out *RequestPolicy
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRoutingRuleList 对应 GET /api/v1/admin/routing-rules
+//
+// 获取所有规则
+func (c *Client) AdminRoutingRuleList(ctx context.Context) ([]*RoutingRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/routing-rules"}
+	var out []*RoutingRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRoutingRuleCreate 对应 POST /api/v1/admin/routing-rules
+//
+// 创建规则
+func (c *Client) AdminRoutingRuleCreate(ctx context.Context, body *CreateRoutingRuleRequest) (*RoutingRule, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/routing-rules", body: body}
+	var out *RoutingRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRoutingRuleDelete 对应 DELETE /api/v1/admin/routing-rules/{id}
+//
+// 删除规则
+func (c *Client) AdminRoutingRuleDelete(ctx context.Context, id int64) (*AdminRoutingRuleDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/routing-rules/" + pathParam(id)}
+	var out *AdminRoutingRuleDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRoutingRuleGetByID 对应 GET /api/v1/admin/routing-rules/{id}
+//
+// 根据 ID 获取规则
+func (c *Client) AdminRoutingRuleGetByID(ctx context.Context, id int64) (*RoutingRule, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/routing-rules/" + pathParam(id)}
+	var out *RoutingRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminRoutingRuleUpdate 对应 PUT /api/v1/admin/routing-rules/{id}
+//
+// 更新规则（支持部分更新）
+func (c *Client) AdminRoutingRuleUpdate(ctx context.Context, id int64, body *UpdateRoutingRuleRequest) (*RoutingRule, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/routing-rules/" + pathParam(id), body: body}
+	var out *RoutingRule
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingGetSettings 对应 GET /api/v1/admin/settings
+//
+// 获取所有系统设置
+func (c *Client) AdminSettingGetSettings(ctx context.Context) (*SystemSettings, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/settings"}
+	var out *SystemSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingUpdateSettings 对应 PUT /api/v1/admin/settings
+//
+// 更新系统设置
+func (c *Client) AdminSettingUpdateSettings(ctx context.Context, body *UpdateSettingsRequest) (*SystemSettings, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/settings", body: body}
+	var out *SystemSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingDeleteAdminAPIKey 对应 DELETE /api/v1/admin/settings/admin-api-key
+//
+// 删除管理员 API Key
+func (c *Client) AdminSettingDeleteAdminAPIKey(ctx context.Context) (*AdminSettingDeleteAdminAPIKeyResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/settings/admin-api-key"}
+	var out *AdminSettingDeleteAdminAPIKeyResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingGetAdminAPIKey 对应 GET /api/v1/admin/settings/admin-api-key
+//
+// 获取管理员 API Key 状态
+func (c *Client) AdminSettingGetAdminAPIKey(ctx context.Context) (*AdminSettingGetAdminAPIKeyResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/settings/admin-api-key"}
+	var out *AdminSettingGetAdminAPIKeyResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingRegenerateAdminAPIKey 对应 POST /api/v1/admin/settings/admin-api-key/regenerate
+//
+// 生成/重新生成管理员 API Key
+func (c *Client) AdminSettingRegenerateAdminAPIKey(ctx context.Context) (*AdminSettingRegenerateAdminAPIKeyResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/settings/admin-api-key/regenerate"}
+	var out *AdminSettingRegenerateAdminAPIKeyResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingSendTestEmail 对应 POST /api/v1/admin/settings/send-test-email
+//
+// 发送测试邮件
+func (c *Client) AdminSettingSendTestEmail(ctx context.Context, body *SendTestEmailRequest) (*AdminSettingSendTestEmailResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/settings/send-test-email", body: body}
+	var out *AdminSettingSendTestEmailResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingGetStreamTimeoutSettings 对应 GET /api/v1/admin/settings/stream-timeout
+//
+// 获取流超时处理配置
+func (c *Client) AdminSettingGetStreamTimeoutSettings(ctx context.Context) (*StreamTimeoutSettings, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/settings/stream-timeout"}
+	var out *StreamTimeoutSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingUpdateStreamTimeoutSettings 对应 PUT /api/v1/admin/settings/stream-timeout
+//
+// 更新流超时处理配置
+func (c *Client) AdminSettingUpdateStreamTimeoutSettings(ctx context.Context, body *UpdateStreamTimeoutSettingsRequest) (*StreamTimeoutSettings, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/settings/stream-timeout", body: body}
+	var out *StreamTimeoutSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSettingTestSMTPConnection 对应 POST /api/v1/admin/settings/test-smtp
+//
+// 测试SMTP连接
+func (c *Client) AdminSettingTestSMTPConnection(ctx context.Context, body *TestSMTPRequest) (*AdminSettingTestSMTPConnectionResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/settings/test-smtp", body: body}
+	var out *AdminSettingTestSMTPConnectionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionListParams AdminSubscriptionList 的查询与请求头参数
+type AdminSubscriptionListParams struct {
+	Page      *int64
+	PageSize  *int64
+	UserID    *int64
+	GroupID   *int64
+	Status    *string
+	SortBy    *string
+	SortOrder *string
+}
+
+// AdminSubscriptionList 对应 GET /api/v1/admin/subscriptions
+//
+// Listing all subscriptions with pagination and filters
+func (c *Client) AdminSubscriptionList(ctx context.Context, params *AdminSubscriptionListParams) (*Page[AdminUserSubscription], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/subscriptions"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("status", params.Status)
+		req.setQuery("sort_by", params.SortBy)
+		req.setQuery("sort_order", params.SortOrder)
+	}
+	var out *Page[AdminUserSubscription]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionAssign 对应 POST /api/v1/admin/subscriptions/assign
+//
+// Assigning a subscription to a user
+func (c *Client) AdminSubscriptionAssign(ctx context.Context, body *AssignSubscriptionRequest) (*AdminUserSubscription, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/subscriptions/assign", body: body}
+	var out *AdminUserSubscription
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionBulkAssign 对应 POST /api/v1/admin/subscriptions/bulk-assign
+//
+// Bulk assigning subscriptions to multiple users
+func (c *Client) AdminSubscriptionBulkAssign(ctx context.Context, body *BulkAssignSubscriptionRequest) (*BulkAssignResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/subscriptions/bulk-assign", body: body}
+	var out *BulkAssignResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionRevoke 对应 DELETE /api/v1/admin/subscriptions/{id}
+//
+// Revoking a subscription
+func (c *Client) AdminSubscriptionRevoke(ctx context.Context, id int64) (*AdminSubscriptionRevokeResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/subscriptions/" + pathParam(id)}
+	var out *AdminSubscriptionRevokeResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionGetByID 对应 GET /api/v1/admin/subscriptions/{id}
+//
+// Getting a subscription by ID
+func (c *Client) AdminSubscriptionGetByID(ctx context.Context, id int64) (*AdminUserSubscription, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/subscriptions/" + pathParam(id)}
+	var out *AdminUserSubscription
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionExtend 对应 POST /api/v1/admin/subscriptions/{id}/extend
+//
+// Adjusting a subscription (extend or shorten)
+func (c *Client) AdminSubscriptionExtend(ctx context.Context, id int64, body *AdjustSubscriptionRequest) (*AdminUserSubscription, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/subscriptions/" + pathParam(id) + "/extend", body: body}
+	var out *AdminUserSubscription
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionGetProgress 对应 GET /api/v1/admin/subscriptions/{id}/progress
+//
+// Getting subscription usage progress
+func (c *Client) AdminSubscriptionGetProgress(ctx context.Context, id int64) (*SubscriptionProgress, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/subscriptions/" + pathParam(id) + "/progress"}
+	var out *SubscriptionProgress
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminBackupBackupParams AdminBackupBackup 的查询与请求头参数
+type AdminBackupBackupParams struct {
+	SkipUsageLogs     *bool
+	UsageFrom         *string
+	UsageTo           *string
+	XBackupPassphrase *string
+}
+
+// AdminBackupBackup 对应 POST /api/v1/admin/system/backup
+//
+// 以附件形式流式下载全量备份归档
+func (c *Client) AdminBackupBackup(ctx context.Context, params *AdminBackupBackupParams) (*http.Response, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/backup"}
+	if params != nil {
+		req.setQuery("skip_usage_logs", params.SkipUsageLogs)
+		req.setQuery("usage_from", params.UsageFrom)
+		req.setQuery("usage_to", params.UsageTo)
+		req.setHeader("X-Backup-Passphrase", params.XBackupPassphrase)
+	}
+	return c.stream(ctx, req)
+}
+
+// AdminSystemCheckUpdatesParams AdminSystemCheckUpdates 的查询与请求头参数
+type AdminSystemCheckUpdatesParams struct {
+	Force *string
+}
+
+// AdminSystemCheckUpdates 对应 GET /api/v1/admin/system/check-updates
+//
+// Checks for available updates
+func (c *Client) AdminSystemCheckUpdates(ctx context.Context, params *AdminSystemCheckUpdatesParams) (*UpdateInfo, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/system/check-updates"}
+	if params != nil {
+		req.setQuery("force", params.Force)
+	}
+	var out *UpdateInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminConfigReloadGetStatus 对应 GET /api/v1/admin/system/config/reload
+//
+// 获取配置文件监听状态与最近一次重新加载结果
+func (c *Client) AdminConfigReloadGetStatus(ctx context.Context) (*ConfigReloadStatus, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/system/config/reload"}
+	var out *ConfigReloadStatus
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminConfigReloadReload 对应 POST /api/v1/admin/system/config/reload
+//
+// 重新读取并校验配置文件，应用可热更新的配置项，返回已生效与需要重启的配置项
+func (c *Client) AdminConfigReloadReload(ctx context.Context) (*ConfigReloadResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/config/reload"}
+	var out *ConfigReloadResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOneAPIImportImport 对应 POST /api/v1/admin/system/import/oneapi
+//
+// 执行导入并返回逐项报告
+func (c *Client) AdminOneAPIImportImport(ctx context.Context, body *OneAPIImportRequest) (*OneAPIImportResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/import/oneapi", body: body}
+	var out *OneAPIImportResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminOneAPIImportPreview 对应 POST /api/v1/admin/system/import/oneapi/preview
+//
+// 预览导入结果，不写入数据
+func (c *Client) AdminOneAPIImportPreview(ctx context.Context, body *OneAPIImportRequest) (*OneAPIImportResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/import/oneapi/preview", body: body}
+	var out *OneAPIImportResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClusterListNodes 对应 GET /api/v1/admin/system/nodes
+//
+// 列出在线节点
+func (c *Client) AdminClusterListNodes(ctx context.Context) (*AdminClusterListNodesResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/system/nodes"}
+	var out *AdminClusterListNodesResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClusterSendCommand 对应 POST /api/v1/admin/system/nodes/{id}/command
+//
+// 向指定节点下发 drain / undrain / restart 指令
+func (c *Client) AdminClusterSendCommand(ctx context.Context, id string, body *NodeCommandRequest) (*AdminClusterSendCommandResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/nodes/" + pathParam(id) + "/command", body: body}
+	var out *AdminClusterSendCommandResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSystemRestartService 对应 POST /api/v1/admin/system/restart
+//
+// Restarts the systemd service
+func (c *Client) AdminSystemRestartService(ctx context.Context) (*AdminSystemRestartServiceResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/restart"}
+	var out *AdminSystemRestartServiceResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminBackupRestoreParams AdminBackupRestore 的查询与请求头参数
+type AdminBackupRestoreParams struct {
+	Force             *bool
+	XBackupPassphrase *string
+}
+
+// AdminBackupRestore 对应 POST /api/v1/admin/system/restore
+//
+// 上传归档并在单个事务中恢复
+func (c *Client) AdminBackupRestore(ctx context.Context, params *AdminBackupRestoreParams, body io.Reader) (*RestoreResult, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/restore", raw: body, contentType: "application/octet-stream"}
+	if params != nil {
+		req.setQuery("force", params.Force)
+		req.setHeader("X-Backup-Passphrase", params.XBackupPassphrase)
+	}
+	var out *RestoreResult
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSystemRollback 对应 POST /api/v1/admin/system/rollback
+//
+// Restores the previous version
+func (c *Client) AdminSystemRollback(ctx context.Context) (*AdminSystemRollbackResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/rollback"}
+	var out *AdminSystemRollbackResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClusterGetRollingRestart 对应 GET /api/v1/admin/system/rolling-restart
+//
+// 获取最近一次滚动重启状态
+func (c *Client) AdminClusterGetRollingRestart(ctx context.Context) (*ClusterRollingRestartStatus, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/system/rolling-restart"}
+	var out *ClusterRollingRestartStatus
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminClusterStartRollingRestart 对应 POST /api/v1/admin/system/rolling-restart
+//
+// 依次重启所有节点
+func (c *Client) AdminClusterStartRollingRestart(ctx context.Context) (*ClusterRollingRestartStatus, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/rolling-restart"}
+	var out *ClusterRollingRestartStatus
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSystemPerformUpdate 对应 POST /api/v1/admin/system/update
+//
+// Downloads and applies the update
+func (c *Client) AdminSystemPerformUpdate(ctx context.Context) (*AdminSystemPerformUpdateResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/system/update"}
+	var out *AdminSystemPerformUpdateResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSystemGetVersion 对应 GET /api/v1/admin/system/version
+//
+// Returns the current version
+func (c *Client) AdminSystemGetVersion(ctx context.Context) (*AdminSystemGetVersionResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/system/version"}
+	var out *AdminSystemGetVersionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageListParams AdminUsageList 的查询与请求头参数
+type AdminUsageListParams struct {
+	Page        *int64
+	PageSize    *int64
+	UserID      *int64
+	APIKeyID    *int64
+	AccountID   *int64
+	GroupID     *int64
+	Model       *string
+	Stream      *bool
+	BillingType *int64
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+}
+
+// AdminUsageList 对应 GET /api/v1/admin/usage
+//
+// Listing all usage records with filters
+func (c *Client) AdminUsageList(ctx context.Context, params *AdminUsageListParams) (*Page[AdminUsageLog], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/usage"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("model", params.Model)
+		req.setQuery("stream", params.Stream)
+		req.setQuery("billing_type", params.BillingType)
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+	}
+	var out *Page[AdminUsageLog]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageListCleanupTasksParams AdminUsageListCleanupTasks 的查询与请求头参数
+type AdminUsageListCleanupTasksParams struct {
+	Page     *int64
+	PageSize *int64
+}
+
+// AdminUsageListCleanupTasks 对应 GET /api/v1/admin/usage/cleanup-tasks
+//
+// Listing usage cleanup tasks
+func (c *Client) AdminUsageListCleanupTasks(ctx context.Context, params *AdminUsageListCleanupTasksParams) (*Page[UsageCleanupTask], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/usage/cleanup-tasks"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+	}
+	var out *Page[UsageCleanupTask]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageCreateCleanupTask 对应 POST /api/v1/admin/usage/cleanup-tasks
+//
+// Creating a usage cleanup task
+func (c *Client) AdminUsageCreateCleanupTask(ctx context.Context, body *CreateUsageCleanupTaskRequest) (*UsageCleanupTask, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/usage/cleanup-tasks", body: body}
+	var out *UsageCleanupTask
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageCancelCleanupTask 对应 POST /api/v1/admin/usage/cleanup-tasks/{id}/cancel
+//
+// Canceling a usage cleanup task
+func (c *Client) AdminUsageCancelCleanupTask(ctx context.Context, id int64) (*AdminUsageCancelCleanupTaskResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/usage/cleanup-tasks/" + pathParam(id) + "/cancel"}
+	var out *AdminUsageCancelCleanupTaskResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageSearchAPIKeysParams AdminUsageSearchAPIKeys 的查询与请求头参数
+type AdminUsageSearchAPIKeysParams struct {
+	UserID *int64
+	Q      *string
+}
+
+// AdminUsageSearchAPIKeys 对应 GET /api/v1/admin/usage/search-api-keys
+//
+// Searching API keys by user
+func (c *Client) AdminUsageSearchAPIKeys(ctx context.Context, params *AdminUsageSearchAPIKeysParams) ([]SimpleAPIKey, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/usage/search-api-keys"}
+	if params != nil {
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("q", params.Q)
+	}
+	var out []SimpleAPIKey
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageSearchUsersParams AdminUsageSearchUsers 的查询与请求头参数
+type AdminUsageSearchUsersParams struct {
+	Q *string
+}
+
+// AdminUsageSearchUsers 对应 GET /api/v1/admin/usage/search-users
+//
+// Searching users by email keyword
+func (c *Client) AdminUsageSearchUsers(ctx context.Context, params *AdminUsageSearchUsersParams) ([]SimpleUser, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/usage/search-users"}
+	if params != nil {
+		req.setQuery("q", params.Q)
+	}
+	var out []SimpleUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUsageStatsParams AdminUsageStats 的查询与请求头参数
+type AdminUsageStatsParams struct {
+	UserID      *int64
+	APIKeyID    *int64
+	AccountID   *int64
+	GroupID     *int64
+	Model       *string
+	Stream      *bool
+	BillingType *int64
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+	Period      *string
+}
+
+// AdminUsageStats 对应 GET /api/v1/admin/usage/stats
+//
+// Getting usage statistics with filters
+func (c *Client) AdminUsageStats(ctx context.Context, params *AdminUsageStatsParams) (*UsagestatsUsageStats, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/usage/stats"}
+	if params != nil {
+		req.setQuery("user_id", params.UserID)
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("account_id", params.AccountID)
+		req.setQuery("group_id", params.GroupID)
+		req.setQuery("model", params.Model)
+		req.setQuery("stream", params.Stream)
+		req.setQuery("billing_type", params.BillingType)
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("period", params.Period)
+	}
+	var out *UsagestatsUsageStats
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeListDefinitionsParams AdminUserAttributeListDefinitions 的查询与请求头参数
+type AdminUserAttributeListDefinitionsParams struct {
+	Enabled *string
+}
+
+// AdminUserAttributeListDefinitions 对应 GET /api/v1/admin/user-attributes
+//
+// Lists all attribute definitions
+func (c *Client) AdminUserAttributeListDefinitions(ctx context.Context, params *AdminUserAttributeListDefinitionsParams) ([]*AttributeDefinitionResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/user-attributes"}
+	if params != nil {
+		req.setQuery("enabled", params.Enabled)
+	}
+	var out []*AttributeDefinitionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeCreateDefinition 对应 POST /api/v1/admin/user-attributes
+//
+// Creates a new attribute definition
+func (c *Client) AdminUserAttributeCreateDefinition(ctx context.Context, body *CreateAttributeDefinitionRequest) (*AttributeDefinitionResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/user-attributes", body: body}
+	var out *AttributeDefinitionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeGetBatchUserAttributes 对应 POST /api/v1/admin/user-attributes/batch
+//
+// Gets attribute values for multiple users
+func (c *Client) AdminUserAttributeGetBatchUserAttributes(ctx context.Context, body *BatchGetUserAttributesRequest) (*BatchUserAttributesResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/user-attributes/batch", body: body}
+	var out *BatchUserAttributesResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeReorderDefinitions 对应 PUT /api/v1/admin/user-attributes/reorder
+//
+// Reorders attribute definitions
+func (c *Client) AdminUserAttributeReorderDefinitions(ctx context.Context, body *ReorderRequest) (*AdminUserAttributeReorderDefinitionsResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/user-attributes/reorder", body: body}
+	var out *AdminUserAttributeReorderDefinitionsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeDeleteDefinition 对应 DELETE /api/v1/admin/user-attributes/{id}
+//
+// Deletes an attribute definition
+func (c *Client) AdminUserAttributeDeleteDefinition(ctx context.Context, id int64) (*AdminUserAttributeDeleteDefinitionResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/user-attributes/" + pathParam(id)}
+	var out *AdminUserAttributeDeleteDefinitionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeUpdateDefinition 对应 PUT /api/v1/admin/user-attributes/{id}
+//
+// Updates an attribute definition
+func (c *Client) AdminUserAttributeUpdateDefinition(ctx context.Context, id int64, body *UpdateAttributeDefinitionRequest) (*AttributeDefinitionResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/user-attributes/" + pathParam(id), body: body}
+	var out *AttributeDefinitionResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserListParams AdminUserList 的查询与请求头参数
+type AdminUserListParams struct {
+	Page     *int64
+	PageSize *int64
+	Search   *string
+	Status   *string
+	Role     *string
+}
+
+// AdminUserList 对应 GET /api/v1/admin/users
+//
+// Listing all users with pagination
+func (c *Client) AdminUserList(ctx context.Context, params *AdminUserListParams) (*Page[UserWithConcurrency], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("search", params.Search)
+		req.setQuery("status", params.Status)
+		req.setQuery("role", params.Role)
+	}
+	var out *Page[UserWithConcurrency]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserCreate 对应 POST /api/v1/admin/users
+//
+// Creating a new user
+func (c *Client) AdminUserCreate(ctx context.Context, body *CreateUserRequest) (*AdminUser, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/users", body: body}
+	var out *AdminUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserDelete 对应 DELETE /api/v1/admin/users/{id}
+//
+// Deleting a user
+func (c *Client) AdminUserDelete(ctx context.Context, id int64) (*AdminUserDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/admin/users/" + pathParam(id)}
+	var out *AdminUserDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserGetByID 对应 GET /api/v1/admin/users/{id}
+//
+// Getting a user by ID
+func (c *Client) AdminUserGetByID(ctx context.Context, id int64) (*AdminUser, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users/" + pathParam(id)}
+	var out *AdminUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserUpdate 对应 PUT /api/v1/admin/users/{id}
+//
+// Updating a user
+func (c *Client) AdminUserUpdate(ctx context.Context, id int64, body *UpdateUserRequest) (*AdminUser, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/users/" + pathParam(id), body: body}
+	var out *AdminUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserGetUserAPIKeysParams AdminUserGetUserAPIKeys 的查询与请求头参数
+type AdminUserGetUserAPIKeysParams struct {
+	Page     *int64
+	PageSize *int64
+}
+
+// AdminUserGetUserAPIKeys 对应 GET /api/v1/admin/users/{id}/api-keys
+//
+// Getting user's API keys
+func (c *Client) AdminUserGetUserAPIKeys(ctx context.Context, id int64, params *AdminUserGetUserAPIKeysParams) (*Page[APIKey], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users/" + pathParam(id) + "/api-keys"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+	}
+	var out *Page[APIKey]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeGetUserAttributes 对应 GET /api/v1/admin/users/{id}/attributes
+//
+// Gets a user's attribute values
+func (c *Client) AdminUserAttributeGetUserAttributes(ctx context.Context, id int64) ([]*AttributeValueResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users/" + pathParam(id) + "/attributes"}
+	var out []*AttributeValueResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserAttributeUpdateUserAttributes 对应 PUT /api/v1/admin/users/{id}/attributes
+//
+// Updates a user's attribute values
+func (c *Client) AdminUserAttributeUpdateUserAttributes(ctx context.Context, id int64, body *UpdateUserAttributesRequest) ([]*AttributeValueResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/admin/users/" + pathParam(id) + "/attributes", body: body}
+	var out []*AttributeValueResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserUpdateBalance 对应 POST /api/v1/admin/users/{id}/balance
+//
+// Updating user balance
+func (c *Client) AdminUserUpdateBalance(ctx context.Context, id int64, body *UpdateBalanceRequest) (*AdminUser, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/admin/users/" + pathParam(id) + "/balance", body: body}
+	var out *AdminUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserGetBalanceHistoryParams AdminUserGetBalanceHistory 的查询与请求头参数
+type AdminUserGetBalanceHistoryParams struct {
+	Page     *int64
+	PageSize *int64
+	Type     *string
+}
+
+// AdminUserGetBalanceHistory 对应 GET /api/v1/admin/users/{id}/balance-history
+//
+// Getting user's balance/concurrency change history
+func (c *Client) AdminUserGetBalanceHistory(ctx context.Context, id int64, params *AdminUserGetBalanceHistoryParams) (*AdminUserGetBalanceHistoryResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users/" + pathParam(id) + "/balance-history"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("type", params.Type)
+	}
+	var out *AdminUserGetBalanceHistoryResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminSubscriptionListByUser 对应 GET /api/v1/admin/users/{id}/subscriptions
+//
+// Listing subscriptions for a specific user
+func (c *Client) AdminSubscriptionListByUser(ctx context.Context, id int64) ([]AdminUserSubscription, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users/" + pathParam(id) + "/subscriptions"}
+	var out []AdminUserSubscription
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AdminUserGetUserUsageParams AdminUserGetUserUsage 的查询与请求头参数
+type AdminUserGetUserUsageParams struct {
+	Period *string
+}
+
+// AdminUserGetUserUsage 对应 GET /api/v1/admin/users/{id}/usage
+//
+// Getting user's usage statistics
+func (c *Client) AdminUserGetUserUsage(ctx context.Context, id int64, params *AdminUserGetUserUsageParams) (json.RawMessage, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/admin/users/" + pathParam(id) + "/usage"}
+	if params != nil {
+		req.setQuery("period", params.Period)
+	}
+	var out json.RawMessage
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AnnouncementListParams AnnouncementList 的查询与请求头参数
+type AnnouncementListParams struct {
+	UnreadOnly *string
+}
+
+// AnnouncementList 对应 GET /api/v1/announcements
+//
+// Listing announcements visible to current user
+func (c *Client) AnnouncementList(ctx context.Context, params *AnnouncementListParams) ([]UserAnnouncement, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/announcements"}
+	if params != nil {
+		req.setQuery("unread_only", params.UnreadOnly)
+	}
+	var out []UserAnnouncement
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AnnouncementMarkRead 对应 POST /api/v1/announcements/{id}/read
+//
+// Marks an announcement as read for current user
+func (c *Client) AnnouncementMarkRead(ctx context.Context, id int64) (*AnnouncementMarkReadResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/announcements/" + pathParam(id) + "/read"}
+	var out *AnnouncementMarkReadResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthForgotPasswordParams AuthForgotPassword 的查询与请求头参数
+type AuthForgotPasswordParams struct {
+	XForwardedProto *string
+}
+
+// AuthForgotPassword 对应 POST /api/v1/auth/forgot-password
+//
+// 请求密码重置
+func (c *Client) AuthForgotPassword(ctx context.Context, params *AuthForgotPasswordParams, body *ForgotPasswordRequest) (*ForgotPasswordResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/forgot-password", body: body}
+	if params != nil {
+		req.setHeader("X-Forwarded-Proto", params.XForwardedProto)
+	}
+	var out *ForgotPasswordResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthLogin 对应 POST /api/v1/auth/login
+//
+// User login
+func (c *Client) AuthLogin(ctx context.Context, body *LoginRequest) (json.RawMessage, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/login", body: body}
+	var out json.RawMessage
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthLogin2FA 对应 POST /api/v1/auth/login/2fa
+//
+// Completes the login with 2FA verification
+func (c *Client) AuthLogin2FA(ctx context.Context, body *Login2FARequest) (*AuthResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/login/2fa", body: body}
+	var out *AuthResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthLogout 对应 POST /api/v1/auth/logout
+//
+// 用户登出
+func (c *Client) AuthLogout(ctx context.Context, body *LogoutRequest) (*LogoutResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/logout", body: body}
+	var out *LogoutResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthGetCurrentUser 对应 GET /api/v1/auth/me
+//
+// Getting current authenticated user
+func (c *Client) AuthGetCurrentUser(ctx context.Context) (*UserResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/auth/me"}
+	var out *UserResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthLinuxDoOAuthCallbackParams AuthLinuxDoOAuthCallback 的查询与请求头参数
+type AuthLinuxDoOAuthCallbackParams struct {
+	Error            *string
+	ErrorDescription *string
+	Code             *string
+	State            *string
+	XForwardedProto  *string
+}
+
+// AuthLinuxDoOAuthCallback 对应 GET /api/v1/auth/oauth/linuxdo/callback
+//
+// 处理 OAuth 回调：创建/登录用户，然后重定向到前端。
+func (c *Client) AuthLinuxDoOAuthCallback(ctx context.Context, params *AuthLinuxDoOAuthCallbackParams) (*http.Response, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/auth/oauth/linuxdo/callback"}
+	if params != nil {
+		req.setQuery("error", params.Error)
+		req.setQuery("error_description", params.ErrorDescription)
+		req.setQuery("code", params.Code)
+		req.setQuery("state", params.State)
+		req.setHeader("X-Forwarded-Proto", params.XForwardedProto)
+	}
+	return c.stream(ctx, req)
+}
+
+// AuthLinuxDoOAuthStartParams AuthLinuxDoOAuthStart 的查询与请求头参数
+type AuthLinuxDoOAuthStartParams struct {
+	Redirect        *string
+	XForwardedProto *string
+}
+
+// AuthLinuxDoOAuthStart 对应 GET /api/v1/auth/oauth/linuxdo/start
+//
+// 启动 LinuxDo Connect OAuth 登录流程。
+func (c *Client) AuthLinuxDoOAuthStart(ctx context.Context, params *AuthLinuxDoOAuthStartParams) (*http.Response, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/auth/oauth/linuxdo/start"}
+	if params != nil {
+		req.setQuery("redirect", params.Redirect)
+		req.setHeader("X-Forwarded-Proto", params.XForwardedProto)
+	}
+	return c.stream(ctx, req)
+}
+
+// AuthRefreshToken 对应 POST /api/v1/auth/refresh
+//
+// 刷新Token
+func (c *Client) AuthRefreshToken(ctx context.Context, body *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/refresh", body: body}
+	var out *RefreshTokenResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthRegister 对应 POST /api/v1/auth/register
+//
+// User registration
+func (c *Client) AuthRegister(ctx context.Context, body *RegisterRequest) (*AuthResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/register", body: body}
+	var out *AuthResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthResetPassword 对应 POST /api/v1/auth/reset-password
+//
+// 重置密码
+func (c *Client) AuthResetPassword(ctx context.Context, body *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/reset-password", body: body}
+	var out *ResetPasswordResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthRevokeAllSessions 对应 POST /api/v1/auth/revoke-all-sessions
+//
+// 撤销当前用户的所有会话
+func (c *Client) AuthRevokeAllSessions(ctx context.Context) (*RevokeAllSessionsResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/revoke-all-sessions"}
+	var out *RevokeAllSessionsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthSendVerifyCode 对应 POST /api/v1/auth/send-verify-code
+//
+// 发送邮箱验证码
+func (c *Client) AuthSendVerifyCode(ctx context.Context, body *SendVerifyCodeRequest) (*SendVerifyCodeResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/send-verify-code", body: body}
+	var out *SendVerifyCodeResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthValidateInvitationCode 对应 POST /api/v1/auth/validate-invitation-code
+//
+// 验证邀请码（公开接口，注册前调用）
+func (c *Client) AuthValidateInvitationCode(ctx context.Context, body *ValidateInvitationCodeRequest) (*ValidateInvitationCodeResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/validate-invitation-code", body: body}
+	var out *ValidateInvitationCodeResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// AuthValidatePromoCode 对应 POST /api/v1/auth/validate-promo-code
+//
+// 验证优惠码（公开接口，注册前调用）
+func (c *Client) AuthValidatePromoCode(ctx context.Context, body *ValidatePromoCodeRequest) (*ValidatePromoCodeResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/auth/validate-promo-code", body: body}
+	var out *ValidatePromoCodeResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyGetAvailableGroups 对应 GET /api/v1/groups/available
+//
+// 获取用户可以绑定的分组列表
+func (c *Client) APIKeyGetAvailableGroups(ctx context.Context) ([]Group, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/groups/available"}
+	var out []Group
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyGetUserGroupRates 对应 GET /api/v1/groups/rates
+//
+// 获取当前用户的专属分组倍率配置
+func (c *Client) APIKeyGetUserGroupRates(ctx context.Context) (map[string]float64, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/groups/rates"}
+	var out map[string]float64
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyListParams APIKeyList 的查询与请求头参数
+type APIKeyListParams struct {
+	Page     *int64
+	PageSize *int64
+}
+
+// APIKeyList 对应 GET /api/v1/keys
+//
+// Listing user's API keys with pagination
+func (c *Client) APIKeyList(ctx context.Context, params *APIKeyListParams) (*Page[APIKey], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/keys"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+	}
+	var out *Page[APIKey]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyCreate 对应 POST /api/v1/keys
+//
+// Creating a new API key
+func (c *Client) APIKeyCreate(ctx context.Context, body *CreateAPIKeyRequest) (*APIKey, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/keys", body: body}
+	var out *APIKey
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyDelete 对应 DELETE /api/v1/keys/{id}
+//
+// Deleting an API key
+func (c *Client) APIKeyDelete(ctx context.Context, id int64) (*ApiKeyDeleteResponse, error) {
+	req := &request{method: http.MethodDelete, path: "/api/v1/keys/" + pathParam(id)}
+	var out *ApiKeyDeleteResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyGetByID 对应 GET /api/v1/keys/{id}
+//
+// Getting a single API key
+func (c *Client) APIKeyGetByID(ctx context.Context, id int64) (*APIKey, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/keys/" + pathParam(id)}
+	var out *APIKey
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// APIKeyUpdate 对应 PUT /api/v1/keys/{id}
+//
+// Updating an API key
+func (c *Client) APIKeyUpdate(ctx context.Context, id int64, body *UpdateAPIKeyRequest) (*APIKey, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/keys/" + pathParam(id), body: body}
+	var out *APIKey
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// ServeOpenAPI 对应 GET /api/v1/openapi.json
+//
+// 提供 OpenAPI 文档，无需认证
+func (c *Client) ServeOpenAPI(ctx context.Context) (json.RawMessage, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/openapi.json"}
+	var out json.RawMessage
+	err := c.call(ctx, req, false, &out)
+	return out, err
+}
+
+// RedeemRedeem 对应 POST /api/v1/redeem
+//
+// Redeeming a code
+func (c *Client) RedeemRedeem(ctx context.Context, body *RedeemRequest) (*RedeemCode, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/redeem", body: body}
+	var out *RedeemCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// RedeemGetHistory 对应 GET /api/v1/redeem/history
+//
+// Returns the user's redemption history
+func (c *Client) RedeemGetHistory(ctx context.Context) ([]RedeemCode, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/redeem/history"}
+	var out []RedeemCode
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// SettingGetPublicSettings 对应 GET /api/v1/settings/public
+//
+// 获取公开设置
+func (c *Client) SettingGetPublicSettings(ctx context.Context) (*PublicSettings, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/settings/public"}
+	var out *PublicSettings
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// SubscriptionList 对应 GET /api/v1/subscriptions
+//
+// Listing current user's subscriptions
+func (c *Client) SubscriptionList(ctx context.Context) ([]UserSubscription, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/subscriptions"}
+	var out []UserSubscription
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// SubscriptionGetActive 对应 GET /api/v1/subscriptions/active
+//
+// Getting current user's active subscriptions
+func (c *Client) SubscriptionGetActive(ctx context.Context) ([]UserSubscription, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/subscriptions/active"}
+	var out []UserSubscription
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// SubscriptionGetProgress 对应 GET /api/v1/subscriptions/progress
+//
+// Getting subscription progress for current user
+func (c *Client) SubscriptionGetProgress(ctx context.Context) ([]SubscriptionProgressInfo, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/subscriptions/progress"}
+	var out []SubscriptionProgressInfo
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// SubscriptionGetSummary 对应 GET /api/v1/subscriptions/summary
+//
+// Getting a summary of current user's subscription status
+func (c *Client) SubscriptionGetSummary(ctx context.Context) (*SubscriptionGetSummaryResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/subscriptions/summary"}
+	var out *SubscriptionGetSummaryResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageListParams UsageList 的查询与请求头参数
+type UsageListParams struct {
+	Page        *int64
+	PageSize    *int64
+	APIKeyID    *int64
+	Model       *string
+	Stream      *bool
+	BillingType *int64
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+}
+
+// UsageList 对应 GET /api/v1/usage
+//
+// Listing usage records with pagination
+func (c *Client) UsageList(ctx context.Context, params *UsageListParams) (*Page[UsageLog], error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/usage"}
+	if params != nil {
+		req.setQuery("page", params.Page)
+		req.setQuery("page_size", params.PageSize)
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("model", params.Model)
+		req.setQuery("stream", params.Stream)
+		req.setQuery("billing_type", params.BillingType)
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+	}
+	var out *Page[UsageLog]
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageDashboardAPIKeysUsage 对应 POST /api/v1/usage/dashboard/api-keys-usage
+//
+// Getting usage stats for user's own API keys
+func (c *Client) UsageDashboardAPIKeysUsage(ctx context.Context, body *HandlerBatchAPIKeysUsageRequest) (*UsageDashboardAPIKeysUsageResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/usage/dashboard/api-keys-usage", body: body}
+	var out *UsageDashboardAPIKeysUsageResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageDashboardModelsParams UsageDashboardModels 的查询与请求头参数
+type UsageDashboardModelsParams struct {
+	Timezone  *string
+	StartDate *string
+	EndDate   *string
+}
+
+// UsageDashboardModels 对应 GET /api/v1/usage/dashboard/models
+//
+// Getting user model usage statistics
+func (c *Client) UsageDashboardModels(ctx context.Context, params *UsageDashboardModelsParams) (*UsageDashboardModelsResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/usage/dashboard/models"}
+	if params != nil {
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+	}
+	var out *UsageDashboardModelsResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageDashboardStats 对应 GET /api/v1/usage/dashboard/stats
+//
+// Getting user dashboard statistics
+func (c *Client) UsageDashboardStats(ctx context.Context) (*UserDashboardStats, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/usage/dashboard/stats"}
+	var out *UserDashboardStats
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageDashboardTrendParams UsageDashboardTrend 的查询与请求头参数
+type UsageDashboardTrendParams struct {
+	Timezone    *string
+	StartDate   *string
+	EndDate     *string
+	Granularity *string
+}
+
+// UsageDashboardTrend 对应 GET /api/v1/usage/dashboard/trend
+//
+// Getting user usage trend data
+func (c *Client) UsageDashboardTrend(ctx context.Context, params *UsageDashboardTrendParams) (*UsageDashboardTrendResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/usage/dashboard/trend"}
+	if params != nil {
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("granularity", params.Granularity)
+	}
+	var out *UsageDashboardTrendResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageStatsParams UsageStats 的查询与请求头参数
+type UsageStatsParams struct {
+	APIKeyID  *int64
+	Timezone  *string
+	StartDate *string
+	EndDate   *string
+	Period    *string
+}
+
+// UsageStats 对应 GET /api/v1/usage/stats
+//
+// Getting usage statistics
+func (c *Client) UsageStats(ctx context.Context, params *UsageStatsParams) (*ServiceUsageStats, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/usage/stats"}
+	if params != nil {
+		req.setQuery("api_key_id", params.APIKeyID)
+		req.setQuery("timezone", params.Timezone)
+		req.setQuery("start_date", params.StartDate)
+		req.setQuery("end_date", params.EndDate)
+		req.setQuery("period", params.Period)
+	}
+	var out *ServiceUsageStats
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UsageGetByID 对应 GET /api/v1/usage/{id}
+//
+// Getting a single usage record
+func (c *Client) UsageGetByID(ctx context.Context, id int64) (*UsageLog, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/usage/" + pathParam(id)}
+	var out *UsageLog
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UserUpdateProfile 对应 PUT /api/v1/user
+//
+// Updating user profile
+func (c *Client) UserUpdateProfile(ctx context.Context, body *UpdateProfileRequest) (*DtoUser, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/user", body: body}
+	var out *DtoUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UserChangePassword 对应 PUT /api/v1/user/password
+//
+// Changing user password
+func (c *Client) UserChangePassword(ctx context.Context, body *ChangePasswordRequest) (*UserChangePasswordResponse, error) {
+	req := &request{method: http.MethodPut, path: "/api/v1/user/password", body: body}
+	var out *UserChangePasswordResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// UserGetProfile 对应 GET /api/v1/user/profile
+//
+// Getting user profile
+func (c *Client) UserGetProfile(ctx context.Context) (*DtoUser, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/user/profile"}
+	var out *DtoUser
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// TotpDisable 对应 POST /api/v1/user/totp/disable
+//
+// Disables TOTP for the current user
+func (c *Client) TotpDisable(ctx context.Context, body *TotpDisableRequest) (*TotpDisableResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/user/totp/disable", body: body}
+	var out *TotpDisableResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// TotpEnable 对应 POST /api/v1/user/totp/enable
+//
+// Completes the TOTP setup
+func (c *Client) TotpEnable(ctx context.Context, body *TotpEnableRequest) (*TotpEnableResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/user/totp/enable", body: body}
+	var out *TotpEnableResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// TotpSendVerifyCode 对应 POST /api/v1/user/totp/send-code
+//
+// Sends an email verification code for TOTP operations
+func (c *Client) TotpSendVerifyCode(ctx context.Context) (*TotpSendVerifyCodeResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/user/totp/send-code"}
+	var out *TotpSendVerifyCodeResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// TotpInitiateSetup 对应 POST /api/v1/user/totp/setup
+//
+// Starts the TOTP setup process
+func (c *Client) TotpInitiateSetup(ctx context.Context, body *TotpSetupRequest) (*TotpSetupResponse, error) {
+	req := &request{method: http.MethodPost, path: "/api/v1/user/totp/setup", body: body}
+	var out *TotpSetupResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// TotpGetStatus 对应 GET /api/v1/user/totp/status
+//
+// Returns the TOTP status for the current user
+func (c *Client) TotpGetStatus(ctx context.Context) (*TotpStatusResponse, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/user/totp/status"}
+	var out *TotpStatusResponse
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// TotpGetVerificationMethod 对应 GET /api/v1/user/totp/verification-method
+//
+// Returns the verification method for TOTP operations
+func (c *Client) TotpGetVerificationMethod(ctx context.Context) (*VerificationMethod, error) {
+	req := &request{method: http.MethodGet, path: "/api/v1/user/totp/verification-method"}
+	var out *VerificationMethod
+	err := c.call(ctx, req, true, &out)
+	return out, err
+}
+
+// GetHealth 对应 GET /health
+//
+// 健康检查（保留旧端点，仅表示进程存活）
+func (c *Client) GetHealth(ctx context.Context) (*GetHealthResponse, error) {
+	req := &request{method: http.MethodGet, path: "/health"}
+	var out *GetHealthResponse
+	err := c.call(ctx, req, false, &out)
+	return out, err
+}
+
+// HealthLivez 对应 GET /livez
+//
+// 存活检查：进程可以处理 HTTP 请求即视为存活，draining 期间同样返回 200，避免停机过程中被重启
+func (c *Client) HealthLivez(ctx context.Context) (*HealthLivezResponse, error) {
+	req := &request{method: http.MethodGet, path: "/livez"}
+	var out *HealthLivezResponse
+	err := c.call(ctx, req, false, &out)
+	return out, err
+}
+
+// HealthReadyz 对应 GET /readyz
+//
+// 就绪检查：依赖不可用或处于 draining 状态时返回 503
+func (c *Client) HealthReadyz(ctx context.Context) (*ReadinessReport, error) {
+	req := &request{method: http.MethodGet, path: "/readyz"}
+	var out *ReadinessReport
+	err := c.call(ctx, req, false, &out)
+	return out, err
+}
+
+// GetSetupStatus 对应 GET /setup/status
+//
+// Setup status endpoint (always returns needs_setup: false in normal mode)
+func (c *Client) GetSetupStatus(ctx context.Context) (*GetSetupStatusResponse, error) {
+	req := &request{method: http.MethodGet, path: "/setup/status"}
+	var out *GetSetupStatusResponse
+	err := c.call(ctx, req, false, &out)
+	return out, err
+}
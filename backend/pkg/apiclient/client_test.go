@@ -0,0 +1,91 @@
+package apiclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCall(t *testing.T) {
+	var got *http.Request
+	var gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		raw, _ := io.ReadAll(r.Body)
+		gotBody = string(raw)
+		switch r.URL.Path {
+		case "/api/v1/items":
+			_, _ = io.WriteString(w, `{"code":0,"message":"success","data":{"items":[{"id":1}],"total":1,"page":2,"page_size":10,"pages":1}}`)
+		case "/api/v1/fail":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, `{"code":403,"message":"forbidden","reason":"ADMIN_ONLY","metadata":{"k":"v"}}`)
+		case "/api/v1/soft-fail":
+			_, _ = io.WriteString(w, `{"code":1001,"message":"quota exceeded"}`)
+		case "/plain":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = io.WriteString(w, "upstream down")
+		default:
+			_, _ = io.WriteString(w, `{"status":"ok"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL+"/", WithAdminAPIKey("admin-key"), WithHeader("X-Trace", "t1"))
+	ctx := context.Background()
+
+	req := &request{method: http.MethodPost, path: "/api/v1/items", body: map[string]int{"n": 1}}
+	req.setQuery("page", Ptr[int64](2))
+	req.setQuery("search", (*string)(nil))
+	req.setQuery("ids", []int64{1, 2})
+	req.setHeader("X-Backup-Passphrase", Ptr("secret"))
+	var page *Page[struct {
+		ID int64 `json:"id"`
+	}]
+	require.NoError(t, c.call(ctx, req, true, &page))
+	require.Equal(t, int64(1), page.Total)
+	require.Equal(t, int64(1), page.Items[0].ID)
+	require.Equal(t, "ids=1&ids=2&page=2", got.URL.RawQuery)
+	require.Equal(t, "admin-key", got.Header.Get("x-api-key"))
+	require.Equal(t, "t1", got.Header.Get("X-Trace"))
+	require.Equal(t, "secret", got.Header.Get("X-Backup-Passphrase"))
+	require.Equal(t, "application/json", got.Header.Get("Content-Type"))
+	require.JSONEq(t, `{"n":1}`, gotBody)
+
+	err := c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/fail"}, true, nil)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	require.Equal(t, "ADMIN_ONLY", apiErr.Reason)
+	require.Equal(t, map[string]string{"k": "v"}, apiErr.Metadata)
+
+	err = c.call(ctx, &request{method: http.MethodGet, path: "/api/v1/soft-fail"}, true, nil)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 1001, apiErr.Code)
+	require.Equal(t, http.StatusOK, apiErr.StatusCode)
+
+	_, err = c.stream(ctx, &request{method: http.MethodGet, path: "/plain"})
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "upstream down", apiErr.Message)
+
+	var health struct {
+		Status string `json:"status"`
+	}
+	require.NoError(t, c.call(ctx, &request{method: http.MethodGet, path: "/health"}, false, &health))
+	require.Equal(t, "ok", health.Status)
+
+	resp, err := c.stream(ctx, &request{method: http.MethodPost, path: "/upload", raw: strings.NewReader("raw-bytes"), contentType: "application/octet-stream"})
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, "raw-bytes", gotBody)
+	require.Equal(t, "application/octet-stream", got.Header.Get("Content-Type"))
+}
+
+func TestPathParam(t *testing.T) {
+	require.Equal(t, "42", pathParam(int64(42)))
+	require.Equal(t, "a%2Fb", pathParam("a/b"))
+}
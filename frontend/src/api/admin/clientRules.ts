@@ -0,0 +1,133 @@
+/**
+ * Admin Client Rules API endpoints
+ * Handles per-group client allowlist rule management for administrators
+ */
+
+import { apiClient } from '../client'
+
+export type ClientPreset = '' | 'claude_code' | 'codex_cli' | 'gemini_cli'
+
+/**
+ * Client rule interface
+ */
+export interface ClientRule {
+  id: number
+  name: string
+  enabled: boolean
+  priority: number
+  group_ids: number[]
+  preset: ClientPreset
+  user_agent_pattern: string | null
+  required_headers: string[]
+  metadata_user_id_pattern: string | null
+  body_fields: string[]
+  fallback_group_id: number | null
+  description: string | null
+  rejected_count: number
+  created_at: string
+  updated_at: string
+}
+
+/**
+ * Create rule request
+ */
+export interface CreateClientRuleRequest {
+  name: string
+  enabled?: boolean
+  priority?: number
+  group_ids?: number[]
+  preset?: ClientPreset
+  user_agent_pattern?: string | null
+  required_headers?: string[]
+  metadata_user_id_pattern?: string | null
+  body_fields?: string[]
+  fallback_group_id?: number | null
+  description?: string | null
+}
+
+/**
+ * Update rule request
+ */
+export type UpdateClientRuleRequest = Partial<CreateClientRuleRequest>
+
+/**
+ * List all client rules
+ * @returns List of all rules sorted by priority, with rejection counters
+ */
+export async function list(): Promise<ClientRule[]> {
+  const { data } = await apiClient.get<ClientRule[]>('/admin/client-rules')
+  return data
+}
+
+/**
+ * Get rule by ID
+ * @param id - Rule ID
+ * @returns Rule details
+ */
+export async function getById(id: number): Promise<ClientRule> {
+  const { data } = await apiClient.get<ClientRule>(`/admin/client-rules/${id}`)
+  return data
+}
+
+/**
+ * Create new rule
+ * @param ruleData - Rule data
+ * @returns Created rule
+ */
+export async function create(ruleData: CreateClientRuleRequest): Promise<ClientRule> {
+  const { data } = await apiClient.post<ClientRule>('/admin/client-rules', ruleData)
+  return data
+}
+
+/**
+ * Update rule
+ * @param id - Rule ID
+ * @param updates - Fields to update
+ * @returns Updated rule
+ */
+export async function update(id: number, updates: UpdateClientRuleRequest): Promise<ClientRule> {
+  const { data } = await apiClient.put<ClientRule>(`/admin/client-rules/${id}`, updates)
+  return data
+}
+
+/**
+ * Delete rule
+ * @param id - Rule ID
+ * @returns Success confirmation
+ */
+export async function deleteRule(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/client-rules/${id}`)
+  return data
+}
+
+/**
+ * Toggle rule enabled status
+ * @param id - Rule ID
+ * @param enabled - New enabled status
+ * @returns Updated rule
+ */
+export async function toggleEnabled(id: number, enabled: boolean): Promise<ClientRule> {
+  return update(id, { enabled })
+}
+
+/**
+ * Reset rejected request counter
+ * @param id - Rule ID
+ * @returns Success confirmation
+ */
+export async function resetCounter(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.post<{ message: string }>(`/admin/client-rules/${id}/reset-counter`)
+  return data
+}
+
+export const clientRulesAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deleteRule,
+  toggleEnabled,
+  resetCounter
+}
+
+export default clientRulesAPI
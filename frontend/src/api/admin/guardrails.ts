@@ -0,0 +1,121 @@
+/**
+ * Admin Guardrails API endpoints
+ * Handles content guardrail rule management for administrators
+ */
+
+import { apiClient } from '../client'
+
+export type GuardrailMatchType = 'keyword' | 'regex' | 'pii'
+
+export type GuardrailAction = 'block' | 'mask' | 'flag'
+
+export type GuardrailPIIType = 'email' | 'phone' | 'card' | 'api_key'
+
+/**
+ * Guardrail rule interface
+ */
+export interface GuardrailRule {
+  id: number
+  name: string
+  enabled: boolean
+  priority: number
+  group_ids: number[]
+  match_type: GuardrailMatchType
+  patterns: string[]
+  action: GuardrailAction
+  block_message: string | null
+  description: string | null
+  created_at: string
+  updated_at: string
+}
+
+/**
+ * Create rule request
+ */
+export interface CreateGuardrailRuleRequest {
+  name: string
+  enabled?: boolean
+  priority?: number
+  group_ids?: number[]
+  match_type: GuardrailMatchType
+  patterns?: string[]
+  action?: GuardrailAction
+  block_message?: string | null
+  description?: string | null
+}
+
+/**
+ * Update rule request
+ */
+export type UpdateGuardrailRuleRequest = Partial<CreateGuardrailRuleRequest>
+
+/**
+ * List all guardrail rules
+ * @returns List of all rules sorted by priority
+ */
+export async function list(): Promise<GuardrailRule[]> {
+  const { data } = await apiClient.get<GuardrailRule[]>('/admin/guardrail-rules')
+  return data
+}
+
+/**
+ * Get rule by ID
+ * @param id - Rule ID
+ * @returns Rule details
+ */
+export async function getById(id: number): Promise<GuardrailRule> {
+  const { data } = await apiClient.get<GuardrailRule>(`/admin/guardrail-rules/${id}`)
+  return data
+}
+
+/**
+ * Create new rule
+ * @param ruleData - Rule data
+ * @returns Created rule
+ */
+export async function create(ruleData: CreateGuardrailRuleRequest): Promise<GuardrailRule> {
+  const { data } = await apiClient.post<GuardrailRule>('/admin/guardrail-rules', ruleData)
+  return data
+}
+
+/**
+ * Update rule
+ * @param id - Rule ID
+ * @param updates - Fields to update
+ * @returns Updated rule
+ */
+export async function update(id: number, updates: UpdateGuardrailRuleRequest): Promise<GuardrailRule> {
+  const { data } = await apiClient.put<GuardrailRule>(`/admin/guardrail-rules/${id}`, updates)
+  return data
+}
+
+/**
+ * Delete rule
+ * @param id - Rule ID
+ * @returns Success confirmation
+ */
+export async function deleteRule(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/guardrail-rules/${id}`)
+  return data
+}
+
+/**
+ * Toggle rule enabled status
+ * @param id - Rule ID
+ * @param enabled - New enabled status
+ * @returns Updated rule
+ */
+export async function toggleEnabled(id: number, enabled: boolean): Promise<GuardrailRule> {
+  return update(id, { enabled })
+}
+
+export const guardrailsAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deleteRule,
+  toggleEnabled
+}
+
+export default guardrailsAPI
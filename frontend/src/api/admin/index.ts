@@ -20,6 +20,10 @@ import antigravityAPI from './antigravity'
 import userAttributesAPI from './userAttributes'
 import opsAPI from './ops'
 import errorPassthroughAPI from './errorPassthrough'
+import requestPoliciesAPI from './requestPolicies'
+import guardrailsAPI from './guardrails'
+import clientRulesAPI from './clientRules'
+import routingRulesAPI from './routingRules'
 
 /**
  * Unified admin API object for convenient access
@@ -41,7 +45,11 @@ export const adminAPI = {
   antigravity: antigravityAPI,
   userAttributes: userAttributesAPI,
   ops: opsAPI,
-  errorPassthrough: errorPassthroughAPI
+  errorPassthrough: errorPassthroughAPI,
+  requestPolicies: requestPoliciesAPI,
+  guardrails: guardrailsAPI,
+  clientRules: clientRulesAPI,
+  routingRules: routingRulesAPI
 }
 
 export {
@@ -61,7 +69,11 @@ export {
   antigravityAPI,
   userAttributesAPI,
   opsAPI,
-  errorPassthroughAPI
+  errorPassthroughAPI,
+  requestPoliciesAPI,
+  guardrailsAPI,
+  clientRulesAPI,
+  routingRulesAPI
 }
 
 export default adminAPI
@@ -69,3 +81,23 @@ export default adminAPI
 // Re-export types used by components
 export type { BalanceHistoryItem } from './users'
 export type { ErrorPassthroughRule, CreateRuleRequest, UpdateRuleRequest } from './errorPassthrough'
+export type {
+  RequestPolicy,
+  CreateRequestPolicyRequest,
+  UpdateRequestPolicyRequest
+} from './requestPolicies'
+export type {
+  GuardrailRule,
+  CreateGuardrailRuleRequest,
+  UpdateGuardrailRuleRequest
+} from './guardrails'
+export type {
+  ClientRule,
+  CreateClientRuleRequest,
+  UpdateClientRuleRequest
+} from './clientRules'
+export type {
+  RoutingRule,
+  CreateRoutingRuleRequest,
+  UpdateRoutingRuleRequest
+} from './routingRules'
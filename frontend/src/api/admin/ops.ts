@@ -91,6 +91,8 @@ export interface OpsDashboardOverview {
 
   system_metrics?: OpsSystemMetricsSnapshot | null
   job_heartbeats?: OpsJobHeartbeat[] | null
+  usage_outbox?: OpsUsageOutboxStats | null
+  fair_queue?: OpsFairQueueStats | null
 
   success_count: number
   error_count_total: number
@@ -299,6 +301,34 @@ export interface OpsJobHeartbeat {
   updated_at: string
 }
 
+export interface OpsUsageOutboxStats {
+  enabled: boolean
+  backlog: number
+  pending: number
+  oldest_event_age_seconds: number
+  dead_letters: number
+  spooled: number
+  published: number
+  fallbacks: number
+  applied: number
+  duplicates: number
+  retries: number
+  dead_lettered: number
+  last_applied_at?: string | null
+}
+
+export interface OpsFairQueueGroupStats {
+  group_id: number
+  depth: number
+  avg_admit_interval_ms: number
+  estimated_wait_seconds: number
+}
+
+export interface OpsFairQueueStats {
+  enabled: boolean
+  groups: OpsFairQueueGroupStats[]
+}
+
 export interface PlatformConcurrencyInfo {
   platform: string
   current_in_use: number
@@ -466,6 +496,16 @@ export async function getRealtimeTrafficSummary(
   return data
 }
 
+export async function getUsageOutboxStats(): Promise<OpsUsageOutboxStats> {
+  const { data } = await apiClient.get<OpsUsageOutboxStats>('/admin/ops/usage-outbox')
+  return data
+}
+
+export async function getFairQueueStats(): Promise<OpsFairQueueStats> {
+  const { data } = await apiClient.get<OpsFairQueueStats>('/admin/ops/fair-queue')
+  return data
+}
+
 /**
  * Subscribe to realtime QPS updates via WebSocket.
  *
@@ -877,6 +917,9 @@ export interface OpsErrorDetail extends OpsErrorLog {
   request_body_bytes?: number | null
 
   is_business_limited: boolean
+
+  // OpenTelemetry trace ID (only when tracing is enabled and the request was sampled)
+  trace_id?: string
 }
 
 export type OpsErrorLogsResponse = PaginatedResponse<OpsErrorLog>
@@ -1192,6 +1235,8 @@ export const opsAPI = {
   getUserConcurrencyStats,
   getAccountAvailabilityStats,
   getRealtimeTrafficSummary,
+  getUsageOutboxStats,
+  getFairQueueStats,
   subscribeQPS,
 
   // Legacy unified endpoints
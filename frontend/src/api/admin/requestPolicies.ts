@@ -0,0 +1,132 @@
+/**
+ * Admin Request Policies API endpoints
+ * Handles per-group request parameter policy management for administrators
+ */
+
+import { apiClient } from '../client'
+
+export type RequestPolicyKind =
+  | 'max_tokens'
+  | 'thinking_budget'
+  | 'anthropic_beta_deny'
+  | 'anthropic_beta_force'
+  | 'server_tools'
+  | 'reasoning_effort'
+  | 'image_input'
+
+export type RequestPolicyAction = 'reject' | 'modify' | 'warn'
+
+/**
+ * Request policy interface
+ */
+export interface RequestPolicy {
+  id: number
+  name: string
+  enabled: boolean
+  priority: number
+  group_ids: number[]
+  protocols: string[]
+  kind: RequestPolicyKind
+  action: RequestPolicyAction
+  dry_run: boolean
+  limit_value: number | null
+  params: string[]
+  reject_message: string | null
+  description: string | null
+  created_at: string
+  updated_at: string
+}
+
+/**
+ * Create policy request
+ */
+export interface CreateRequestPolicyRequest {
+  name: string
+  enabled?: boolean
+  priority?: number
+  group_ids?: number[]
+  protocols?: string[]
+  kind: RequestPolicyKind
+  action?: RequestPolicyAction
+  dry_run?: boolean
+  limit_value?: number | null
+  params?: string[]
+  reject_message?: string | null
+  description?: string | null
+}
+
+/**
+ * Update policy request
+ */
+export type UpdateRequestPolicyRequest = Partial<CreateRequestPolicyRequest>
+
+/**
+ * List all request policies
+ * @returns List of all policies sorted by priority
+ */
+export async function list(): Promise<RequestPolicy[]> {
+  const { data } = await apiClient.get<RequestPolicy[]>('/admin/request-policies')
+  return data
+}
+
+/**
+ * Get policy by ID
+ * @param id - Policy ID
+ * @returns Policy details
+ */
+export async function getById(id: number): Promise<RequestPolicy> {
+  const { data } = await apiClient.get<RequestPolicy>(`/admin/request-policies/${id}`)
+  return data
+}
+
+/**
+ * Create new policy
+ * @param policyData - Policy data
+ * @returns Created policy
+ */
+export async function create(policyData: CreateRequestPolicyRequest): Promise<RequestPolicy> {
+  const { data } = await apiClient.post<RequestPolicy>('/admin/request-policies', policyData)
+  return data
+}
+
+/**
+ * Update policy
+ * @param id - Policy ID
+ * @param updates - Fields to update
+ * @returns Updated policy
+ */
+export async function update(id: number, updates: UpdateRequestPolicyRequest): Promise<RequestPolicy> {
+  const { data } = await apiClient.put<RequestPolicy>(`/admin/request-policies/${id}`, updates)
+  return data
+}
+
+/**
+ * Delete policy
+ * @param id - Policy ID
+ * @returns Success confirmation
+ */
+export async function deletePolicy(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/request-policies/${id}`)
+  return data
+}
+
+/**
+ * Toggle policy enabled status
+ * @param id - Policy ID
+ * @param enabled - New enabled status
+ * @returns Updated policy
+ */
+export async function toggleEnabled(id: number, enabled: boolean): Promise<RequestPolicy> {
+  return update(id, { enabled })
+}
+
+export const requestPoliciesAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deletePolicy,
+  toggleEnabled
+}
+
+export default requestPoliciesAPI
@@ -0,0 +1,119 @@
+/**
+ * Admin Routing Rules API endpoints
+ * Handles per-group request routing rule management for administrators
+ */
+
+import { apiClient } from '../client'
+
+/**
+ * Routing rule interface
+ */
+export interface RoutingRule {
+  id: number
+  name: string
+  enabled: boolean
+  priority: number
+  group_ids: number[]
+  model_patterns: string[]
+  max_tokens_below: number | null
+  require_no_tools: boolean
+  input_tokens_below: number | null
+  target_group_id: number | null
+  target_account_ids: number[]
+  description: string | null
+  created_at: string
+  updated_at: string
+}
+
+/**
+ * Create rule request
+ */
+export interface CreateRoutingRuleRequest {
+  name: string
+  enabled?: boolean
+  priority?: number
+  group_ids?: number[]
+  model_patterns?: string[]
+  max_tokens_below?: number | null
+  require_no_tools?: boolean
+  input_tokens_below?: number | null
+  target_group_id?: number | null
+  target_account_ids?: number[]
+  description?: string | null
+}
+
+/**
+ * Update rule request
+ */
+export type UpdateRoutingRuleRequest = Partial<CreateRoutingRuleRequest>
+
+/**
+ * List all routing rules
+ * @returns List of all rules sorted by priority
+ */
+export async function list(): Promise<RoutingRule[]> {
+  const { data } = await apiClient.get<RoutingRule[]>('/admin/routing-rules')
+  return data
+}
+
+/**
+ * Get rule by ID
+ * @param id - Rule ID
+ * @returns Rule details
+ */
+export async function getById(id: number): Promise<RoutingRule> {
+  const { data } = await apiClient.get<RoutingRule>(`/admin/routing-rules/${id}`)
+  return data
+}
+
+/**
+ * Create new rule
+ * @param ruleData - Rule data
+ * @returns Created rule
+ */
+export async function create(ruleData: CreateRoutingRuleRequest): Promise<RoutingRule> {
+  const { data } = await apiClient.post<RoutingRule>('/admin/routing-rules', ruleData)
+  return data
+}
+
+/**
+ * Update rule
+ * @param id - Rule ID
+ * @param updates - Fields to update
+ * @returns Updated rule
+ */
+export async function update(id: number, updates: UpdateRoutingRuleRequest): Promise<RoutingRule> {
+  const { data } = await apiClient.put<RoutingRule>(`/admin/routing-rules/${id}`, updates)
+  return data
+}
+
+/**
+ * Delete rule
+ * @param id - Rule ID
+ * @returns Success confirmation
+ */
+export async function deleteRule(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/routing-rules/${id}`)
+  return data
+}
+
+/**
+ * Toggle rule enabled status
+ * @param id - Rule ID
+ * @param enabled - New enabled status
+ * @returns Updated rule
+ */
+export async function toggleEnabled(id: number, enabled: boolean): Promise<RoutingRule> {
+  return update(id, { enabled })
+}
+
+export const routingRulesAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deleteRule,
+  toggleEnabled
+}
+
+export default routingRulesAPI
@@ -70,12 +70,96 @@ export async function restartService(): Promise<{ message: string }> {
   return data
 }
 
+export interface ClusterLeaderLock {
+  key: string
+  held: boolean
+  last_acquired_at: string
+  expires_at: string
+  released_at?: string
+}
+
+export interface ClusterNode {
+  node_id: string
+  host: string
+  pid: number
+  address: string
+  version: string
+  build_type: string
+  go_version: string
+  started_at: string
+  last_heartbeat_at: string
+  draining: boolean
+  restarting: boolean
+  in_flight_requests: number
+  goroutines: number
+  locks: ClusterLeaderLock[]
+  self: boolean
+}
+
+export interface ClusterNodesResponse {
+  self_node_id: string
+  nodes: ClusterNode[]
+}
+
+export type ClusterNodeAction = 'drain' | 'undrain' | 'restart'
+
+export interface ClusterRollingRestartStatus {
+  id: string
+  state: 'running' | 'completed' | 'failed'
+  initiated_by: string
+  node_ids: string[]
+  completed: number
+  current_node?: string
+  error?: string
+  started_at: string
+  finished_at?: string
+}
+
+/**
+ * List gateway instances registered in the cluster node registry
+ */
+export async function listNodes(): Promise<ClusterNodesResponse> {
+  const { data } = await apiClient.get<ClusterNodesResponse>('/admin/system/nodes')
+  return data
+}
+
+/**
+ * Send drain / undrain / restart to a node
+ */
+export async function sendNodeCommand(nodeId: string, action: ClusterNodeAction): Promise<{ message: string }> {
+  const { data } = await apiClient.post<{ message: string }>(
+    `/admin/system/nodes/${encodeURIComponent(nodeId)}/command`,
+    { action }
+  )
+  return data
+}
+
+/**
+ * Get the latest rolling restart status (null if none)
+ */
+export async function getRollingRestart(): Promise<ClusterRollingRestartStatus | null> {
+  const { data } = await apiClient.get<ClusterRollingRestartStatus | null>('/admin/system/rolling-restart')
+  return data
+}
+
+/**
+ * Restart all nodes one at a time
+ */
+export async function startRollingRestart(): Promise<ClusterRollingRestartStatus> {
+  const { data } = await apiClient.post<ClusterRollingRestartStatus>('/admin/system/rolling-restart')
+  return data
+}
+
 export const systemAPI = {
   getVersion,
   checkUpdates,
   performUpdate,
   rollback,
-  restartService
+  restartService,
+  listNodes,
+  sendNodeCommand,
+  getRollingRestart,
+  startRollingRestart
 }
 
 export default systemAPI
@@ -27,6 +27,8 @@ export interface DatabaseConfig {
 }
 
 export interface RedisConfig {
+  // embedded: 使用进程内缓存，无需外部 Redis（仅单节点部署）
+  mode?: 'external' | 'embedded'
   host: string
   port: number
   password: string
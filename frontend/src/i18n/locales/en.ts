@@ -140,7 +140,10 @@ export default {
       database: 'Database',
       passwordPlaceholder: 'Password',
       enableTls: 'Enable TLS',
-      enableTlsHint: 'Use TLS when connecting to Redis (public CA certs)'
+      enableTlsHint: 'Use TLS when connecting to Redis (public CA certs)',
+      embedded: 'Use embedded cache (no Redis)',
+      embeddedHint: 'Single-node only: cache, rate limits and queues live in memory and reset on restart',
+      embeddedSummary: 'Embedded (single-node)'
     },
     admin: {
       title: 'Admin Account',
@@ -432,7 +435,8 @@ export default {
 
   // Groups (shared)
   groups: {
-    subscription: 'Sub'
+    subscription: 'Sub',
+    modelRates: 'Model rates: {rates}'
   },
 
   // API Keys
@@ -907,6 +911,9 @@ export default {
       failedToLoadApiKeys: 'Failed to load user API keys',
       emailRequired: 'Please enter email',
       concurrencyMin: 'Concurrency must be at least 1',
+      queueWeight: 'Queue Weight',
+      queueWeightHint: 'Weight in the group fair queue when all accounts are busy; higher weight waits less',
+      queueWeightRange: 'Queue weight must be between 1 and 100',
       amountRequired: 'Please enter a valid amount',
       insufficientBalance: 'Insufficient balance',
       deleteConfirm: "Are you sure you want to delete '{email}'? This action cannot be undone.",
@@ -1110,6 +1117,28 @@ export default {
         "Are you sure you want to delete '{name}'? All associated API keys will no longer belong to any group.",
       deleteConfirmSubscription:
         "Are you sure you want to delete subscription group '{name}'? This will invalidate all API keys bound to this subscription and delete all related subscription records. This action cannot be undone.",
+      schedulingStrategy: {
+        title: 'Scheduling Strategy',
+        hint: 'How accounts in this group are picked among the same priority. Quota-exhausted accounts are always skipped first.',
+        inherit: 'Use global default',
+        default: 'Default (load rate, then least recently used)',
+        weightedRoundRobin: 'Weighted round robin (by concurrency)',
+        leastCost: 'Least cost in window',
+        leastLatency: 'Lowest first-token latency',
+        fillFirst: 'Fill first (drain one account before the next)'
+      },
+      modelRateMultipliers: {
+        title: 'Model Rate Multipliers',
+        hint: 'Applied on top of the group rate for matching models. Patterns support a trailing * wildcard; the longest match wins.',
+        modelPlaceholder: 'Model, e.g. claude-opus-*',
+        multiplierPlaceholder: 'Multiplier',
+        add: 'Add model multiplier',
+        invalid: 'Model rate multipliers need a model and a multiplier of at least 0'
+      },
+      firstByteTimeout: {
+        title: 'First Byte Timeout (seconds)',
+        hint: 'For streaming requests, switch to another account if the upstream sends nothing within this time. 0 uses the global setting.'
+      },
       subscription: {
         title: 'Subscription Settings',
         type: 'Billing Type',
@@ -1567,6 +1596,10 @@ export default {
       interceptWarmupRequests: 'Intercept Warmup Requests',
       interceptWarmupRequestsDesc:
         'When enabled, warmup requests like title generation will return mock responses without consuming upstream tokens',
+      maxContextTokens: 'Context Window (tokens)',
+      maxContextTokensPlaceholder: 'Default 200000',
+      maxContextTokensHint:
+        'Largest input this account can accept. Set to 1000000 for accounts with the 1M context beta; requests estimated above the window only go to accounts that can hold them, and are rejected when none can',
       autoPauseOnExpired: 'Auto Pause On Expired',
       autoPauseOnExpiredDesc: 'When enabled, the account will auto pause scheduling after it expires',
       // Quota control (Anthropic OAuth/SetupToken only)
@@ -2670,6 +2703,7 @@ export default {
         },
         loading: 'Loading…',
         requestId: 'Request ID',
+        traceId: 'Trace ID',
         time: 'Time',
         phase: 'Phase',
         status: 'Status',
@@ -2764,6 +2798,45 @@ export default {
           actions: 'Actions'
         }
       },
+      cluster: {
+        title: 'Cluster Nodes',
+        description: 'Gateway instances reporting heartbeats to the node registry',
+        empty: 'No nodes registered',
+        loadFailed: 'Failed to load cluster nodes',
+        commandSent: 'Command sent',
+        commandFailed: 'Failed to send command',
+        self: 'This node',
+        rollingRestart: 'Restart all sequentially',
+        rollingStarted: 'Rolling restart started',
+        rollingProgress: 'Rolling restart in progress: {done}/{total} nodes restarted',
+        rollingFailed: 'Last rolling restart failed: {error}',
+        rollingCompleted: 'Last rolling restart completed at {time}',
+        status: {
+          serving: 'Serving',
+          draining: 'Draining',
+          restarting: 'Restarting'
+        },
+        actions: {
+          drain: 'Drain',
+          undrain: 'Undrain',
+          restart: 'Restart'
+        },
+        confirm: {
+          drain: 'Stop routing new requests to {host}? In-flight requests will finish normally.',
+          undrain: 'Resume routing new requests to {host}?',
+          restart: 'Restart {host}? It drains first and waits for in-flight requests before exiting; a process supervisor must bring it back.',
+          rolling: 'Restart all {count} nodes one at a time? Each node drains and must rejoin before the next one restarts; this node restarts last.'
+        },
+        table: {
+          node: 'Node',
+          version: 'Version',
+          startedAt: 'Started',
+          status: 'Status',
+          inFlight: 'In-flight',
+          locks: 'Leader Locks',
+          actions: 'Actions'
+        }
+      },
       alertEvents: {
         title: 'Alert Events',
         description: 'Recent alert firing/resolution records (email-only)',
@@ -3088,6 +3161,7 @@ export default {
         disabledHint: 'Realtime monitoring is disabled in settings.',
         empty: 'No data',
         queued: 'Queue {count}',
+        fairQueued: 'Fair queue {count} · ~{seconds}s',
         rateLimited: 'Rate-limited {count}',
         errorAccounts: 'Errors {count}',
         loadFailed: 'Failed to load concurrency data'
@@ -3352,6 +3426,254 @@ export default {
       failedToSendTestEmail: 'Failed to send test email'
     },
 
+    // Routing Rules
+    routingRules: {
+      title: 'Routing Rules',
+      description: 'Send cheap or background requests (title generation, probes) to another group or account subset so premium accounts serve real work',
+      createRule: 'Create Rule',
+      editRule: 'Edit Rule',
+      deleteRule: 'Delete Rule',
+      noRules: 'No routing rules configured',
+      createFirstRule: 'Create a rule to route haiku or small max_tokens requests to a cheaper pool',
+      allGroups: 'All groups',
+      noTools: 'no tools',
+      accountCount: '{count} accounts',
+      columns: {
+        priority: 'Priority',
+        name: 'Name',
+        conditions: 'Conditions',
+        scope: 'Groups',
+        target: 'Target',
+        status: 'Status',
+        actions: 'Actions'
+      },
+      form: {
+        name: 'Rule Name',
+        priority: 'Priority',
+        priorityHint: 'Lower values run first; the first matching rule wins',
+        description: 'Description',
+        conditionsHint: 'All configured conditions must match',
+        modelPatterns: 'Models',
+        modelPatternsHint: 'One per line; exact name or prefix ending with *; leave empty for any model',
+        maxTokensBelow: 'max_tokens below',
+        inputTokensBelow: 'Estimated input tokens below',
+        thresholdHint: 'Leave empty to ignore; requests without max_tokens never match the max_tokens condition',
+        requireNoTools: 'Only requests without tool definitions',
+        groups: 'Groups',
+        groupsHint: 'Leave empty to apply to all groups',
+        targetGroup: 'Target Group',
+        targetGroupHint: 'Requests are scheduled and billed in this group; must be an active, non-subscription group on the same platform. Requests from subscription groups are never moved',
+        sameGroup: 'Same group',
+        targetAccounts: 'Target Accounts',
+        targetAccountsHint: 'Requests are scheduled only on these accounts in the (target) group; no fallback to other accounts when all are unavailable',
+        searchAccountPlaceholder: 'Search accounts...',
+        enabled: 'Enabled'
+      },
+      nameRequired: 'Please enter a rule name',
+      ruleCreated: 'Rule created',
+      ruleUpdated: 'Rule updated',
+      ruleDeleted: 'Rule deleted',
+      deleteConfirm: 'Delete rule "{name}"?',
+      failedToLoad: 'Failed to load routing rules',
+      failedToSave: 'Failed to save rule',
+      failedToToggle: 'Failed to update rule status',
+      failedToDelete: 'Failed to delete rule'
+    },
+
+    // Client Rules
+    clientRules: {
+      title: 'Client Rules',
+      description: 'Only allow requests from specific clients per group; non-matching requests are rejected or moved to a fallback group',
+      createRule: 'Create Rule',
+      editRule: 'Edit Rule',
+      deleteRule: 'Delete Rule',
+      noRules: 'No client rules configured',
+      createFirstRule: 'Create a rule to restrict a group to Claude Code, Codex CLI or a custom client',
+      allGroups: 'All groups',
+      reject: 'Reject (403)',
+      resetCounter: 'Reset counter',
+      columns: {
+        priority: 'Priority',
+        name: 'Name',
+        match: 'Client',
+        scope: 'Groups',
+        fallback: 'On Mismatch',
+        rejected: 'Rejected',
+        status: 'Status',
+        actions: 'Actions'
+      },
+      presets: {
+        none: 'None (custom matchers only)',
+        claude_code: 'Claude Code',
+        codex_cli: 'Codex CLI',
+        gemini_cli: 'Gemini CLI'
+      },
+      form: {
+        name: 'Rule Name',
+        priority: 'Priority',
+        priorityHint: 'Lower values run first',
+        description: 'Description',
+        matchHint: 'A request matches when the preset and every configured matcher pass; it is allowed if any rule for its group matches',
+        preset: 'Preset',
+        userAgentPattern: 'User-Agent Regex',
+        metadataUserIdPattern: 'metadata.user_id Regex',
+        requiredHeaders: 'Required Headers',
+        requiredHeadersHint: 'One per line: "Name" or "Name: regex"',
+        bodyFields: 'Required Body Fields',
+        bodyFieldsHint: 'One JSON path per line, e.g. metadata.user_id',
+        groups: 'Groups',
+        groupsHint: 'Leave empty to apply to all groups',
+        fallbackGroup: 'Fallback Group',
+        fallbackGroupHint: 'Mismatched requests are routed to this group instead of being rejected; must be an active, non-subscription group on the same platform',
+        enabled: 'Enabled'
+      },
+      nameRequired: 'Please enter a rule name',
+      ruleCreated: 'Rule created',
+      ruleUpdated: 'Rule updated',
+      ruleDeleted: 'Rule deleted',
+      deleteConfirm: 'Delete rule "{name}"?',
+      failedToLoad: 'Failed to load client rules',
+      failedToSave: 'Failed to save rule',
+      failedToToggle: 'Failed to update rule status',
+      failedToReset: 'Failed to reset counter',
+      failedToDelete: 'Failed to delete rule'
+    },
+
+    // Content Guardrails
+    guardrails: {
+      title: 'Content Guardrails',
+      description: 'Scan system prompts, messages and tool results before forwarding; block, mask or flag matches per group',
+      createRule: 'Create Rule',
+      editRule: 'Edit Rule',
+      deleteRule: 'Delete Rule',
+      noRules: 'No guardrail rules configured',
+      createFirstRule: 'Create a rule to block prohibited content or redact PII',
+      allGroups: 'All groups',
+      columns: {
+        priority: 'Priority',
+        name: 'Name',
+        match: 'Match',
+        scope: 'Groups',
+        action: 'Action',
+        status: 'Status',
+        actions: 'Actions'
+      },
+      matchTypes: {
+        keyword: 'Keywords',
+        regex: 'Regex',
+        pii: 'PII detectors'
+      },
+      piiTypes: {
+        email: 'Email',
+        phone: 'Phone number',
+        card: 'Card number',
+        api_key: 'API key'
+      },
+      actions: {
+        block: 'Block',
+        mask: 'Mask',
+        flag: 'Flag'
+      },
+      patternsHint: {
+        keyword: 'One keyword per line, case-insensitive',
+        regex: 'One regular expression per line (RE2 syntax)'
+      },
+      form: {
+        name: 'Rule Name',
+        priority: 'Priority',
+        priorityHint: 'Lower values run first',
+        description: 'Description',
+        matchType: 'Match Type',
+        action: 'Action',
+        patterns: 'Patterns',
+        piiTypes: 'Detectors',
+        blockMessage: 'Block Message',
+        blockMessageHint: 'Returned to the client; leave empty for the default message',
+        groups: 'Groups',
+        groupsHint: 'Leave empty to apply to all groups',
+        enabled: 'Enabled'
+      },
+      nameRequired: 'Please enter a rule name',
+      ruleCreated: 'Rule created',
+      ruleUpdated: 'Rule updated',
+      ruleDeleted: 'Rule deleted',
+      deleteConfirm: 'Delete rule "{name}"?',
+      failedToLoad: 'Failed to load guardrail rules',
+      failedToSave: 'Failed to save rule',
+      failedToToggle: 'Failed to update rule status',
+      failedToDelete: 'Failed to delete rule'
+    },
+
+    // Request Policies
+    requestPolicies: {
+      title: 'Request Policies',
+      description: 'Clamp, rewrite or reject client request parameters per group before forwarding',
+      createPolicy: 'Create Policy',
+      editPolicy: 'Edit Policy',
+      deletePolicy: 'Delete Policy',
+      noPolicies: 'No policies configured',
+      createFirstPolicy: 'Create a policy to cap max_tokens, strip beta flags or block server tools',
+      allGroups: 'All groups',
+      dryRun: 'Dry run',
+      columns: {
+        priority: 'Priority',
+        name: 'Name',
+        kind: 'Policy',
+        scope: 'Scope',
+        action: 'Action',
+        status: 'Status',
+        actions: 'Actions'
+      },
+      kinds: {
+        max_tokens: 'Max output tokens',
+        thinking_budget: 'Thinking budget',
+        anthropic_beta_deny: 'Strip anthropic-beta flags',
+        anthropic_beta_force: 'Force anthropic-beta flags',
+        server_tools: 'Deny server tools',
+        reasoning_effort: 'Force reasoning effort',
+        image_input: 'Deny image input'
+      },
+      actions: {
+        modify: 'Modify',
+        reject: 'Reject',
+        warn: 'Warn only'
+      },
+      paramsHint: {
+        anthropic_beta_deny: 'One beta flag per line, e.g. context-1m-2025-08-07',
+        anthropic_beta_force: 'One beta flag per line, added when missing',
+        server_tools: 'Tool type prefixes, one per line, e.g. web_search',
+        reasoning_effort: 'A single effort value, e.g. low / medium / high'
+      },
+      form: {
+        name: 'Name',
+        priority: 'Priority',
+        priorityHint: 'Lower values run first',
+        description: 'Description',
+        kind: 'Policy Type',
+        action: 'Action',
+        limitValue: 'Limit',
+        params: 'Values',
+        rejectMessage: 'Reject Message',
+        rejectMessageHint: 'Returned to the client; leave empty to use the default message',
+        groups: 'Groups',
+        groupsHint: 'Leave empty to apply to all groups',
+        protocols: 'Request Protocols',
+        protocolsHint: 'Leave empty to apply to both Anthropic and OpenAI requests',
+        dryRun: 'Dry run',
+        dryRunHint: 'Only log what would change, without modifying or rejecting requests',
+        enabled: 'Enabled'
+      },
+      nameRequired: 'Please enter a policy name',
+      policyCreated: 'Policy created',
+      policyUpdated: 'Policy updated',
+      policyDeleted: 'Policy deleted',
+      deleteConfirm: "Are you sure you want to delete policy '{name}'?",
+      failedToLoad: 'Failed to load policies',
+      failedToSave: 'Failed to save policy',
+      failedToDelete: 'Failed to delete policy',
+      failedToToggle: 'Failed to toggle policy'
+    },
+
     // Error Passthrough Rules
     errorPassthrough: {
       title: 'Error Passthrough Rules',
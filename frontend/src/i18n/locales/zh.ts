@@ -140,7 +140,10 @@ export default {
       database: '数据库',
       passwordPlaceholder: '密码',
       enableTls: '启用 TLS',
-      enableTlsHint: '连接 Redis 时使用 TLS（公共 CA 证书）'
+      enableTlsHint: '连接 Redis 时使用 TLS（公共 CA 证书）',
+      embedded: '使用内置缓存（无需 Redis）',
+      embeddedHint: '仅适用于单节点：缓存、限流与排队数据保存在内存中，重启后清空',
+      embeddedSummary: '内置（单节点）'
     },
     admin: {
       title: '管理员账户',
@@ -433,7 +436,8 @@ export default {
 
   // Groups (shared)
   groups: {
-    subscription: '订阅'
+    subscription: '订阅',
+    modelRates: '模型倍率：{rates}'
   },
 
   // API Keys
@@ -973,6 +977,9 @@ export default {
       failedToAdjust: '调整失败',
       emailRequired: '请输入邮箱',
       concurrencyMin: '并发数不能小于1',
+      queueWeight: '排队权重',
+      queueWeightHint: '账号全部繁忙时在分组公平队列中的权重，权重越高等待越短',
+      queueWeightRange: '排队权重需在 1 到 100 之间',
       amountRequired: '请输入有效金额',
       insufficientBalance: '余额不足',
       setAllowedGroups: '设置允许分组',
@@ -1198,6 +1205,28 @@ export default {
       failedToCreate: '创建分组失败',
       failedToUpdate: '更新分组失败',
       nameRequired: '请输入分组名称',
+      schedulingStrategy: {
+        title: '调度策略',
+        hint: '同一优先级的账号之间如何选择。额度耗尽的账号总是优先被跳过。',
+        inherit: '使用全局默认',
+        default: '默认（负载率优先，其次最久未用）',
+        weightedRoundRobin: '加权轮询（按并发数）',
+        leastCost: '窗口内费用最低',
+        leastLatency: '首字延迟最低',
+        fillFirst: '用满优先（用满一个账号再用下一个）'
+      },
+      modelRateMultipliers: {
+        title: '模型倍率',
+        hint: '匹配的模型在分组倍率之上再乘以该倍率。模型支持末尾 * 通配，按最长匹配生效。',
+        modelPlaceholder: '模型，如 claude-opus-*',
+        multiplierPlaceholder: '倍率',
+        add: '添加模型倍率',
+        invalid: '模型倍率需要填写模型，且倍率不小于 0'
+      },
+      firstByteTimeout: {
+        title: '首字节超时（秒）',
+        hint: '流式请求在该时间内未收到上游任何数据时切换到其他账号。0 表示使用全局配置。'
+      },
       subscription: {
         title: '订阅设置',
         type: '计费类型',
@@ -1713,6 +1742,10 @@ export default {
       errorCodeExists: '该错误码已被选中',
       interceptWarmupRequests: '拦截预热请求',
       interceptWarmupRequestsDesc: '启用后，标题生成等预热请求将返回 mock 响应，不消耗上游 token',
+      maxContextTokens: '上下文窗口（token）',
+      maxContextTokensPlaceholder: '默认 200000',
+      maxContextTokensHint:
+        '账号可接受的最大输入长度。开通 1M 上下文 beta 的账号请设置为 1000000；估算输入超过窗口的请求只调度到容量足够的账号，没有可承载的账号时直接拒绝',
       autoPauseOnExpired: '过期自动暂停调度',
       autoPauseOnExpiredDesc: '启用后，账号过期将自动暂停调度',
       // Quota control (Anthropic OAuth/SetupToken only)
@@ -2843,6 +2876,7 @@ export default {
         },
         loading: '加载中…',
         requestId: '请求 ID',
+        traceId: '链路追踪 ID',
         time: '时间',
         phase: '阶段',
         status: '状态码',
@@ -2937,6 +2971,45 @@ export default {
           actions: '操作'
         }
       },
+      cluster: {
+        title: '集群节点',
+        description: '向节点注册表上报心跳的网关实例',
+        empty: '暂无已注册节点',
+        loadFailed: '加载集群节点失败',
+        commandSent: '指令已下发',
+        commandFailed: '指令下发失败',
+        self: '当前节点',
+        rollingRestart: '依次重启全部节点',
+        rollingStarted: '滚动重启已开始',
+        rollingProgress: '滚动重启进行中：已重启 {done}/{total} 个节点',
+        rollingFailed: '上次滚动重启失败：{error}',
+        rollingCompleted: '上次滚动重启完成于 {time}',
+        status: {
+          serving: '服务中',
+          draining: '摘流中',
+          restarting: '重启中'
+        },
+        actions: {
+          drain: '摘流',
+          undrain: '恢复',
+          restart: '重启'
+        },
+        confirm: {
+          drain: '停止向 {host} 分发新请求？进行中的请求会正常完成。',
+          undrain: '恢复向 {host} 分发新请求？',
+          restart: '重启 {host}？节点会先摘流并等待进行中的请求结束后退出，需由进程守护拉起。',
+          rolling: '依次重启全部 {count} 个节点？每个节点摘流重启并重新加入后才会继续下一个，当前节点最后重启。'
+        },
+        table: {
+          node: '节点',
+          version: '版本',
+          startedAt: '启动时间',
+          status: '状态',
+          inFlight: '进行中',
+          locks: 'Leader 锁',
+          actions: '操作'
+        }
+      },
       alertEvents: {
         title: '告警事件',
         description: '最近的告警触发/恢复记录（仅邮件通知）',
@@ -3262,6 +3335,7 @@ export default {
         disabledHint: '已在设置中关闭实时监控。',
         empty: '暂无数据',
         queued: '队列 {count}',
+        fairQueued: '公平队列 {count} · 约 {seconds} 秒',
         rateLimited: '限流 {count}',
         errorAccounts: '异常 {count}',
         loadFailed: '加载并发数据失败'
@@ -3527,6 +3601,254 @@ export default {
       failedToSendTestEmail: '发送测试邮件失败'
     },
 
+    // 请求路由规则
+    routingRules: {
+      title: '路由规则',
+      description: '将廉价/后台请求（标题生成、探测请求）转入其他分组或账号子集，让高价值账号只承接真实负载',
+      createRule: '创建规则',
+      editRule: '编辑规则',
+      deleteRule: '删除规则',
+      noRules: '暂无路由规则',
+      createFirstRule: '创建规则，将 haiku 或小 max_tokens 请求转入低成本账号池',
+      allGroups: '所有分组',
+      noTools: '无工具',
+      accountCount: '{count} 个账号',
+      columns: {
+        priority: '优先级',
+        name: '名称',
+        conditions: '条件',
+        scope: '分组',
+        target: '目标',
+        status: '状态',
+        actions: '操作'
+      },
+      form: {
+        name: '规则名称',
+        priority: '优先级',
+        priorityHint: '数值越小越先执行，命中第一条规则即停止',
+        description: '描述',
+        conditionsHint: '已配置的条件需全部满足',
+        modelPatterns: '模型',
+        modelPatternsHint: '每行一个，精确名称或以 * 结尾的前缀；留空表示不限模型',
+        maxTokensBelow: 'max_tokens 小于',
+        inputTokensBelow: '估算输入 token 小于',
+        thresholdHint: '留空表示不限制；未设置 max_tokens 的请求不满足 max_tokens 条件',
+        requireNoTools: '仅匹配未携带工具定义的请求',
+        groups: '分组',
+        groupsHint: '留空表示适用于所有分组',
+        targetGroup: '目标分组',
+        targetGroupHint: '请求在该分组内调度并按该分组计费；须为同平台、已启用的非订阅分组，订阅分组的请求不会被转出',
+        sameGroup: '原分组',
+        targetAccounts: '目标账号',
+        targetAccountsHint: '请求只在（目标）分组内的这些账号中调度；全部不可用时不回退到分组其他账号',
+        searchAccountPlaceholder: '搜索账号...',
+        enabled: '启用'
+      },
+      nameRequired: '请输入规则名称',
+      ruleCreated: '规则已创建',
+      ruleUpdated: '规则已更新',
+      ruleDeleted: '规则已删除',
+      deleteConfirm: '确定删除规则「{name}」吗？',
+      failedToLoad: '加载路由规则失败',
+      failedToSave: '保存规则失败',
+      failedToToggle: '更新规则状态失败',
+      failedToDelete: '删除规则失败'
+    },
+
+    // 客户端规则
+    clientRules: {
+      title: '客户端规则',
+      description: '按分组限制允许接入的客户端，不匹配的请求将被拒绝或转入降级分组',
+      createRule: '创建规则',
+      editRule: '编辑规则',
+      deleteRule: '删除规则',
+      noRules: '暂无客户端规则',
+      createFirstRule: '创建规则，将分组限制为 Claude Code、Codex CLI 或自定义客户端',
+      allGroups: '所有分组',
+      reject: '拒绝（403）',
+      resetCounter: '重置计数',
+      columns: {
+        priority: '优先级',
+        name: '名称',
+        match: '客户端',
+        scope: '分组',
+        fallback: '不匹配时',
+        rejected: '拒绝次数',
+        status: '状态',
+        actions: '操作'
+      },
+      presets: {
+        none: '无（仅自定义匹配）',
+        claude_code: 'Claude Code',
+        codex_cli: 'Codex CLI',
+        gemini_cli: 'Gemini CLI'
+      },
+      form: {
+        name: '规则名称',
+        priority: '优先级',
+        priorityHint: '数值越小越先执行',
+        description: '描述',
+        matchHint: '预设与所有已配置的匹配项均通过才算匹配；分组内任一规则匹配即放行',
+        preset: '预设',
+        userAgentPattern: 'User-Agent 正则',
+        metadataUserIdPattern: 'metadata.user_id 正则',
+        requiredHeaders: '必需请求头',
+        requiredHeadersHint: '每行一个："名称" 或 "名称: 正则"',
+        bodyFields: '必需请求体字段',
+        bodyFieldsHint: '每行一个 JSON 路径，例如 metadata.user_id',
+        groups: '分组',
+        groupsHint: '留空表示适用于所有分组',
+        fallbackGroup: '降级分组',
+        fallbackGroupHint: '不匹配的请求转入该分组而非直接拒绝；须为同平台、已启用的非订阅分组',
+        enabled: '启用'
+      },
+      nameRequired: '请输入规则名称',
+      ruleCreated: '规则已创建',
+      ruleUpdated: '规则已更新',
+      ruleDeleted: '规则已删除',
+      deleteConfirm: '确定删除规则「{name}」吗？',
+      failedToLoad: '加载客户端规则失败',
+      failedToSave: '保存规则失败',
+      failedToToggle: '更新规则状态失败',
+      failedToReset: '重置计数失败',
+      failedToDelete: '删除规则失败'
+    },
+
+    // 内容护栏
+    guardrails: {
+      title: '内容护栏',
+      description: '转发前扫描系统提示词、消息和工具结果，按分组拦截、脱敏或标记命中内容',
+      createRule: '创建规则',
+      editRule: '编辑规则',
+      deleteRule: '删除规则',
+      noRules: '暂无护栏规则',
+      createFirstRule: '创建规则以拦截违规内容或脱敏个人信息',
+      allGroups: '所有分组',
+      columns: {
+        priority: '优先级',
+        name: '名称',
+        match: '匹配',
+        scope: '分组',
+        action: '动作',
+        status: '状态',
+        actions: '操作'
+      },
+      matchTypes: {
+        keyword: '关键词',
+        regex: '正则',
+        pii: 'PII 检测'
+      },
+      piiTypes: {
+        email: '邮箱',
+        phone: '手机号',
+        card: '银行卡号',
+        api_key: 'API 密钥'
+      },
+      actions: {
+        block: '拦截',
+        mask: '脱敏',
+        flag: '标记'
+      },
+      patternsHint: {
+        keyword: '每行一个关键词，不区分大小写',
+        regex: '每行一个正则表达式（RE2 语法）'
+      },
+      form: {
+        name: '规则名称',
+        priority: '优先级',
+        priorityHint: '数值越小越先执行',
+        description: '描述',
+        matchType: '匹配方式',
+        action: '处理动作',
+        patterns: '匹配内容',
+        piiTypes: '检测项',
+        blockMessage: '拦截提示',
+        blockMessageHint: '返回给客户端的错误信息，留空使用默认信息',
+        groups: '适用分组',
+        groupsHint: '留空表示适用于所有分组',
+        enabled: '启用'
+      },
+      nameRequired: '请输入规则名称',
+      ruleCreated: '规则已创建',
+      ruleUpdated: '规则已更新',
+      ruleDeleted: '规则已删除',
+      deleteConfirm: '确定删除规则「{name}」吗？',
+      failedToLoad: '加载护栏规则失败',
+      failedToSave: '保存规则失败',
+      failedToToggle: '更新规则状态失败',
+      failedToDelete: '删除规则失败'
+    },
+
+    // 请求参数策略
+    requestPolicies: {
+      title: '请求参数策略',
+      description: '按分组在转发前限制、改写或拒绝客户端请求参数',
+      createPolicy: '创建策略',
+      editPolicy: '编辑策略',
+      deletePolicy: '删除策略',
+      noPolicies: '暂无策略',
+      createFirstPolicy: '创建策略以限制 max_tokens、剔除 beta 标记或禁用服务端工具',
+      allGroups: '所有分组',
+      dryRun: '试运行',
+      columns: {
+        priority: '优先级',
+        name: '名称',
+        kind: '策略',
+        scope: '范围',
+        action: '动作',
+        status: '状态',
+        actions: '操作'
+      },
+      kinds: {
+        max_tokens: '最大输出 token',
+        thinking_budget: 'Thinking 预算',
+        anthropic_beta_deny: '剔除 anthropic-beta 标记',
+        anthropic_beta_force: '强制 anthropic-beta 标记',
+        server_tools: '禁用服务端工具',
+        reasoning_effort: '强制 reasoning effort',
+        image_input: '禁止图片输入'
+      },
+      actions: {
+        modify: '改写',
+        reject: '拒绝',
+        warn: '仅告警'
+      },
+      paramsHint: {
+        anthropic_beta_deny: '每行一个 beta 标记，如 context-1m-2025-08-07',
+        anthropic_beta_force: '每行一个 beta 标记，缺失时自动添加',
+        server_tools: '工具类型前缀，每行一个，如 web_search',
+        reasoning_effort: '单个 effort 取值，如 low / medium / high'
+      },
+      form: {
+        name: '名称',
+        priority: '优先级',
+        priorityHint: '数值越小越先执行',
+        description: '描述',
+        kind: '策略类型',
+        action: '动作',
+        limitValue: '上限',
+        params: '取值',
+        rejectMessage: '拒绝信息',
+        rejectMessageHint: '返回给客户端的错误信息，留空使用默认信息',
+        groups: '适用分组',
+        groupsHint: '留空表示适用于所有分组',
+        protocols: '请求协议',
+        protocolsHint: '留空表示同时适用于 Anthropic 与 OpenAI 请求',
+        dryRun: '试运行',
+        dryRunHint: '仅记录将要执行的动作，不改写也不拒绝请求',
+        enabled: '启用'
+      },
+      nameRequired: '请输入策略名称',
+      policyCreated: '策略创建成功',
+      policyUpdated: '策略更新成功',
+      policyDeleted: '策略删除成功',
+      deleteConfirm: "确定要删除策略 '{name}' 吗？",
+      failedToLoad: '加载策略失败',
+      failedToSave: '保存策略失败',
+      failedToDelete: '删除策略失败',
+      failedToToggle: '切换策略状态失败'
+    },
+
     // Error Passthrough Rules
     errorPassthrough: {
       title: '错误透传规则',
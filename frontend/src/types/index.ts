@@ -43,6 +43,8 @@ export interface AdminUser extends User {
   notes: string
   // 用户专属分组倍率配置 (group_id -> rate_multiplier)
   group_rates?: Record<number, number>
+  // 账号饱和时分组公平队列中的权重（1-100）
+  queue_weight?: number
   // 当前并发数（仅管理员列表接口返回）
   current_concurrency?: number
 }
@@ -362,6 +364,8 @@ export interface Group {
   claude_code_only: boolean
   fallback_group_id: number | null
   fallback_group_id_on_invalid_request: number | null
+  // 分组内模型倍率（模型匹配模式 -> 倍率）
+  model_rate_multipliers?: Record<string, number>
   created_at: string
   updated_at: string
 }
@@ -380,6 +384,12 @@ export interface AdminGroup extends Group {
   // 分组下账号数量（仅管理员可见）
   account_count?: number
 
+  // 账号调度策略，空字符串表示使用全局默认
+  scheduling_strategy?: SchedulingStrategy | ''
+
+  // 流式请求首字节超时（秒），0 表示使用全局配置
+  first_byte_timeout_seconds?: number
+
   // 分组排序
   sort_order: number
 }
@@ -422,6 +432,13 @@ export interface UpdateApiKeyRequest {
   reset_quota?: boolean // Reset quota_used to 0
 }
 
+export type SchedulingStrategy =
+  | 'default'
+  | 'weighted_round_robin'
+  | 'least_cost'
+  | 'least_latency'
+  | 'fill_first'
+
 export interface CreateGroupRequest {
   name: string
   description?: string | null
@@ -440,6 +457,9 @@ export interface CreateGroupRequest {
   fallback_group_id_on_invalid_request?: number | null
   mcp_xml_inject?: boolean
   supported_model_scopes?: string[]
+  scheduling_strategy?: SchedulingStrategy | ''
+  first_byte_timeout_seconds?: number
+  model_rate_multipliers?: Record<string, number>
   // 从指定分组复制账号
   copy_accounts_from_group_ids?: number[]
 }
@@ -463,6 +483,10 @@ export interface UpdateGroupRequest {
   fallback_group_id_on_invalid_request?: number | null
   mcp_xml_inject?: boolean
   supported_model_scopes?: string[]
+  scheduling_strategy?: SchedulingStrategy | ''
+  first_byte_timeout_seconds?: number
+  // 传入空对象表示清除
+  model_rate_multipliers?: Record<string, number>
   copy_accounts_from_group_ids?: number[]
 }
 
@@ -648,6 +672,7 @@ export interface AccountUsageInfo {
   five_hour: UsageProgress | null
   seven_day: UsageProgress | null
   seven_day_sonnet: UsageProgress | null
+  seven_day_opus?: UsageProgress | null
   gemini_shared_daily?: UsageProgress | null
   gemini_pro_daily?: UsageProgress | null
   gemini_flash_daily?: UsageProgress | null
@@ -1021,6 +1046,7 @@ export interface UpdateUserRequest {
   role?: 'admin' | 'user'
   balance?: number
   concurrency?: number
+  queue_weight?: number
   status?: 'active' | 'disabled'
   allowed_groups?: number[] | null
   // 用户专属分组倍率配置 (group_id -> rate_multiplier | null)